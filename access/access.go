@@ -0,0 +1,158 @@
+// Package access holds the proxy-level access rules of a user — which
+// libraries they may see, the highest parental rating and the tags they may
+// watch, and the hours during which they may use the server — and decides
+// whether a library, an item or a point in time is allowed by them.
+//
+// The rules are enforced by the proxy itself, so a restricted account is
+// limited the same way on every backend, even when it shares its backend
+// accounts with unrestricted users.
+package access
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+)
+
+// Days accepted in Schedule.DayOfWeek, named like Jellyfin's DynamicDayOfWeek.
+const (
+	Everyday = "Everyday"
+	Weekday  = "Weekday"
+	Weekend  = "Weekend"
+)
+
+// Schedule is a span of hours during which a user may use the server.
+// Hours are in the proxy's local time zone; EndHour 24 is the end of the day.
+type Schedule struct {
+	// DayOfWeek is a weekday name such as "Monday", or Everyday, Weekday or
+	// Weekend.
+	DayOfWeek string  `json:"day_of_week"`
+	StartHour float64 `json:"start_hour"`
+	EndHour   float64 `json:"end_hour"`
+}
+
+// Rules are the access restrictions of one user. The zero value allows
+// everything.
+type Rules struct {
+	// AllowedLibraries lists the only libraries the user may see, by proxy
+	// ID. A merged_ ID stands for every library of its collection type.
+	// Empty allows all libraries.
+	AllowedLibraries []string `json:"allowed_libraries,omitempty"`
+	// BlockedLibraries lists libraries the user may not see, by proxy or
+	// merged_ ID. It applies on top of AllowedLibraries.
+	BlockedLibraries []string `json:"blocked_libraries,omitempty"`
+	// MaxParentalRating is the highest parental rating the user may watch,
+	// as the minimum viewer age of the rating (e.g. 13 for PG-13). Unrated
+	// items are allowed. Nil means no limit.
+	MaxParentalRating *int `json:"max_parental_rating,omitempty"`
+	// BlockedTags hides items carrying any of these tags, matched ignoring
+	// case.
+	BlockedTags []string `json:"blocked_tags,omitempty"`
+	// Schedules are the times the user may use the server. Empty allows
+	// any time.
+	Schedules []Schedule `json:"schedules,omitempty"`
+}
+
+// Validate reports the first rule that cannot be evaluated.
+func (r Rules) Validate() error {
+	if r.MaxParentalRating != nil && *r.MaxParentalRating < 0 {
+		return fmt.Errorf("max_parental_rating must not be negative")
+	}
+	for _, s := range r.Schedules {
+		if _, ok := scheduleDays[strings.ToLower(s.DayOfWeek)]; !ok {
+			return fmt.Errorf("unknown day_of_week %q", s.DayOfWeek)
+		}
+		if s.StartHour < 0 || s.EndHour > 24 || s.StartHour >= s.EndHour {
+			return fmt.Errorf("schedule hours must satisfy 0 <= start_hour < end_hour <= 24")
+		}
+	}
+	return nil
+}
+
+// RestrictsLibraries reports whether any library is hidden from the user.
+func (r Rules) RestrictsLibraries() bool {
+	return len(r.AllowedLibraries) > 0 || len(r.BlockedLibraries) > 0
+}
+
+// RestrictsItems reports whether items are filtered by rating or tags.
+func (r Rules) RestrictsItems() bool {
+	return r.MaxParentalRating != nil || len(r.BlockedTags) > 0
+}
+
+// Restricted reports whether the rules limit the user in any way.
+func (r Rules) Restricted() bool {
+	return r.RestrictsLibraries() || r.RestrictsItems() || len(r.Schedules) > 0
+}
+
+// LibraryAllowed reports whether the user may see a library. ids are the
+// IDs the library is known by: its own proxy ID and, when it has a
+// collection type, the merged_ ID of that type.
+func (r Rules) LibraryAllowed(ids ...string) bool {
+	for _, id := range ids {
+		if containsFold(r.BlockedLibraries, id) {
+			return false
+		}
+	}
+	if len(r.AllowedLibraries) == 0 {
+		return true
+	}
+	for _, id := range ids {
+		if containsFold(r.AllowedLibraries, id) {
+			return true
+		}
+	}
+	return false
+}
+
+// ItemAllowed reports whether the user may see an item with the given
+// official rating and tags. Callers pass the tags of the item's parents
+// (e.g. the series of an episode) along with its own.
+func (r Rules) ItemAllowed(officialRating string, tags []string) bool {
+	for _, t := range tags {
+		if containsFold(r.BlockedTags, t) {
+			return false
+		}
+	}
+	if r.MaxParentalRating != nil {
+		if age, ok := RatingAge(officialRating); ok && age > *r.MaxParentalRating {
+			return false
+		}
+	}
+	return true
+}
+
+// Allows reports whether the user may use the server at t, which is
+// converted to the proxy's local time zone.
+func (r Rules) Allows(t time.Time) bool {
+	if len(r.Schedules) == 0 {
+		return true
+	}
+	t = t.Local()
+	hour := float64(t.Hour()) + float64(t.Minute())/60 + float64(t.Second())/3600
+	for _, s := range r.Schedules {
+		if scheduleDays[strings.ToLower(s.DayOfWeek)](t.Weekday()) &&
+			hour >= s.StartHour && hour < s.EndHour {
+			return true
+		}
+	}
+	return false
+}
+
+// scheduleDays maps the lower-cased Schedule.DayOfWeek values to the
+// weekdays they match.
+var scheduleDays = map[string]func(time.Weekday) bool{
+	"everyday": func(time.Weekday) bool { return true },
+	"weekday":  func(d time.Weekday) bool { return d != time.Saturday && d != time.Sunday },
+	"weekend":  func(d time.Weekday) bool { return d == time.Saturday || d == time.Sunday },
+}
+
+func init() {
+	for d := time.Sunday; d <= time.Saturday; d++ {
+		scheduleDays[strings.ToLower(d.String())] = func(w time.Weekday) bool { return w == d }
+	}
+}
+
+func containsFold(list []string, s string) bool {
+	return slices.ContainsFunc(list, func(v string) bool { return strings.EqualFold(v, s) })
+}
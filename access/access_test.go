@@ -0,0 +1,124 @@
+package access_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/ddevcap/jellyfin-proxy/access"
+)
+
+var _ = Describe("RatingAge", func() {
+	DescribeTable("recognised ratings",
+		func(rating string, age int) {
+			got, ok := access.RatingAge(rating)
+			Expect(ok).To(BeTrue())
+			Expect(got).To(Equal(age))
+		},
+		Entry("MPA", "PG-13", 13),
+		Entry("US TV", "TV-MA", 17),
+		Entry("country prefix", "US-R", 17),
+		Entry("lower case", "pg", 10),
+		Entry("BBFC", "12A", 12),
+		Entry("FSK", "FSK-16", 16),
+		Entry("prefixed age", "DE-12", 12),
+		Entry("age with plus", "16+", 16),
+		Entry("bare age", "15", 15),
+	)
+
+	DescribeTable("unrated or unknown ratings",
+		func(rating string) {
+			_, ok := access.RatingAge(rating)
+			Expect(ok).To(BeFalse())
+		},
+		Entry("empty", ""),
+		Entry("not rated", "NR"),
+		Entry("unrated", "Unrated"),
+		Entry("unknown", "Family Friendly"),
+		Entry("not an age", "2023-01"),
+	)
+})
+
+var _ = Describe("Rules", func() {
+	intp := func(v int) *int { return &v }
+
+	It("allows everything when empty", func() {
+		var r access.Rules
+		Expect(r.Restricted()).To(BeFalse())
+		Expect(r.LibraryAllowed("a_lib", "merged_movies")).To(BeTrue())
+		Expect(r.ItemAllowed("NC-17", []string{"horror"})).To(BeTrue())
+		Expect(r.Allows(time.Now())).To(BeTrue())
+	})
+
+	Describe("LibraryAllowed", func() {
+		It("limits to allowed libraries, by proxy or merged ID", func() {
+			r := access.Rules{AllowedLibraries: []string{"a_kids", "merged_music"}}
+			Expect(r.LibraryAllowed("a_kids", "merged_movies")).To(BeTrue())
+			Expect(r.LibraryAllowed("b_songs", "merged_music")).To(BeTrue())
+			Expect(r.LibraryAllowed("a_films", "merged_movies")).To(BeFalse())
+		})
+
+		It("hides blocked libraries even when allowed", func() {
+			r := access.Rules{
+				AllowedLibraries: []string{"merged_movies"},
+				BlockedLibraries: []string{"B_ADULT"},
+			}
+			Expect(r.LibraryAllowed("a_films", "merged_movies")).To(BeTrue())
+			Expect(r.LibraryAllowed("b_adult", "merged_movies")).To(BeFalse())
+		})
+	})
+
+	Describe("ItemAllowed", func() {
+		It("blocks ratings above the limit and lets unrated items through", func() {
+			r := access.Rules{MaxParentalRating: intp(12)}
+			Expect(r.ItemAllowed("PG", nil)).To(BeTrue())
+			Expect(r.ItemAllowed("12", nil)).To(BeTrue())
+			Expect(r.ItemAllowed("PG-13", nil)).To(BeFalse())
+			Expect(r.ItemAllowed("TV-MA", nil)).To(BeFalse())
+			Expect(r.ItemAllowed("", nil)).To(BeTrue())
+		})
+
+		It("blocks tags ignoring case", func() {
+			r := access.Rules{BlockedTags: []string{"Horror"}}
+			Expect(r.ItemAllowed("", []string{"comedy", "horror"})).To(BeFalse())
+			Expect(r.ItemAllowed("", []string{"comedy"})).To(BeTrue())
+		})
+	})
+
+	Describe("Allows", func() {
+		// 2026-03-14 is a Saturday.
+		at := func(day, hour, minute int) time.Time {
+			return time.Date(2026, time.March, day, hour, minute, 0, 0, time.Local)
+		}
+
+		It("matches named days, weekdays and weekends", func() {
+			r := access.Rules{Schedules: []access.Schedule{
+				{DayOfWeek: access.Weekday, StartHour: 16, EndHour: 19.5},
+				{DayOfWeek: "saturday", StartHour: 8, EndHour: 24},
+			}}
+			Expect(r.Allows(at(16, 17, 0))).To(BeTrue())   // Monday
+			Expect(r.Allows(at(16, 19, 45))).To(BeFalse()) // Monday, too late
+			Expect(r.Allows(at(14, 23, 59))).To(BeTrue())  // Saturday
+			Expect(r.Allows(at(15, 10, 0))).To(BeFalse())  // Sunday
+		})
+	})
+
+	Describe("Validate", func() {
+		It("accepts well-formed rules", func() {
+			r := access.Rules{
+				MaxParentalRating: intp(0),
+				Schedules:         []access.Schedule{{DayOfWeek: access.Everyday, StartHour: 0, EndHour: 24}},
+			}
+			Expect(r.Validate()).To(Succeed())
+		})
+
+		It("rejects unknown days, bad hours and negative ratings", func() {
+			Expect(access.Rules{Schedules: []access.Schedule{{DayOfWeek: "Funday", EndHour: 1}}}.Validate()).
+				To(MatchError(ContainSubstring("day_of_week")))
+			Expect(access.Rules{Schedules: []access.Schedule{{DayOfWeek: "Monday", StartHour: 20, EndHour: 8}}}.Validate()).
+				To(HaveOccurred())
+			Expect(access.Rules{MaxParentalRating: intp(-1)}.Validate()).To(HaveOccurred())
+		})
+	})
+})
@@ -0,0 +1,61 @@
+package access
+
+import (
+	"strconv"
+	"strings"
+)
+
+// ratingAges maps official ratings that are not simply an age to the
+// minimum viewer age they stand for, following the values of Jellyfin's
+// rating tables.
+var ratingAges = map[string]int{
+	// US film (MPA)
+	"G": 0, "PG": 10, "PG-13": 13, "R": 17, "NC-17": 18, "X": 18,
+	// US television
+	"TV-Y": 0, "TV-Y7": 7, "TV-Y7-FV": 7, "TV-G": 0, "TV-PG": 10, "TV-14": 14, "TV-MA": 17,
+	// UK (BBFC)
+	"U": 0, "UC": 0, "12A": 12, "R18": 18,
+	// Others
+	"ALL": 0, "AL": 0, "TP": 0, "E": 0, "A": 18, "ADULT": 18, "XXX": 18,
+}
+
+// unratedRatings are ratings that carry no age.
+var unratedRatings = map[string]bool{
+	"": true, "NR": true, "NOT RATED": true, "UNRATED": true, "APPROVED": true, "PASSED": true,
+}
+
+// RatingAge returns the minimum viewer age of an official rating such as
+// "PG-13", "TV-MA", "FSK-16", "DE-12" or "15". ok is false for unrated
+// items and ratings that are not recognised.
+func RatingAge(rating string) (age int, ok bool) {
+	r := strings.ToUpper(strings.TrimSpace(rating))
+	if unratedRatings[r] {
+		return 0, false
+	}
+	if age, ok := ratingAges[r]; ok {
+		return age, true
+	}
+	// Metadata providers often prefix the country, e.g. "US-PG-13" or "GB-15".
+	if i := strings.IndexByte(r, '-'); i == 2 {
+		if age, ok := ratingAges[r[3:]]; ok {
+			return age, true
+		}
+	}
+	// Most other systems name the age, e.g. "FSK-16", "DE-12", "16+" or "15".
+	end := len(r)
+	for end > 0 && (r[end-1] < '0' || r[end-1] > '9') {
+		end--
+	}
+	start := end
+	for start > 0 && r[start-1] >= '0' && r[start-1] <= '9' {
+		start--
+	}
+	if start == end || end-start > 2 || end < len(r)-1 || strings.ContainsAny(r[:start], "0123456789") {
+		return 0, false
+	}
+	age, err := strconv.Atoi(r[start:end])
+	if err != nil {
+		return 0, false
+	}
+	return age, true
+}
@@ -0,0 +1,54 @@
+// Package activity records the proxy's audit trail — logins, sessions,
+// playback, admin changes and backend outages — in the ActivityLog table,
+// from which it is served to clients in Jellyfin's activity log format.
+package activity
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/ddevcap/jellyfin-proxy/ent"
+	"github.com/google/uuid"
+)
+
+// Severity levels, named like Jellyfin's log levels.
+const (
+	Information = "Information"
+	Warning     = "Warning"
+	Error       = "Error"
+)
+
+// Entry is one event to record.
+type Entry struct {
+	Name          string    // one-line summary shown in the dashboard
+	Type          string    // Jellyfin event type, e.g. "AuthenticationSucceeded"
+	ShortOverview string    // optional detail, e.g. "IP address: 10.0.0.2"
+	Overview      string    // optional longer detail
+	UserID        uuid.UUID // the user the event concerns, or uuid.Nil
+	ItemID        string    // the proxy item ID the event concerns, if any
+	Severity      string    // defaults to Information
+}
+
+// Record stores e. Recording is best-effort: a failure is logged but never
+// fails the operation being recorded, and the entry is written even if ctx
+// is cancelled because the client went away.
+func Record(ctx context.Context, db *ent.Client, e Entry) {
+	if db == nil {
+		return
+	}
+	if e.Severity == "" {
+		e.Severity = Information
+	}
+	err := db.ActivityLog.Create().
+		SetName(e.Name).
+		SetEventType(e.Type).
+		SetShortOverview(e.ShortOverview).
+		SetOverview(e.Overview).
+		SetUserID(e.UserID).
+		SetItemID(e.ItemID).
+		SetSeverity(e.Severity).
+		Exec(context.WithoutCancel(ctx))
+	if err != nil {
+		slog.Warn("activity: recording entry", "type", e.Type, "error", err)
+	}
+}
@@ -0,0 +1,298 @@
+package handler
+
+import (
+	"crypto/rand"
+	"encoding/binary"
+	"encoding/hex"
+	"net/http"
+	"time"
+
+	"github.com/ddevcap/jellyfin-proxy/activity"
+	"github.com/ddevcap/jellyfin-proxy/api/middleware"
+	"github.com/ddevcap/jellyfin-proxy/config"
+	"github.com/ddevcap/jellyfin-proxy/ent"
+	entapikey "github.com/ddevcap/jellyfin-proxy/ent/apikey"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// APIKeyHandler manages long-lived API keys, both through the admin REST API
+// and the Jellyfin-compatible /Auth/Keys endpoints. Only a hash of each key
+// is stored, so the key itself is returned once, on creation.
+type APIKeyHandler struct {
+	db  *ent.Client
+	cfg config.Config
+	hub *WSHub // nil until SetWSHub
+}
+
+func NewAPIKeyHandler(db *ent.Client, cfg config.Config) *APIKeyHandler {
+	return &APIKeyHandler{db: db, cfg: cfg}
+}
+
+// SetWSHub lets the handler close the WebSockets of keys it revokes.
+func (h *APIKeyHandler) SetWSHub(hub *WSHub) {
+	h.hub = hub
+}
+
+// apiKeyResponse is the outward representation of an API key. Key is only
+// set in the response that creates it.
+type apiKeyResponse struct {
+	ID         uuid.UUID  `json:"id"`
+	Name       string     `json:"name"`
+	UserID     uuid.UUID  `json:"user_id"`
+	Username   string     `json:"username"`
+	Key        string     `json:"key,omitempty"`
+	ExpiresAt  *time.Time `json:"expires_at"`
+	LastUsedAt *time.Time `json:"last_used_at"`
+	CreatedAt  time.Time  `json:"created_at"`
+}
+
+func toAPIKeyResponse(k *ent.ApiKey) apiKeyResponse {
+	r := apiKeyResponse{
+		ID:         k.ID,
+		Name:       k.Name,
+		ExpiresAt:  k.ExpiresAt,
+		LastUsedAt: k.LastUsedAt,
+		CreatedAt:  k.CreatedAt,
+	}
+	if u := k.Edges.User; u != nil {
+		r.UserID = u.ID
+		r.Username = u.Username
+	}
+	return r
+}
+
+// create stores a new key for user and returns it along with the secret.
+// A nil expiresAt falls back to API_KEY_TTL, and to no expiry without one.
+func (h *APIKeyHandler) create(c *gin.Context, user *ent.User, name string, expiresAt *time.Time) (*ent.ApiKey, string, error) {
+	secret := make([]byte, 16)
+	if _, err := rand.Read(secret); err != nil {
+		return nil, "", err
+	}
+	// 32 hex characters, the format of Jellyfin's own API keys.
+	key := hex.EncodeToString(secret)
+
+	if expiresAt == nil && h.cfg.APIKeyTTL > 0 {
+		t := time.Now().Add(h.cfg.APIKeyTTL)
+		expiresAt = &t
+	}
+	k, err := h.db.ApiKey.Create().
+		SetName(name).
+		SetKeyHash(middleware.HashToken(key)).
+		SetNillableExpiresAt(expiresAt).
+		SetUser(user).
+		Save(c.Request.Context())
+	if err != nil {
+		return nil, "", err
+	}
+	k.Edges.User = user
+	recordAdminActivity(c, h.db, activity.Entry{
+		Name:   "API key " + name + " was created for user " + user.Username,
+		Type:   "ApiKeyCreated",
+		UserID: user.ID,
+	})
+	return k, key, nil
+}
+
+// revoke deletes k, closes the WebSockets opened with it and records it.
+func (h *APIKeyHandler) revoke(c *gin.Context, k *ent.ApiKey) error {
+	if err := h.db.ApiKey.DeleteOne(k).Exec(c.Request.Context()); err != nil {
+		return err
+	}
+	if h.hub != nil {
+		h.hub.CloseAPIKeys(k.ID)
+	}
+	e := activity.Entry{
+		Name: "API key " + k.Name + " was revoked",
+		Type: "ApiKeyRevoked",
+	}
+	if u := k.Edges.User; u != nil {
+		e.Name += " for user " + u.Username
+		e.UserID = u.ID
+	}
+	recordAdminActivity(c, h.db, e)
+	return nil
+}
+
+// ── Admin API ─────────────────────────────────────────────────────────────────
+
+type createAPIKeyRequest struct {
+	Name string `json:"name" binding:"required"`
+	// UserID is the owner of the key; the calling admin when empty.
+	UserID    *uuid.UUID `json:"user_id"`
+	ExpiresAt *time.Time `json:"expires_at"`
+}
+
+// CreateAPIKey handles POST /proxy/apikeys.
+// The response is the only time the key itself is returned.
+func (h *APIKeyHandler) CreateAPIKey(c *gin.Context) {
+	var req createAPIKeyRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	if req.ExpiresAt != nil && !req.ExpiresAt.After(time.Now()) {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "expires_at must be in the future"})
+		return
+	}
+
+	owner := userFromCtx(c)
+	if req.UserID != nil {
+		u, err := h.db.User.Get(c.Request.Context(), *req.UserID)
+		if err != nil {
+			if ent.IsNotFound(err) {
+				c.JSON(http.StatusNotFound, gin.H{"error": "user not found"})
+				return
+			}
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to get user"})
+			return
+		}
+		owner = u
+	}
+
+	k, key, err := h.create(c, owner, req.Name, req.ExpiresAt)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to create API key"})
+		return
+	}
+	resp := toAPIKeyResponse(k)
+	resp.Key = key
+	c.JSON(http.StatusCreated, resp)
+}
+
+// ListAPIKeys handles GET /proxy/apikeys.
+func (h *APIKeyHandler) ListAPIKeys(c *gin.Context) {
+	keys, err := h.db.ApiKey.Query().
+		WithUser().
+		Order(entapikey.ByCreatedAt()).
+		All(c.Request.Context())
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to list API keys"})
+		return
+	}
+
+	resp := make([]apiKeyResponse, len(keys))
+	for i, k := range keys {
+		resp[i] = toAPIKeyResponse(k)
+	}
+	c.JSON(http.StatusOK, resp)
+}
+
+// DeleteAPIKey handles DELETE /proxy/apikeys/:id.
+func (h *APIKeyHandler) DeleteAPIKey(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid API key ID"})
+		return
+	}
+
+	k, err := h.db.ApiKey.Query().
+		Where(entapikey.ID(id)).
+		WithUser().
+		Only(c.Request.Context())
+	if err == nil {
+		err = h.revoke(c, k)
+	}
+	if err != nil {
+		if ent.IsNotFound(err) {
+			c.JSON(http.StatusNotFound, gin.H{"error": "API key not found"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to delete API key"})
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
+
+// ── Jellyfin /Auth/Keys ───────────────────────────────────────────────────────
+
+// authKeyInfo is a key in Jellyfin's AuthenticationInfo format. AccessToken
+// carries the key's ID, which DELETE /Auth/Keys/{key} accepts, because the
+// key itself is not stored.
+func authKeyInfo(k *ent.ApiKey, accessToken string) gin.H {
+	var userID uuid.UUID
+	var userName string
+	if u := k.Edges.User; u != nil {
+		userID, userName = u.ID, u.Username
+	}
+	return gin.H{
+		"Id":               int64(binary.BigEndian.Uint64(k.ID[:8]) >> 11),
+		"AccessToken":      accessToken,
+		"DeviceId":         "",
+		"AppName":          k.Name,
+		"AppVersion":       "",
+		"DeviceName":       "",
+		"UserId":           userID,
+		"UserName":         userName,
+		"IsActive":         k.ExpiresAt == nil || time.Now().Before(*k.ExpiresAt),
+		"DateCreated":      k.CreatedAt.UTC(),
+		"DateRevoked":      nil,
+		"DateLastActivity": k.LastUsedAt,
+	}
+}
+
+// GetAuthKeys handles GET /Auth/Keys.
+func (h *APIKeyHandler) GetAuthKeys(c *gin.Context) {
+	keys, err := h.db.ApiKey.Query().
+		WithUser().
+		Order(entapikey.ByCreatedAt()).
+		All(c.Request.Context())
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to list API keys"})
+		return
+	}
+
+	items := make([]gin.H, len(keys))
+	for i, k := range keys {
+		items[i] = authKeyInfo(k, k.ID.String())
+	}
+	c.JSON(http.StatusOK, gin.H{
+		"Items":            items,
+		"TotalRecordCount": len(items),
+		"StartIndex":       0,
+	})
+}
+
+// CreateAuthKey handles POST /Auth/Keys?app=Name.
+// Jellyfin answers 204; the proxy returns the new key instead, since it
+// cannot be listed later.
+func (h *APIKeyHandler) CreateAuthKey(c *gin.Context) {
+	app := c.Query("app")
+	if app == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "app is required"})
+		return
+	}
+	k, key, err := h.create(c, userFromCtx(c), app, nil)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to create API key"})
+		return
+	}
+	c.JSON(http.StatusOK, authKeyInfo(k, key))
+}
+
+// RevokeAuthKey handles DELETE /Auth/Keys/:key.
+// key is either the key itself or its ID as listed by GET /Auth/Keys.
+func (h *APIKeyHandler) RevokeAuthKey(c *gin.Context) {
+	key := c.Param("key")
+	where := entapikey.KeyHash(middleware.HashToken(key))
+	if id, err := uuid.Parse(key); err == nil {
+		where = entapikey.Or(where, entapikey.ID(id))
+	}
+
+	k, err := h.db.ApiKey.Query().
+		Where(where).
+		WithUser().
+		Only(c.Request.Context())
+	if err == nil {
+		err = h.revoke(c, k)
+	}
+	if err != nil {
+		if ent.IsNotFound(err) {
+			c.JSON(http.StatusNotFound, gin.H{"error": "API key not found"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to revoke API key"})
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
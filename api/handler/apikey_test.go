@@ -0,0 +1,205 @@
+package handler_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/gorilla/websocket"
+
+	"github.com/ddevcap/jellyfin-proxy/api/handler"
+	"github.com/ddevcap/jellyfin-proxy/api/middleware"
+	"github.com/ddevcap/jellyfin-proxy/backend"
+	"github.com/ddevcap/jellyfin-proxy/config"
+	"github.com/ddevcap/jellyfin-proxy/ent"
+	entactivitylog "github.com/ddevcap/jellyfin-proxy/ent/activitylog"
+)
+
+var _ = Describe("APIKeyHandler", func() {
+	var (
+		router *gin.Engine
+		hub    *handler.WSHub
+		admin  *ent.User
+		bob    *ent.User
+	)
+
+	adminAuth := map[string]string{"X-Emby-Token": "apikey-admin-token"}
+
+	// createKey creates a key through the admin API and returns the response.
+	createKey := func(body map[string]interface{}) map[string]interface{} {
+		w := doPost(router, "/proxy/apikeys", body, adminAuth)
+		Expect(w.Code).To(Equal(http.StatusCreated))
+		var resp map[string]interface{}
+		Expect(json.Unmarshal(w.Body.Bytes(), &resp)).To(Succeed())
+		return resp
+	}
+
+	whoami := func(key string) *http.Response {
+		w := doGet(router, "/whoami?api_key="+key)
+		return w.Result()
+	}
+
+	BeforeEach(func() {
+		cleanDB()
+		admin = createUser("admin", "adminpassword1", true)
+		createSession(admin, "apikey-admin-token")
+		bob = createUser("bob", "bobpassword1", false)
+
+		cfg := config.Config{SessionTTL: time.Hour}
+		hub = handler.NewWSHub(backend.NewPool(db, cfg))
+		h := handler.NewAPIKeyHandler(db, cfg)
+		h.SetWSHub(hub)
+		router = gin.New()
+		auth := router.Group("/")
+		auth.Use(middleware.Auth(db, cfg))
+		auth.GET("/socket", handler.WebSocketHandler(hub))
+		auth.GET("/whoami", func(c *gin.Context) {
+			c.String(http.StatusOK, c.MustGet(middleware.ContextKeyUser).(*ent.User).Username)
+		})
+		adminOnly := auth.Group("/", middleware.AdminOnly())
+		adminOnly.POST("/proxy/apikeys", h.CreateAPIKey)
+		adminOnly.GET("/proxy/apikeys", h.ListAPIKeys)
+		adminOnly.DELETE("/proxy/apikeys/:id", h.DeleteAPIKey)
+		adminOnly.GET("/Auth/Keys", h.GetAuthKeys)
+		adminOnly.POST("/Auth/Keys", h.CreateAuthKey)
+		adminOnly.DELETE("/Auth/Keys/:key", h.RevokeAuthKey)
+	})
+
+	AfterEach(func() {
+		hub.Shutdown()
+	})
+
+	It("authenticates as the owner and never expires by default", func() {
+		resp := createKey(map[string]interface{}{"name": "Sonarr", "user_id": bob.ID})
+		Expect(resp["key"]).To(HaveLen(32))
+		Expect(resp["expires_at"]).To(BeNil())
+
+		// Idle longer than SESSION_TTL is fine for a key.
+		db.ApiKey.UpdateOneID(uuid.MustParse(resp["id"].(string))).
+			SetLastUsedAt(time.Now().Add(-48 * time.Hour)).
+			ExecX(context.Background())
+
+		w := doGet(router, "/whoami", map[string]string{"X-Emby-Token": resp["key"].(string)})
+		Expect(w.Code).To(Equal(http.StatusOK))
+		Expect(w.Body.String()).To(Equal("bob"))
+
+		key := db.ApiKey.GetX(context.Background(), uuid.MustParse(resp["id"].(string)))
+		Expect(*key.LastUsedAt).To(BeTemporally("~", time.Now(), time.Minute))
+	})
+
+	It("rejects expired and revoked keys", func() {
+		expired := createKey(map[string]interface{}{
+			"name":       "old",
+			"expires_at": time.Now().Add(time.Hour),
+		})
+		db.ApiKey.UpdateOneID(uuid.MustParse(expired["id"].(string))).
+			SetExpiresAt(time.Now().Add(-time.Minute)).
+			ExecX(context.Background())
+		Expect(whoami(expired["key"].(string)).StatusCode).To(Equal(http.StatusUnauthorized))
+
+		revoked := createKey(map[string]interface{}{"name": "revoked"})
+		w := doDelete(router, "/proxy/apikeys/"+revoked["id"].(string), adminAuth)
+		Expect(w.Code).To(Equal(http.StatusNoContent))
+		Expect(whoami(revoked["key"].(string)).StatusCode).To(Equal(http.StatusUnauthorized))
+	})
+
+	It("lists keys without their secret", func() {
+		createKey(map[string]interface{}{"name": "Sonarr"})
+
+		w := doGet(router, "/proxy/apikeys", adminAuth)
+		Expect(w.Code).To(Equal(http.StatusOK))
+		var keys []map[string]interface{}
+		Expect(json.Unmarshal(w.Body.Bytes(), &keys)).To(Succeed())
+		Expect(keys).To(HaveLen(1))
+		Expect(keys[0]["name"]).To(Equal("Sonarr"))
+		Expect(keys[0]["username"]).To(Equal("admin"))
+		Expect(keys[0]).NotTo(HaveKey("key"))
+	})
+
+	It("serves the Jellyfin /Auth/Keys endpoints", func() {
+		w := doPost(router, "/Auth/Keys?app=Jellyseerr", nil, adminAuth)
+		Expect(w.Code).To(Equal(http.StatusOK))
+		var created map[string]interface{}
+		Expect(json.Unmarshal(w.Body.Bytes(), &created)).To(Succeed())
+		Expect(whoami(created["AccessToken"].(string)).StatusCode).To(Equal(http.StatusOK))
+
+		w = doGet(router, "/Auth/Keys", adminAuth)
+		var list struct {
+			Items []map[string]interface{}
+		}
+		Expect(json.Unmarshal(w.Body.Bytes(), &list)).To(Succeed())
+		Expect(list.Items).To(HaveLen(1))
+		Expect(list.Items[0]["AppName"]).To(Equal("Jellyseerr"))
+		Expect(list.Items[0]["AccessToken"]).NotTo(Equal(created["AccessToken"]))
+
+		w = doDelete(router, "/Auth/Keys/"+list.Items[0]["AccessToken"].(string), adminAuth)
+		Expect(w.Code).To(Equal(http.StatusNoContent))
+		Expect(db.ApiKey.Query().CountX(context.Background())).To(BeZero())
+	})
+
+	It("records creation and revocation in the activity log", func() {
+		resp := createKey(map[string]interface{}{"name": "Sonarr"})
+		doDelete(router, "/proxy/apikeys/"+resp["id"].(string), adminAuth)
+
+		types := db.ActivityLog.Query().
+			Where(entactivitylog.EventTypeHasPrefix("ApiKey")).
+			Select(entactivitylog.FieldEventType).
+			StringsX(context.Background())
+		Expect(types).To(ConsistOf("ApiKeyCreated", "ApiKeyRevoked"))
+	})
+
+	It("records key usage in the activity log at most every few minutes", func() {
+		resp := createKey(map[string]interface{}{"name": "Sonarr", "user_id": bob.ID})
+		Expect(whoami(resp["key"].(string)).StatusCode).To(Equal(http.StatusOK))
+		Expect(whoami(resp["key"].(string)).StatusCode).To(Equal(http.StatusOK))
+
+		used := db.ActivityLog.Query().
+			Where(entactivitylog.EventType("ApiKeyUsed")).
+			AllX(context.Background())
+		Expect(used).To(HaveLen(1))
+		Expect(used[0].UserID).To(Equal(bob.ID))
+		Expect(used[0].Name).To(ContainSubstring("Sonarr"))
+	})
+
+	It("closes the WebSockets opened with a key when it is revoked", func() {
+		resp := createKey(map[string]interface{}{"name": "Sonarr"})
+		other := createKey(map[string]interface{}{"name": "Radarr"})
+
+		server := httptest.NewServer(router)
+		defer server.Close()
+		connect := func(key string) *websocket.Conn {
+			u := "ws" + strings.TrimPrefix(server.URL, "http") + "/socket?api_key=" + key
+			conn, _, err := websocket.DefaultDialer.Dial(u, nil)
+			Expect(err).NotTo(HaveOccurred())
+			_ = conn.SetReadDeadline(time.Now().Add(5 * time.Second))
+			_, _, err = conn.ReadMessage() // initial KeepAlive
+			Expect(err).NotTo(HaveOccurred())
+			return conn
+		}
+		revoked := connect(resp["key"].(string))
+		defer revoked.Close()
+		kept := connect(other["key"].(string))
+		defer kept.Close()
+
+		w := doDelete(router, "/proxy/apikeys/"+resp["id"].(string), adminAuth)
+		Expect(w.Code).To(Equal(http.StatusNoContent))
+
+		_ = revoked.SetReadDeadline(time.Now().Add(5 * time.Second))
+		_, _, err := revoked.ReadMessage()
+		Expect(websocket.IsCloseError(err, websocket.ClosePolicyViolation)).To(BeTrue(), fmt.Sprint(err))
+
+		// A connection opened with another key stays open.
+		_ = kept.SetReadDeadline(time.Now().Add(200 * time.Millisecond))
+		_, _, err = kept.ReadMessage()
+		Expect(websocket.IsCloseError(err, websocket.ClosePolicyViolation)).To(BeFalse())
+	})
+})
@@ -0,0 +1,188 @@
+package handler
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base32"
+	"log/slog"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/ddevcap/jellyfin-proxy/activity"
+	"github.com/ddevcap/jellyfin-proxy/api/middleware"
+	"github.com/ddevcap/jellyfin-proxy/ent"
+	entapppassword "github.com/ddevcap/jellyfin-proxy/ent/apppassword"
+	entsession "github.com/ddevcap/jellyfin-proxy/ent/session"
+	entuser "github.com/ddevcap/jellyfin-proxy/ent/user"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// ── App passwords ─────────────────────────────────────────────────────────────
+//
+// TV and mobile apps only send a username and password, so they cannot pass
+// the two-factor step. Users give each such device its own app password
+// instead, which AuthenticateByName accepts in place of the account password
+// without a code. Deleting one logs its device out.
+
+// newAppPassword returns a random app password, 80 bits in four groups of
+// lower-case base32 so it can be typed on a remote.
+func newAppPassword() (string, error) {
+	b := make([]byte, 10)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	s := strings.ToLower(base32.StdEncoding.EncodeToString(b))
+	return s[0:4] + "-" + s[4:8] + "-" + s[8:12] + "-" + s[12:16], nil
+}
+
+// appPasswordHash returns the stored hash of an app password as typed,
+// ignoring case, spaces and dashes.
+func appPasswordHash(pw string) string {
+	pw = strings.ToLower(strings.NewReplacer("-", "", " ", "").Replace(pw))
+	return middleware.HashToken(pw)
+}
+
+// matchAppPassword returns the app password of user that pw is, or nil, and
+// marks it used.
+func (h *AuthHandler) matchAppPassword(ctx context.Context, user *ent.User, pw string) (*ent.AppPassword, error) {
+	if pw == "" {
+		return nil, nil
+	}
+	ap, err := h.db.AppPassword.Query().
+		Where(
+			entapppassword.PasswordHash(appPasswordHash(pw)),
+			entapppassword.HasUserWith(entuser.ID(user.ID)),
+		).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := h.db.AppPassword.UpdateOne(ap).SetLastUsedAt(time.Now()).Exec(ctx); err != nil {
+		slog.Warn("failed to update app password last use", "id", ap.ID, "error", err)
+	}
+	return ap, nil
+}
+
+// appPasswordResponse is the outward representation of an app password.
+// Password is only set in the response that creates it.
+type appPasswordResponse struct {
+	ID         uuid.UUID  `json:"id"`
+	Name       string     `json:"name"`
+	Password   string     `json:"password,omitempty"`
+	LastUsedAt *time.Time `json:"last_used_at"`
+	CreatedAt  time.Time  `json:"created_at"`
+}
+
+func toAppPasswordResponse(ap *ent.AppPassword) appPasswordResponse {
+	return appPasswordResponse{
+		ID:         ap.ID,
+		Name:       ap.Name,
+		LastUsedAt: ap.LastUsedAt,
+		CreatedAt:  ap.CreatedAt,
+	}
+}
+
+// ListAppPasswords handles GET /proxy/me/app-passwords.
+func (h *AuthHandler) ListAppPasswords(c *gin.Context) {
+	passwords, err := h.db.AppPassword.Query().
+		Where(entapppassword.HasUserWith(entuser.ID(userFromCtx(c).ID))).
+		Order(entapppassword.ByCreatedAt()).
+		All(c.Request.Context())
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to list app passwords"})
+		return
+	}
+
+	resp := make([]appPasswordResponse, len(passwords))
+	for i, ap := range passwords {
+		resp[i] = toAppPasswordResponse(ap)
+	}
+	c.JSON(http.StatusOK, resp)
+}
+
+// CreateAppPassword handles POST /proxy/me/app-passwords.
+// The response is the only time the password itself is returned.
+func (h *AuthHandler) CreateAppPassword(c *gin.Context) {
+	var req struct {
+		Name string `json:"name" binding:"required"`
+	}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	user := userFromCtx(c)
+	pw, err := newAppPassword()
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to generate app password"})
+		return
+	}
+	ap, err := h.db.AppPassword.Create().
+		SetName(req.Name).
+		SetPasswordHash(appPasswordHash(pw)).
+		SetUser(user).
+		Save(c.Request.Context())
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to create app password"})
+		return
+	}
+	activity.Record(c.Request.Context(), h.db, activity.Entry{
+		Name:   "App password " + req.Name + " was created for user " + user.Username,
+		Type:   "AppPasswordCreated",
+		UserID: user.ID,
+	})
+
+	resp := toAppPasswordResponse(ap)
+	resp.Password = pw
+	c.JSON(http.StatusCreated, resp)
+}
+
+// DeleteAppPassword handles DELETE /proxy/me/app-passwords/:id.
+// The sessions logged in with the password are revoked with it. App
+// passwords of other users are reported as not found.
+func (h *AuthHandler) DeleteAppPassword(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid app password ID"})
+		return
+	}
+
+	ctx := c.Request.Context()
+	user := userFromCtx(c)
+	ap, err := h.db.AppPassword.Query().
+		Where(entapppassword.ID(id), entapppassword.HasUserWith(entuser.ID(user.ID))).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			c.JSON(http.StatusNotFound, gin.H{"error": "app password not found"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to get app password"})
+		return
+	}
+
+	sessions, err := h.db.Session.Query().
+		Where(entsession.HasAppPasswordWith(entapppassword.ID(ap.ID))).
+		All(ctx)
+	if err == nil {
+		_, err = revokeSessions(ctx, h.db, h.hub, sessions)
+	}
+	if err == nil {
+		err = h.db.AppPassword.DeleteOne(ap).Exec(ctx)
+	}
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to delete app password"})
+		return
+	}
+	activity.Record(ctx, h.db, activity.Entry{
+		Name:   "App password " + ap.Name + " was revoked for user " + user.Username,
+		Type:   "AppPasswordRevoked",
+		UserID: user.ID,
+	})
+	c.Status(http.StatusNoContent)
+}
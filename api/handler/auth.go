@@ -1,14 +1,20 @@
 package handler
 
 import (
+	"errors"
+	"log/slog"
 	"net/http"
 	"time"
 
+	"github.com/ddevcap/jellyfin-proxy/activity"
 	"github.com/ddevcap/jellyfin-proxy/api/middleware"
 	"github.com/ddevcap/jellyfin-proxy/config"
 	"github.com/ddevcap/jellyfin-proxy/ent"
 	entsession "github.com/ddevcap/jellyfin-proxy/ent/session"
 	entuser "github.com/ddevcap/jellyfin-proxy/ent/user"
+	"github.com/ddevcap/jellyfin-proxy/ldapauth"
+	"github.com/ddevcap/jellyfin-proxy/notify"
+	"github.com/ddevcap/jellyfin-proxy/tokencrypt"
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
 	"golang.org/x/crypto/bcrypt"
@@ -22,6 +28,12 @@ type AuthHandler struct {
 	cfg            config.Config
 	onLoginFail    func(string)
 	onLoginSuccess func(string)
+	quickConnect   *quickConnectStore
+	passwordReset  *passwordResetStore
+	resetNotifiers []notify.Notifier       // how password reset PINs are sent
+	ldap           *ldapauth.Authenticator // nil when LDAP is disabled
+	hub            *WSHub                  // nil until SetWSHub
+	secrets        *tokencrypt.Keyring     // decrypts TOTP secrets; nil when stored in plaintext
 }
 
 func NewAuthHandler(db *ent.Client, cfg config.Config, onFail, onSuccess func(string)) *AuthHandler {
@@ -30,17 +42,35 @@ func NewAuthHandler(db *ent.Client, cfg config.Config, onFail, onSuccess func(st
 		cfg:            cfg,
 		onLoginFail:    onFail,
 		onLoginSuccess: onSuccess,
+		quickConnect:   newQuickConnectStore(),
+		passwordReset:  newPasswordResetStore(cfg.PasswordResetInterval),
+		resetNotifiers: newPasswordResetNotifiers(cfg),
+		ldap:           newLDAPAuthenticator(cfg),
 	}
 }
 
+// SetWSHub lets the handler close the WebSockets of sessions it revokes.
+func (h *AuthHandler) SetWSHub(hub *WSHub) {
+	h.hub = hub
+}
+
+// SetTokenKeyring makes the handler decrypt TOTP secrets with kr.
+func (h *AuthHandler) SetTokenKeyring(kr *tokencrypt.Keyring) {
+	h.secrets = kr
+}
+
 type authenticateRequest struct {
 	Username string `json:"Username" binding:"required"`
 	Pw       string `json:"Pw"`
+	// TotpCode is the second step for users with two-factor authentication:
+	// a code from their authenticator app or a recovery code.
+	TotpCode string `json:"TotpCode"`
 }
 
 // AuthenticateByName handles POST /Users/AuthenticateByName.
 // It validates credentials, creates a session token, and returns the
-// Jellyfin-compatible auth response that clients expect.
+// Jellyfin-compatible auth response that clients expect. Pw may also be one
+// of the user's app passwords, which skips two-factor authentication.
 func (h *AuthHandler) AuthenticateByName(c *gin.Context) {
 	var req authenticateRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
@@ -53,66 +83,191 @@ func (h *AuthHandler) AuthenticateByName(c *gin.Context) {
 	user, err := h.db.User.Query().
 		Where(entuser.Username(req.Username)).
 		Only(c.Request.Context())
-	if err != nil {
-		h.onLoginFail(ip)
+	if err != nil && !ent.IsNotFound(err) {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to get user"})
+		return
+	}
+	if user != nil {
+		ap, err := h.matchAppPassword(c.Request.Context(), user, req.Pw)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to check app passwords"})
+			return
+		}
+		if ap != nil {
+			if !h.lockedOut(c, user, ip) {
+				h.completeLogin(c, user, ip, ap)
+			}
+			return
+		}
+	}
+
+	// Unknown users are looked up in the directory, where they may log in
+	// for the first time; local accounts keep their own password.
+	if h.ldap != nil && (user == nil || user.AuthSource == authSourceLDAP) {
+		known := user
+		user, err = h.ldapLogin(c, req.Username, req.Pw, known)
+		if errors.Is(err, ldapauth.ErrInvalidCredentials) {
+			userID := uuid.Nil
+			if known != nil {
+				userID = known.ID
+			}
+			h.loginFailed(c, req.Username, userID, ip)
+			c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid username or password"})
+			return
+		}
+		if err != nil {
+			slog.Warn("ldap login failed", "username", req.Username, "error", err)
+			c.JSON(http.StatusBadGateway, gin.H{"error": "LDAP server unavailable"})
+			return
+		}
+		if h.lockedOut(c, user, ip) {
+			return
+		}
+		if h.secondFactor(c, user, req.TotpCode, ip) {
+			h.completeLogin(c, user, ip, nil)
+		}
+		return
+	}
+
+	if user == nil {
+		h.loginFailed(c, req.Username, uuid.Nil, ip)
 		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid username or password"})
 		return
 	}
 
 	if err := bcrypt.CompareHashAndPassword([]byte(user.HashedPassword), []byte(req.Pw)); err != nil {
-		h.onLoginFail(ip)
+		h.loginFailed(c, req.Username, user.ID, ip)
 		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid username or password"})
 		return
 	}
+	if h.lockedOut(c, user, ip) {
+		return
+	}
 
-	h.onLoginSuccess(ip)
+	if h.secondFactor(c, user, req.TotpCode, ip) {
+		h.completeLogin(c, user, ip, nil)
+	}
+}
 
-	// Extract Jellyfin client identity from the Authorization header.
-	authParams := middleware.ParseMediaBrowserAuth(c.GetHeader("Authorization"))
-	deviceID := fallback(authParams["DeviceId"], "unknown")
-	deviceName := fallback(authParams["Device"], "Unknown Device")
-	appName := fallback(authParams["Client"], "Unknown")
-	appVersion := authParams["Version"]
+// completeLogin finishes a successful login, by password, app password or
+// QuickConnect: it records the login, creates a session for the calling
+// device and writes the Jellyfin-compatible auth response. appPassword is the
+// app password used, if any, which the session is tied to. Users whose
+// policy refuses the client (disabled, or remote without remote access) get
+// 403 instead.
+func (h *AuthHandler) completeLogin(c *gin.Context, user *ent.User, ip string, appPassword *ent.AppPassword) {
+	if err := middleware.CheckUserPolicy(c, user); err != nil {
+		c.JSON(http.StatusForbidden, gin.H{"error": err.Error()})
+		return
+	}
+	if err := resetFailedLogins(c.Request.Context(), h.db, user); err != nil {
+		slog.Warn("failed to reset failed logins", "user", user.Username, "error", err)
+	}
+	h.onLoginSuccess(ip)
+	recordLogin(c, h.db, user, ip)
 
-	token := uuid.New().String()
-	_, err = h.db.Session.Create().
-		SetToken(token).
-		SetDeviceID(deviceID).
-		SetDeviceName(deviceName).
-		SetAppName(appName).
-		SetNillableAppVersion(nilIfEmpty(appVersion)).
-		SetUser(user).
-		Save(c.Request.Context())
+	client := clientFromAuth(c)
+	if appPassword != nil {
+		client.AppPasswordID = &appPassword.ID
+	}
+	token, err := createSession(c, h.db, user, client)
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to create session"})
 		return
 	}
 
+	configuration, err := loadUserConfiguration(c.Request.Context(), h.db, user.ID)
+	if err != nil {
+		slog.Warn("failed to load user configuration", "user", user.Username, "error", err)
+	}
 	now := time.Now().UTC()
+	userObj := buildUserObject(user, h.cfg, configuration)
+	userObj["LastLoginDate"] = now
+	userObj["LastActivityDate"] = now
 	c.JSON(http.StatusOK, gin.H{
-		"User": gin.H{
-			"Name":                      user.Username,
-			"ServerId":                  h.cfg.ServerID,
-			"ServerName":                h.cfg.ServerName,
-			"Id":                        user.ID,
-			"HasPassword":               true,
-			"HasConfiguredPassword":     true,
-			"HasConfiguredEasyPassword": false,
-			"EnableAutoLogin":           false,
-			"LastLoginDate":             now,
-			"LastActivityDate":          now,
-			"Policy":                    buildUserPolicy(user.IsAdmin, h.cfg),
-		},
+		"User": userObj,
 		"SessionInfo": gin.H{
-			"DeviceId":   deviceID,
-			"DeviceName": deviceName,
-			"Client":     appName,
+			"DeviceId":   client.DeviceID,
+			"DeviceName": client.DeviceName,
+			"Client":     client.AppName,
 		},
 		"AccessToken": token,
 		"ServerId":    h.cfg.ServerID,
 	})
 }
 
+// sessionClient identifies the device and app a session is created for.
+type sessionClient struct {
+	DeviceID   string
+	DeviceName string
+	AppName    string
+	AppVersion string
+	// AppPasswordID is the app password the session logs in with, if any.
+	AppPasswordID *uuid.UUID
+}
+
+// clientFromAuth reads the Jellyfin client identity from the Authorization
+// header.
+func clientFromAuth(c *gin.Context) sessionClient {
+	authParams := middleware.ParseMediaBrowserAuth(c.GetHeader("Authorization"))
+	return sessionClient{
+		DeviceID:   fallback(authParams["DeviceId"], "unknown"),
+		DeviceName: fallback(authParams["Device"], "Unknown Device"),
+		AppName:    fallback(authParams["Client"], "Unknown"),
+		AppVersion: authParams["Version"],
+	}
+}
+
+// createSession creates a session for user on the given client, records it
+// in the activity log and returns its token.
+func createSession(c *gin.Context, db *ent.Client, user *ent.User, client sessionClient) (string, error) {
+	token := uuid.New().String()
+	_, err := db.Session.Create().
+		SetToken(middleware.HashToken(token)).
+		SetDeviceID(client.DeviceID).
+		SetDeviceName(client.DeviceName).
+		SetAppName(client.AppName).
+		SetNillableAppVersion(nilIfEmpty(client.AppVersion)).
+		SetNillableAppPasswordID(client.AppPasswordID).
+		SetUser(user).
+		Save(c.Request.Context())
+	if err != nil {
+		return "", err
+	}
+	activity.Record(c.Request.Context(), db, activity.Entry{
+		Name:          user.Username + " is online from " + client.DeviceName,
+		Type:          "SessionStarted",
+		ShortOverview: client.AppName,
+		UserID:        user.ID,
+	})
+	return token, nil
+}
+
+// recordLogin records a successful login in the activity log.
+func recordLogin(c *gin.Context, db *ent.Client, user *ent.User, ip string) {
+	activity.Record(c.Request.Context(), db, activity.Entry{
+		Name:          user.Username + " successfully authenticated",
+		Type:          "AuthenticationSucceeded",
+		ShortOverview: "IP address: " + ip,
+		UserID:        user.ID,
+	})
+}
+
+// loginFailed counts a failed login towards the rate limit and the account's
+// lockout, and records it. userID is uuid.Nil when the username does not
+// exist.
+func (h *AuthHandler) loginFailed(c *gin.Context, username string, userID uuid.UUID, ip string) {
+	h.onLoginFail(ip)
+	activity.Record(c.Request.Context(), h.db, activity.Entry{
+		Name:          "Failed login try from " + username,
+		Type:          "AuthenticationFailed",
+		ShortOverview: "IP address: " + ip,
+		UserID:        userID,
+		Severity:      activity.Error,
+	})
+	h.countFailedLogin(c, userID, ip)
+}
+
 // UpdatePassword handles POST /Users/:userId/Password.
 // A user may change their own password (CurrentPw required).
 // An admin may reset any user's password without providing CurrentPw.
@@ -155,6 +310,10 @@ func (h *AuthHandler) UpdatePassword(c *gin.Context) {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to get user"})
 		return
 	}
+	if target.AuthSource == authSourceLDAP {
+		c.JSON(http.StatusForbidden, gin.H{"error": "Password is managed by the LDAP directory"})
+		return
+	}
 
 	// Non-admins must verify their current password.
 	if !caller.IsAdmin {
@@ -183,29 +342,30 @@ func (h *AuthHandler) UpdatePassword(c *gin.Context) {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to update password"})
 		return
 	}
+	activity.Record(c.Request.Context(), h.db, activity.Entry{
+		Name:   "Password was changed for user " + target.Username,
+		Type:   "UserPasswordChanged",
+		UserID: target.ID,
+	})
 
-	// Invalidate all sessions for the target user except the caller's current
+	// Revoke all sessions for the target user except the caller's current
 	// session, so a compromised token cannot survive a password change.
-	currentSession, _ := c.Get(middleware.ContextKeySession)
-	if cs, ok := currentSession.(*ent.Session); ok {
-		_, _ = h.db.Session.Delete().
-			Where(
-				entsession.HasUserWith(entuser.ID(targetID)),
-				entsession.IDNEQ(cs.ID),
-			).
-			Exec(c.Request.Context())
-	} else {
-		// No current session (shouldn't happen) — delete all sessions for the user.
-		_, _ = h.db.Session.Delete().
-			Where(entsession.HasUserWith(entuser.ID(targetID))).
-			Exec(c.Request.Context())
+	// Callers without a session (API keys) revoke all of them.
+	others := h.db.Session.Query().Where(entsession.HasUserWith(entuser.ID(targetID)))
+	if cs := sessionFromCtx(c); cs != nil {
+		others.Where(entsession.IDNEQ(cs.ID))
+	}
+	if sessions, err := others.All(c.Request.Context()); err == nil {
+		if _, err := revokeSessions(c.Request.Context(), h.db, h.hub, sessions); err != nil {
+			slog.Warn("failed to revoke sessions after password change", "user", target.Username, "error", err)
+		}
 	}
 
 	c.Status(http.StatusNoContent)
 }
 
 // Logout handles DELETE /Sessions/Logout and POST /Sessions/Logout.
-// It deletes the token so subsequent requests with it are rejected.
+// It revokes the session so subsequent requests with its token are rejected.
 func (h *AuthHandler) Logout(c *gin.Context) {
 	raw, exists := c.Get(middleware.ContextKeySession)
 	if !exists {
@@ -213,6 +373,16 @@ func (h *AuthHandler) Logout(c *gin.Context) {
 		return
 	}
 	session := raw.(*ent.Session)
-	_ = h.db.Session.DeleteOne(session).Exec(c.Request.Context())
+	if _, err := revokeSessions(c.Request.Context(), h.db, h.hub, []*ent.Session{session}); err != nil {
+		slog.Warn("failed to delete session on logout", "session", session.ID, "error", err)
+	}
+	if user := userFromCtx(c); user != nil {
+		activity.Record(c.Request.Context(), h.db, activity.Entry{
+			Name:          user.Username + " has disconnected from " + session.DeviceName,
+			Type:          "SessionEnded",
+			ShortOverview: session.AppName,
+			UserID:        user.ID,
+		})
+	}
 	c.Status(http.StatusNoContent)
 }
@@ -1,8 +1,12 @@
 package handler_test
 
 import (
+	"context"
 	"encoding/json"
 	"net/http"
+	"net/http/httptest"
+	"strings"
+	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -13,6 +17,7 @@ import (
 	"github.com/ddevcap/jellyfin-proxy/api/middleware"
 	"github.com/ddevcap/jellyfin-proxy/config"
 	"github.com/ddevcap/jellyfin-proxy/ent"
+	entactivitylog "github.com/ddevcap/jellyfin-proxy/ent/activitylog"
 )
 
 var _ = Describe("AuthHandler", func() {
@@ -55,6 +60,62 @@ var _ = Describe("AuthHandler", func() {
 				Expect(resp["AccessToken"]).NotTo(BeEmpty())
 				Expect(resp["ServerId"]).To(Equal("test-server-id"))
 			})
+
+			It("returns the user's stored configuration", func() {
+				alice := createUser("alice", "correctpass1", false)
+				db.UserConfiguration.Create().SetUserID(alice.ID).
+					SetData(json.RawMessage(`{"SubtitleMode":"Always"}`)).ExecX(context.Background())
+
+				w := doPost(router, "/Users/AuthenticateByName", map[string]string{
+					"Username": "alice",
+					"Pw":       "correctpass1",
+				})
+
+				Expect(w.Code).To(Equal(http.StatusOK))
+				var resp struct {
+					User struct {
+						Configuration map[string]interface{}
+						LastLoginDate string
+					}
+				}
+				Expect(json.Unmarshal(w.Body.Bytes(), &resp)).To(Succeed())
+				Expect(resp.User.Configuration).To(Equal(map[string]interface{}{"SubtitleMode": "Always"}))
+				Expect(resp.User.LastLoginDate).NotTo(BeEmpty())
+			})
+
+			It("records the login and the new session in the activity log", func() {
+				alice := createUser("alice", "correctpass1", false)
+
+				doPost(router, "/Users/AuthenticateByName", map[string]string{
+					"Username": "alice",
+					"Pw":       "correctpass1",
+				})
+
+				entries := db.ActivityLog.Query().AllX(context.Background())
+				Expect(entries).To(HaveLen(2))
+				types := []string{entries[0].EventType, entries[1].EventType}
+				Expect(types).To(ConsistOf("AuthenticationSucceeded", "SessionStarted"))
+				Expect(entries[0].UserID).To(Equal(alice.ID))
+			})
+
+			It("stores only a hash of the token, which authenticates", func() {
+				createUser("alice", "correctpass1", false)
+
+				w := doPost(router, "/Users/AuthenticateByName", map[string]string{
+					"Username": "alice",
+					"Pw":       "correctpass1",
+				})
+				var resp struct{ AccessToken string }
+				Expect(json.Unmarshal(w.Body.Bytes(), &resp)).To(Succeed())
+
+				session := db.Session.Query().OnlyX(context.Background())
+				Expect(session.Token).NotTo(Equal(resp.AccessToken))
+				Expect(session.Token).To(Equal(middleware.HashToken(resp.AccessToken)))
+
+				w = doDelete(router, "/Sessions/Logout", map[string]string{"X-Emby-Token": resp.AccessToken})
+				Expect(w.Code).To(Equal(http.StatusNoContent))
+				Expect(db.Session.Query().CountX(context.Background())).To(BeZero())
+			})
 		})
 
 		Context("with wrong password", func() {
@@ -68,6 +129,20 @@ var _ = Describe("AuthHandler", func() {
 
 				Expect(w.Code).To(Equal(http.StatusUnauthorized))
 			})
+
+			It("records the failed attempt as an error", func() {
+				alice := createUser("alice", "correctpass1", false)
+
+				doPost(router, "/Users/AuthenticateByName", map[string]string{
+					"Username": "alice",
+					"Pw":       "wrongpass",
+				})
+
+				entry := db.ActivityLog.Query().OnlyX(context.Background())
+				Expect(entry.EventType).To(Equal("AuthenticationFailed"))
+				Expect(entry.Severity).To(Equal("Error"))
+				Expect(entry.UserID).To(Equal(alice.ID))
+			})
 		})
 
 		Context("with an unknown username", func() {
@@ -81,6 +156,46 @@ var _ = Describe("AuthHandler", func() {
 			})
 		})
 
+		Context("when the account is disabled", func() {
+			It("returns 403 without creating a session", func() {
+				alice := createUser("alice", "correctpass1", false)
+				db.User.UpdateOne(alice).SetIsDisabled(true).ExecX(context.Background())
+
+				w := doPost(router, "/Users/AuthenticateByName", map[string]string{
+					"Username": "alice",
+					"Pw":       "correctpass1",
+				})
+
+				Expect(w.Code).To(Equal(http.StatusForbidden))
+				Expect(db.Session.Query().CountX(context.Background())).To(BeZero())
+			})
+		})
+
+		Context("when remote access is disabled", func() {
+			login := func(remoteAddr string) int {
+				req := httptest.NewRequest(http.MethodPost, "/Users/AuthenticateByName",
+					strings.NewReader(`{"Username":"alice","Pw":"correctpass1"}`))
+				req.Header.Set("Content-Type", "application/json")
+				req.RemoteAddr = remoteAddr
+				w := httptest.NewRecorder()
+				router.ServeHTTP(w, req)
+				return w.Code
+			}
+
+			BeforeEach(func() {
+				alice := createUser("alice", "correctpass1", false)
+				db.User.UpdateOne(alice).SetEnableRemoteAccess(false).ExecX(context.Background())
+			})
+
+			It("refuses clients outside the local network", func() {
+				Expect(login("203.0.113.7:50000")).To(Equal(http.StatusForbidden))
+			})
+
+			It("lets local clients in", func() {
+				Expect(login("192.168.1.20:50000")).To(Equal(http.StatusOK))
+			})
+		})
+
 		Context("when the Username field is missing", func() {
 			It("returns 400", func() {
 				w := doPost(router, "/Users/AuthenticateByName", map[string]string{
@@ -185,6 +300,85 @@ var _ = Describe("AuthHandler", func() {
 		})
 	})
 
+	// ── Account lockout ───────────────────────────────────────────────────────
+
+	Describe("Account lockout", func() {
+		var alice *ent.User
+
+		BeforeEach(func() {
+			cfg := testCfg
+			cfg.AccountLockoutAttempts = 3
+			cfg.AccountLockoutDuration = time.Hour
+			router = gin.New()
+			h := handler.NewAuthHandler(db, cfg, func(string) {}, func(string) {})
+			router.POST("/Users/AuthenticateByName", h.AuthenticateByName)
+			alice = createUser("alice", "correctpass1", false)
+		})
+
+		login := func(pw string) int {
+			return doPost(router, "/Users/AuthenticateByName", map[string]string{
+				"Username": "alice",
+				"Pw":       pw,
+			}).Code
+		}
+
+		It("locks the account after too many failed logins", func() {
+			for range 3 {
+				Expect(login("wrongpass")).To(Equal(http.StatusUnauthorized))
+			}
+
+			// A locked account answers exactly like a wrong password.
+			w := doPost(router, "/Users/AuthenticateByName", map[string]string{"Username": "alice", "Pw": "correctpass1"})
+			Expect(w.Code).To(Equal(http.StatusUnauthorized))
+			Expect(w.Body.String()).To(MatchJSON(`{"error":"Invalid username or password"}`))
+			locked := db.User.GetX(context.Background(), alice.ID)
+			Expect(locked.LockedUntil).NotTo(BeNil())
+			Expect(*locked.LockedUntil).To(BeTemporally("~", time.Now().Add(time.Hour), time.Minute))
+			Expect(db.ActivityLog.Query().Where(entactivitylog.EventType("UserLockedOut")).ExistX(context.Background())).To(BeTrue())
+		})
+
+		It("locks administrators like everyone else", func() {
+			db.User.UpdateOne(alice).SetIsAdmin(true).ExecX(context.Background())
+			for range 3 {
+				Expect(login("wrongpass")).To(Equal(http.StatusUnauthorized))
+			}
+
+			Expect(db.User.GetX(context.Background(), alice.ID).LockedUntil).NotTo(BeNil())
+			Expect(login("correctpass1")).To(Equal(http.StatusUnauthorized))
+		})
+
+		It("unlocks by itself once the lockout expires", func() {
+			db.User.UpdateOne(alice).SetLockedUntil(time.Now().Add(-time.Minute)).ExecX(context.Background())
+
+			Expect(login("correctpass1")).To(Equal(http.StatusOK))
+			Expect(db.User.GetX(context.Background(), alice.ID).LockedUntil).To(BeNil())
+		})
+
+		It("resets the count on a successful login", func() {
+			Expect(login("wrongpass")).To(Equal(http.StatusUnauthorized))
+			Expect(login("wrongpass")).To(Equal(http.StatusUnauthorized))
+			Expect(db.User.GetX(context.Background(), alice.ID).FailedLoginCount).To(Equal(2))
+
+			Expect(login("correctpass1")).To(Equal(http.StatusOK))
+			Expect(db.User.GetX(context.Background(), alice.ID).FailedLoginCount).To(BeZero())
+			Expect(login("wrongpass")).To(Equal(http.StatusUnauthorized))
+			Expect(login("correctpass1")).To(Equal(http.StatusOK))
+		})
+	})
+
+	// ── Disabled accounts ─────────────────────────────────────────────────────
+
+	Describe("Disabled accounts", func() {
+		It("rejects the existing sessions of a disabled user", func() {
+			user := createUser("dora", "password123", false)
+			createSession(user, "dora-token")
+			db.User.UpdateOne(user).SetIsDisabled(true).ExecX(context.Background())
+
+			w := doDelete(router, "/Sessions/Logout", map[string]string{"X-Emby-Token": "dora-token"})
+			Expect(w.Code).To(Equal(http.StatusUnauthorized))
+		})
+	})
+
 	// ── Logout ────────────────────────────────────────────────────────────────
 
 	Describe("Logout", func() {
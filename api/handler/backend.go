@@ -8,9 +8,11 @@ import (
 	"strings"
 	"time"
 
+	"github.com/ddevcap/jellyfin-proxy/activity"
 	"github.com/ddevcap/jellyfin-proxy/ent"
 	entbackend "github.com/ddevcap/jellyfin-proxy/ent/backend"
 	entbackenduser "github.com/ddevcap/jellyfin-proxy/ent/backenduser"
+	entitem "github.com/ddevcap/jellyfin-proxy/ent/item"
 	entuser "github.com/ddevcap/jellyfin-proxy/ent/user"
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
@@ -151,6 +153,10 @@ func (h *BackendHandler) CreateBackend(c *gin.Context) {
 		return
 	}
 
+	recordAdminActivity(c, h.db, activity.Entry{
+		Name: fmt.Sprintf("Backend %s has been added", b.Name),
+		Type: "BackendCreated",
+	})
 	c.JSON(http.StatusCreated, toBackendResponse(b))
 }
 
@@ -254,11 +260,16 @@ func (h *BackendHandler) UpdateBackend(c *gin.Context) {
 		return
 	}
 
+	recordAdminActivity(c, h.db, activity.Entry{
+		Name: fmt.Sprintf("Backend %s has been updated", b.Name),
+		Type: "BackendUpdated",
+	})
 	c.JSON(http.StatusOK, toBackendResponse(b))
 }
 
 // DeleteBackend handles DELETE /proxy/backends/:id.
-// Cascade-deletes all backend-user mappings first to avoid FK constraint errors.
+// Cascade-deletes all backend-user mappings and indexed items first to avoid
+// FK constraint errors.
 func (h *BackendHandler) DeleteBackend(c *gin.Context) {
 	id, err := uuid.Parse(c.Param("id"))
 	if err != nil {
@@ -268,10 +279,23 @@ func (h *BackendHandler) DeleteBackend(c *gin.Context) {
 
 	ctx := c.Request.Context()
 
+	b, err := h.db.Backend.Get(ctx, id)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			c.JSON(http.StatusNotFound, gin.H{"error": "backend not found"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to get backend"})
+		return
+	}
+
 	// Delete all user mappings for this backend first.
 	_, _ = h.db.BackendUser.Delete().
 		Where(entbackenduser.HasBackendWith(entbackend.ID(id))).
 		Exec(ctx)
+	_, _ = h.db.Item.Delete().
+		Where(entitem.HasBackendWith(entbackend.ID(id))).
+		Exec(ctx)
 
 	if err := h.db.Backend.DeleteOneID(id).Exec(ctx); err != nil {
 		if ent.IsNotFound(err) {
@@ -282,6 +306,10 @@ func (h *BackendHandler) DeleteBackend(c *gin.Context) {
 		return
 	}
 
+	recordAdminActivity(c, h.db, activity.Entry{
+		Name: fmt.Sprintf("Backend %s has been deleted", b.Name),
+		Type: "BackendDeleted",
+	})
 	c.Status(http.StatusNoContent)
 }
 
@@ -344,6 +372,7 @@ func (h *BackendHandler) CreateBackendUser(c *gin.Context) {
 		return
 	}
 
+	h.recordMappingActivity(c, "BackendUserCreated", "has been mapped to", bu, backendID)
 	c.JSON(http.StatusCreated, toBackendUserResponse(bu, backendID))
 }
 
@@ -444,6 +473,7 @@ func (h *BackendHandler) UpdateBackendUser(c *gin.Context) {
 		return
 	}
 
+	h.recordMappingActivity(c, "BackendUserUpdated", "has an updated mapping on", bu, backendID)
 	c.JSON(http.StatusOK, toBackendUserResponse(bu, backendID))
 }
 
@@ -563,6 +593,11 @@ func (h *BackendHandler) LoginToBackend(c *gin.Context) {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to reload backend user"})
 		return
 	}
+	if status == http.StatusCreated {
+		h.recordMappingActivity(c, "BackendUserCreated", "has been mapped to", bu, backendID)
+	} else {
+		h.recordMappingActivity(c, "BackendUserUpdated", "has an updated mapping on", bu, backendID)
+	}
 	c.JSON(status, toBackendUserResponse(bu, backendID))
 }
 
@@ -574,7 +609,20 @@ func (h *BackendHandler) DeleteBackendUser(c *gin.Context) {
 		return
 	}
 
-	if err := h.db.BackendUser.DeleteOneID(mappingID).Exec(c.Request.Context()); err != nil {
+	bu, err := h.db.BackendUser.Query().
+		Where(entbackenduser.ID(mappingID)).
+		WithUser().
+		Only(c.Request.Context())
+	if err != nil {
+		if ent.IsNotFound(err) {
+			c.JSON(http.StatusNotFound, gin.H{"error": "mapping not found"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to get backend user"})
+		return
+	}
+
+	if err := h.db.BackendUser.DeleteOne(bu).Exec(c.Request.Context()); err != nil {
 		if ent.IsNotFound(err) {
 			c.JSON(http.StatusNotFound, gin.H{"error": "mapping not found"})
 			return
@@ -583,5 +631,24 @@ func (h *BackendHandler) DeleteBackendUser(c *gin.Context) {
 		return
 	}
 
+	backendID, _ := uuid.Parse(c.Param("id"))
+	h.recordMappingActivity(c, "BackendUserDeleted", "is no longer mapped to", bu, backendID)
 	c.Status(http.StatusNoContent)
 }
+
+// recordMappingActivity records a change to a user's backend mapping, e.g.
+// "alice has been mapped to backend NAS". bu must have its user edge loaded.
+func (h *BackendHandler) recordMappingActivity(c *gin.Context, eventType, verb string, bu *ent.BackendUser, backendID uuid.UUID) {
+	if bu.Edges.User == nil {
+		return
+	}
+	backendName := backendID.String()
+	if b, err := h.db.Backend.Get(c.Request.Context(), backendID); err == nil {
+		backendName = b.Name
+	}
+	recordAdminActivity(c, h.db, activity.Entry{
+		Name:   fmt.Sprintf("%s %s backend %s", bu.Edges.User.Username, verb, backendName),
+		Type:   eventType,
+		UserID: bu.Edges.User.ID,
+	})
+}
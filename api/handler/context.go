@@ -1,6 +1,7 @@
 package handler
 
 import (
+	"github.com/ddevcap/jellyfin-proxy/activity"
 	"github.com/ddevcap/jellyfin-proxy/api/middleware"
 	"github.com/ddevcap/jellyfin-proxy/ent"
 	"github.com/gin-gonic/gin"
@@ -13,6 +14,30 @@ func userFromCtx(c *gin.Context) *ent.User {
 	return user
 }
 
+// sessionFromCtx extracts the caller's session from the gin context.
+func sessionFromCtx(c *gin.Context) *ent.Session {
+	s, _ := c.Get(middleware.ContextKeySession)
+	session, _ := s.(*ent.Session)
+	return session
+}
+
+// apiKeyFromCtx extracts the API key the caller authenticated with, or nil
+// when they hold a session.
+func apiKeyFromCtx(c *gin.Context) *ent.ApiKey {
+	k, _ := c.Get(middleware.ContextKeyAPIKey)
+	key, _ := k.(*ent.ApiKey)
+	return key
+}
+
+// recordAdminActivity records an admin API change, naming the admin who
+// made it.
+func recordAdminActivity(c *gin.Context, db *ent.Client, e activity.Entry) {
+	if caller := userFromCtx(c); caller != nil {
+		e.ShortOverview = "By " + caller.Username
+	}
+	activity.Record(c.Request.Context(), db, e)
+}
+
 func fallback(s, def string) string {
 	if s != "" {
 		return s
@@ -0,0 +1,263 @@
+package handler
+
+import (
+	"context"
+	"encoding/binary"
+	"net/http"
+
+	"github.com/ddevcap/jellyfin-proxy/activity"
+	"github.com/ddevcap/jellyfin-proxy/ent"
+	entdeviceoption "github.com/ddevcap/jellyfin-proxy/ent/deviceoption"
+	"github.com/ddevcap/jellyfin-proxy/ent/predicate"
+	entsession "github.com/ddevcap/jellyfin-proxy/ent/session"
+	entuser "github.com/ddevcap/jellyfin-proxy/ent/user"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// DeviceHandler serves Jellyfin's /Devices API. A device is every client
+// device ID with a proxy session, described by its most recently used
+// session; only the custom names admins give devices are stored separately.
+type DeviceHandler struct {
+	db  *ent.Client
+	hub *WSHub
+}
+
+func NewDeviceHandler(db *ent.Client, hub *WSHub) *DeviceHandler {
+	return &DeviceHandler{db: db, hub: hub}
+}
+
+// latestSessions returns the most recently used session of each device
+// matching where, most recent first.
+func (h *DeviceHandler) latestSessions(ctx context.Context, where ...predicate.Session) ([]*ent.Session, error) {
+	sessions, err := h.db.Session.Query().
+		Where(where...).
+		WithUser().
+		Order(ent.Desc(entsession.FieldLastActivity)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	latest := make([]*ent.Session, 0, len(sessions))
+	seen := make(map[string]bool, len(sessions))
+	for _, s := range sessions {
+		if !seen[s.DeviceID] {
+			seen[s.DeviceID] = true
+			latest = append(latest, s)
+		}
+	}
+	return latest, nil
+}
+
+// customNames returns the custom names of the given devices by device ID.
+func (h *DeviceHandler) customNames(ctx context.Context, deviceIDs ...string) (map[string]string, error) {
+	opts, err := h.db.DeviceOption.Query().
+		Where(entdeviceoption.DeviceIDIn(deviceIDs...)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	names := make(map[string]string, len(opts))
+	for _, o := range opts {
+		if o.CustomName != "" {
+			names[o.DeviceID] = o.CustomName
+		}
+	}
+	return names, nil
+}
+
+// deviceInfo builds the Jellyfin DeviceInfo object for a device from its
+// latest session. Session tokens are stored hashed, so AccessToken is not
+// available.
+func deviceInfo(s *ent.Session, customName string) gin.H {
+	u := s.Edges.User
+	return gin.H{
+		"Id":               s.DeviceID,
+		"Name":             fallback(customName, s.DeviceName),
+		"CustomName":       nilIfEmpty(customName),
+		"AccessToken":      nil,
+		"LastUserId":       u.ID,
+		"LastUserName":     u.Username,
+		"AppName":          s.AppName,
+		"AppVersion":       s.AppVersion,
+		"DateLastActivity": s.LastActivity.UTC(),
+		"Capabilities": gin.H{
+			"PlayableMediaTypes":           []string{},
+			"SupportedCommands":            []string{},
+			"SupportsMediaControl":         false,
+			"SupportsPersistentIdentifier": true,
+		},
+		"IconUrl": nil,
+	}
+}
+
+// GetDevices handles GET /Devices.
+// UserId limits the list to devices the user has signed in on.
+func (h *DeviceHandler) GetDevices(c *gin.Context) {
+	var where []predicate.Session
+	if s := queryParam(c, "userId"); s != "" {
+		userID, err := uuid.Parse(s)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid user ID"})
+			return
+		}
+		where = append(where, entsession.HasUserWith(entuser.ID(userID)))
+	}
+	sessions, err := h.latestSessions(c.Request.Context(), where...)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to list devices"})
+		return
+	}
+	deviceIDs := make([]string, len(sessions))
+	for i, s := range sessions {
+		deviceIDs[i] = s.DeviceID
+	}
+	names, err := h.customNames(c.Request.Context(), deviceIDs...)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to list devices"})
+		return
+	}
+
+	items := make([]gin.H, len(sessions))
+	for i, s := range sessions {
+		items[i] = deviceInfo(s, names[s.DeviceID])
+	}
+	c.JSON(http.StatusOK, gin.H{
+		"Items":            items,
+		"TotalRecordCount": len(items),
+		"StartIndex":       0,
+	})
+}
+
+// deviceIDParam returns the id query param. ok is false after a 400 was
+// written for a missing one.
+func deviceIDParam(c *gin.Context) (id string, ok bool) {
+	id = queryParam(c, "id")
+	if id == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "id is required"})
+		return "", false
+	}
+	return id, true
+}
+
+// GetDeviceInfo handles GET /Devices/Info?id=.
+func (h *DeviceHandler) GetDeviceInfo(c *gin.Context) {
+	deviceID, ok := deviceIDParam(c)
+	if !ok {
+		return
+	}
+	sessions, err := h.latestSessions(c.Request.Context(), entsession.DeviceID(deviceID))
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to get device"})
+		return
+	}
+	if len(sessions) == 0 {
+		c.JSON(http.StatusNotFound, gin.H{"error": "device not found"})
+		return
+	}
+	names, err := h.customNames(c.Request.Context(), deviceID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to get device"})
+		return
+	}
+	c.JSON(http.StatusOK, deviceInfo(sessions[0], names[deviceID]))
+}
+
+// GetDeviceOptions handles GET /Devices/Options?id=.
+func (h *DeviceHandler) GetDeviceOptions(c *gin.Context) {
+	deviceID, ok := deviceIDParam(c)
+	if !ok {
+		return
+	}
+	o, err := h.db.DeviceOption.Query().
+		Where(entdeviceoption.DeviceID(deviceID)).
+		Only(c.Request.Context())
+	if ent.IsNotFound(err) {
+		// Devices without options get the defaults, as long as they exist.
+		exists, err := h.db.Session.Query().Where(entsession.DeviceID(deviceID)).Exist(c.Request.Context())
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to get device options"})
+			return
+		}
+		if !exists {
+			c.JSON(http.StatusNotFound, gin.H{"error": "device not found"})
+			return
+		}
+		c.JSON(http.StatusOK, gin.H{"Id": 0, "DeviceId": deviceID, "CustomName": nil})
+		return
+	}
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to get device options"})
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{
+		"Id":         int64(binary.BigEndian.Uint64(o.ID[:8]) >> 11),
+		"DeviceId":   o.DeviceID,
+		"CustomName": nilIfEmpty(o.CustomName),
+	})
+}
+
+// UpdateDeviceOptions handles POST /Devices/Options?id=.
+// An empty CustomName goes back to the name the client reports.
+func (h *DeviceHandler) UpdateDeviceOptions(c *gin.Context) {
+	deviceID, ok := deviceIDParam(c)
+	if !ok {
+		return
+	}
+	var req struct {
+		CustomName string `json:"CustomName"`
+	}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid request body"})
+		return
+	}
+
+	ctx := c.Request.Context()
+	n, err := h.db.DeviceOption.Update().
+		Where(entdeviceoption.DeviceID(deviceID)).
+		SetCustomName(req.CustomName).
+		Save(ctx)
+	if err == nil && n == 0 {
+		err = h.db.DeviceOption.Create().
+			SetDeviceID(deviceID).
+			SetCustomName(req.CustomName).
+			Exec(ctx)
+	}
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to update device options"})
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
+
+// DeleteDevice handles DELETE /Devices?id=.
+// Revokes every session of the device, which signs it out and removes it
+// from the list until it logs in again. Its options are kept.
+func (h *DeviceHandler) DeleteDevice(c *gin.Context) {
+	deviceID, ok := deviceIDParam(c)
+	if !ok {
+		return
+	}
+	ctx := c.Request.Context()
+	sessions, err := h.db.Session.Query().
+		Where(entsession.DeviceID(deviceID)).
+		Order(ent.Desc(entsession.FieldLastActivity)).
+		All(ctx)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to get device"})
+		return
+	}
+	if len(sessions) == 0 {
+		c.JSON(http.StatusNotFound, gin.H{"error": "device not found"})
+		return
+	}
+	if _, err := revokeSessions(ctx, h.db, h.hub, sessions); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to delete device"})
+		return
+	}
+	recordAdminActivity(c, h.db, activity.Entry{
+		Name: "Device " + sessions[0].DeviceName + " was deleted",
+		Type: "DeviceDeleted",
+	})
+	c.Status(http.StatusNoContent)
+}
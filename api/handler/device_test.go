@@ -0,0 +1,170 @@
+package handler_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/ddevcap/jellyfin-proxy/api/handler"
+	"github.com/ddevcap/jellyfin-proxy/api/middleware"
+	"github.com/ddevcap/jellyfin-proxy/backend"
+	"github.com/ddevcap/jellyfin-proxy/config"
+	"github.com/ddevcap/jellyfin-proxy/ent"
+	entactivitylog "github.com/ddevcap/jellyfin-proxy/ent/activitylog"
+)
+
+var _ = Describe("DeviceHandler", func() {
+	var (
+		router *gin.Engine
+		alice  *ent.User
+	)
+
+	adminHdr := map[string]string{"X-Emby-Token": "device-admin-token"}
+	aliceHdr := map[string]string{"X-Emby-Token": "device-alice-token"}
+
+	newSession := func(u *ent.User, token, deviceID, deviceName string, lastActivity time.Time) *ent.Session {
+		return db.Session.Create().
+			SetToken(middleware.HashToken(token)).
+			SetDeviceID(deviceID).
+			SetDeviceName(deviceName).
+			SetAppName("Jellyfin Web").
+			SetAppVersion("10.11.6").
+			SetLastActivity(lastActivity).
+			SetUser(u).
+			SaveX(context.Background())
+	}
+
+	type deviceInfo struct {
+		Id           string
+		Name         string
+		CustomName   *string
+		LastUserName string
+		AppName      string
+	}
+	listDevices := func(path string) []deviceInfo {
+		w := doGet(router, path, adminHdr)
+		Expect(w.Code).To(Equal(http.StatusOK), w.Body.String())
+		var resp struct {
+			Items            []deviceInfo
+			TotalRecordCount int
+		}
+		Expect(json.Unmarshal(w.Body.Bytes(), &resp)).To(Succeed())
+		Expect(resp.TotalRecordCount).To(Equal(len(resp.Items)))
+		return resp.Items
+	}
+
+	BeforeEach(func() {
+		cleanDB()
+		now := time.Now()
+		admin := createUser("deviceadmin", "password1!", true)
+		alice = createUser("alice", "password1!", false)
+		newSession(admin, "device-admin-token", "admin-browser", "Firefox", now)
+		newSession(alice, "device-alice-token", "alice-phone", "Pixel", now.Add(-time.Hour))
+		newSession(admin, "device-shared-admin", "shared-tv", "Living Room", now.Add(-2*time.Hour))
+		newSession(alice, "device-shared-alice", "shared-tv", "Living Room", now.Add(-time.Minute))
+
+		cfg := config.Config{ServerID: "test-server-id"}
+		h := handler.NewDeviceHandler(db, handler.NewWSHub(backend.NewPool(db, cfg)))
+		router = gin.New()
+		priv := router.Group("/")
+		priv.Use(middleware.Auth(db, cfg), middleware.AdminOnly())
+		priv.GET("/devices", h.GetDevices)
+		priv.DELETE("/devices", h.DeleteDevice)
+		priv.GET("/devices/info", h.GetDeviceInfo)
+		priv.GET("/devices/options", h.GetDeviceOptions)
+		priv.POST("/devices/options", h.UpdateDeviceOptions)
+	})
+
+	Describe("GetDevices", func() {
+		It("lists each device once, by its latest session", func() {
+			devices := listDevices("/devices")
+
+			Expect(devices).To(HaveLen(3))
+			Expect(devices[0].Id).To(Equal("admin-browser"))
+			Expect(devices[1].Id).To(Equal("shared-tv"))
+			Expect(devices[1].LastUserName).To(Equal("alice"))
+			Expect(devices[1].Name).To(Equal("Living Room"))
+			Expect(devices[1].CustomName).To(BeNil())
+			Expect(devices[2].Id).To(Equal("alice-phone"))
+		})
+
+		It("filters by user", func() {
+			devices := listDevices("/devices?userId=" + alice.ID.String())
+
+			Expect(devices).To(HaveLen(2))
+			Expect(devices[0].Id).To(Equal("shared-tv"))
+			Expect(devices[1].Id).To(Equal("alice-phone"))
+		})
+
+		It("is admin only", func() {
+			Expect(doGet(router, "/devices", aliceHdr).Code).To(Equal(http.StatusForbidden))
+		})
+	})
+
+	Describe("GetDeviceInfo", func() {
+		It("returns one device", func() {
+			w := doGet(router, "/devices/info?id=alice-phone", adminHdr)
+			Expect(w.Code).To(Equal(http.StatusOK))
+			var info deviceInfo
+			Expect(json.Unmarshal(w.Body.Bytes(), &info)).To(Succeed())
+			Expect(info.Name).To(Equal("Pixel"))
+			Expect(info.LastUserName).To(Equal("alice"))
+		})
+
+		It("returns 404 for an unknown device", func() {
+			Expect(doGet(router, "/devices/info?id=nope", adminHdr).Code).To(Equal(http.StatusNotFound))
+		})
+
+		It("requires an id", func() {
+			Expect(doGet(router, "/devices/info", adminHdr).Code).To(Equal(http.StatusBadRequest))
+		})
+	})
+
+	Describe("Options", func() {
+		It("stores a custom name that replaces the reported one", func() {
+			w := doGet(router, "/devices/options?id=alice-phone", adminHdr)
+			Expect(w.Code).To(Equal(http.StatusOK))
+			Expect(w.Body.String()).To(MatchJSON(`{"Id":0,"DeviceId":"alice-phone","CustomName":null}`))
+
+			w = doPost(router, "/devices/options?id=alice-phone", map[string]string{"CustomName": "Alice's phone"}, adminHdr)
+			Expect(w.Code).To(Equal(http.StatusNoContent))
+			w = doPost(router, "/devices/options?id=alice-phone", map[string]string{"CustomName": "Work phone"}, adminHdr)
+			Expect(w.Code).To(Equal(http.StatusNoContent))
+			Expect(db.DeviceOption.Query().CountX(context.Background())).To(Equal(1))
+
+			w = doGet(router, "/devices/options?id=alice-phone", adminHdr)
+			var opts map[string]interface{}
+			Expect(json.Unmarshal(w.Body.Bytes(), &opts)).To(Succeed())
+			Expect(opts["CustomName"]).To(Equal("Work phone"))
+
+			devices := listDevices("/devices")
+			Expect(devices[2].Name).To(Equal("Work phone"))
+			Expect(*devices[2].CustomName).To(Equal("Work phone"))
+		})
+
+		It("returns 404 for an unknown device", func() {
+			Expect(doGet(router, "/devices/options?id=nope", adminHdr).Code).To(Equal(http.StatusNotFound))
+		})
+	})
+
+	Describe("DeleteDevice", func() {
+		It("revokes all sessions of the device", func() {
+			w := doDelete(router, "/devices?id=shared-tv", adminHdr)
+			Expect(w.Code).To(Equal(http.StatusNoContent))
+
+			Expect(listDevices("/devices")).To(HaveLen(2))
+			Expect(db.Session.Query().CountX(context.Background())).To(Equal(2))
+			Expect(db.ActivityLog.Query().Where(entactivitylog.EventType("DeviceDeleted")).ExistX(context.Background())).To(BeTrue())
+		})
+
+		It("returns 404 for an unknown device", func() {
+			Expect(doDelete(router, "/devices?id=nope", adminHdr).Code).To(Equal(http.StatusNotFound))
+		})
+	})
+})
@@ -1,38 +1,103 @@
 package handler
 
 import (
+	"context"
 	"encoding/json"
-	"sync"
+	"time"
+
+	"github.com/ddevcap/jellyfin-proxy/ent"
+	entdisplaypreference "github.com/ddevcap/jellyfin-proxy/ent/displaypreference"
+	"github.com/google/uuid"
+	"github.com/jellydator/ttlcache/v3"
 )
 
-// displayPrefsStore stores display preferences per user+client combination
-// in memory. Preferences are small JSON blobs that clients send/retrieve
-// frequently; keeping them in memory avoids DB round-trips while the proxy
-// is running. Preferences survive per-session because clients re-send them
-// on each login.
-//
-// Key format: "<userId>:<prefsId>:<client>" where client comes from the
-// query parameter.
+// prefsCacheTTL is how long stored preferences stay cached after their last
+// read. Only the proxy writes them, so the cache never goes stale.
+const prefsCacheTTL = time.Hour
+
+// newPrefsCache creates a write-through cache of stored JSON documents. A nil
+// value records that nothing is stored, so defaults are not looked up again.
+func newPrefsCache() *ttlcache.Cache[string, json.RawMessage] {
+	cache := ttlcache.New[string, json.RawMessage](
+		ttlcache.WithTTL[string, json.RawMessage](prefsCacheTTL),
+	)
+	go cache.Start()
+	return cache
+}
+
+// displayPrefsStore keeps display preferences per user, preferences ID and
+// client in the database. Clients read them on nearly every page, so reads
+// are served from a write-through cache.
 type displayPrefsStore struct {
-	mu    sync.RWMutex
-	prefs map[string]json.RawMessage
+	db    *ent.Client
+	cache *ttlcache.Cache[string, json.RawMessage]
 }
 
-func newDisplayPrefsStore() *displayPrefsStore {
-	return &displayPrefsStore{
-		prefs: make(map[string]json.RawMessage),
+func newDisplayPrefsStore(db *ent.Client) *displayPrefsStore {
+	return &displayPrefsStore{db: db, cache: newPrefsCache()}
+}
+
+func displayPrefsKey(userID uuid.UUID, prefsID, client string) string {
+	return userID.String() + ":" + prefsID + ":" + client
+}
+
+// get returns the stored preferences, or nil when none were saved.
+func (s *displayPrefsStore) get(ctx context.Context, userID uuid.UUID, prefsID, client string) (json.RawMessage, error) {
+	key := displayPrefsKey(userID, prefsID, client)
+	if item := s.cache.Get(key); item != nil {
+		return item.Value(), nil
+	}
+	p, err := s.db.DisplayPreference.Query().
+		Where(
+			entdisplaypreference.UserID(userID),
+			entdisplaypreference.PrefsID(prefsID),
+			entdisplaypreference.ClientName(client),
+		).
+		Only(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return nil, err
+	}
+	var data json.RawMessage
+	if p != nil {
+		data = p.Data
 	}
+	s.cache.Set(key, data, ttlcache.DefaultTTL)
+	return data, nil
 }
 
-func (s *displayPrefsStore) get(key string) (json.RawMessage, bool) {
-	s.mu.RLock()
-	defer s.mu.RUnlock()
-	v, ok := s.prefs[key]
-	return v, ok
+// set stores the preferences, replacing earlier ones.
+func (s *displayPrefsStore) set(ctx context.Context, userID uuid.UUID, prefsID, client string, data json.RawMessage) error {
+	update := func() (int, error) {
+		return s.db.DisplayPreference.Update().
+			Where(
+				entdisplaypreference.UserID(userID),
+				entdisplaypreference.PrefsID(prefsID),
+				entdisplaypreference.ClientName(client),
+			).
+			SetData(data).
+			Save(ctx)
+	}
+	n, err := update()
+	if err == nil && n == 0 {
+		err = s.db.DisplayPreference.Create().
+			SetUserID(userID).
+			SetPrefsID(prefsID).
+			SetClientName(client).
+			SetData(data).
+			Exec(ctx)
+		if ent.IsConstraintError(err) {
+			// Created concurrently by another request; overwrite it.
+			_, err = update()
+		}
+	}
+	if err != nil {
+		return err
+	}
+	s.cache.Set(displayPrefsKey(userID, prefsID, client), data, ttlcache.DefaultTTL)
+	return nil
 }
 
-func (s *displayPrefsStore) set(key string, value json.RawMessage) {
-	s.mu.Lock()
-	defer s.mu.Unlock()
-	s.prefs[key] = value
+// forget drops the cached preferences of the user with userID.
+func (s *displayPrefsStore) forget(userID uuid.UUID) {
+	deleteUserKeys(s.cache, userID)
 }
@@ -1,6 +1,7 @@
 package handler_test
 
 import (
+	"context"
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
@@ -11,6 +12,7 @@ import (
 	"github.com/gin-gonic/gin"
 
 	"github.com/ddevcap/jellyfin-proxy/api/handler"
+	"github.com/ddevcap/jellyfin-proxy/api/middleware"
 	"github.com/ddevcap/jellyfin-proxy/config"
 )
 
@@ -63,6 +65,69 @@ var _ = Describe("DisplayPreferencesUpdate", func() {
 	})
 })
 
+var _ = Describe("Stored display preferences", func() {
+	cfg := config.Config{ServerID: "test-server-id"}
+	aliceHdr := map[string]string{"X-Emby-Token": "prefs-alice-token"}
+	bobHdr := map[string]string{"X-Emby-Token": "prefs-bob-token"}
+
+	// prefsRouter builds a router around a fresh SystemHandler, so nothing
+	// can be served from an earlier handler's cache.
+	prefsRouter := func() *gin.Engine {
+		h := handler.NewSystemHandler(cfg, db, nil)
+		r := gin.New()
+		priv := r.Group("/")
+		priv.Use(middleware.Auth(db, cfg))
+		priv.GET("/displaypreferences/:id", h.DisplayPreferencesGet)
+		priv.POST("/displaypreferences/:id", h.DisplayPreferencesUpdate)
+		return r
+	}
+
+	BeforeEach(func() {
+		cleanDB()
+		createSession(createUser("alice", "password1!", false), "prefs-alice-token")
+		createSession(createUser("bob", "password1!", false), "prefs-bob-token")
+	})
+
+	It("survives a restart", func() {
+		prefs := map[string]interface{}{"Id": "usersettings", "SortBy": "DateCreated", "CustomPrefs": map[string]string{"homesection0": "resume"}}
+		w := doPost(prefsRouter(), "/displaypreferences/usersettings?client=emby", prefs, aliceHdr)
+		Expect(w.Code).To(Equal(http.StatusNoContent))
+
+		w = doGet(prefsRouter(), "/displaypreferences/usersettings?client=emby", aliceHdr)
+		Expect(w.Code).To(Equal(http.StatusOK))
+		Expect(w.Body.String()).To(MatchJSON(`{"Id":"usersettings","SortBy":"DateCreated","CustomPrefs":{"homesection0":"resume"}}`))
+	})
+
+	It("replaces earlier preferences", func() {
+		r := prefsRouter()
+		doPost(r, "/displaypreferences/usersettings?client=emby", map[string]string{"SortBy": "SortName"}, aliceHdr)
+		doPost(r, "/displaypreferences/usersettings?client=emby", map[string]string{"SortBy": "Random"}, aliceHdr)
+
+		Expect(db.DisplayPreference.Query().CountX(context.Background())).To(Equal(1))
+		w := doGet(prefsRouter(), "/displaypreferences/usersettings?client=emby", aliceHdr)
+		Expect(w.Body.String()).To(MatchJSON(`{"SortBy":"Random"}`))
+	})
+
+	It("keeps preferences per user and client", func() {
+		r := prefsRouter()
+		doPost(r, "/displaypreferences/usersettings?client=emby", map[string]string{"SortBy": "Random"}, aliceHdr)
+
+		var resp map[string]interface{}
+		w := doGet(r, "/displaypreferences/usersettings?client=emby", bobHdr)
+		Expect(json.Unmarshal(w.Body.Bytes(), &resp)).To(Succeed())
+		Expect(resp["SortBy"]).To(Equal("SortName"))
+
+		w = doGet(r, "/displaypreferences/usersettings?client=ATV", aliceHdr)
+		Expect(json.Unmarshal(w.Body.Bytes(), &resp)).To(Succeed())
+		Expect(resp["SortBy"]).To(Equal("SortName"))
+	})
+
+	It("rejects a body that is not JSON", func() {
+		w := doRawPost(prefsRouter(), "/displaypreferences/usersettings", []byte("not json"), "application/json", aliceHdr)
+		Expect(w.Code).To(Equal(http.StatusBadRequest))
+	})
+})
+
 var _ = Describe("HealthLive", func() {
 	var h *handler.SystemHandler
 
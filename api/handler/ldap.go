@@ -0,0 +1,98 @@
+package handler
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+
+	"github.com/ddevcap/jellyfin-proxy/activity"
+	"github.com/ddevcap/jellyfin-proxy/config"
+	"github.com/ddevcap/jellyfin-proxy/ent"
+	entuser "github.com/ddevcap/jellyfin-proxy/ent/user"
+	"github.com/ddevcap/jellyfin-proxy/ldapauth"
+	"github.com/gin-gonic/gin"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// Values of the user auth_source field.
+const (
+	authSourceLocal = "local"
+	authSourceLDAP  = "ldap"
+)
+
+// newLDAPAuthenticator returns nil when LDAP authentication is disabled.
+func newLDAPAuthenticator(cfg config.Config) *ldapauth.Authenticator {
+	if cfg.LDAPURL == "" {
+		return nil
+	}
+	return ldapauth.New(ldapauth.Config{
+		URL:                  cfg.LDAPURL,
+		BindDN:               cfg.LDAPBindDN,
+		BindPassword:         cfg.LDAPBindPassword,
+		BaseDN:               cfg.LDAPBaseDN,
+		UserFilter:           cfg.LDAPUserFilter,
+		AdminFilter:          cfg.LDAPAdminFilter,
+		DisplayNameAttribute: cfg.LDAPDisplayNameAttribute,
+	})
+}
+
+// ldapLogin checks the password against the directory and returns the proxy
+// user, creating it on the first login. existing is the user found by
+// username, or nil. The display name and, with an admin filter, is_admin are
+// refreshed from the directory on every login.
+func (h *AuthHandler) ldapLogin(c *gin.Context, username, password string, existing *ent.User) (*ent.User, error) {
+	id, err := h.ldap.Authenticate(username, password)
+	if err != nil {
+		return nil, err
+	}
+	ctx := c.Request.Context()
+	displayName := fallback(id.DisplayName, username)
+
+	if existing != nil {
+		update := existing.Update()
+		changed := false
+		if displayName != existing.DisplayName {
+			update.SetDisplayName(displayName)
+			changed = true
+		}
+		if h.cfg.LDAPAdminFilter != "" && id.IsAdmin != existing.IsAdmin {
+			update.SetIsAdmin(id.IsAdmin)
+			changed = true
+		}
+		if !changed {
+			return existing, nil
+		}
+		return update.Save(ctx)
+	}
+
+	// The local hash is never checked for LDAP users, but the field is
+	// required, so store one nobody knows.
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		return nil, err
+	}
+	hash, err := bcrypt.GenerateFromPassword([]byte(hex.EncodeToString(secret)), bcrypt.MinCost)
+	if err != nil {
+		return nil, err
+	}
+	user, err := h.db.User.Create().
+		SetUsername(username).
+		SetDisplayName(displayName).
+		SetHashedPassword(string(hash)).
+		SetIsAdmin(h.cfg.LDAPAdminFilter != "" && id.IsAdmin).
+		SetAuthSource(authSourceLDAP).
+		Save(ctx)
+	if ent.IsConstraintError(err) {
+		// A concurrent login created the user first.
+		return h.db.User.Query().Where(entuser.Username(username)).Only(ctx)
+	}
+	if err != nil {
+		return nil, err
+	}
+	activity.Record(ctx, h.db, activity.Entry{
+		Name:          "User " + user.Username + " has been created",
+		Type:          "UserCreated",
+		ShortOverview: "By LDAP login",
+		UserID:        user.ID,
+	})
+	return user, nil
+}
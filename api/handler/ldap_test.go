@@ -0,0 +1,110 @@
+package handler_test
+
+import (
+	"context"
+	"net/http"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/ddevcap/jellyfin-proxy/api/handler"
+	"github.com/ddevcap/jellyfin-proxy/api/middleware"
+	"github.com/ddevcap/jellyfin-proxy/config"
+	entuser "github.com/ddevcap/jellyfin-proxy/ent/user"
+	"github.com/ddevcap/jellyfin-proxy/ldapauth/ldaptest"
+)
+
+var _ = Describe("LDAP login", func() {
+	var (
+		router *gin.Engine
+		dir    *ldaptest.Server
+	)
+
+	login := func(username, password string) int {
+		return doPost(router, "/Users/AuthenticateByName", map[string]string{
+			"Username": username,
+			"Pw":       password,
+		}).Code
+	}
+
+	BeforeEach(func() {
+		cleanDB()
+		dir = ldaptest.NewServer(
+			ldaptest.Entry{DN: "cn=svc,dc=example,dc=com", Password: "svc-secret"},
+			ldaptest.Entry{
+				DN:       "uid=grace,ou=people,dc=example,dc=com",
+				Password: "grace-secret",
+				Attributes: map[string][]string{
+					"objectClass": {"person"},
+					"uid":         {"grace"},
+					"displayName": {"Grace Hopper"},
+					"memberOf":    {"cn=admins,ou=groups,dc=example,dc=com"},
+				},
+			},
+			ldaptest.Entry{
+				DN:       "uid=alice,ou=people,dc=example,dc=com",
+				Password: "directory-pass",
+				Attributes: map[string][]string{
+					"objectClass": {"person"},
+					"uid":         {"alice"},
+				},
+			},
+		)
+		DeferCleanup(dir.Close)
+
+		cfg := config.Config{
+			ServerID:                 "test-server-id",
+			LDAPURL:                  dir.URL,
+			LDAPBindDN:               "cn=svc,dc=example,dc=com",
+			LDAPBindPassword:         "svc-secret",
+			LDAPBaseDN:               "dc=example,dc=com",
+			LDAPUserFilter:           "(&(objectClass=person)(uid={username}))",
+			LDAPAdminFilter:          "(memberOf=cn=admins,ou=groups,dc=example,dc=com)",
+			LDAPDisplayNameAttribute: "displayName",
+		}
+		h := handler.NewAuthHandler(db, cfg, func(string) {}, func(string) {})
+		router = gin.New()
+		router.POST("/Users/AuthenticateByName", h.AuthenticateByName)
+		auth := router.Group("/")
+		auth.Use(middleware.Auth(db, cfg))
+		auth.POST("/Users/:userId/Password", h.UpdatePassword)
+	})
+
+	It("creates the user on first login and maps the admin filter", func() {
+		Expect(login("grace", "grace-secret")).To(Equal(http.StatusOK))
+
+		grace := db.User.Query().Where(entuser.Username("grace")).OnlyX(context.Background())
+		Expect(grace.AuthSource).To(Equal("ldap"))
+		Expect(grace.DisplayName).To(Equal("Grace Hopper"))
+		Expect(grace.IsAdmin).To(BeTrue())
+
+		Expect(login("grace", "grace-secret")).To(Equal(http.StatusOK))
+		Expect(db.User.Query().CountX(context.Background())).To(Equal(1))
+	})
+
+	It("rejects a wrong directory password without creating the user", func() {
+		Expect(login("grace", "wrong")).To(Equal(http.StatusUnauthorized))
+		Expect(db.User.Query().CountX(context.Background())).To(BeZero())
+	})
+
+	It("keeps checking local accounts against their own password", func() {
+		createUser("alice", "local-pass1", false)
+
+		Expect(login("alice", "local-pass1")).To(Equal(http.StatusOK))
+		Expect(login("alice", "directory-pass")).To(Equal(http.StatusUnauthorized))
+	})
+
+	It("refuses password changes for LDAP accounts", func() {
+		Expect(login("grace", "grace-secret")).To(Equal(http.StatusOK))
+		grace := db.User.Query().Where(entuser.Username("grace")).OnlyX(context.Background())
+		createSession(grace, "grace-token")
+
+		w := doPost(router, "/Users/"+grace.ID.String()+"/Password",
+			map[string]string{"CurrentPw": "grace-secret", "NewPw": "newpassword1"},
+			map[string]string{"X-Emby-Token": "grace-token"},
+		)
+		Expect(w.Code).To(Equal(http.StatusForbidden))
+	})
+})
@@ -0,0 +1,82 @@
+package handler
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/ddevcap/jellyfin-proxy/activity"
+	"github.com/ddevcap/jellyfin-proxy/ent"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// ── Account lockout ───────────────────────────────────────────────────────────
+//
+// The per-IP login limiter does not stop an attack spread over many
+// addresses, so failed logins are also counted per account. After
+// AccountLockoutAttempts consecutive failures the account refuses password
+// logins for AccountLockoutDuration. The counter and unlock time live on the
+// user row, so a restart does not unlock anyone.
+//
+// A locked account answers like a wrong password, and only once the
+// credentials were checked, so a lock tells nobody whether the account
+// exists or the password was right. Administrators are locked like everyone
+// else; another administrator can unlock them, or they wait out the lock.
+
+// accountLocked reports whether user is locked out of password logins.
+func accountLocked(user *ent.User) bool {
+	return user.LockedUntil != nil && time.Now().Before(*user.LockedUntil)
+}
+
+// lockedOut refuses the login of user, whose credentials are valid, while the
+// account is locked. It writes the response and returns true when it did.
+func (h *AuthHandler) lockedOut(c *gin.Context, user *ent.User, ip string) bool {
+	if !accountLocked(user) {
+		return false
+	}
+	h.onLoginFail(ip)
+	c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid username or password"})
+	return true
+}
+
+// countFailedLogin adds a failed login to the account of userID and locks it
+// once the failures reach the configured limit.
+func (h *AuthHandler) countFailedLogin(c *gin.Context, userID uuid.UUID, ip string) {
+	if h.cfg.AccountLockoutAttempts <= 0 || userID == uuid.Nil {
+		return
+	}
+	ctx := c.Request.Context()
+	user, err := h.db.User.UpdateOneID(userID).AddFailedLoginCount(1).Save(ctx)
+	if err != nil {
+		slog.Warn("failed to count failed login", "user", userID, "error", err)
+		return
+	}
+	if user.FailedLoginCount < h.cfg.AccountLockoutAttempts {
+		return
+	}
+	until := time.Now().Add(h.cfg.AccountLockoutDuration)
+	if err := user.Update().SetFailedLoginCount(0).SetLockedUntil(until).Exec(ctx); err != nil {
+		slog.Warn("failed to lock account", "user", user.Username, "error", err)
+		return
+	}
+	slog.Warn("account locked after failed logins", "user", user.Username, "ip", ip, "until", until)
+	activity.Record(ctx, h.db, activity.Entry{
+		Name: "User " + user.Username + " has been locked out",
+		Type: "UserLockedOut",
+		ShortOverview: strconv.Itoa(h.cfg.AccountLockoutAttempts) +
+			" failed login attempts, last from IP address " + ip,
+		UserID:   user.ID,
+		Severity: activity.Error,
+	})
+}
+
+// resetFailedLogins clears the failed login count and lock of user, if any.
+func resetFailedLogins(ctx context.Context, db *ent.Client, user *ent.User) error {
+	if user.FailedLoginCount == 0 && user.LockedUntil == nil {
+		return nil
+	}
+	return db.User.UpdateOne(user).SetFailedLoginCount(0).ClearLockedUntil().Exec(ctx)
+}
@@ -17,6 +17,7 @@ import (
 	entsession "github.com/ddevcap/jellyfin-proxy/ent/session"
 	"github.com/ddevcap/jellyfin-proxy/idtrans"
 	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
 	"github.com/jellydator/ttlcache/v3"
 )
 
@@ -40,21 +41,50 @@ func setApiKey(query url.Values, sc *backend.ServerClient) {
 
 // MediaHandler handles all media-browsing and playback routes.
 type MediaHandler struct {
-	pool      *backend.Pool
-	cfg       config.Config
-	db        *ent.Client
-	viewCache *ttlcache.Cache[string, []json.RawMessage]
+	pool         *backend.Pool
+	cfg          config.Config
+	db           *ent.Client
+	viewCache    *ttlcache.Cache[string, []json.RawMessage]
+	dupCache     *ttlcache.Cache[string, []string]
+	cursorCache  *ttlcache.Cache[string, *mergeCursor]
+	accessCache  *ttlcache.Cache[string, bool]
+	libraryCache *ttlcache.Cache[string, map[string]string]
+	userConfig   *userConfigStore
+	playing      *PlaybackRegistry // optional; fed by playback reports
+	hub          *WSHub            // nil until SetWSHub
 }
 
 func NewMediaHandler(pool *backend.Pool, cfg config.Config, db *ent.Client) *MediaHandler {
-	return &MediaHandler{pool: pool, cfg: cfg, db: db, viewCache: newViewCache()}
+	return &MediaHandler{
+		pool:         pool,
+		cfg:          cfg,
+		db:           db,
+		viewCache:    newViewCache(),
+		dupCache:     newDupCache(db),
+		cursorCache:  newCursorCache(),
+		accessCache:  newAccessCache(),
+		libraryCache: newLibraryCache(),
+		userConfig:   newUserConfigStore(db),
+	}
+}
+
+// SetPlaybackRegistry makes the handler record the playback reports it
+// forwards in r.
+func (h *MediaHandler) SetPlaybackRegistry(r *PlaybackRegistry) {
+	h.playing = r
+}
+
+// SetWSHub lets the handler close the WebSockets of users it disables.
+func (h *MediaHandler) SetWSHub(hub *WSHub) {
+	h.hub = hub
 }
 
 // ── context helpers ───────────────────────────────────────────────────────────
 
 // tryResolveUser attempts to resolve the proxy user from the request's token
 // (X-Emby-Token header, api_key query param, etc.) without requiring the Auth
-// middleware to have run. Returns nil if no valid session is found.
+// middleware to have run. Returns nil if no valid session or API key is found.
+// Sessions idle for longer than SessionTTL are expired as in middleware.Auth.
 //
 // When a request carries multiple ApiKey query params (e.g. a leaked backend
 // token plus the injected proxy token), each candidate is tried until one
@@ -63,7 +93,12 @@ func (h *MediaHandler) tryResolveUser(c *gin.Context) *ent.User {
 	if u := userFromCtx(c); u != nil {
 		return u
 	}
+	if v, ok := c.Get(ctxKeyResolvedUser); ok {
+		u, _ := v.(*ent.User)
+		return u
+	}
 
+	var user *ent.User
 	// Collect all candidate tokens: headers first, then every ApiKey/api_key
 	// query value.
 	candidates := middleware.ExtractAllTokens(c)
@@ -72,14 +107,24 @@ func (h *MediaHandler) tryResolveUser(c *gin.Context) *ent.User {
 			continue
 		}
 		session, err := h.db.Session.Query().
-			Where(entsession.Token(token)).
+			Where(entsession.Token(middleware.HashToken(token))).
 			WithUser().
 			Only(c.Request.Context())
 		if err == nil {
-			return session.Edges.User
+			if h.cfg.SessionTTL > 0 && time.Since(session.LastActivity) > h.cfg.SessionTTL {
+				_ = h.db.Session.DeleteOne(session).Exec(c.Request.Context())
+				continue
+			}
+			user = session.Edges.User
+			break
+		}
+		if key, err := middleware.LookupAPIKey(c.Request.Context(), h.db, token); err == nil {
+			user = key.Edges.User
+			break
 		}
 	}
-	return nil
+	c.Set(ctxKeyResolvedUser, user)
+	return user
 }
 
 // ── query-param forwarding ────────────────────────────────────────────────────
@@ -182,14 +227,30 @@ func canonicalKey(k string) string {
 
 // routeByID decodes a proxy item ID, resolves the backend server client with
 // the authenticated user's credentials, and returns both the client and the
-// raw backend item ID.
+// raw backend item ID. A virtual dup_ ID resolves to a reachable copy.
+// Items the user's access rules hide yield errAccessDenied; callers report
+// errors with routeError.
 func (h *MediaHandler) routeByID(c *gin.Context, proxyID string) (*backend.ServerClient, string, error) {
-	prefix, backendID, err := idtrans.Decode(proxyID)
+	user := userFromCtx(c)
+	var sc *backend.ServerClient
+	var backendID string
+	var err error
+	if idtrans.IsDup(proxyID) {
+		sc, backendID, err = h.routeDup(proxyID, func(prefix string) (*backend.ServerClient, error) {
+			return h.pool.ForUser(c.Request.Context(), prefix, user)
+		})
+	} else {
+		var prefix string
+		prefix, backendID, err = idtrans.Decode(proxyID)
+		if err != nil {
+			return nil, "", err
+		}
+		sc, err = h.pool.ForUser(c.Request.Context(), prefix, user)
+	}
 	if err != nil {
 		return nil, "", err
 	}
-	sc, err := h.pool.ForUser(c.Request.Context(), prefix, userFromCtx(c))
-	if err != nil {
+	if err := h.checkAccess(c.Request.Context(), user, sc, backendID); err != nil {
 		return nil, "", err
 	}
 	return sc, backendID, nil
@@ -200,20 +261,32 @@ func (h *MediaHandler) routeByID(c *gin.Context, proxyID string) (*backend.Serve
 // (streaming, images) where the video player may fetch resources without
 // sending auth headers.
 func (h *MediaHandler) routeByIDPublic(c *gin.Context, proxyID string) (*backend.ServerClient, string, error) {
-	prefix, backendID, err := idtrans.Decode(proxyID)
-	if err != nil {
-		return nil, "", err
-	}
 	user := h.tryResolveUser(c)
+	clientFor := func(prefix string) (*backend.ServerClient, error) {
+		if user != nil {
+			return h.pool.ForUser(c.Request.Context(), prefix, user)
+		}
+		return h.pool.ForBackend(c.Request.Context(), prefix)
+	}
 	var sc *backend.ServerClient
-	if user != nil {
-		sc, err = h.pool.ForUser(c.Request.Context(), prefix, user)
+	var backendID string
+	var err error
+	if idtrans.IsDup(proxyID) {
+		sc, backendID, err = h.routeDup(proxyID, clientFor)
 	} else {
-		sc, err = h.pool.ForBackend(c.Request.Context(), prefix)
+		var prefix string
+		prefix, backendID, err = idtrans.Decode(proxyID)
+		if err != nil {
+			return nil, "", err
+		}
+		sc, err = clientFor(prefix)
 	}
 	if err != nil {
 		return nil, "", err
 	}
+	if err := h.checkAccess(c.Request.Context(), user, sc, backendID); err != nil {
+		return nil, "", err
+	}
 	return sc, backendID, nil
 }
 
@@ -429,23 +502,32 @@ func injectProxyToken(body []byte, token string) []byte {
 
 // buildUserPolicy returns the Jellyfin Policy object for a proxy user.
 // Centralised so the same policy shape is returned from both the login
-// response (AuthenticateByName) and the user-object endpoints.
-func buildUserPolicy(isAdmin bool, cfg config.Config) gin.H {
-	return gin.H{
+// response (AuthenticateByName) and the user-object endpoints. The folder,
+// rating, tag and schedule fields mirror the user's access rules, and the
+// account and playback flags the policy stored by UpdateUserPolicy; the proxy
+// enforces both itself.
+func buildUserPolicy(user *ent.User, cfg config.Config) gin.H {
+	isAdmin := user.IsAdmin
+	rules := user.AccessRules
+	schedules := make([]gin.H, len(rules.Schedules))
+	for i, s := range rules.Schedules {
+		schedules[i] = gin.H{"DayOfWeek": s.DayOfWeek, "StartHour": s.StartHour, "EndHour": s.EndHour}
+	}
+	policy := gin.H{
 		"IsAdministrator":                 isAdmin,
 		"IsHidden":                        false,
-		"IsDisabled":                      false,
+		"IsDisabled":                      user.IsDisabled,
 		"EnableRemoteControlOfOtherUsers": isAdmin,
 		"EnableSharedDeviceControl":       false,
-		"EnableRemoteAccess":              true,
+		"EnableRemoteAccess":              user.EnableRemoteAccess,
 		"EnableLiveTvManagement":          false,
 		"EnableLiveTvAccess":              true,
-		"EnableMediaPlayback":             true,
+		"EnableMediaPlayback":             user.EnableMediaPlayback,
 		"EnableAudioPlaybackTranscoding":  true,
-		"EnableVideoPlaybackTranscoding":  true,
+		"EnableVideoPlaybackTranscoding":  user.EnableVideoPlaybackTranscoding,
 		"EnablePlaybackRemuxing":          true,
 		"EnableContentDeletion":           isAdmin,
-		"EnableContentDownloading":        true,
+		"EnableContentDownloading":        user.EnableContentDownloading,
 		"EnableSubtitleDownloading":       true,
 		"EnableSubtitleManagement":        false,
 		"EnableSyncTranscoding":           true,
@@ -454,22 +536,70 @@ func buildUserPolicy(isAdmin bool, cfg config.Config) gin.H {
 		"EnableAllDevices":                true,
 		"EnabledChannels":                 []string{},
 		"EnableAllChannels":               true,
-		"EnabledFolders":                  []string{},
-		"EnableAllFolders":                true,
-		"InvalidLoginAttemptCount":        0,
+		"EnabledFolders":                  nonNilStrings(rules.AllowedLibraries),
+		"EnableAllFolders":                len(rules.AllowedLibraries) == 0,
+		"InvalidLoginAttemptCount":        user.FailedLoginCount,
+		"LoginAttemptsBeforeLockout":      cfg.AccountLockoutAttempts,
 		"EnablePublicSharing":             false,
-		"BlockedMediaFolders":             []string{},
+		"BlockedMediaFolders":             nonNilStrings(rules.BlockedLibraries),
 		"BlockedChannels":                 []string{},
-		"RemoteClientBitrateLimit":        cfg.BitrateLimit,
+		"BlockedTags":                     nonNilStrings(rules.BlockedTags),
+		"AccessSchedules":                 schedules,
+		"RemoteClientBitrateLimit":        remoteBitrateLimit(user, cfg),
 		"AuthenticationProviderId":        "Jellyfin.Server.Implementations.Users.DefaultAuthenticationProvider",
 		"PasswordResetProviderId":         "Jellyfin.Server.Implementations.Users.DefaultPasswordResetProvider",
 		"SyncPlayAccess":                  "CreateAndJoinGroups",
 		"ManagementFeatures":              []interface{}{},
 	}
+	if rules.MaxParentalRating != nil {
+		policy["MaxParentalRating"] = *rules.MaxParentalRating
+	}
+	return policy
+}
+
+// nonNilStrings returns s, or an empty slice so it encodes as [] rather than null.
+func nonNilStrings(s []string) []string {
+	if s == nil {
+		return []string{}
+	}
+	return s
+}
+
+// ForgetUser drops what the handler cached about a deleted user.
+func (h *MediaHandler) ForgetUser(userID uuid.UUID) {
+	h.userConfig.forget(userID)
+	deleteUserKeys(h.viewCache, userID)
+	deleteUserKeys(h.cursorCache, userID)
+	deleteUserKeys(h.accessCache, userID)
+}
+
+// defaultUserConfiguration is the UserConfiguration of users who have not
+// saved one yet.
+func defaultUserConfiguration() gin.H {
+	return gin.H{
+		"PlayDefaultAudioTrack":      true,
+		"RememberAudioSelections":    true,
+		"RememberSubtitleSelections": true,
+		"EnableNextEpisodeAutoPlay":  true,
+		"SubtitleMode":               "Default",
+		"DisplayCollectionsView":     false,
+		"DisplayMissingEpisodes":     false,
+		"EnableLocalPassword":        false,
+		"HidePlayedInLatest":         true,
+		"GroupedFolders":             []interface{}{},
+		"MyMediaExcludes":            []interface{}{},
+		"LatestItemsExcludes":        []interface{}{},
+		"OrderedViews":               []interface{}{},
+	}
 }
 
 // buildUserObject returns the Jellyfin user response object for the proxy user.
-func buildUserObject(user *ent.User, cfg config.Config) gin.H {
+// configuration is the user's stored UserConfiguration; nil means defaults.
+func buildUserObject(user *ent.User, cfg config.Config, configuration json.RawMessage) gin.H {
+	var conf interface{} = defaultUserConfiguration()
+	if configuration != nil {
+		conf = configuration
+	}
 	obj := gin.H{
 		"Name":                      user.Username,
 		"ServerId":                  cfg.ServerID,
@@ -479,22 +609,8 @@ func buildUserObject(user *ent.User, cfg config.Config) gin.H {
 		"HasConfiguredPassword":     true,
 		"HasConfiguredEasyPassword": false,
 		"EnableAutoLogin":           false,
-		"Configuration": gin.H{
-			"PlayDefaultAudioTrack":      true,
-			"RememberAudioSelections":    true,
-			"RememberSubtitleSelections": true,
-			"EnableNextEpisodeAutoPlay":  true,
-			"SubtitleMode":               "Default",
-			"DisplayCollectionsView":     false,
-			"DisplayMissingEpisodes":     false,
-			"EnableLocalPassword":        false,
-			"HidePlayedInLatest":         true,
-			"GroupedFolders":             []interface{}{},
-			"MyMediaExcludes":            []interface{}{},
-			"LatestItemsExcludes":        []interface{}{},
-			"OrderedViews":               []interface{}{},
-		},
-		"Policy": buildUserPolicy(user.IsAdmin, cfg),
+		"Configuration":             conf,
+		"Policy":                    buildUserPolicy(user, cfg),
 	}
 	if user.Avatar != nil && len(*user.Avatar) > 0 {
 		sum := sha256.Sum256(*user.Avatar)
@@ -0,0 +1,420 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ddevcap/jellyfin-proxy/access"
+	"github.com/ddevcap/jellyfin-proxy/backend"
+	"github.com/ddevcap/jellyfin-proxy/ent"
+	entbackend "github.com/ddevcap/jellyfin-proxy/ent/backend"
+	entitem "github.com/ddevcap/jellyfin-proxy/ent/item"
+	"github.com/ddevcap/jellyfin-proxy/idtrans"
+	"github.com/gin-gonic/gin"
+	"github.com/jellydator/ttlcache/v3"
+)
+
+// ── Access rules ──────────────────────────────────────────────────────────────
+//
+// A user's access.Rules are enforced by the proxy rather than by the
+// backends, so a restricted account sees the same libraries and items on
+// every backend even when its backend account is shared with other users.
+// Library, rating and tag rules are evaluated per item from the item index;
+// for items the index does not hold, only the rating and tags the backend
+// reports are known.
+
+// errAccessDenied is returned by routeByID for an item the user's access
+// rules hide.
+var errAccessDenied = errors.New("access denied")
+
+const (
+	// accessCacheTTL is how long an item's access decision is reused. Players
+	// route every stream segment through routeByID.
+	accessCacheTTL = time.Minute
+	// libraryCacheTTL is how long the library list of a backend account is
+	// kept for recognising library IDs.
+	libraryCacheTTL = 5 * time.Minute
+)
+
+// newAccessCache creates the cache of item access decisions, keyed by
+// rulesKey and proxy item ID.
+func newAccessCache() *ttlcache.Cache[string, bool] {
+	cache := ttlcache.New[string, bool](
+		ttlcache.WithTTL[string, bool](accessCacheTTL),
+		ttlcache.WithDisableTouchOnHit[string, bool](),
+	)
+	go cache.Start()
+	return cache
+}
+
+// newLibraryCache creates the cache of backend libraries per backend
+// account, mapping proxy library IDs to their lower-cased CollectionType.
+func newLibraryCache() *ttlcache.Cache[string, map[string]string] {
+	cache := ttlcache.New[string, map[string]string](
+		ttlcache.WithTTL[string, map[string]string](libraryCacheTTL),
+		ttlcache.WithDisableTouchOnHit[string, map[string]string](),
+	)
+	go cache.Start()
+	return cache
+}
+
+// routeError writes the response for a routeByID failure.
+func routeError(c *gin.Context, err error) {
+	if errors.Is(err, errAuthRequired) {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+		return
+	}
+	if errors.Is(err, errAccessDenied) {
+		c.JSON(http.StatusForbidden, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+}
+
+// userRules returns the access rules of user. Requests without a user, such
+// as unauthenticated stream fetches, carry no rules.
+func userRules(user *ent.User) access.Rules {
+	if user == nil {
+		return access.Rules{}
+	}
+	return user.AccessRules
+}
+
+// filtersItems reports whether rules hide any library or item, so lists have
+// to be filtered.
+func filtersItems(rules access.Rules) bool {
+	return rules.RestrictsLibraries() || rules.RestrictsItems()
+}
+
+// allowedNow reports whether the user's schedules allow using the server now.
+func allowedNow(user *ent.User) bool {
+	return userRules(user).Allows(time.Now())
+}
+
+// rulesKey identifies user and the current version of their rules in cache
+// keys. updated_at changes whenever the rules are edited, so cached views,
+// cursors and decisions are not reused across an edit.
+func rulesKey(user *ent.User) string {
+	return user.ID.String() + "@" + strconv.FormatInt(user.UpdatedAt.UnixNano(), 10)
+}
+
+// libraryIDs returns the IDs a library is known by in access rules: its proxy
+// ID and, when it has a CollectionType, the merged_ ID of that type.
+func libraryIDs(proxyID, collectionType string) []string {
+	if collectionType == "" {
+		return []string{proxyID}
+	}
+	return []string{proxyID, idtrans.EncodeMerged(strings.ToLower(collectionType))}
+}
+
+// withAccessFields asks the backend for the item fields the rules look at
+// that Jellyfin leaves out unless requested.
+func withAccessFields(q url.Values, rules access.Rules) {
+	if len(rules.BlockedTags) > 0 {
+		q.Set("Fields", withField(q.Get("Fields"), "Tags"))
+	}
+}
+
+// checkAccess returns errAccessDenied when user may not open the item
+// backendID on sc. Decisions are cached briefly per user and item.
+func (h *MediaHandler) checkAccess(ctx context.Context, user *ent.User, sc *backend.ServerClient, backendID string) error {
+	rules := userRules(user)
+	if !rules.Restricted() {
+		return nil
+	}
+	if !rules.Allows(time.Now()) {
+		return errAccessDenied
+	}
+	if !filtersItems(rules) {
+		return nil
+	}
+	key := rulesKey(user) + "|" + idtrans.Encode(sc.Prefix(), backendID)
+	var allowed bool
+	if item := h.accessCache.Get(key); item != nil {
+		allowed = item.Value()
+	} else {
+		allowed = h.itemAllowed(ctx, rules, sc, backendID)
+		h.accessCache.Set(key, allowed, ttlcache.DefaultTTL)
+	}
+	if !allowed {
+		return errAccessDenied
+	}
+	return nil
+}
+
+// itemAllowed decides whether rules allow the item backendID on sc, which
+// may be a library root or any item below one. It fails closed: when the
+// library list, the index or the backend cannot be read, the item is denied.
+func (h *MediaHandler) itemAllowed(ctx context.Context, rules access.Rules, sc *backend.ServerClient, backendID string) bool {
+	proxyID := idtrans.Encode(sc.Prefix(), backendID)
+	var libs map[string]string
+	if rules.RestrictsLibraries() {
+		libs = h.backendLibraries(ctx, sc)
+		if libs == nil {
+			return false
+		}
+		if ct, ok := libs[proxyID]; ok {
+			return rules.LibraryAllowed(libraryIDs(proxyID, ct)...)
+		}
+	}
+	indexed, err := h.db.Item.Query().
+		Where(
+			entitem.BackendItemID(backendID),
+			entitem.HasBackendWith(entbackend.Prefix(sc.Prefix())),
+		).
+		Exist(ctx)
+	if err != nil {
+		return false
+	}
+	meta := accessMeta{Id: proxyID}
+	if !indexed {
+		// Not in the index (yet): take the library from the item's
+		// ancestors and the rating and tags from the backend.
+		if libs != nil {
+			library, ct, ok := h.ancestorLibrary(ctx, sc, backendID, libs)
+			if !ok || !rules.LibraryAllowed(libraryIDs(library, ct)...) {
+				return false
+			}
+		}
+		if rules.RestrictsItems() {
+			q := url.Values{}
+			withAccessFields(q, rules)
+			body, status, err := sc.ProxyJSON(ctx, "GET",
+				"/users/"+sc.BackendUserID()+"/items/"+backendID, q, nil)
+			if err != nil || status != http.StatusOK || json.Unmarshal(body, &meta) != nil {
+				return false
+			}
+		}
+	}
+	return h.allowedItems(ctx, rules, []accessMeta{meta})[0]
+}
+
+// ancestorLibrary returns the proxy ID and collection type of the library in
+// libs that holds the item backendID on sc, looked up through the item's
+// ancestors. ok is false when the ancestors cannot be read or include none
+// of libs.
+func (h *MediaHandler) ancestorLibrary(ctx context.Context, sc *backend.ServerClient, backendID string, libs map[string]string) (library, collectionType string, ok bool) {
+	q := url.Values{"userId": {sc.BackendUserID()}}
+	body, status, err := sc.ProxyJSON(ctx, "GET", "/items/"+backendID+"/ancestors", q, nil)
+	if err != nil || status != http.StatusOK {
+		return "", "", false
+	}
+	var ancestors []accessMeta
+	if err := json.Unmarshal(body, &ancestors); err != nil {
+		return "", "", false
+	}
+	for _, a := range ancestors {
+		if ct, found := libs[a.Id]; found {
+			return a.Id, ct, true
+		}
+	}
+	return "", "", false
+}
+
+// proxyItemList forwards a GET for a list of items to sc and writes the
+// response without the items the caller's rules hide.
+func (h *MediaHandler) proxyItemList(c *gin.Context, sc *backend.ServerClient, path string, q url.Values) {
+	withAccessFields(q, userRules(userFromCtx(c)))
+	body, status, err := sc.ProxyJSON(c.Request.Context(), "GET", path, q, nil)
+	if err != nil {
+		gatewayError(c, err)
+		return
+	}
+	h.writeFilteredItems(c, body, status)
+}
+
+// checkParentAccess returns errAccessDenied when the caller may not use the
+// server now, or may not browse the ParentId of a list request routed to sc.
+func (h *MediaHandler) checkParentAccess(c *gin.Context, sc *backend.ServerClient) error {
+	user := userFromCtx(c)
+	if !allowedNow(user) {
+		return errAccessDenied
+	}
+	_, backendID, err := idtrans.Decode(queryParam(c, "parentid"))
+	if err != nil {
+		return nil
+	}
+	return h.checkAccess(c.Request.Context(), user, sc, backendID)
+}
+
+// backendLibraries returns the libraries the backend account of sc can see,
+// mapping their proxy IDs to their lower-cased CollectionType.
+func (h *MediaHandler) backendLibraries(ctx context.Context, sc *backend.ServerClient) map[string]string {
+	key := sc.Prefix() + "|" + sc.BackendUserID()
+	if item := h.libraryCache.Get(key); item != nil {
+		return item.Value()
+	}
+	fanCtx, cancel := context.WithTimeout(ctx, fanOutTimeout)
+	defer cancel()
+	body, status, err := sc.ProxyJSON(fanCtx, "GET", "/users/"+sc.BackendUserID()+"/views", nil, nil)
+	if err != nil || status != http.StatusOK {
+		return nil
+	}
+	var resp struct {
+		Items []accessMeta `json:"Items"`
+	}
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return nil
+	}
+	libs := make(map[string]string, len(resp.Items))
+	for _, v := range resp.Items {
+		libs[v.Id] = strings.ToLower(v.CollectionType)
+	}
+	h.libraryCache.Set(key, libs, ttlcache.DefaultTTL)
+	return libs
+}
+
+// accessMeta is the part of a backend item the access rules look at. IDs are
+// in proxy form.
+type accessMeta struct {
+	Id             string   `json:"Id"`
+	Type           string   `json:"Type"`
+	CollectionType string   `json:"CollectionType"`
+	OfficialRating string   `json:"OfficialRating"`
+	Tags           []string `json:"Tags"`
+	SeasonId       string   `json:"SeasonId"`
+	SeriesId       string   `json:"SeriesId"`
+}
+
+// allowedItems decides for each item whether rules allow it. The item index
+// supplies each item's library and the rating and tags of its season and
+// series, which episodes inherit; an item's own rating takes precedence.
+func (h *MediaHandler) allowedItems(ctx context.Context, rules access.Rules, metas []accessMeta) []bool {
+	byPrefix := make(map[string][]string)
+	for _, m := range metas {
+		for _, id := range []string{m.Id, m.SeasonId, m.SeriesId} {
+			if prefix, backendID, err := idtrans.Decode(id); err == nil {
+				byPrefix[prefix] = append(byPrefix[prefix], backendID)
+			}
+		}
+	}
+	rows := make(map[string]*ent.Item) // by proxy ID
+	for prefix, ids := range byPrefix {
+		for _, it := range h.indexedRows(ctx, prefix, ids) {
+			rows[idtrans.Encode(prefix, it.BackendItemID)] = it
+		}
+	}
+
+	out := make([]bool, len(metas))
+	for i, m := range metas {
+		prefix, _, _ := idtrans.Decode(m.Id)
+		var library, collectionType string
+		if m.Type == "CollectionFolder" {
+			library, collectionType = m.Id, m.CollectionType
+		}
+		rating, tags := m.OfficialRating, m.Tags
+		seasonID, seriesID := m.SeasonId, m.SeriesId
+		if row := rows[m.Id]; row != nil {
+			library, collectionType = idtrans.Encode(prefix, row.LibraryID), row.CollectionType
+			seasonID = fallback(seasonID, idtrans.Encode(prefix, row.SeasonID))
+			seriesID = fallback(seriesID, idtrans.Encode(prefix, row.SeriesID))
+		}
+		for _, id := range []string{m.Id, seasonID, seriesID} {
+			if row := rows[id]; row != nil && id != "" {
+				rating = fallback(rating, row.OfficialRating)
+				tags = append(tags, splitList(row.Tags)...)
+			}
+		}
+		out[i] = (library == "" || rules.LibraryAllowed(libraryIDs(library, collectionType)...)) &&
+			rules.ItemAllowed(rating, tags)
+	}
+	return out
+}
+
+// indexedRows returns the index rows of the given items on the backend with
+// prefix, together with the rows of their seasons and series.
+func (h *MediaHandler) indexedRows(ctx context.Context, prefix string, backendIDs []string) []*ent.Item {
+	query := func(ids []string) []*ent.Item {
+		items, err := h.db.Item.Query().
+			Where(
+				entitem.BackendItemIDIn(ids...),
+				entitem.HasBackendWith(entbackend.Prefix(prefix)),
+			).
+			Select(
+				entitem.FieldBackendItemID, entitem.FieldLibraryID, entitem.FieldCollectionType,
+				entitem.FieldSeasonID, entitem.FieldSeriesID,
+				entitem.FieldOfficialRating, entitem.FieldTags,
+			).
+			All(ctx)
+		if err != nil {
+			return nil
+		}
+		return items
+	}
+	rows := query(backendIDs)
+	seen := make(map[string]bool, len(rows))
+	for _, it := range rows {
+		seen[it.BackendItemID] = true
+	}
+	var parents []string
+	for _, it := range rows {
+		for _, id := range []string{it.SeasonID, it.SeriesID} {
+			if id != "" && !seen[id] {
+				seen[id] = true
+				parents = append(parents, id)
+			}
+		}
+	}
+	if len(parents) > 0 {
+		rows = append(rows, query(parents)...)
+	}
+	return rows
+}
+
+// filterItems drops the items rules hide from a list of backend items.
+func (h *MediaHandler) filterItems(ctx context.Context, rules access.Rules, items []json.RawMessage) []json.RawMessage {
+	if !filtersItems(rules) || len(items) == 0 {
+		return items
+	}
+	metas := make([]accessMeta, len(items))
+	for i, raw := range items {
+		_ = json.Unmarshal(raw, &metas[i])
+	}
+	allowed := h.allowedItems(ctx, rules, metas)
+	out := make([]json.RawMessage, 0, len(items))
+	for i, raw := range items {
+		if allowed[i] {
+			out = append(out, raw)
+		}
+	}
+	return out
+}
+
+// writeFilteredItems writes a backend list response — a paged result or a
+// bare array — without the items the caller's rules hide.
+func (h *MediaHandler) writeFilteredItems(c *gin.Context, body []byte, status int) {
+	rules := userRules(userFromCtx(c))
+	if status != http.StatusOK || !filtersItems(rules) {
+		writeJSON(c, body, status)
+		return
+	}
+	ctx := c.Request.Context()
+
+	var list []json.RawMessage
+	if err := json.Unmarshal(body, &list); err == nil {
+		c.JSON(http.StatusOK, h.filterItems(ctx, rules, list))
+		return
+	}
+	var page map[string]json.RawMessage
+	if err := json.Unmarshal(body, &page); err != nil || page["Items"] == nil {
+		writeJSON(c, body, status)
+		return
+	}
+	var items []json.RawMessage
+	if err := json.Unmarshal(page["Items"], &items); err != nil {
+		writeJSON(c, body, status)
+		return
+	}
+	kept := h.filterItems(ctx, rules, items)
+	var total int
+	_ = json.Unmarshal(page["TotalRecordCount"], &total)
+	page["Items"], _ = json.Marshal(kept)
+	page["TotalRecordCount"], _ = json.Marshal(max(total-(len(items)-len(kept)), len(kept)))
+	c.JSON(http.StatusOK, page)
+}
@@ -0,0 +1,363 @@
+package handler_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/ddevcap/jellyfin-proxy/access"
+	"github.com/ddevcap/jellyfin-proxy/api/handler"
+	"github.com/ddevcap/jellyfin-proxy/api/middleware"
+	"github.com/ddevcap/jellyfin-proxy/backend"
+	"github.com/ddevcap/jellyfin-proxy/config"
+	"github.com/ddevcap/jellyfin-proxy/ent"
+)
+
+const accessToken = "access-test-session-token"
+
+// accessMovie is a movie served by accessBackend.
+type accessMovie struct {
+	id, name, rating string
+	tags             []string
+}
+
+// newAccessBackend is a fake Jellyfin server with a kids, an adult and a
+// music library. Every movie listing returns movies in name order, honouring
+// StartIndex and Limit; like Jellyfin, Tags are only sent when requested.
+// fields records the Fields param of the last listing.
+func newAccessBackend(movies ...accessMovie) (*httptest.Server, *atomic.Value) {
+	fields := &atomic.Value{}
+	fields.Store("")
+	render := func(m accessMovie, withTags bool) string {
+		item := map[string]interface{}{
+			"Id": m.id, "Name": m.name, "SortName": strings.ToLower(m.name),
+			"Type": "Movie", "OfficialRating": m.rating,
+		}
+		if withTags {
+			item["Tags"] = m.tags
+		}
+		b, _ := json.Marshal(item)
+		return string(b)
+	}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		withTags := strings.Contains(r.URL.Query().Get("Fields"), "Tags")
+		switch {
+		case strings.HasSuffix(r.URL.Path, "/views"):
+			_, _ = fmt.Fprint(w, `{"Items":[`+
+				`{"Id":"kids","Name":"Kids","Type":"CollectionFolder","CollectionType":"movies"},`+
+				`{"Id":"adult","Name":"Films","Type":"CollectionFolder","CollectionType":"movies"},`+
+				`{"Id":"music","Name":"Music","Type":"CollectionFolder","CollectionType":"music"}]}`)
+		case r.URL.Path == "/items" || strings.HasSuffix(r.URL.Path, "/items"):
+			fields.Store(r.URL.Query().Get("Fields"))
+			start, _ := strconv.Atoi(r.URL.Query().Get("StartIndex"))
+			end := len(movies)
+			if l, err := strconv.Atoi(r.URL.Query().Get("Limit")); err == nil {
+				end = min(start+l, end)
+			}
+			start = min(start, end)
+			items := make([]string, 0, end-start)
+			for _, m := range movies[start:end] {
+				items = append(items, render(m, withTags))
+			}
+			_, _ = fmt.Fprintf(w, `{"Items":[%s],"TotalRecordCount":%d,"StartIndex":%d}`,
+				strings.Join(items, ","), len(movies), start)
+		case strings.HasSuffix(r.URL.Path, "/ancestors"):
+			// Every movie lives in the kids library.
+			id := strings.TrimSuffix(strings.TrimPrefix(r.URL.Path, "/items/"), "/ancestors")
+			for _, m := range movies {
+				if m.id == id {
+					_, _ = fmt.Fprint(w, `[{"Id":"kids","Type":"CollectionFolder","CollectionType":"movies"}]`)
+					return
+				}
+			}
+			w.WriteHeader(http.StatusNotFound)
+		case strings.HasSuffix(r.URL.Path, "/similar"):
+			items := make([]string, 0, len(movies))
+			for _, m := range movies {
+				items = append(items, render(m, withTags))
+			}
+			_, _ = fmt.Fprintf(w, `{"Items":[%s],"TotalRecordCount":%d}`, strings.Join(items, ","), len(movies))
+		case r.URL.Path == "/search/hints":
+			hints := make([]string, 0, len(movies))
+			for _, m := range movies {
+				hints = append(hints, fmt.Sprintf(`{"Id":%q,"ItemId":%q,"Name":%q,"Type":"Movie"}`, m.id, m.id, m.name))
+			}
+			_, _ = fmt.Fprintf(w, `{"SearchHints":[%s],"TotalRecordCount":%d}`, strings.Join(hints, ","), len(hints))
+		case strings.Contains(r.URL.Path, "/images/"):
+			w.Header().Set("Content-Type", "image/jpeg")
+			_, _ = fmt.Fprint(w, "jpeg-bytes")
+		default:
+			id := r.URL.Path[strings.LastIndex(r.URL.Path, "/")+1:]
+			if id == "kids" || id == "adult" || id == "music" {
+				_, _ = fmt.Fprintf(w, `{"Id":%q,"Type":"CollectionFolder"}`, id)
+				return
+			}
+			for _, m := range movies {
+				if m.id == id {
+					_, _ = fmt.Fprint(w, render(m, withTags))
+					return
+				}
+			}
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	return srv, fields
+}
+
+var _ = Describe("Access rules", func() {
+	var (
+		router *gin.Engine
+		fake   *httptest.Server
+		fields *atomic.Value
+		user   *ent.User
+		b      *ent.Backend
+	)
+
+	hdr := map[string]string{"X-Emby-Token": accessToken}
+	intp := func(v int) *int { return &v }
+
+	BeforeEach(func() {
+		cleanDB()
+		user = createUser("kid", "password1!", false)
+		createSession(user, accessToken)
+		fake, fields = newAccessBackend(
+			accessMovie{id: "m1", name: "Alpha", rating: "PG"},
+			accessMovie{id: "m2", name: "Bravo", rating: "R"},
+			accessMovie{id: "m3", name: "Charlie", rating: "PG", tags: []string{"Horror"}},
+		)
+		b = createBackend("Backend", fake.URL, "ac")
+		createBackendUser(b, user, "user-ac", "token-ac")
+
+		cfg := config.Config{ServerID: "test-server-id", ServerName: "Test Proxy"}
+		mediaH := handler.NewMediaHandler(backend.NewPool(db, cfg), cfg, db)
+		router = gin.New()
+		priv := router.Group("/")
+		priv.Use(middleware.Auth(db, cfg))
+		priv.GET("/users/:userId", mediaH.GetUser)
+		priv.GET("/users/:userId/views", mediaH.GetViews)
+		priv.GET("/users/:userId/items", mediaH.GetUserItems)
+		priv.GET("/users/:userId/items/:itemId", mediaH.GetUserItem)
+		priv.GET("/items", mediaH.GetItems)
+		priv.GET("/items/:itemId/similar", mediaH.GetSimilarItems)
+		priv.GET("/search/hints", mediaH.SearchHints)
+		router.GET("/items/:itemId/images/:imageType", mediaH.GetImage)
+	})
+
+	AfterEach(func() {
+		fake.Close()
+	})
+
+	restrict := func(rules access.Rules) {
+		user = db.User.UpdateOne(user).SetAccessRules(rules).SaveX(context.Background())
+	}
+
+	type pagedResponse struct {
+		Items []struct {
+			Id string `json:"Id"`
+		} `json:"Items"`
+		TotalRecordCount int `json:"TotalRecordCount"`
+	}
+	list := func(path string) (ids []string, total int) {
+		w := doGet(router, path, hdr)
+		Expect(w.Code).To(Equal(http.StatusOK), w.Body.String())
+		var resp pagedResponse
+		Expect(json.Unmarshal(w.Body.Bytes(), &resp)).To(Succeed())
+		for _, it := range resp.Items {
+			ids = append(ids, it.Id)
+		}
+		return ids, resp.TotalRecordCount
+	}
+	itemCode := func(id string) int {
+		return doGet(router, "/users/"+user.ID.String()+"/items/"+id, hdr).Code
+	}
+
+	It("leaves an unrestricted user alone", func() {
+		ids, _ := list("/users/" + user.ID.String() + "/views")
+		Expect(ids).To(Equal([]string{"merged_movies", "ac_music"}))
+		ids, total := list("/users/" + user.ID.String() + "/items?ParentId=ac_kids")
+		Expect(ids).To(HaveLen(3))
+		Expect(total).To(Equal(3))
+		Expect(fields.Load()).NotTo(ContainSubstring("Tags"))
+	})
+
+	Describe("libraries", func() {
+		It("hides blocked libraries from views and browsing", func() {
+			restrict(access.Rules{BlockedLibraries: []string{"ac_adult"}})
+
+			// With the adult library gone, kids is the only movies library
+			// and is no longer merged.
+			ids, _ := list("/users/" + user.ID.String() + "/views")
+			Expect(ids).To(Equal([]string{"ac_kids", "ac_music"}))
+			Expect(doGet(router, "/users/"+user.ID.String()+"/items?ParentId=ac_adult", hdr).Code).
+				To(Equal(http.StatusForbidden))
+			Expect(itemCode("ac_adult")).To(Equal(http.StatusForbidden))
+			Expect(itemCode("ac_kids")).To(Equal(http.StatusOK))
+		})
+
+		It("limits views to allowed libraries, by merged ID", func() {
+			restrict(access.Rules{AllowedLibraries: []string{"merged_movies"}})
+
+			ids, _ := list("/users/" + user.ID.String() + "/views")
+			Expect(ids).To(Equal([]string{"merged_movies"}))
+			Expect(itemCode("ac_music")).To(Equal(http.StatusForbidden))
+		})
+
+		It("hides indexed items of a blocked library", func() {
+			db.Item.Create().
+				SetBackend(b).
+				SetBackendItemID("m1").
+				SetName("Alpha").
+				SetSortName("alpha").
+				SetItemType("Movie").
+				SetLibraryID("adult").
+				SetCollectionType("movies").
+				SetData([]byte(`{}`)).
+				SaveX(context.Background())
+			restrict(access.Rules{BlockedLibraries: []string{"ac_adult"}})
+
+			Expect(itemCode("ac_m1")).To(Equal(http.StatusForbidden))
+			Expect(itemCode("ac_m2")).To(Equal(http.StatusOK))
+			ids, total := list("/items?searchTerm=a&Limit=10")
+			Expect(ids).To(Equal([]string{"ac_m2", "ac_m3"}))
+			Expect(total).To(Equal(2))
+		})
+	})
+
+	Describe("items", func() {
+		It("hides items above the parental rating limit", func() {
+			restrict(access.Rules{MaxParentalRating: intp(12)})
+
+			ids, total := list("/users/" + user.ID.String() + "/items?ParentId=ac_kids")
+			Expect(ids).To(Equal([]string{"ac_m1", "ac_m3"}))
+			Expect(total).To(Equal(2))
+			Expect(itemCode("ac_m2")).To(Equal(http.StatusForbidden))
+			Expect(itemCode("ac_m1")).To(Equal(http.StatusOK))
+		})
+
+		It("hides items with a blocked tag, asking backends for tags", func() {
+			restrict(access.Rules{BlockedTags: []string{"horror"}})
+
+			ids, total := list("/items?searchTerm=a&Limit=10")
+			Expect(ids).To(Equal([]string{"ac_m1", "ac_m2"}))
+			Expect(total).To(Equal(2))
+			Expect(fields.Load()).To(ContainSubstring("Tags"))
+			Expect(itemCode("ac_m3")).To(Equal(http.StatusForbidden))
+		})
+
+		It("inherits the rating of an episode's series from the index", func() {
+			db.Item.Create().
+				SetBackend(b).
+				SetBackendItemID("show").
+				SetName("Show").
+				SetSortName("show").
+				SetItemType("Series").
+				SetOfficialRating("TV-MA").
+				SetData([]byte(`{}`)).
+				SaveX(context.Background())
+			db.Item.Create().
+				SetBackend(b).
+				SetBackendItemID("m1").
+				SetName("Pilot").
+				SetSortName("pilot").
+				SetItemType("Episode").
+				SetSeriesID("show").
+				SetData([]byte(`{}`)).
+				SaveX(context.Background())
+			restrict(access.Rules{MaxParentalRating: intp(12)})
+
+			Expect(itemCode("ac_m1")).To(Equal(http.StatusForbidden))
+		})
+	})
+
+	Describe("other lists", func() {
+		It("filters similar items and search hints", func() {
+			restrict(access.Rules{MaxParentalRating: intp(12)})
+
+			ids, total := list("/items/ac_m1/similar")
+			Expect(ids).To(Equal([]string{"ac_m1", "ac_m3"}))
+			Expect(total).To(Equal(2))
+
+			w := doGet(router, "/search/hints?searchTerm=a", hdr)
+			Expect(w.Code).To(Equal(http.StatusOK))
+			var resp struct {
+				SearchHints []struct{ Id string }
+			}
+			Expect(json.Unmarshal(w.Body.Bytes(), &resp)).To(Succeed())
+			Expect(resp.SearchHints).To(HaveLen(2))
+			Expect(resp.SearchHints[0].Id).To(Equal("ac_m1"))
+			Expect(resp.SearchHints[1].Id).To(Equal("ac_m3"))
+		})
+
+		It("refuses images of hidden items", func() {
+			restrict(access.Rules{MaxParentalRating: intp(12)})
+
+			Expect(doGet(router, "/items/ac_m2/images/Primary", hdr).Code).To(Equal(http.StatusForbidden))
+			Expect(doGet(router, "/items/ac_m1/images/Primary", hdr).Code).To(Equal(http.StatusOK))
+		})
+	})
+
+	It("denies an unindexed item whose library cannot be determined", func() {
+		restrict(access.Rules{BlockedLibraries: []string{"ac_adult"}})
+
+		Expect(itemCode("ac_gone")).To(Equal(http.StatusForbidden))
+		Expect(itemCode("ac_m2")).To(Equal(http.StatusOK))
+	})
+
+	Describe("schedules", func() {
+		It("shows nothing outside the allowed hours", func() {
+			other := time.Now().Add(48 * time.Hour).Weekday().String()
+			restrict(access.Rules{Schedules: []access.Schedule{{DayOfWeek: other, StartHour: 0, EndHour: 24}}})
+
+			ids, total := list("/users/" + user.ID.String() + "/views")
+			Expect(ids).To(BeEmpty())
+			Expect(total).To(BeZero())
+			ids, _ = list("/items?searchTerm=a")
+			Expect(ids).To(BeEmpty())
+			Expect(itemCode("ac_m1")).To(Equal(http.StatusForbidden))
+		})
+
+		It("allows use during the allowed hours", func() {
+			restrict(access.Rules{Schedules: []access.Schedule{{DayOfWeek: access.Everyday, StartHour: 0, EndHour: 24}}})
+
+			ids, _ := list("/users/" + user.ID.String() + "/views")
+			Expect(ids).To(HaveLen(2))
+			Expect(itemCode("ac_m1")).To(Equal(http.StatusOK))
+		})
+	})
+
+	It("reports the rules in the user's policy", func() {
+		restrict(access.Rules{
+			AllowedLibraries:  []string{"ac_kids"},
+			MaxParentalRating: intp(10),
+			BlockedTags:       []string{"Horror"},
+		})
+
+		w := doGet(router, "/users/"+user.ID.String(), hdr)
+		Expect(w.Code).To(Equal(http.StatusOK))
+		var resp struct {
+			Policy struct {
+				EnableAllFolders  bool
+				EnabledFolders    []string
+				MaxParentalRating *int
+				BlockedTags       []string
+			}
+		}
+		Expect(json.Unmarshal(w.Body.Bytes(), &resp)).To(Succeed())
+		Expect(resp.Policy.EnableAllFolders).To(BeFalse())
+		Expect(resp.Policy.EnabledFolders).To(Equal([]string{"ac_kids"}))
+		Expect(*resp.Policy.MaxParentalRating).To(Equal(10))
+		Expect(resp.Policy.BlockedTags).To(Equal([]string{"Horror"}))
+	})
+})
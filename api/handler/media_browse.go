@@ -4,54 +4,51 @@ import (
 	"context"
 	"encoding/json"
 	"io"
+	"log/slog"
 	"net/http"
 	"net/url"
 	"sync"
 
+	"github.com/ddevcap/jellyfin-proxy/activity"
 	"github.com/ddevcap/jellyfin-proxy/backend"
+	"github.com/ddevcap/jellyfin-proxy/ent"
+	entuser "github.com/ddevcap/jellyfin-proxy/ent/user"
 	"github.com/ddevcap/jellyfin-proxy/idtrans"
 	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
 )
 
 // GetSeasons handles GET /Shows/:seriesId/seasons.
 func (h *MediaHandler) GetSeasons(c *gin.Context) {
 	sc, backendID, err := h.routeByID(c, c.Param("seriesId"))
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		routeError(c, err)
 		return
 	}
 
-	query := forwardQuery(c.Request.URL.Query(), sc.BackendUserID())
-	body, status, err := sc.ProxyJSON(c.Request.Context(), "GET",
-		"/shows/"+backendID+"/seasons", query, nil)
-	if err != nil {
-		gatewayError(c, err)
-		return
-	}
-	writeJSON(c, body, status)
+	h.proxyItemList(c, sc, "/shows/"+backendID+"/seasons",
+		forwardQuery(c.Request.URL.Query(), sc.BackendUserID()))
 }
 
 // GetEpisodes handles GET /Shows/:seriesId/episodes.
 func (h *MediaHandler) GetEpisodes(c *gin.Context) {
 	sc, backendID, err := h.routeByID(c, c.Param("seriesId"))
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		routeError(c, err)
 		return
 	}
 
-	query := forwardQuery(c.Request.URL.Query(), sc.BackendUserID())
-	body, status, err := sc.ProxyJSON(c.Request.Context(), "GET",
-		"/shows/"+backendID+"/episodes", query, nil)
-	if err != nil {
-		gatewayError(c, err)
-		return
-	}
-	writeJSON(c, body, status)
+	h.proxyItemList(c, sc, "/shows/"+backendID+"/episodes",
+		forwardQuery(c.Request.URL.Query(), sc.BackendUserID()))
 }
 
 // SearchHints handles GET /Search/Hints — aggregates hits across all backends.
 func (h *MediaHandler) SearchHints(c *gin.Context) {
 	user := userFromCtx(c)
+	if !allowedNow(user) {
+		c.JSON(http.StatusOK, gin.H{"SearchHints": []json.RawMessage{}, "TotalRecordCount": 0})
+		return
+	}
 	clients, err := h.pool.AllForUser(c.Request.Context(), user)
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
@@ -80,7 +77,7 @@ func (h *MediaHandler) SearchHints(c *gin.Context) {
 			if err := json.Unmarshal(body, &resp); err != nil {
 				return
 			}
-			results[i] = result{hints: resp.SearchHints}
+			results[i] = result{hints: h.filterHints(ctx, user, sc, resp.SearchHints)}
 		}(i, sc)
 	}
 	wg.Wait()
@@ -98,6 +95,29 @@ func (h *MediaHandler) SearchHints(c *gin.Context) {
 	})
 }
 
+// filterHints drops the search hints for items user may not open. Hints
+// carry neither rating nor tags, so each one is checked like an item request.
+func (h *MediaHandler) filterHints(ctx context.Context, user *ent.User, sc *backend.ServerClient, hints []json.RawMessage) []json.RawMessage {
+	if !filtersItems(userRules(user)) {
+		return hints
+	}
+	out := make([]json.RawMessage, 0, len(hints))
+	for _, raw := range hints {
+		var hint struct {
+			Id string `json:"Id"`
+		}
+		if json.Unmarshal(raw, &hint) != nil {
+			continue
+		}
+		_, backendID, err := idtrans.Decode(hint.Id)
+		if err != nil || h.checkAccess(ctx, user, sc, backendID) != nil {
+			continue
+		}
+		out = append(out, raw)
+	}
+	return out
+}
+
 // GetArtists handles GET /Artists — aggregates across all backends.
 func (h *MediaHandler) GetArtists(c *gin.Context) {
 	h.aggregatePagedItems(c, "/artists", func(sc *backend.ServerClient) url.Values {
@@ -221,33 +241,22 @@ func (h *MediaHandler) CreatePlaylist(c *gin.Context) {
 func (h *MediaHandler) GetPlaylistItems(c *gin.Context) {
 	sc, backendID, err := h.routeByID(c, c.Param("itemId"))
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
-		return
-	}
-	q := forwardQuery(c.Request.URL.Query(), sc.BackendUserID())
-	body, status, err := sc.ProxyJSON(c.Request.Context(), "GET", "/playlists/"+backendID+"/items", q, nil)
-	if err != nil {
-		gatewayError(c, err)
+		routeError(c, err)
 		return
 	}
-	writeJSON(c, body, status)
+	h.proxyItemList(c, sc, "/playlists/"+backendID+"/items",
+		forwardQuery(c.Request.URL.Query(), sc.BackendUserID()))
 }
 
 // GetCollectionItems handles GET /Collections/:itemId/Items.
 func (h *MediaHandler) GetCollectionItems(c *gin.Context) {
 	sc, backendID, err := h.routeByID(c, c.Param("itemId"))
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
-		return
-	}
-	q := forwardQuery(c.Request.URL.Query(), sc.BackendUserID())
-	body, status, err := sc.ProxyJSON(c.Request.Context(), "GET",
-		"/collections/"+backendID+"/items", q, nil)
-	if err != nil {
-		gatewayError(c, err)
+		routeError(c, err)
 		return
 	}
-	writeJSON(c, body, status)
+	h.proxyItemList(c, sc, "/collections/"+backendID+"/items",
+		forwardQuery(c.Request.URL.Query(), sc.BackendUserID()))
 }
 
 // GetNextUp handles GET /Shows/NextUp — aggregates across backends.
@@ -268,48 +277,33 @@ func (h *MediaHandler) GetUpcomingEpisodes(c *gin.Context) {
 func (h *MediaHandler) GetSimilarItems(c *gin.Context) {
 	sc, backendID, err := h.routeByID(c, c.Param("itemId"))
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
-		return
-	}
-	q := forwardQuery(c.Request.URL.Query(), sc.BackendUserID())
-	body, status, err := sc.ProxyJSON(c.Request.Context(), "GET", "/items/"+backendID+"/similar", q, nil)
-	if err != nil {
-		gatewayError(c, err)
+		routeError(c, err)
 		return
 	}
-	writeJSON(c, body, status)
+	h.proxyItemList(c, sc, "/items/"+backendID+"/similar",
+		forwardQuery(c.Request.URL.Query(), sc.BackendUserID()))
 }
 
 // GetSimilarMovies handles GET /Movies/:itemId/similar.
 func (h *MediaHandler) GetSimilarMovies(c *gin.Context) {
 	sc, backendID, err := h.routeByID(c, c.Param("itemId"))
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
-		return
-	}
-	q := forwardQuery(c.Request.URL.Query(), sc.BackendUserID())
-	body, status, err := sc.ProxyJSON(c.Request.Context(), "GET", "/movies/"+backendID+"/similar", q, nil)
-	if err != nil {
-		gatewayError(c, err)
+		routeError(c, err)
 		return
 	}
-	writeJSON(c, body, status)
+	h.proxyItemList(c, sc, "/movies/"+backendID+"/similar",
+		forwardQuery(c.Request.URL.Query(), sc.BackendUserID()))
 }
 
 // GetSimilarShows handles GET /Shows/:itemId/similar.
 func (h *MediaHandler) GetSimilarShows(c *gin.Context) {
 	sc, backendID, err := h.routeByID(c, c.Param("seriesId"))
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
-		return
-	}
-	q := forwardQuery(c.Request.URL.Query(), sc.BackendUserID())
-	body, status, err := sc.ProxyJSON(c.Request.Context(), "GET", "/shows/"+backendID+"/similar", q, nil)
-	if err != nil {
-		gatewayError(c, err)
+		routeError(c, err)
 		return
 	}
-	writeJSON(c, body, status)
+	h.proxyItemList(c, sc, "/shows/"+backendID+"/similar",
+		forwardQuery(c.Request.URL.Query(), sc.BackendUserID()))
 }
 
 // SyncPlayList handles GET /SyncPlay/List — returns empty list (not supported cross-backend).
@@ -317,11 +311,6 @@ func (h *MediaHandler) SyncPlayList(c *gin.Context) {
 	c.JSON(http.StatusOK, []interface{}{})
 }
 
-// GetSessions handles GET /Sessions — returns empty list (sessions are local to each backend).
-func (h *MediaHandler) GetSessions(c *gin.Context) {
-	c.JSON(http.StatusOK, []interface{}{})
-}
-
 // GetScheduledTasks handles GET /ScheduledTasks — returns empty list.
 func (h *MediaHandler) GetScheduledTasks(c *gin.Context) {
 	c.JSON(http.StatusOK, []interface{}{})
@@ -370,7 +359,7 @@ func (h *MediaHandler) userItemAction(c *gin.Context, method, collection string)
 	proxyItemID := c.Param("itemId")
 	sc, backendID, err := h.routeByID(c, proxyItemID)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		routeError(c, err)
 		return
 	}
 	var path string
@@ -402,18 +391,182 @@ func (h *MediaHandler) userItemAction(c *gin.Context, method, collection string)
 	}
 }
 
-// UpdateUserConfiguration handles POST /Users/:userId/Configuration.
+// UpdateUserConfiguration handles POST /Users/:userId/Configuration and
+// POST /Users/Configuration?userId=.
 // Stores the user's personal preferences (audio/subtitle language, home screen
-// order, etc.). The proxy does not persist these — clients re-send them on
-// every session start, and playback state lives on the backend servers.
+// order, etc.) so every client sees them in the user object. Users may only
+// change their own configuration unless they are an admin; without a userId
+// the caller's own is updated.
 func (h *MediaHandler) UpdateUserConfiguration(c *gin.Context) {
+	caller := userFromCtx(c)
+	targetID := caller.ID
+	if s := fallback(c.Param("userId"), queryParam(c, "userId")); s != "" {
+		id, err := uuid.Parse(s)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid user ID"})
+			return
+		}
+		targetID = id
+	}
+	if targetID != caller.ID && !caller.IsAdmin {
+		c.JSON(http.StatusForbidden, gin.H{"error": "Forbidden"})
+		return
+	}
+
+	var configuration map[string]json.RawMessage
+	if err := c.ShouldBindJSON(&configuration); err != nil || configuration == nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid request body"})
+		return
+	}
+	data, err := json.Marshal(configuration)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid request body"})
+		return
+	}
+
+	ctx := c.Request.Context()
+	if targetID != caller.ID {
+		exists, err := h.db.User.Query().Where(entuser.ID(targetID)).Exist(ctx)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to get user"})
+			return
+		}
+		if !exists {
+			c.JSON(http.StatusNotFound, gin.H{"error": "user not found"})
+			return
+		}
+	}
+	if err := h.userConfig.set(ctx, targetID, data); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to save user configuration"})
+		return
+	}
 	c.Status(http.StatusNoContent)
 }
 
+// userPolicyUpdate holds the UserPolicy fields the proxy stores and enforces.
+// Every other field the dashboard sends is ignored.
+type userPolicyUpdate struct {
+	IsAdministrator                *bool
+	IsDisabled                     *bool
+	EnableRemoteAccess             *bool
+	EnableMediaPlayback            *bool
+	EnableVideoPlaybackTranscoding *bool
+	EnableContentDownloading       *bool
+	RemoteClientBitrateLimit       *int
+	MaxParentalRating              *int
+	BlockedTags                    []string
+}
+
 // UpdateUserPolicy handles POST /Users/:userId/Policy.
-// Admin-only call to update a user's policy flags. The proxy manages its own
-// policy via the /proxy API; we acknowledge and discard to avoid 404 errors.
+// Admin-only call from the dashboard's user editor. Stores the policy fields
+// the proxy enforces; fields missing from the body keep their value. A
+// MaxParentalRating of null removes the limit. Disabling a user revokes their
+// sessions.
 func (h *MediaHandler) UpdateUserPolicy(c *gin.Context) {
+	caller := userFromCtx(c)
+	if !caller.IsAdmin {
+		c.JSON(http.StatusForbidden, gin.H{"error": "Forbidden"})
+		return
+	}
+	id, err := uuid.Parse(c.Param("userId"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid user ID"})
+		return
+	}
+
+	body, err := io.ReadAll(io.LimitReader(c.Request.Body, maxBodySize))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "could not read body"})
+		return
+	}
+	var present map[string]json.RawMessage
+	var req userPolicyUpdate
+	if json.Unmarshal(body, &present) != nil || json.Unmarshal(body, &req) != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid request body"})
+		return
+	}
+
+	ctx := c.Request.Context()
+	user, err := h.db.User.Get(ctx, id)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			c.JSON(http.StatusNotFound, gin.H{"error": "user not found"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to get user"})
+		return
+	}
+
+	isAdmin := user.IsAdmin
+	if req.IsAdministrator != nil {
+		isAdmin = *req.IsAdministrator
+	}
+	if req.IsDisabled != nil && *req.IsDisabled && isAdmin {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "administrators cannot be disabled"})
+		return
+	}
+	if user.IsAdmin && !isAdmin {
+		admins, err := h.db.User.Query().Where(entuser.IsAdmin(true)).Count(ctx)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to count administrators"})
+			return
+		}
+		if admins <= 1 {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "there must be at least one administrator"})
+			return
+		}
+	}
+
+	rules := user.AccessRules
+	if _, ok := present["MaxParentalRating"]; ok {
+		rules.MaxParentalRating = req.MaxParentalRating
+	}
+	if _, ok := present["BlockedTags"]; ok {
+		rules.BlockedTags = req.BlockedTags
+	}
+	if err := rules.Validate(); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	upd := user.Update().
+		SetIsAdmin(isAdmin).
+		SetAccessRules(rules)
+	if req.IsDisabled != nil {
+		upd.SetIsDisabled(*req.IsDisabled)
+	}
+	if req.EnableRemoteAccess != nil {
+		upd.SetEnableRemoteAccess(*req.EnableRemoteAccess)
+	}
+	if req.EnableMediaPlayback != nil {
+		upd.SetEnableMediaPlayback(*req.EnableMediaPlayback)
+	}
+	if req.EnableVideoPlaybackTranscoding != nil {
+		upd.SetEnableVideoPlaybackTranscoding(*req.EnableVideoPlaybackTranscoding)
+	}
+	if req.EnableContentDownloading != nil {
+		upd.SetEnableContentDownloading(*req.EnableContentDownloading)
+	}
+	// The dashboard sends back the limit it was shown, which for most users
+	// is BITRATE_LIMIT; only store a limit that differs from it, so users
+	// keep following the config.
+	if req.RemoteClientBitrateLimit != nil && *req.RemoteClientBitrateLimit != remoteBitrateLimit(user, h.cfg) {
+		upd.SetRemoteClientBitrateLimit(*req.RemoteClientBitrateLimit)
+	}
+	if err := upd.Exec(ctx); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to update user policy"})
+		return
+	}
+	if req.IsDisabled != nil && *req.IsDisabled {
+		if _, err := revokeUserSessions(ctx, h.db, h.hub, user.ID); err != nil {
+			slog.Warn("failed to revoke sessions of disabled user", "user", user.Username, "error", err)
+		}
+	}
+	recordAdminActivity(c, h.db, activity.Entry{
+		Name:   "Policy was changed for user " + user.Username,
+		Type:   "UserPolicyUpdated",
+		UserID: user.ID,
+	})
 	c.Status(http.StatusNoContent)
 }
 
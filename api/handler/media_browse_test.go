@@ -1,6 +1,7 @@
 package handler_test
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -12,11 +13,14 @@ import (
 
 	"github.com/gin-gonic/gin"
 
+	"github.com/ddevcap/jellyfin-proxy/access"
 	"github.com/ddevcap/jellyfin-proxy/api/handler"
 	"github.com/ddevcap/jellyfin-proxy/api/middleware"
 	"github.com/ddevcap/jellyfin-proxy/backend"
 	"github.com/ddevcap/jellyfin-proxy/config"
+	"github.com/ddevcap/jellyfin-proxy/ent"
 	"github.com/ddevcap/jellyfin-proxy/idtrans"
+	"github.com/google/uuid"
 )
 
 const (
@@ -62,7 +66,9 @@ func browseRouter() (*gin.Engine, string) {
 	priv.POST("/users/:userId/playeditems/:itemId", mediaH.MarkPlayed)
 	priv.DELETE("/users/:userId/playeditems/:itemId", mediaH.UnmarkPlayed)
 	priv.POST("/users/:userId/items/:itemId/rating", mediaH.UpdateUserItemRating)
+	priv.POST("/users/configuration", mediaH.UpdateUserConfiguration)
 	priv.POST("/users/:userId/configuration", mediaH.UpdateUserConfiguration)
+	priv.GET("/users/:userId", mediaH.GetUser)
 	priv.POST("/users/:userId/policy", mediaH.UpdateUserPolicy)
 
 	// Aggregated browse endpoints
@@ -83,7 +89,6 @@ func browseRouter() (*gin.Engine, string) {
 
 	// Static stubs
 	priv.GET("/syncplay/list", mediaH.SyncPlayList)
-	priv.GET("/sessions", mediaH.GetSessions)
 	priv.GET("/scheduledtasks", mediaH.GetScheduledTasks)
 	priv.GET("/plugins", mediaH.GetInstalledPlugins)
 	priv.GET("/notifications/summary", mediaH.GetNotificationsSummary)
@@ -347,22 +352,221 @@ var _ = Describe("Route-by-ID browse endpoints", func() {
 	// ── UpdateUserConfiguration ───────────────────────────────────────────────
 
 	Describe("UpdateUserConfiguration", func() {
-		It("returns 204 (no-op stub)", func() {
-			u := createUser("cfguser", "password1!", false)
+		var u *ent.User
+
+		BeforeEach(func() {
+			u = createUser("cfguser", "password1!", false)
 			createSession(u, browseToken)
-			w := doPost(router, "/users/ignored/configuration", map[string]interface{}{}, browseAuth())
+		})
+
+		It("stores the configuration and returns it in the user object", func() {
+			conf := map[string]interface{}{"SubtitleMode": "Always", "OrderedViews": []string{"a", "b"}}
+			w := doPost(router, "/users/"+u.ID.String()+"/configuration", conf, browseAuth())
 			Expect(w.Code).To(Equal(http.StatusNoContent))
+			Expect(db.UserConfiguration.Query().CountX(context.Background())).To(Equal(1))
+
+			// A fresh handler reads it back from the database.
+			fresh, _ := browseRouter()
+			w = doGet(fresh, "/users/"+u.ID.String(), browseAuth())
+			Expect(w.Code).To(Equal(http.StatusOK))
+			var resp struct{ Configuration map[string]interface{} }
+			Expect(json.Unmarshal(w.Body.Bytes(), &resp)).To(Succeed())
+			Expect(resp.Configuration).To(Equal(map[string]interface{}{
+				"SubtitleMode": "Always",
+				"OrderedViews": []interface{}{"a", "b"},
+			}))
+		})
+
+		It("returns the defaults when nothing is stored", func() {
+			w := doGet(router, "/users/"+u.ID.String(), browseAuth())
+			var resp struct{ Configuration map[string]interface{} }
+			Expect(json.Unmarshal(w.Body.Bytes(), &resp)).To(Succeed())
+			Expect(resp.Configuration).To(HaveKeyWithValue("SubtitleMode", "Default"))
+		})
+
+		It("lists every user with their configuration", func() {
+			other := createUser("cfgother", "password1!", false)
+			w := doPost(router, "/users/"+u.ID.String()+"/configuration", map[string]string{"SubtitleMode": "Always"}, browseAuth())
+			Expect(w.Code).To(Equal(http.StatusNoContent))
+
+			fresh, _ := browseRouter()
+			w = doGet(fresh, "/users", browseAuth())
+			Expect(w.Code).To(Equal(http.StatusOK))
+			var resp []struct {
+				Id            string
+				Configuration map[string]interface{}
+			}
+			Expect(json.Unmarshal(w.Body.Bytes(), &resp)).To(Succeed())
+			Expect(resp).To(HaveLen(2))
+			for _, user := range resp {
+				switch user.Id {
+				case u.ID.String():
+					Expect(user.Configuration).To(Equal(map[string]interface{}{"SubtitleMode": "Always"}))
+				case other.ID.String():
+					Expect(user.Configuration).To(HaveKeyWithValue("SubtitleMode", "Default"))
+				}
+			}
+		})
+
+		It("forgets the cached configuration of a deleted user", func() {
+			cfg := config.Config{ServerID: "test-server-id", ServerName: "Test Proxy"}
+			mediaH := handler.NewMediaHandler(backend.NewPool(db, cfg), cfg, db)
+			userH := handler.NewProxyUserHandler(db)
+			userH.OnUserDeleted(mediaH.ForgetUser)
+			r := gin.New()
+			r.Use(middleware.Auth(db, cfg))
+			r.GET("/users/:userId", mediaH.GetUser)
+			r.POST("/users/:userId/configuration", mediaH.UpdateUserConfiguration)
+			r.DELETE("/proxy/users/:id", userH.DeleteUser)
+
+			admin := createUser("cfgadmin", "password1!", true)
+			createSession(admin, "cfg-admin-token")
+			adminAuth := map[string]string{"X-Emby-Token": "cfg-admin-token"}
+			Expect(doPost(r, "/users/"+admin.ID.String()+"/configuration", map[string]string{}, adminAuth).Code).
+				To(Equal(http.StatusNoContent))
+			Expect(doPost(r, "/users/"+u.ID.String()+"/configuration", map[string]string{"SubtitleMode": "Always"}, browseAuth()).Code).
+				To(Equal(http.StatusNoContent))
+			Expect(doDelete(r, "/proxy/users/"+u.ID.String(), adminAuth).Code).To(Equal(http.StatusNoContent))
+
+			// A user created again under the same ID starts from the defaults.
+			db.User.Create().SetID(u.ID).SetUsername("cfguser").SetDisplayName("cfguser").
+				SetHashedPassword(u.HashedPassword).ExecX(context.Background())
+			createSession(u, "cfg-user-token")
+			w := doGet(r, "/users/"+u.ID.String(), map[string]string{"X-Emby-Token": "cfg-user-token"})
+			Expect(w.Code).To(Equal(http.StatusOK))
+			var resp struct{ Configuration map[string]interface{} }
+			Expect(json.Unmarshal(w.Body.Bytes(), &resp)).To(Succeed())
+			Expect(resp.Configuration).To(HaveKeyWithValue("SubtitleMode", "Default"))
+		})
+
+		It("accepts the userId query param", func() {
+			w := doPost(router, "/users/configuration?userId="+u.ID.String(), map[string]string{"SubtitleMode": "None"}, browseAuth())
+			Expect(w.Code).To(Equal(http.StatusNoContent))
+			Expect(db.UserConfiguration.Query().CountX(context.Background())).To(Equal(1))
+		})
+
+		It("forbids changing another user's configuration", func() {
+			other := createUser("cfgother", "password1!", false)
+			w := doPost(router, "/users/"+other.ID.String()+"/configuration", map[string]string{}, browseAuth())
+			Expect(w.Code).To(Equal(http.StatusForbidden))
+		})
+
+		It("rejects an invalid body", func() {
+			w := doPost(router, "/users/"+u.ID.String()+"/configuration", []string{"x"}, browseAuth())
+			Expect(w.Code).To(Equal(http.StatusBadRequest))
 		})
 	})
 
 	// ── UpdateUserPolicy ──────────────────────────────────────────────────────
 
 	Describe("UpdateUserPolicy", func() {
-		It("returns 204 (no-op stub)", func() {
-			u := createUser("poluser", "password1!", false)
-			createSession(u, browseToken)
-			w := doPost(router, "/users/ignored/policy", map[string]interface{}{}, browseAuth())
+		var admin, u *ent.User
+
+		BeforeEach(func() {
+			admin = createUser("poladmin", "password1!", true)
+			createSession(admin, browseToken)
+			u = createUser("poluser", "password1!", false)
+		})
+
+		policyOf := func(id string) map[string]interface{} {
+			w := doGet(router, "/users/"+id, browseAuth())
+			Expect(w.Code).To(Equal(http.StatusOK))
+			var resp struct{ Policy map[string]interface{} }
+			Expect(json.Unmarshal(w.Body.Bytes(), &resp)).To(Succeed())
+			return resp.Policy
+		}
+
+		It("stores the enforced fields and reports them in the user's policy", func() {
+			w := doPost(router, "/users/"+u.ID.String()+"/policy", map[string]interface{}{
+				"IsAdministrator":                false,
+				"IsDisabled":                     true,
+				"EnableRemoteAccess":             false,
+				"EnableMediaPlayback":            false,
+				"EnableVideoPlaybackTranscoding": false,
+				"EnableContentDownloading":       false,
+				"RemoteClientBitrateLimit":       4000000,
+				"MaxParentalRating":              13,
+				"BlockedTags":                    []string{"Horror"},
+				"EnableLiveTvAccess":             false,
+			}, browseAuth())
+			Expect(w.Code).To(Equal(http.StatusNoContent), w.Body.String())
+
+			policy := policyOf(u.ID.String())
+			Expect(policy).To(HaveKeyWithValue("IsDisabled", true))
+			Expect(policy).To(HaveKeyWithValue("EnableRemoteAccess", false))
+			Expect(policy).To(HaveKeyWithValue("EnableMediaPlayback", false))
+			Expect(policy).To(HaveKeyWithValue("EnableVideoPlaybackTranscoding", false))
+			Expect(policy).To(HaveKeyWithValue("EnableContentDownloading", false))
+			Expect(policy).To(HaveKeyWithValue("RemoteClientBitrateLimit", BeNumerically("==", 4000000)))
+			Expect(policy).To(HaveKeyWithValue("MaxParentalRating", BeNumerically("==", 13)))
+			Expect(policy).To(HaveKeyWithValue("BlockedTags", []interface{}{"Horror"}))
+			// Fields the proxy does not enforce keep their fixed value.
+			Expect(policy).To(HaveKeyWithValue("EnableLiveTvAccess", true))
+		})
+
+		It("keeps fields missing from the body and clears a null rating", func() {
+			rating := 10
+			db.User.UpdateOne(u).
+				SetEnableContentDownloading(false).
+				SetAccessRules(access.Rules{MaxParentalRating: &rating, BlockedLibraries: []string{"bw_x"}}).
+				ExecX(context.Background())
+
+			w := doPost(router, "/users/"+u.ID.String()+"/policy",
+				map[string]interface{}{"MaxParentalRating": nil}, browseAuth())
+			Expect(w.Code).To(Equal(http.StatusNoContent))
+
+			stored := db.User.GetX(context.Background(), u.ID)
+			Expect(stored.EnableContentDownloading).To(BeFalse())
+			Expect(stored.AccessRules.MaxParentalRating).To(BeNil())
+			Expect(stored.AccessRules.BlockedLibraries).To(Equal([]string{"bw_x"}))
+		})
+
+		It("does not pin the configured bitrate limit", func() {
+			w := doPost(router, "/users/"+u.ID.String()+"/policy",
+				map[string]interface{}{"RemoteClientBitrateLimit": 0}, browseAuth())
 			Expect(w.Code).To(Equal(http.StatusNoContent))
+			Expect(db.User.GetX(context.Background(), u.ID).RemoteClientBitrateLimit).To(BeNil())
+		})
+
+		It("promotes a user to administrator", func() {
+			w := doPost(router, "/users/"+u.ID.String()+"/policy",
+				map[string]interface{}{"IsAdministrator": true}, browseAuth())
+			Expect(w.Code).To(Equal(http.StatusNoContent))
+			Expect(db.User.GetX(context.Background(), u.ID).IsAdmin).To(BeTrue())
+		})
+
+		It("revokes the sessions of a user it disables", func() {
+			createSession(u, "poluser-token")
+			w := doPost(router, "/users/"+u.ID.String()+"/policy",
+				map[string]interface{}{"IsDisabled": true}, browseAuth())
+			Expect(w.Code).To(Equal(http.StatusNoContent))
+			Expect(db.Session.Query().CountX(context.Background())).To(Equal(1)) // the admin's
+		})
+
+		It("refuses to disable an administrator", func() {
+			w := doPost(router, "/users/"+admin.ID.String()+"/policy",
+				map[string]interface{}{"IsDisabled": true}, browseAuth())
+			Expect(w.Code).To(Equal(http.StatusBadRequest))
+		})
+
+		It("refuses to demote the last administrator", func() {
+			w := doPost(router, "/users/"+admin.ID.String()+"/policy",
+				map[string]interface{}{"IsAdministrator": false}, browseAuth())
+			Expect(w.Code).To(Equal(http.StatusBadRequest))
+			Expect(db.User.GetX(context.Background(), admin.ID).IsAdmin).To(BeTrue())
+		})
+
+		It("returns 404 for an unknown user", func() {
+			w := doPost(router, "/users/"+uuid.NewString()+"/policy", map[string]interface{}{}, browseAuth())
+			Expect(w.Code).To(Equal(http.StatusNotFound))
+		})
+
+		It("is admin-only", func() {
+			createSession(u, "poluser-token")
+			w := doPost(router, "/users/"+u.ID.String()+"/policy",
+				map[string]interface{}{"IsAdministrator": true}, map[string]string{"X-Emby-Token": "poluser-token"})
+			Expect(w.Code).To(Equal(http.StatusForbidden))
+			Expect(db.User.GetX(context.Background(), u.ID).IsAdmin).To(BeFalse())
 		})
 	})
 })
@@ -546,16 +750,6 @@ var _ = Describe("Static stub endpoints", func() {
 		})
 	})
 
-	Describe("GetSessions", func() {
-		It("returns 200 with an empty array", func() {
-			w := doGet(router, "/sessions", browseAuth())
-			Expect(w.Code).To(Equal(http.StatusOK))
-			var resp []interface{}
-			Expect(json.Unmarshal(w.Body.Bytes(), &resp)).To(Succeed())
-			Expect(resp).To(BeEmpty())
-		})
-	})
-
 	Describe("GetScheduledTasks", func() {
 		It("returns 200 with an empty array", func() {
 			w := doGet(router, "/scheduledtasks", browseAuth())
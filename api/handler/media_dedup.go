@@ -0,0 +1,286 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/ddevcap/jellyfin-proxy/backend"
+	"github.com/ddevcap/jellyfin-proxy/ent"
+	"github.com/ddevcap/jellyfin-proxy/ent/duplicateitem"
+	"github.com/ddevcap/jellyfin-proxy/idtrans"
+	"github.com/gin-gonic/gin"
+	"github.com/jellydator/ttlcache/v3"
+)
+
+// dupCacheTTL is how long a dup_ ID stays in memory after the title was last
+// seen in a merged list or requested by a client.
+const dupCacheTTL = 7 * 24 * time.Hour
+
+// newDupCache creates the cache mapping virtual dup_ IDs to the proxy IDs of
+// every backend copy, in precedence order. The mapping is stored as a
+// DuplicateItem row, so IDs kept in a client's favorites or resume list keep
+// working after a restart or a long time unlisted; the cache loads rows on a
+// miss and remembers IDs that have none as an empty list.
+func newDupCache(db *ent.Client) *ttlcache.Cache[string, []string] {
+	loader := ttlcache.LoaderFunc[string, []string](
+		func(c *ttlcache.Cache[string, []string], dupID string) *ttlcache.Item[string, []string] {
+			row, err := db.DuplicateItem.Query().
+				Where(duplicateitem.DupID(dupID)).
+				Only(context.Background())
+			switch {
+			case ent.IsNotFound(err):
+				return c.Set(dupID, nil, ttlcache.DefaultTTL)
+			case err != nil:
+				slog.Warn("dedup: loading duplicate item", "id", dupID, "error", err)
+				return nil
+			}
+			return c.Set(dupID, row.Copies, ttlcache.DefaultTTL)
+		},
+	)
+	cache := ttlcache.New[string, []string](
+		ttlcache.WithTTL[string, []string](dupCacheTTL),
+		ttlcache.WithLoader[string, []string](loader),
+	)
+	go cache.Start()
+	return cache
+}
+
+// dupCopies returns the proxy IDs of every copy of the title dupID, or nil
+// when the proxy has never seen it on several backends.
+func (h *MediaHandler) dupCopies(dupID string) []string {
+	if item := h.dupCache.Get(dupID); item != nil {
+		return item.Value()
+	}
+	return nil
+}
+
+// rememberDup stores where the copies of dupID live, writing the
+// DuplicateItem row only when they changed.
+func (h *MediaHandler) rememberDup(ctx context.Context, dupID string, ids []string) {
+	if slices.Equal(h.dupCopies(dupID), ids) {
+		return
+	}
+	h.dupCache.Set(dupID, ids, ttlcache.DefaultTTL)
+	n, err := h.db.DuplicateItem.Update().
+		Where(duplicateitem.DupID(dupID)).
+		SetCopies(ids).
+		Save(ctx)
+	if err == nil && n == 0 {
+		err = h.db.DuplicateItem.Create().
+			SetDupID(dupID).
+			SetCopies(ids).
+			Exec(ctx)
+		if ent.IsConstraintError(err) {
+			err = nil // stored concurrently by another request
+		}
+	}
+	if err != nil {
+		slog.Warn("dedup: storing duplicate item", "id", dupID, "error", err)
+	}
+}
+
+// providerIDKeys lists the external metadata providers used to recognise the
+// same title on different backends, most reliable first.
+var providerIDKeys = []string{"Tmdb", "Imdb", "Tvdb"}
+
+// preferredProviderID returns the first provider ID from providerIDKeys that
+// is set on an item, or empty strings when the item has none of them.
+func preferredProviderID(ids map[string]string) (provider, value string) {
+	for _, k := range providerIDKeys {
+		if v := ids[k]; v != "" {
+			return k, v
+		}
+	}
+	return "", ""
+}
+
+// providerMatchKeys returns one lookup key per known provider ID of an item.
+// The item type is part of the key because providers such as TMDB number
+// movies and series independently.
+func providerMatchKeys(itemType string, ids map[string]string) []string {
+	var keys []string
+	for _, k := range providerIDKeys {
+		if v := ids[k]; v != "" {
+			keys = append(keys, itemType+"|"+k+"|"+strings.ToLower(v))
+		}
+	}
+	return keys
+}
+
+// dedupCopy is the subset of an item needed to detect and merge duplicates.
+type dedupCopy struct {
+	Id           string            `json:"Id"`
+	Type         string            `json:"Type"`
+	MediaType    string            `json:"MediaType"`
+	BackendName  string            `json:"BackendName"`
+	ProviderIds  map[string]string `json:"ProviderIds"`
+	MediaSources []json.RawMessage `json:"MediaSources"`
+}
+
+// markDuplicate gives the surviving copy of a title the virtual dup_ ID for
+// key and remembers where its copies live. A title seen on only one backend
+// keeps its own ID unless it is already known as a duplicate, so it doesn't
+// change identity while its other copies are offline.
+func (h *MediaHandler) markDuplicate(ctx context.Context, raw json.RawMessage, key string, copies []dedupCopy) json.RawMessage {
+	dupID := idtrans.EncodeDup(key)
+	if len(copies) < 2 {
+		if len(h.dupCopies(dupID)) > 0 {
+			return mergeCopies(raw, dupID, copies)
+		}
+		return raw
+	}
+	ids := make([]string, len(copies))
+	for i, cp := range copies {
+		ids[i] = cp.Id
+	}
+	h.rememberDup(ctx, dupID, ids)
+	return mergeCopies(raw, dupID, copies)
+}
+
+// mergeCopies gives the surviving item the virtual dupID and, for playable
+// items, replaces its MediaSources with the sources of every copy. A copy that
+// was returned without MediaSources (the client did not request that field)
+// contributes a minimal source whose Id is the copy's proxy-prefixed item ID.
+func mergeCopies(raw json.RawMessage, dupID string, copies []dedupCopy) json.RawMessage {
+	var obj map[string]json.RawMessage
+	if err := json.Unmarshal(raw, &obj); err != nil {
+		return raw
+	}
+	idJSON, err := json.Marshal(dupID)
+	if err != nil {
+		return raw
+	}
+	obj["Id"] = idJSON
+
+	if mt := copies[0].MediaType; mt == "Video" || mt == "Audio" {
+		if sources := copiesAsMediaSources(copies); sources != nil {
+			obj["MediaSources"] = sources
+		}
+	}
+
+	merged, err := json.Marshal(obj)
+	if err != nil {
+		return raw
+	}
+	return merged
+}
+
+// copiesAsMediaSources returns the JSON array of media sources across all
+// copies of a title, or nil if it cannot be encoded.
+func copiesAsMediaSources(copies []dedupCopy) json.RawMessage {
+	var sources []json.RawMessage
+	for _, cp := range copies {
+		if len(cp.MediaSources) > 0 {
+			sources = append(sources, cp.MediaSources...)
+			continue
+		}
+		src, err := json.Marshal(map[string]string{
+			"Id":       cp.Id,
+			"Name":     cp.BackendName,
+			"Protocol": "File",
+			"Type":     "Default",
+		})
+		if err != nil {
+			continue
+		}
+		sources = append(sources, src)
+	}
+	b, err := json.Marshal(sources)
+	if err != nil {
+		return nil
+	}
+	return b
+}
+
+// routeDup resolves a virtual dup_ ID to the first backend copy that the
+// health checker considers reachable. clientFor builds the backend client for
+// a copy's prefix. When every copy is offline the first one is returned so
+// the request fails with the backend's error rather than a lookup error.
+func (h *MediaHandler) routeDup(
+	dupID string,
+	clientFor func(prefix string) (*backend.ServerClient, error),
+) (*backend.ServerClient, string, error) {
+	copies := h.dupCopies(dupID)
+	if len(copies) == 0 {
+		return nil, "", fmt.Errorf("unknown item %q", dupID)
+	}
+
+	var fallback *backend.ServerClient
+	var fallbackID string
+	for _, copyID := range copies {
+		prefix, backendID, err := idtrans.Decode(copyID)
+		if err != nil {
+			continue
+		}
+		sc, err := clientFor(prefix)
+		if err != nil {
+			continue // backend disabled or removed
+		}
+		if sc.Available() {
+			return sc, backendID, nil
+		}
+		if fallback == nil {
+			fallback, fallbackID = sc, backendID
+		}
+	}
+	if fallback == nil {
+		return nil, "", fmt.Errorf("no backend holds item %q", dupID)
+	}
+	return fallback, fallbackID, nil
+}
+
+// resolveDupQuery replaces a virtual dup_ ID in the parentid or ids query
+// params with the proxy ID of a reachable copy, so that prefix-based routing
+// and forwardQuery see an ordinary backend ID (e.g. when browsing the seasons
+// of a series that exists on several backends).
+func (h *MediaHandler) resolveDupQuery(c *gin.Context) {
+	query := c.Request.URL.Query()
+	changed := false
+	for key, vals := range query {
+		switch strings.ToLower(key) {
+		case "parentid", "ids":
+		default:
+			continue
+		}
+		for i, v := range vals {
+			parts := strings.Split(v, ",")
+			for j, part := range parts {
+				part = strings.TrimSpace(part)
+				if !idtrans.IsDup(part) {
+					continue
+				}
+				sc, backendID, err := h.routeDup(part, func(prefix string) (*backend.ServerClient, error) {
+					return h.pool.ForUser(c.Request.Context(), prefix, userFromCtx(c))
+				})
+				if err != nil {
+					continue
+				}
+				parts[j] = idtrans.Encode(sc.Prefix(), backendID)
+				changed = true
+			}
+			vals[i] = strings.Join(parts, ",")
+		}
+	}
+	if changed {
+		c.Request.URL.RawQuery = query.Encode()
+	}
+}
+
+// withField appends field to a comma-separated Jellyfin Fields list unless it
+// is already present.
+func withField(fields, field string) string {
+	if fields == "" {
+		return field
+	}
+	for _, f := range strings.Split(fields, ",") {
+		if strings.EqualFold(strings.TrimSpace(f), field) {
+			return fields
+		}
+	}
+	return fields + "," + field
+}
@@ -0,0 +1,686 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/ddevcap/jellyfin-proxy/access"
+	"github.com/ddevcap/jellyfin-proxy/backend"
+	"github.com/ddevcap/jellyfin-proxy/ent"
+	entbackend "github.com/ddevcap/jellyfin-proxy/ent/backend"
+	entitem "github.com/ddevcap/jellyfin-proxy/ent/item"
+	"github.com/ddevcap/jellyfin-proxy/ent/predicate"
+	"github.com/ddevcap/jellyfin-proxy/idtrans"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// ── Item index lookups ────────────────────────────────────────────────────────
+//
+// Merged-library listings, counts and filters are answered from the Item
+// table maintained by backend.Indexer once every backend the user can reach
+// has been synced. Only the items on the requested page are fetched live, to
+// pick up the caller's own UserData and requested Fields. Anything the index
+// cannot answer falls back to the fan-out in aggregatePagedItems.
+
+// indexListParams are the query params (lower-cased) an indexed listing
+// evaluates or may safely ignore. Any other non-empty param — IsPlayed,
+// Filters, SearchTerm, PersonIds and the like — depends on per-user state or
+// on metadata the index does not keep.
+var indexListParams = map[string]bool{
+	"userid": true, "apikey": true, "api_key": true,
+	"parentid": true, "includeitemtypes": true, "recursive": true,
+	"startindex": true, "limit": true, "sortby": true, "sortorder": true,
+	"fields": true, "enableimages": true, "imagetypelimit": true,
+	"enableimagetypes": true, "enableuserdata": true, "enabletotalrecordcount": true,
+	"genres": true, "tags": true, "years": true, "officialratings": true, "namestartswith": true,
+}
+
+// indexFilterParams are the params an indexed Filters2 lookup accepts.
+var indexFilterParams = map[string]bool{
+	"userid": true, "apikey": true, "api_key": true,
+	"parentid": true, "includeitemtypes": true, "recursive": true,
+}
+
+// indexCountParams are the params an indexed Counts lookup accepts.
+var indexCountParams = map[string]bool{
+	"userid": true, "apikey": true, "api_key": true,
+}
+
+// indexSortColumns maps Jellyfin SortBy keys (lower-cased) to Item columns.
+var indexSortColumns = map[string]string{
+	"sortname":        entitem.FieldSortName,
+	"name":            entitem.FieldName,
+	"datecreated":     entitem.FieldDateCreated,
+	"premieredate":    entitem.FieldPremiereDate,
+	"communityrating": entitem.FieldCommunityRating,
+	"productionyear":  entitem.FieldProductionYear,
+}
+
+// indexCountKeys maps indexed item types to their key in an /Items/Counts response.
+var indexCountKeys = map[string]string{
+	"Movie":       "MovieCount",
+	"Series":      "SeriesCount",
+	"Episode":     "EpisodeCount",
+	"MusicArtist": "ArtistCount",
+	"Trailer":     "TrailerCount",
+	"Audio":       "SongCount",
+	"MusicAlbum":  "AlbumCount",
+	"MusicVideo":  "MusicVideoCount",
+	"BoxSet":      "BoxSetCount",
+	"Book":        "BookCount",
+}
+
+// livePageParams are the presentation params forwarded when the items of an
+// indexed page are fetched live.
+var livePageParams = []string{
+	"Fields", "EnableImages", "ImageTypeLimit", "EnableImageTypes", "EnableUserData",
+}
+
+// onlyQueryParams reports whether every non-empty query param is in allowed.
+func onlyQueryParams(c *gin.Context, allowed map[string]bool) bool {
+	for k, vals := range c.Request.URL.Query() {
+		if allowed[strings.ToLower(k)] {
+			continue
+		}
+		for _, v := range vals {
+			if v != "" {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// indexedClients returns the caller's backends, ordered by prefix, when the
+// index is enabled, covers itemType (if set) and has synced every one of
+// them. ok is false when the request has to fan out instead.
+func (h *MediaHandler) indexedClients(c *gin.Context, itemType string) ([]*backend.ServerClient, bool) {
+	ix := h.pool.GetIndexer()
+	if ix == nil || (itemType != "" && !ix.Covers(itemType)) {
+		return nil, false
+	}
+	clients, err := h.pool.AllForUser(c.Request.Context(), userFromCtx(c))
+	if err != nil || len(clients) == 0 {
+		return nil, false
+	}
+	ids := make([]string, len(clients))
+	for i, sc := range clients {
+		ids[i] = sc.BackendID()
+	}
+	if !ix.Ready(ids...) {
+		return nil, false
+	}
+	sort.Slice(clients, func(i, j int) bool { return clients[i].Prefix() < clients[j].Prefix() })
+	return clients, true
+}
+
+// clientPrefixes returns the prefixes of clients.
+func clientPrefixes(clients []*backend.ServerClient) []string {
+	prefixes := make([]string, len(clients))
+	for i, sc := range clients {
+		prefixes[i] = sc.Prefix()
+	}
+	return prefixes
+}
+
+// serveIndexedItems answers a merged-library listing from the item index.
+// It returns false without writing a response when the index cannot be used.
+func (h *MediaHandler) serveIndexedItems(c *gin.Context, collectionType string) bool {
+	itemType := collectionTypeToItemType(collectionType)
+	if itemType == "" || !onlyQueryParams(c, indexListParams) {
+		return false
+	}
+	order, ok := indexOrder(queryParam(c, "sortby"), queryParam(c, "sortorder"))
+	if !ok {
+		return false
+	}
+	clients, ok := h.indexedClients(c, itemType)
+	if !ok {
+		return false
+	}
+	ctx := c.Request.Context()
+	rules := userRules(userFromCtx(c))
+	if !rules.Allows(time.Now()) {
+		c.JSON(http.StatusOK, emptyPagedList())
+		return true
+	}
+	visible, ok := h.indexedVisibility(ctx, clients, rules)
+	if !ok {
+		return false
+	}
+
+	q := h.db.Item.Query().
+		Where(entitem.ItemTypeEqualFold(itemType)).
+		Where(visible...)
+	if years := splitInts(queryParam(c, "years")); len(years) > 0 {
+		q.Where(entitem.ProductionYearIn(years...))
+	}
+	if ratings := splitList(queryParam(c, "officialratings")); len(ratings) > 0 {
+		q.Where(entitem.OfficialRatingIn(ratings...))
+	}
+	if genres := splitList(queryParam(c, "genres")); len(genres) > 0 {
+		q.Where(listHasAny(entitem.FieldGenres, genres))
+	}
+	if tags := splitList(queryParam(c, "tags")); len(tags) > 0 {
+		q.Where(listHasAny(entitem.FieldTags, tags))
+	}
+	if prefix := queryParam(c, "namestartswith"); prefix != "" {
+		q.Where(nameHasPrefix(prefix))
+	}
+	// Only the columns needed to group duplicates and fetch the page are
+	// loaded; the items themselves are fetched live later.
+	items, err := q.
+		Order(order...).
+		WithBackend().
+		Select(
+			entitem.FieldBackendItemID, entitem.FieldItemType, entitem.FieldMediaType,
+			entitem.FieldTmdbID, entitem.FieldImdbID, entitem.FieldTvdbID,
+		).
+		All(ctx)
+	if err != nil {
+		return false
+	}
+
+	survivors, groups := groupIndexedItems(items)
+	totalCount := len(survivors)
+	startIndex, end := pageBounds(c, totalCount)
+	page := survivors[startIndex:end]
+
+	raws, err := h.indexedPageItems(c, clients, page)
+	if err != nil {
+		gatewayError(c, err)
+		return true
+	}
+	out := make([]json.RawMessage, 0, len(page))
+	for i, it := range page {
+		raw := raws[i]
+		if raw == nil {
+			// The caller's own backend account does not see the item.
+			totalCount--
+			continue
+		}
+		if g := groups[it.ID]; g != nil {
+			raw = h.markDuplicate(ctx, raw, g.key, indexedCopies(raw, g.items))
+		}
+		out = append(out, raw)
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"Items":            out,
+		"TotalRecordCount": totalCount,
+		"StartIndex":       startIndex,
+	})
+	return true
+}
+
+// indexOrder translates Jellyfin's SortBy/SortOrder into index ordering,
+// defaulting to SortName, pairing the keys with SortOrder like parseSort.
+// ok is false when a key cannot be sorted by from the index.
+func indexOrder(sortBy, sortOrder string) (order []entitem.OrderOption, ok bool) {
+	terms := parseSort(sortBy, sortOrder)
+	if len(terms) == 0 {
+		terms = []sortTerm{{key: "sortname"}}
+	}
+	for _, t := range terms {
+		col, known := indexSortColumns[t.key]
+		if !known {
+			return nil, false
+		}
+		if t.desc {
+			order = append(order, ent.Desc(col))
+		} else {
+			order = append(order, ent.Asc(col))
+		}
+	}
+	// Tie-break so that paging is stable.
+	order = append(order, ent.Asc(entitem.FieldSortName, entitem.FieldID))
+	return order, true
+}
+
+// indexedVisibility returns the predicates that limit index rows to what
+// the caller may see. The index is built with the widest account on each
+// backend, so rows are first limited to the libraries the caller's own
+// backend accounts list, then narrowed by their access rules. ok is false
+// when a backend's libraries cannot be read.
+func (h *MediaHandler) indexedVisibility(ctx context.Context, clients []*backend.ServerClient, rules access.Rules) ([]predicate.Item, bool) {
+	var perBackend []predicate.Item
+	for _, sc := range clients {
+		libs := h.backendLibraries(ctx, sc)
+		if libs == nil {
+			return nil, false
+		}
+		var libIDs []string
+		for proxyID, ct := range libs {
+			_, backendID, err := idtrans.Decode(proxyID)
+			if err != nil || !rules.LibraryAllowed(libraryIDs(proxyID, ct)...) {
+				continue
+			}
+			libIDs = append(libIDs, backendID)
+		}
+		if len(libIDs) == 0 {
+			continue
+		}
+		perBackend = append(perBackend, entitem.And(
+			entitem.HasBackendWith(entbackend.Prefix(sc.Prefix())),
+			entitem.LibraryIDIn(libIDs...),
+		))
+	}
+	if len(perBackend) == 0 {
+		return []predicate.Item{entitem.IDIn()}, true // nothing is visible
+	}
+	preds := []predicate.Item{entitem.Or(perBackend...)}
+
+	if len(rules.BlockedTags) > 0 {
+		preds = append(preds, entitem.Not(listHasAny(entitem.FieldTags, rules.BlockedTags)))
+	}
+	if rules.MaxParentalRating != nil {
+		ratings, err := h.db.Item.Query().
+			Where(entitem.HasBackendWith(entbackend.PrefixIn(clientPrefixes(clients)...))).
+			GroupBy(entitem.FieldOfficialRating).
+			Strings(ctx)
+		if err != nil {
+			return nil, false
+		}
+		var blocked []string
+		for _, r := range ratings {
+			if !rules.ItemAllowed(r, nil) {
+				blocked = append(blocked, r)
+			}
+		}
+		if len(blocked) > 0 {
+			preds = append(preds, entitem.OfficialRatingNotIn(blocked...))
+		}
+	}
+	return preds, true
+}
+
+// listHasAny matches rows whose "|"-joined column holds any of values,
+// ignoring case.
+func listHasAny(column string, values []string) predicate.Item {
+	return func(s *sql.Selector) {
+		preds := make([]*sql.Predicate, len(values))
+		for i, v := range values {
+			pattern := "%|" + likeEscape(strings.ToLower(v)) + "|%"
+			preds[i] = sql.P(func(b *sql.Builder) {
+				b.WriteString("('|' || LOWER(" + s.C(column) + ") || '|') LIKE ").
+					Arg(pattern).
+					WriteString(` ESCAPE '\'`)
+			})
+		}
+		s.Where(sql.Or(preds...))
+	}
+}
+
+// nameHasPrefix matches rows whose sort name, or name when it has none,
+// starts with prefix, ignoring case.
+func nameHasPrefix(prefix string) predicate.Item {
+	return func(s *sql.Selector) {
+		pattern := likeEscape(strings.ToLower(prefix)) + "%"
+		s.Where(sql.P(func(b *sql.Builder) {
+			b.WriteString("LOWER(CASE WHEN " + s.C(entitem.FieldSortName) + " = '' THEN " +
+				s.C(entitem.FieldName) + " ELSE " + s.C(entitem.FieldSortName) + " END) LIKE ").
+				Arg(pattern).
+				WriteString(` ESCAPE '\'`)
+		}))
+	}
+}
+
+// likeEscape escapes the LIKE wildcards in s.
+func likeEscape(s string) string {
+	return strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`).Replace(s)
+}
+
+// indexGroup is one title found on several backends.
+type indexGroup struct {
+	key   string // first match key of the surviving copy
+	items []*ent.Item
+}
+
+// groupIndexedItems applies the same duplicate detection as the fan-out merge to
+// index rows. Copies are grouped in backend-prefix order, so the copy from
+// the alphabetically first prefix survives and keeps its place in items.
+// The returned map is keyed by the surviving row's ID.
+func groupIndexedItems(items []*ent.Item) ([]*ent.Item, map[uuid.UUID]*indexGroup) {
+	byPrefix := make([]*ent.Item, len(items))
+	copy(byPrefix, items)
+	sort.SliceStable(byPrefix, func(i, j int) bool {
+		return itemPrefix(byPrefix[i]) < itemPrefix(byPrefix[j])
+	})
+
+	byKey := make(map[string]*indexGroup)
+	groups := make(map[uuid.UUID]*indexGroup)
+	dropped := make(map[uuid.UUID]bool)
+	for _, it := range byPrefix {
+		keys := providerMatchKeys(it.ItemType, indexedProviderIDs(it))
+		if len(keys) == 0 {
+			continue
+		}
+		var g *indexGroup
+		for _, k := range keys {
+			if g = byKey[k]; g != nil {
+				break
+			}
+		}
+		if g == nil {
+			g = &indexGroup{key: keys[0]}
+			groups[it.ID] = g
+		} else {
+			dropped[it.ID] = true
+		}
+		g.items = append(g.items, it)
+		for _, k := range keys {
+			if _, ok := byKey[k]; !ok {
+				byKey[k] = g
+			}
+		}
+	}
+
+	survivors := make([]*ent.Item, 0, len(items))
+	for _, it := range items {
+		if !dropped[it.ID] {
+			survivors = append(survivors, it)
+		}
+	}
+	return survivors, groups
+}
+
+// indexedProviderIDs returns the provider IDs stored for an item in the shape
+// of a Jellyfin ProviderIds object.
+func indexedProviderIDs(it *ent.Item) map[string]string {
+	ids := make(map[string]string, 3)
+	for provider, v := range map[string]string{"Tmdb": it.TmdbID, "Imdb": it.ImdbID, "Tvdb": it.TvdbID} {
+		if v != "" {
+			ids[provider] = v
+		}
+	}
+	return ids
+}
+
+// itemPrefix returns the prefix of the backend an indexed item belongs to.
+func itemPrefix(it *ent.Item) string {
+	if it.Edges.Backend == nil {
+		return ""
+	}
+	return it.Edges.Backend.Prefix
+}
+
+// indexedProxyID returns the client-facing ID of an indexed item.
+func indexedProxyID(it *ent.Item) string {
+	return idtrans.Encode(itemPrefix(it), it.BackendItemID)
+}
+
+// indexedCopies describes every copy of a duplicated title for markDuplicate.
+// The surviving copy is read from raw so its MediaSources are kept; the other
+// copies are described from their index rows.
+func indexedCopies(raw json.RawMessage, items []*ent.Item) []dedupCopy {
+	copies := make([]dedupCopy, len(items))
+	for i, it := range items {
+		if i == 0 && json.Unmarshal(raw, &copies[0]) == nil {
+			continue
+		}
+		name := ""
+		if it.Edges.Backend != nil {
+			name = it.Edges.Backend.Name
+		}
+		copies[i] = dedupCopy{
+			Id:          indexedProxyID(it),
+			Type:        it.ItemType,
+			MediaType:   it.MediaType,
+			BackendName: name,
+			ProviderIds: indexedProviderIDs(it),
+		}
+	}
+	return copies
+}
+
+// indexedPageItems returns the JSON for each item of an indexed page, in
+// order. Items are fetched live from their backend with the caller's own
+// account, so they carry the caller's UserData and requested Fields. The
+// index is built with another account, so an item the caller's account does
+// not return is left nil rather than shown from the index. A backend that
+// fails or does not answer in time fails the page.
+func (h *MediaHandler) indexedPageItems(c *gin.Context, clients []*backend.ServerClient, page []*ent.Item) ([]json.RawMessage, error) {
+	out := make([]json.RawMessage, len(page))
+	if len(page) == 0 {
+		return out, nil
+	}
+
+	byPrefix := make(map[string][]string)
+	for _, it := range page {
+		p := itemPrefix(it)
+		byPrefix[p] = append(byPrefix[p], it.BackendItemID)
+	}
+
+	type result struct {
+		items map[string]json.RawMessage
+		err   error
+	}
+	results := make([]result, len(clients))
+	var wg sync.WaitGroup
+	for i, sc := range clients {
+		ids := byPrefix[sc.Prefix()]
+		if len(ids) == 0 {
+			continue
+		}
+		wg.Add(1)
+		go func(i int, sc *backend.ServerClient, ids []string) {
+			defer wg.Done()
+			ctx, cancel := context.WithTimeout(c.Request.Context(), fanOutTimeout)
+			defer cancel()
+			q := url.Values{}
+			for _, p := range livePageParams {
+				if v := queryParam(c, p); v != "" {
+					q.Set(p, v)
+				}
+			}
+			q.Set("Ids", strings.Join(ids, ","))
+			body, status, err := sc.ProxyJSON(ctx, "GET",
+				"/users/"+sc.BackendUserID()+"/items", q, nil)
+			if err == nil && status != http.StatusOK {
+				err = fmt.Errorf("returned %d", status)
+			}
+			var resp struct {
+				Items []json.RawMessage `json:"Items"`
+			}
+			if err == nil {
+				err = json.Unmarshal(body, &resp)
+			}
+			if err != nil {
+				results[i] = result{err: fmt.Errorf("backend %s: %w", sc.BackendName(), err)}
+				return
+			}
+			live := make(map[string]json.RawMessage, len(resp.Items))
+			for _, raw := range resp.Items {
+				var meta struct {
+					Id string `json:"Id"`
+				}
+				if json.Unmarshal(raw, &meta) == nil {
+					live[meta.Id] = raw
+				}
+			}
+			results[i] = result{items: live}
+		}(i, sc, ids)
+	}
+	wg.Wait()
+
+	live := make(map[string]json.RawMessage)
+	for _, r := range results {
+		if r.err != nil {
+			return nil, r.err
+		}
+		for id, raw := range r.items {
+			live[id] = raw
+		}
+	}
+	for i, it := range page {
+		out[i] = live[indexedProxyID(it)]
+	}
+	return out, nil
+}
+
+// serveIndexedCounts answers /Items/Counts from the item index. It returns
+// false without writing a response when the index cannot be used.
+func (h *MediaHandler) serveIndexedCounts(c *gin.Context) bool {
+	if !onlyQueryParams(c, indexCountParams) {
+		return false
+	}
+	clients, ok := h.indexedClients(c, "")
+	if !ok {
+		return false
+	}
+	var items []*ent.Item
+	rules := userRules(userFromCtx(c))
+	if rules.Allows(time.Now()) {
+		visible, ok := h.indexedVisibility(c.Request.Context(), clients, rules)
+		if !ok {
+			return false
+		}
+		var err error
+		items, err = h.db.Item.Query().
+			Where(visible...).
+			WithBackend().
+			Select(entitem.FieldItemType, entitem.FieldTmdbID, entitem.FieldImdbID, entitem.FieldTvdbID).
+			All(c.Request.Context())
+		if err != nil {
+			return false
+		}
+	}
+	// A title on several backends counts once, as it is listed once.
+	items, _ = groupIndexedItems(items)
+
+	totals := map[string]int{
+		"MovieCount": 0, "SeriesCount": 0, "EpisodeCount": 0,
+		"ArtistCount": 0, "ProgramCount": 0, "TrailerCount": 0,
+		"SongCount": 0, "AlbumCount": 0, "MusicVideoCount": 0,
+		"BoxSetCount": 0, "BookCount": 0, "ItemCount": 0,
+	}
+	for _, it := range items {
+		if key, ok := indexCountKeys[it.ItemType]; ok {
+			totals[key]++
+		}
+		totals["ItemCount"]++
+	}
+	c.JSON(http.StatusOK, totals)
+	return true
+}
+
+// serveIndexedFilters answers a merged-library Filters2 request from the item
+// index. It returns false without writing a response when the index cannot
+// be used.
+func (h *MediaHandler) serveIndexedFilters(c *gin.Context, collectionType string) bool {
+	itemType := collectionTypeToItemType(collectionType)
+	if itemType == "" || !onlyQueryParams(c, indexFilterParams) {
+		return false
+	}
+	clients, ok := h.indexedClients(c, itemType)
+	if !ok {
+		return false
+	}
+	rules := userRules(userFromCtx(c))
+	if !rules.Allows(time.Now()) {
+		c.JSON(http.StatusOK, gin.H{"Genres": []string{}, "Tags": []string{}, "OfficialRatings": []string{}, "Years": []int{}})
+		return true
+	}
+	visible, ok := h.indexedVisibility(c.Request.Context(), clients, rules)
+	if !ok {
+		return false
+	}
+	items, err := h.db.Item.Query().
+		Where(entitem.ItemTypeEqualFold(itemType)).
+		Where(visible...).
+		Select(entitem.FieldGenres, entitem.FieldTags, entitem.FieldOfficialRating, entitem.FieldProductionYear).
+		All(c.Request.Context())
+	if err != nil {
+		return false
+	}
+
+	type nameItem struct {
+		Name string `json:"Name"`
+	}
+	genreSeen := map[string]bool{}
+	tagSeen := map[string]bool{}
+	ratingSeen := map[string]bool{}
+	yearSeen := map[int]bool{}
+	genres := []nameItem{}
+	tags := []nameItem{}
+	ratings := []string{}
+	years := []int{}
+
+	for _, it := range items {
+		for _, g := range splitList(it.Genres) {
+			if !genreSeen[g] {
+				genreSeen[g] = true
+				genres = append(genres, nameItem{Name: g})
+			}
+		}
+		for _, t := range splitList(it.Tags) {
+			if !tagSeen[t] {
+				tagSeen[t] = true
+				tags = append(tags, nameItem{Name: t})
+			}
+		}
+		if r := it.OfficialRating; r != "" && !ratingSeen[r] {
+			ratingSeen[r] = true
+			ratings = append(ratings, r)
+		}
+		if y := it.ProductionYear; y > 0 && !yearSeen[y] {
+			yearSeen[y] = true
+			years = append(years, y)
+		}
+	}
+	sort.Slice(genres, func(i, j int) bool { return strings.ToLower(genres[i].Name) < strings.ToLower(genres[j].Name) })
+	sort.Slice(tags, func(i, j int) bool { return strings.ToLower(tags[i].Name) < strings.ToLower(tags[j].Name) })
+	sort.Strings(ratings)
+	sort.Ints(years)
+
+	c.JSON(http.StatusOK, gin.H{
+		"Genres":          genres,
+		"Tags":            tags,
+		"OfficialRatings": ratings,
+		"Years":           years,
+	})
+	return true
+}
+
+// splitList splits a Jellyfin "|"-separated list, dropping empty entries.
+func splitList(s string) []string {
+	var out []string
+	for _, v := range strings.Split(s, "|") {
+		if v = strings.TrimSpace(v); v != "" {
+			out = append(out, v)
+		}
+	}
+	return out
+}
+
+// splitCSV splits a comma-separated list, dropping empty entries.
+func splitCSV(s string) []string {
+	var out []string
+	for _, v := range strings.Split(s, ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			out = append(out, v)
+		}
+	}
+	return out
+}
+
+// splitInts parses a comma-separated list of integers, skipping invalid ones.
+func splitInts(s string) []int {
+	var out []int
+	for _, v := range splitCSV(s) {
+		if n, err := strconv.Atoi(v); err == nil {
+			out = append(out, n)
+		}
+	}
+	return out
+}
@@ -0,0 +1,310 @@
+package handler_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/ddevcap/jellyfin-proxy/access"
+	"github.com/ddevcap/jellyfin-proxy/api/handler"
+	"github.com/ddevcap/jellyfin-proxy/api/middleware"
+	"github.com/ddevcap/jellyfin-proxy/backend"
+	"github.com/ddevcap/jellyfin-proxy/config"
+)
+
+// indexRouter builds a router like viewsRouter whose pool has an item
+// indexer attached. The indexer is never started; specs call SyncAll.
+func indexRouter() (*gin.Engine, *backend.Indexer) {
+	cfg := config.Config{ServerID: "test-server-id", ServerName: "Test Proxy"}
+	pool := backend.NewPool(db, cfg)
+	ix := backend.NewIndexer(pool, time.Hour)
+	pool.SetIndexer(ix)
+	mediaH := handler.NewMediaHandler(pool, cfg, db)
+
+	r := gin.New()
+	priv := r.Group("/")
+	priv.Use(middleware.Auth(db, cfg))
+	priv.GET("/users/:userId/items", mediaH.GetUserItems)
+	priv.GET("/items", mediaH.GetItems)
+	priv.GET("/items/filters2", mediaH.GetQueryFilters)
+	priv.GET("/items/counts", mediaH.GetItemCounts)
+	return r, ix
+}
+
+// indexBackend is a fake Jellyfin server with one movies library. It counts
+// full library listings (made by the indexer), live page fetches (made with
+// Ids) and fan-out requests to /items. Live page fetches leave out the item
+// ID stored in hidden, as for an account that may not see it, and fail while
+// failPages is set.
+type indexBackend struct {
+	*httptest.Server
+	listings  atomic.Int32
+	pages     atomic.Int32
+	fanOuts   atomic.Int32
+	hidden    atomic.Value
+	failPages atomic.Bool
+}
+
+func newIndexBackend(movies ...string) *indexBackend {
+	fb := &indexBackend{}
+	fb.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case strings.HasSuffix(r.URL.Path, "/views"):
+			_, _ = fmt.Fprint(w, `{"Items":[{"Id":"lib","Name":"Movies","CollectionType":"movies"}]}`)
+		case r.URL.Path == "/items":
+			fb.fanOuts.Add(1)
+			_, _ = fmt.Fprintf(w, `{"Items":[%s],"TotalRecordCount":%d}`, strings.Join(movies, ","), len(movies))
+		case strings.HasSuffix(r.URL.Path, "/items") && r.URL.Query().Get("Ids") != "":
+			fb.pages.Add(1)
+			if fb.failPages.Load() {
+				w.WriteHeader(http.StatusInternalServerError)
+				return
+			}
+			var page []string
+			for _, id := range strings.Split(r.URL.Query().Get("Ids"), ",") {
+				if id == fb.hidden.Load() {
+					continue
+				}
+				for _, m := range movies {
+					if strings.Contains(m, `"Id":"`+id+`"`) {
+						page = append(page, strings.TrimSuffix(m, "}")+`,"UserData":{"IsFavorite":true}}`)
+					}
+				}
+			}
+			_, _ = fmt.Fprintf(w, `{"Items":[%s],"TotalRecordCount":%d}`, strings.Join(page, ","), len(page))
+		case strings.HasSuffix(r.URL.Path, "/items"):
+			fb.listings.Add(1)
+			_, _ = fmt.Fprintf(w, `{"Items":[%s],"TotalRecordCount":%d}`, strings.Join(movies, ","), len(movies))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	return fb
+}
+
+// movie returns the JSON of a fake movie as a backend would list it.
+func movie(id, name string, tmdb string, year int, genre string) string {
+	return fmt.Sprintf(`{"Id":%q,"Name":%q,"SortName":%q,"Type":"Movie","MediaType":"Video",`+
+		`"ProviderIds":{"Tmdb":%q},"ProductionYear":%d,"Genres":[%q],"OfficialRating":"PG"}`,
+		id, name, strings.ToLower(name), tmdb, year, genre)
+}
+
+var _ = Describe("Item index", func() {
+	var (
+		router *gin.Engine
+		ix     *backend.Indexer
+		fakeA  *indexBackend
+		fakeB  *indexBackend
+	)
+
+	BeforeEach(func() {
+		cleanDB()
+		u := createUser("indexuser", "password1!", true)
+		createSession(u, viewsToken)
+		router, ix = indexRouter()
+
+		fakeA = newIndexBackend(
+			movie("a1", "Alpha", "1", 2001, "Drama"),
+			movie("a3", "Charlie", "3", 2003, "Comedy"),
+		)
+		fakeB = newIndexBackend(
+			movie("b2", "Bravo", "2", 2002, "Action"),
+			movie("b1", "Alpha", "1", 2001, "Drama"),
+		)
+		createBackendUser(createBackend("Backend A", fakeA.URL, "ia"), u, "user-ia", "token-a")
+		createBackendUser(createBackend("Backend B", fakeB.URL, "ib"), u, "user-ib", "token-b")
+	})
+
+	AfterEach(func() {
+		fakeA.Close()
+		fakeB.Close()
+	})
+
+	type pagedResponse struct {
+		Items []struct {
+			Id           string            `json:"Id"`
+			Name         string            `json:"Name"`
+			MediaSources []json.RawMessage `json:"MediaSources"`
+			UserData     struct {
+				IsFavorite bool `json:"IsFavorite"`
+			} `json:"UserData"`
+		} `json:"Items"`
+		TotalRecordCount int `json:"TotalRecordCount"`
+		StartIndex       int `json:"StartIndex"`
+	}
+
+	It("falls back to fanning out until every backend has been synced", func() {
+		w := doGet(router, "/items?ParentId=merged_movies", auth())
+
+		Expect(w.Code).To(Equal(http.StatusOK))
+		Expect(fakeA.fanOuts.Load()).To(BeEquivalentTo(1))
+		Expect(fakeB.fanOuts.Load()).To(BeEquivalentTo(1))
+	})
+
+	Context("after a sync", func() {
+		BeforeEach(func() {
+			ix.SyncAll(mediaCtx())
+		})
+
+		It("pages and sorts a merged library without listing the backends again", func() {
+			listings := fakeA.listings.Load() + fakeB.listings.Load()
+
+			w := doGet(router, "/items?ParentId=merged_movies&SortBy=SortName&StartIndex=1&Limit=1", auth())
+
+			Expect(w.Code).To(Equal(http.StatusOK))
+			var resp pagedResponse
+			Expect(json.Unmarshal(w.Body.Bytes(), &resp)).To(Succeed())
+			Expect(resp.TotalRecordCount).To(Equal(3)) // Alpha is on both backends
+			Expect(resp.StartIndex).To(Equal(1))
+			Expect(resp.Items).To(HaveLen(1))
+			Expect(resp.Items[0].Name).To(Equal("Bravo"))
+			Expect(resp.Items[0].Id).To(Equal("ib_b2"))
+			// The page itself is fetched live to get the caller's UserData.
+			Expect(resp.Items[0].UserData.IsFavorite).To(BeTrue())
+			Expect(fakeB.pages.Load()).To(BeEquivalentTo(1))
+			Expect(fakeA.listings.Load() + fakeB.listings.Load()).To(Equal(listings))
+			Expect(fakeA.fanOuts.Load() + fakeB.fanOuts.Load()).To(BeZero())
+		})
+
+		It("sorts descending when asked", func() {
+			w := doGet(router, "/users/ignored/items?ParentId=merged_movies&SortBy=ProductionYear&SortOrder=Descending", auth())
+
+			Expect(w.Code).To(Equal(http.StatusOK))
+			var resp pagedResponse
+			Expect(json.Unmarshal(w.Body.Bytes(), &resp)).To(Succeed())
+			names := make([]string, len(resp.Items))
+			for i, it := range resp.Items {
+				names[i] = it.Name
+			}
+			Expect(names).To(Equal([]string{"Charlie", "Bravo", "Alpha"}))
+		})
+
+		It("collapses a title found on several backends into one dup_ entry", func() {
+			w := doGet(router, "/items?ParentId=merged_movies&Limit=1", auth())
+
+			Expect(w.Code).To(Equal(http.StatusOK))
+			var resp pagedResponse
+			Expect(json.Unmarshal(w.Body.Bytes(), &resp)).To(Succeed())
+			Expect(resp.Items).To(HaveLen(1))
+			Expect(resp.Items[0].Name).To(Equal("Alpha"))
+			Expect(resp.Items[0].Id).To(HavePrefix("dup_"))
+			Expect(resp.Items[0].MediaSources).To(HaveLen(2))
+		})
+
+		It("filters by genre and year", func() {
+			w := doGet(router, "/items?ParentId=merged_movies&Genres=Drama|Comedy&Years=2003", auth())
+
+			Expect(w.Code).To(Equal(http.StatusOK))
+			var resp pagedResponse
+			Expect(json.Unmarshal(w.Body.Bytes(), &resp)).To(Succeed())
+			Expect(resp.TotalRecordCount).To(Equal(1))
+			Expect(resp.Items[0].Name).To(Equal("Charlie"))
+		})
+
+		It("fans out for filters that depend on the user's own data", func() {
+			w := doGet(router, "/items?ParentId=merged_movies&IsFavorite=true", auth())
+
+			Expect(w.Code).To(Equal(http.StatusOK))
+			Expect(fakeA.fanOuts.Load()).To(BeEquivalentTo(1))
+			Expect(fakeB.fanOuts.Load()).To(BeEquivalentTo(1))
+		})
+
+		It("counts items from the index", func() {
+			w := doGet(router, "/items/counts", auth())
+
+			Expect(w.Code).To(Equal(http.StatusOK))
+			var counts map[string]int
+			Expect(json.Unmarshal(w.Body.Bytes(), &counts)).To(Succeed())
+			// Alpha is on both backends and counts once, as in the listing.
+			Expect(counts["MovieCount"]).To(Equal(3))
+			Expect(counts["ItemCount"]).To(Equal(3))
+			Expect(counts["SeriesCount"]).To(BeZero())
+		})
+
+		It("drops items the caller's own backend account does not return", func() {
+			fakeA.hidden.Store("a3")
+
+			w := doGet(router, "/items?ParentId=merged_movies&SortBy=SortName", auth())
+
+			Expect(w.Code).To(Equal(http.StatusOK))
+			var resp pagedResponse
+			Expect(json.Unmarshal(w.Body.Bytes(), &resp)).To(Succeed())
+			names := make([]string, len(resp.Items))
+			for i, it := range resp.Items {
+				names[i] = it.Name
+			}
+			Expect(names).To(Equal([]string{"Alpha", "Bravo"}))
+			Expect(resp.TotalRecordCount).To(Equal(2))
+		})
+
+		It("fails the page when a backend cannot fetch its items", func() {
+			fakeB.failPages.Store(true)
+
+			w := doGet(router, "/items?ParentId=merged_movies&SortBy=SortName&StartIndex=1&Limit=1", auth())
+
+			Expect(w.Code).To(Equal(http.StatusBadGateway))
+			Expect(w.Body.String()).NotTo(ContainSubstring("Bravo"))
+		})
+
+		It("filters by the user's access rules before paging and counting", func() {
+			u := db.User.Query().OnlyX(mediaCtx())
+			db.User.UpdateOne(u).SetAccessRules(access.Rules{BlockedLibraries: []string{"ia_lib"}}).ExecX(mediaCtx())
+
+			w := doGet(router, "/items?ParentId=merged_movies&SortBy=SortName&Limit=1", auth())
+			Expect(w.Code).To(Equal(http.StatusOK))
+			var resp pagedResponse
+			Expect(json.Unmarshal(w.Body.Bytes(), &resp)).To(Succeed())
+			Expect(resp.TotalRecordCount).To(Equal(2))
+			Expect(resp.Items).To(HaveLen(1))
+			Expect(resp.Items[0].Id).To(Equal("ib_b1"))
+
+			w = doGet(router, "/items/counts", auth())
+			Expect(w.Code).To(Equal(http.StatusOK))
+			var counts map[string]int
+			Expect(json.Unmarshal(w.Body.Bytes(), &counts)).To(Succeed())
+			Expect(counts["MovieCount"]).To(Equal(2))
+		})
+
+		It("hides items above the parental rating limit", func() {
+			u := db.User.Query().OnlyX(mediaCtx())
+			limit := 0
+			db.User.UpdateOne(u).SetAccessRules(access.Rules{MaxParentalRating: &limit}).ExecX(mediaCtx())
+
+			w := doGet(router, "/items?ParentId=merged_movies", auth())
+			Expect(w.Code).To(Equal(http.StatusOK))
+			var resp pagedResponse
+			Expect(json.Unmarshal(w.Body.Bytes(), &resp)).To(Succeed())
+			Expect(resp.TotalRecordCount).To(BeZero())
+			Expect(resp.Items).To(BeEmpty())
+			Expect(fakeA.fanOuts.Load() + fakeB.fanOuts.Load()).To(BeZero())
+		})
+
+		It("builds merged filters from the index", func() {
+			w := doGet(router, "/items/filters2?ParentId=merged_movies", auth())
+
+			Expect(w.Code).To(Equal(http.StatusOK))
+			var resp struct {
+				Genres []struct {
+					Name string `json:"Name"`
+				} `json:"Genres"`
+				OfficialRatings []string `json:"OfficialRatings"`
+				Years           []int    `json:"Years"`
+			}
+			Expect(json.Unmarshal(w.Body.Bytes(), &resp)).To(Succeed())
+			Expect(resp.Genres).To(HaveLen(3))
+			Expect(resp.Genres[0].Name).To(Equal("Action"))
+			Expect(resp.OfficialRatings).To(Equal([]string{"PG"}))
+			Expect(resp.Years).To(Equal([]int{2001, 2002, 2003}))
+		})
+	})
+})
@@ -17,8 +17,10 @@ import (
 // GetItems handles GET /Items.
 // Routes by ParentId or first entry in Ids; fans out to all backends when a
 // SearchTerm is present without a ParentId; returns empty list otherwise.
-// When ParentId is a merged virtual ID, fans out to all backends.
+// When ParentId is a merged virtual ID, answers from the item index or fans
+// out to all backends.
 func (h *MediaHandler) GetItems(c *gin.Context) {
+	h.resolveDupQuery(c)
 	prefix := prefixFromQuery(c)
 	if prefix == "" {
 		// No parentId/ids — fan out if this is a search request.
@@ -33,6 +35,9 @@ func (h *MediaHandler) GetItems(c *gin.Context) {
 	}
 
 	if collectionType, ok := idtrans.DecodeMerged(prefix); ok {
+		if h.serveIndexedItems(c, collectionType) {
+			return
+		}
 		h.aggregatePagedItems(c, "/items", func(sc *backend.ServerClient) url.Values {
 			q := forwardQuery(c.Request.URL.Query(), sc.BackendUserID())
 			q.Del("ParentId")
@@ -49,13 +54,19 @@ func (h *MediaHandler) GetItems(c *gin.Context) {
 		return
 	}
 
+	if err := h.checkParentAccess(c, sc); err != nil {
+		routeError(c, err)
+		return
+	}
+
 	query := forwardQuery(c.Request.URL.Query(), sc.BackendUserID())
+	withAccessFields(query, userRules(userFromCtx(c)))
 	body, status, err := sc.ProxyJSON(c.Request.Context(), "GET", "/items", query, nil)
 	if err != nil {
 		gatewayError(c, err)
 		return
 	}
-	writeJSON(c, body, status)
+	h.writeFilteredItems(c, body, status)
 }
 
 // GetItem handles GET /Items/:itemId.
@@ -77,7 +88,7 @@ func (h *MediaHandler) GetItem(c *gin.Context) {
 
 	sc, backendID, err := h.routeByID(c, itemID)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		routeError(c, err)
 		return
 	}
 
@@ -95,6 +106,7 @@ func (h *MediaHandler) GetItem(c *gin.Context) {
 // When parentid is a merged virtual ID, fans out to all backends that expose a
 // library of that collectiontype and concatenates their results.
 func (h *MediaHandler) GetUserItems(c *gin.Context) {
+	h.resolveDupQuery(c)
 	parentID := queryParam(c, "parentid")
 	if parentID == "" {
 		// No parentId — if there is a search term, fan out to all backends.
@@ -112,6 +124,9 @@ func (h *MediaHandler) GetUserItems(c *gin.Context) {
 	}
 
 	if collectionType, ok := idtrans.DecodeMerged(parentID); ok {
+		if h.serveIndexedItems(c, collectionType) {
+			return
+		}
 		h.aggregatePagedItems(c, "/items",
 			func(sc *backend.ServerClient) url.Values {
 				q := forwardQuery(c.Request.URL.Query(), sc.BackendUserID())
@@ -136,24 +151,36 @@ func (h *MediaHandler) GetUserItems(c *gin.Context) {
 		return
 	}
 
+	if err := h.checkParentAccess(c, sc); err != nil {
+		routeError(c, err)
+		return
+	}
+
 	query := forwardQuery(c.Request.URL.Query(), sc.BackendUserID())
+	withAccessFields(query, userRules(userFromCtx(c)))
 	body, status, err := sc.ProxyJSON(c.Request.Context(), "GET",
 		"/users/"+sc.BackendUserID()+"/items", query, nil)
 	if err != nil {
 		gatewayError(c, err)
 		return
 	}
-	writeJSON(c, body, status)
+	h.writeFilteredItems(c, body, status)
 }
 
 // GetLatestItems handles GET /Users/:userId/Items/Latest.
 // Returns a bare JSON array (Jellyfin's format for this endpoint).
 // When parentid is a merged virtual ID, fans out to all backends.
 func (h *MediaHandler) GetLatestItems(c *gin.Context) {
+	h.resolveDupQuery(c)
 	parentID := queryParam(c, "parentid")
 
 	if collectionType, ok := idtrans.DecodeMerged(parentID); ok {
 		user := userFromCtx(c)
+		if !allowedNow(user) {
+			c.JSON(http.StatusOK, []interface{}{})
+			return
+		}
+		rules := userRules(user)
 		clients, err := h.pool.AllForUser(c.Request.Context(), user)
 		if err != nil {
 			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
@@ -173,6 +200,7 @@ func (h *MediaHandler) GetLatestItems(c *gin.Context) {
 				q := forwardQuery(c.Request.URL.Query(), sc.BackendUserID())
 				q.Del("ParentId")
 				q.Set("IncludeItemTypes", collectionTypeToItemType(collectionType))
+				withAccessFields(q, rules)
 				body, status, err := sc.ProxyJSON(ctx, "GET",
 					"/users/"+sc.BackendUserID()+"/items/Latest", q, nil)
 				if err != nil || status != http.StatusOK {
@@ -182,7 +210,7 @@ func (h *MediaHandler) GetLatestItems(c *gin.Context) {
 				if err := json.Unmarshal(body, &items); err != nil {
 					return
 				}
-				results[i] = result{items: items}
+				results[i] = result{items: h.filterItems(ctx, rules, items)}
 			}(i, sc)
 		}
 		wg.Wait()
@@ -216,14 +244,20 @@ func (h *MediaHandler) GetLatestItems(c *gin.Context) {
 		return
 	}
 
+	if err := h.checkParentAccess(c, sc); err != nil {
+		routeError(c, err)
+		return
+	}
+
 	query := forwardQuery(c.Request.URL.Query(), sc.BackendUserID())
+	withAccessFields(query, userRules(userFromCtx(c)))
 	body, status, err := sc.ProxyJSON(c.Request.Context(), "GET",
 		"/users/"+sc.BackendUserID()+"/items/Latest", query, nil)
 	if err != nil {
 		gatewayError(c, err)
 		return
 	}
-	writeJSON(c, body, status)
+	h.writeFilteredItems(c, body, status)
 }
 
 // GetUserItem handles GET /Users/:userId/Items/:itemId.
@@ -247,7 +281,7 @@ func (h *MediaHandler) GetUserItem(c *gin.Context) {
 
 	sc, backendID, err := h.routeByID(c, itemID)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		routeError(c, err)
 		return
 	}
 	query := forwardQuery(c.Request.URL.Query(), sc.BackendUserID())
@@ -280,8 +314,12 @@ func (h *MediaHandler) GetSuggestedItems(c *gin.Context) {
 }
 
 // GetItemCounts handles GET /Items/Counts.
-// Aggregates item type counts across all backends the user has access to.
+// Aggregates item type counts across all backends the user has access to,
+// from the item index when it is ready.
 func (h *MediaHandler) GetItemCounts(c *gin.Context) {
+	if h.serveIndexedCounts(c) {
+		return
+	}
 	user := userFromCtx(c)
 	clients, err := h.pool.AllForUser(c.Request.Context(), user)
 	if err != nil {
@@ -334,23 +372,18 @@ func (h *MediaHandler) GetItemCounts(c *gin.Context) {
 func (h *MediaHandler) GetItemChildren(c *gin.Context) {
 	sc, backendID, err := h.routeByID(c, c.Param("itemId"))
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		routeError(c, err)
 		return
 	}
-	q := forwardQuery(c.Request.URL.Query(), sc.BackendUserID())
-	body, status, err := sc.ProxyJSON(c.Request.Context(), "GET", "/items/"+backendID+"/children", q, nil)
-	if err != nil {
-		gatewayError(c, err)
-		return
-	}
-	writeJSON(c, body, status)
+	h.proxyItemList(c, sc, "/items/"+backendID+"/children",
+		forwardQuery(c.Request.URL.Query(), sc.BackendUserID()))
 }
 
 // UpdateItem handles POST /Items/:itemId (metadata update).
 func (h *MediaHandler) UpdateItem(c *gin.Context) {
 	sc, backendID, err := h.routeByID(c, c.Param("itemId"))
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		routeError(c, err)
 		return
 	}
 	body, err := io.ReadAll(io.LimitReader(c.Request.Body, maxBodySize))
@@ -370,7 +403,7 @@ func (h *MediaHandler) UpdateItem(c *gin.Context) {
 func (h *MediaHandler) DeleteItem(c *gin.Context) {
 	sc, backendID, err := h.routeByID(c, c.Param("itemId"))
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		routeError(c, err)
 		return
 	}
 	body, status, err := sc.ProxyJSON(c.Request.Context(), "DELETE", "/items/"+backendID, nil, nil)
@@ -385,7 +418,7 @@ func (h *MediaHandler) DeleteItem(c *gin.Context) {
 func (h *MediaHandler) RefreshItem(c *gin.Context) {
 	sc, backendID, err := h.routeByID(c, c.Param("itemId"))
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		routeError(c, err)
 		return
 	}
 	q := forwardQuery(c.Request.URL.Query(), sc.BackendUserID())
@@ -401,23 +434,18 @@ func (h *MediaHandler) RefreshItem(c *gin.Context) {
 func (h *MediaHandler) GetSpecialFeatures(c *gin.Context) {
 	sc, backendID, err := h.routeByID(c, c.Param("itemId"))
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
-		return
-	}
-	q := forwardQuery(c.Request.URL.Query(), sc.BackendUserID())
-	body, status, err := sc.ProxyJSON(c.Request.Context(), "GET", "/items/"+backendID+"/specialfeatures", q, nil)
-	if err != nil {
-		gatewayError(c, err)
+		routeError(c, err)
 		return
 	}
-	writeJSON(c, body, status)
+	h.proxyItemList(c, sc, "/items/"+backendID+"/specialfeatures",
+		forwardQuery(c.Request.URL.Query(), sc.BackendUserID()))
 }
 
 // GetThemeMedia handles GET /Items/:itemId/thememedia.
 func (h *MediaHandler) GetThemeMedia(c *gin.Context) {
 	sc, backendID, err := h.routeByID(c, c.Param("itemId"))
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		routeError(c, err)
 		return
 	}
 	q := forwardQuery(c.Request.URL.Query(), sc.BackendUserID())
@@ -433,38 +461,27 @@ func (h *MediaHandler) GetThemeMedia(c *gin.Context) {
 func (h *MediaHandler) GetLocalTrailers(c *gin.Context) {
 	sc, backendID, err := h.routeByID(c, c.Param("itemId"))
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		routeError(c, err)
 		return
 	}
-	q := forwardQuery(c.Request.URL.Query(), sc.BackendUserID())
-	body, status, err := sc.ProxyJSON(c.Request.Context(), "GET",
-		"/users/"+sc.BackendUserID()+"/items/"+backendID+"/localtrailers", q, nil)
-	if err != nil {
-		gatewayError(c, err)
-		return
-	}
-	writeJSON(c, body, status)
+	h.proxyItemList(c, sc, "/users/"+sc.BackendUserID()+"/items/"+backendID+"/localtrailers",
+		forwardQuery(c.Request.URL.Query(), sc.BackendUserID()))
 }
 
 // GetIntros handles GET /Users/:userId/Items/:itemId/intros.
 func (h *MediaHandler) GetIntros(c *gin.Context) {
 	sc, backendID, err := h.routeByID(c, c.Param("itemId"))
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		routeError(c, err)
 		return
 	}
-	body, status, err := sc.ProxyJSON(c.Request.Context(), "GET",
-		"/users/"+sc.BackendUserID()+"/items/"+backendID+"/intros", nil, nil)
-	if err != nil {
-		gatewayError(c, err)
-		return
-	}
-	writeJSON(c, body, status)
+	h.proxyItemList(c, sc, "/users/"+sc.BackendUserID()+"/items/"+backendID+"/intros", url.Values{})
 }
 
 // GetQueryFilters handles GET /Items/Filters2 and GET /Items/Filters.
 // Routes to the backend identified by ParentId; returns empty filters if absent.
 func (h *MediaHandler) GetQueryFilters(c *gin.Context) {
+	h.resolveDupQuery(c)
 	prefix := prefixFromQuery(c)
 	if prefix == "" {
 		c.JSON(http.StatusOK, gin.H{
@@ -497,8 +514,12 @@ func (h *MediaHandler) GetQueryFilters(c *gin.Context) {
 }
 
 // aggregateFilters fans out a Filters2 request to all backends the user is
-// mapped to and merges the results, deduplicating by name/value.
+// mapped to and merges the results, deduplicating by name/value. The item
+// index answers instead when it is ready.
 func (h *MediaHandler) aggregateFilters(c *gin.Context, collectionType string) {
+	if h.serveIndexedFilters(c, collectionType) {
+		return
+	}
 	user := userFromCtx(c)
 	clients, err := h.pool.AllForUser(c.Request.Context(), user)
 	if err != nil {
@@ -0,0 +1,407 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ddevcap/jellyfin-proxy/backend"
+	"github.com/gin-gonic/gin"
+	"github.com/jellydator/ttlcache/v3"
+)
+
+const (
+	// mergeCursorTTL is how long the merge position of a paged query is kept
+	// after its last page was served.
+	mergeCursorTTL = 2 * time.Minute
+	// mergeCursorCapacity bounds the number of cursors held at once. A cursor
+	// buffers the rows it fetched but has not served yet, so they are not free.
+	mergeCursorCapacity = 1024
+)
+
+// newCursorCache creates the cache of merge cursors for paged fan-out
+// queries, keyed by user and query. It lets a client scrolling through a
+// merged library continue where the previous page stopped instead of
+// fetching every earlier row from every backend again. Cursors are touched
+// on every hit, so one stays alive while a client keeps paging.
+func newCursorCache() *ttlcache.Cache[string, *mergeCursor] {
+	cache := ttlcache.New[string, *mergeCursor](
+		ttlcache.WithTTL[string, *mergeCursor](mergeCursorTTL),
+		ttlcache.WithCapacity[string, *mergeCursor](mergeCursorCapacity),
+	)
+	go cache.Start()
+	return cache
+}
+
+// mergeEntry is one backend row waiting to be merged.
+type mergeEntry struct {
+	raw   json.RawMessage
+	keys  sortKeys
+	cp    dedupCopy
+	match []string // provider match keys, empty for items without any
+}
+
+// mergeStream is one backend's position in a merged query.
+type mergeStream struct {
+	offset int          // rows fetched so far; the next StartIndex
+	total  int          // TotalRecordCount reported by the backend, less hidden rows
+	hidden int          // fetched rows dropped by the user's access rules
+	done   bool         // the backend has no more rows to send
+	buf    []mergeEntry // fetched rows not merged yet, in sort order
+}
+
+// fetchFunc loads the next batch of at most need rows (all of them when need
+// is negative) of st from its backend. It reports false when the backend
+// could not be queried.
+type fetchFunc func(ctx context.Context, sc *backend.ServerClient, st *mergeStream, need int) bool
+
+// mergeGroup collects the copies of a title found on several backends.
+type mergeGroup struct {
+	key    string // first match key of the copy that was emitted
+	copies []dedupCopy
+}
+
+// add records cp unless the group already holds it.
+func (g *mergeGroup) add(cp dedupCopy) {
+	for _, have := range g.copies {
+		if have.Id == cp.Id {
+			return
+		}
+	}
+	g.copies = append(g.copies, cp)
+}
+
+// mergeCursor is the state of a k-way merge over the sorted results of
+// several backends. Its streams are in the same order as the clients the
+// merge was started with.
+type mergeCursor struct {
+	mu      sync.Mutex
+	order   itemOrder
+	streams []*mergeStream
+	pos     int                    // merged rows emitted so far
+	dropped int                    // rows dropped as later copies of an emitted title
+	groups  map[string]*mergeGroup // by provider match key
+	failed  bool                   // a backend request failed; don't reuse
+}
+
+func newMergeCursor(n int, order itemOrder) *mergeCursor {
+	cur := &mergeCursor{
+		order:   order,
+		streams: make([]*mergeStream, n),
+		groups:  make(map[string]*mergeGroup),
+	}
+	for i := range cur.streams {
+		cur.streams[i] = &mergeStream{}
+	}
+	return cur
+}
+
+// admit records e's title and reports whether a copy of it was emitted
+// before, in which case e must be dropped.
+func (cur *mergeCursor) admit(e *mergeEntry) (*mergeGroup, bool) {
+	if len(e.match) == 0 {
+		return nil, false
+	}
+	var g *mergeGroup
+	for _, k := range e.match {
+		if g = cur.groups[k]; g != nil {
+			break
+		}
+	}
+	dup := g != nil
+	if g == nil {
+		g = &mergeGroup{key: e.match[0]}
+	}
+	g.add(e.cp)
+	for _, k := range e.match {
+		if _, ok := cur.groups[k]; !ok {
+			cur.groups[k] = g
+		}
+	}
+	return g, dup
+}
+
+// lookahead adds buffered copies of emitted titles to their groups, so an
+// entry on the current page lists copies the merge has fetched but not
+// reached yet. It returns the number of such copies, which will be dropped
+// once the merge gets to them.
+func (cur *mergeCursor) lookahead() int {
+	pending := 0
+	for _, st := range cur.streams {
+		for _, e := range st.buf {
+			for _, k := range e.match {
+				if g := cur.groups[k]; g != nil {
+					g.add(e.cp)
+					pending++
+					break
+				}
+			}
+		}
+	}
+	return pending
+}
+
+// exhausted reports whether every row of every backend has been merged.
+func (cur *mergeCursor) exhausted() bool {
+	for _, st := range cur.streams {
+		if !st.done || len(st.buf) > 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// pageParams returns the startindex and limit params of the request. limit
+// is -1 when the client did not set one.
+func pageParams(c *gin.Context) (start, limit int) {
+	limit = -1
+	if s := queryParam(c, "startindex"); s != "" {
+		if v, err := strconv.Atoi(s); err == nil && v > 0 {
+			start = v
+		}
+	}
+	if s := queryParam(c, "limit"); s != "" {
+		if v, err := strconv.Atoi(s); err == nil && v >= 0 {
+			limit = v
+		}
+	}
+	return start, limit
+}
+
+// cursorKey identifies the merge of a paged query for one user over one set
+// of backends. Paging params are left out so consecutive pages share a key.
+func cursorKey(c *gin.Context, userID string, clients []*backend.ServerClient) string {
+	norm := make(url.Values)
+	for k, vals := range c.Request.URL.Query() {
+		switch k = strings.ToLower(k); k {
+		case "startindex", "limit":
+		default:
+			norm[k] = append(norm[k], vals...)
+		}
+	}
+	return userID + "|" + c.Request.URL.Path + "|" + strings.Join(clientPrefixes(clients), ",") + "|" + norm.Encode()
+}
+
+// fill fetches the next batch of every stream that still has rows on its
+// backend but none buffered. All the backends are queried concurrently.
+func (cur *mergeCursor) fill(
+	ctx context.Context,
+	clients []*backend.ServerClient,
+	fetch fetchFunc,
+	need int,
+) {
+	ok := make([]bool, len(cur.streams))
+	var wg sync.WaitGroup
+	for i, st := range cur.streams {
+		if st.done || len(st.buf) > 0 {
+			ok[i] = true
+			continue
+		}
+		wg.Add(1)
+		go func(i int, st *mergeStream) {
+			defer wg.Done()
+			ok[i] = fetch(ctx, clients[i], st, need)
+		}(i, st)
+	}
+	wg.Wait()
+	for i, st := range cur.streams {
+		if !ok[i] {
+			st.done = true
+			cur.failed = true
+		}
+	}
+}
+
+// mergePage advances cur to the page starting at merged position start and
+// returns up to limit rows, or every remaining row when limit is negative.
+// Streams whose buffer runs dry are refilled from their backend with a
+// sorted page just large enough to finish the requested page on its own.
+func (h *MediaHandler) mergePage(
+	ctx context.Context,
+	cur *mergeCursor,
+	clients []*backend.ServerClient,
+	fetch fetchFunc,
+	start, limit int,
+) []json.RawMessage {
+	type pageEntry struct {
+		raw json.RawMessage
+		g   *mergeGroup
+	}
+	var page []pageEntry
+
+	if limit == 0 {
+		// Only the total is wanted; one row per backend is enough to get it.
+		cur.fill(ctx, clients, fetch, 1)
+		return []json.RawMessage{}
+	}
+	for limit < 0 || len(page) < limit {
+		need := -1
+		if limit >= 0 {
+			need = max(start+limit-cur.pos, 1)
+		}
+		cur.fill(ctx, clients, fetch, need)
+
+		// Pick the smallest head. Ties go to the stream with the lower
+		// prefix, so the same copy of a title wins on every request.
+		best := -1
+		for i, st := range cur.streams {
+			if len(st.buf) == 0 {
+				continue
+			}
+			if best < 0 || cur.order.compare(&st.buf[0].keys, &cur.streams[best].buf[0].keys) < 0 {
+				best = i
+			}
+		}
+		if best < 0 {
+			break
+		}
+		st := cur.streams[best]
+		e := st.buf[0]
+		st.buf = st.buf[1:]
+
+		g, dup := cur.admit(&e)
+		if dup {
+			cur.dropped++
+			continue
+		}
+		cur.pos++
+		if cur.pos > start {
+			page = append(page, pageEntry{raw: e.raw, g: g})
+		}
+	}
+
+	cur.lookahead()
+	out := make([]json.RawMessage, len(page))
+	for i, p := range page {
+		out[i] = p.raw
+		if p.g != nil {
+			out[i] = h.markDuplicate(ctx, p.raw, p.g.key, p.g.copies)
+		}
+	}
+	return out
+}
+
+// total estimates the number of rows in the merged result: every row the
+// backends reported, less the copies of titles already found on another
+// backend. It is exact once the merge has consumed everything.
+func (cur *mergeCursor) total() int {
+	if cur.exhausted() {
+		return cur.pos
+	}
+	n := -cur.dropped - cur.lookahead()
+	for _, st := range cur.streams {
+		n += st.total
+	}
+	return max(n, cur.pos)
+}
+
+// fetchSorted returns the fetchFunc of a fan-out query. It asks the backend
+// for rows from the stream's offset, sorted by the client's sortBy and
+// sortOrder, which must match order. filter, when set, drops the rows the
+// user may not see; a batch that loses every row is followed by the next one.
+//
+// A backend shuffles every page of a Random sort anew, so its pages would
+// repeat some rows and skip others. For such an order every row is fetched
+// at once in name order, and the merge shuffles them with the order's seed.
+func fetchSorted(
+	pathFn func(sc *backend.ServerClient) string,
+	queryFn func(sc *backend.ServerClient) url.Values,
+	order itemOrder,
+	sortBy, sortOrder string,
+	filter func(ctx context.Context, items []json.RawMessage) []json.RawMessage,
+) fetchFunc {
+	shuffle := order.shuffles()
+	if shuffle {
+		sortBy, sortOrder = "SortName", ""
+	}
+	return func(ctx context.Context, sc *backend.ServerClient, st *mergeStream, need int) bool {
+		ctx, cancel := context.WithTimeout(ctx, fanOutTimeout)
+		defer cancel()
+		if shuffle {
+			need = -1
+		}
+
+		q := queryFn(sc)
+		// ProviderIds are needed to spot the same title on several backends.
+		fields := withField(q.Get("Fields"), "ProviderIds")
+		for _, f := range order.fields() {
+			fields = withField(fields, f)
+		}
+		q.Set("Fields", fields)
+		q.Set("SortBy", sortBy)
+		if sortOrder != "" {
+			q.Set("SortOrder", sortOrder)
+		}
+		q.Del("Limit")
+		if need >= 0 {
+			q.Set("Limit", strconv.Itoa(need))
+		}
+
+		for {
+			q.Del("StartIndex")
+			if st.offset > 0 {
+				q.Set("StartIndex", strconv.Itoa(st.offset))
+			}
+			body, status, err := sc.ProxyJSON(ctx, "GET", pathFn(sc), q, nil)
+			if err != nil || status != http.StatusOK {
+				return false
+			}
+			var resp struct {
+				Items            []json.RawMessage `json:"Items"`
+				TotalRecordCount int               `json:"TotalRecordCount"`
+				StartIndex       int               `json:"StartIndex"`
+			}
+			if err := json.Unmarshal(body, &resp); err != nil {
+				return false
+			}
+
+			items := resp.Items
+			switch {
+			case (need >= 0 && len(items) > need) || (st.offset > 0 && resp.StartIndex != st.offset):
+				// Some endpoints ignore paging and always send the whole list.
+				items = items[min(st.offset, len(items)):]
+				st.done = true
+			case need < 0 || len(items) < need:
+				st.done = true
+			}
+			st.offset += len(items)
+			if resp.TotalRecordCount > 0 && st.offset >= resp.TotalRecordCount {
+				st.done = true
+			}
+			if filter != nil {
+				kept := filter(ctx, items)
+				st.hidden += len(items) - len(kept)
+				items = kept
+			}
+			st.total = max(resp.TotalRecordCount, st.offset) - st.hidden
+			if st.done {
+				st.total = st.offset - st.hidden
+			}
+			if len(items) == 0 && !st.done {
+				continue
+			}
+
+			st.buf = make([]mergeEntry, len(items))
+			for i, raw := range items {
+				e := &st.buf[i]
+				e.raw = raw
+				_ = json.Unmarshal(raw, &e.keys)
+				if err := json.Unmarshal(raw, &e.cp); err == nil {
+					e.match = providerMatchKeys(e.cp.Type, e.cp.ProviderIds)
+				}
+			}
+			// Backends collate with their own rules; re-sorting each batch keeps
+			// the merge's invariant at least within it.
+			sort.SliceStable(st.buf, func(i, j int) bool {
+				return order.compare(&st.buf[i].keys, &st.buf[j].keys) < 0
+			})
+			return true
+		}
+	}
+}
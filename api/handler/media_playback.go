@@ -1,6 +1,7 @@
 package handler
 
 import (
+	"context"
 	"encoding/json"
 	"io"
 	"net/http"
@@ -11,21 +12,23 @@ import (
 	"github.com/ddevcap/jellyfin-proxy/backend"
 	"github.com/ddevcap/jellyfin-proxy/idtrans"
 	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
 )
 
 // GetPlaybackInfo handles GET and POST /Items/:itemId/playbackinfo.
 // After the standard JSON rewrite, rewrites any URL fields so that stream
-// URLs point to the proxy rather than directly to the backend server.
+// URLs point to the proxy rather than directly to the backend server. The
+// user's policy may turn transcoding off and cap the bitrate of remote
+// clients before the request is forwarded.
 func (h *MediaHandler) GetPlaybackInfo(c *gin.Context) {
-	sc, backendID, err := h.routeByID(c, c.Param("itemId"))
-	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	if err := h.checkPlayback(c); err != nil {
+		routeError(c, err)
 		return
 	}
-
 	method := c.Request.Method
 	var body []byte
 	if method == http.MethodPost {
+		var err error
 		body, err = io.ReadAll(io.LimitReader(c.Request.Body, maxBodySize))
 		if err != nil {
 			c.JSON(http.StatusBadRequest, gin.H{"error": "could not read body"})
@@ -33,7 +36,32 @@ func (h *MediaHandler) GetPlaybackInfo(c *gin.Context) {
 		}
 	}
 
+	itemID := c.Param("itemId")
+	// Items merged across backends list the other copies as extra media
+	// sources. When the client picks one of those, ask the backend that owns
+	// the copy rather than the one the item ID points at. Most clients name
+	// the source in the query; the web client sends it in the POST body.
+	msID := queryParam(c, "mediaSourceId")
+	if msID == "" {
+		msID = bodyMediaSourceID(body)
+	}
+	if msID != "" {
+		msPrefix, _, msErr := idtrans.Decode(msID)
+		itemPrefix, _, _ := idtrans.Decode(itemID)
+		if msErr == nil && msPrefix != itemPrefix {
+			itemID = msID
+		}
+	}
+
+	sc, backendID, err := h.routeByID(c, itemID)
+	if err != nil {
+		routeError(c, err)
+		return
+	}
+
 	query := forwardQuery(c.Request.URL.Query(), sc.BackendUserID())
+	body = translateBodyMediaSourceID(body)
+	body = h.applyPlaybackPolicy(c, userFromCtx(c), query, body)
 	respBody, status, err := sc.ProxyJSON(c.Request.Context(), method,
 		"/items/"+backendID+"/playbackinfo", query, body)
 	if err != nil {
@@ -60,27 +88,61 @@ func (h *MediaHandler) GetPlaybackInfo(c *gin.Context) {
 	writeJSON(c, respBody, status)
 }
 
-// GetImage handles GET /Items/:itemId/images/:imageType[/:imageIndex].
-// A single handler covers both routes; imageIndex is "" when not present.
-func (h *MediaHandler) GetImage(c *gin.Context) {
-	proxyID := c.Param("itemId")
-	prefix, backendID, err := idtrans.Decode(proxyID)
-	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
-		return
+// bodyMediaSourceID returns the MediaSourceId field of a PlaybackInfo POST
+// body, or "" when the body is not a JSON object or does not carry one.
+func bodyMediaSourceID(body []byte) string {
+	var fields map[string]json.RawMessage
+	if len(body) == 0 || json.Unmarshal(body, &fields) != nil {
+		return ""
+	}
+	for k, v := range fields {
+		if strings.EqualFold(k, "MediaSourceId") {
+			var id string
+			if json.Unmarshal(v, &id) == nil {
+				return id
+			}
+		}
 	}
+	return ""
+}
 
-	// Images are served unauthenticated. Use a user-scoped client when a user
-	// is present (better token), otherwise fall back to the server service account.
-	var sc *backend.ServerClient
-	user := h.tryResolveUser(c)
-	if user != nil {
-		sc, err = h.pool.ForUser(c.Request.Context(), prefix, user)
-	} else {
-		sc, err = h.pool.ForBackend(c.Request.Context(), prefix)
+// translateBodyMediaSourceID strips the proxy prefix from the MediaSourceId
+// of a PlaybackInfo POST body, as forwardQuery does for the query parameter.
+// Bodies without a prefixed MediaSourceId are returned unchanged.
+func translateBodyMediaSourceID(body []byte) []byte {
+	msID := bodyMediaSourceID(body)
+	if msID == "" {
+		return body
+	}
+	_, backendID, err := idtrans.Decode(msID)
+	if err != nil {
+		return body
 	}
+	var fields map[string]json.RawMessage
+	if json.Unmarshal(body, &fields) != nil {
+		return body
+	}
+	for k := range fields {
+		if strings.EqualFold(k, "MediaSourceId") {
+			fields[k], _ = json.Marshal(backendID)
+		}
+	}
+	out, err := json.Marshal(fields)
+	if err != nil {
+		return body
+	}
+	return out
+}
+
+// GetImage handles GET /Items/:itemId/images/:imageType[/:imageIndex].
+// A single handler covers both routes; imageIndex is "" when not present.
+// Images are served unauthenticated: routeByIDPublic uses a user-scoped
+// client when a user is present (better token), otherwise the server service
+// account, and resolves virtual dup_ IDs to a reachable copy.
+func (h *MediaHandler) GetImage(c *gin.Context) {
+	sc, backendID, err := h.routeByIDPublic(c, c.Param("itemId"))
 	if err != nil {
-		c.JSON(http.StatusNotFound, gin.H{"error": "server not found"})
+		routeError(c, err)
 		return
 	}
 
@@ -102,9 +164,13 @@ func (h *MediaHandler) GetImage(c *gin.Context) {
 
 // StreamVideo handles GET /Videos/:itemId/stream and /Videos/:itemId/stream.:container.
 func (h *MediaHandler) StreamVideo(c *gin.Context) {
+	if err := h.checkPlayback(c); err != nil {
+		routeError(c, err)
+		return
+	}
 	sc, backendID, err := h.routeByID(c, c.Param("itemId"))
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		routeError(c, err)
 		return
 	}
 
@@ -127,9 +193,13 @@ func (h *MediaHandler) StreamVideo(c *gin.Context) {
 // HLSMasterPlaylist handles GET /Videos/:itemId/master.m3u8 and /videos/:itemId/main.m3u8.
 // These are the HLS master playlist URLs returned in TranscodingUrl from PlaybackInfo.
 func (h *MediaHandler) HLSMasterPlaylist(c *gin.Context) {
+	if err := h.checkPlayback(c); err != nil {
+		routeError(c, err)
+		return
+	}
 	sc, backendID, err := h.routeByID(c, c.Param("itemId"))
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		routeError(c, err)
 		return
 	}
 	playlist := c.Param("playlist") // "master.m3u8" or "main.m3u8"
@@ -153,9 +223,13 @@ func (h *MediaHandler) HLSMasterPlaylist(c *gin.Context) {
 // HLSSegment handles GET /Videos/:itemId/:playSessionId/hls1/:segmentId/:segment.
 // These are the individual HLS transport stream segments.
 func (h *MediaHandler) HLSSegment(c *gin.Context) {
+	if err := h.checkPlayback(c); err != nil {
+		routeError(c, err)
+		return
+	}
 	sc, backendID, err := h.routeByID(c, c.Param("itemId"))
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		routeError(c, err)
 		return
 	}
 	path := "/videos/" + backendID + c.Param("playSessionId") + "/hls1" +
@@ -175,9 +249,13 @@ func (h *MediaHandler) HLSSegment(c *gin.Context) {
 
 // StreamAudio handles GET /Audio/:itemId/stream and /Audio/:itemId/stream.:container.
 func (h *MediaHandler) StreamAudio(c *gin.Context) {
+	if err := h.checkPlayback(c); err != nil {
+		routeError(c, err)
+		return
+	}
 	sc, backendID, err := h.routeByIDPublic(c, c.Param("itemId"))
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		routeError(c, err)
 		return
 	}
 
@@ -199,9 +277,13 @@ func (h *MediaHandler) StreamAudio(c *gin.Context) {
 
 // UniversalAudio handles GET /Audio/:itemId/universal.
 func (h *MediaHandler) UniversalAudio(c *gin.Context) {
+	if err := h.checkPlayback(c); err != nil {
+		routeError(c, err)
+		return
+	}
 	sc, backendID, err := h.routeByIDPublic(c, c.Param("itemId"))
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		routeError(c, err)
 		return
 	}
 	query := forwardQuery(c.Request.URL.Query(), sc.BackendUserID())
@@ -225,9 +307,13 @@ func (h *MediaHandler) UniversalAudio(c *gin.Context) {
 //	/{mediaSourceId}/Subtitles/...   → subtitle stream
 //	(anything else)                  → generic proxy stream
 func (h *MediaHandler) VideoSubpath(c *gin.Context) {
+	if err := h.checkPlayback(c); err != nil {
+		routeError(c, err)
+		return
+	}
 	sc, backendID, err := h.routeByIDPublic(c, c.Param("itemId"))
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		routeError(c, err)
 		return
 	}
 
@@ -322,7 +408,7 @@ func (h *MediaHandler) VideoSubpath(c *gin.Context) {
 func (h *MediaHandler) GetSubtitle(c *gin.Context) {
 	sc, backendID, err := h.routeByID(c, c.Param("itemId"))
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		routeError(c, err)
 		return
 	}
 	_, msBackendID, _ := idtrans.Decode(c.Param("mediaSourceId"))
@@ -395,10 +481,15 @@ func injectTokenIntoTagURI(line, param string) string {
 
 // Download handles GET /Items/:itemId/Download.
 // Public endpoint — clients pass their token via the api_key query param.
+// Users whose policy disables downloading get 403.
 func (h *MediaHandler) Download(c *gin.Context) {
+	if err := h.checkDownload(c); err != nil {
+		routeError(c, err)
+		return
+	}
 	sc, backendID, err := h.routeByIDPublic(c, c.Param("itemId"))
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		routeError(c, err)
 		return
 	}
 	path := "/items/" + backendID + "/download"
@@ -417,7 +508,7 @@ func (h *MediaHandler) Download(c *gin.Context) {
 func (h *MediaHandler) Lyrics(c *gin.Context) {
 	sc, backendID, err := h.routeByID(c, c.Param("itemId"))
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		routeError(c, err)
 		return
 	}
 	query := forwardQuery(c.Request.URL.Query(), sc.BackendUserID())
@@ -462,16 +553,15 @@ func (h *MediaHandler) forwardPlaybackReport(c *gin.Context, endpoint string) {
 		return
 	}
 
-	prefix, _, err := idtrans.Decode(payload.ItemId)
+	sc, backendID, err := h.routeByID(c, payload.ItemId)
 	if err != nil {
 		c.Status(http.StatusNoContent)
 		return
 	}
-
-	sc, err := h.pool.ForUser(c.Request.Context(), prefix, userFromCtx(c))
-	if err != nil {
-		c.Status(http.StatusNoContent)
-		return
+	// A virtual dup_ ID means nothing to the backend: report the copy it
+	// resolved to, so resume position and watched state land on that item.
+	if idtrans.IsDup(payload.ItemId) {
+		body = withItemID(body, idtrans.Encode(sc.Prefix(), backendID))
 	}
 
 	// ProxyJSON calls RewriteRequest internally to strip proxy prefixes from the body.
@@ -481,5 +571,113 @@ func (h *MediaHandler) forwardPlaybackReport(c *gin.Context, endpoint string) {
 		gatewayError(c, err)
 		return
 	}
+	if status < 300 {
+		h.recordPlayback(c, sc, endpoint, body)
+	}
 	c.Status(status)
 }
+
+// withItemID returns the JSON object body with its ItemId set to itemID.
+// body is returned unchanged if it cannot be decoded.
+func withItemID(body []byte, itemID string) []byte {
+	var obj map[string]json.RawMessage
+	if err := json.Unmarshal(body, &obj); err != nil {
+		return body
+	}
+	idJSON, err := json.Marshal(itemID)
+	if err != nil {
+		return body
+	}
+	obj["ItemId"] = idJSON
+	out, err := json.Marshal(obj)
+	if err != nil {
+		return body
+	}
+	return out
+}
+
+// recordPlayback updates the playback registry from a report the backend
+// accepted. Item and transcoding details are not part of the report; they
+// are read from the backend's session in the background.
+func (h *MediaHandler) recordPlayback(c *gin.Context, sc *backend.ServerClient, endpoint string, body []byte) {
+	session := sessionFromCtx(c)
+	if h.playing == nil || session == nil {
+		return
+	}
+	var report struct {
+		ItemId              string `json:"ItemId"`
+		MediaSourceId       string `json:"MediaSourceId"`
+		PlaySessionId       string `json:"PlaySessionId"`
+		PlayMethod          string `json:"PlayMethod"`
+		PositionTicks       int64  `json:"PositionTicks"`
+		IsPaused            bool   `json:"IsPaused"`
+		IsMuted             bool   `json:"IsMuted"`
+		AudioStreamIndex    *int   `json:"AudioStreamIndex"`
+		SubtitleStreamIndex *int   `json:"SubtitleStreamIndex"`
+	}
+	if err := json.Unmarshal(body, &report); err != nil {
+		return
+	}
+	user := userFromCtx(c)
+	if endpoint == "Playing/Stopped" {
+		h.playing.stop(c.Request.Context(), user.ID, session.DeviceID, report.PositionTicks)
+		return
+	}
+	refresh := h.playing.report(c.Request.Context(), activePlayback{
+		SessionID:           session.ID,
+		UserID:              user.ID,
+		Username:            user.Username,
+		DeviceID:            session.DeviceID,
+		DeviceName:          session.DeviceName,
+		Client:              session.AppName,
+		ItemID:              report.ItemId,
+		BackendID:           sc.BackendID(),
+		BackendName:         sc.BackendName(),
+		MediaSourceID:       report.MediaSourceId,
+		PlaySessionID:       report.PlaySessionId,
+		PositionTicks:       report.PositionTicks,
+		IsPaused:            report.IsPaused,
+		IsMuted:             report.IsMuted,
+		AudioStreamIndex:    report.AudioStreamIndex,
+		SubtitleStreamIndex: report.SubtitleStreamIndex,
+		PlayMethod:          report.PlayMethod,
+	})
+	if refresh {
+		go h.refreshPlayback(sc, user.ID, session.DeviceID, report.ItemId, report.PlaySessionId)
+	}
+}
+
+// refreshPlayback looks up the backend session that plays itemID and stores
+// its NowPlayingItem and TranscodingInfo in the registry. The session is
+// matched by PlaySessionId when the client sent one, otherwise by item.
+func (h *MediaHandler) refreshPlayback(sc *backend.ServerClient, userID uuid.UUID, deviceID, itemID, playSessionID string) {
+	ctx, cancel := context.WithTimeout(context.Background(), fanOutTimeout)
+	defer cancel()
+	body, status, err := sc.ProxyJSON(ctx, "GET", "/sessions", nil, nil)
+	if err != nil || status != http.StatusOK {
+		return
+	}
+	var sessions []struct {
+		NowPlayingItem json.RawMessage `json:"NowPlayingItem"`
+		PlayState      struct {
+			PlaySessionId string `json:"PlaySessionId"`
+		} `json:"PlayState"`
+		TranscodingInfo json.RawMessage `json:"TranscodingInfo"`
+	}
+	if err := json.Unmarshal(body, &sessions); err != nil {
+		return
+	}
+	for _, s := range sessions {
+		var item struct {
+			Id string `json:"Id"`
+		}
+		if len(s.NowPlayingItem) == 0 || json.Unmarshal(s.NowPlayingItem, &item) != nil || item.Id != itemID {
+			continue
+		}
+		if playSessionID != "" && s.PlayState.PlaySessionId != playSessionID {
+			continue
+		}
+		h.playing.enrich(userID, deviceID, itemID, s.NowPlayingItem, s.TranscodingInfo)
+		return
+	}
+}
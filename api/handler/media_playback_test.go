@@ -5,7 +5,9 @@ import (
 	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
 	"strings"
+	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -16,6 +18,7 @@ import (
 	"github.com/ddevcap/jellyfin-proxy/api/middleware"
 	"github.com/ddevcap/jellyfin-proxy/backend"
 	"github.com/ddevcap/jellyfin-proxy/config"
+	"github.com/ddevcap/jellyfin-proxy/ent"
 	"github.com/ddevcap/jellyfin-proxy/idtrans"
 )
 
@@ -48,6 +51,7 @@ func playbackRouter(directStream bool) (*gin.Engine, string) {
 		ServerName:   "Test Proxy",
 		DirectStream: directStream,
 		ExternalURL:  "http://proxy:8096",
+		SessionTTL:   24 * time.Hour,
 	}
 	pool := backend.NewPool(db, cfg)
 	mediaH := handler.NewMediaHandler(pool, cfg, db)
@@ -60,8 +64,10 @@ func playbackRouter(directStream bool) (*gin.Engine, string) {
 	priv.GET("/items/:itemId/playbackinfo", mediaH.GetPlaybackInfo)
 	priv.POST("/items/:itemId/playbackinfo", mediaH.GetPlaybackInfo)
 
-	// Public route — browsers fetch HLS/stream URLs without custom headers.
+	// Public routes — browsers fetch HLS/stream URLs without custom headers.
 	r.GET("/videos/:itemId/*subpath", mediaH.VideoSubpath)
+	r.GET("/items/:itemId/download", mediaH.Download)
+	r.GET("/audio/:itemId/universal", mediaH.UniversalAudio)
 
 	proxyID := idtrans.Encode(pbPrefix, pbBackendItemID)
 	return r, proxyID
@@ -365,9 +371,9 @@ var _ = Describe("Playback flow", func() {
 				Expect(loc).NotTo(ContainSubstring("ApiKey=" + pbProxyToken))
 			})
 
-			It("omits ApiKey entirely when no user can be resolved", func() {
+			It("refuses the playlist when no user can be resolved", func() {
 				fakeBackend := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-					w.WriteHeader(http.StatusOK)
+					w.WriteHeader(http.StatusOK) // should not be reached
 				}))
 				defer fakeBackend.Close()
 
@@ -378,14 +384,8 @@ var _ = Describe("Playback flow", func() {
 					"/videos/"+proxyItemID+"/master.m3u8?ApiKey=&MediaSourceId=x",
 				)
 
-				Expect(w.Code).To(Equal(http.StatusFound))
-				loc := w.Header().Get("Location")
-
-				// Must not contain an empty ApiKey.
-				if strings.Contains(loc, "ApiKey=") {
-					Expect(loc).NotTo(ContainSubstring("ApiKey=&"))
-					Expect(loc).NotTo(MatchRegexp(`ApiKey=$`))
-				}
+				Expect(w.Code).To(Equal(http.StatusUnauthorized))
+				Expect(w.Header().Get("Location")).To(BeEmpty())
 			})
 		})
 	})
@@ -584,6 +584,128 @@ var _ = Describe("Playback flow", func() {
 		})
 	})
 
+	// ═══════════════════════════════════════════════════════════════════════
+	// USER POLICY: flags set from the dashboard's user editor
+	// ═══════════════════════════════════════════════════════════════════════
+
+	Describe("User policy", func() {
+		var (
+			fakeBackend *httptest.Server
+			router      *gin.Engine
+			proxyItemID string
+			gotQuery    url.Values
+			gotBody     map[string]interface{}
+		)
+
+		BeforeEach(func() {
+			gotQuery, gotBody = nil, nil
+			fakeBackend = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				gotQuery = r.URL.Query()
+				_ = json.NewDecoder(r.Body).Decode(&gotBody)
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = fmt.Fprint(w, `{"MediaSources":[]}`)
+			}))
+			setupPlaybackDB(fakeBackend.URL)
+			router, proxyItemID = playbackRouter(false)
+		})
+
+		AfterEach(func() {
+			fakeBackend.Close()
+		})
+
+		update := func(apply func(*ent.UserUpdate)) {
+			upd := db.User.Update()
+			apply(upd)
+			upd.ExecX(mediaCtx())
+		}
+		// postPlaybackInfo sends a PlaybackInfo request from the client ip.
+		postPlaybackInfo := func(ip string, body string) int {
+			req := httptest.NewRequest(http.MethodPost, "/items/"+proxyItemID+"/playbackinfo?MaxStreamingBitrate=20000000",
+				strings.NewReader(body))
+			req.RemoteAddr = ip + ":40000"
+			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set("X-Emby-Token", pbProxyToken)
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+			return w.Code
+		}
+
+		It("turns transcoding off when video transcoding is disabled", func() {
+			update(func(u *ent.UserUpdate) { u.SetEnableVideoPlaybackTranscoding(false) })
+
+			Expect(postPlaybackInfo("192.168.1.10", `{"EnableTranscoding":true,"DeviceProfile":{}}`)).
+				To(Equal(http.StatusOK))
+			Expect(gotQuery.Get("EnableTranscoding")).To(Equal("false"))
+			Expect(gotBody).To(HaveKeyWithValue("EnableTranscoding", false))
+			Expect(gotBody).To(HaveKey("DeviceProfile"))
+		})
+
+		It("caps the bitrate of remote clients only", func() {
+			update(func(u *ent.UserUpdate) { u.SetRemoteClientBitrateLimit(3000000) })
+
+			Expect(postPlaybackInfo("203.0.113.7", `{"MaxStreamingBitrate":120000000}`)).To(Equal(http.StatusOK))
+			Expect(gotQuery.Get("MaxStreamingBitrate")).To(Equal("3000000"))
+			Expect(gotBody).To(HaveKeyWithValue("MaxStreamingBitrate", BeNumerically("==", 3000000)))
+
+			Expect(postPlaybackInfo("192.168.1.10", `{"MaxStreamingBitrate":120000000}`)).To(Equal(http.StatusOK))
+			Expect(gotQuery.Get("MaxStreamingBitrate")).To(Equal("20000000"))
+			Expect(gotBody).To(HaveKeyWithValue("MaxStreamingBitrate", BeNumerically("==", 120000000)))
+		})
+
+		It("refuses playback when media playback is disabled", func() {
+			update(func(u *ent.UserUpdate) { u.SetEnableMediaPlayback(false) })
+
+			Expect(postPlaybackInfo("192.168.1.10", `{}`)).To(Equal(http.StatusForbidden))
+			w := doGet(router, "/videos/"+proxyItemID+"/stream?ApiKey="+pbProxyToken)
+			Expect(w.Code).To(Equal(http.StatusForbidden))
+		})
+
+		It("refuses downloads when content downloading is disabled", func() {
+			Expect(doGet(router, "/items/"+proxyItemID+"/download?api_key="+pbProxyToken).Code).
+				To(Equal(http.StatusOK))
+
+			update(func(u *ent.UserUpdate) { u.SetEnableContentDownloading(false) })
+			Expect(doGet(router, "/items/"+proxyItemID+"/download?api_key="+pbProxyToken).Code).
+				To(Equal(http.StatusForbidden))
+		})
+
+		It("refuses streams and downloads without a token", func() {
+			Expect(doGet(router, "/items/"+proxyItemID+"/download").Code).To(Equal(http.StatusUnauthorized))
+			Expect(doGet(router, "/videos/"+proxyItemID+"/stream").Code).To(Equal(http.StatusUnauthorized))
+			Expect(doGet(router, "/audio/"+proxyItemID+"/universal").Code).To(Equal(http.StatusUnauthorized))
+		})
+
+		It("refuses streams and downloads with an expired session", func() {
+			db.Session.Update().SetLastActivity(time.Now().Add(-48 * time.Hour)).ExecX(mediaCtx())
+
+			Expect(doGet(router, "/videos/"+proxyItemID+"/stream?ApiKey="+pbProxyToken).Code).
+				To(Equal(http.StatusUnauthorized))
+			Expect(db.Session.Query().CountX(mediaCtx())).To(BeZero())
+			Expect(doGet(router, "/items/"+proxyItemID+"/download?api_key="+pbProxyToken).Code).
+				To(Equal(http.StatusUnauthorized))
+		})
+
+		It("refuses remote streams and downloads without remote access", func() {
+			update(func(u *ent.UserUpdate) { u.SetEnableRemoteAccess(false) })
+			// get fetches path from the client ip.
+			get := func(ip, path string) int {
+				req := httptest.NewRequest(http.MethodGet, path, nil)
+				req.RemoteAddr = ip + ":40000"
+				w := httptest.NewRecorder()
+				router.ServeHTTP(w, req)
+				return w.Code
+			}
+
+			for _, path := range []string{
+				"/videos/" + proxyItemID + "/stream?ApiKey=" + pbProxyToken,
+				"/items/" + proxyItemID + "/download?api_key=" + pbProxyToken,
+			} {
+				Expect(get("203.0.113.7", path)).To(Equal(http.StatusForbidden))
+				Expect(get("192.168.1.10", path)).To(Equal(http.StatusOK))
+			}
+		})
+	})
+
 	// ═══════════════════════════════════════════════════════════════════════
 	// SECURITY: ApiKey handling invariants
 	// ═══════════════════════════════════════════════════════════════════════
@@ -653,3 +775,186 @@ var _ = Describe("Playback flow", func() {
 		})
 	})
 })
+
+var _ = Describe("Playback registry", func() {
+	var (
+		router      *gin.Engine
+		fake        *httptest.Server
+		proxyItemID string
+	)
+
+	client := map[string]string{"X-Emby-Token": pbProxyToken}
+	admin := map[string]string{"X-Emby-Token": "registry-admin-token"}
+
+	BeforeEach(func() {
+		cleanDB()
+		fake = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Method == http.MethodPost {
+				w.WriteHeader(http.StatusNoContent)
+				return
+			}
+			Expect(r.URL.Path).To(Equal("/sessions"))
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = fmt.Fprintf(w, `[
+				{"NowPlayingItem": {"Id": "other", "Name": "Someone Else's Movie"}, "PlayState": {"PlaySessionId": "ps-other"}},
+				{
+					"NowPlayingItem": {"Id": "%s", "Name": "Big Movie", "Type": "Movie", "RunTimeTicks": 72000000000},
+					"PlayState": {"PlaySessionId": "ps-1"},
+					"TranscodingInfo": {"Bitrate": 8000000, "TranscodeReasons": ["VideoCodecNotSupported"]}
+				}
+			]`, pbBackendItemID)
+		}))
+		setupPlaybackDB(fake.URL)
+		createSession(createUser("registryadmin", "password1!", true), "registry-admin-token")
+
+		cfg := config.Config{ServerID: "test-server-id"}
+		pool := backend.NewPool(db, cfg)
+		playing := handler.NewPlaybackRegistry(db)
+		mediaH := handler.NewMediaHandler(pool, cfg, db)
+		mediaH.SetPlaybackRegistry(playing)
+		sessionH := handler.NewSessionHandler(db, cfg, handler.NewWSHub(pool), playing)
+
+		router = gin.New()
+		priv := router.Group("/")
+		priv.Use(middleware.Auth(db, cfg))
+		priv.GET("/sessions", sessionH.GetSessions)
+		priv.POST("/sessions/playing", mediaH.ReportPlaybackStart)
+		priv.POST("/sessions/playing/progress", mediaH.ReportPlaybackProgress)
+		priv.POST("/sessions/playing/stopped", mediaH.ReportPlaybackStopped)
+		router.GET("/proxy/sessions/active", middleware.Auth(db, cfg), middleware.AdminOnly(), sessionH.ActivePlayback)
+
+		proxyItemID = idtrans.Encode(pbPrefix, pbBackendItemID)
+	})
+
+	AfterEach(func() {
+		fake.Close()
+	})
+
+	type playback struct {
+		Username         string   `json:"username"`
+		DeviceID         string   `json:"device_id"`
+		ItemID           string   `json:"item_id"`
+		ItemName         string   `json:"item_name"`
+		BackendName      string   `json:"backend_name"`
+		PositionTicks    int64    `json:"position_ticks"`
+		IsPaused         bool     `json:"is_paused"`
+		PlayMethod       string   `json:"play_method"`
+		TranscodeReasons []string `json:"transcode_reasons"`
+		Bitrate          int64    `json:"bitrate"`
+	}
+	active := func() []playback {
+		w := doGet(router, "/proxy/sessions/active", admin)
+		Expect(w.Code).To(Equal(http.StatusOK))
+		var resp []playback
+		Expect(json.Unmarshal(w.Body.Bytes(), &resp)).To(Succeed())
+		return resp
+	}
+	report := func(path string, body map[string]interface{}) {
+		body["ItemId"] = proxyItemID
+		body["PlaySessionId"] = "ps-1"
+		w := doPost(router, path, body, client)
+		Expect(w.Code).To(Equal(http.StatusNoContent))
+	}
+
+	It("lists a started stream with the backend's item and transcoding details", func() {
+		report("/sessions/playing", map[string]interface{}{"PlayMethod": "Transcode", "PositionTicks": 0})
+
+		Eventually(func() string {
+			list := active()
+			if len(list) != 1 {
+				return ""
+			}
+			return list[0].ItemName
+		}).Should(Equal("Big Movie"))
+		p := active()[0]
+		Expect(p.Username).To(Equal("pbuser"))
+		Expect(p.DeviceID).To(Equal("test-device"))
+		Expect(p.ItemID).To(Equal(proxyItemID))
+		Expect(p.BackendName).To(Equal("Playback Test Backend"))
+		Expect(p.PlayMethod).To(Equal("Transcode"))
+		Expect(p.TranscodeReasons).To(Equal([]string{"VideoCodecNotSupported"}))
+		Expect(p.Bitrate).To(Equal(int64(8000000)))
+	})
+
+	It("tracks progress and forgets the stream once it stops", func() {
+		report("/sessions/playing", map[string]interface{}{"PlayMethod": "DirectPlay"})
+		report("/sessions/playing/progress", map[string]interface{}{"PlayMethod": "DirectPlay", "PositionTicks": 600000000, "IsPaused": true})
+
+		list := active()
+		Expect(list).To(HaveLen(1))
+		Expect(list[0].PositionTicks).To(Equal(int64(600000000)))
+		Expect(list[0].IsPaused).To(BeTrue())
+
+		report("/sessions/playing/stopped", map[string]interface{}{"PositionTicks": 700000000})
+		Expect(active()).To(BeEmpty())
+	})
+
+	It("shows what each session is playing in the admin session list", func() {
+		report("/sessions/playing", map[string]interface{}{"PlayMethod": "Transcode", "PositionTicks": 300000000})
+
+		Eventually(func() interface{} {
+			w := doGet(router, "/sessions", admin)
+			Expect(w.Code).To(Equal(http.StatusOK))
+			var sessions []map[string]interface{}
+			Expect(json.Unmarshal(w.Body.Bytes(), &sessions)).To(Succeed())
+			for _, s := range sessions {
+				if s["UserName"] == "pbuser" {
+					if item, ok := s["NowPlayingItem"].(map[string]interface{}); ok {
+						return item["Name"]
+					}
+				}
+			}
+			return nil
+		}).Should(Equal("Big Movie"))
+
+		w := doGet(router, "/sessions", admin)
+		var sessions []map[string]interface{}
+		Expect(json.Unmarshal(w.Body.Bytes(), &sessions)).To(Succeed())
+		for _, s := range sessions {
+			if s["UserName"] != "pbuser" {
+				Expect(s).NotTo(HaveKey("NowPlayingItem"))
+				continue
+			}
+			state := s["PlayState"].(map[string]interface{})
+			Expect(state["PositionTicks"]).To(BeNumerically("==", 300000000))
+			Expect(state["PlayMethod"]).To(Equal("Transcode"))
+			Expect(s["TranscodingInfo"]).To(HaveKeyWithValue("Bitrate", BeNumerically("==", 8000000)))
+		}
+	})
+
+	It("records each playback in the history", func() {
+		report("/sessions/playing", map[string]interface{}{"PlayMethod": "DirectPlay"})
+		Eventually(func() string {
+			return db.PlaybackEvent.Query().OnlyX(mediaCtx()).ItemName
+		}).Should(Equal("Big Movie"))
+		report("/sessions/playing/stopped", map[string]interface{}{"PositionTicks": 900000000})
+
+		e := db.PlaybackEvent.Query().OnlyX(mediaCtx())
+		Expect(e.Username).To(Equal("pbuser"))
+		Expect(e.ItemID).To(Equal(proxyItemID))
+		Expect(e.ItemType).To(Equal("Movie"))
+		Expect(e.BackendName).To(Equal("Playback Test Backend"))
+		Expect(e.AppName).To(Equal("Test App"))
+		Expect(e.PlayMethod).To(Equal("DirectPlay"))
+		Expect(e.PositionTicks).To(Equal(int64(900000000)))
+		Expect(e.StoppedAt).NotTo(BeNil())
+	})
+
+	It("closes playbacks left open by a previous run", func() {
+		report("/sessions/playing", map[string]interface{}{"PlayMethod": "DirectPlay"})
+		e := db.PlaybackEvent.Query().OnlyX(mediaCtx())
+		Expect(e.StoppedAt).To(BeNil())
+		db.PlaybackEvent.UpdateOne(e).SetPlayDuration(90).ExecX(mediaCtx())
+
+		handler.NewPlaybackRegistry(db)
+
+		e = db.PlaybackEvent.Query().OnlyX(mediaCtx())
+		Expect(e.StoppedAt).NotTo(BeNil())
+		Expect(*e.StoppedAt).To(BeTemporally("~", e.StartedAt.Add(90*time.Second), time.Second))
+	})
+
+	It("is not visible to regular users", func() {
+		w := doGet(router, "/proxy/sessions/active", client)
+		Expect(w.Code).To(Equal(http.StatusForbidden))
+	})
+})
@@ -0,0 +1,162 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/ddevcap/jellyfin-proxy/api/middleware"
+	"github.com/ddevcap/jellyfin-proxy/config"
+	"github.com/ddevcap/jellyfin-proxy/ent"
+	"github.com/gin-gonic/gin"
+)
+
+// ── User policy ───────────────────────────────────────────────────────────────
+//
+// The playback flags of a user's Jellyfin policy, edited from the dashboard,
+// are enforced by the proxy: backends only ever see the shared backend
+// account. Disabled users and remote access are refused by middleware.Auth on
+// authenticated routes, and by checkUser on the public stream and download
+// routes, which resolve their token themselves.
+
+var (
+	// errPlaybackDisabled is returned for stream requests of a user whose
+	// policy disables media playback.
+	errPlaybackDisabled = fmt.Errorf("%w: media playback is disabled for this user", errAccessDenied)
+	// errDownloadDisabled is returned for downloads of a user whose policy
+	// disables content downloading.
+	errDownloadDisabled = fmt.Errorf("%w: downloading is disabled for this user", errAccessDenied)
+	// errAuthRequired is returned for stream and download requests that carry
+	// no valid token, so the user's policy cannot be checked.
+	errAuthRequired = errors.New("authentication required")
+)
+
+// ctxKeyResolvedUser caches the result of tryResolveUser for the request,
+// including a nil user, so public stream routes look the token up once.
+const ctxKeyResolvedUser = "resolved_user"
+
+// remoteBitrateLimit returns the bitrate in bits/s user may stream at from
+// outside the local network, 0 meaning unlimited.
+func remoteBitrateLimit(user *ent.User, cfg config.Config) int {
+	if user.RemoteClientBitrateLimit != nil {
+		return *user.RemoteClientBitrateLimit
+	}
+	return cfg.BitrateLimit
+}
+
+// checkUser returns the requesting user of a public stream or download route,
+// or errAuthRequired without a valid token. Users that middleware.Auth would
+// refuse, because they are disabled or connect remotely without remote
+// access, are denied.
+func (h *MediaHandler) checkUser(c *gin.Context) (*ent.User, error) {
+	user := h.tryResolveUser(c)
+	if user == nil {
+		return nil, errAuthRequired
+	}
+	if err := middleware.CheckUserPolicy(c, user); err != nil {
+		return nil, fmt.Errorf("%w: %w", errAccessDenied, err)
+	}
+	return user, nil
+}
+
+// checkPlayback returns errPlaybackDisabled when the requesting user may not
+// play media, and the error of checkUser when they may not use the server.
+func (h *MediaHandler) checkPlayback(c *gin.Context) error {
+	user, err := h.checkUser(c)
+	if err != nil {
+		return err
+	}
+	if !user.EnableMediaPlayback {
+		return errPlaybackDisabled
+	}
+	return nil
+}
+
+// checkDownload returns errDownloadDisabled when the requesting user may not
+// download content, and the error of checkUser when they may not use the
+// server.
+func (h *MediaHandler) checkDownload(c *gin.Context) error {
+	user, err := h.checkUser(c)
+	if err != nil {
+		return err
+	}
+	if !user.EnableContentDownloading {
+		return errDownloadDisabled
+	}
+	return nil
+}
+
+// applyPlaybackPolicy forces the PlaybackInfo options user's policy
+// requires onto query and the JSON body: transcoding is turned off when video
+// transcoding is disabled, and MaxStreamingBitrate is capped for remote
+// clients. Clients send these options in either place, so both are set.
+// Returns the body to forward.
+func (h *MediaHandler) applyPlaybackPolicy(c *gin.Context, user *ent.User, query url.Values, body []byte) []byte {
+	var fields map[string]json.RawMessage
+	if len(body) > 0 && json.Unmarshal(body, &fields) != nil {
+		fields = nil // not an object; forwarded untouched
+	}
+	set := func(name string, v interface{}) {
+		setFold(query, name, fmt.Sprint(v))
+		if fields != nil {
+			for k := range fields {
+				if strings.EqualFold(k, name) {
+					delete(fields, k)
+				}
+			}
+			fields[name], _ = json.Marshal(v)
+		}
+	}
+
+	if !user.EnableVideoPlaybackTranscoding {
+		set("EnableTranscoding", false)
+	}
+	if limit := remoteBitrateLimit(user, h.cfg); limit > 0 && !middleware.IsLocalAddress(middleware.ClientIP(c)) {
+		bitrate := limit
+		requested := []string{getFold(query, "MaxStreamingBitrate")}
+		for k, v := range fields {
+			if strings.EqualFold(k, "MaxStreamingBitrate") {
+				requested = append(requested, string(v))
+			}
+		}
+		for _, s := range requested {
+			if n, err := strconv.Atoi(s); err == nil && n > 0 && n < bitrate {
+				bitrate = n
+			}
+		}
+		set("MaxStreamingBitrate", bitrate)
+	}
+
+	if fields == nil {
+		return body
+	}
+	out, err := json.Marshal(fields)
+	if err != nil {
+		return body
+	}
+	return out
+}
+
+// getFold returns the first value of the query parameter name, matched
+// case-insensitively.
+func getFold(q url.Values, name string) string {
+	for k, v := range q {
+		if strings.EqualFold(k, name) && len(v) > 0 {
+			return v[0]
+		}
+	}
+	return ""
+}
+
+// setFold sets the query parameter name, replacing it in any letter case.
+func setFold(q url.Values, name, value string) {
+	for k := range q {
+		if strings.EqualFold(k, name) {
+			q.Del(k)
+		}
+	}
+	q.Set(name, value)
+}
@@ -0,0 +1,268 @@
+package handler
+
+import (
+	"cmp"
+	"encoding/binary"
+	"hash/fnv"
+	"math/rand/v2"
+	"strconv"
+	"strings"
+)
+
+// sortKeys holds the fields of an item that merged results can be sorted by.
+// Pointer fields are absent on some items; like Jellyfin's database, absent
+// values sort before any value in ascending order.
+type sortKeys struct {
+	Id                string   `json:"Id"`
+	Name              string   `json:"Name"`
+	SortName          string   `json:"SortName"`
+	SeriesName        string   `json:"SeriesName"`
+	SeriesSortName    string   `json:"SeriesSortName"`
+	Album             string   `json:"Album"`
+	AlbumArtist       string   `json:"AlbumArtist"`
+	IndexNumber       *int     `json:"IndexNumber"`
+	ParentIndexNumber *int     `json:"ParentIndexNumber"`
+	ProductionYear    *int     `json:"ProductionYear"`
+	DateCreated       string   `json:"DateCreated"`
+	PremiereDate      string   `json:"PremiereDate"`
+	CommunityRating   *float64 `json:"CommunityRating"`
+	CriticRating      *float64 `json:"CriticRating"`
+	RunTimeTicks      *int64   `json:"RunTimeTicks"`
+	OfficialRating    string   `json:"OfficialRating"`
+	IsFolder          bool     `json:"IsFolder"`
+	UserData          struct {
+		LastPlayedDate string `json:"LastPlayedDate"`
+		PlayCount      int    `json:"PlayCount"`
+		Played         bool   `json:"Played"`
+		IsFavorite     bool   `json:"IsFavorite"`
+		Likes          *bool  `json:"Likes"`
+	} `json:"UserData"`
+}
+
+// sortComparators maps Jellyfin SortBy keys (lower-cased) to a comparison of
+// the matching item fields. Dates are compared as strings: Jellyfin always
+// formats them as fixed-width UTC timestamps.
+var sortComparators = map[string]func(a, b *sortKeys) int{
+	"sortname": func(a, b *sortKeys) int { return compareFold(sortName(a), sortName(b)) },
+	"name":     func(a, b *sortKeys) int { return compareFold(a.Name, b.Name) },
+	"seriessortname": func(a, b *sortKeys) int {
+		return compareFold(seriesSortName(a), seriesSortName(b))
+	},
+	"album":       func(a, b *sortKeys) int { return compareFold(a.Album, b.Album) },
+	"albumartist": func(a, b *sortKeys) int { return compareFold(a.AlbumArtist, b.AlbumArtist) },
+	"indexnumber": func(a, b *sortKeys) int { return compareOptional(a.IndexNumber, b.IndexNumber) },
+	"parentindexnumber": func(a, b *sortKeys) int {
+		return compareOptional(a.ParentIndexNumber, b.ParentIndexNumber)
+	},
+	"productionyear": func(a, b *sortKeys) int {
+		return compareOptional(a.ProductionYear, b.ProductionYear)
+	},
+	"datecreated":  func(a, b *sortKeys) int { return strings.Compare(a.DateCreated, b.DateCreated) },
+	"premieredate": func(a, b *sortKeys) int { return strings.Compare(a.PremiereDate, b.PremiereDate) },
+	"dateplayed": func(a, b *sortKeys) int {
+		return strings.Compare(a.UserData.LastPlayedDate, b.UserData.LastPlayedDate)
+	},
+	"communityrating": func(a, b *sortKeys) int {
+		return compareOptional(a.CommunityRating, b.CommunityRating)
+	},
+	"criticrating": func(a, b *sortKeys) int { return compareOptional(a.CriticRating, b.CriticRating) },
+	"runtime":      func(a, b *sortKeys) int { return compareOptional(a.RunTimeTicks, b.RunTimeTicks) },
+	"playcount":    func(a, b *sortKeys) int { return cmp.Compare(a.UserData.PlayCount, b.UserData.PlayCount) },
+	"officialrating": func(a, b *sortKeys) int {
+		if n := compareOptional(ratingLevel(a.OfficialRating), ratingLevel(b.OfficialRating)); n != 0 {
+			return n
+		}
+		return strings.Compare(a.OfficialRating, b.OfficialRating)
+	},
+	"isfolder":   func(a, b *sortKeys) int { return compareBool(a.IsFolder, b.IsFolder) },
+	"isplayed":   func(a, b *sortKeys) int { return compareBool(a.UserData.Played, b.UserData.Played) },
+	"isunplayed": func(a, b *sortKeys) int { return compareBool(b.UserData.Played, a.UserData.Played) },
+	"isfavoriteorliked": func(a, b *sortKeys) int {
+		return compareBool(favoriteOrLiked(a), favoriteOrLiked(b))
+	},
+}
+
+// sortFields maps SortBy keys to the Fields value a backend must be asked
+// for so that items carry the value they are sorted by.
+var sortFields = map[string]string{
+	"sortname":    "SortName",
+	"datecreated": "DateCreated",
+}
+
+// sortTerm is one SortBy key with its direction.
+type sortTerm struct {
+	key  string // lower-cased SortBy key
+	desc bool
+}
+
+// parseSort pairs the SortBy keys with the SortOrder list the way Jellyfin
+// does: the n-th key uses the n-th order, and keys without their own order
+// use the first one given, or ascending when there is none.
+func parseSort(sortBy, sortOrder string) []sortTerm {
+	keys := splitCSV(sortBy)
+	orders := splitCSV(sortOrder)
+	terms := make([]sortTerm, len(keys))
+	for i, key := range keys {
+		dir := ""
+		if i < len(orders) {
+			dir = orders[i]
+		} else if len(orders) > 0 {
+			dir = orders[0]
+		}
+		terms[i] = sortTerm{key: strings.ToLower(key), desc: strings.EqualFold(dir, "descending")}
+	}
+	return terms
+}
+
+// itemOrder compares items by a Jellyfin SortBy list, applying each key in
+// turn until one tells the items apart. Keys the proxy doesn't know are
+// skipped.
+type itemOrder struct {
+	terms []sortTerm
+	seed  uint64 // orders items for the Random key
+}
+
+// newItemOrder returns the order for the client's SortBy and SortOrder
+// params, defaulting to SortName. Each order gets its own seed, so Random
+// shuffles differently per request but consistently across the pages of
+// one merge.
+func newItemOrder(sortBy, sortOrder string) itemOrder {
+	terms := parseSort(sortBy, sortOrder)
+	if len(terms) == 0 {
+		terms = []sortTerm{{key: "sortname"}}
+	}
+	return itemOrder{terms: terms, seed: rand.Uint64()}
+}
+
+// compare returns a negative number when a sorts before b, a positive one
+// when it sorts after and zero when the order does not tell them apart.
+func (o itemOrder) compare(a, b *sortKeys) int {
+	for _, t := range o.terms {
+		var n int
+		if t.key == "random" {
+			n = cmp.Compare(o.randomRank(a.Id), o.randomRank(b.Id))
+		} else if fn := sortComparators[t.key]; fn != nil {
+			n = fn(a, b)
+		}
+		if n != 0 {
+			if t.desc {
+				return -n
+			}
+			return n
+		}
+	}
+	return 0
+}
+
+// shuffles reports whether o has a Random key.
+func (o itemOrder) shuffles() bool {
+	for _, t := range o.terms {
+		if t.key == "random" {
+			return true
+		}
+	}
+	return false
+}
+
+// fields returns the Fields an item needs for o to compare it.
+func (o itemOrder) fields() []string {
+	var out []string
+	for _, t := range o.terms {
+		if f := sortFields[t.key]; f != "" {
+			out = append(out, f)
+		}
+	}
+	return out
+}
+
+// randomRank returns the pseudo-random position of an item under o's seed.
+func (o itemOrder) randomRank(id string) uint64 {
+	h := fnv.New64a()
+	_ = binary.Write(h, binary.LittleEndian, o.seed)
+	_, _ = h.Write([]byte(id))
+	return h.Sum64()
+}
+
+// sortName returns the name an item sorts by, which is its Name unless the
+// backend set a SortName.
+func sortName(k *sortKeys) string {
+	if k.SortName != "" {
+		return k.SortName
+	}
+	return k.Name
+}
+
+// seriesSortName returns the series name an episode sorts by, which is its
+// SeriesName unless the backend set a SeriesSortName.
+func seriesSortName(k *sortKeys) string {
+	if k.SeriesSortName != "" {
+		return k.SeriesSortName
+	}
+	return k.SeriesName
+}
+
+// favoriteOrLiked reports whether the user marked an item as favorite or liked it.
+func favoriteOrLiked(k *sortKeys) bool {
+	return k.UserData.IsFavorite || (k.UserData.Likes != nil && *k.UserData.Likes)
+}
+
+// compareFold compares two strings case-insensitively.
+func compareFold(a, b string) int {
+	return strings.Compare(strings.ToLower(a), strings.ToLower(b))
+}
+
+// compareOptional compares two optional values; an absent value sorts first.
+func compareOptional[T cmp.Ordered](a, b *T) int {
+	switch {
+	case a == nil && b == nil:
+		return 0
+	case a == nil:
+		return -1
+	case b == nil:
+		return 1
+	}
+	return cmp.Compare(*a, *b)
+}
+
+// compareBool sorts false before true.
+func compareBool(a, b bool) int {
+	switch {
+	case a == b:
+		return 0
+	case a:
+		return 1
+	}
+	return -1
+}
+
+// ratingLevels are the age levels Jellyfin assigns to the common US ratings.
+var ratingLevels = map[string]int{
+	"APPROVED": 0, "G": 0, "E": 0, "EC": 0, "TV-G": 0, "TV-Y": 0,
+	"TV-Y7": 7, "TV-Y7-FV": 7,
+	"PG": 10, "TV-PG": 10,
+	"PG-13": 13,
+	"T":     14, "TV-14": 14,
+	"R": 17, "M": 17, "TV-MA": 17,
+	"NC-17": 18, "AO": 18, "RP": 18, "UR": 18, "NR": 18, "X": 18,
+	"XXX": 1000,
+}
+
+// ratingLevel returns the age level Jellyfin sorts an official rating by.
+// Ratings it doesn't know by name, such as "DE-12" or "FSK-16", are ranked
+// by their trailing number. It returns nil for unrated items.
+func ratingLevel(rating string) *int {
+	rating = strings.ToUpper(strings.TrimSpace(rating))
+	if rating == "" {
+		return nil
+	}
+	if v, ok := ratingLevels[rating]; ok {
+		return &v
+	}
+	if i := strings.LastIndexAny(rating, "-: "); i >= 0 {
+		rating = rating[i+1:]
+	}
+	if v, err := strconv.Atoi(rating); err == nil {
+		return &v
+	}
+	return nil
+}
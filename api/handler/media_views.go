@@ -6,7 +6,6 @@ import (
 	"net/http"
 	"net/url"
 	"sort"
-	"strconv"
 	"strings"
 	"sync"
 
@@ -15,19 +14,37 @@ import (
 	entuser "github.com/ddevcap/jellyfin-proxy/ent/user"
 	"github.com/ddevcap/jellyfin-proxy/idtrans"
 	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
 	"github.com/jellydator/ttlcache/v3"
 )
 
 // GetUser handles GET /Users/:userId.
-// Always returns the authenticated caller's own profile; the path userId is
-// validated but not used to look up a different user.
+// Returns the authenticated caller's own profile. Admins get the user the
+// path names instead, which the dashboard's user editor loads; for anyone
+// else the path userId is not used to look up a different user.
 func (h *MediaHandler) GetUser(c *gin.Context) {
 	user := userFromCtx(c)
 	if user == nil {
 		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
 		return
 	}
-	c.JSON(http.StatusOK, buildUserObject(user, h.cfg))
+	if id, err := uuid.Parse(c.Param("userId")); err == nil && id != user.ID && user.IsAdmin {
+		user, err = h.db.User.Get(c.Request.Context(), id)
+		if ent.IsNotFound(err) {
+			c.JSON(http.StatusNotFound, gin.H{"error": "user not found"})
+			return
+		}
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to get user"})
+			return
+		}
+	}
+	configuration, err := h.userConfig.get(c.Request.Context(), user.ID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to get user configuration"})
+		return
+	}
+	c.JSON(http.StatusOK, buildUserObject(user, h.cfg, configuration))
 }
 
 // GetViews handles GET /Users/:userId/views.
@@ -42,8 +59,13 @@ func (h *MediaHandler) GetViews(c *gin.Context) {
 		return
 	}
 
+	if !allowedNow(user) {
+		writePagedViews(c, []json.RawMessage{})
+		return
+	}
+
 	// Check cache first.
-	if item := h.viewCache.Get(user.ID.String()); item != nil {
+	if item := h.viewCache.Get(rulesKey(user)); item != nil {
 		writePagedViews(c, item.Value())
 		return
 	}
@@ -53,7 +75,7 @@ func (h *MediaHandler) GetViews(c *gin.Context) {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 		return
 	}
-	h.viewCache.Set(user.ID.String(), allItems, ttlcache.DefaultTTL)
+	h.viewCache.Set(rulesKey(user), allItems, ttlcache.DefaultTTL)
 	writePagedViews(c, allItems)
 }
 
@@ -66,9 +88,18 @@ func (h *MediaHandler) GetUsers(c *gin.Context) {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to list users"})
 		return
 	}
+	ids := make([]uuid.UUID, len(users))
+	for i, u := range users {
+		ids[i] = u.ID
+	}
+	configurations, err := h.userConfig.getMany(c.Request.Context(), ids)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to list users"})
+		return
+	}
 	resp := make([]gin.H, len(users))
 	for i, u := range users {
-		resp[i] = buildUserObject(u, h.cfg)
+		resp[i] = buildUserObject(u, h.cfg, configurations[u.ID])
 	}
 	c.JSON(http.StatusOK, resp)
 }
@@ -82,7 +113,12 @@ func (h *MediaHandler) GetUserViews(c *gin.Context) {
 		return
 	}
 
-	if item := h.viewCache.Get(user.ID.String()); item != nil {
+	if !allowedNow(user) {
+		writePagedViews(c, []json.RawMessage{})
+		return
+	}
+
+	if item := h.viewCache.Get(rulesKey(user)); item != nil {
 		writePagedViews(c, item.Value())
 		return
 	}
@@ -92,7 +128,7 @@ func (h *MediaHandler) GetUserViews(c *gin.Context) {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 		return
 	}
-	h.viewCache.Set(user.ID.String(), allItems, ttlcache.DefaultTTL)
+	h.viewCache.Set(rulesKey(user), allItems, ttlcache.DefaultTTL)
 	writePagedViews(c, allItems)
 }
 
@@ -105,6 +141,9 @@ func (h *MediaHandler) GetUserViews(c *gin.Context) {
 // The merged virtual view has its Id replaced with idtrans.EncodeMerged(type),
 // e.g. "merged_movies", so that item-browsing requests can be fanned out to
 // all contributing backends.
+//
+// Libraries the user's access rules hide are left out before grouping, so a
+// type with a single visible library is not merged.
 func (h *MediaHandler) mergedViews(ctx context.Context, user *ent.User) ([]json.RawMessage, error) {
 	clients, err := h.pool.AllForUser(ctx, user)
 	if err != nil {
@@ -147,6 +186,7 @@ func (h *MediaHandler) mergedViews(ctx context.Context, user *ent.User) ([]json.
 	}
 	wg.Wait()
 
+	rules := userRules(user)
 	for _, br := range backendResults {
 		for _, raw := range br.items {
 			var meta struct {
@@ -157,6 +197,9 @@ func (h *MediaHandler) mergedViews(ctx context.Context, user *ent.User) ([]json.
 				continue
 			}
 			ct := strings.ToLower(meta.CollectionType)
+			if !rules.LibraryAllowed(libraryIDs(meta.Id, ct)...) {
+				continue
+			}
 			if ct == "" {
 				// Unknown type — pass through as a unique entry keyed by its ID.
 				key := "unknown_" + meta.Id
@@ -218,10 +261,16 @@ func mergedDisplayName(collectionType string) string {
 // path must be a backend path like "/items" or "/artists".
 // queryFn builds the per-backend query from a ServerClient (allows substituting UserId etc.).
 //
-// Client-side pagination (StartIndex/Limit) is applied after merging: each
-// backend receives the request without pagination constraints so the proxy
-// can collect the full set, then the merged result is sliced to the requested
-// page before being returned.
+// Every backend is asked for its rows sorted the way the client asked, and
+// the sorted streams are merged k-way. A page starting at StartIndex S with
+// Limit L needs at most S+L rows from each backend; the merge cursor is
+// cached per user and query, so the next page of a scrolling client only
+// fetches what the previous one did not already buffer.
+//
+// Items that exist on several backends (same TMDB/IMDB/TVDB ID) are collapsed
+// into one entry with a virtual dup_ ID. The copy that comes first in sort
+// order wins, ties going to the backend with the alphabetically first prefix,
+// so the surviving metadata does not depend on which backend answered first.
 func (h *MediaHandler) aggregatePagedItems(
 	c *gin.Context,
 	path string,
@@ -233,88 +282,106 @@ func (h *MediaHandler) aggregatePagedItems(
 // aggregatePagedItemsFn is like aggregatePagedItems but accepts a pathFn that
 // can return a different backend path per client (e.g. to embed the backend
 // user ID in user-scoped Jellyfin endpoints).
+//
+// Rows the user's access rules hide are dropped as they are fetched, and
+// nothing is listed outside the user's schedules.
 func (h *MediaHandler) aggregatePagedItemsFn(
 	c *gin.Context,
 	pathFn func(sc *backend.ServerClient) string,
 	queryFn func(sc *backend.ServerClient) url.Values,
 ) {
 	user := userFromCtx(c)
+	if !allowedNow(user) {
+		c.JSON(http.StatusOK, emptyPagedList())
+		return
+	}
 	clients, err := h.pool.AllForUser(c.Request.Context(), user)
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 		return
 	}
+	// Order backends by prefix so duplicate resolution is deterministic.
+	sort.Slice(clients, func(i, j int) bool { return clients[i].Prefix() < clients[j].Prefix() })
 
-	type result struct {
-		items []json.RawMessage
+	sortBy := queryParam(c, "sortby")
+	if sortBy == "" {
+		sortBy = "SortName"
 	}
-	results := make([]result, len(clients))
-	var wg sync.WaitGroup
-	for i, sc := range clients {
-		wg.Add(1)
-		go func(i int, sc *backend.ServerClient) {
-			defer wg.Done()
-			ctx, cancel := context.WithTimeout(c.Request.Context(), fanOutTimeout)
-			defer cancel()
-			q := queryFn(sc)
-			// Remove per-backend pagination — we paginate the merged result below.
-			q.Del("StartIndex")
-			q.Del("Limit")
-			body, status, err := sc.ProxyJSON(ctx, "GET", pathFn(sc), q, nil)
-			if err != nil || status != http.StatusOK {
-				return
-			}
-			var resp struct {
-				Items []json.RawMessage `json:"Items"`
-			}
-			if err := json.Unmarshal(body, &resp); err != nil {
-				return
-			}
-			results[i] = result{items: resp.Items}
-		}(i, sc)
+	sortOrder := queryParam(c, "sortorder")
+	start, limit := pageParams(c)
+
+	// Only paged queries keep a cursor; without a limit the merge consumes
+	// every row anyway.
+	var key string
+	var cur *mergeCursor
+	if limit > 0 {
+		key = cursorKey(c, rulesKey(user), clients)
+		if item := h.cursorCache.Get(key); item != nil {
+			cur = item.Value()
+		}
 	}
-	wg.Wait()
-
-	var allItems []json.RawMessage
-	for _, r := range results {
-		allItems = append(allItems, r.items...)
+	order := newItemOrder(sortBy, sortOrder)
+	if cur != nil {
+		cur.mu.Lock()
+		if cur.pos > start {
+			// The client went back; the rows before the cursor are gone. The
+			// new merge keeps the order, so a Random sort shuffles the same.
+			order = cur.order
+			cur.mu.Unlock()
+			cur = nil
+		}
 	}
-	if allItems == nil {
-		allItems = []json.RawMessage{}
+	if cur == nil {
+		cur = newMergeCursor(len(clients), order)
+		cur.mu.Lock()
 	}
-
-	// Sort the merged set so paginated results are deterministic.
-	sortBy := queryParam(c, "sortby")
-	sortOrder := queryParam(c, "sortorder")
-	sortMergedItems(allItems, sortBy, sortOrder)
-
-	totalCount := len(allItems)
-
-	// Apply client-requested pagination to the merged set.
-	startIndex := 0
-	if s := queryParam(c, "startindex"); s != "" {
-		if v, err := strconv.Atoi(s); err == nil && v > 0 {
-			startIndex = v
+	defer cur.mu.Unlock()
+
+	var filter func(ctx context.Context, items []json.RawMessage) []json.RawMessage
+	if rules := userRules(user); filtersItems(rules) {
+		baseQueryFn := queryFn
+		queryFn = func(sc *backend.ServerClient) url.Values {
+			q := baseQueryFn(sc)
+			withAccessFields(q, rules)
+			return q
+		}
+		filter = func(ctx context.Context, items []json.RawMessage) []json.RawMessage {
+			return h.filterItems(ctx, rules, items)
 		}
 	}
-	if startIndex > len(allItems) {
-		startIndex = len(allItems)
-	}
-	allItems = allItems[startIndex:]
-
-	if s := queryParam(c, "limit"); s != "" {
-		if v, err := strconv.Atoi(s); err == nil && v >= 0 && v < len(allItems) {
-			allItems = allItems[:v]
+	fetch := fetchSorted(pathFn, queryFn, cur.order, sortBy, sortOrder, filter)
+	items := h.mergePage(c.Request.Context(), cur, clients, fetch, start, limit)
+	totalCount := cur.total()
+
+	if key != "" {
+		// A finished Random merge is kept for its seed: a client that pages
+		// back must get the same shuffle.
+		if cur.failed || (cur.exhausted() && !cur.order.shuffles()) {
+			h.cursorCache.Delete(key)
+		} else {
+			h.cursorCache.Set(key, cur, ttlcache.DefaultTTL)
 		}
 	}
 
 	c.JSON(http.StatusOK, gin.H{
-		"Items":            allItems,
+		"Items":            items,
 		"TotalRecordCount": totalCount,
-		"StartIndex":       startIndex,
+		"StartIndex":       min(start, totalCount),
 	})
 }
 
+// pageBounds returns the slice bounds of the page selected by the client's
+// startindex and limit params within a merged result of n items.
+func pageBounds(c *gin.Context, n int) (start, end int) {
+	start, limit := pageParams(c)
+	start = min(start, n)
+	end = n
+	if limit >= 0 && limit < end-start {
+		end = start + limit
+	}
+	return start, end
+}
+
 // writePagedViews writes a standard paged views response.
 func writePagedViews(c *gin.Context, items []json.RawMessage) {
 	c.JSON(http.StatusOK, gin.H{
@@ -323,61 +390,3 @@ func writePagedViews(c *gin.Context, items []json.RawMessage) {
 		"StartIndex":       0,
 	})
 }
-
-// sortMergedItems sorts a slice of JSON items by the given Jellyfin SortBy
-// field. Supports the most common sort fields: SortName, DateCreated,
-// PremiereDate, CommunityRating, ProductionYear. Falls back to SortName.
-// SortOrder "Descending" reverses the result.
-func sortMergedItems(items []json.RawMessage, sortBy, sortOrder string) {
-	if len(items) < 2 {
-		return
-	}
-
-	field := "SortName"
-	if sortBy != "" {
-		field = strings.SplitN(sortBy, ",", 2)[0] // take first field only
-	}
-	desc := strings.EqualFold(sortOrder, "descending")
-
-	type sortable struct {
-		SortName        string  `json:"SortName"`
-		Name            string  `json:"Name"`
-		DateCreated     string  `json:"DateCreated"`
-		PremiereDate    string  `json:"PremiereDate"`
-		CommunityRating float64 `json:"CommunityRating"`
-		ProductionYear  int     `json:"ProductionYear"`
-	}
-	parsed := make([]sortable, len(items))
-	for i, raw := range items {
-		_ = json.Unmarshal(raw, &parsed[i])
-	}
-
-	sort.SliceStable(items, func(i, j int) bool {
-		a, b := parsed[i], parsed[j]
-		var less bool
-		switch strings.ToLower(field) {
-		case "datecreated":
-			less = a.DateCreated < b.DateCreated
-		case "premieredate":
-			less = a.PremiereDate < b.PremiereDate
-		case "communityrating":
-			less = a.CommunityRating < b.CommunityRating
-		case "productionyear":
-			less = a.ProductionYear < b.ProductionYear
-		default: // SortName
-			nameA := a.SortName
-			if nameA == "" {
-				nameA = a.Name
-			}
-			nameB := b.SortName
-			if nameB == "" {
-				nameB = b.Name
-			}
-			less = strings.ToLower(nameA) < strings.ToLower(nameB)
-		}
-		if desc {
-			return !less
-		}
-		return less
-	})
-}
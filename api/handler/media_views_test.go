@@ -5,6 +5,9 @@ import (
 	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync/atomic"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -15,6 +18,7 @@ import (
 	"github.com/ddevcap/jellyfin-proxy/api/middleware"
 	"github.com/ddevcap/jellyfin-proxy/backend"
 	"github.com/ddevcap/jellyfin-proxy/config"
+	entbackend "github.com/ddevcap/jellyfin-proxy/ent/backend"
 	"github.com/ddevcap/jellyfin-proxy/idtrans"
 )
 
@@ -63,6 +67,31 @@ func registerViewsBackend(name, url, prefix, backendUserID string) {
 	createBackendUser(b, u, backendUserID)
 }
 
+// pagingBackend serves a movie per name from /items, sorted by name and
+// honouring StartIndex and Limit like a real Jellyfin server. served counts
+// the rows sent.
+func pagingBackend(names ...string) (srv *httptest.Server, served *atomic.Int32) {
+	served = &atomic.Int32{}
+	srv = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		Expect(r.URL.Query().Get("SortBy")).To(Equal("SortName"))
+		start, _ := strconv.Atoi(r.URL.Query().Get("StartIndex"))
+		end := len(names)
+		if l, err := strconv.Atoi(r.URL.Query().Get("Limit")); err == nil {
+			end = min(start+l, end)
+		}
+		start = min(start, end)
+		items := make([]string, 0, end-start)
+		for _, n := range names[start:end] {
+			items = append(items, fmt.Sprintf(`{"Id":%q,"Name":%q,"Type":"Movie"}`, strings.ToLower(n), n))
+		}
+		served.Add(int32(len(items)))
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = fmt.Fprintf(w, `{"Items":[%s],"TotalRecordCount":%d,"StartIndex":%d}`,
+			strings.Join(items, ","), len(names), start)
+	}))
+	return srv, served
+}
+
 // auth is a convenience for building an auth header map.
 func auth() map[string]string {
 	return map[string]string{"X-Emby-Token": viewsToken}
@@ -291,6 +320,441 @@ var _ = Describe("Merged library views", func() {
 			})
 		})
 
+		Context("when several backends hold the same title", func() {
+			It("collapses copies sharing a provider ID and lists each as a MediaSource", func() {
+				fakeA := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					Expect(r.URL.Query().Get("Fields")).To(ContainSubstring("ProviderIds"))
+					w.Header().Set("Content-Type", "application/json")
+					_, _ = fmt.Fprint(w, `{"Items":[
+						{"Id":"a1","Name":"Alien (A)","Type":"Movie","MediaType":"Video","ProviderIds":{"Tmdb":"348"}},
+						{"Id":"a2","Name":"Heat","Type":"Movie","MediaType":"Video","ProviderIds":{"Imdb":"tt0113277"}}
+					],"TotalRecordCount":2,"StartIndex":0}`)
+				}))
+				defer fakeA.Close()
+
+				fakeB := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					w.Header().Set("Content-Type", "application/json")
+					_, _ = fmt.Fprint(w, `{"Items":[
+						{"Id":"b1","Name":"Alien (B)","Type":"Movie","MediaType":"Video","ProviderIds":{"Tmdb":"348","Imdb":"tt0078748"}},
+						{"Id":"b2","Name":"Ronin","Type":"Movie","MediaType":"Video","ProviderIds":{"Tmdb":"8195"}}
+					],"TotalRecordCount":2,"StartIndex":0}`)
+				}))
+				defer fakeB.Close()
+
+				// Register B first: metadata precedence follows prefix order,
+				// not registration order.
+				registerViewsBackend("Backend B", fakeB.URL, "bb", "user-bb")
+				registerViewsBackend("Backend A", fakeA.URL, "ba", "user-ba")
+
+				w := doGet(router, "/items?parentId=merged_movies&fields=Overview", auth())
+
+				Expect(w.Code).To(Equal(http.StatusOK))
+				var resp struct {
+					Items []struct {
+						Id           string
+						Name         string
+						MediaSources []struct {
+							Id   string
+							Name string
+						}
+					}
+					TotalRecordCount int
+				}
+				Expect(json.Unmarshal(w.Body.Bytes(), &resp)).To(Succeed())
+				Expect(resp.TotalRecordCount).To(Equal(3))
+				Expect(resp.Items).To(HaveLen(3))
+
+				alien := resp.Items[0]
+				Expect(alien.Id).To(Equal(idtrans.EncodeDup("Movie|Tmdb|348")))
+				Expect(alien.Name).To(Equal("Alien (A)"))
+				Expect(alien.MediaSources).To(HaveLen(2))
+				Expect(alien.MediaSources[0].Id).To(Equal("ba_a1"))
+				Expect(alien.MediaSources[0].Name).To(Equal("Backend A"))
+				Expect(alien.MediaSources[1].Id).To(Equal("bb_b1"))
+				Expect(alien.MediaSources[1].Name).To(Equal("Backend B"))
+
+				Expect(resp.Items[1].Name).To(Equal("Heat"))
+				Expect(resp.Items[1].MediaSources).To(BeEmpty())
+				Expect(resp.Items[2].Name).To(Equal("Ronin"))
+			})
+
+			It("resolves the virtual ID to a copy on a backend that is still online", func() {
+				fakeA := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					w.Header().Set("Content-Type", "application/json")
+					switch r.URL.Path {
+					case "/items":
+						_, _ = fmt.Fprint(w, `{"Items":[{"Id":"a1","Name":"Alien","Type":"Movie","ProviderIds":{"Tmdb":"348"}}]}`)
+					default:
+						_, _ = fmt.Fprint(w, `{"Id":"a1","Name":"Alien (A)"}`)
+					}
+				}))
+				defer fakeA.Close()
+
+				fakeB := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					w.Header().Set("Content-Type", "application/json")
+					switch r.URL.Path {
+					case "/items":
+						_, _ = fmt.Fprint(w, `{"Items":[{"Id":"b1","Name":"Alien","Type":"Movie","ProviderIds":{"Tmdb":"348"}}]}`)
+					default:
+						Expect(r.URL.Path).To(Equal("/items/b1"))
+						_, _ = fmt.Fprint(w, `{"Id":"b1","Name":"Alien (B)"}`)
+					}
+				}))
+				defer fakeB.Close()
+
+				cfg := config.Config{ServerID: "test-server-id"}
+				pool := backend.NewPool(db, cfg)
+				hc := backend.NewHealthChecker(pool, 0)
+				pool.SetHealthChecker(hc)
+				mediaH := handler.NewMediaHandler(pool, cfg, db)
+				r := gin.New()
+				r.Use(middleware.Auth(db, cfg))
+				r.GET("/items", mediaH.GetItems)
+				r.GET("/items/:itemId", mediaH.GetItem)
+
+				registerViewsBackend("Backend A", fakeA.URL, "ba", "user-ba")
+				registerViewsBackend("Backend B", fakeB.URL, "bb", "user-bb")
+
+				dupID := idtrans.EncodeDup("Movie|Tmdb|348")
+				w := doGet(r, "/items?parentId=merged_movies", auth())
+				Expect(w.Code).To(Equal(http.StatusOK))
+				Expect(w.Body.String()).To(ContainSubstring(dupID))
+
+				w = doGet(r, "/items/"+dupID, auth())
+				Expect(w.Code).To(Equal(http.StatusOK))
+				Expect(w.Body.String()).To(ContainSubstring("Alien (A)"))
+
+				// Trip the circuit breaker for backend A.
+				a, err := db.Backend.Query().Where(entbackend.Prefix("ba")).Only(mediaCtx())
+				Expect(err).NotTo(HaveOccurred())
+				for range 5 {
+					hc.RecordRequestFailure(a.ID.String(), a.Name)
+				}
+
+				w = doGet(r, "/items/"+dupID, auth())
+				Expect(w.Code).To(Equal(http.StatusOK))
+				Expect(w.Body.String()).To(ContainSubstring("Alien (B)"))
+
+				// The merged list keeps the virtual ID while only one copy is online.
+				w = doGet(r, "/items?parentId=merged_movies", auth())
+				Expect(w.Code).To(Equal(http.StatusOK))
+				Expect(w.Body.String()).To(ContainSubstring(dupID))
+			})
+
+			It("serves images and forwards playback reports for a virtual ID", func() {
+				var reported string
+				fakeA := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					switch r.URL.Path {
+					case "/items":
+						w.Header().Set("Content-Type", "application/json")
+						_, _ = fmt.Fprint(w, `{"Items":[{"Id":"a1","Name":"Alien","Type":"Movie","ProviderIds":{"Tmdb":"348"}}]}`)
+					case "/items/a1/images/Primary":
+						w.Header().Set("Content-Type", "image/jpeg")
+						_, _ = fmt.Fprint(w, "jpeg-bytes")
+					case "/sessions/Playing":
+						var body struct{ ItemId string }
+						Expect(json.NewDecoder(r.Body).Decode(&body)).To(Succeed())
+						reported = body.ItemId
+						w.WriteHeader(http.StatusNoContent)
+					default:
+						w.WriteHeader(http.StatusNotFound)
+					}
+				}))
+				defer fakeA.Close()
+
+				fakeB := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					w.Header().Set("Content-Type", "application/json")
+					_, _ = fmt.Fprint(w, `{"Items":[{"Id":"b1","Name":"Alien","Type":"Movie","ProviderIds":{"Tmdb":"348"}}]}`)
+				}))
+				defer fakeB.Close()
+
+				cfg := config.Config{ServerID: "test-server-id"}
+				mediaH := handler.NewMediaHandler(backend.NewPool(db, cfg), cfg, db)
+				r := gin.New()
+				r.GET("/items/:itemId/images/:imageType", mediaH.GetImage)
+				priv := r.Group("/")
+				priv.Use(middleware.Auth(db, cfg))
+				priv.GET("/items", mediaH.GetItems)
+				priv.POST("/sessions/playing", mediaH.ReportPlaybackStart)
+
+				registerViewsBackend("Backend A", fakeA.URL, "ba", "user-ba")
+				registerViewsBackend("Backend B", fakeB.URL, "bb", "user-bb")
+
+				dupID := idtrans.EncodeDup("Movie|Tmdb|348")
+				w := doGet(r, "/items?parentId=merged_movies", auth())
+				Expect(w.Code).To(Equal(http.StatusOK))
+				Expect(w.Body.String()).To(ContainSubstring(dupID))
+
+				w = doGet(r, "/items/"+dupID+"/images/Primary")
+				Expect(w.Code).To(Equal(http.StatusOK))
+				Expect(w.Body.String()).To(Equal("jpeg-bytes"))
+
+				w = doPost(r, "/sessions/playing", map[string]interface{}{"ItemId": dupID, "PositionTicks": 0}, auth())
+				Expect(w.Code).To(Equal(http.StatusNoContent))
+				Expect(reported).To(Equal("a1"))
+			})
+
+			It("still resolves a virtual ID after the proxy restarts", func() {
+				fakeA := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					w.Header().Set("Content-Type", "application/json")
+					switch r.URL.Path {
+					case "/items":
+						_, _ = fmt.Fprint(w, `{"Items":[{"Id":"a1","Name":"Alien","Type":"Movie","ProviderIds":{"Tmdb":"348"}}]}`)
+					default:
+						_, _ = fmt.Fprint(w, `{"Id":"a1","Name":"Alien (A)"}`)
+					}
+				}))
+				defer fakeA.Close()
+
+				fakeB := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					w.Header().Set("Content-Type", "application/json")
+					_, _ = fmt.Fprint(w, `{"Items":[{"Id":"b1","Name":"Alien","Type":"Movie","ProviderIds":{"Tmdb":"348"}}]}`)
+				}))
+				defer fakeB.Close()
+
+				registerViewsBackend("Backend A", fakeA.URL, "ba", "user-ba")
+				registerViewsBackend("Backend B", fakeB.URL, "bb", "user-bb")
+
+				dupID := idtrans.EncodeDup("Movie|Tmdb|348")
+				w := doGet(router, "/items?parentId=merged_movies", auth())
+				Expect(w.Code).To(Equal(http.StatusOK))
+				Expect(w.Body.String()).To(ContainSubstring(dupID))
+
+				// A new handler starts with an empty cache, as after a restart.
+				cfg := config.Config{ServerID: "test-server-id"}
+				mediaH := handler.NewMediaHandler(backend.NewPool(db, cfg), cfg, db)
+				r := gin.New()
+				r.Use(middleware.Auth(db, cfg))
+				r.GET("/items/:itemId", mediaH.GetItem)
+
+				w = doGet(r, "/items/"+dupID, auth())
+				Expect(w.Code).To(Equal(http.StatusOK))
+				Expect(w.Body.String()).To(ContainSubstring("Alien (A)"))
+			})
+
+			It("returns 400 for a virtual ID the proxy has never seen", func() {
+				w := doGet(router, "/items/"+idtrans.EncodeDup("Movie|Tmdb|1"), auth())
+				Expect(w.Code).To(Equal(http.StatusBadRequest))
+			})
+
+			It("does not merge items of different types with the same provider ID", func() {
+				fakeA := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					w.Header().Set("Content-Type", "application/json")
+					_, _ = fmt.Fprint(w, `{"Items":[{"Id":"a1","Name":"Movie","Type":"Movie","ProviderIds":{"Tmdb":"1399"}}],"TotalRecordCount":1,"StartIndex":0}`)
+				}))
+				defer fakeA.Close()
+
+				fakeB := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					w.Header().Set("Content-Type", "application/json")
+					_, _ = fmt.Fprint(w, `{"Items":[{"Id":"b1","Name":"Show","Type":"Series","ProviderIds":{"Tmdb":"1399"}}],"TotalRecordCount":1,"StartIndex":0}`)
+				}))
+				defer fakeB.Close()
+
+				registerViewsBackend("Backend A", fakeA.URL, "ba", "user-ba")
+				registerViewsBackend("Backend B", fakeB.URL, "bb", "user-bb")
+
+				w := doGet(router, "/items?searchTerm=x", auth())
+
+				Expect(w.Code).To(Equal(http.StatusOK))
+				var resp map[string]interface{}
+				Expect(json.Unmarshal(w.Body.Bytes(), &resp)).To(Succeed())
+				Expect(resp["TotalRecordCount"]).To(BeNumerically("==", 2))
+			})
+		})
+
+		Context("when paging through a merged library", func() {
+			It("merges sorted pages and continues from the cached cursor", func() {
+				fakeA, servedA := pagingBackend("Alpha", "Charlie", "Echo", "Golf", "India")
+				defer fakeA.Close()
+				fakeB, servedB := pagingBackend("Bravo", "Delta", "Foxtrot", "Hotel", "Juliet")
+				defer fakeB.Close()
+				registerViewsBackend("Backend A", fakeA.URL, "ba", "user-ba")
+				registerViewsBackend("Backend B", fakeB.URL, "bb", "user-bb")
+
+				page := func(start int) ([]string, int) {
+					w := doGet(router, fmt.Sprintf("/items?parentId=merged_movies&StartIndex=%d&Limit=2", start), auth())
+					Expect(w.Code).To(Equal(http.StatusOK))
+					var resp struct {
+						Items []struct {
+							Name string
+						}
+						TotalRecordCount int
+						StartIndex       int
+					}
+					Expect(json.Unmarshal(w.Body.Bytes(), &resp)).To(Succeed())
+					Expect(resp.StartIndex).To(Equal(start))
+					names := make([]string, len(resp.Items))
+					for i, it := range resp.Items {
+						names[i] = it.Name
+					}
+					return names, resp.TotalRecordCount
+				}
+
+				names, total := page(2)
+				Expect(names).To(Equal([]string{"Charlie", "Delta"}))
+				Expect(total).To(Equal(10))
+				// Each backend is asked for no more than StartIndex+Limit rows.
+				Expect(servedA.Load()).To(BeEquivalentTo(4))
+				Expect(servedB.Load()).To(BeEquivalentTo(4))
+
+				// The next page is already buffered by the cursor.
+				names, _ = page(4)
+				Expect(names).To(Equal([]string{"Echo", "Foxtrot"}))
+				Expect(servedA.Load() + servedB.Load()).To(BeEquivalentTo(8))
+
+				// Further on, only the missing rows are fetched.
+				names, total = page(8)
+				Expect(names).To(Equal([]string{"India", "Juliet"}))
+				Expect(total).To(Equal(10))
+				Expect(servedA.Load()).To(BeEquivalentTo(5))
+				Expect(servedB.Load()).To(BeEquivalentTo(5))
+
+				// Going back starts a new merge.
+				names, _ = page(0)
+				Expect(names).To(Equal([]string{"Alpha", "Bravo"}))
+			})
+
+			It("handles backends that ignore the paging params", func() {
+				var requestsA atomic.Int32
+				fakeA := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					requestsA.Add(1)
+					w.Header().Set("Content-Type", "application/json")
+					_, _ = fmt.Fprint(w, `{"Items":[{"Id":"a1","Name":"Alpha"},{"Id":"a2","Name":"Charlie"},{"Id":"a3","Name":"Echo"}],"TotalRecordCount":3}`)
+				}))
+				defer fakeA.Close()
+				fakeB, _ := pagingBackend("Bravo", "Delta")
+				defer fakeB.Close()
+				registerViewsBackend("Backend A", fakeA.URL, "ba", "user-ba")
+				registerViewsBackend("Backend B", fakeB.URL, "bb", "user-bb")
+
+				type pagedNames struct {
+					Items []struct {
+						Name string
+					}
+					TotalRecordCount int
+				}
+
+				w := doGet(router, "/items?parentId=merged_movies&StartIndex=1&Limit=1", auth())
+				Expect(w.Code).To(Equal(http.StatusOK))
+				var resp pagedNames
+				Expect(json.Unmarshal(w.Body.Bytes(), &resp)).To(Succeed())
+				Expect(resp.TotalRecordCount).To(Equal(5))
+				Expect(resp.Items).To(HaveLen(1))
+				Expect(resp.Items[0].Name).To(Equal("Bravo"))
+
+				// A sent its whole list at once, so it is not asked again.
+				w = doGet(router, "/items?parentId=merged_movies&StartIndex=2&Limit=3", auth())
+				Expect(w.Code).To(Equal(http.StatusOK))
+				resp = pagedNames{}
+				Expect(json.Unmarshal(w.Body.Bytes(), &resp)).To(Succeed())
+				Expect(resp.TotalRecordCount).To(Equal(5))
+				Expect(resp.Items).To(HaveLen(3))
+				Expect(resp.Items[0].Name).To(Equal("Charlie"))
+				Expect(resp.Items[1].Name).To(Equal("Delta"))
+				Expect(resp.Items[2].Name).To(Equal("Echo"))
+				Expect(requestsA.Load()).To(BeEquivalentTo(1))
+			})
+		})
+
+		Context("when sorting a merged library", func() {
+			// serve returns a fake backend listing the given items.
+			serve := func(items string) *httptest.Server {
+				return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					w.Header().Set("Content-Type", "application/json")
+					_, _ = fmt.Fprintf(w, `{"Items":[%s]}`, items)
+				}))
+			}
+			ids := func(w *httptest.ResponseRecorder) []string {
+				var resp struct {
+					Items []struct {
+						Id string
+					}
+				}
+				Expect(json.Unmarshal(w.Body.Bytes(), &resp)).To(Succeed())
+				out := make([]string, len(resp.Items))
+				for i, it := range resp.Items {
+					out[i] = it.Id
+				}
+				return out
+			}
+
+			It("applies every SortBy key with its own SortOrder", func() {
+				fakeA := serve(`{"Id":"a1","SeriesName":"Andor","ParentIndexNumber":1,"IndexNumber":2},
+					{"Id":"a2","SeriesName":"Severance","ParentIndexNumber":1,"IndexNumber":1}`)
+				defer fakeA.Close()
+				fakeB := serve(`{"Id":"b1","SeriesName":"Andor","ParentIndexNumber":2,"IndexNumber":1},
+					{"Id":"b2","SeriesName":"andor","ParentIndexNumber":1,"IndexNumber":1}`)
+				defer fakeB.Close()
+				registerViewsBackend("Backend A", fakeA.URL, "ba", "user-ba")
+				registerViewsBackend("Backend B", fakeB.URL, "bb", "user-bb")
+
+				w := doGet(router, "/items?parentId=merged_tvshows"+
+					"&SortBy=SeriesSortName,ParentIndexNumber,IndexNumber&SortOrder=Ascending,Descending", auth())
+
+				Expect(w.Code).To(Equal(http.StatusOK))
+				// IndexNumber has no SortOrder of its own and uses the first one.
+				Expect(ids(w)).To(Equal([]string{"bb_b1", "bb_b2", "ba_a1", "ba_a2"}))
+			})
+
+			It("sorts by SeriesSortName, falling back to SeriesName", func() {
+				fakeA := serve(`{"Id":"a1","SeriesName":"The Expanse","SeriesSortName":"Expanse"},
+					{"Id":"a2","SeriesName":"Dark"}`)
+				defer fakeA.Close()
+				fakeB := serve(`{"Id":"b1","SeriesName":"Fargo"}`)
+				defer fakeB.Close()
+				registerViewsBackend("Backend A", fakeA.URL, "ba", "user-ba")
+				registerViewsBackend("Backend B", fakeB.URL, "bb", "user-bb")
+
+				w := doGet(router, "/items?parentId=merged_tvshows&SortBy=SeriesSortName", auth())
+				Expect(w.Code).To(Equal(http.StatusOK))
+				Expect(ids(w)).To(Equal([]string{"ba_a2", "ba_a1", "bb_b1"}))
+			})
+
+			It("shuffles a Random sort once across its pages", func() {
+				fakeA, _ := pagingBackend("Alpha", "Charlie", "Echo", "Golf", "India")
+				defer fakeA.Close()
+				fakeB, _ := pagingBackend("Bravo", "Delta", "Foxtrot", "Hotel", "Juliet")
+				defer fakeB.Close()
+				registerViewsBackend("Backend A", fakeA.URL, "ba", "user-ba")
+				registerViewsBackend("Backend B", fakeB.URL, "bb", "user-bb")
+
+				page := func(start int) []string {
+					w := doGet(router, fmt.Sprintf("/items?parentId=merged_movies&SortBy=Random&StartIndex=%d&Limit=3", start), auth())
+					Expect(w.Code).To(Equal(http.StatusOK))
+					return ids(w)
+				}
+				var all []string
+				for start := 0; start < 10; start += 3 {
+					all = append(all, page(start)...)
+				}
+				Expect(all).To(HaveLen(10))
+				Expect(all).To(ConsistOf("ba_alpha", "ba_charlie", "ba_echo", "ba_golf", "ba_india",
+					"bb_bravo", "bb_delta", "bb_foxtrot", "bb_hotel", "bb_juliet"))
+
+				// Going back replays the same shuffle.
+				Expect(page(0)).To(Equal(all[:3]))
+			})
+
+			It("sorts by the caller's user data and by rating level", func() {
+				fakeA := serve(`{"Id":"a1","OfficialRating":"NC-17","UserData":{"LastPlayedDate":"2025-03-01T20:00:00.0000000Z"}},
+					{"Id":"a2","OfficialRating":"TV-Y7","UserData":{}}`)
+				defer fakeA.Close()
+				fakeB := serve(`{"Id":"b1","OfficialRating":"TV-MA","UserData":{"LastPlayedDate":"2025-04-01T20:00:00.0000000Z"}},
+					{"Id":"b2","OfficialRating":"PG-13","UserData":{"LastPlayedDate":"2024-12-24T20:00:00.0000000Z"}}`)
+				defer fakeB.Close()
+				registerViewsBackend("Backend A", fakeA.URL, "ba", "user-ba")
+				registerViewsBackend("Backend B", fakeB.URL, "bb", "user-bb")
+
+				w := doGet(router, "/items?parentId=merged_movies&SortBy=DatePlayed&SortOrder=Descending", auth())
+				Expect(w.Code).To(Equal(http.StatusOK))
+				Expect(ids(w)).To(Equal([]string{"bb_b1", "ba_a1", "bb_b2", "ba_a2"}))
+
+				w = doGet(router, "/items?parentId=merged_movies&SortBy=OfficialRating", auth())
+				Expect(w.Code).To(Equal(http.StatusOK))
+				Expect(ids(w)).To(Equal([]string{"ba_a2", "bb_b2", "bb_b1", "ba_a1"}))
+			})
+		})
+
 		Context("with a regular backend prefix parentId", func() {
 			It("routes to that specific backend", func() {
 				fakeBackend := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -913,5 +1377,62 @@ var _ = Describe("Merged library views", func() {
 				Expect(transURL).To(ContainSubstring("MediaSourceId=" + proxyItemID))
 			})
 		})
+
+		Context("when the client picks a media source from another backend", func() {
+			It("asks the backend that owns that copy", func() {
+				fakeA := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					Fail("playback info should not be requested from the item's own backend")
+				}))
+				defer fakeA.Close()
+
+				fakeB := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					Expect(r.URL.Path).To(Equal("/items/b1/playbackinfo"))
+					Expect(r.URL.Query().Get("MediaSourceId")).To(Equal("b1"))
+					w.Header().Set("Content-Type", "application/json")
+					_, _ = fmt.Fprint(w, `{"MediaSources":[{"Id":"b1"}]}`)
+				}))
+				defer fakeB.Close()
+
+				registerViewsBackend("Backend A", fakeA.URL, "ba", "user-ba")
+				registerViewsBackend("Backend B", fakeB.URL, "bb", "user-bb")
+
+				w := doPost(router, "/items/ba_a1/playbackinfo?MediaSourceId=bb_b1",
+					map[string]interface{}{},
+					auth())
+
+				Expect(w.Code).To(Equal(http.StatusOK))
+				var resp map[string]interface{}
+				Expect(json.Unmarshal(w.Body.Bytes(), &resp)).To(Succeed())
+				source := resp["MediaSources"].([]interface{})[0].(map[string]interface{})
+				Expect(source["Id"]).To(Equal("bb_b1"))
+			})
+
+			It("reads the media source from the POST body when the query has none", func() {
+				fakeA := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					Fail("playback info should not be requested from the item's own backend")
+				}))
+				defer fakeA.Close()
+
+				fakeB := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					Expect(r.URL.Path).To(Equal("/items/b1/playbackinfo"))
+					var body map[string]interface{}
+					Expect(json.NewDecoder(r.Body).Decode(&body)).To(Succeed())
+					Expect(body["MediaSourceId"]).To(Equal("b1"))
+					w.Header().Set("Content-Type", "application/json")
+					_, _ = fmt.Fprint(w, `{"MediaSources":[{"Id":"b1"}]}`)
+				}))
+				defer fakeB.Close()
+
+				registerViewsBackend("Backend A", fakeA.URL, "ba", "user-ba")
+				registerViewsBackend("Backend B", fakeB.URL, "bb", "user-bb")
+
+				w := doPost(router, "/items/ba_a1/playbackinfo",
+					map[string]interface{}{"MediaSourceId": "bb_b1"},
+					auth())
+
+				Expect(w.Code).To(Equal(http.StatusOK))
+				Expect(w.Body.String()).To(ContainSubstring(`"bb_b1"`))
+			})
+		})
 	})
 })
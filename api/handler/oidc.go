@@ -0,0 +1,311 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"html/template"
+	"log/slog"
+	"net/http"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ddevcap/jellyfin-proxy/activity"
+	"github.com/ddevcap/jellyfin-proxy/api/middleware"
+	"github.com/ddevcap/jellyfin-proxy/config"
+	"github.com/ddevcap/jellyfin-proxy/ent"
+	entbackenduser "github.com/ddevcap/jellyfin-proxy/ent/backenduser"
+	entuser "github.com/ddevcap/jellyfin-proxy/ent/user"
+	"github.com/ddevcap/jellyfin-proxy/oidc"
+	"github.com/gin-gonic/gin"
+	"github.com/jellydator/ttlcache/v3"
+	"golang.org/x/crypto/bcrypt"
+)
+
+const (
+	// oidcLoginTimeout is how long a user has to complete the login at the
+	// identity provider.
+	oidcLoginTimeout = 10 * time.Minute
+	// oidcStateCookie binds the callback to the browser that started the login.
+	oidcStateCookie = "jellyfin_proxy_oidc_state"
+)
+
+// oidcLogin is a login waiting for the provider's callback.
+type oidcLogin struct {
+	nonce    string
+	verifier string
+	client   sessionClient
+}
+
+// OIDCHandler signs proxy users in through an OpenID Connect provider.
+// Users are matched by the provider's subject and created when they don't
+// exist yet.
+type OIDCHandler struct {
+	db  *ent.Client
+	cfg config.Config
+
+	mu       sync.Mutex
+	provider *oidc.Provider // discovered on first use
+	pending  *ttlcache.Cache[string, oidcLogin]
+}
+
+func NewOIDCHandler(db *ent.Client, cfg config.Config) *OIDCHandler {
+	pending := ttlcache.New[string, oidcLogin](
+		ttlcache.WithTTL[string, oidcLogin](oidcLoginTimeout),
+		ttlcache.WithDisableTouchOnHit[string, oidcLogin](),
+	)
+	go pending.Start() // drops logins that were abandoned at the provider
+	return &OIDCHandler{db: db, cfg: cfg, pending: pending}
+}
+
+// discover returns the provider, running discovery on first use so that the
+// proxy starts even while the provider is down.
+func (h *OIDCHandler) discover(ctx context.Context) (*oidc.Provider, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if h.provider != nil {
+		return h.provider, nil
+	}
+	p, err := oidc.Discover(ctx, oidc.Config{
+		IssuerURL:    h.cfg.OIDCIssuerURL,
+		ClientID:     h.cfg.OIDCClientID,
+		ClientSecret: h.cfg.OIDCClientSecret,
+		RedirectURL:  strings.TrimRight(h.cfg.ExternalURL, "/") + "/proxy/oidc/callback",
+		Scopes:       h.cfg.OIDCScopes,
+	})
+	if err != nil {
+		return nil, err
+	}
+	h.provider = p
+	return p, nil
+}
+
+// Login handles GET /proxy/oidc/login.
+// Redirects the browser to the provider. The optional device_id and
+// device_name query parameters name the session that will be created.
+func (h *OIDCHandler) Login(c *gin.Context) {
+	p, err := h.discover(c.Request.Context())
+	if err != nil {
+		slog.Warn("oidc: discovery failed", "issuer", h.cfg.OIDCIssuerURL, "error", err)
+		c.JSON(http.StatusBadGateway, gin.H{"error": "identity provider unavailable"})
+		return
+	}
+
+	state, err1 := oidc.RandomString()
+	nonce, err2 := oidc.RandomString()
+	verifier, err3 := oidc.RandomString()
+	if err := errors.Join(err1, err2, err3); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to start login"})
+		return
+	}
+	h.pending.Set(state, oidcLogin{
+		nonce:    nonce,
+		verifier: verifier,
+		client: sessionClient{
+			DeviceID:   fallback(c.Query("device_id"), "sso-"+state[:12]),
+			DeviceName: fallback(c.Query("device_name"), "Web Browser"),
+			AppName:    "Jellyfin Web",
+		},
+	}, ttlcache.DefaultTTL)
+
+	c.SetSameSite(http.SameSiteLaxMode)
+	c.SetCookie(oidcStateCookie, state, int(oidcLoginTimeout/time.Second), "/proxy/oidc",
+		"", strings.HasPrefix(h.cfg.ExternalURL, "https://"), true)
+	c.Redirect(http.StatusFound, p.AuthCodeURL(state, nonce, verifier))
+}
+
+// Callback handles GET /proxy/oidc/callback.
+// Exchanges the authorization code, signs the user in and hands the new
+// session to the web UI.
+func (h *OIDCHandler) Callback(c *gin.Context) {
+	if e := c.Query("error"); e != "" {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "login failed at identity provider: " + fallback(c.Query("error_description"), e)})
+		return
+	}
+
+	state := c.Query("state")
+	cookie, _ := c.Cookie(oidcStateCookie)
+	item := h.pending.Get(state)
+	if state == "" || cookie != state || item == nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "login expired or was started in another browser"})
+		return
+	}
+	h.pending.Delete(state)
+	login := item.Value()
+	c.SetCookie(oidcStateCookie, "", -1, "/proxy/oidc", "", strings.HasPrefix(h.cfg.ExternalURL, "https://"), true)
+
+	ctx := c.Request.Context()
+	p, err := h.discover(ctx)
+	if err != nil {
+		c.JSON(http.StatusBadGateway, gin.H{"error": "identity provider unavailable"})
+		return
+	}
+	claims, err := p.Exchange(ctx, c.Query("code"), login.verifier, login.nonce)
+	if err != nil {
+		slog.Warn("oidc: code exchange failed", "error", err)
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "login could not be verified"})
+		return
+	}
+
+	user, err := h.provisionUser(c, claims)
+	if err != nil {
+		slog.Warn("oidc: provisioning user", "sub", claims.String("sub"), "error", err)
+		c.JSON(http.StatusForbidden, gin.H{"error": err.Error()})
+		return
+	}
+
+	if err := middleware.CheckUserPolicy(c, user); err != nil {
+		c.JSON(http.StatusForbidden, gin.H{"error": err.Error()})
+		return
+	}
+	recordLogin(c, h.db, user, middleware.ClientIP(c))
+	token, err := createSession(c, h.db, user, login.client)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to create session"})
+		return
+	}
+
+	c.Header("Cache-Control", "no-store")
+	c.Status(http.StatusOK)
+	c.Header("Content-Type", "text/html; charset=utf-8")
+	_ = oidcCompletePage.Execute(c.Writer, map[string]string{
+		"ServerID":   h.cfg.ServerID,
+		"ServerName": h.cfg.ServerName,
+		"UserID":     user.ID.String(),
+		"Token":      token,
+	})
+}
+
+// provisionUser returns the proxy user for the verified claims. A user is
+// found by subject only; the username claim is under the provider's control,
+// so an existing account is never linked by name. Admins link an account to
+// an SSO identity by setting its oidc_subject. Otherwise a new user is
+// created. The admin flag follows the admin group when one is configured.
+func (h *OIDCHandler) provisionUser(c *gin.Context, claims oidc.Claims) (*ent.User, error) {
+	ctx := c.Request.Context()
+	sub := claims.String("sub")
+	username := claims.String(h.cfg.OIDCUsernameClaim)
+
+	user, err := h.db.User.Query().Where(entuser.OidcSubject(sub)).Only(ctx)
+	switch {
+	case ent.IsNotFound(err) && username == "":
+		return nil, errors.New("identity provider did not send the " + h.cfg.OIDCUsernameClaim + " claim")
+	case ent.IsNotFound(err):
+		taken, err := h.db.User.Query().Where(entuser.Username(username)).Exist(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if taken {
+			return nil, errors.New("username " + username + " already exists; an administrator has to link it to this SSO identity")
+		}
+		return h.createUser(c, claims, username)
+	case err != nil:
+		return nil, err
+	}
+
+	if isAdmin, ok := h.adminFromGroups(claims); ok && isAdmin != user.IsAdmin {
+		return user.Update().SetIsAdmin(isAdmin).Save(ctx)
+	}
+	return user, nil
+}
+
+// createUser creates the user for a first SSO login. The account gets an
+// unusable random password and the template user's backend mappings.
+func (h *OIDCHandler) createUser(c *gin.Context, claims oidc.Claims, username string) (*ent.User, error) {
+	ctx := c.Request.Context()
+	password, err := oidc.RandomString()
+	if err != nil {
+		return nil, err
+	}
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), BcryptCost)
+	if err != nil {
+		return nil, err
+	}
+	isAdmin, _ := h.adminFromGroups(claims)
+
+	user, err := h.db.User.Create().
+		SetUsername(username).
+		SetDisplayName(fallback(claims.String("name"), username)).
+		SetHashedPassword(string(hash)).
+		SetIsAdmin(isAdmin).
+		SetOidcSubject(claims.String("sub")).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	activity.Record(ctx, h.db, activity.Entry{
+		Name:          "User " + user.Username + " has been created",
+		Type:          "UserCreated",
+		ShortOverview: "By single sign-on",
+		UserID:        user.ID,
+	})
+	h.applyTemplate(ctx, user)
+	return user, nil
+}
+
+// applyTemplate copies the template user's backend mappings to user.
+func (h *OIDCHandler) applyTemplate(ctx context.Context, user *ent.User) {
+	if h.cfg.OIDCTemplateUser == "" {
+		return
+	}
+	mappings, err := h.db.BackendUser.Query().
+		Where(entbackenduser.HasUserWith(entuser.Username(h.cfg.OIDCTemplateUser))).
+		WithBackend().
+		All(ctx)
+	if err != nil {
+		slog.Warn("oidc: loading template mappings", "template", h.cfg.OIDCTemplateUser, "error", err)
+		return
+	}
+	for _, m := range mappings {
+		err := h.db.BackendUser.Create().
+			SetUser(user).
+			SetBackend(m.Edges.Backend).
+			SetBackendUserID(m.BackendUserID).
+			SetNillableBackendToken(m.BackendToken).
+			SetEnabled(m.Enabled).
+			Exec(ctx)
+		if err != nil {
+			slog.Warn("oidc: copying template mapping", "user", user.Username, "backend", m.Edges.Backend.Name, "error", err)
+		}
+	}
+}
+
+// adminFromGroups reports whether the claims make the user an admin, and
+// false for ok when no admin group is configured.
+func (h *OIDCHandler) adminFromGroups(claims oidc.Claims) (isAdmin, ok bool) {
+	if h.cfg.OIDCAdminGroup == "" {
+		return false, false
+	}
+	return slices.Contains(claims.Strings(h.cfg.OIDCGroupsClaim), h.cfg.OIDCAdminGroup), true
+}
+
+// oidcCompletePage stores the new session where jellyfin-web keeps its
+// credentials and opens the web UI, which then starts already signed in.
+var oidcCompletePage = template.Must(template.New("oidc").Parse(`<!DOCTYPE html>
+<html>
+<head><meta charset="utf-8"><title>Signing in…</title></head>
+<body>
+<script>
+(function () {
+	var server = {
+		Id: {{.ServerID}},
+		Name: {{.ServerName}},
+		ManualAddress: window.location.origin,
+		LocalAddress: window.location.origin,
+		LastConnectionMode: 2,
+		DateLastAccessed: Date.now(),
+		UserId: {{.UserID}},
+		AccessToken: {{.Token}}
+	};
+	var creds = {};
+	try { creds = JSON.parse(localStorage.getItem("jellyfin_credentials")) || {}; } catch (e) {}
+	creds.Servers = (creds.Servers || []).filter(function (s) { return s.Id !== server.Id; });
+	creds.Servers.unshift(server);
+	localStorage.setItem("jellyfin_credentials", JSON.stringify(creds));
+	window.location.replace("/web/");
+})();
+</script>
+</body>
+</html>
+`))
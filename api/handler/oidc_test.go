@@ -0,0 +1,147 @@
+package handler_test
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/ddevcap/jellyfin-proxy/api/handler"
+	"github.com/ddevcap/jellyfin-proxy/api/middleware"
+	"github.com/ddevcap/jellyfin-proxy/config"
+	entuser "github.com/ddevcap/jellyfin-proxy/ent/user"
+	"github.com/ddevcap/jellyfin-proxy/oidc/oidctest"
+)
+
+var _ = Describe("OIDC login", func() {
+	var (
+		router *gin.Engine
+		idp    *oidctest.Server
+	)
+
+	// signIn runs the browser side of the flow and returns the callback
+	// response. The state cookie is dropped when withCookie is false.
+	signIn := func(withCookie bool) (int, string) {
+		w := doGet(router, "/proxy/oidc/login?device_name=Laptop")
+		Expect(w.Code).To(Equal(http.StatusFound))
+		cookie := w.Header().Get("Set-Cookie")
+		Expect(cookie).To(ContainSubstring("jellyfin_proxy_oidc_state="))
+
+		noFollow := &http.Client{CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		}}
+		resp, err := noFollow.Get(w.Header().Get("Location"))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(resp.Body.Close()).To(Succeed())
+		callback, err := url.Parse(resp.Header.Get("Location"))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(callback.Path).To(Equal("/proxy/oidc/callback"))
+
+		headers := map[string]string{}
+		if withCookie {
+			headers["Cookie"] = strings.SplitN(cookie, ";", 2)[0]
+		}
+		w = doGet(router, callback.RequestURI(), headers)
+		return w.Code, w.Body.String()
+	}
+
+	BeforeEach(func() {
+		cleanDB()
+		idp = oidctest.NewServer()
+		DeferCleanup(idp.Close)
+
+		oidcH := handler.NewOIDCHandler(db, config.Config{
+			ExternalURL:       "http://proxy.test",
+			ServerID:          "test-server-id",
+			OIDCIssuerURL:     idp.URL,
+			OIDCClientID:      oidctest.ClientID,
+			OIDCScopes:        []string{"openid", "profile", "groups"},
+			OIDCUsernameClaim: "preferred_username",
+			OIDCGroupsClaim:   "groups",
+			OIDCAdminGroup:    "jellyfin-admins",
+			OIDCTemplateUser:  "template",
+		})
+		router = gin.New()
+		router.GET("/proxy/oidc/login", oidcH.Login)
+		router.GET("/proxy/oidc/callback", oidcH.Callback)
+	})
+
+	It("creates the user on first login and hands the session to the web UI", func() {
+		template := createUser("template", "password1!", false)
+		nas := createBackend("NAS", "http://nas:8096", "s1")
+		createBackendUser(nas, template, "shared-backend-user", "shared-token")
+		idp.SetClaims(map[string]interface{}{
+			"sub":                "kc-123",
+			"preferred_username": "carol",
+			"name":               "Carol",
+			"groups":             []string{"jellyfin-admins"},
+		})
+
+		code, body := signIn(true)
+		Expect(code).To(Equal(http.StatusOK))
+		Expect(body).To(ContainSubstring("jellyfin_credentials"))
+
+		ctx := context.Background()
+		carol := db.User.Query().Where(entuser.Username("carol")).OnlyX(ctx)
+		Expect(carol.DisplayName).To(Equal("Carol"))
+		Expect(carol.IsAdmin).To(BeTrue())
+		Expect(*carol.OidcSubject).To(Equal("kc-123"))
+
+		session := carol.QuerySessions().OnlyX(ctx)
+		Expect(session.DeviceName).To(Equal("Laptop"))
+		token := regexp.MustCompile(`AccessToken: "([^"]+)"`).FindStringSubmatch(body)
+		Expect(token).To(HaveLen(2))
+		Expect(middleware.HashToken(token[1])).To(Equal(session.Token))
+
+		mapping := carol.QueryBackendUsers().OnlyX(ctx)
+		Expect(mapping.BackendUserID).To(Equal("shared-backend-user"))
+	})
+
+	It("signs in an account an admin linked to the subject and follows the admin group", func() {
+		existing := createUser("dave", "password1!", true)
+		db.User.UpdateOne(existing).SetOidcSubject("kc-456").ExecX(context.Background())
+		idp.SetClaims(map[string]interface{}{
+			"sub":                "kc-456",
+			"preferred_username": "david",
+			"groups":             []string{"users"},
+		})
+
+		code, _ := signIn(true)
+		Expect(code).To(Equal(http.StatusOK))
+
+		dave := db.User.GetX(context.Background(), existing.ID)
+		Expect(dave.Username).To(Equal("dave"))
+		Expect(dave.IsAdmin).To(BeFalse())
+		Expect(db.User.Query().CountX(context.Background())).To(Equal(1))
+	})
+
+	It("never links an existing account by username", func() {
+		existing := createUser("dave", "password1!", true)
+		idp.SetClaims(map[string]interface{}{
+			"sub":                "attacker",
+			"preferred_username": "dave",
+			"groups":             []string{"jellyfin-admins"},
+		})
+
+		code, _ := signIn(true)
+		Expect(code).To(Equal(http.StatusForbidden))
+
+		dave := db.User.GetX(context.Background(), existing.ID)
+		Expect(dave.OidcSubject).To(BeNil())
+		Expect(dave.QuerySessions().CountX(context.Background())).To(BeZero())
+	})
+
+	It("rejects a callback from a browser that did not start the login", func() {
+		idp.SetClaims(map[string]interface{}{"sub": "kc-789", "preferred_username": "eve"})
+
+		code, _ := signIn(false)
+		Expect(code).To(Equal(http.StatusBadRequest))
+		Expect(db.User.Query().CountX(context.Background())).To(BeZero())
+	})
+})
@@ -0,0 +1,292 @@
+package handler
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base32"
+	"log/slog"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ddevcap/jellyfin-proxy/activity"
+	"github.com/ddevcap/jellyfin-proxy/api/middleware"
+	"github.com/ddevcap/jellyfin-proxy/config"
+	"github.com/ddevcap/jellyfin-proxy/ent"
+	entuser "github.com/ddevcap/jellyfin-proxy/ent/user"
+	"github.com/ddevcap/jellyfin-proxy/notify"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/jellydator/ttlcache/v3"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// ── Forgotten passwords ───────────────────────────────────────────────────────
+//
+// Jellyfin's PIN flow: POST /Users/ForgotPassword issues a PIN that is
+// delivered out of band, by the configured notifiers, and POST
+// /Users/ForgotPassword/Pin redeems it, which makes the PIN the user's new
+// password. PINs live in memory only, so a restart cancels them.
+
+// pendingReset is an issued PIN, waiting to be redeemed.
+type pendingReset struct {
+	UserID    uuid.UUID
+	Username  string
+	PIN       string
+	ExpiresAt time.Time
+}
+
+// passwordResetStore holds the pending PINs, keyed by user, and the
+// usernames that asked for one within the last interval.
+type passwordResetStore struct {
+	mu       sync.Mutex
+	pins     *ttlcache.Cache[uuid.UUID, pendingReset]
+	recent   *ttlcache.Cache[string, struct{}]
+	interval time.Duration
+}
+
+func newPasswordResetStore(interval time.Duration) *passwordResetStore {
+	pins := ttlcache.New[uuid.UUID, pendingReset](
+		ttlcache.WithDisableTouchOnHit[uuid.UUID, pendingReset](),
+	)
+	recent := ttlcache.New[string, struct{}](
+		ttlcache.WithDisableTouchOnHit[string, struct{}](),
+	)
+	go pins.Start() // drops PINs that were never redeemed
+	go recent.Start()
+	return &passwordResetStore{pins: pins, recent: recent, interval: interval}
+}
+
+// allow reports whether username may ask for a PIN, and if so counts the
+// request against the interval.
+func (s *passwordResetStore) allow(username string) bool {
+	if s.interval <= 0 {
+		return true
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.recent.Has(username) {
+		return false
+	}
+	s.recent.Set(username, struct{}{}, s.interval)
+	return true
+}
+
+// issue creates a PIN for user that expires at expiresAt. It reports false,
+// and creates nothing, while the user still has a PIN to redeem: asking
+// again must not cancel a PIN that is on its way to the user.
+func (s *passwordResetStore) issue(user *ent.User, expiresAt time.Time) (pendingReset, bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if item := s.pins.Get(user.ID); item != nil {
+		return item.Value(), false, nil
+	}
+	// Sixteen base32 characters, 80 bits: the PIN flow takes nothing but
+	// the PIN, so it must not be guessable across all pending PINs. As the
+	// PIN becomes the password, it also meets the minimum password length.
+	var b [10]byte
+	if _, err := rand.Read(b[:]); err != nil {
+		return pendingReset{}, false, err
+	}
+	r := pendingReset{
+		UserID:    user.ID,
+		Username:  user.Username,
+		PIN:       base32.StdEncoding.EncodeToString(b[:]),
+		ExpiresAt: expiresAt,
+	}
+	s.pins.Set(user.ID, r, time.Until(expiresAt))
+	return r, true, nil
+}
+
+// cancel drops the PIN of the user with userID.
+func (s *passwordResetStore) cancel(userID uuid.UUID) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.pins.Delete(userID)
+}
+
+// redeem removes the PIN and returns what it was issued for. A PIN can only
+// be redeemed once. When username is set, only that user's PIN matches.
+// Case, spaces and dashes are ignored.
+func (s *passwordResetStore) redeem(pin, username string) (pendingReset, bool) {
+	pin = strings.ToUpper(strings.NewReplacer("-", "", " ", "").Replace(pin))
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for id, item := range s.pins.Items() {
+		r := item.Value()
+		if username != "" && r.Username != username {
+			continue
+		}
+		if subtle.ConstantTimeCompare([]byte(r.PIN), []byte(pin)) == 1 {
+			s.pins.Delete(id)
+			return r, true
+		}
+	}
+	return pendingReset{}, false
+}
+
+// newPasswordResetNotifiers returns the configured ways of delivering PINs;
+// none disables the PIN flow.
+func newPasswordResetNotifiers(cfg config.Config) []notify.Notifier {
+	var notifiers []notify.Notifier
+	if cfg.PasswordResetPinFile != "" {
+		notifiers = append(notifiers, notify.File{Path: cfg.PasswordResetPinFile})
+	}
+	if len(cfg.PasswordResetEmailTo) > 0 {
+		notifiers = append(notifiers, notify.SMTP{
+			Host:     cfg.SMTPHost,
+			Port:     cfg.SMTPPort,
+			Username: cfg.SMTPUsername,
+			Password: cfg.SMTPPassword,
+			From:     cfg.SMTPFrom,
+			To:       cfg.PasswordResetEmailTo,
+		})
+	}
+	if cfg.PasswordResetWebhookURL != "" {
+		notifiers = append(notifiers, notify.Webhook{URL: cfg.PasswordResetWebhookURL})
+	}
+	return notifiers
+}
+
+type forgotPasswordRequest struct {
+	EnteredUsername string `json:"EnteredUsername" binding:"required"`
+}
+
+// ForgotPassword handles POST /Users/ForgotPassword.
+// It issues a PIN and tells the client where it was sent, in PinFile. The
+// answer is the same whether or not the username exists; LDAP and disabled
+// accounts get it too, without a PIN. Each username may ask once per
+// PASSWORD_RESET_INTERVAL, and a user whose PIN has not expired yet gets no
+// new one.
+func (h *AuthHandler) ForgotPassword(c *gin.Context) {
+	var req forgotPasswordRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	if len(h.resetNotifiers) == 0 {
+		c.JSON(http.StatusOK, gin.H{
+			"Action":            "ContactAdmin",
+			"PinFile":           "",
+			"PinExpirationDate": nil,
+		})
+		return
+	}
+	if !h.passwordReset.allow(req.EnteredUsername) {
+		c.JSON(http.StatusTooManyRequests, gin.H{"error": "A PIN was requested recently; try again later"})
+		return
+	}
+
+	ctx := c.Request.Context()
+	user, err := h.db.User.Query().
+		Where(entuser.Username(req.EnteredUsername)).
+		Only(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to get user"})
+		return
+	}
+
+	destinations := make([]string, len(h.resetNotifiers))
+	for i, n := range h.resetNotifiers {
+		destinations[i] = n.String()
+	}
+	expiresAt := time.Now().Add(h.cfg.PasswordResetPinTTL).UTC()
+	resp := gin.H{
+		"Action":            "PinCode",
+		"PinFile":           strings.Join(destinations, ", "),
+		"PinExpirationDate": expiresAt,
+	}
+	if user == nil || user.AuthSource == authSourceLDAP || user.IsDisabled {
+		c.JSON(http.StatusOK, resp)
+		return
+	}
+
+	r, issued, err := h.passwordReset.issue(user, expiresAt)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to generate PIN"})
+		return
+	}
+	if !issued {
+		// The earlier PIN stays valid; the answer must not tell it apart.
+		c.JSON(http.StatusOK, resp)
+		return
+	}
+	delivered := false
+	for _, n := range h.resetNotifiers {
+		err := n.Notify(ctx, notify.PasswordReset{Username: r.Username, PIN: r.PIN, ExpiresAt: expiresAt})
+		if err != nil {
+			slog.Error("failed to deliver password reset PIN", "user", r.Username, "via", n.String(), "error", err)
+			continue
+		}
+		delivered = true
+	}
+	if !delivered {
+		h.passwordReset.cancel(r.UserID)
+		c.JSON(http.StatusBadGateway, gin.H{"error": "failed to deliver the PIN"})
+		return
+	}
+	activity.Record(ctx, h.db, activity.Entry{
+		Name:          "Password reset was requested for user " + user.Username,
+		Type:          "PasswordResetRequested",
+		ShortOverview: "IP address: " + middleware.ClientIP(c),
+		UserID:        user.ID,
+	})
+	c.JSON(http.StatusOK, resp)
+}
+
+type forgotPasswordPinRequest struct {
+	Pin string `json:"Pin" binding:"required"`
+	// Jellyfin clients send the PIN alone; clients that still know the
+	// username the PIN was asked for may send it to redeem only its PIN.
+	EnteredUsername string `json:"EnteredUsername"`
+}
+
+// ForgotPasswordPin handles POST /Users/ForgotPassword/Pin.
+// A valid PIN becomes the user's password, lifts any lockout and signs the
+// user out everywhere. Wrong PINs count as failed logins for the client's IP.
+func (h *AuthHandler) ForgotPasswordPin(c *gin.Context) {
+	var req forgotPasswordPinRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	ip := middleware.ClientIP(c)
+	r, ok := h.passwordReset.redeem(req.Pin, req.EnteredUsername)
+	if !ok {
+		h.onLoginFail(ip)
+		c.JSON(http.StatusOK, gin.H{"Success": false, "UsersReset": []string{}})
+		return
+	}
+
+	hash, err := bcrypt.GenerateFromPassword([]byte(r.PIN), BcryptCost)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to hash password"})
+		return
+	}
+	ctx := c.Request.Context()
+	user, err := h.db.User.UpdateOneID(r.UserID).
+		SetHashedPassword(string(hash)).
+		SetFailedLoginCount(0).
+		ClearLockedUntil().
+		Save(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			c.JSON(http.StatusOK, gin.H{"Success": false, "UsersReset": []string{}})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to update password"})
+		return
+	}
+	if _, err := revokeUserSessions(ctx, h.db, h.hub, user.ID); err != nil {
+		slog.Warn("failed to revoke sessions after password reset", "user", user.Username, "error", err)
+	}
+	activity.Record(ctx, h.db, activity.Entry{
+		Name:          "Password was reset for user " + user.Username,
+		Type:          "UserPasswordChanged",
+		ShortOverview: "IP address: " + ip,
+		UserID:        user.ID,
+	})
+	c.JSON(http.StatusOK, gin.H{"Success": true, "UsersReset": []string{user.Username}})
+}
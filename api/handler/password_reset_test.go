@@ -0,0 +1,175 @@
+package handler_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/ddevcap/jellyfin-proxy/api/handler"
+	"github.com/ddevcap/jellyfin-proxy/config"
+	"github.com/ddevcap/jellyfin-proxy/ent"
+	"github.com/ddevcap/jellyfin-proxy/notify"
+)
+
+var _ = Describe("Forgot password", func() {
+	var (
+		router  *gin.Engine
+		alice   *ent.User
+		pinFile string
+		failed  []string
+	)
+
+	newRouter := func(cfg config.Config) {
+		router = gin.New()
+		h := handler.NewAuthHandler(db, cfg, func(ip string) { failed = append(failed, ip) }, func(string) {})
+		router.POST("/Users/AuthenticateByName", h.AuthenticateByName)
+		router.POST("/Users/ForgotPassword", h.ForgotPassword)
+		router.POST("/Users/ForgotPassword/Pin", h.ForgotPasswordPin)
+	}
+
+	BeforeEach(func() {
+		cleanDB()
+		gin.SetMode(gin.TestMode)
+		failed = nil
+		pinFile = filepath.Join(GinkgoT().TempDir(), "pins.jsonl")
+		newRouter(config.Config{
+			PasswordResetPinFile:  pinFile,
+			PasswordResetPinTTL:   15 * time.Minute,
+			PasswordResetInterval: 5 * time.Minute,
+		})
+		alice = createUser("alice", "correctpass1", false)
+	})
+
+	forgot := func(username string) map[string]interface{} {
+		w := doPost(router, "/Users/ForgotPassword", map[string]string{"EnteredUsername": username})
+		Expect(w.Code).To(Equal(http.StatusOK))
+		var resp map[string]interface{}
+		Expect(json.Unmarshal(w.Body.Bytes(), &resp)).To(Succeed())
+		return resp
+	}
+
+	// pins returns the PINs written to the PIN file, oldest first.
+	pins := func() []notify.PasswordReset {
+		data, err := os.ReadFile(pinFile)
+		if os.IsNotExist(err) {
+			return nil
+		}
+		Expect(err).NotTo(HaveOccurred())
+		var out []notify.PasswordReset
+		for _, line := range strings.Split(strings.TrimSpace(string(data)), "\n") {
+			var r notify.PasswordReset
+			Expect(json.Unmarshal([]byte(line), &r)).To(Succeed())
+			out = append(out, r)
+		}
+		return out
+	}
+
+	redeem := func(pin string) map[string]interface{} {
+		w := doPost(router, "/Users/ForgotPassword/Pin", map[string]string{"Pin": pin})
+		Expect(w.Code).To(Equal(http.StatusOK))
+		var resp map[string]interface{}
+		Expect(json.Unmarshal(w.Body.Bytes(), &resp)).To(Succeed())
+		return resp
+	}
+
+	It("writes a PIN to the configured file", func() {
+		resp := forgot("alice")
+		Expect(resp["Action"]).To(Equal("PinCode"))
+		Expect(resp["PinFile"]).To(Equal(pinFile))
+		Expect(resp["PinExpirationDate"]).NotTo(BeNil())
+
+		written := pins()
+		Expect(written).To(HaveLen(1))
+		Expect(written[0].Username).To(Equal("alice"))
+		Expect(written[0].PIN).To(MatchRegexp(`^[A-Z2-7]{16}$`))
+		Expect(written[0].ExpiresAt).To(BeTemporally("~", time.Now().Add(15*time.Minute), time.Minute))
+	})
+
+	It("makes the PIN the new password, once", func() {
+		createSession(alice, "reset-alice-token")
+		db.User.UpdateOne(alice).SetFailedLoginCount(3).SetLockedUntil(time.Now().Add(time.Hour)).ExecX(context.Background())
+		forgot("alice")
+		pin := pins()[0].PIN
+
+		resp := redeem(strings.ToLower(pin))
+		Expect(resp["Success"]).To(BeTrue())
+		Expect(resp["UsersReset"]).To(ConsistOf("alice"))
+
+		u := db.User.GetX(context.Background(), alice.ID)
+		Expect(u.LockedUntil).To(BeNil())
+		Expect(db.Session.Query().CountX(context.Background())).To(BeZero())
+		w := doPost(router, "/Users/AuthenticateByName", map[string]string{"Username": "alice", "Pw": pin})
+		Expect(w.Code).To(Equal(http.StatusOK))
+
+		Expect(redeem(pin)["Success"]).To(BeFalse())
+	})
+
+	It("counts wrong PINs as failed logins", func() {
+		forgot("alice")
+
+		resp := redeem("00000000")
+		Expect(resp["Success"]).To(BeFalse())
+		Expect(resp["UsersReset"]).To(BeEmpty())
+		Expect(failed).To(HaveLen(1))
+	})
+
+	It("answers unknown usernames the same way without sending a PIN", func() {
+		resp := forgot("nobody")
+		Expect(resp["Action"]).To(Equal("PinCode"))
+		Expect(resp["PinFile"]).To(Equal(pinFile))
+		Expect(pins()).To(BeEmpty())
+	})
+
+	It("does not send PINs for disabled users", func() {
+		db.User.UpdateOne(alice).SetIsDisabled(true).ExecX(context.Background())
+
+		Expect(forgot("alice")["Action"]).To(Equal("PinCode"))
+		Expect(pins()).To(BeEmpty())
+	})
+
+	It("limits how often a user can ask for a PIN", func() {
+		forgot("alice")
+
+		w := doPost(router, "/Users/ForgotPassword", map[string]string{"EnteredUsername": "alice"})
+		Expect(w.Code).To(Equal(http.StatusTooManyRequests))
+		Expect(pins()).To(HaveLen(1))
+	})
+
+	It("keeps a user's outstanding PIN when another one is asked for", func() {
+		newRouter(config.Config{PasswordResetPinFile: pinFile, PasswordResetPinTTL: 15 * time.Minute})
+		forgot("alice")
+		Expect(forgot("alice")["Action"]).To(Equal("PinCode"))
+		written := pins()
+		Expect(written).To(HaveLen(1))
+
+		Expect(redeem(written[0].PIN)["Success"]).To(BeTrue())
+	})
+
+	It("redeems only the PIN of the username sent with it", func() {
+		createUser("bob", "correctpass1", false)
+		forgot("alice")
+		pin := pins()[0].PIN
+
+		w := doPost(router, "/Users/ForgotPassword/Pin", map[string]string{"Pin": pin, "EnteredUsername": "bob"})
+		Expect(w.Body.String()).To(ContainSubstring(`"Success":false`))
+
+		w = doPost(router, "/Users/ForgotPassword/Pin", map[string]string{"Pin": pin, "EnteredUsername": "alice"})
+		Expect(w.Body.String()).To(ContainSubstring(`"Success":true`))
+	})
+
+	It("tells users to contact the administrator without a way to send PINs", func() {
+		newRouter(config.Config{})
+
+		resp := forgot("alice")
+		Expect(resp["Action"]).To(Equal("ContactAdmin"))
+	})
+})
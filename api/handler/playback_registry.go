@@ -0,0 +1,343 @@
+package handler
+
+import (
+	"cmp"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/ddevcap/jellyfin-proxy/activity"
+	"github.com/ddevcap/jellyfin-proxy/ent"
+	"github.com/ddevcap/jellyfin-proxy/ent/playbackevent"
+	"github.com/google/uuid"
+	"github.com/jellydator/ttlcache/v3"
+)
+
+const (
+	// playbackIdleTimeout is how long a playback stays listed without a
+	// progress report. Clients report every few seconds while playing, so
+	// this only drops streams whose client vanished without a stop report.
+	playbackIdleTimeout = 5 * time.Minute
+	// playbackRefreshInterval is how often the item and transcoding details
+	// of a running playback are re-read from its backend.
+	playbackRefreshInterval = 30 * time.Second
+)
+
+// activePlayback is one live stream as seen through the playback reports of
+// a proxy client.
+type activePlayback struct {
+	SessionID           uuid.UUID `json:"session_id"`
+	UserID              uuid.UUID `json:"user_id"`
+	Username            string    `json:"username"`
+	DeviceID            string    `json:"device_id"`
+	DeviceName          string    `json:"device_name"`
+	Client              string    `json:"client"`
+	ItemID              string    `json:"item_id"`
+	ItemName            string    `json:"item_name"`
+	ItemType            string    `json:"item_type"`
+	SeriesName          string    `json:"series_name,omitempty"`
+	BackendID           string    `json:"backend_id"`
+	BackendName         string    `json:"backend_name"`
+	MediaSourceID       string    `json:"media_source_id"`
+	PlaySessionID       string    `json:"play_session_id"`
+	PositionTicks       int64     `json:"position_ticks"`
+	RunTimeTicks        int64     `json:"runtime_ticks"`
+	IsPaused            bool      `json:"is_paused"`
+	IsMuted             bool      `json:"is_muted"`
+	AudioStreamIndex    *int      `json:"audio_stream_index"`
+	SubtitleStreamIndex *int      `json:"subtitle_stream_index"`
+	PlayMethod          string    `json:"play_method"`
+	TranscodeReasons    []string  `json:"transcode_reasons"`
+	Bitrate             int64     `json:"bitrate"`
+	StartedAt           time.Time `json:"started_at"`
+	UpdatedAt           time.Time `json:"updated_at"`
+
+	// nowPlayingItem and transcodingInfo are the backend's own SessionInfo
+	// objects, with proxy IDs, for the Jellyfin-format session list.
+	nowPlayingItem  json.RawMessage
+	transcodingInfo json.RawMessage
+	refreshedAt     time.Time
+	mediaType       string // "Video" or "Audio", from nowPlayingItem
+
+	eventID      uuid.UUID     // the PlaybackEvent row recording this playback
+	playDuration time.Duration // time spent playing up to UpdatedAt
+}
+
+// advance returns p's play duration extended to now, counting the time
+// since the last report unless the playback was paused.
+func (p activePlayback) advance(now time.Time) time.Duration {
+	if p.IsPaused {
+		return p.playDuration
+	}
+	return p.playDuration + now.Sub(p.UpdatedAt)
+}
+
+// PlaybackRegistry keeps the live streams of all proxy clients in memory,
+// keyed by user and device. It is fed by the /Sessions/Playing reports that
+// the proxy forwards to backends, so it covers every backend without asking
+// any of them who is watching.
+//
+// With a database, every playback is also recorded as a PlaybackEvent: the
+// row is created on the first report, brought up to date whenever the
+// backend details are refreshed and closed when the playback stops or
+// times out. Its start and end are written to the activity log as well,
+// the start once the backend has told us what is playing. Rows left open by
+// a previous run are closed when the registry is created.
+type PlaybackRegistry struct {
+	db      *ent.Client // nil keeps no history
+	mu      sync.Mutex
+	entries *ttlcache.Cache[string, activePlayback]
+}
+
+func NewPlaybackRegistry(db *ent.Client) *PlaybackRegistry {
+	cache := ttlcache.New[string, activePlayback](
+		ttlcache.WithTTL[string, activePlayback](playbackIdleTimeout),
+		ttlcache.WithDisableTouchOnHit[string, activePlayback](),
+	)
+	r := &PlaybackRegistry{db: db, entries: cache}
+	r.closeOrphanedEvents(context.Background())
+	cache.OnEviction(func(ctx context.Context, reason ttlcache.EvictionReason, item *ttlcache.Item[string, activePlayback]) {
+		if reason == ttlcache.EvictionReasonExpired {
+			// The client vanished; the playback ended with its last report.
+			p := item.Value()
+			r.saveEvent(context.Background(), p, &p.UpdatedAt)
+		}
+	})
+	go cache.Start() // drops playbacks that stopped reporting
+	return r
+}
+
+func playbackKey(userID uuid.UUID, deviceID string) string {
+	return userID.String() + "|" + deviceID
+}
+
+// report records a start or progress report. A report for a different item
+// than the device was playing replaces the playback. It returns true when the
+// backend details of the playback are due for a refresh, and the caller is
+// expected to do it.
+func (r *PlaybackRegistry) report(ctx context.Context, p activePlayback) bool {
+	r.mu.Lock()
+	key := playbackKey(p.UserID, p.DeviceID)
+	now := time.Now()
+	p.UpdatedAt = now
+	p.StartedAt = now
+	p.eventID = uuid.New()
+	started := true
+	var replaced *activePlayback
+	if item := r.entries.Get(key); item != nil {
+		prev := item.Value()
+		if prev.ItemID != p.ItemID {
+			replaced = &prev
+		} else {
+			started = false
+			p.StartedAt = prev.StartedAt
+			p.eventID = prev.eventID
+			p.playDuration = prev.advance(now)
+			p.ItemName, p.ItemType, p.SeriesName = prev.ItemName, prev.ItemType, prev.SeriesName
+			p.RunTimeTicks = prev.RunTimeTicks
+			p.TranscodeReasons, p.Bitrate = prev.TranscodeReasons, prev.Bitrate
+			p.nowPlayingItem, p.transcodingInfo = prev.nowPlayingItem, prev.transcodingInfo
+			p.mediaType = prev.mediaType
+			p.refreshedAt = prev.refreshedAt
+		}
+	}
+	refresh := now.Sub(p.refreshedAt) >= playbackRefreshInterval
+	if refresh {
+		// Claimed now so concurrent reports don't start a second refresh.
+		p.refreshedAt = now
+	}
+	r.entries.Set(key, p, ttlcache.DefaultTTL)
+	r.mu.Unlock()
+
+	if replaced != nil {
+		replaced.playDuration = replaced.advance(now)
+		r.saveEvent(ctx, *replaced, &now)
+	}
+	if started {
+		r.createEvent(ctx, p)
+	} else if refresh {
+		r.saveEvent(ctx, p, nil)
+	}
+	return refresh
+}
+
+// enrich stores the details the backend reports for a playback, unless the
+// device has moved on to another item in the meantime.
+func (r *PlaybackRegistry) enrich(userID uuid.UUID, deviceID, itemID string, nowPlaying, transcoding json.RawMessage) {
+	var np struct {
+		Name         string `json:"Name"`
+		Type         string `json:"Type"`
+		MediaType    string `json:"MediaType"`
+		SeriesName   string `json:"SeriesName"`
+		RunTimeTicks int64  `json:"RunTimeTicks"`
+	}
+	_ = json.Unmarshal(nowPlaying, &np)
+	var ti struct {
+		Bitrate          int64    `json:"Bitrate"`
+		TranscodeReasons []string `json:"TranscodeReasons"`
+	}
+	_ = json.Unmarshal(transcoding, &ti)
+
+	r.mu.Lock()
+	key := playbackKey(userID, deviceID)
+	item := r.entries.Get(key)
+	if item == nil || item.Value().ItemID != itemID {
+		r.mu.Unlock()
+		return
+	}
+	p := item.Value()
+	announce := p.ItemName == "" && np.Name != ""
+	p.ItemName, p.ItemType, p.SeriesName = np.Name, np.Type, np.SeriesName
+	p.mediaType = np.MediaType
+	if np.RunTimeTicks > 0 {
+		p.RunTimeTicks = np.RunTimeTicks
+	}
+	p.TranscodeReasons, p.Bitrate = ti.TranscodeReasons, ti.Bitrate
+	p.nowPlayingItem, p.transcodingInfo = nowPlaying, transcoding
+	if ttl := time.Until(item.ExpiresAt()); ttl > 0 {
+		r.entries.Set(key, p, ttl) // keep the expiry of the last report
+	}
+	r.mu.Unlock()
+	r.saveEvent(context.Background(), p, nil)
+	if announce {
+		r.recordActivity(context.Background(), p, "Playback", "is playing")
+	}
+}
+
+// stop removes the device's playback and closes its event at positionTicks.
+func (r *PlaybackRegistry) stop(ctx context.Context, userID uuid.UUID, deviceID string, positionTicks int64) {
+	r.mu.Lock()
+	key := playbackKey(userID, deviceID)
+	item := r.entries.Get(key)
+	r.entries.Delete(key)
+	r.mu.Unlock()
+	if item == nil {
+		return
+	}
+	now := time.Now()
+	p := item.Value()
+	p.playDuration = p.advance(now)
+	if positionTicks > 0 {
+		p.PositionTicks = positionTicks
+	}
+	r.saveEvent(ctx, p, &now)
+}
+
+// createEvent inserts the PlaybackEvent row for a new playback.
+func (r *PlaybackRegistry) createEvent(ctx context.Context, p activePlayback) {
+	if r.db == nil {
+		return
+	}
+	backendID, _ := uuid.Parse(p.BackendID)
+	err := r.db.PlaybackEvent.Create().
+		SetID(p.eventID).
+		SetUserID(p.UserID).
+		SetUsername(p.Username).
+		SetSessionID(p.SessionID).
+		SetDeviceID(p.DeviceID).
+		SetDeviceName(p.DeviceName).
+		SetAppName(p.Client).
+		SetItemID(p.ItemID).
+		SetBackendID(backendID).
+		SetBackendName(p.BackendName).
+		SetPlayMethod(p.PlayMethod).
+		SetStartedAt(p.StartedAt).
+		SetPositionTicks(p.PositionTicks).
+		Exec(ctx)
+	if err != nil {
+		slog.Warn("playback: recording event", "item", p.ItemID, "error", err)
+	}
+}
+
+// closeOrphanedEvents closes the PlaybackEvent rows that are still open. The
+// registry starts empty, so they belong to playbacks that were running when
+// the proxy last stopped and will never get a stop report. Each is closed
+// after the play time it had recorded, as the last known point it was alive.
+func (r *PlaybackRegistry) closeOrphanedEvents(ctx context.Context) {
+	if r.db == nil {
+		return
+	}
+	open, err := r.db.PlaybackEvent.Query().
+		Where(playbackevent.StoppedAtIsNil()).
+		All(ctx)
+	if err != nil {
+		slog.Warn("playback: loading open events", "error", err)
+		return
+	}
+	for _, e := range open {
+		stoppedAt := e.StartedAt.Add(time.Duration(e.PlayDuration) * time.Second)
+		if err := r.db.PlaybackEvent.UpdateOne(e).SetStoppedAt(stoppedAt).Exec(ctx); err != nil {
+			slog.Warn("playback: closing open event", "item", e.ItemID, "error", err)
+		}
+	}
+}
+
+// saveEvent writes the current state of a playback to its PlaybackEvent row,
+// closing it when stoppedAt is set.
+func (r *PlaybackRegistry) saveEvent(ctx context.Context, p activePlayback, stoppedAt *time.Time) {
+	if r.db == nil {
+		return
+	}
+	u := r.db.PlaybackEvent.UpdateOneID(p.eventID).
+		SetPositionTicks(p.PositionTicks).
+		SetPlayDuration(int64(p.playDuration / time.Second)).
+		SetPlayMethod(p.PlayMethod)
+	if p.ItemName != "" {
+		u.SetItemName(p.ItemName).SetItemType(p.ItemType)
+	}
+	if stoppedAt != nil {
+		u.SetStoppedAt(*stoppedAt)
+	}
+	if err := u.Exec(ctx); err != nil && !ent.IsNotFound(err) {
+		slog.Warn("playback: updating event", "item", p.ItemID, "error", err)
+	}
+	if stoppedAt != nil && p.ItemName != "" {
+		r.recordActivity(ctx, p, "PlaybackStopped", "has finished playing")
+	}
+}
+
+// recordActivity writes a playback start or stop to the activity log, typed
+// like Jellyfin's VideoPlayback / AudioPlaybackStopped events.
+func (r *PlaybackRegistry) recordActivity(ctx context.Context, p activePlayback, event, verb string) {
+	item := p.ItemName
+	if p.SeriesName != "" {
+		item = p.SeriesName + " - " + item
+	}
+	activity.Record(ctx, r.db, activity.Entry{
+		Name:          fmt.Sprintf("%s %s %s on %s", p.Username, verb, item, p.DeviceName),
+		Type:          fallback(p.mediaType, "Video") + event,
+		ShortOverview: p.Client,
+		UserID:        p.UserID,
+		ItemID:        p.ItemID,
+	})
+}
+
+// get returns the device's playback, if any.
+func (r *PlaybackRegistry) get(userID uuid.UUID, deviceID string) (activePlayback, bool) {
+	item := r.entries.Get(playbackKey(userID, deviceID))
+	if item == nil {
+		return activePlayback{}, false
+	}
+	return item.Value(), true
+}
+
+// list returns all live playbacks, oldest first.
+func (r *PlaybackRegistry) list() []activePlayback {
+	out := []activePlayback{}
+	for _, item := range r.entries.Items() {
+		if !item.IsExpired() {
+			out = append(out, item.Value())
+		}
+	}
+	slices.SortFunc(out, func(a, b activePlayback) int {
+		if n := a.StartedAt.Compare(b.StartedAt); n != 0 {
+			return n
+		}
+		return cmp.Compare(a.DeviceID, b.DeviceID)
+	})
+	return out
+}
@@ -1,12 +1,18 @@
 package handler
 
 import (
+	"log/slog"
 	"net/http"
 	"time"
 
+	"github.com/ddevcap/jellyfin-proxy/access"
+	"github.com/ddevcap/jellyfin-proxy/activity"
 	"github.com/ddevcap/jellyfin-proxy/ent"
+	entapppassword "github.com/ddevcap/jellyfin-proxy/ent/apppassword"
 	entbackenduser "github.com/ddevcap/jellyfin-proxy/ent/backenduser"
+	entdisplaypreference "github.com/ddevcap/jellyfin-proxy/ent/displaypreference"
 	entuser "github.com/ddevcap/jellyfin-proxy/ent/user"
+	entuserconfiguration "github.com/ddevcap/jellyfin-proxy/ent/userconfiguration"
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
 	"golang.org/x/crypto/bcrypt"
@@ -14,13 +20,26 @@ import (
 
 // ProxyUserHandler manages proxy-local user accounts via the admin REST API.
 type ProxyUserHandler struct {
-	db *ent.Client
+	db        *ent.Client
+	hub       *WSHub            // nil until SetWSHub
+	onDeleted []func(uuid.UUID) // called with the ID of each deleted user
 }
 
 func NewProxyUserHandler(db *ent.Client) *ProxyUserHandler {
 	return &ProxyUserHandler{db: db}
 }
 
+// SetWSHub lets the handler close the WebSockets of users it disables.
+func (h *ProxyUserHandler) SetWSHub(hub *WSHub) {
+	h.hub = hub
+}
+
+// OnUserDeleted registers fn to be called after a user is deleted, so other
+// handlers can drop what they cached about the user.
+func (h *ProxyUserHandler) OnUserDeleted(fn func(userID uuid.UUID)) {
+	h.onDeleted = append(h.onDeleted, fn)
+}
+
 // userResponse is the outward representation of a proxy user.
 // hashed_password is intentionally omitted.
 type userResponse struct {
@@ -28,19 +47,37 @@ type userResponse struct {
 	Username    string    `json:"username"`
 	DisplayName string    `json:"display_name"`
 	IsAdmin     bool      `json:"is_admin"`
+	IsDisabled  bool      `json:"is_disabled"`
+	// TwoFactorEnabled reports TOTP enrolment; DELETE /proxy/users/:id/2fa
+	// resets it.
+	TwoFactorEnabled bool `json:"two_factor_enabled"`
+	// FailedLoginCount counts consecutive failed logins; LockedUntil is set
+	// while the account is locked out because of them.
+	FailedLoginCount int        `json:"failed_login_count"`
+	LockedUntil      *time.Time `json:"locked_until,omitempty"`
+	// OIDCSubject is the SSO identity the account is linked to.
+	OIDCSubject *string   `json:"oidc_subject,omitempty"`
 	CreatedAt   time.Time `json:"created_at"`
 	UpdatedAt   time.Time `json:"updated_at"`
 }
 
 func toUserResponse(u *ent.User) userResponse {
-	return userResponse{
-		ID:          u.ID,
-		Username:    u.Username,
-		DisplayName: u.DisplayName,
-		IsAdmin:     u.IsAdmin,
-		CreatedAt:   u.CreatedAt,
-		UpdatedAt:   u.UpdatedAt,
+	r := userResponse{
+		ID:               u.ID,
+		Username:         u.Username,
+		DisplayName:      u.DisplayName,
+		IsAdmin:          u.IsAdmin,
+		IsDisabled:       u.IsDisabled,
+		TwoFactorEnabled: u.TotpEnabled,
+		FailedLoginCount: u.FailedLoginCount,
+		OIDCSubject:      u.OidcSubject,
+		CreatedAt:        u.CreatedAt,
+		UpdatedAt:        u.UpdatedAt,
+	}
+	if accountLocked(u) {
+		r.LockedUntil = u.LockedUntil
 	}
+	return r
 }
 
 // ── Create ────────────────────────────────────────────────────────────────────
@@ -80,6 +117,11 @@ func (h *ProxyUserHandler) CreateUser(c *gin.Context) {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to create user"})
 		return
 	}
+	recordAdminActivity(c, h.db, activity.Entry{
+		Name:   "User " + user.Username + " has been created",
+		Type:   "UserCreated",
+		UserID: user.ID,
+	})
 
 	c.JSON(http.StatusCreated, toUserResponse(user))
 }
@@ -134,6 +176,11 @@ type updateUserRequest struct {
 	DisplayName *string `json:"display_name"`
 	Password    *string `json:"password"`
 	IsAdmin     *bool   `json:"is_admin"`
+	// IsDisabled disables the account and revokes its sessions.
+	IsDisabled *bool `json:"is_disabled"`
+	// OIDCSubject links the account to the SSO identity with this subject;
+	// "" unlinks it.
+	OIDCSubject *string `json:"oidc_subject"`
 }
 
 // UpdateUser handles PATCH /proxy/users/:id.
@@ -181,6 +228,37 @@ func (h *ProxyUserHandler) UpdateUser(c *gin.Context) {
 		changed = true
 	}
 
+	if req.OIDCSubject != nil {
+		if *req.OIDCSubject == "" {
+			upd.ClearOidcSubject()
+		} else {
+			upd.SetOidcSubject(*req.OIDCSubject)
+		}
+		changed = true
+	}
+
+	if req.IsDisabled != nil {
+		if *req.IsDisabled {
+			// Admins must be demoted first, so disabling can never lock
+			// every administrator out.
+			isAdmin := req.IsAdmin != nil && *req.IsAdmin
+			if req.IsAdmin == nil {
+				var err error
+				isAdmin, err = h.db.User.Query().Where(entuser.ID(id), entuser.IsAdmin(true)).Exist(c.Request.Context())
+				if err != nil {
+					c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to get user"})
+					return
+				}
+			}
+			if isAdmin {
+				c.JSON(http.StatusBadRequest, gin.H{"error": "administrators cannot be disabled"})
+				return
+			}
+		}
+		upd.SetIsDisabled(*req.IsDisabled)
+		changed = true
+	}
+
 	if !changed {
 		c.JSON(http.StatusBadRequest, gin.H{"error": "no fields provided to update"})
 		return
@@ -192,13 +270,130 @@ func (h *ProxyUserHandler) UpdateUser(c *gin.Context) {
 			c.JSON(http.StatusNotFound, gin.H{"error": "user not found"})
 			return
 		}
+		if ent.IsConstraintError(err) {
+			c.JSON(http.StatusConflict, gin.H{"error": "SSO identity is linked to another user"})
+			return
+		}
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to update user"})
 		return
 	}
+	if req.Password != nil {
+		recordAdminActivity(c, h.db, activity.Entry{
+			Name:   "Password was changed for user " + user.Username,
+			Type:   "UserPasswordChanged",
+			UserID: user.ID,
+		})
+	}
+	if req.DisplayName != nil || req.IsAdmin != nil || req.IsDisabled != nil || req.OIDCSubject != nil {
+		recordAdminActivity(c, h.db, activity.Entry{
+			Name:   "User " + user.Username + " has been updated",
+			Type:   "UserUpdated",
+			UserID: user.ID,
+		})
+	}
+	if user.IsDisabled {
+		if _, err := revokeUserSessions(c.Request.Context(), h.db, h.hub, user.ID); err != nil {
+			slog.Warn("failed to revoke sessions of disabled user", "user", user.Username, "error", err)
+		}
+	}
 
 	c.JSON(http.StatusOK, toUserResponse(user))
 }
 
+// UnlockUser handles POST /proxy/users/:id/unlock.
+// Lifts an account lockout before it expires and resets the failed login
+// count.
+func (h *ProxyUserHandler) UnlockUser(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid user ID"})
+		return
+	}
+
+	user, err := h.db.User.UpdateOneID(id).
+		SetFailedLoginCount(0).
+		ClearLockedUntil().
+		Save(c.Request.Context())
+	if err != nil {
+		if ent.IsNotFound(err) {
+			c.JSON(http.StatusNotFound, gin.H{"error": "user not found"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to unlock user"})
+		return
+	}
+	recordAdminActivity(c, h.db, activity.Entry{
+		Name:   "User " + user.Username + " has been unlocked",
+		Type:   "UserUnlocked",
+		UserID: user.ID,
+	})
+
+	c.JSON(http.StatusOK, toUserResponse(user))
+}
+
+// ── Access rules ──────────────────────────────────────────────────────────────
+
+// GetUserAccess handles GET /proxy/users/:id/access.
+// Returns the user's access rules; an unrestricted user gets an empty object.
+func (h *ProxyUserHandler) GetUserAccess(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid user ID"})
+		return
+	}
+
+	user, err := h.db.User.Get(c.Request.Context(), id)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			c.JSON(http.StatusNotFound, gin.H{"error": "user not found"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to get user"})
+		return
+	}
+
+	c.JSON(http.StatusOK, user.AccessRules)
+}
+
+// SetUserAccess handles PUT /proxy/users/:id/access.
+// Replaces the user's access rules; an empty object lifts every restriction.
+func (h *ProxyUserHandler) SetUserAccess(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid user ID"})
+		return
+	}
+
+	var rules access.Rules
+	if err := c.ShouldBindJSON(&rules); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	if err := rules.Validate(); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	user, err := h.db.User.UpdateOneID(id).
+		SetAccessRules(rules).
+		Save(c.Request.Context())
+	if err != nil {
+		if ent.IsNotFound(err) {
+			c.JSON(http.StatusNotFound, gin.H{"error": "user not found"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to update access rules"})
+		return
+	}
+	recordAdminActivity(c, h.db, activity.Entry{
+		Name:   "Access rules were changed for user " + user.Username,
+		Type:   "UserPolicyUpdated",
+		UserID: user.ID,
+	})
+
+	c.JSON(http.StatusOK, user.AccessRules)
+}
+
 // ── User backends ─────────────────────────────────────────────────────────────
 
 // userBackendResponse is the user-centric view of a single BackendUser mapping.
@@ -274,7 +469,23 @@ func (h *ProxyUserHandler) DeleteUser(c *gin.Context) {
 		return
 	}
 
-	err = h.db.User.DeleteOneID(id).Exec(c.Request.Context())
+	// Recovery codes, app passwords and sessions reference the user; remove
+	// them first.
+	user, err := h.db.User.Get(c.Request.Context(), id)
+	if err == nil {
+		err = resetTwoFactor(c.Request.Context(), h.db, id)
+	}
+	if err == nil {
+		_, err = h.db.AppPassword.Delete().
+			Where(entapppassword.HasUserWith(entuser.ID(id))).
+			Exec(c.Request.Context())
+	}
+	if err == nil {
+		_, err = revokeUserSessions(c.Request.Context(), h.db, h.hub, id)
+	}
+	if err == nil {
+		err = h.db.User.DeleteOne(user).Exec(c.Request.Context())
+	}
 	if err != nil {
 		if ent.IsNotFound(err) {
 			c.JSON(http.StatusNotFound, gin.H{"error": "user not found"})
@@ -283,6 +494,22 @@ func (h *ProxyUserHandler) DeleteUser(c *gin.Context) {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to delete user"})
 		return
 	}
+	// Stored preferences reference the user by ID only; remove them too.
+	ctx := c.Request.Context()
+	if _, err := h.db.DisplayPreference.Delete().Where(entdisplaypreference.UserID(id)).Exec(ctx); err != nil {
+		slog.Warn("failed to delete display preferences", "user_id", id, "error", err)
+	}
+	if _, err := h.db.UserConfiguration.Delete().Where(entuserconfiguration.UserID(id)).Exec(ctx); err != nil {
+		slog.Warn("failed to delete user configuration", "user_id", id, "error", err)
+	}
+	for _, fn := range h.onDeleted {
+		fn(id)
+	}
+	recordAdminActivity(c, h.db, activity.Entry{
+		Name:   "User " + user.Username + " has been deleted",
+		Type:   "UserDeleted",
+		UserID: user.ID,
+	})
 
 	c.Status(http.StatusNoContent)
 }
@@ -1,8 +1,10 @@
 package handler_test
 
 import (
+	"context"
 	"encoding/json"
 	"net/http"
+	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -12,6 +14,7 @@ import (
 	"github.com/ddevcap/jellyfin-proxy/api/handler"
 	"github.com/ddevcap/jellyfin-proxy/api/middleware"
 	"github.com/ddevcap/jellyfin-proxy/ent"
+	entactivitylog "github.com/ddevcap/jellyfin-proxy/ent/activitylog"
 )
 
 var _ = Describe("ProxyUserHandler", func() {
@@ -29,7 +32,10 @@ var _ = Describe("ProxyUserHandler", func() {
 		router.GET("/proxy/users", h.ListUsers)
 		router.GET("/proxy/users/:id", h.GetProxyUser)
 		router.GET("/proxy/users/:id/backends", h.GetUserBackends)
+		router.GET("/proxy/users/:id/access", h.GetUserAccess)
+		router.PUT("/proxy/users/:id/access", h.SetUserAccess)
 		router.PATCH("/proxy/users/:id", h.UpdateUser)
+		router.POST("/proxy/users/:id/unlock", h.UnlockUser)
 		router.DELETE("/proxy/users/:id", h.DeleteUser)
 	})
 
@@ -191,6 +197,61 @@ var _ = Describe("ProxyUserHandler", func() {
 			})
 		})
 
+		Context("updating is_disabled", func() {
+			It("disables the user and revokes their sessions", func() {
+				createSession(user, "henry-token")
+
+				w := doPatch(router, "/proxy/users/"+user.ID.String(),
+					map[string]interface{}{"is_disabled": true},
+				)
+
+				Expect(w.Code).To(Equal(http.StatusOK))
+				var resp map[string]interface{}
+				Expect(json.Unmarshal(w.Body.Bytes(), &resp)).To(Succeed())
+				Expect(resp["is_disabled"]).To(BeTrue())
+				Expect(db.Session.Query().CountX(context.Background())).To(BeZero())
+			})
+
+			It("refuses to disable an administrator", func() {
+				admin := createUser("root", "password1!", true)
+
+				w := doPatch(router, "/proxy/users/"+admin.ID.String(),
+					map[string]interface{}{"is_disabled": true},
+				)
+
+				Expect(w.Code).To(Equal(http.StatusBadRequest))
+				Expect(db.User.GetX(context.Background(), admin.ID).IsDisabled).To(BeFalse())
+			})
+		})
+
+		Context("updating oidc_subject", func() {
+			It("links and unlinks an SSO identity", func() {
+				w := doPatch(router, "/proxy/users/"+user.ID.String(),
+					map[string]interface{}{"oidc_subject": "kc-123"},
+				)
+				Expect(w.Code).To(Equal(http.StatusOK))
+				var resp map[string]interface{}
+				Expect(json.Unmarshal(w.Body.Bytes(), &resp)).To(Succeed())
+				Expect(resp["oidc_subject"]).To(Equal("kc-123"))
+
+				w = doPatch(router, "/proxy/users/"+user.ID.String(),
+					map[string]interface{}{"oidc_subject": ""},
+				)
+				Expect(w.Code).To(Equal(http.StatusOK))
+				Expect(db.User.GetX(context.Background(), user.ID).OidcSubject).To(BeNil())
+			})
+
+			It("returns 409 when another user holds the identity", func() {
+				other := createUser("ida", "password1!", false)
+				db.User.UpdateOne(other).SetOidcSubject("kc-123").ExecX(context.Background())
+
+				w := doPatch(router, "/proxy/users/"+user.ID.String(),
+					map[string]interface{}{"oidc_subject": "kc-123"},
+				)
+				Expect(w.Code).To(Equal(http.StatusConflict))
+			})
+		})
+
 		Context("when no fields are provided", func() {
 			It("returns 400", func() {
 				w := doPatch(router, "/proxy/users/"+user.ID.String(),
@@ -212,6 +273,37 @@ var _ = Describe("ProxyUserHandler", func() {
 		})
 	})
 
+	// ── UnlockUser ────────────────────────────────────────────────────────────
+
+	Describe("UnlockUser", func() {
+		It("lifts the lockout and resets the failed login count", func() {
+			user := createUser("ivy", "password1!", false)
+			db.User.UpdateOne(user).
+				SetFailedLoginCount(2).
+				SetLockedUntil(time.Now().Add(time.Hour)).
+				ExecX(context.Background())
+
+			var before map[string]interface{}
+			Expect(json.Unmarshal(doGet(router, "/proxy/users/"+user.ID.String()).Body.Bytes(), &before)).To(Succeed())
+			Expect(before).To(HaveKey("locked_until"))
+
+			w := doPost(router, "/proxy/users/"+user.ID.String()+"/unlock", nil)
+
+			Expect(w.Code).To(Equal(http.StatusOK))
+			var resp map[string]interface{}
+			Expect(json.Unmarshal(w.Body.Bytes(), &resp)).To(Succeed())
+			Expect(resp).NotTo(HaveKey("locked_until"))
+			Expect(resp["failed_login_count"]).To(BeNumerically("==", 0))
+			Expect(db.User.GetX(context.Background(), user.ID).LockedUntil).To(BeNil())
+			Expect(db.ActivityLog.Query().Where(entactivitylog.EventType("UserUnlocked")).ExistX(context.Background())).To(BeTrue())
+		})
+
+		It("returns 404 for an unknown user", func() {
+			w := doPost(router, "/proxy/users/00000000-0000-0000-0000-000000000001/unlock", nil)
+			Expect(w.Code).To(Equal(http.StatusNotFound))
+		})
+	})
+
 	// ── GetUserBackends ───────────────────────────────────────────────────────
 
 	Describe("GetUserBackends", func() {
@@ -274,6 +366,52 @@ var _ = Describe("ProxyUserHandler", func() {
 		})
 	})
 
+	// ── Access rules ──────────────────────────────────────────────────────────
+
+	Describe("Access rules", func() {
+		It("stores, returns and clears a user's rules", func() {
+			user := createUser("kid", "password1!", false)
+			path := "/proxy/users/" + user.ID.String() + "/access"
+
+			w := doGet(router, path)
+			Expect(w.Code).To(Equal(http.StatusOK))
+			Expect(w.Body.String()).To(MatchJSON(`{}`))
+
+			rules := `{"blocked_libraries":["a_adult"],"max_parental_rating":10,` +
+				`"blocked_tags":["Horror"],"schedules":[{"day_of_week":"Weekday","start_hour":16,"end_hour":19.5}]}`
+			w = doRequest(router, http.MethodPut, path, json.RawMessage(rules))
+			Expect(w.Code).To(Equal(http.StatusOK), w.Body.String())
+			Expect(w.Body.String()).To(MatchJSON(rules))
+
+			stored := db.User.GetX(context.Background(), user.ID).AccessRules
+			Expect(stored.BlockedLibraries).To(Equal([]string{"a_adult"}))
+			Expect(*stored.MaxParentalRating).To(Equal(10))
+			Expect(doGet(router, path).Body.String()).To(MatchJSON(rules))
+			Expect(db.ActivityLog.Query().Where(entactivitylog.EventType("UserPolicyUpdated")).ExistX(context.Background())).To(BeTrue())
+
+			w = doRequest(router, http.MethodPut, path, map[string]interface{}{})
+			Expect(w.Code).To(Equal(http.StatusOK))
+			Expect(db.User.GetX(context.Background(), user.ID).AccessRules.Restricted()).To(BeFalse())
+		})
+
+		It("rejects invalid rules", func() {
+			user := createUser("kid", "password1!", false)
+			path := "/proxy/users/" + user.ID.String() + "/access"
+
+			w := doRequest(router, http.MethodPut, path, map[string]interface{}{
+				"schedules": []map[string]interface{}{{"day_of_week": "Funday", "start_hour": 0, "end_hour": 24}},
+			})
+			Expect(w.Code).To(Equal(http.StatusBadRequest))
+			Expect(db.User.GetX(context.Background(), user.ID).AccessRules.Restricted()).To(BeFalse())
+		})
+
+		It("returns 404 for an unknown user", func() {
+			w := doRequest(router, http.MethodPut, "/proxy/users/00000000-0000-0000-0000-000000000001/access",
+				map[string]interface{}{"blocked_tags": []string{"Horror"}})
+			Expect(w.Code).To(Equal(http.StatusNotFound))
+		})
+	})
+
 	// ── DeleteUser ────────────────────────────────────────────────────────────
 
 	Describe("DeleteUser", func() {
@@ -0,0 +1,296 @@
+package handler
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/ddevcap/jellyfin-proxy/api/middleware"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/jellydator/ttlcache/v3"
+)
+
+// quickConnectTimeout is how long a QuickConnect code stays valid, matching
+// Jellyfin's own timeout.
+const quickConnectTimeout = 10 * time.Minute
+
+// quickConnectRequest is a pending QuickConnect login. The device that asks
+// to be logged in holds the secret; the user types the code into an
+// already logged-in client, which authorizes the request for their account.
+type quickConnectRequest struct {
+	Secret     string
+	Code       string
+	DeviceID   string
+	DeviceName string
+	AppName    string
+	AppVersion string
+	DateAdded  time.Time
+	UserID     uuid.UUID // uuid.Nil until authorized
+}
+
+func (r quickConnectRequest) result() gin.H {
+	return gin.H{
+		"Authenticated": r.UserID != uuid.Nil,
+		"Secret":        r.Secret,
+		"Code":          r.Code,
+		"DeviceId":      r.DeviceID,
+		"DeviceName":    r.DeviceName,
+		"AppName":       r.AppName,
+		"AppVersion":    r.AppVersion,
+		"DateAdded":     r.DateAdded,
+	}
+}
+
+// quickConnectMaxPerIP is how many QuickConnect requests one IP address may
+// start per quickConnectTimeout. Initiate needs no login, so without a limit
+// anyone could fill the store and exhaust the six-digit code space.
+const quickConnectMaxPerIP = 10
+
+// errQuickConnectLimit is returned by initiate when the caller's IP started
+// too many requests recently.
+var errQuickConnectLimit = errors.New("too many quick connect requests")
+
+// quickConnectStore holds the pending QuickConnect requests, keyed by
+// secret and indexed by code. Requests expire after quickConnectTimeout
+// whether or not they were used.
+type quickConnectStore struct {
+	mu       sync.Mutex
+	requests *ttlcache.Cache[string, quickConnectRequest]
+	codes    *ttlcache.Cache[string, string] // code → secret
+	perIP    *ttlcache.Cache[string, int]    // requests started per IP
+}
+
+func newQuickConnectStore() *quickConnectStore {
+	requests := ttlcache.New[string, quickConnectRequest](
+		ttlcache.WithTTL[string, quickConnectRequest](quickConnectTimeout),
+		ttlcache.WithDisableTouchOnHit[string, quickConnectRequest](),
+	)
+	codes := ttlcache.New[string, string](
+		ttlcache.WithTTL[string, string](quickConnectTimeout),
+		ttlcache.WithDisableTouchOnHit[string, string](),
+	)
+	perIP := ttlcache.New[string, int](
+		ttlcache.WithTTL[string, int](quickConnectTimeout),
+		ttlcache.WithDisableTouchOnHit[string, int](),
+	)
+	// Drop requests that were never completed, and stale counts.
+	go requests.Start()
+	go codes.Start()
+	go perIP.Start()
+	return &quickConnectStore{requests: requests, codes: codes, perIP: perIP}
+}
+
+// initiate starts a request for the given device, calling from ip, and
+// returns it. It returns errQuickConnectLimit once ip has started
+// quickConnectMaxPerIP requests within quickConnectTimeout.
+func (s *quickConnectStore) initiate(ip, deviceID, deviceName, appName, appVersion string) (quickConnectRequest, error) {
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		return quickConnectRequest{}, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	// The window starts with the IP's first request and is not extended by
+	// later ones.
+	if item := s.perIP.Get(ip); item == nil {
+		s.perIP.Set(ip, 1, ttlcache.DefaultTTL)
+	} else if item.Value() >= quickConnectMaxPerIP {
+		return quickConnectRequest{}, errQuickConnectLimit
+	} else if ttl := time.Until(item.ExpiresAt()); ttl > 0 {
+		s.perIP.Set(ip, item.Value()+1, ttl)
+	}
+
+	// Codes are short enough to type with a remote, so make sure no two
+	// pending requests share one.
+	var code string
+	for {
+		n, err := rand.Int(rand.Reader, big.NewInt(1_000_000))
+		if err != nil {
+			return quickConnectRequest{}, err
+		}
+		code = fmt.Sprintf("%06d", n.Int64())
+		if _, taken := s.byCode(code); !taken {
+			break
+		}
+	}
+
+	r := quickConnectRequest{
+		Secret:     hex.EncodeToString(secret),
+		Code:       code,
+		DeviceID:   deviceID,
+		DeviceName: deviceName,
+		AppName:    appName,
+		AppVersion: appVersion,
+		DateAdded:  time.Now().UTC(),
+	}
+	s.requests.Set(r.Secret, r, ttlcache.DefaultTTL)
+	s.codes.Set(r.Code, r.Secret, ttlcache.DefaultTTL)
+	return r, nil
+}
+
+// get returns the request for secret, if it is still pending.
+func (s *quickConnectStore) get(secret string) (quickConnectRequest, bool) {
+	item := s.requests.Get(secret)
+	if item == nil {
+		return quickConnectRequest{}, false
+	}
+	return item.Value(), true
+}
+
+// authorize logs the request with the given code in as userID. It returns
+// false when no pending request has that code.
+func (s *quickConnectStore) authorize(code string, userID uuid.UUID) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	item, ok := s.byCode(code)
+	if !ok {
+		return false
+	}
+	r := item.Value()
+	r.UserID = userID
+	if ttl := time.Until(item.ExpiresAt()); ttl > 0 {
+		s.requests.Set(r.Secret, r, ttl) // authorizing does not extend the code
+	}
+	return true
+}
+
+// redeem removes an authorized request and returns the user it was
+// authorized for. A secret can only be redeemed once.
+func (s *quickConnectStore) redeem(secret string) (uuid.UUID, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	item := s.requests.Get(secret)
+	if item == nil || item.Value().UserID == uuid.Nil {
+		return uuid.Nil, false
+	}
+	s.requests.Delete(secret)
+	s.codes.Delete(item.Value().Code)
+	return item.Value().UserID, true
+}
+
+// byCode finds a pending request by code. The caller must hold s.mu.
+func (s *quickConnectStore) byCode(code string) (*ttlcache.Item[string, quickConnectRequest], bool) {
+	secret := s.codes.Get(code)
+	if secret == nil {
+		return nil, false
+	}
+	item := s.requests.Get(secret.Value())
+	if item == nil {
+		return nil, false
+	}
+	return item, true
+}
+
+// quickConnectEnabled writes the error Jellyfin returns while QuickConnect
+// is switched off and reports whether the request may proceed.
+func (h *AuthHandler) quickConnectEnabled(c *gin.Context) bool {
+	if !h.cfg.QuickConnectEnabled {
+		c.JSON(http.StatusForbidden, gin.H{"error": "Quick connect is disabled"})
+		return false
+	}
+	return true
+}
+
+// QuickConnectInitiate handles POST /QuickConnect/Initiate.
+// Starts a QuickConnect request for the calling device and returns the code
+// to show the user and the secret the device polls with.
+func (h *AuthHandler) QuickConnectInitiate(c *gin.Context) {
+	if !h.quickConnectEnabled(c) {
+		return
+	}
+	client := clientFromAuth(c)
+	r, err := h.quickConnect.initiate(middleware.ClientIP(c),
+		client.DeviceID, client.DeviceName, client.AppName, client.AppVersion)
+	if errors.Is(err, errQuickConnectLimit) {
+		c.JSON(http.StatusTooManyRequests, gin.H{"error": "Too many quick connect requests. Please try again later."})
+		return
+	}
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to start quick connect"})
+		return
+	}
+	c.JSON(http.StatusOK, r.result())
+}
+
+// QuickConnectConnect handles GET /QuickConnect/Connect.
+// Polled by the initiating device with its secret until Authenticated is true.
+func (h *AuthHandler) QuickConnectConnect(c *gin.Context) {
+	if !h.quickConnectEnabled(c) {
+		return
+	}
+	r, ok := h.quickConnect.get(queryParam(c, "secret"))
+	if !ok {
+		c.JSON(http.StatusNotFound, gin.H{"error": "Unknown secret"})
+		return
+	}
+	c.JSON(http.StatusOK, r.result())
+}
+
+// QuickConnectAuthorize handles POST /QuickConnect/Authorize.
+// Authorizes the pending request with the given code for the caller, or for
+// userId when the caller is an admin.
+func (h *AuthHandler) QuickConnectAuthorize(c *gin.Context) {
+	if !h.quickConnectEnabled(c) {
+		return
+	}
+	caller := userFromCtx(c)
+	userID := caller.ID
+	if s := queryParam(c, "userId"); s != "" {
+		id, err := uuid.Parse(s)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid userId"})
+			return
+		}
+		if id != caller.ID && !caller.IsAdmin {
+			c.JSON(http.StatusForbidden, gin.H{"error": "cannot authorize quick connect for another user"})
+			return
+		}
+		userID = id
+	}
+
+	if !h.quickConnect.authorize(queryParam(c, "code"), userID) {
+		c.JSON(http.StatusNotFound, gin.H{"error": "Unknown quick connect code"})
+		return
+	}
+	c.JSON(http.StatusOK, true)
+}
+
+type quickConnectAuthRequest struct {
+	Secret string `json:"Secret" binding:"required"`
+}
+
+// AuthenticateWithQuickConnect handles POST /Users/AuthenticateWithQuickConnect.
+// Exchanges the secret of an authorized request for a session, exactly like
+// a password login.
+func (h *AuthHandler) AuthenticateWithQuickConnect(c *gin.Context) {
+	if !h.quickConnectEnabled(c) {
+		return
+	}
+	var req quickConnectAuthRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	ip := middleware.ClientIP(c)
+	userID, ok := h.quickConnect.redeem(req.Secret)
+	if !ok {
+		h.onLoginFail(ip)
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Quick connect request is unknown or not yet authorized"})
+		return
+	}
+
+	user, err := h.db.User.Get(c.Request.Context(), userID)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Quick connect user no longer exists"})
+		return
+	}
+	h.completeLogin(c, user, ip, nil)
+}
@@ -0,0 +1,127 @@
+package handler_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/ddevcap/jellyfin-proxy/api/handler"
+	"github.com/ddevcap/jellyfin-proxy/api/middleware"
+	"github.com/ddevcap/jellyfin-proxy/config"
+	"github.com/ddevcap/jellyfin-proxy/ent"
+	entsession "github.com/ddevcap/jellyfin-proxy/ent/session"
+)
+
+var _ = Describe("QuickConnect", func() {
+	var (
+		router *gin.Engine
+		alice  *ent.User
+	)
+
+	tv := map[string]string{
+		"Authorization": `MediaBrowser Client="Jellyfin Android TV", Device="Living Room TV", DeviceId="tv-1", Version="0.17.0"`,
+	}
+	phone := map[string]string{"X-Emby-Token": "quickconnect-phone-token"}
+
+	setup := func(enabled bool) {
+		cfg := config.Config{ServerID: "test-server-id", QuickConnectEnabled: enabled}
+		h := handler.NewAuthHandler(db, cfg, func(string) {}, func(string) {})
+		router = gin.New()
+		router.POST("/QuickConnect/Initiate", h.QuickConnectInitiate)
+		router.GET("/QuickConnect/Connect", h.QuickConnectConnect)
+		router.POST("/Users/AuthenticateWithQuickConnect", h.AuthenticateWithQuickConnect)
+		auth := router.Group("/")
+		auth.Use(middleware.Auth(db, cfg))
+		auth.POST("/QuickConnect/Authorize", h.QuickConnectAuthorize)
+	}
+
+	initiate := func() (secret, code string) {
+		w := doPost(router, "/QuickConnect/Initiate", nil, tv)
+		Expect(w.Code).To(Equal(http.StatusOK))
+		var resp struct {
+			Secret        string
+			Code          string
+			DeviceName    string
+			Authenticated bool
+		}
+		Expect(json.Unmarshal(w.Body.Bytes(), &resp)).To(Succeed())
+		Expect(resp.Code).To(HaveLen(6))
+		Expect(resp.DeviceName).To(Equal("Living Room TV"))
+		Expect(resp.Authenticated).To(BeFalse())
+		return resp.Secret, resp.Code
+	}
+
+	BeforeEach(func() {
+		cleanDB()
+		alice = createUser("alice", "password1!", false)
+		createSession(alice, "quickconnect-phone-token")
+		setup(true)
+	})
+
+	It("logs the device in once the code is authorized", func() {
+		secret, code := initiate()
+
+		w := doPost(router, "/Users/AuthenticateWithQuickConnect", map[string]string{"Secret": secret}, tv)
+		Expect(w.Code).To(Equal(http.StatusUnauthorized))
+
+		w = doPost(router, "/QuickConnect/Authorize?code="+code, nil, phone)
+		Expect(w.Code).To(Equal(http.StatusOK))
+
+		w = doGet(router, "/QuickConnect/Connect?secret="+secret)
+		Expect(w.Code).To(Equal(http.StatusOK))
+		var state map[string]interface{}
+		Expect(json.Unmarshal(w.Body.Bytes(), &state)).To(Succeed())
+		Expect(state["Authenticated"]).To(BeTrue())
+
+		w = doPost(router, "/Users/AuthenticateWithQuickConnect", map[string]string{"Secret": secret}, tv)
+		Expect(w.Code).To(Equal(http.StatusOK))
+		var resp map[string]interface{}
+		Expect(json.Unmarshal(w.Body.Bytes(), &resp)).To(Succeed())
+		Expect(resp["AccessToken"]).NotTo(BeEmpty())
+
+		s := db.Session.Query().Where(entsession.DeviceID("tv-1")).OnlyX(context.Background())
+		Expect(s.DeviceName).To(Equal("Living Room TV"))
+		Expect(s.QueryUser().OnlyIDX(context.Background())).To(Equal(alice.ID))
+
+		// The secret is single-use.
+		w = doPost(router, "/Users/AuthenticateWithQuickConnect", map[string]string{"Secret": secret}, tv)
+		Expect(w.Code).To(Equal(http.StatusUnauthorized))
+	})
+
+	It("rejects unknown codes and secrets", func() {
+		initiate()
+
+		w := doPost(router, "/QuickConnect/Authorize?code=not-a-code", nil, phone)
+		Expect(w.Code).To(Equal(http.StatusNotFound))
+		w = doGet(router, "/QuickConnect/Connect?secret=nope")
+		Expect(w.Code).To(Equal(http.StatusNotFound))
+	})
+
+	It("does not let a non-admin authorize for someone else", func() {
+		bob := createUser("bob", "password1!", false)
+		_, code := initiate()
+
+		w := doPost(router, "/QuickConnect/Authorize?code="+code+"&userId="+bob.ID.String(), nil, phone)
+		Expect(w.Code).To(Equal(http.StatusForbidden))
+	})
+
+	It("limits how many requests one address can start", func() {
+		for i := 0; i < 10; i++ {
+			initiate()
+		}
+		w := doPost(router, "/QuickConnect/Initiate", nil, tv)
+		Expect(w.Code).To(Equal(http.StatusTooManyRequests))
+	})
+
+	It("is refused while the flag is off", func() {
+		setup(false)
+
+		w := doPost(router, "/QuickConnect/Initiate", nil, tv)
+		Expect(w.Code).To(Equal(http.StatusForbidden))
+	})
+})
@@ -0,0 +1,418 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ddevcap/jellyfin-proxy/config"
+	"github.com/ddevcap/jellyfin-proxy/ent"
+	entsession "github.com/ddevcap/jellyfin-proxy/ent/session"
+	entuser "github.com/ddevcap/jellyfin-proxy/ent/user"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// sessionActiveWindow is how recently a session must have made a request to
+// be listed as active, mirroring Jellyfin's own threshold.
+const sessionActiveWindow = 10 * time.Minute
+
+// playstateCommands are the commands of POST /Sessions/{id}/Playing/{command},
+// keyed by their lower-cased name.
+var playstateCommands = canonicalNames(
+	"Stop", "Pause", "Unpause", "NextTrack", "PreviousTrack", "Seek",
+	"Rewind", "FastForward", "PlayPause",
+)
+
+// generalCommands are Jellyfin's GeneralCommandType values, keyed by their
+// lower-cased name.
+var generalCommands = canonicalNames(
+	"MoveUp", "MoveDown", "MoveLeft", "MoveRight", "PageUp", "PageDown",
+	"PreviousLetter", "NextLetter", "ToggleOsd", "ToggleContextMenu", "Select",
+	"Back", "TakeScreenshot", "SendKey", "SendString", "GoHome", "GoToSettings",
+	"VolumeUp", "VolumeDown", "Mute", "Unmute", "ToggleMute", "SetVolume",
+	"SetAudioStreamIndex", "SetSubtitleStreamIndex", "ToggleFullscreen",
+	"DisplayContent", "GoToSearch", "DisplayMessage", "SetRepeatMode",
+	"ChannelUp", "ChannelDown", "Guide", "ToggleStats", "PlayMediaSource",
+	"PlayTrailers", "SetShuffleQueue", "PlayState", "PlayNext", "ToggleOsdMenu",
+	"Play", "SetMaxStreamingBitrate", "SetPlaybackOrder",
+)
+
+// canonicalNames maps each name's lower-cased form to the name itself. The
+// router lower-cases paths, so commands taken from the path need it.
+func canonicalNames(names ...string) map[string]string {
+	m := make(map[string]string, len(names))
+	for _, n := range names {
+		m[strings.ToLower(n)] = n
+	}
+	return m
+}
+
+// sessionCapabilities is what a client reported via /Sessions/Capabilities.
+type sessionCapabilities struct {
+	PlayableMediaTypes   []string `json:"PlayableMediaTypes"`
+	SupportedCommands    []string `json:"SupportedCommands"`
+	SupportsMediaControl bool     `json:"SupportsMediaControl"`
+}
+
+// SessionHandler serves the proxy's own client sessions and lets clients
+// remote-control each other. Commands are pushed over the target device's
+// WebSocket, so two devices can control each other even when their items
+// come from different backends. What a session is playing comes from the
+// playback registry.
+type SessionHandler struct {
+	db      *ent.Client
+	cfg     config.Config
+	hub     *WSHub
+	playing *PlaybackRegistry
+
+	mu   sync.Mutex
+	caps map[uuid.UUID]sessionCapabilities // by session ID
+}
+
+func NewSessionHandler(db *ent.Client, cfg config.Config, hub *WSHub, playing *PlaybackRegistry) *SessionHandler {
+	h := &SessionHandler{
+		db:      db,
+		cfg:     cfg,
+		hub:     hub,
+		playing: playing,
+		caps:    make(map[uuid.UUID]sessionCapabilities),
+	}
+	if hub != nil {
+		// Every session removal goes through the hub, whichever handler
+		// makes it, so capabilities are dropped with their session.
+		hub.OnSessionsClosed(h.forgetSessions)
+	}
+	return h
+}
+
+// forgetSessions drops the capabilities reported by the given sessions.
+func (h *SessionHandler) forgetSessions(ids ...uuid.UUID) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	for _, id := range ids {
+		delete(h.caps, id)
+	}
+}
+
+// GetSessions handles GET /Sessions.
+// Lists the proxy sessions that were active recently or have an open
+// WebSocket, in Jellyfin SessionInfo format. Admins see every user's
+// sessions, other users only their own. ControllableByUserId limits the list
+// to remote-controllable sessions other than the caller's device, DeviceId
+// to one device and ActiveWithinSeconds changes the activity window.
+func (h *SessionHandler) GetSessions(c *gin.Context) {
+	user := userFromCtx(c)
+	window := sessionActiveWindow
+	if s := queryParam(c, "activewithinseconds"); s != "" {
+		if v, err := strconv.Atoi(s); err == nil && v > 0 {
+			window = time.Duration(v) * time.Second
+		}
+	}
+	controllable := queryParam(c, "controllablebyuserid") != ""
+	currentDevice := ""
+	if s := sessionFromCtx(c); s != nil {
+		currentDevice = s.DeviceID
+	}
+
+	q := h.db.Session.Query().WithUser().Order(ent.Desc(entsession.FieldLastActivity))
+	if !user.IsAdmin {
+		q.Where(entsession.HasUserWith(entuser.ID(user.ID)))
+	}
+	if deviceID := queryParam(c, "deviceid"); deviceID != "" {
+		q.Where(entsession.DeviceID(deviceID))
+	}
+	sessions, err := q.All(c.Request.Context())
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to list sessions"})
+		return
+	}
+
+	// A device that logged in several times is listed once: by the session
+	// its WebSocket was opened with, or else by its most recently used one.
+	// Remote control is addressed to that session.
+	connected := make(map[uuid.UUID]bool, len(sessions))
+	listed := make(map[string]*ent.Session)
+	var keys []string
+	for _, s := range sessions {
+		connected[s.ID] = h.hub.Connected(s.Edges.User.ID, s.ID)
+		key := s.Edges.User.ID.String() + "|" + s.DeviceID
+		prev, ok := listed[key]
+		if !ok {
+			keys = append(keys, key)
+		}
+		if !ok || (!connected[prev.ID] && connected[s.ID]) {
+			listed[key] = s
+		}
+	}
+
+	out := []gin.H{}
+	for _, key := range keys {
+		s := listed[key]
+		active := time.Since(s.LastActivity) < window
+		if !connected[s.ID] && !active {
+			continue
+		}
+		if controllable && (!connected[s.ID] || s.DeviceID == currentDevice) {
+			continue
+		}
+		out = append(out, h.sessionInfo(s, connected[s.ID], active))
+	}
+	c.JSON(http.StatusOK, out)
+}
+
+// sessionInfo builds the Jellyfin SessionInfo object for a session.
+func (h *SessionHandler) sessionInfo(s *ent.Session, connected, active bool) gin.H {
+	h.mu.Lock()
+	caps := h.caps[s.ID]
+	h.mu.Unlock()
+	if caps.PlayableMediaTypes == nil {
+		caps.PlayableMediaTypes = []string{}
+	}
+	if caps.SupportedCommands == nil {
+		caps.SupportedCommands = []string{}
+	}
+	u := s.Edges.User
+	info := gin.H{
+		"Id":                    s.ID.String(),
+		"UserId":                u.ID,
+		"UserName":              u.Username,
+		"Client":                s.AppName,
+		"DeviceId":              s.DeviceID,
+		"DeviceName":            s.DeviceName,
+		"ApplicationVersion":    s.AppVersion,
+		"LastActivityDate":      s.LastActivity,
+		"IsActive":              active,
+		"SupportsRemoteControl": connected,
+		"SupportsMediaControl":  connected && caps.SupportsMediaControl,
+		"PlayableMediaTypes":    caps.PlayableMediaTypes,
+		"SupportedCommands":     caps.SupportedCommands,
+		"Capabilities": gin.H{
+			"PlayableMediaTypes":           caps.PlayableMediaTypes,
+			"SupportedCommands":            caps.SupportedCommands,
+			"SupportsMediaControl":         caps.SupportsMediaControl,
+			"SupportsPersistentIdentifier": true,
+		},
+		"PlayState": gin.H{
+			"CanSeek":       false,
+			"IsPaused":      false,
+			"IsMuted":       false,
+			"RepeatMode":    "RepeatNone",
+			"PlaybackOrder": "Default",
+		},
+		"AdditionalUsers":     []interface{}{},
+		"NowPlayingQueue":     []interface{}{},
+		"HasCustomDeviceName": false,
+		"ServerId":            h.cfg.ServerID,
+	}
+	if p, ok := h.playing.get(u.ID, s.DeviceID); ok {
+		info["NowPlayingItem"] = h.nowPlayingItem(p)
+		if len(p.transcodingInfo) > 0 {
+			info["TranscodingInfo"] = p.transcodingInfo
+		}
+		info["PlayState"] = gin.H{
+			"PositionTicks":       p.PositionTicks,
+			"CanSeek":             p.RunTimeTicks > 0,
+			"IsPaused":            p.IsPaused,
+			"IsMuted":             p.IsMuted,
+			"AudioStreamIndex":    p.AudioStreamIndex,
+			"SubtitleStreamIndex": p.SubtitleStreamIndex,
+			"MediaSourceId":       p.MediaSourceID,
+			"PlayMethod":          nilIfEmpty(p.PlayMethod),
+			"PlaySessionId":       p.PlaySessionID,
+			"RepeatMode":          "RepeatNone",
+			"PlaybackOrder":       "Default",
+		}
+	}
+	return info
+}
+
+// nowPlayingItem returns the item of a playback in BaseItemDto form: the
+// backend's own copy when it has been fetched, else the few fields known
+// from the client's reports.
+func (h *SessionHandler) nowPlayingItem(p activePlayback) interface{} {
+	if len(p.nowPlayingItem) > 0 {
+		return p.nowPlayingItem
+	}
+	return gin.H{"Id": p.ItemID, "ServerId": h.cfg.ServerID}
+}
+
+// ActivePlayback handles GET /proxy/sessions/active.
+// Lists every stream currently playing through the proxy, across all users
+// and backends, with its position, play method and transcoding details.
+func (h *SessionHandler) ActivePlayback(c *gin.Context) {
+	c.JSON(http.StatusOK, h.playing.list())
+}
+
+// PostCapabilitiesFull handles POST /Sessions/Capabilities/Full.
+// Clients call this after login to advertise what they can play and which
+// remote commands they accept; GetSessions reports it back to controllers.
+func (h *SessionHandler) PostCapabilitiesFull(c *gin.Context) {
+	var caps sessionCapabilities
+	if err := c.ShouldBindJSON(&caps); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid request body"})
+		return
+	}
+	h.setCapabilities(c, caps)
+	c.Status(http.StatusNoContent)
+}
+
+// PostCapabilities handles POST /Sessions/Capabilities, the query-param
+// variant of PostCapabilitiesFull.
+func (h *SessionHandler) PostCapabilities(c *gin.Context) {
+	caps := sessionCapabilities{
+		PlayableMediaTypes:   splitCSV(queryParam(c, "playablemediatypes")),
+		SupportedCommands:    splitCSV(queryParam(c, "supportedcommands")),
+		SupportsMediaControl: strings.EqualFold(queryParam(c, "supportsmediacontrol"), "true"),
+	}
+	h.setCapabilities(c, caps)
+	c.Status(http.StatusNoContent)
+}
+
+func (h *SessionHandler) setCapabilities(c *gin.Context, caps sessionCapabilities) {
+	s := sessionFromCtx(c)
+	if s == nil {
+		return
+	}
+	h.mu.Lock()
+	h.caps[s.ID] = caps
+	h.mu.Unlock()
+}
+
+// Play handles POST /Sessions/{sessionId}/Playing.
+// Tells the target client to play the items in ItemIds. The IDs are proxy
+// IDs, so the target plays them through the proxy like any other item.
+func (h *SessionHandler) Play(c *gin.Context) {
+	itemIDs := splitCSV(queryParam(c, "itemids"))
+	if len(itemIDs) == 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "ItemIds is required"})
+		return
+	}
+	data := gin.H{
+		"ItemIds":           itemIDs,
+		"PlayCommand":       fallback(queryParam(c, "playcommand"), "PlayNow"),
+		"ControllingUserId": userFromCtx(c).ID,
+	}
+	for _, p := range []string{"StartPositionTicks", "AudioStreamIndex", "SubtitleStreamIndex", "StartIndex"} {
+		if v, err := strconv.ParseInt(queryParam(c, p), 10, 64); err == nil {
+			data[p] = v
+		}
+	}
+	if v := queryParam(c, "mediasourceid"); v != "" {
+		data["MediaSourceId"] = v
+	}
+	h.sendToSession(c, "Play", data)
+}
+
+// Playstate handles POST /Sessions/{sessionId}/Playing/{command}, e.g. Pause
+// or Seek with SeekPositionTicks.
+func (h *SessionHandler) Playstate(c *gin.Context) {
+	command, ok := playstateCommands[strings.ToLower(c.Param("command"))]
+	if !ok {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "unknown playstate command"})
+		return
+	}
+	data := gin.H{
+		"Command":           command,
+		"ControllingUserId": userFromCtx(c).ID,
+	}
+	if v, err := strconv.ParseInt(queryParam(c, "seekpositionticks"), 10, 64); err == nil {
+		data["SeekPositionTicks"] = v
+	}
+	h.sendToSession(c, "Playstate", data)
+}
+
+// GeneralCommand handles POST /Sessions/{sessionId}/Command with a
+// GeneralCommand body.
+func (h *SessionHandler) GeneralCommand(c *gin.Context) {
+	var body struct {
+		Name      string            `json:"Name"`
+		Arguments map[string]string `json:"Arguments"`
+	}
+	if err := c.ShouldBindJSON(&body); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid request body"})
+		return
+	}
+	h.sendGeneralCommand(c, body.Name, body.Arguments)
+}
+
+// NamedCommand handles POST /Sessions/{sessionId}/Command/{command}, a
+// GeneralCommand without arguments.
+func (h *SessionHandler) NamedCommand(c *gin.Context) {
+	h.sendGeneralCommand(c, c.Param("command"), nil)
+}
+
+// DisplayMessage handles POST /Sessions/{sessionId}/Message.
+func (h *SessionHandler) DisplayMessage(c *gin.Context) {
+	var body struct {
+		Header    string `json:"Header"`
+		Text      string `json:"Text"`
+		TimeoutMs *int64 `json:"TimeoutMs"`
+	}
+	if err := c.ShouldBindJSON(&body); err != nil || body.Text == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Text is required"})
+		return
+	}
+	args := map[string]string{"Header": body.Header, "Text": body.Text}
+	if body.TimeoutMs != nil {
+		args["TimeoutMs"] = strconv.FormatInt(*body.TimeoutMs, 10)
+	}
+	h.sendGeneralCommand(c, "DisplayMessage", args)
+}
+
+func (h *SessionHandler) sendGeneralCommand(c *gin.Context, name string, args map[string]string) {
+	command, ok := generalCommands[strings.ToLower(name)]
+	if !ok {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "unknown command"})
+		return
+	}
+	if args == nil {
+		args = map[string]string{}
+	}
+	h.sendToSession(c, "GeneralCommand", gin.H{
+		"Name":              command,
+		"Arguments":         args,
+		"ControllingUserId": userFromCtx(c).ID,
+	})
+}
+
+// sendToSession pushes a WebSocket message to the device of the session in
+// the sessionId path param. Only admins may control other users' sessions.
+func (h *SessionHandler) sendToSession(c *gin.Context, messageType string, data gin.H) {
+	id, err := uuid.Parse(c.Param("sessionId"))
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "session not found"})
+		return
+	}
+	target, err := h.db.Session.Query().
+		Where(entsession.ID(id)).
+		WithUser().
+		Only(c.Request.Context())
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "session not found"})
+		return
+	}
+	user := userFromCtx(c)
+	if !user.IsAdmin && target.Edges.User.ID != user.ID {
+		c.JSON(http.StatusForbidden, gin.H{"error": "not allowed to control this session"})
+		return
+	}
+
+	msg, err := json.Marshal(gin.H{
+		"MessageType": messageType,
+		"MessageId":   strings.ReplaceAll(uuid.NewString(), "-", ""),
+		"Data":        data,
+	})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to encode message"})
+		return
+	}
+	if !h.hub.SendToSession(target.Edges.User.ID, target.ID, msg) {
+		c.JSON(http.StatusNotFound, gin.H{"error": "session is not connected"})
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
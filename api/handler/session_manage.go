@@ -0,0 +1,286 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/ddevcap/jellyfin-proxy/activity"
+	"github.com/ddevcap/jellyfin-proxy/ent"
+	entsession "github.com/ddevcap/jellyfin-proxy/ent/session"
+	entuser "github.com/ddevcap/jellyfin-proxy/ent/user"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// revokeSessions deletes sessions and closes the WebSockets opened with them,
+// so revoked devices are cut off at once instead of on their next request.
+// hub may be nil.
+func revokeSessions(ctx context.Context, db *ent.Client, hub *WSHub, sessions []*ent.Session) (int, error) {
+	if len(sessions) == 0 {
+		return 0, nil
+	}
+	ids := make([]uuid.UUID, len(sessions))
+	for i, s := range sessions {
+		ids[i] = s.ID
+	}
+	n, err := db.Session.Delete().Where(entsession.IDIn(ids...)).Exec(ctx)
+	if err != nil {
+		return 0, err
+	}
+	if hub != nil {
+		hub.CloseSessions(ids...)
+	}
+	return n, nil
+}
+
+// revokeUserSessions revokes every session of the user with userID but the
+// ones listed in except.
+func revokeUserSessions(ctx context.Context, db *ent.Client, hub *WSHub, userID uuid.UUID, except ...uuid.UUID) (int, error) {
+	sessions, err := db.Session.Query().
+		Where(entsession.HasUserWith(entuser.ID(userID)), entsession.IDNotIn(except...)).
+		All(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return revokeSessions(ctx, db, hub, sessions)
+}
+
+// proxySessionResponse is the admin API representation of a login session.
+type proxySessionResponse struct {
+	ID           uuid.UUID `json:"id"`
+	UserID       uuid.UUID `json:"user_id"`
+	Username     string    `json:"username"`
+	DeviceID     string    `json:"device_id"`
+	DeviceName   string    `json:"device_name"`
+	AppName      string    `json:"app_name"`
+	AppVersion   string    `json:"app_version"`
+	LastActivity time.Time `json:"last_activity"`
+	CreatedAt    time.Time `json:"created_at"`
+	// Connected reports an open WebSocket; Current marks the caller's own
+	// session.
+	Connected bool `json:"connected"`
+	Current   bool `json:"current"`
+}
+
+func (h *SessionHandler) toProxySessionResponse(c *gin.Context, s *ent.Session) proxySessionResponse {
+	r := proxySessionResponse{
+		ID:           s.ID,
+		DeviceID:     s.DeviceID,
+		DeviceName:   s.DeviceName,
+		AppName:      s.AppName,
+		AppVersion:   s.AppVersion,
+		LastActivity: s.LastActivity,
+		CreatedAt:    s.CreatedAt,
+	}
+	if u := s.Edges.User; u != nil {
+		r.UserID = u.ID
+		r.Username = u.Username
+		r.Connected = h.hub.Connected(u.ID, s.ID)
+	}
+	if cur := sessionFromCtx(c); cur != nil {
+		r.Current = cur.ID == s.ID
+	}
+	return r
+}
+
+// sessionQuery returns the sessions matching the device_id and app query
+// params, with their users loaded.
+func (h *SessionHandler) sessionQuery(c *gin.Context) *ent.SessionQuery {
+	q := h.db.Session.Query().WithUser().Order(ent.Desc(entsession.FieldLastActivity))
+	if deviceID := c.Query("device_id"); deviceID != "" {
+		q.Where(entsession.DeviceID(deviceID))
+	}
+	if app := c.Query("app"); app != "" {
+		q.Where(entsession.AppName(app))
+	}
+	return q
+}
+
+// userIDQuery parses the user_id query param. ok is false after a 400 was
+// written for an invalid value.
+func userIDQuery(c *gin.Context) (id uuid.UUID, ok bool) {
+	s := c.Query("user_id")
+	if s == "" {
+		return uuid.Nil, true
+	}
+	id, err := uuid.Parse(s)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid user ID"})
+		return uuid.Nil, false
+	}
+	return id, true
+}
+
+// revoke revokes sessions and records it.
+func (h *SessionHandler) revoke(c *gin.Context, sessions []*ent.Session, byAdmin bool) (int, error) {
+	n, err := revokeSessions(c.Request.Context(), h.db, h.hub, sessions)
+	if err != nil {
+		return 0, err
+	}
+
+	for _, s := range sessions {
+		u := s.Edges.User
+		e := activity.Entry{
+			Name:          u.Username + " was signed out of " + s.DeviceName,
+			Type:          "SessionRevoked",
+			ShortOverview: s.AppName,
+			UserID:        u.ID,
+		}
+		if byAdmin {
+			recordAdminActivity(c, h.db, e)
+		} else {
+			activity.Record(c.Request.Context(), h.db, e)
+		}
+	}
+	return n, nil
+}
+
+func (h *SessionHandler) writeSessions(c *gin.Context, sessions []*ent.Session) {
+	resp := make([]proxySessionResponse, len(sessions))
+	for i, s := range sessions {
+		resp[i] = h.toProxySessionResponse(c, s)
+	}
+	c.JSON(http.StatusOK, resp)
+}
+
+// ── Admin API ─────────────────────────────────────────────────────────────────
+
+// ListSessions handles GET /proxy/sessions.
+// Lists every login session, most recently used first, optionally filtered
+// by user_id, device_id and app.
+func (h *SessionHandler) ListSessions(c *gin.Context) {
+	userID, ok := userIDQuery(c)
+	if !ok {
+		return
+	}
+	q := h.sessionQuery(c)
+	if userID != uuid.Nil {
+		q.Where(entsession.HasUserWith(entuser.ID(userID)))
+	}
+	sessions, err := q.All(c.Request.Context())
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to list sessions"})
+		return
+	}
+	h.writeSessions(c, sessions)
+}
+
+// RevokeSession handles DELETE /proxy/sessions/:id.
+func (h *SessionHandler) RevokeSession(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid session ID"})
+		return
+	}
+	s, err := h.db.Session.Query().
+		Where(entsession.ID(id)).
+		WithUser().
+		Only(c.Request.Context())
+	if err != nil {
+		if ent.IsNotFound(err) {
+			c.JSON(http.StatusNotFound, gin.H{"error": "session not found"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to get session"})
+		return
+	}
+	if _, err := h.revoke(c, []*ent.Session{s}, true); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to revoke session"})
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
+
+// RevokeSessions handles DELETE /proxy/sessions?user_id=…&device_id=….
+// Revokes every session of a user, of a device, or both combined; app
+// narrows it further. At least user_id or device_id is required so a bare
+// DELETE cannot sign everybody out.
+func (h *SessionHandler) RevokeSessions(c *gin.Context) {
+	userID, ok := userIDQuery(c)
+	if !ok {
+		return
+	}
+	if userID == uuid.Nil && c.Query("device_id") == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "user_id or device_id is required"})
+		return
+	}
+	q := h.sessionQuery(c)
+	if userID != uuid.Nil {
+		q.Where(entsession.HasUserWith(entuser.ID(userID)))
+	}
+	sessions, err := q.All(c.Request.Context())
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to list sessions"})
+		return
+	}
+	n, err := h.revoke(c, sessions, true)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to revoke sessions"})
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"revoked": n})
+}
+
+// ── Self-service ──────────────────────────────────────────────────────────────
+
+// ListMySessions handles GET /proxy/me/sessions.
+// Lists the caller's own sessions, filtered like ListSessions.
+func (h *SessionHandler) ListMySessions(c *gin.Context) {
+	sessions, err := h.sessionQuery(c).
+		Where(entsession.HasUserWith(entuser.ID(userFromCtx(c).ID))).
+		All(c.Request.Context())
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to list sessions"})
+		return
+	}
+	h.writeSessions(c, sessions)
+}
+
+// RevokeMySession handles DELETE /proxy/me/sessions/:id.
+// Sessions of other users are reported as not found.
+func (h *SessionHandler) RevokeMySession(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid session ID"})
+		return
+	}
+	s, err := h.db.Session.Query().
+		Where(entsession.ID(id), entsession.HasUserWith(entuser.ID(userFromCtx(c).ID))).
+		WithUser().
+		Only(c.Request.Context())
+	if err != nil {
+		if ent.IsNotFound(err) {
+			c.JSON(http.StatusNotFound, gin.H{"error": "session not found"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to get session"})
+		return
+	}
+	if _, err := h.revoke(c, []*ent.Session{s}, false); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to revoke session"})
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
+
+// RevokeMySessions handles DELETE /proxy/me/sessions.
+// Signs the caller out everywhere else: every own session matching the
+// device_id and app filters is revoked, except the one making the request.
+func (h *SessionHandler) RevokeMySessions(c *gin.Context) {
+	q := h.sessionQuery(c).Where(entsession.HasUserWith(entuser.ID(userFromCtx(c).ID)))
+	if cur := sessionFromCtx(c); cur != nil {
+		q.Where(entsession.IDNEQ(cur.ID))
+	}
+	sessions, err := q.All(c.Request.Context())
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to list sessions"})
+		return
+	}
+	n, err := h.revoke(c, sessions, false)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to revoke sessions"})
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"revoked": n})
+}
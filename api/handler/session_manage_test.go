@@ -0,0 +1,262 @@
+package handler_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/gorilla/websocket"
+
+	"github.com/ddevcap/jellyfin-proxy/api/handler"
+	"github.com/ddevcap/jellyfin-proxy/api/middleware"
+	"github.com/ddevcap/jellyfin-proxy/backend"
+	"github.com/ddevcap/jellyfin-proxy/config"
+	"github.com/ddevcap/jellyfin-proxy/ent"
+)
+
+var _ = Describe("Session management", func() {
+	var (
+		router *gin.Engine
+		hub    *handler.WSHub
+		server *httptest.Server
+		alice  *ent.User
+		bob    *ent.User
+		phone  *ent.Session
+		tv     *ent.Session
+	)
+
+	adminHdr := map[string]string{"X-Emby-Token": "manage-admin-token"}
+	phoneHdr := map[string]string{"X-Emby-Token": "manage-alice-phone"}
+	tvHdr := map[string]string{"X-Emby-Token": "manage-alice-tv"}
+	bobHdr := map[string]string{"X-Emby-Token": "manage-bob-token"}
+
+	newSession := func(u *ent.User, token, deviceID, app string) *ent.Session {
+		return db.Session.Create().
+			SetToken(middleware.HashToken(token)).
+			SetDeviceID(deviceID).
+			SetDeviceName(deviceID).
+			SetAppName(app).
+			SetUser(u).
+			SaveX(context.Background())
+	}
+
+	BeforeEach(func() {
+		cleanDB()
+		admin := createUser("manageadmin", "password1!", true)
+		alice = createUser("alice", "password1!", false)
+		bob = createUser("bob", "password1!", false)
+		newSession(admin, "manage-admin-token", "admin-browser", "Jellyfin Web")
+		phone = newSession(alice, "manage-alice-phone", "alice-phone", "Jellyfin Mobile")
+		tv = newSession(alice, "manage-alice-tv", "living-room-tv", "Jellyfin Android TV")
+		newSession(bob, "manage-bob-token", "living-room-tv", "Jellyfin Android TV")
+
+		cfg := config.Config{ServerID: "test-server-id"}
+		hub = handler.NewWSHub(backend.NewPool(db, cfg))
+		sessionH := handler.NewSessionHandler(db, cfg, hub, handler.NewPlaybackRegistry(db))
+		router = gin.New()
+		router.GET("/socket", middleware.Auth(db, cfg), handler.WebSocketHandler(hub))
+		adm := router.Group("/proxy")
+		adm.Use(middleware.Auth(db, cfg), middleware.AdminOnly())
+		adm.GET("/sessions", sessionH.ListSessions)
+		adm.DELETE("/sessions", sessionH.RevokeSessions)
+		adm.DELETE("/sessions/:id", sessionH.RevokeSession)
+		me := router.Group("/proxy/me")
+		me.Use(middleware.Auth(db, cfg))
+		me.GET("/sessions", sessionH.ListMySessions)
+		me.DELETE("/sessions", sessionH.RevokeMySessions)
+		me.DELETE("/sessions/:id", sessionH.RevokeMySession)
+		authH := handler.NewAuthHandler(db, cfg, func(string) {}, func(string) {})
+		authH.SetWSHub(hub)
+		router.POST("/users/:userId/password", middleware.Auth(db, cfg), authH.UpdatePassword)
+		router.POST("/Sessions/Logout", middleware.Auth(db, cfg), authH.Logout)
+		server = httptest.NewServer(router)
+	})
+
+	AfterEach(func() {
+		hub.Shutdown()
+		server.Close()
+	})
+
+	// connect opens a WebSocket with token and waits for the initial KeepAlive.
+	connect := func(token string) *websocket.Conn {
+		u := "ws" + strings.TrimPrefix(server.URL, "http") + "/socket?api_key=" + token
+		conn, _, err := websocket.DefaultDialer.Dial(u, nil)
+		Expect(err).NotTo(HaveOccurred())
+		_ = conn.SetReadDeadline(time.Now().Add(5 * time.Second))
+		_, _, err = conn.ReadMessage()
+		Expect(err).NotTo(HaveOccurred())
+		return conn
+	}
+
+	// expectClosed waits for the server to close conn.
+	expectClosed := func(conn *websocket.Conn) {
+		_ = conn.SetReadDeadline(time.Now().Add(5 * time.Second))
+		for {
+			_, _, err := conn.ReadMessage()
+			if err != nil {
+				Expect(websocket.IsCloseError(err, websocket.ClosePolicyViolation)).To(BeTrue(), err.Error())
+				return
+			}
+		}
+	}
+
+	type sessionResp struct {
+		ID       string `json:"id"`
+		Username string `json:"username"`
+		DeviceID string `json:"device_id"`
+		AppName  string `json:"app_name"`
+		Current  bool   `json:"current"`
+	}
+	list := func(path string, headers map[string]string) []sessionResp {
+		w := doGet(router, path, headers)
+		Expect(w.Code).To(Equal(http.StatusOK), w.Body.String())
+		var resp []sessionResp
+		Expect(json.Unmarshal(w.Body.Bytes(), &resp)).To(Succeed())
+		return resp
+	}
+
+	Describe("admin API", func() {
+		It("lists all sessions and filters by user, device and app", func() {
+			Expect(list("/proxy/sessions", adminHdr)).To(HaveLen(4))
+
+			byUser := list("/proxy/sessions?user_id="+alice.ID.String(), adminHdr)
+			Expect(byUser).To(HaveLen(2))
+			Expect(byUser[0].Username).To(Equal("alice"))
+
+			byDevice := list("/proxy/sessions?device_id=living-room-tv", adminHdr)
+			Expect(byDevice).To(HaveLen(2))
+
+			byApp := list("/proxy/sessions?app=Jellyfin+Mobile", adminHdr)
+			Expect(byApp).To(HaveLen(1))
+			Expect(byApp[0].ID).To(Equal(phone.ID.String()))
+		})
+
+		It("is admin only", func() {
+			Expect(doGet(router, "/proxy/sessions", phoneHdr).Code).To(Equal(http.StatusForbidden))
+			Expect(doDelete(router, "/proxy/sessions/"+tv.ID.String(), phoneHdr).Code).To(Equal(http.StatusForbidden))
+		})
+
+		It("revokes one session, closing its WebSocket", func() {
+			tvConn := connect("manage-alice-tv")
+			defer tvConn.Close()
+			phoneConn := connect("manage-alice-phone")
+			defer phoneConn.Close()
+
+			w := doDelete(router, "/proxy/sessions/"+tv.ID.String(), adminHdr)
+			Expect(w.Code).To(Equal(http.StatusNoContent))
+
+			expectClosed(tvConn)
+			Expect(doGet(router, "/proxy/me/sessions", tvHdr).Code).To(Equal(http.StatusUnauthorized))
+			Expect(doGet(router, "/proxy/me/sessions", phoneHdr).Code).To(Equal(http.StatusOK))
+
+			entry := db.ActivityLog.Query().OnlyX(context.Background())
+			Expect(entry.EventType).To(Equal("SessionRevoked"))
+			Expect(entry.ShortOverview).To(Equal("By manageadmin"))
+		})
+
+		It("returns 404 for an unknown session", func() {
+			w := doDelete(router, "/proxy/sessions/"+bob.ID.String(), adminHdr)
+			Expect(w.Code).To(Equal(http.StatusNotFound))
+		})
+
+		It("revokes all sessions of a user", func() {
+			w := doDelete(router, "/proxy/sessions?user_id="+alice.ID.String(), adminHdr)
+			Expect(w.Code).To(Equal(http.StatusOK))
+			Expect(w.Body.String()).To(MatchJSON(`{"revoked":2}`))
+
+			Expect(db.Session.Query().CountX(context.Background())).To(Equal(2))
+			Expect(doGet(router, "/proxy/me/sessions", phoneHdr).Code).To(Equal(http.StatusUnauthorized))
+			Expect(doGet(router, "/proxy/me/sessions", bobHdr).Code).To(Equal(http.StatusOK))
+		})
+
+		It("revokes all sessions of a device", func() {
+			w := doDelete(router, "/proxy/sessions?device_id=living-room-tv", adminHdr)
+			Expect(w.Code).To(Equal(http.StatusOK))
+			Expect(w.Body.String()).To(MatchJSON(`{"revoked":2}`))
+
+			Expect(doGet(router, "/proxy/me/sessions", tvHdr).Code).To(Equal(http.StatusUnauthorized))
+			Expect(doGet(router, "/proxy/me/sessions", bobHdr).Code).To(Equal(http.StatusUnauthorized))
+			Expect(doGet(router, "/proxy/me/sessions", phoneHdr).Code).To(Equal(http.StatusOK))
+		})
+
+		It("refuses to revoke every session at once", func() {
+			w := doDelete(router, "/proxy/sessions", adminHdr)
+			Expect(w.Code).To(Equal(http.StatusBadRequest))
+			Expect(db.Session.Query().CountX(context.Background())).To(Equal(4))
+		})
+	})
+
+	Describe("self-service", func() {
+		It("lists only the caller's sessions and marks the current one", func() {
+			sessions := list("/proxy/me/sessions", phoneHdr)
+			Expect(sessions).To(HaveLen(2))
+			for _, s := range sessions {
+				Expect(s.Username).To(Equal("alice"))
+				Expect(s.Current).To(Equal(s.ID == phone.ID.String()))
+			}
+		})
+
+		It("revokes one of the caller's sessions", func() {
+			tvConn := connect("manage-alice-tv")
+			defer tvConn.Close()
+
+			w := doDelete(router, "/proxy/me/sessions/"+tv.ID.String(), phoneHdr)
+			Expect(w.Code).To(Equal(http.StatusNoContent))
+
+			expectClosed(tvConn)
+			Expect(list("/proxy/me/sessions", phoneHdr)).To(HaveLen(1))
+		})
+
+		It("does not revoke other users' sessions", func() {
+			w := doDelete(router, "/proxy/me/sessions/"+tv.ID.String(), bobHdr)
+			Expect(w.Code).To(Equal(http.StatusNotFound))
+			Expect(doGet(router, "/proxy/me/sessions", tvHdr).Code).To(Equal(http.StatusOK))
+		})
+
+		It("signs out everywhere else", func() {
+			w := doDelete(router, "/proxy/me/sessions", phoneHdr)
+			Expect(w.Code).To(Equal(http.StatusOK))
+			Expect(w.Body.String()).To(MatchJSON(`{"revoked":1}`))
+
+			sessions := list("/proxy/me/sessions", phoneHdr)
+			Expect(sessions).To(HaveLen(1))
+			Expect(sessions[0].Current).To(BeTrue())
+			Expect(doGet(router, "/proxy/me/sessions", bobHdr).Code).To(Equal(http.StatusOK))
+		})
+	})
+
+	It("closes other sessions' WebSockets when the password changes", func() {
+		tvConn := connect("manage-alice-tv")
+		defer tvConn.Close()
+
+		w := doPost(router, "/users/"+alice.ID.String()+"/password", map[string]string{
+			"CurrentPw": "password1!",
+			"NewPw":     "new-password1!",
+		}, phoneHdr)
+		Expect(w.Code).To(Equal(http.StatusNoContent))
+
+		expectClosed(tvConn)
+		Expect(doGet(router, "/proxy/me/sessions", phoneHdr).Code).To(Equal(http.StatusOK))
+	})
+
+	It("closes the session's WebSocket on logout and tells the hub's listeners", func() {
+		var closed []uuid.UUID
+		hub.OnSessionsClosed(func(ids ...uuid.UUID) { closed = append(closed, ids...) })
+		tvConn := connect("manage-alice-tv")
+		defer tvConn.Close()
+
+		Expect(doPost(router, "/Sessions/Logout", nil, tvHdr).Code).To(Equal(http.StatusNoContent))
+
+		expectClosed(tvConn)
+		Expect(closed).To(Equal([]uuid.UUID{tv.ID}))
+		Expect(doGet(router, "/proxy/me/sessions", tvHdr).Code).To(Equal(http.StatusUnauthorized))
+	})
+})
@@ -0,0 +1,252 @@
+package handler_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gorilla/websocket"
+
+	"github.com/ddevcap/jellyfin-proxy/api/handler"
+	"github.com/ddevcap/jellyfin-proxy/api/middleware"
+	"github.com/ddevcap/jellyfin-proxy/backend"
+	"github.com/ddevcap/jellyfin-proxy/config"
+	"github.com/ddevcap/jellyfin-proxy/ent"
+)
+
+const (
+	phoneToken = "session-test-phone-token"
+	tvToken    = "session-test-tv-token"
+)
+
+var _ = Describe("Sessions", func() {
+	var (
+		router *gin.Engine
+		hub    *handler.WSHub
+		server *httptest.Server
+		user   *ent.User
+		tv     *ent.Session
+	)
+
+	phone := map[string]string{"X-Emby-Token": phoneToken}
+
+	BeforeEach(func() {
+		cleanDB()
+		user = createUser("sessionuser", "password1!", false)
+		createSession(user, phoneToken)
+		tv = db.Session.Create().
+			SetToken(middleware.HashToken(tvToken)).
+			SetDeviceID("living-room-tv").
+			SetDeviceName("Living Room TV").
+			SetAppName("Jellyfin Android TV").
+			SetUser(user).
+			SaveX(context.Background())
+
+		cfg := config.Config{ServerID: "test-server-id"}
+		hub = handler.NewWSHub(backend.NewPool(db, cfg))
+		sessionH := handler.NewSessionHandler(db, cfg, hub, handler.NewPlaybackRegistry(db))
+		router = gin.New()
+		priv := router.Group("/")
+		priv.Use(middleware.Auth(db, cfg))
+		priv.GET("/socket", handler.WebSocketHandler(hub))
+		priv.GET("/sessions", sessionH.GetSessions)
+		priv.POST("/sessions/capabilities/full", sessionH.PostCapabilitiesFull)
+		priv.POST("/sessions/:sessionId/playing", sessionH.Play)
+		priv.POST("/sessions/:sessionId/playing/:command", sessionH.Playstate)
+		priv.POST("/sessions/:sessionId/command", sessionH.GeneralCommand)
+		priv.POST("/sessions/:sessionId/message", sessionH.DisplayMessage)
+		server = httptest.NewServer(router)
+	})
+
+	AfterEach(func() {
+		hub.Shutdown()
+		server.Close()
+	})
+
+	// connect opens the device's WebSocket and waits until the hub tracks it.
+	connect := func(token string) *websocket.Conn {
+		u := "ws" + strings.TrimPrefix(server.URL, "http") + "/socket?api_key=" + token
+		conn, _, err := websocket.DefaultDialer.Dial(u, nil)
+		Expect(err).NotTo(HaveOccurred())
+		_ = conn.SetReadDeadline(time.Now().Add(5 * time.Second))
+		_, _, err = conn.ReadMessage() // the initial KeepAlive
+		Expect(err).NotTo(HaveOccurred())
+		return conn
+	}
+
+	// readEvent returns the next message other than a KeepAlive.
+	readEvent := func(conn *websocket.Conn) map[string]interface{} {
+		_ = conn.SetReadDeadline(time.Now().Add(5 * time.Second))
+		for {
+			_, msg, err := conn.ReadMessage()
+			Expect(err).NotTo(HaveOccurred())
+			var m map[string]interface{}
+			Expect(json.Unmarshal(msg, &m)).To(Succeed())
+			if m["MessageType"] != "KeepAlive" {
+				return m
+			}
+		}
+	}
+
+	type sessionInfo struct {
+		Id                    string
+		DeviceId              string
+		DeviceName            string
+		SupportsRemoteControl bool
+		SupportsMediaControl  bool
+		SupportedCommands     []string
+	}
+	listSessions := func(path string, headers map[string]string) []sessionInfo {
+		w := doGet(router, path, headers)
+		Expect(w.Code).To(Equal(http.StatusOK))
+		var resp []sessionInfo
+		Expect(json.Unmarshal(w.Body.Bytes(), &resp)).To(Succeed())
+		return resp
+	}
+
+	Describe("GetSessions", func() {
+		It("lists the user's sessions with their connection state and capabilities", func() {
+			conn := connect(tvToken)
+			defer conn.Close()
+			w := doPost(router, "/sessions/capabilities/full", map[string]interface{}{
+				"PlayableMediaTypes":   []string{"Video"},
+				"SupportedCommands":    []string{"DisplayMessage"},
+				"SupportsMediaControl": true,
+			}, map[string]string{"X-Emby-Token": tvToken})
+			Expect(w.Code).To(Equal(http.StatusNoContent))
+
+			sessions := listSessions("/sessions", phone)
+
+			Expect(sessions).To(HaveLen(2))
+			byDevice := map[string]sessionInfo{}
+			for _, s := range sessions {
+				byDevice[s.DeviceId] = s
+			}
+			Expect(byDevice["living-room-tv"].Id).To(Equal(tv.ID.String()))
+			Expect(byDevice["living-room-tv"].SupportsRemoteControl).To(BeTrue())
+			Expect(byDevice["living-room-tv"].SupportsMediaControl).To(BeTrue())
+			Expect(byDevice["living-room-tv"].SupportedCommands).To(Equal([]string{"DisplayMessage"}))
+			Expect(byDevice["test-device"].SupportsRemoteControl).To(BeFalse())
+		})
+
+		It("lists only connected sessions on other devices when asked for controllable ones", func() {
+			conn := connect(tvToken)
+			defer conn.Close()
+			phoneConn := connect(phoneToken)
+			defer phoneConn.Close()
+
+			sessions := listSessions("/sessions?ControllableByUserId="+user.ID.String(), phone)
+
+			Expect(sessions).To(HaveLen(1))
+			Expect(sessions[0].DeviceName).To(Equal("Living Room TV"))
+		})
+
+		It("hides other users' sessions from non-admins but not from admins", func() {
+			other := createUser("otheruser", "password1!", false)
+			createSession(other, "other-token")
+			admin := createUser("adminuser", "password1!", true)
+			createSession(admin, "admin-token")
+
+			Expect(listSessions("/sessions", phone)).To(HaveLen(2))
+			Expect(listSessions("/sessions", map[string]string{"X-Emby-Token": "admin-token"})).To(HaveLen(4))
+		})
+	})
+
+	Describe("remote control", func() {
+		var conn *websocket.Conn
+
+		BeforeEach(func() {
+			conn = connect(tvToken)
+		})
+
+		AfterEach(func() {
+			_ = conn.Close()
+		})
+
+		It("tells the target device to play items", func() {
+			w := doPost(router, "/sessions/"+tv.ID.String()+"/playing?playCommand=PlayNow&itemIds=s1_abc,s2_def&startPositionTicks=600000000", nil, phone)
+			Expect(w.Code).To(Equal(http.StatusNoContent))
+
+			msg := readEvent(conn)
+			Expect(msg["MessageType"]).To(Equal("Play"))
+			data := msg["Data"].(map[string]interface{})
+			Expect(data["ItemIds"]).To(Equal([]interface{}{"s1_abc", "s2_def"}))
+			Expect(data["PlayCommand"]).To(Equal("PlayNow"))
+			Expect(data["StartPositionTicks"]).To(BeNumerically("==", 600000000))
+			Expect(data["ControllingUserId"]).To(Equal(user.ID.String()))
+		})
+
+		It("sends playstate commands with their canonical name", func() {
+			w := doPost(router, "/sessions/"+tv.ID.String()+"/playing/pause", nil, phone)
+			Expect(w.Code).To(Equal(http.StatusNoContent))
+
+			msg := readEvent(conn)
+			Expect(msg["MessageType"]).To(Equal("Playstate"))
+			Expect(msg["Data"].(map[string]interface{})["Command"]).To(Equal("Pause"))
+		})
+
+		It("displays a message", func() {
+			w := doPost(router, "/sessions/"+tv.ID.String()+"/message",
+				map[string]interface{}{"Header": "Dinner", "Text": "Dinner is ready", "TimeoutMs": 5000}, phone)
+			Expect(w.Code).To(Equal(http.StatusNoContent))
+
+			msg := readEvent(conn)
+			Expect(msg["MessageType"]).To(Equal("GeneralCommand"))
+			data := msg["Data"].(map[string]interface{})
+			Expect(data["Name"]).To(Equal("DisplayMessage"))
+			Expect(data["Arguments"]).To(Equal(map[string]interface{}{
+				"Header": "Dinner", "Text": "Dinner is ready", "TimeoutMs": "5000",
+			}))
+		})
+
+		It("addresses the target session, not other sessions on the same device", func() {
+			db.Session.Create().
+				SetToken(middleware.HashToken("session-test-tv-relogin")).
+				SetDeviceID("living-room-tv").
+				SetDeviceName("Living Room TV").
+				SetAppName("Jellyfin Android TV").
+				SetUser(user).
+				SaveX(context.Background())
+			other := connect("session-test-tv-relogin")
+			defer other.Close()
+
+			w := doPost(router, "/sessions/"+tv.ID.String()+"/playing/pause", nil, phone)
+			Expect(w.Code).To(Equal(http.StatusNoContent))
+			Expect(readEvent(conn)["MessageType"]).To(Equal("Playstate"))
+
+			_ = other.SetReadDeadline(time.Now().Add(200 * time.Millisecond))
+			for {
+				_, msg, err := other.ReadMessage()
+				if err != nil {
+					break // timed out without a command
+				}
+				Expect(string(msg)).NotTo(ContainSubstring("Playstate"))
+			}
+		})
+
+		It("rejects unknown commands", func() {
+			w := doPost(router, "/sessions/"+tv.ID.String()+"/command", map[string]interface{}{"Name": "SelfDestruct"}, phone)
+			Expect(w.Code).To(Equal(http.StatusBadRequest))
+		})
+
+		It("returns 404 for a session without a connection", func() {
+			s := createSession(user, "idle-token")
+			w := doPost(router, "/sessions/"+s.ID.String()+"/playing/stop", nil, phone)
+			Expect(w.Code).To(Equal(http.StatusNotFound))
+		})
+
+		It("forbids controlling another user's session", func() {
+			other := createUser("otheruser", "password1!", false)
+			createSession(other, "other-token")
+			w := doPost(router, "/sessions/"+tv.ID.String()+"/playing/stop", nil, map[string]string{"X-Emby-Token": "other-token"})
+			Expect(w.Code).To(Equal(http.StatusForbidden))
+		})
+	})
+})
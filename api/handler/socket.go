@@ -1,12 +1,17 @@
 package handler
 
 import (
+	"context"
+	"encoding/json"
 	"log/slog"
 	"net/http"
 	"sync"
 	"time"
 
+	"github.com/ddevcap/jellyfin-proxy/backend"
+	"github.com/ddevcap/jellyfin-proxy/ent"
 	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
 	"github.com/gorilla/websocket"
 )
 
@@ -15,6 +20,13 @@ const (
 	wsKeepAliveInterval = 10 * time.Second
 	// wsReadDeadline is the maximum time to wait for a pong before considering the connection dead.
 	wsReadDeadline = 90 * time.Second
+	// wsSendBuffer is how many events may queue up for a slow client before
+	// further ones are dropped.
+	wsSendBuffer = 64
+	// relayRetryMin and relayRetryMax bound the backoff between attempts to
+	// reconnect an upstream socket.
+	relayRetryMin = 2 * time.Second
+	relayRetryMax = time.Minute
 )
 
 var upgrader = websocket.Upgrader{
@@ -25,60 +37,405 @@ var upgrader = websocket.Upgrader{
 	CheckOrigin: func(r *http.Request) bool { return true },
 }
 
-// WSHub tracks all active WebSocket connections so they can be closed
-// during graceful shutdown. Create one in main and pass it to the handler.
+// wsConn is one client WebSocket connection. Everything written to it goes
+// through send, so the handler goroutine is its only writer.
+type wsConn struct {
+	conn      *websocket.Conn
+	userID    uuid.UUID
+	deviceID  string
+	sessionID uuid.UUID // uuid.Nil when opened with an API key
+	apiKeyID  uuid.UUID // uuid.Nil when opened with a session
+	send      chan []byte
+}
+
+// WSHub tracks all active WebSocket connections by proxy user and session, so
+// they can receive backend events and be closed during graceful shutdown.
+// Create one in main and pass it to the handler.
+//
+// While a user has at least one connection the hub keeps an upstream socket
+// to every backend the user is mapped to and relays its events, with IDs
+// rewritten, to all of that user's connections. Proxy users that share a
+// backend account share one upstream socket. Remote-control commands are not
+// relayed: the proxy's own /Sessions endpoints address them to one session.
 type WSHub struct {
-	mu    sync.Mutex
-	conns map[*websocket.Conn]struct{}
-	done  chan struct{} // closed on shutdown
+	pool   *backend.Pool
+	mu     sync.Mutex
+	users  map[uuid.UUID]map[*wsConn]struct{}
+	relays map[string]*wsRelay // by backend ID and backend user ID
+	done   chan struct{}       // closed on shutdown
+
+	onSessionsClosed []func(ids ...uuid.UUID)
 }
 
-func NewWSHub() *WSHub {
+func NewWSHub(pool *backend.Pool) *WSHub {
 	return &WSHub{
-		conns: make(map[*websocket.Conn]struct{}),
-		done:  make(chan struct{}),
+		pool:   pool,
+		users:  make(map[uuid.UUID]map[*wsConn]struct{}),
+		relays: make(map[string]*wsRelay),
+		done:   make(chan struct{}),
 	}
 }
 
-func (h *WSHub) add(conn *websocket.Conn) {
+// add registers wc and subscribes its user to their backends' relays. Every
+// connection subscribes, not just the user's first: an earlier attempt may
+// have failed or skipped a backend that was offline at the time.
+func (h *WSHub) add(wc *wsConn, user *ent.User) {
 	h.mu.Lock()
-	h.conns[conn] = struct{}{}
+	conns := h.users[wc.userID]
+	if conns == nil {
+		conns = make(map[*wsConn]struct{})
+		h.users[wc.userID] = conns
+	}
+	conns[wc] = struct{}{}
 	h.mu.Unlock()
+
+	go h.subscribe(user)
+}
+
+func (h *WSHub) remove(wc *wsConn) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	conns := h.users[wc.userID]
+	delete(conns, wc)
+	if len(conns) > 0 {
+		return
+	}
+	delete(h.users, wc.userID)
+	for key, r := range h.relays {
+		delete(r.users, wc.userID)
+		if len(r.users) == 0 {
+			r.cancel()
+			delete(h.relays, key)
+		}
+	}
+}
+
+// subscribe attaches a connected user to an upstream relay for each of their
+// backends, starting the relays that are not running yet and refreshing the
+// credentials of those that are. It is safe to call repeatedly.
+func (h *WSHub) subscribe(user *ent.User) {
+	ctx, cancel := context.WithTimeout(context.Background(), fanOutTimeout)
+	clients, err := h.pool.AllForUser(ctx, user)
+	cancel()
+	if err != nil {
+		slog.Warn("ws: resolving backends for relay", "user", user.Username, "error", err)
+		return
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if h.users[user.ID] == nil {
+		return // disconnected in the meantime
+	}
+	select {
+	case <-h.done:
+		return
+	default:
+	}
+	for _, sc := range clients {
+		key := sc.BackendID() + "|" + sc.BackendUserID()
+		r := h.relays[key]
+		if r == nil {
+			ctx, cancel := context.WithCancel(context.Background())
+			r = &wsRelay{hub: h, users: make(map[uuid.UUID]*ent.User), cancel: cancel}
+			h.relays[key] = r
+			go r.run(ctx)
+		}
+		r.sc = sc
+		r.users[user.ID] = user
+	}
 }
 
-func (h *WSHub) remove(conn *websocket.Conn) {
+// resubscribe subscribes the users of r again, e.g. before r reconnects, so
+// that it dials with current credentials and the users pick up backends that
+// were offline or unmapped when they connected.
+func (h *WSHub) resubscribe(r *wsRelay) {
 	h.mu.Lock()
-	delete(h.conns, conn)
+	users := make([]*ent.User, 0, len(r.users))
+	for _, u := range r.users {
+		users = append(users, u)
+	}
 	h.mu.Unlock()
+	for _, u := range users {
+		h.subscribe(u)
+	}
+}
+
+// broadcast queues msg on every connection of the relay's users. A
+// UserDataChanged event names the backend user; each recipient gets a copy
+// naming their proxy user instead.
+func (h *WSHub) broadcast(r *wsRelay, messageType string, msg []byte) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	for userID := range r.users {
+		out := msg
+		if messageType == "UserDataChanged" {
+			out = withDataUserID(msg, userID)
+		}
+		for wc := range h.users[userID] {
+			select {
+			case wc.send <- out:
+			default:
+				slog.Debug("ws: dropping event for slow client", "type", messageType, "device", wc.deviceID)
+			}
+		}
+	}
+}
+
+// Connected reports whether the user has an open connection opened with
+// session sessionID.
+func (h *WSHub) Connected(userID, sessionID uuid.UUID) bool {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	for wc := range h.users[userID] {
+		if wc.sessionID == sessionID {
+			return true
+		}
+	}
+	return false
+}
+
+// SendToSession queues msg on the user's connections opened with session
+// sessionID and reports whether there was any. Other sessions of the user,
+// even on the same device, do not receive it.
+func (h *WSHub) SendToSession(userID, sessionID uuid.UUID, msg []byte) bool {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	sent := false
+	for wc := range h.users[userID] {
+		if wc.sessionID != sessionID {
+			continue
+		}
+		select {
+		case wc.send <- msg:
+			sent = true
+		default:
+			slog.Debug("ws: dropping message for slow client", "device", wc.deviceID)
+		}
+	}
+	return sent
+}
+
+// OnSessionsClosed registers fn to be called with the IDs passed to
+// CloseSessions, so per-session state kept elsewhere can be dropped. Register
+// callbacks before the hub is in use.
+func (h *WSHub) OnSessionsClosed(fn func(ids ...uuid.UUID)) {
+	h.onSessionsClosed = append(h.onSessionsClosed, fn)
+}
+
+// CloseSessions closes the connections opened with any of the given sessions,
+// e.g. after they were revoked or expired, and notifies the OnSessionsClosed
+// callbacks. The handlers clean up as their reads fail.
+func (h *WSHub) CloseSessions(ids ...uuid.UUID) {
+	h.closeMatching(ids, func(wc *wsConn) uuid.UUID { return wc.sessionID }, "session revoked")
+	for _, fn := range h.onSessionsClosed {
+		fn(ids...)
+	}
+}
+
+// CloseAPIKeys closes the connections opened with any of the given API keys,
+// e.g. after they were revoked.
+func (h *WSHub) CloseAPIKeys(ids ...uuid.UUID) {
+	h.closeMatching(ids, func(wc *wsConn) uuid.UUID { return wc.apiKeyID }, "API key revoked")
 }
 
-// Shutdown closes all active WebSocket connections and signals handlers to exit.
+// closeMatching closes every connection whose credential, as returned by
+// credential, is one of ids. Connections without one (uuid.Nil) never match.
+func (h *WSHub) closeMatching(ids []uuid.UUID, credential func(*wsConn) uuid.UUID, reason string) {
+	revoked := make(map[uuid.UUID]bool, len(ids))
+	for _, id := range ids {
+		revoked[id] = true
+	}
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	for _, conns := range h.users {
+		for wc := range conns {
+			id := credential(wc)
+			if id == uuid.Nil || !revoked[id] {
+				continue
+			}
+			_ = wc.conn.WriteControl(
+				websocket.CloseMessage,
+				websocket.FormatCloseMessage(websocket.ClosePolicyViolation, reason),
+				time.Now().Add(time.Second),
+			)
+			_ = wc.conn.Close()
+		}
+	}
+}
+
+// Shutdown closes all active WebSocket connections and upstream sockets and
+// signals handlers to exit.
 func (h *WSHub) Shutdown() {
 	close(h.done)
 	h.mu.Lock()
 	defer h.mu.Unlock()
-	for conn := range h.conns {
-		_ = conn.WriteControl(
-			websocket.CloseMessage,
-			websocket.FormatCloseMessage(websocket.CloseGoingAway, "server shutting down"),
-			time.Now().Add(time.Second),
-		)
-		_ = conn.Close()
+	for _, r := range h.relays {
+		r.cancel()
+	}
+	for _, conns := range h.users {
+		for wc := range conns {
+			_ = wc.conn.WriteControl(
+				websocket.CloseMessage,
+				websocket.FormatCloseMessage(websocket.CloseGoingAway, "server shutting down"),
+				time.Now().Add(time.Second),
+			)
+			_ = wc.conn.Close()
+		}
+	}
+	h.users = make(map[uuid.UUID]map[*wsConn]struct{})
+	h.relays = make(map[string]*wsRelay)
+}
+
+// wsRelay pumps the events of one backend account's upstream socket to the
+// proxy users mapped to it, reconnecting with backoff when it drops.
+type wsRelay struct {
+	hub    *WSHub
+	sc     *backend.ServerClient   // guarded by hub.mu; refreshed by subscribe
+	users  map[uuid.UUID]*ent.User // guarded by hub.mu
+	cancel context.CancelFunc
+}
+
+// client returns the relay's current backend client.
+func (r *wsRelay) client() *backend.ServerClient {
+	r.hub.mu.Lock()
+	defer r.hub.mu.Unlock()
+	return r.sc
+}
+
+func (r *wsRelay) run(ctx context.Context) {
+	delay := relayRetryMin
+	for {
+		started := time.Now()
+		sc := r.client()
+		err := r.relay(ctx, sc)
+		if ctx.Err() != nil {
+			return
+		}
+		slog.Debug("ws: upstream socket closed", "backend", sc.Prefix(), "error", err)
+		if time.Since(started) > relayRetryMax {
+			delay = relayRetryMin // it was up for a while; retry quickly
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(delay):
+		}
+		delay = min(delay*2, relayRetryMax)
+		r.hub.resubscribe(r)
 	}
-	h.conns = make(map[*websocket.Conn]struct{})
+}
+
+// relay connects to the backend and forwards its messages until the
+// connection fails or ctx is cancelled.
+func (r *wsRelay) relay(ctx context.Context, sc *backend.ServerClient) error {
+	conn, err := sc.DialSocket(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = conn.Close() }()
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	go func() {
+		// Jellyfin drops sockets that stay silent; this goroutine is the
+		// connection's only writer. Closing the connection on exit also
+		// unblocks the read loop below when the relay is stopped.
+		defer func() { _ = conn.Close() }()
+		ticker := time.NewTicker(wsKeepAliveInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				if err := sendKeepAlive(conn); err != nil {
+					return
+				}
+			}
+		}
+	}()
+
+	for {
+		_, msg, err := conn.ReadMessage()
+		if err != nil {
+			return err
+		}
+		r.forward(sc, msg)
+	}
+}
+
+// forward rewrites an upstream message into proxy form and broadcasts it.
+// The backend's keep-alive traffic stays between it and the relay, and so do
+// remote-control commands: the backend addresses them to the relay's own
+// session, which stands for none of the proxy devices in particular.
+func (r *wsRelay) forward(sc *backend.ServerClient, msg []byte) {
+	var head struct {
+		MessageType string `json:"MessageType"`
+	}
+	if err := json.Unmarshal(msg, &head); err != nil {
+		return
+	}
+	switch head.MessageType {
+	case "", "KeepAlive", "ForceKeepAlive",
+		"Play", "Playstate", "GeneralCommand":
+		return
+	}
+	out, err := sc.RewriteMessage(msg)
+	if err != nil {
+		return
+	}
+	r.hub.broadcast(r, head.MessageType, out)
+}
+
+// withDataUserID returns msg with its Data.UserId set to userID.
+func withDataUserID(msg []byte, userID uuid.UUID) []byte {
+	var m map[string]interface{}
+	if err := json.Unmarshal(msg, &m); err != nil {
+		return msg
+	}
+	data, ok := m["Data"].(map[string]interface{})
+	if !ok {
+		return msg
+	}
+	data["UserId"] = userID.String()
+	out, err := json.Marshal(m)
+	if err != nil {
+		return msg
+	}
+	return out
 }
 
 // WebSocketHandler returns a gin handler that manages WebSocket connections
 // with lifecycle tracking via the hub.
 func WebSocketHandler(hub *WSHub) gin.HandlerFunc {
 	return func(c *gin.Context) {
+		user := userFromCtx(c)
+		deviceID := queryParam(c, "deviceid")
+		var sessionID, apiKeyID uuid.UUID
+		if s := sessionFromCtx(c); s != nil {
+			deviceID = s.DeviceID
+			sessionID = s.ID
+		}
+		if k := apiKeyFromCtx(c); k != nil {
+			apiKeyID = k.ID
+		}
+
 		conn, err := upgrader.Upgrade(c.Writer, c.Request, nil)
 		if err != nil {
 			return
 		}
-		hub.add(conn)
+		wc := &wsConn{
+			conn:      conn,
+			userID:    user.ID,
+			deviceID:  deviceID,
+			sessionID: sessionID,
+			apiKeyID:  apiKeyID,
+			send:      make(chan []byte, wsSendBuffer),
+		}
+		hub.add(wc, user)
 		defer func() {
-			hub.remove(conn)
+			hub.remove(wc)
 			_ = conn.Close()
 		}()
 
@@ -115,6 +472,11 @@ func WebSocketHandler(hub *WSHub) gin.HandlerFunc {
 					slog.Debug("ws: keepalive write error", "error", err)
 					return
 				}
+			case msg := <-wc.send:
+				if err := conn.WriteMessage(websocket.TextMessage, msg); err != nil {
+					slog.Debug("ws: event write error", "error", err)
+					return
+				}
 			case err := <-readErr:
 				if websocket.IsUnexpectedCloseError(err,
 					websocket.CloseGoingAway,
@@ -0,0 +1,201 @@
+package handler_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gorilla/websocket"
+
+	"github.com/ddevcap/jellyfin-proxy/api/handler"
+	"github.com/ddevcap/jellyfin-proxy/api/middleware"
+	"github.com/ddevcap/jellyfin-proxy/backend"
+	"github.com/ddevcap/jellyfin-proxy/config"
+	"github.com/ddevcap/jellyfin-proxy/ent"
+)
+
+const socketToken = "socket-test-session-token"
+
+var _ = Describe("WebSocket relay", func() {
+	var (
+		user     *ent.User
+		hub      *handler.WSHub
+		proxy    *httptest.Server
+		fake     *httptest.Server
+		upstream chan *websocket.Conn
+		tokens   chan string // the backend token upstream sockets must present
+	)
+
+	BeforeEach(func() {
+		cleanDB()
+		user = createUser("socketuser", "password1!", false)
+		createSession(user, socketToken)
+
+		// The fake backend hands every accepted upstream socket to the spec.
+		upstream = make(chan *websocket.Conn, 1)
+		tokens = make(chan string, 1)
+		token := "socket-backend-token"
+		fake = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			defer GinkgoRecover()
+			select {
+			case token = <-tokens:
+			default:
+			}
+			Expect(r.URL.Path).To(Equal("/socket"))
+			Expect(r.Header.Get("X-Emby-Token")).To(Equal(token))
+			conn, err := (&websocket.Upgrader{}).Upgrade(w, r, nil)
+			Expect(err).NotTo(HaveOccurred())
+			upstream <- conn
+		}))
+		createBackendUser(createBackend("Socket Backend", fake.URL, "ws"), user, "backend-user", "socket-backend-token")
+
+		cfg := config.Config{ServerID: "test-server-id"}
+		hub = handler.NewWSHub(backend.NewPool(db, cfg))
+		r := gin.New()
+		r.GET("/socket", middleware.Auth(db, cfg), handler.WebSocketHandler(hub))
+		proxy = httptest.NewServer(r)
+	})
+
+	AfterEach(func() {
+		hub.Shutdown()
+		proxy.Close()
+		fake.Close()
+	})
+
+	connect := func() *websocket.Conn {
+		u := "ws" + strings.TrimPrefix(proxy.URL, "http") + "/socket?api_key=" + socketToken
+		conn, _, err := websocket.DefaultDialer.Dial(u, nil)
+		Expect(err).NotTo(HaveOccurred())
+		return conn
+	}
+
+	// readEvent returns the next message other than a KeepAlive.
+	readEvent := func(conn *websocket.Conn) map[string]interface{} {
+		_ = conn.SetReadDeadline(time.Now().Add(5 * time.Second))
+		for {
+			_, msg, err := conn.ReadMessage()
+			Expect(err).NotTo(HaveOccurred())
+			var m map[string]interface{}
+			Expect(json.Unmarshal(msg, &m)).To(Succeed())
+			if m["MessageType"] != "KeepAlive" {
+				return m
+			}
+		}
+	}
+
+	It("relays backend events with proxy IDs to the user's sockets", func() {
+		client := connect()
+		defer client.Close()
+		var up *websocket.Conn
+		Eventually(upstream).Should(Receive(&up))
+		defer up.Close()
+
+		Expect(up.WriteMessage(websocket.TextMessage, []byte(`{"MessageType":"ForceKeepAlive","Data":60}`))).To(Succeed())
+		Expect(up.WriteMessage(websocket.TextMessage, []byte(
+			`{"MessageType":"UserDataChanged","Data":{"UserId":"backend-user","UserDataList":[{"ItemId":"m1","Played":true}]}}`,
+		))).To(Succeed())
+		Expect(up.WriteMessage(websocket.TextMessage, []byte(
+			`{"MessageType":"LibraryChanged","Data":{"ItemsAdded":["m2"],"FoldersAddedTo":["lib"]}}`,
+		))).To(Succeed())
+
+		ev := readEvent(client)
+		Expect(ev["MessageType"]).To(Equal("UserDataChanged"))
+		data := ev["Data"].(map[string]interface{})
+		Expect(data["UserId"]).To(Equal(user.ID.String()))
+		list := data["UserDataList"].([]interface{})
+		Expect(list[0].(map[string]interface{})["ItemId"]).To(Equal("ws_m1"))
+
+		ev = readEvent(client)
+		Expect(ev["MessageType"]).To(Equal("LibraryChanged"))
+		data = ev["Data"].(map[string]interface{})
+		Expect(data["ItemsAdded"]).To(Equal([]interface{}{"ws_m2"}))
+		Expect(data["FoldersAddedTo"]).To(Equal([]interface{}{"ws_lib"}))
+	})
+
+	It("shares one upstream socket between a user's devices and closes it after the last one leaves", func() {
+		first := connect()
+		var up *websocket.Conn
+		Eventually(upstream).Should(Receive(&up))
+		defer up.Close()
+		second := connect()
+		defer second.Close()
+		Consistently(upstream, 200*time.Millisecond).ShouldNot(Receive())
+
+		Expect(up.WriteMessage(websocket.TextMessage, []byte(`{"MessageType":"LibraryChanged","Data":{"ItemsAdded":["m2"]}}`))).To(Succeed())
+		Expect(readEvent(first)["MessageType"]).To(Equal("LibraryChanged"))
+		Expect(readEvent(second)["MessageType"]).To(Equal("LibraryChanged"))
+
+		_ = first.Close()
+		_ = second.Close()
+
+		_ = up.SetReadDeadline(time.Now().Add(5 * time.Second))
+		var err error
+		for err == nil {
+			_, _, err = up.ReadMessage()
+		}
+		Expect(websocket.IsCloseError(err, websocket.CloseAbnormalClosure)).To(BeTrue())
+	})
+
+	It("keeps remote-control commands addressed to its own backend session", func() {
+		client := connect()
+		defer client.Close()
+		var up *websocket.Conn
+		Eventually(upstream).Should(Receive(&up))
+		defer up.Close()
+
+		Expect(up.WriteMessage(websocket.TextMessage, []byte(`{"MessageType":"Playstate","Data":{"Command":"Pause"}}`))).To(Succeed())
+		Expect(up.WriteMessage(websocket.TextMessage, []byte(`{"MessageType":"LibraryChanged","Data":{"ItemsAdded":["m2"]}}`))).To(Succeed())
+		Expect(readEvent(client)["MessageType"]).To(Equal("LibraryChanged"))
+	})
+
+	It("subscribes later devices to backends the first one missed", func() {
+		// The first device connects before the user is mapped to the backend.
+		other := createUser("socketlate", "password1!", false)
+		createSession(other, "socket-late-token")
+		dial := func() *websocket.Conn {
+			u := "ws" + strings.TrimPrefix(proxy.URL, "http") + "/socket?api_key=socket-late-token"
+			conn, _, err := websocket.DefaultDialer.Dial(u, nil)
+			Expect(err).NotTo(HaveOccurred())
+			return conn
+		}
+		first := dial()
+		defer first.Close()
+		Consistently(upstream, 200*time.Millisecond).ShouldNot(Receive())
+
+		b := db.Backend.Query().OnlyX(context.Background())
+		createBackendUser(b, other, "backend-late", "socket-backend-token")
+		second := dial()
+		defer second.Close()
+		var up *websocket.Conn
+		Eventually(upstream).Should(Receive(&up))
+		defer up.Close()
+
+		Expect(up.WriteMessage(websocket.TextMessage, []byte(`{"MessageType":"LibraryChanged","Data":{"ItemsAdded":["m2"]}}`))).To(Succeed())
+		Expect(readEvent(first)["MessageType"]).To(Equal("LibraryChanged"))
+		Expect(readEvent(second)["MessageType"]).To(Equal("LibraryChanged"))
+	})
+
+	It("resubscribes with current credentials when the upstream socket reconnects", func() {
+		client := connect()
+		defer client.Close()
+		var up *websocket.Conn
+		Eventually(upstream).Should(Receive(&up))
+
+		tokens <- "socket-backend-token-2"
+		db.BackendUser.Update().SetBackendToken("socket-backend-token-2").ExecX(context.Background())
+		_ = up.Close()
+
+		var again *websocket.Conn
+		Eventually(upstream, 5*time.Second).Should(Receive(&again))
+		defer again.Close()
+		Expect(again.WriteMessage(websocket.TextMessage, []byte(`{"MessageType":"LibraryChanged","Data":{"ItemsAdded":["m2"]}}`))).To(Succeed())
+		Expect(readEvent(client)["MessageType"]).To(Equal("LibraryChanged"))
+	})
+})
@@ -0,0 +1,287 @@
+package handler
+
+import (
+	"cmp"
+	"math"
+	"net/http"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/ddevcap/jellyfin-proxy/ent"
+	entplaybackevent "github.com/ddevcap/jellyfin-proxy/ent/playbackevent"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+const (
+	// defaultStatsDays is the reporting window when the days param is absent.
+	defaultStatsDays = 30
+	// maxStatsDays caps the window so one request can't load years of history.
+	maxStatsDays = 366
+	// defaultTopItems is how many items /proxy/stats/items returns by default.
+	defaultTopItems = 10
+)
+
+// StatsHandler serves watch statistics computed from the playback history
+// the proxy records for all backends. Every endpoint covers the last "days"
+// days (default 30).
+type StatsHandler struct {
+	db *ent.Client
+}
+
+func NewStatsHandler(db *ent.Client) *StatsHandler {
+	return &StatsHandler{db: db}
+}
+
+// ── Response shapes ───────────────────────────────────────────────────────────
+
+// playTotals are the counters every statistic reports. Hours count the time
+// actually spent playing, excluding pauses.
+type playTotals struct {
+	Plays int     `json:"plays"`
+	Hours float64 `json:"hours"`
+}
+
+func (t *playTotals) add(e *ent.PlaybackEvent) {
+	t.Plays++
+	t.Hours += float64(e.PlayDuration) / 3600
+}
+
+// round trims Hours to two decimals for display.
+func (t *playTotals) round() {
+	t.Hours = math.Round(t.Hours*100) / 100
+}
+
+type userStats struct {
+	UserID     uuid.UUID `json:"user_id"`
+	Username   string    `json:"username"`
+	LastPlayed time.Time `json:"last_played"`
+	playTotals
+}
+
+type itemStats struct {
+	ItemID   string `json:"item_id"`
+	ItemName string `json:"item_name"`
+	ItemType string `json:"item_type"`
+	Users    int    `json:"users"`
+	playTotals
+	users map[uuid.UUID]struct{}
+}
+
+type backendStats struct {
+	BackendID   uuid.UUID      `json:"backend_id"`
+	BackendName string         `json:"backend_name"`
+	Users       int            `json:"users"`
+	PlayMethods map[string]int `json:"play_methods"`
+	playTotals
+	users map[uuid.UUID]struct{}
+}
+
+type clientStats struct {
+	Client string `json:"client"`
+	Users  int    `json:"users"`
+	playTotals
+	users map[uuid.UUID]struct{}
+}
+
+type dailyStats struct {
+	Date  string `json:"date"`
+	Users int    `json:"users"`
+	playTotals
+	users map[uuid.UUID]struct{}
+}
+
+// ── Handlers ──────────────────────────────────────────────────────────────────
+
+// UserStats handles GET /proxy/stats/users.
+// Returns the plays and hours watched of every user, most hours first.
+func (h *StatsHandler) UserStats(c *gin.Context) {
+	events, ok := h.events(c)
+	if !ok {
+		return
+	}
+	byUser := make(map[uuid.UUID]*userStats)
+	for _, e := range events {
+		s := byUser[e.UserID]
+		if s == nil {
+			s = &userStats{UserID: e.UserID}
+			byUser[e.UserID] = s
+		}
+		s.add(e)
+		if !e.StartedAt.Before(s.LastPlayed) {
+			s.LastPlayed = e.StartedAt
+			s.Username = e.Username // the latest name if it was changed
+		}
+	}
+	resp := make([]userStats, 0, len(byUser))
+	for _, s := range byUser {
+		s.round()
+		resp = append(resp, *s)
+	}
+	slices.SortFunc(resp, func(a, b userStats) int {
+		return cmp.Or(cmp.Compare(b.Hours, a.Hours), cmp.Compare(a.Username, b.Username))
+	})
+	c.JSON(http.StatusOK, resp)
+}
+
+// ItemStats handles GET /proxy/stats/items.
+// Returns the most played items, by plays and then hours. The limit param
+// sets how many (default 10).
+func (h *StatsHandler) ItemStats(c *gin.Context) {
+	events, ok := h.events(c)
+	if !ok {
+		return
+	}
+	limit := defaultTopItems
+	if v, err := strconv.Atoi(c.Query("limit")); err == nil && v > 0 {
+		limit = v
+	}
+	byItem := make(map[string]*itemStats)
+	for _, e := range events {
+		s := byItem[e.ItemID]
+		if s == nil {
+			s = &itemStats{ItemID: e.ItemID, users: make(map[uuid.UUID]struct{})}
+			byItem[e.ItemID] = s
+		}
+		s.add(e)
+		s.users[e.UserID] = struct{}{}
+		if e.ItemName != "" {
+			s.ItemName, s.ItemType = e.ItemName, e.ItemType
+		}
+	}
+	resp := make([]itemStats, 0, len(byItem))
+	for _, s := range byItem {
+		s.Users = len(s.users)
+		s.round()
+		resp = append(resp, *s)
+	}
+	slices.SortFunc(resp, func(a, b itemStats) int {
+		return cmp.Or(cmp.Compare(b.Plays, a.Plays), cmp.Compare(b.Hours, a.Hours), cmp.Compare(a.ItemName, b.ItemName))
+	})
+	c.JSON(http.StatusOK, resp[:min(limit, len(resp))])
+}
+
+// BackendStats handles GET /proxy/stats/backends.
+// Returns how much playback each backend served and how it was delivered
+// (DirectPlay, DirectStream or Transcode), busiest first.
+func (h *StatsHandler) BackendStats(c *gin.Context) {
+	events, ok := h.events(c)
+	if !ok {
+		return
+	}
+	byBackend := make(map[uuid.UUID]*backendStats)
+	for _, e := range events {
+		s := byBackend[e.BackendID]
+		if s == nil {
+			s = &backendStats{
+				BackendID:   e.BackendID,
+				PlayMethods: make(map[string]int),
+				users:       make(map[uuid.UUID]struct{}),
+			}
+			byBackend[e.BackendID] = s
+		}
+		s.add(e)
+		s.users[e.UserID] = struct{}{}
+		s.BackendName = e.BackendName
+		s.PlayMethods[fallback(e.PlayMethod, "Unknown")]++
+	}
+	resp := make([]backendStats, 0, len(byBackend))
+	for _, s := range byBackend {
+		s.Users = len(s.users)
+		s.round()
+		resp = append(resp, *s)
+	}
+	slices.SortFunc(resp, func(a, b backendStats) int {
+		return cmp.Or(cmp.Compare(b.Hours, a.Hours), cmp.Compare(a.BackendName, b.BackendName))
+	})
+	c.JSON(http.StatusOK, resp)
+}
+
+// ClientStats handles GET /proxy/stats/clients.
+// Returns the playback per client application, most plays first.
+func (h *StatsHandler) ClientStats(c *gin.Context) {
+	events, ok := h.events(c)
+	if !ok {
+		return
+	}
+	byClient := make(map[string]*clientStats)
+	for _, e := range events {
+		s := byClient[e.AppName]
+		if s == nil {
+			s = &clientStats{Client: e.AppName, users: make(map[uuid.UUID]struct{})}
+			byClient[e.AppName] = s
+		}
+		s.add(e)
+		s.users[e.UserID] = struct{}{}
+	}
+	resp := make([]clientStats, 0, len(byClient))
+	for _, s := range byClient {
+		s.Users = len(s.users)
+		s.round()
+		resp = append(resp, *s)
+	}
+	slices.SortFunc(resp, func(a, b clientStats) int {
+		return cmp.Or(cmp.Compare(b.Plays, a.Plays), cmp.Compare(a.Client, b.Client))
+	})
+	c.JSON(http.StatusOK, resp)
+}
+
+// DailyStats handles GET /proxy/stats/daily.
+// Returns the number of distinct users who played something on each day of
+// the window, oldest first, with days without playback included. Days follow
+// the server's time zone.
+func (h *StatsHandler) DailyStats(c *gin.Context) {
+	events, ok := h.events(c)
+	if !ok {
+		return
+	}
+	days := statsDays(c)
+	today := time.Now()
+	resp := make([]dailyStats, days)
+	index := make(map[string]*dailyStats, days)
+	for i := range resp {
+		date := today.AddDate(0, 0, i-days+1).Format(time.DateOnly)
+		resp[i] = dailyStats{Date: date, users: make(map[uuid.UUID]struct{})}
+		index[date] = &resp[i]
+	}
+	for _, e := range events {
+		s := index[e.StartedAt.Local().Format(time.DateOnly)]
+		if s == nil {
+			continue
+		}
+		s.add(e)
+		s.users[e.UserID] = struct{}{}
+	}
+	for i := range resp {
+		resp[i].Users = len(resp[i].users)
+		resp[i].round()
+	}
+	c.JSON(http.StatusOK, resp)
+}
+
+// events loads the playback events that started within the requested
+// window. It writes the error response itself and returns false on failure.
+func (h *StatsHandler) events(c *gin.Context) ([]*ent.PlaybackEvent, bool) {
+	now := time.Now()
+	since := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location()).
+		AddDate(0, 0, 1-statsDays(c))
+	events, err := h.db.PlaybackEvent.Query().
+		Where(entplaybackevent.StartedAtGTE(since)).
+		All(c.Request.Context())
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to load playback history"})
+		return nil, false
+	}
+	return events, true
+}
+
+// statsDays returns the window from the days query param, in whole days
+// including today.
+func statsDays(c *gin.Context) int {
+	days, err := strconv.Atoi(c.Query("days"))
+	if err != nil || days <= 0 {
+		return defaultStatsDays
+	}
+	return min(days, maxStatsDays)
+}
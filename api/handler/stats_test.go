@@ -0,0 +1,157 @@
+package handler_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"github.com/ddevcap/jellyfin-proxy/api/handler"
+)
+
+var _ = Describe("Playback statistics", func() {
+	var (
+		router       *gin.Engine
+		alice, bob   uuid.UUID
+		nas, seedbox uuid.UUID
+	)
+
+	// record inserts a finished playback that started daysAgo days ago and
+	// played for minutes minutes.
+	record := func(user uuid.UUID, backendID uuid.UUID, itemID, client, method string, daysAgo, minutes int) {
+		names := map[uuid.UUID]string{alice: "alice", bob: "bob", nas: "NAS", seedbox: "Seedbox"}
+		started := time.Now().AddDate(0, 0, -daysAgo)
+		db.PlaybackEvent.Create().
+			SetUserID(user).
+			SetUsername(names[user]).
+			SetSessionID(uuid.New()).
+			SetDeviceID("device-" + names[user]).
+			SetDeviceName("Device").
+			SetAppName(client).
+			SetItemID(itemID).
+			SetItemName("Title " + itemID).
+			SetItemType("Movie").
+			SetBackendID(backendID).
+			SetBackendName(names[backendID]).
+			SetPlayMethod(method).
+			SetStartedAt(started).
+			SetStoppedAt(started.Add(time.Duration(minutes) * time.Minute)).
+			SetPlayDuration(int64(minutes * 60)).
+			ExecX(context.Background())
+	}
+
+	get := func(path string, out interface{}) {
+		w := doGet(router, path)
+		Expect(w.Code).To(Equal(http.StatusOK))
+		Expect(json.Unmarshal(w.Body.Bytes(), out)).To(Succeed())
+	}
+
+	BeforeEach(func() {
+		cleanDB()
+		alice, bob = uuid.New(), uuid.New()
+		nas, seedbox = uuid.New(), uuid.New()
+
+		record(alice, nas, "s1_a", "Jellyfin Web", "DirectPlay", 0, 90)
+		record(alice, nas, "s1_b", "Jellyfin Web", "Transcode", 1, 30)
+		record(bob, nas, "s1_a", "Infuse", "DirectPlay", 1, 60)
+		record(bob, seedbox, "s2_c", "Infuse", "DirectStream", 2, 15)
+		record(bob, seedbox, "s2_d", "Infuse", "Transcode", 45, 600) // outside the default window
+
+		statsH := handler.NewStatsHandler(db)
+		router = gin.New()
+		router.GET("/proxy/stats/users", statsH.UserStats)
+		router.GET("/proxy/stats/items", statsH.ItemStats)
+		router.GET("/proxy/stats/backends", statsH.BackendStats)
+		router.GET("/proxy/stats/clients", statsH.ClientStats)
+		router.GET("/proxy/stats/daily", statsH.DailyStats)
+	})
+
+	It("reports hours watched per user within the window", func() {
+		var resp []struct {
+			Username string
+			Plays    int
+			Hours    float64
+		}
+		get("/proxy/stats/users", &resp)
+
+		Expect(resp).To(HaveLen(2))
+		Expect(resp[0].Username).To(Equal("alice"))
+		Expect(resp[0].Plays).To(Equal(2))
+		Expect(resp[0].Hours).To(Equal(2.0))
+		Expect(resp[1].Username).To(Equal("bob"))
+		Expect(resp[1].Hours).To(Equal(1.25))
+
+		get("/proxy/stats/users?days=60", &resp)
+		Expect(resp[0].Username).To(Equal("bob"))
+		Expect(resp[0].Plays).To(Equal(3))
+	})
+
+	It("ranks the most played items", func() {
+		var resp []struct {
+			ItemID   string `json:"item_id"`
+			ItemName string `json:"item_name"`
+			Plays    int
+			Users    int
+		}
+		get("/proxy/stats/items?limit=2", &resp)
+
+		Expect(resp).To(HaveLen(2))
+		Expect(resp[0].ItemID).To(Equal("s1_a"))
+		Expect(resp[0].ItemName).To(Equal("Title s1_a"))
+		Expect(resp[0].Plays).To(Equal(2))
+		Expect(resp[0].Users).To(Equal(2))
+	})
+
+	It("breaks down the load per backend and play method", func() {
+		var resp []struct {
+			BackendName string         `json:"backend_name"`
+			Plays       int            `json:"plays"`
+			Users       int            `json:"users"`
+			PlayMethods map[string]int `json:"play_methods"`
+		}
+		get("/proxy/stats/backends", &resp)
+
+		Expect(resp).To(HaveLen(2))
+		Expect(resp[0].BackendName).To(Equal("NAS"))
+		Expect(resp[0].Plays).To(Equal(3))
+		Expect(resp[0].Users).To(Equal(2))
+		Expect(resp[0].PlayMethods).To(Equal(map[string]int{"DirectPlay": 2, "Transcode": 1}))
+		Expect(resp[1].BackendName).To(Equal("Seedbox"))
+		Expect(resp[1].PlayMethods).To(Equal(map[string]int{"DirectStream": 1}))
+	})
+
+	It("breaks down playback per client", func() {
+		var resp []struct {
+			Client string `json:"client"`
+			Plays  int    `json:"plays"`
+			Users  int    `json:"users"`
+		}
+		get("/proxy/stats/clients", &resp)
+
+		Expect(resp).To(HaveLen(2))
+		Expect(resp[0].Client).To(Equal("Infuse"))
+		Expect(resp[0].Plays).To(Equal(2))
+		Expect(resp[1].Client).To(Equal("Jellyfin Web"))
+		Expect(resp[1].Users).To(Equal(1))
+	})
+
+	It("counts daily active users, including idle days", func() {
+		var resp []struct {
+			Date  string `json:"date"`
+			Users int    `json:"users"`
+			Plays int    `json:"plays"`
+		}
+		get("/proxy/stats/daily?days=4", &resp)
+
+		Expect(resp).To(HaveLen(4))
+		Expect(resp[3].Date).To(Equal(time.Now().Format(time.DateOnly)))
+		Expect([]int{resp[0].Users, resp[1].Users, resp[2].Users, resp[3].Users}).To(Equal([]int{0, 1, 2, 1}))
+		Expect(resp[2].Plays).To(Equal(2))
+	})
+})
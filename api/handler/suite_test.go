@@ -14,6 +14,7 @@ import (
 	. "github.com/onsi/gomega"
 	"golang.org/x/crypto/bcrypt"
 
+	"github.com/ddevcap/jellyfin-proxy/api/middleware"
 	"github.com/ddevcap/jellyfin-proxy/ent"
 	"github.com/ddevcap/jellyfin-proxy/ent/enttest"
 	_ "modernc.org/sqlite"
@@ -61,8 +62,18 @@ var _ = AfterSuite(func() {
 // BeforeEach so every spec starts from a blank slate.
 func cleanDB() {
 	ctx := context.Background()
+	db.ActivityLog.Delete().ExecX(ctx)
+	db.PlaybackEvent.Delete().ExecX(ctx)
+	db.Item.Delete().ExecX(ctx)
+	db.DuplicateItem.Delete().ExecX(ctx)
 	db.BackendUser.Delete().ExecX(ctx)
 	db.Session.Delete().ExecX(ctx)
+	db.ApiKey.Delete().ExecX(ctx)
+	db.AppPassword.Delete().ExecX(ctx)
+	db.RecoveryCode.Delete().ExecX(ctx)
+	db.DeviceOption.Delete().ExecX(ctx)
+	db.DisplayPreference.Delete().ExecX(ctx)
+	db.UserConfiguration.Delete().ExecX(ctx)
 	db.Backend.Delete().ExecX(ctx)
 	db.User.Delete().ExecX(ctx)
 }
@@ -110,10 +121,11 @@ func createBackendUser(backend *ent.Backend, user *ent.User, backendUserID strin
 	return bu
 }
 
-// createSession inserts a session for the given user with the supplied token.
+// createSession inserts a session for the given user with the supplied token,
+// stored hashed like the proxy stores it.
 func createSession(user *ent.User, token string) *ent.Session {
 	s, err := db.Session.Create().
-		SetToken(token).
+		SetToken(middleware.HashToken(token)).
 		SetDeviceID("test-device").
 		SetDeviceName("Test Device").
 		SetAppName("Test App").
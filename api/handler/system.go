@@ -1,15 +1,20 @@
 package handler
 
 import (
+	"encoding/binary"
+	"encoding/json"
 	"io"
 	"net/http"
 	"strconv"
+	"time"
 
 	"github.com/ddevcap/jellyfin-proxy/backend"
 	"github.com/ddevcap/jellyfin-proxy/config"
 	"github.com/ddevcap/jellyfin-proxy/ent"
+	entactivitylog "github.com/ddevcap/jellyfin-proxy/ent/activitylog"
 	"github.com/ddevcap/jellyfin-proxy/static"
 	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
 )
 
 type SystemHandler struct {
@@ -23,7 +28,12 @@ type SystemHandler struct {
 const jellyfinVersion = "10.11.6"
 
 func NewSystemHandler(cfg config.Config, db *ent.Client, pool *backend.Pool) *SystemHandler {
-	return &SystemHandler{cfg: cfg, db: db, pool: pool, displayPrefs: newDisplayPrefsStore()}
+	return &SystemHandler{cfg: cfg, db: db, pool: pool, displayPrefs: newDisplayPrefsStore(db)}
+}
+
+// ForgetUser drops what the handler cached about a deleted user.
+func (h *SystemHandler) ForgetUser(userID uuid.UUID) {
+	h.displayPrefs.forget(userID)
 }
 
 // InfoPublic handles GET /System/Info/Public.
@@ -85,9 +95,14 @@ func (h *SystemHandler) GetRepositories(c *gin.Context) {
 }
 
 // BrandingConfiguration handles GET /Branding/Configuration.
+// With SSO configured, the login disclaimer carries the sign-in button.
 func (h *SystemHandler) BrandingConfiguration(c *gin.Context) {
+	disclaimer := ""
+	if h.cfg.OIDCIssuerURL != "" {
+		disclaimer = `<a is="emby-linkbutton" class="raised block emby-button" href="/proxy/oidc/login">Sign in with SSO</a>`
+	}
 	c.JSON(http.StatusOK, gin.H{
-		"LoginDisclaimer":     "",
+		"LoginDisclaimer":     disclaimer,
 		"CustomCss":           static.BrandingCSS,
 		"SplashscreenEnabled": false,
 	})
@@ -110,14 +125,7 @@ func (h *SystemHandler) UsersPublic(c *gin.Context) {
 // QuickConnectEnabled handles GET /QuickConnect/Enabled.
 // Returns whether QuickConnect is enabled on this server.
 func (h *SystemHandler) QuickConnectEnabled(c *gin.Context) {
-	c.JSON(http.StatusOK, false)
-}
-
-// SessionCapabilitiesFull handles POST /Sessions/Capabilities/Full.
-// Clients call this after login to advertise what they can play/support.
-// We acknowledge the request and discard the body.
-func (h *SystemHandler) SessionCapabilitiesFull(c *gin.Context) {
-	c.Status(http.StatusNoContent)
+	c.JSON(http.StatusOK, h.cfg.QuickConnectEnabled)
 }
 
 // DisplayPreferencesGet handles GET /DisplayPreferences/{id}.
@@ -125,15 +133,13 @@ func (h *SystemHandler) SessionCapabilitiesFull(c *gin.Context) {
 // sensible defaults if nothing has been saved yet.
 func (h *SystemHandler) DisplayPreferencesGet(c *gin.Context) {
 	id := c.Param("id")
-	client := c.Query("client")
-	user := userFromCtx(c)
-	key := ""
-	if user != nil {
-		key = user.ID.String() + ":" + id + ":" + client
-	}
-
-	if key != "" {
-		if stored, ok := h.displayPrefs.get(key); ok {
+	if user := userFromCtx(c); user != nil {
+		stored, err := h.displayPrefs.get(c.Request.Context(), user.ID, id, c.Query("client"))
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to get display preferences"})
+			return
+		}
+		if stored != nil {
 			c.Data(http.StatusOK, "application/json", stored)
 			return
 		}
@@ -158,11 +164,9 @@ func (h *SystemHandler) DisplayPreferencesGet(c *gin.Context) {
 }
 
 // DisplayPreferencesUpdate handles POST /DisplayPreferences/{id}.
-// Stores the client's display preference payload so it survives across
-// page reloads within the same proxy session.
+// Stores the client's display preference payload in the database, so home
+// screen layout, sort orders and view types survive restarts.
 func (h *SystemHandler) DisplayPreferencesUpdate(c *gin.Context) {
-	id := c.Param("id")
-	client := c.Query("client")
 	user := userFromCtx(c)
 	if user == nil {
 		c.Status(http.StatusNoContent)
@@ -174,9 +178,15 @@ func (h *SystemHandler) DisplayPreferencesUpdate(c *gin.Context) {
 		c.Status(http.StatusNoContent)
 		return
 	}
+	if !json.Valid(body) {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid request body"})
+		return
+	}
 
-	key := user.ID.String() + ":" + id + ":" + client
-	h.displayPrefs.set(key, body)
+	if err := h.displayPrefs.set(c.Request.Context(), user.ID, c.Param("id"), c.Query("client"), body); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to save display preferences"})
+		return
+	}
 	c.Status(http.StatusNoContent)
 }
 
@@ -192,9 +202,69 @@ func (h *SystemHandler) GetEndpointInfo(c *gin.Context) {
 }
 
 // ActivityLogEntries handles GET /System/ActivityLog/Entries.
-// Returns an empty log — the proxy does not record activity.
+// Returns the proxy's activity log, newest first. MinDate and HasUserId
+// filter the entries; StartIndex and Limit page through them.
 func (h *SystemHandler) ActivityLogEntries(c *gin.Context) {
-	c.JSON(http.StatusOK, gin.H{"Items": []interface{}{}, "TotalRecordCount": 0, "StartIndex": 0})
+	q := h.db.ActivityLog.Query()
+	if s := queryParam(c, "minDate"); s != "" {
+		minDate, err := time.Parse(time.RFC3339, s)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid minDate"})
+			return
+		}
+		q.Where(entactivitylog.DateGTE(minDate))
+	}
+	if s := queryParam(c, "hasUserId"); s != "" {
+		hasUser, err := strconv.ParseBool(s)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid hasUserId"})
+			return
+		}
+		if hasUser {
+			q.Where(entactivitylog.UserIDNEQ(uuid.Nil))
+		} else {
+			q.Where(entactivitylog.UserIDEQ(uuid.Nil))
+		}
+	}
+
+	ctx := c.Request.Context()
+	total, err := q.Clone().Count(ctx)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to count activity log"})
+		return
+	}
+
+	startIndex, _ := strconv.Atoi(queryParam(c, "startIndex"))
+	if startIndex < 0 {
+		startIndex = 0
+	}
+	q.Order(ent.Desc(entactivitylog.FieldDate)).Offset(startIndex)
+	if limit, err := strconv.Atoi(queryParam(c, "limit")); err == nil && limit >= 0 {
+		q.Limit(limit)
+	}
+	entries, err := q.All(ctx)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to list activity log"})
+		return
+	}
+
+	items := make([]gin.H, len(entries))
+	for i, e := range entries {
+		items[i] = gin.H{
+			// Jellyfin clients expect a numeric ID; the top bits of the row
+			// UUID are unique enough and stay within a JS-safe integer.
+			"Id":            int64(binary.BigEndian.Uint64(e.ID[:8]) >> 11),
+			"Name":          e.Name,
+			"Overview":      e.Overview,
+			"ShortOverview": e.ShortOverview,
+			"Type":          e.EventType,
+			"ItemId":        e.ItemID,
+			"Date":          e.Date,
+			"UserId":        e.UserID,
+			"Severity":      e.Severity,
+		}
+	}
+	c.JSON(http.StatusOK, gin.H{"Items": items, "TotalRecordCount": total, "StartIndex": startIndex})
 }
 
 // InfoStorage handles GET /System/Info/Storage.
@@ -203,12 +273,6 @@ func (h *SystemHandler) InfoStorage(c *gin.Context) {
 	c.JSON(http.StatusOK, gin.H{"Drives": []interface{}{}})
 }
 
-// GetDevices handles GET /Devices.
-// Returns an empty list — device management is not supported cross-backend.
-func (h *SystemHandler) GetDevices(c *gin.Context) {
-	c.JSON(http.StatusOK, gin.H{"Items": []interface{}{}, "TotalRecordCount": 0, "StartIndex": 0})
-}
-
 // GetConfiguration handles GET /System/Configuration.
 // Returns a minimal config object so the admin UI renders without errors.
 // Flags that would expose unsupported multi-backend admin features are
@@ -220,7 +284,7 @@ func (h *SystemHandler) GetConfiguration(c *gin.Context) {
 		"EnableMetrics":                    false,
 		"EnableNormalizedItemByNameIds":    false,
 		"IsPortAuthorized":                 true,
-		"QuickConnectAvailable":            false,
+		"QuickConnectAvailable":            h.cfg.QuickConnectEnabled,
 		"EnableCaseSensitiveItemIds":       true,
 		"DisableLiveTvChannelUserDataName": true,
 		"MetadataPath":                     "",
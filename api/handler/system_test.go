@@ -1,14 +1,17 @@
 package handler_test
 
 import (
+	"context"
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
+	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 
 	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
 
 	"github.com/ddevcap/jellyfin-proxy/api/handler"
 	"github.com/ddevcap/jellyfin-proxy/config"
@@ -94,13 +97,14 @@ var _ = Describe("SystemHandler", func() {
 			Expect(json.Unmarshal(w.Body.Bytes(), &body)).To(Succeed())
 			Expect(body).To(BeFalse())
 		})
-	})
 
-	Describe("SessionCapabilitiesFull", func() {
-		It("returns 204", func() {
-			w := serve("POST", "/sessions/capabilities/full", h.SessionCapabilitiesFull, "/sessions/capabilities/full")
+		It("returns true when QuickConnect is switched on", func() {
+			h = handler.NewSystemHandler(config.Config{QuickConnectEnabled: true}, nil, nil)
+			w := serve("GET", "/quickconnect/enabled", h.QuickConnectEnabled, "/quickconnect/enabled")
 
-			Expect(w.Code).To(Equal(http.StatusNoContent))
+			var body bool
+			Expect(json.Unmarshal(w.Body.Bytes(), &body)).To(Succeed())
+			Expect(body).To(BeTrue())
 		})
 	})
 
@@ -144,14 +148,74 @@ var _ = Describe("SystemHandler", func() {
 	})
 
 	Describe("ActivityLogEntries", func() {
-		It("returns 200 with empty Items and zero TotalRecordCount", func() {
-			w := serve("GET", "/system/activitylog/entries", h.ActivityLogEntries, "/system/activitylog/entries")
+		var userID uuid.UUID
+
+		BeforeEach(func() {
+			cleanDB()
+			h = handler.NewSystemHandler(config.Config{}, db, nil)
+			userID = uuid.New()
+			ctx := context.Background()
+			base := time.Now().Add(-time.Hour)
+			for i, name := range []string{"old login", "backend down", "new login"} {
+				owner := userID
+				if name == "backend down" {
+					owner = uuid.Nil
+				}
+				db.ActivityLog.Create().
+					SetName(name).
+					SetEventType("Test").
+					SetUserID(owner).
+					SetDate(base.Add(time.Duration(i) * 20 * time.Minute)).
+					ExecX(ctx)
+			}
+		})
 
+		list := func(query string) (names []string, total int) {
+			w := serve("GET", "/system/activitylog/entries", h.ActivityLogEntries, "/system/activitylog/entries"+query)
 			Expect(w.Code).To(Equal(http.StatusOK))
-			var body map[string]interface{}
+			var body struct {
+				Items []struct {
+					Id     int64
+					Name   string
+					UserId uuid.UUID
+				}
+				TotalRecordCount int
+			}
 			Expect(json.Unmarshal(w.Body.Bytes(), &body)).To(Succeed())
-			Expect(body["TotalRecordCount"]).To(BeNumerically("==", 0))
-			Expect(body["Items"]).To(BeEmpty())
+			for _, it := range body.Items {
+				Expect(it.Id).To(BeNumerically(">", 0))
+				names = append(names, it.Name)
+			}
+			return names, body.TotalRecordCount
+		}
+
+		It("lists entries newest first", func() {
+			names, total := list("")
+			Expect(names).To(Equal([]string{"new login", "backend down", "old login"}))
+			Expect(total).To(Equal(3))
+		})
+
+		It("pages with StartIndex and Limit", func() {
+			names, total := list("?StartIndex=1&Limit=1")
+			Expect(names).To(Equal([]string{"backend down"}))
+			Expect(total).To(Equal(3))
+		})
+
+		It("filters by MinDate and HasUserId", func() {
+			minDate := time.Now().Add(-50 * time.Minute).UTC().Format(time.RFC3339)
+			names, total := list("?minDate=" + minDate)
+			Expect(names).To(Equal([]string{"new login", "backend down"}))
+			Expect(total).To(Equal(2))
+
+			names, _ = list("?hasUserId=true")
+			Expect(names).To(Equal([]string{"new login", "old login"}))
+			names, _ = list("?hasUserId=false")
+			Expect(names).To(Equal([]string{"backend down"}))
+		})
+
+		It("rejects a malformed MinDate", func() {
+			w := serve("GET", "/system/activitylog/entries", h.ActivityLogEntries, "/system/activitylog/entries?minDate=yesterday")
+			Expect(w.Code).To(Equal(http.StatusBadRequest))
 		})
 	})
 
@@ -166,17 +230,6 @@ var _ = Describe("SystemHandler", func() {
 		})
 	})
 
-	Describe("GetDevices", func() {
-		It("returns 200 with empty Items and zero TotalRecordCount", func() {
-			w := serve("GET", "/devices", h.GetDevices, "/devices")
-
-			Expect(w.Code).To(Equal(http.StatusOK))
-			var body map[string]interface{}
-			Expect(json.Unmarshal(w.Body.Bytes(), &body)).To(Succeed())
-			Expect(body["TotalRecordCount"]).To(BeNumerically("==", 0))
-		})
-	})
-
 	Describe("GetConfiguration", func() {
 		It("returns 200 with IsStartupWizardCompleted true", func() {
 			w := serve("GET", "/system/configuration", h.GetConfiguration, "/system/configuration")
@@ -0,0 +1,122 @@
+package handler_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/ddevcap/jellyfin-proxy/api/middleware"
+	"github.com/ddevcap/jellyfin-proxy/config"
+	"github.com/ddevcap/jellyfin-proxy/ent"
+	entsession "github.com/ddevcap/jellyfin-proxy/ent/session"
+	entuser "github.com/ddevcap/jellyfin-proxy/ent/user"
+)
+
+var _ = Describe("Trusted header authentication", func() {
+	var router *gin.Engine
+
+	const (
+		caddy  = "172.18.0.5:41000"
+		direct = "203.0.113.7:52000"
+	)
+
+	// whoami sends a request from peer with the given headers and returns
+	// the response; the body is the signed-in username.
+	whoami := func(peer string, headers map[string]string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, "/whoami", nil)
+		req.RemoteAddr = peer
+		for k, v := range headers {
+			req.Header.Set(k, v)
+		}
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		return w
+	}
+
+	BeforeEach(func() {
+		cleanDB()
+		cfg := config.Config{
+			TrustedAuthHeader:  "Remote-User",
+			TrustedAuthProxies: []string{"172.16.0.0/12"},
+			SessionTTL:         time.Hour,
+		}
+		router = gin.New()
+		router.GET("/whoami", middleware.Auth(db, cfg), func(c *gin.Context) {
+			user := c.MustGet(middleware.ContextKeyUser).(*ent.User)
+			c.String(http.StatusOK, user.Username)
+		})
+	})
+
+	It("creates the user and one implicit session per device", func() {
+		tv := map[string]string{
+			"Remote-User":   "frank",
+			"Authorization": `MediaBrowser Client="Jellyfin Web", Device="Firefox", DeviceId="browser-1", Version="10.11.6"`,
+		}
+		w := whoami(caddy, tv)
+		Expect(w.Code).To(Equal(http.StatusOK))
+		Expect(w.Body.String()).To(Equal("frank"))
+		Expect(whoami(caddy, tv).Code).To(Equal(http.StatusOK))
+
+		ctx := context.Background()
+		frank := db.User.Query().Where(entuser.Username("frank")).OnlyX(ctx)
+		session := frank.QuerySessions().OnlyX(ctx)
+		Expect(session.DeviceID).To(Equal("browser-1"))
+		Expect(session.DeviceName).To(Equal("Firefox"))
+	})
+
+	It("keeps users without a device ID apart", func() {
+		Expect(whoami(caddy, map[string]string{"Remote-User": "frank"}).Code).To(Equal(http.StatusOK))
+		Expect(whoami(caddy, map[string]string{"Remote-User": "grace"}).Code).To(Equal(http.StatusOK))
+
+		ctx := context.Background()
+		frank := db.User.Query().Where(entuser.Username("frank")).OnlyX(ctx)
+		grace := db.User.Query().Where(entuser.Username("grace")).OnlyX(ctx)
+		Expect(frank.QuerySessions().OnlyX(ctx).DeviceID).
+			NotTo(Equal(grace.QuerySessions().OnlyX(ctx).DeviceID))
+	})
+
+	It("starts a new session once the old one outlived SESSION_TTL", func() {
+		headers := map[string]string{
+			"Remote-User":   "frank",
+			"Authorization": `MediaBrowser Client="Jellyfin Web", Device="Firefox", DeviceId="browser-1", Version="10.11.6"`,
+		}
+		Expect(whoami(caddy, headers).Code).To(Equal(http.StatusOK))
+		ctx := context.Background()
+		old := db.Session.Query().OnlyX(ctx)
+		old.Update().SetLastActivity(time.Now().Add(-2 * time.Hour)).ExecX(ctx)
+
+		Expect(whoami(caddy, headers).Code).To(Equal(http.StatusOK))
+		sessions := db.Session.Query().AllX(ctx)
+		Expect(sessions).To(HaveLen(2))
+		fresh := db.Session.Query().Where(entsession.IDNEQ(old.ID)).OnlyX(ctx)
+		Expect(fresh.DeviceID).To(Equal("browser-1"))
+		Expect(fresh.LastActivity).To(BeTemporally("~", time.Now(), time.Minute))
+	})
+
+	It("ignores the header from untrusted peers, even with forwarding headers", func() {
+		w := whoami(direct, map[string]string{
+			"Remote-User":     "frank",
+			"X-Forwarded-For": "172.18.0.5",
+		})
+		Expect(w.Code).To(Equal(http.StatusUnauthorized))
+		Expect(db.User.Query().CountX(context.Background())).To(BeZero())
+	})
+
+	It("lets a token take precedence over the header", func() {
+		alice := createUser("alice", "password1!", false)
+		createSession(alice, "trusted-header-alice-token")
+
+		w := whoami(caddy, map[string]string{
+			"Remote-User":  "frank",
+			"X-Emby-Token": "trusted-header-alice-token",
+		})
+		Expect(w.Code).To(Equal(http.StatusOK))
+		Expect(w.Body.String()).To(Equal("alice"))
+	})
+})
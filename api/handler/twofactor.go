@@ -0,0 +1,330 @@
+package handler
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/ddevcap/jellyfin-proxy/activity"
+	"github.com/ddevcap/jellyfin-proxy/api/middleware"
+	"github.com/ddevcap/jellyfin-proxy/backend"
+	"github.com/ddevcap/jellyfin-proxy/ent"
+	entrecoverycode "github.com/ddevcap/jellyfin-proxy/ent/recoverycode"
+	entuser "github.com/ddevcap/jellyfin-proxy/ent/user"
+	"github.com/ddevcap/jellyfin-proxy/totp"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// ── Two-factor authentication ─────────────────────────────────────────────────
+//
+// Users may enrol an authenticator app from /proxy/me/2fa. Password logins of
+// enrolled users then take a second step: AuthenticateByName answers 401 with
+// TwoFactorRequired until the request carries a TotpCode, since Jellyfin's
+// Pw field has no room for one. Clients that cannot prompt for a code log in
+// with an app password instead. QuickConnect, SSO and trusted-header logins
+// are vouched for elsewhere and skip the step.
+
+// recoveryCodeCount is how many recovery codes are handed out at a time.
+const recoveryCodeCount = 10
+
+// secondFactor runs the TOTP step of a password login for user. It writes the
+// response and returns false when the login must not go on. A wrong code
+// counts as a failed login.
+func (h *AuthHandler) secondFactor(c *gin.Context, user *ent.User, code, ip string) bool {
+	if !user.TotpEnabled {
+		return true
+	}
+	if strings.TrimSpace(code) == "" {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Two-factor code required", "TwoFactorRequired": true})
+		return false
+	}
+	ok, err := h.verifySecondFactor(c.Request.Context(), user, code)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to verify two-factor code"})
+		return false
+	}
+	if !ok {
+		h.loginFailed(c, user.Username, user.ID, ip)
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid two-factor code", "TwoFactorRequired": true})
+		return false
+	}
+	return true
+}
+
+// verifySecondFactor reports whether code is a valid TOTP code or an unused
+// recovery code of user. Either is consumed: a TOTP code by recording its
+// time step, which later codes must be past, and a recovery code by deleting
+// it. Both are single conditional writes, so a code raced by two logins
+// passes only once.
+func (h *AuthHandler) verifySecondFactor(ctx context.Context, user *ent.User, code string) (bool, error) {
+	secret, err := h.totpSecret(user)
+	if err != nil {
+		return false, err
+	}
+	if secret != "" {
+		if step, ok := totp.Match(secret, code, time.Now()); ok {
+			n, err := h.db.User.Update().
+				Where(entuser.ID(user.ID), entuser.TotpLastStepLT(step)).
+				SetTotpLastStep(step).
+				Save(ctx)
+			return n == 1, err
+		}
+	}
+	n, err := h.db.RecoveryCode.Delete().
+		Where(
+			entrecoverycode.CodeHash(middleware.HashToken(totp.NormalizeRecoveryCode(code))),
+			entrecoverycode.HasUserWith(entuser.ID(user.ID)),
+		).
+		Exec(ctx)
+	return n > 0, err
+}
+
+// totpSecret returns the decrypted TOTP secret of user, or "" when enrolment
+// has not been started.
+func (h *AuthHandler) totpSecret(user *ent.User) (string, error) {
+	if user.TotpSecret == nil {
+		return "", nil
+	}
+	return backend.DecryptSecret(h.secrets, *user.TotpSecret)
+}
+
+// replaceRecoveryCodes deletes the recovery codes of user and returns a fresh
+// set. Only their hashes are stored.
+func (h *AuthHandler) replaceRecoveryCodes(ctx context.Context, user *ent.User) ([]string, error) {
+	codes, err := totp.GenerateRecoveryCodes(recoveryCodeCount)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := h.db.RecoveryCode.Delete().
+		Where(entrecoverycode.HasUserWith(entuser.ID(user.ID))).
+		Exec(ctx); err != nil {
+		return nil, err
+	}
+	builders := make([]*ent.RecoveryCodeCreate, len(codes))
+	for i, code := range codes {
+		builders[i] = h.db.RecoveryCode.Create().
+			SetCodeHash(middleware.HashToken(totp.NormalizeRecoveryCode(code))).
+			SetUser(user)
+	}
+	if _, err := h.db.RecoveryCode.CreateBulk(builders...).Save(ctx); err != nil {
+		return nil, err
+	}
+	return codes, nil
+}
+
+// resetTwoFactor turns two-factor authentication off for the user with
+// userID and deletes their secret and recovery codes.
+func resetTwoFactor(ctx context.Context, db *ent.Client, userID uuid.UUID) error {
+	if err := db.User.UpdateOneID(userID).
+		SetTotpEnabled(false).
+		ClearTotpSecret().
+		SetTotpLastStep(0).
+		Exec(ctx); err != nil {
+		return err
+	}
+	_, err := db.RecoveryCode.Delete().
+		Where(entrecoverycode.HasUserWith(entuser.ID(userID))).
+		Exec(ctx)
+	return err
+}
+
+// ── Self-service ──────────────────────────────────────────────────────────────
+
+type twoFactorCodeRequest struct {
+	Code string `json:"code" binding:"required"`
+}
+
+// GetTwoFactor handles GET /proxy/me/2fa.
+func (h *AuthHandler) GetTwoFactor(c *gin.Context) {
+	user := userFromCtx(c)
+	left, err := h.db.RecoveryCode.Query().
+		Where(entrecoverycode.HasUserWith(entuser.ID(user.ID))).
+		Count(c.Request.Context())
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to count recovery codes"})
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{
+		"enabled":             user.TotpEnabled,
+		"recovery_codes_left": left,
+	})
+}
+
+// BeginTwoFactor handles POST /proxy/me/2fa.
+// It generates a new secret and returns it with the otpauth:// URI to show
+// as a QR code. Two-factor authentication is only turned on once a code from
+// the app is confirmed, so starting over replaces an unconfirmed secret.
+func (h *AuthHandler) BeginTwoFactor(c *gin.Context) {
+	user := userFromCtx(c)
+	if user.TotpEnabled {
+		c.JSON(http.StatusConflict, gin.H{"error": "two-factor authentication is already enabled"})
+		return
+	}
+	secret, err := totp.GenerateSecret()
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to generate secret"})
+		return
+	}
+	if err := h.db.User.UpdateOne(user).SetTotpSecret(secret).Exec(c.Request.Context()); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to update user"})
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{
+		"secret":      secret,
+		"otpauth_uri": totp.KeyURI(h.cfg.ServerName, user.Username, secret),
+	})
+}
+
+// ConfirmTwoFactor handles POST /proxy/me/2fa/confirm.
+// A valid code from the enrolled app turns two-factor authentication on. The
+// response is the only time the recovery codes are returned.
+func (h *AuthHandler) ConfirmTwoFactor(c *gin.Context) {
+	var req twoFactorCodeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	user := userFromCtx(c)
+	if user.TotpEnabled {
+		c.JSON(http.StatusConflict, gin.H{"error": "two-factor authentication is already enabled"})
+		return
+	}
+	secret, err := h.totpSecret(user)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to read two-factor secret"})
+		return
+	}
+	if secret == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "two-factor enrolment has not been started"})
+		return
+	}
+	step, ok := totp.Match(secret, req.Code, time.Now())
+	if !ok {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid two-factor code"})
+		return
+	}
+
+	ctx := c.Request.Context()
+	if err := h.db.User.UpdateOne(user).SetTotpEnabled(true).SetTotpLastStep(step).Exec(ctx); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to update user"})
+		return
+	}
+	codes, err := h.replaceRecoveryCodes(ctx, user)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to create recovery codes"})
+		return
+	}
+	// Sessions opened before the second factor was required may have been
+	// stolen along with the password; only the one confirming it stays.
+	var keep []uuid.UUID
+	if cs := sessionFromCtx(c); cs != nil {
+		keep = append(keep, cs.ID)
+	}
+	if _, err := revokeUserSessions(ctx, h.db, h.hub, user.ID, keep...); err != nil {
+		slog.Warn("failed to revoke sessions after enabling two-factor authentication", "user", user.Username, "error", err)
+	}
+	activity.Record(ctx, h.db, activity.Entry{
+		Name:   "Two-factor authentication was enabled for user " + user.Username,
+		Type:   "TwoFactorEnabled",
+		UserID: user.ID,
+	})
+	c.JSON(http.StatusOK, gin.H{"recovery_codes": codes})
+}
+
+// DisableTwoFactor handles POST /proxy/me/2fa/disable.
+// The body carries a current code or a recovery code, so a stolen session
+// alone cannot turn the second factor off.
+func (h *AuthHandler) DisableTwoFactor(c *gin.Context) {
+	user, ok := h.bindTwoFactorChallenge(c)
+	if !ok {
+		return
+	}
+	ctx := c.Request.Context()
+	if err := resetTwoFactor(ctx, h.db, user.ID); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to disable two-factor authentication"})
+		return
+	}
+	activity.Record(ctx, h.db, activity.Entry{
+		Name:   "Two-factor authentication was disabled for user " + user.Username,
+		Type:   "TwoFactorDisabled",
+		UserID: user.ID,
+	})
+	c.Status(http.StatusNoContent)
+}
+
+// RegenerateRecoveryCodes handles POST /proxy/me/2fa/recovery-codes.
+// It replaces all recovery codes, for when they run low or may have leaked.
+func (h *AuthHandler) RegenerateRecoveryCodes(c *gin.Context) {
+	user, ok := h.bindTwoFactorChallenge(c)
+	if !ok {
+		return
+	}
+	codes, err := h.replaceRecoveryCodes(c.Request.Context(), user)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to create recovery codes"})
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"recovery_codes": codes})
+}
+
+// bindTwoFactorChallenge checks the code in the request body against the
+// calling user, whose two-factor authentication must be enabled. It writes
+// the response and returns false when the request must not go on.
+func (h *AuthHandler) bindTwoFactorChallenge(c *gin.Context) (*ent.User, bool) {
+	var req twoFactorCodeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return nil, false
+	}
+	user := userFromCtx(c)
+	if !user.TotpEnabled {
+		c.JSON(http.StatusConflict, gin.H{"error": "two-factor authentication is not enabled"})
+		return nil, false
+	}
+	ok, err := h.verifySecondFactor(c.Request.Context(), user, req.Code)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to verify two-factor code"})
+		return nil, false
+	}
+	if !ok {
+		c.JSON(http.StatusForbidden, gin.H{"error": "Invalid two-factor code"})
+		return nil, false
+	}
+	return user, true
+}
+
+// ── Admin ─────────────────────────────────────────────────────────────────────
+
+// ResetTwoFactor handles DELETE /proxy/users/:id/2fa.
+// It turns two-factor authentication off for a user who lost both their
+// authenticator and recovery codes.
+func (h *ProxyUserHandler) ResetTwoFactor(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid user ID"})
+		return
+	}
+	ctx := c.Request.Context()
+	user, err := h.db.User.Get(ctx, id)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			c.JSON(http.StatusNotFound, gin.H{"error": "user not found"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to get user"})
+		return
+	}
+	if err := resetTwoFactor(ctx, h.db, user.ID); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to reset two-factor authentication"})
+		return
+	}
+	recordAdminActivity(c, h.db, activity.Entry{
+		Name:   "Two-factor authentication was reset for user " + user.Username,
+		Type:   "TwoFactorDisabled",
+		UserID: user.ID,
+	})
+	c.Status(http.StatusNoContent)
+}
@@ -0,0 +1,306 @@
+package handler_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"github.com/ddevcap/jellyfin-proxy/api/handler"
+	"github.com/ddevcap/jellyfin-proxy/api/middleware"
+	"github.com/ddevcap/jellyfin-proxy/config"
+	"github.com/ddevcap/jellyfin-proxy/ent"
+	entsession "github.com/ddevcap/jellyfin-proxy/ent/session"
+	"github.com/ddevcap/jellyfin-proxy/totp"
+)
+
+var _ = Describe("Two-factor authentication", func() {
+	var (
+		router *gin.Engine
+		alice  *ent.User
+	)
+
+	const secret = "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ"
+	aliceHdr := map[string]string{"X-Emby-Token": "2fa-alice-token"}
+
+	testCfg := config.Config{
+		ServerID:               "test-server-id",
+		ServerName:             "Test Proxy",
+		AccountLockoutAttempts: 5,
+		AccountLockoutDuration: time.Hour,
+	}
+
+	// codeAt returns alice's TOTP code for the time step of t.
+	codeAt := func(t time.Time) string {
+		code, err := totp.Code(secret, totp.Step(t))
+		Expect(err).NotTo(HaveOccurred())
+		return code
+	}
+
+	enrol := func(u *ent.User) {
+		db.User.UpdateOne(u).SetTotpSecret(secret).SetTotpEnabled(true).ExecX(context.Background())
+	}
+
+	login := func(body map[string]string) *httptest.ResponseRecorder {
+		return doPost(router, "/Users/AuthenticateByName", body)
+	}
+
+	decode := func(w *httptest.ResponseRecorder) map[string]interface{} {
+		var resp map[string]interface{}
+		Expect(json.Unmarshal(w.Body.Bytes(), &resp)).To(Succeed())
+		return resp
+	}
+
+	BeforeEach(func() {
+		cleanDB()
+		gin.SetMode(gin.TestMode)
+		router = gin.New()
+		h := handler.NewAuthHandler(db, testCfg, func(string) {}, func(string) {})
+		router.POST("/Users/AuthenticateByName", h.AuthenticateByName)
+		me := router.Group("/proxy/me")
+		me.Use(middleware.Auth(db, testCfg))
+		me.GET("/2fa", h.GetTwoFactor)
+		me.POST("/2fa", h.BeginTwoFactor)
+		me.POST("/2fa/confirm", h.ConfirmTwoFactor)
+		me.POST("/2fa/disable", h.DisableTwoFactor)
+		me.POST("/2fa/recovery-codes", h.RegenerateRecoveryCodes)
+		me.GET("/app-passwords", h.ListAppPasswords)
+		me.POST("/app-passwords", h.CreateAppPassword)
+		me.DELETE("/app-passwords/:id", h.DeleteAppPassword)
+		uh := handler.NewProxyUserHandler(db)
+		router.DELETE("/proxy/users/:id/2fa", uh.ResetTwoFactor)
+		router.DELETE("/proxy/users/:id", uh.DeleteUser)
+
+		alice = createUser("alice", "correctpass1", false)
+		createSession(alice, "2fa-alice-token")
+	})
+
+	// ── Login ─────────────────────────────────────────────────────────────────
+
+	Describe("login", func() {
+		BeforeEach(func() { enrol(alice) })
+
+		It("asks for a code after a correct password", func() {
+			w := login(map[string]string{"Username": "alice", "Pw": "correctpass1"})
+			Expect(w.Code).To(Equal(http.StatusUnauthorized))
+			Expect(decode(w)["TwoFactorRequired"]).To(BeTrue())
+			Expect(db.User.GetX(context.Background(), alice.ID).FailedLoginCount).To(BeZero())
+		})
+
+		It("does not ask for a code after a wrong password", func() {
+			w := login(map[string]string{"Username": "alice", "Pw": "wrongpass", "TotpCode": codeAt(time.Now())})
+			Expect(w.Code).To(Equal(http.StatusUnauthorized))
+			Expect(decode(w)).NotTo(HaveKey("TwoFactorRequired"))
+		})
+
+		It("logs in with a valid code, which cannot be used again", func() {
+			code := codeAt(time.Now())
+			w := login(map[string]string{"Username": "alice", "Pw": "correctpass1", "TotpCode": code})
+			Expect(w.Code).To(Equal(http.StatusOK))
+			Expect(decode(w)["AccessToken"]).NotTo(BeEmpty())
+
+			w = login(map[string]string{"Username": "alice", "Pw": "correctpass1", "TotpCode": code})
+			Expect(w.Code).To(Equal(http.StatusUnauthorized))
+		})
+
+		It("counts a wrong code as a failed login", func() {
+			w := login(map[string]string{"Username": "alice", "Pw": "correctpass1", "TotpCode": "000000"})
+			Expect(w.Code).To(Equal(http.StatusUnauthorized))
+			Expect(decode(w)["TwoFactorRequired"]).To(BeTrue())
+			Expect(db.User.GetX(context.Background(), alice.ID).FailedLoginCount).To(Equal(1))
+		})
+	})
+
+	// ── Enrolment ─────────────────────────────────────────────────────────────
+
+	Describe("enrolment", func() {
+		It("enables two-factor authentication once a code is confirmed", func() {
+			w := doPost(router, "/proxy/me/2fa", nil, aliceHdr)
+			Expect(w.Code).To(Equal(http.StatusOK))
+			var begin struct {
+				Secret     string `json:"secret"`
+				OtpauthURI string `json:"otpauth_uri"`
+			}
+			Expect(json.Unmarshal(w.Body.Bytes(), &begin)).To(Succeed())
+			Expect(begin.OtpauthURI).To(HavePrefix("otpauth://totp/Test%20Proxy:alice?"))
+			Expect(db.User.GetX(context.Background(), alice.ID).TotpEnabled).To(BeFalse())
+
+			code, err := totp.Code(begin.Secret, totp.Step(time.Now()))
+			Expect(err).NotTo(HaveOccurred())
+			w = doPost(router, "/proxy/me/2fa/confirm", map[string]string{"code": code}, aliceHdr)
+			Expect(w.Code).To(Equal(http.StatusOK))
+			var confirm struct {
+				RecoveryCodes []string `json:"recovery_codes"`
+			}
+			Expect(json.Unmarshal(w.Body.Bytes(), &confirm)).To(Succeed())
+			Expect(confirm.RecoveryCodes).To(HaveLen(10))
+
+			Expect(db.User.GetX(context.Background(), alice.ID).TotpEnabled).To(BeTrue())
+			w = doGet(router, "/proxy/me/2fa", aliceHdr)
+			Expect(w.Body.String()).To(MatchJSON(`{"enabled": true, "recovery_codes_left": 10}`))
+		})
+
+		It("revokes the user's other sessions once enabled", func() {
+			createSession(alice, "2fa-alice-phone")
+
+			w := doPost(router, "/proxy/me/2fa", nil, aliceHdr)
+			Expect(w.Code).To(Equal(http.StatusOK))
+			var begin struct {
+				Secret string `json:"secret"`
+			}
+			Expect(json.Unmarshal(w.Body.Bytes(), &begin)).To(Succeed())
+			code, err := totp.Code(begin.Secret, totp.Step(time.Now()))
+			Expect(err).NotTo(HaveOccurred())
+			w = doPost(router, "/proxy/me/2fa/confirm", map[string]string{"code": code}, aliceHdr)
+			Expect(w.Code).To(Equal(http.StatusOK))
+
+			Expect(doGet(router, "/proxy/me/2fa", aliceHdr).Code).To(Equal(http.StatusOK))
+			Expect(doGet(router, "/proxy/me/2fa", map[string]string{"X-Emby-Token": "2fa-alice-phone"}).Code).
+				To(Equal(http.StatusUnauthorized))
+		})
+
+		It("rejects a wrong confirmation code", func() {
+			doPost(router, "/proxy/me/2fa", nil, aliceHdr)
+
+			w := doPost(router, "/proxy/me/2fa/confirm", map[string]string{"code": "000000"}, aliceHdr)
+			Expect(w.Code).To(Equal(http.StatusBadRequest))
+			Expect(db.User.GetX(context.Background(), alice.ID).TotpEnabled).To(BeFalse())
+		})
+
+		It("returns 409 when two-factor authentication is already enabled", func() {
+			enrol(alice)
+
+			w := doPost(router, "/proxy/me/2fa", nil, aliceHdr)
+			Expect(w.Code).To(Equal(http.StatusConflict))
+		})
+	})
+
+	// ── Recovery codes ────────────────────────────────────────────────────────
+
+	Describe("recovery codes", func() {
+		var codes []string
+
+		BeforeEach(func() {
+			enrol(alice)
+			w := doPost(router, "/proxy/me/2fa/recovery-codes", map[string]string{"code": codeAt(time.Now())}, aliceHdr)
+			Expect(w.Code).To(Equal(http.StatusOK))
+			var resp struct {
+				RecoveryCodes []string `json:"recovery_codes"`
+			}
+			Expect(json.Unmarshal(w.Body.Bytes(), &resp)).To(Succeed())
+			codes = resp.RecoveryCodes
+		})
+
+		It("stand in for a code once each", func() {
+			w := login(map[string]string{"Username": "alice", "Pw": "correctpass1", "TotpCode": codes[0]})
+			Expect(w.Code).To(Equal(http.StatusOK))
+
+			w = login(map[string]string{"Username": "alice", "Pw": "correctpass1", "TotpCode": codes[0]})
+			Expect(w.Code).To(Equal(http.StatusUnauthorized))
+
+			w = doGet(router, "/proxy/me/2fa", aliceHdr)
+			Expect(w.Body.String()).To(MatchJSON(`{"enabled": true, "recovery_codes_left": 9}`))
+		})
+
+		It("turn two-factor authentication off", func() {
+			w := doPost(router, "/proxy/me/2fa/disable", map[string]string{"code": codes[1]}, aliceHdr)
+			Expect(w.Code).To(Equal(http.StatusNoContent))
+
+			u := db.User.GetX(context.Background(), alice.ID)
+			Expect(u.TotpEnabled).To(BeFalse())
+			Expect(u.TotpSecret).To(BeNil())
+			Expect(db.RecoveryCode.Query().CountX(context.Background())).To(BeZero())
+			w = login(map[string]string{"Username": "alice", "Pw": "correctpass1"})
+			Expect(w.Code).To(Equal(http.StatusOK))
+		})
+	})
+
+	It("refuses to disable two-factor authentication without a valid code", func() {
+		enrol(alice)
+
+		w := doPost(router, "/proxy/me/2fa/disable", map[string]string{"code": "000000"}, aliceHdr)
+		Expect(w.Code).To(Equal(http.StatusForbidden))
+		Expect(db.User.GetX(context.Background(), alice.ID).TotpEnabled).To(BeTrue())
+	})
+
+	It("lets an admin reset two-factor authentication", func() {
+		enrol(alice)
+
+		w := doDelete(router, "/proxy/users/"+alice.ID.String()+"/2fa")
+		Expect(w.Code).To(Equal(http.StatusNoContent))
+		Expect(db.User.GetX(context.Background(), alice.ID).TotpEnabled).To(BeFalse())
+	})
+
+	// ── App passwords ─────────────────────────────────────────────────────────
+
+	Describe("app passwords", func() {
+		var created struct {
+			ID       string `json:"id"`
+			Password string `json:"password"`
+		}
+
+		BeforeEach(func() {
+			enrol(alice)
+			w := doPost(router, "/proxy/me/app-passwords", map[string]string{"name": "Living room TV"}, aliceHdr)
+			Expect(w.Code).To(Equal(http.StatusCreated))
+			Expect(json.Unmarshal(w.Body.Bytes(), &created)).To(Succeed())
+			Expect(created.Password).To(MatchRegexp(`^[a-z2-7]{4}(-[a-z2-7]{4}){3}$`))
+		})
+
+		It("log in without a code", func() {
+			w := login(map[string]string{"Username": "alice", "Pw": created.Password})
+			Expect(w.Code).To(Equal(http.StatusOK))
+
+			ap := db.AppPassword.GetX(context.Background(), uuid.MustParse(created.ID))
+			Expect(ap.LastUsedAt).NotTo(BeNil())
+		})
+
+		It("are listed without the password", func() {
+			w := doGet(router, "/proxy/me/app-passwords", aliceHdr)
+			Expect(w.Code).To(Equal(http.StatusOK))
+			Expect(w.Body.String()).To(ContainSubstring("Living room TV"))
+			Expect(w.Body.String()).NotTo(ContainSubstring(created.Password))
+		})
+
+		It("log their device out when deleted", func() {
+			w := login(map[string]string{"Username": "alice", "Pw": created.Password})
+			Expect(w.Code).To(Equal(http.StatusOK))
+			Expect(db.Session.Query().Where(entsession.HasAppPassword()).CountX(context.Background())).To(Equal(1))
+
+			w = doDelete(router, "/proxy/me/app-passwords/"+created.ID, aliceHdr)
+			Expect(w.Code).To(Equal(http.StatusNoContent))
+			Expect(db.Session.Query().Where(entsession.HasAppPassword()).CountX(context.Background())).To(BeZero())
+			Expect(db.Session.Query().CountX(context.Background())).To(Equal(1))
+
+			w = login(map[string]string{"Username": "alice", "Pw": created.Password})
+			Expect(w.Code).To(Equal(http.StatusUnauthorized))
+		})
+
+		It("cannot be deleted by another user", func() {
+			bob := createUser("bob", "bobspass1", false)
+			createSession(bob, "2fa-bob-token")
+
+			w := doDelete(router, "/proxy/me/app-passwords/"+created.ID, map[string]string{"X-Emby-Token": "2fa-bob-token"})
+			Expect(w.Code).To(Equal(http.StatusNotFound))
+		})
+
+		It("are deleted with their user, along with the recovery codes", func() {
+			ctx := context.Background()
+			db.RecoveryCode.Create().SetCodeHash("hash").SetUser(alice).ExecX(ctx)
+			// Leave only the rows two-factor authentication added.
+			db.Session.Delete().ExecX(ctx)
+
+			w := doDelete(router, "/proxy/users/"+alice.ID.String())
+			Expect(w.Code).To(Equal(http.StatusNoContent))
+			Expect(db.AppPassword.Query().CountX(ctx)).To(BeZero())
+			Expect(db.RecoveryCode.Query().CountX(ctx)).To(BeZero())
+		})
+	})
+})
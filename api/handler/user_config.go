@@ -0,0 +1,113 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/ddevcap/jellyfin-proxy/ent"
+	entuserconfiguration "github.com/ddevcap/jellyfin-proxy/ent/userconfiguration"
+	"github.com/google/uuid"
+	"github.com/jellydator/ttlcache/v3"
+)
+
+// userConfigStore keeps each user's UserConfiguration in the database, with
+// a write-through cache for the user objects built on every login and page.
+type userConfigStore struct {
+	db    *ent.Client
+	cache *ttlcache.Cache[string, json.RawMessage]
+}
+
+func newUserConfigStore(db *ent.Client) *userConfigStore {
+	return &userConfigStore{db: db, cache: newPrefsCache()}
+}
+
+// get returns the user's stored configuration, or nil when none was saved.
+func (s *userConfigStore) get(ctx context.Context, userID uuid.UUID) (json.RawMessage, error) {
+	if item := s.cache.Get(userID.String()); item != nil {
+		return item.Value(), nil
+	}
+	data, err := loadUserConfiguration(ctx, s.db, userID)
+	if err != nil {
+		return nil, err
+	}
+	s.cache.Set(userID.String(), data, ttlcache.DefaultTTL)
+	return data, nil
+}
+
+// getMany returns the stored configurations of the users with userIDs,
+// loading the ones not cached in a single query. Users without one are
+// left out.
+func (s *userConfigStore) getMany(ctx context.Context, userIDs []uuid.UUID) (map[uuid.UUID]json.RawMessage, error) {
+	out := make(map[uuid.UUID]json.RawMessage, len(userIDs))
+	var missing []uuid.UUID
+	for _, id := range userIDs {
+		if item := s.cache.Get(id.String()); item != nil {
+			if item.Value() != nil {
+				out[id] = item.Value()
+			}
+			continue
+		}
+		missing = append(missing, id)
+	}
+	if len(missing) == 0 {
+		return out, nil
+	}
+	stored, err := s.db.UserConfiguration.Query().
+		Where(entuserconfiguration.UserIDIn(missing...)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, uc := range stored {
+		out[uc.UserID] = uc.Data
+	}
+	for _, id := range missing {
+		s.cache.Set(id.String(), out[id], ttlcache.DefaultTTL)
+	}
+	return out, nil
+}
+
+// forget drops the cached configuration of the user with userID.
+func (s *userConfigStore) forget(userID uuid.UUID) {
+	s.cache.Delete(userID.String())
+}
+
+// loadUserConfiguration reads the user's stored configuration from the
+// database, bypassing any cache. It returns nil when none was saved.
+func loadUserConfiguration(ctx context.Context, db *ent.Client, userID uuid.UUID) (json.RawMessage, error) {
+	uc, err := db.UserConfiguration.Query().
+		Where(entuserconfiguration.UserID(userID)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return uc.Data, nil
+}
+
+// set stores the user's configuration, replacing the earlier one.
+func (s *userConfigStore) set(ctx context.Context, userID uuid.UUID, data json.RawMessage) error {
+	update := func() (int, error) {
+		return s.db.UserConfiguration.Update().
+			Where(entuserconfiguration.UserID(userID)).
+			SetData(data).
+			Save(ctx)
+	}
+	n, err := update()
+	if err == nil && n == 0 {
+		err = s.db.UserConfiguration.Create().
+			SetUserID(userID).
+			SetData(data).
+			Exec(ctx)
+		if ent.IsConstraintError(err) {
+			_, err = update()
+		}
+	}
+	if err != nil {
+		return err
+	}
+	s.cache.Set(userID.String(), data, ttlcache.DefaultTTL)
+	return nil
+}
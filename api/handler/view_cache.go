@@ -2,8 +2,10 @@ package handler
 
 import (
 	"encoding/json"
+	"strings"
 	"time"
 
+	"github.com/google/uuid"
 	"github.com/jellydator/ttlcache/v3"
 )
 
@@ -22,3 +24,14 @@ func newViewCache() *ttlcache.Cache[string, []json.RawMessage] {
 	go cache.Start() // starts the automatic expired-item eviction loop
 	return cache
 }
+
+// deleteUserKeys removes the entries of a per-user cache whose key starts
+// with userID, such as the ones keyed by rulesKey.
+func deleteUserKeys[V any](cache *ttlcache.Cache[string, V], userID uuid.UUID) {
+	prefix := userID.String()
+	for _, key := range cache.Keys() {
+		if strings.HasPrefix(key, prefix) {
+			cache.Delete(key)
+		}
+	}
+}
@@ -17,7 +17,7 @@ import (
 const syncWatchStateTimeout = 10 * time.Second
 
 // syncWatchState propagates a played/favorite action to all other backends
-// the user has access to. It fetches the item's ProviderIds (TMDB, IMDB)
+// the user has access to. It fetches the item's ProviderIds (TMDB, IMDB, TVDB)
 // from the source backend, searches each other backend for a matching item,
 // and applies the same action.
 //
@@ -49,17 +49,9 @@ func (h *MediaHandler) syncWatchState(
 		return // no provider IDs — can't match across backends
 	}
 
-	// Pick the best ID for matching: prefer TMDB, fall back to IMDB.
-	matchParam := ""
-	matchValue := ""
-	if v, ok := item.ProviderIds["Tmdb"]; ok && v != "" {
-		matchParam = "HasTmdbId"
-		matchValue = v
-	} else if v, ok := item.ProviderIds["Imdb"]; ok && v != "" {
-		matchParam = "HasImdbId"
-		matchValue = v
-	}
-	if matchParam == "" {
+	// Pick the best ID for matching: TMDB, then IMDB, then TVDB.
+	matchProvider, matchValue := preferredProviderID(item.ProviderIds)
+	if matchProvider == "" {
 		return
 	}
 
@@ -77,11 +69,7 @@ func (h *MediaHandler) syncWatchState(
 			q := url.Values{}
 			q.Set("Recursive", "true")
 			q.Set("IncludeItemTypes", item.Type)
-			if matchParam == "HasTmdbId" {
-				q.Set("HasTmdbId", "true")
-			} else {
-				q.Set("HasImdbId", "true")
-			}
+			q.Set("Has"+matchProvider+"Id", "true")
 			q.Set("Fields", "ProviderIds")
 			q.Set("Limit", "50")
 
@@ -108,14 +96,7 @@ func (h *MediaHandler) syncWatchState(
 					continue
 				}
 
-				matched := false
-				if matchParam == "HasTmdbId" {
-					matched = candidate.ProviderIds["Tmdb"] == matchValue
-				} else {
-					matched = candidate.ProviderIds["Imdb"] == matchValue
-				}
-
-				if !matched {
+				if candidate.ProviderIds[matchProvider] != matchValue {
 					continue
 				}
 
@@ -0,0 +1,49 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/ddevcap/jellyfin-proxy/activity"
+	"github.com/ddevcap/jellyfin-proxy/ent"
+	entapikey "github.com/ddevcap/jellyfin-proxy/ent/apikey"
+)
+
+// ContextKeyAPIKey holds the *ent.ApiKey a request authenticated with.
+// Requests authenticated by API key have no session.
+const ContextKeyAPIKey = "api_key"
+
+// ErrAPIKeyExpired is returned by LookupAPIKey for a key past its expiry.
+var ErrAPIKeyExpired = errors.New("API key expired")
+
+// LookupAPIKey returns the API key matching token, with its owner loaded,
+// and records that it was used, both on the key and, at most every five
+// minutes per key, in the activity log. It returns a not-found error when
+// token is not an API key.
+func LookupAPIKey(ctx context.Context, db *ent.Client, token string) (*ent.ApiKey, error) {
+	key, err := db.ApiKey.Query().
+		Where(entapikey.KeyHash(HashToken(token))).
+		WithUser().
+		Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if key.ExpiresAt != nil && time.Now().After(*key.ExpiresAt) {
+		return nil, ErrAPIKeyExpired
+	}
+	// Debounced like session activity.
+	if key.LastUsedAt == nil || time.Since(*key.LastUsedAt) > 5*time.Minute {
+		_ = key.Update().SetLastUsedAt(time.Now()).Exec(ctx)
+		e := activity.Entry{
+			Name: "API key " + key.Name + " was used",
+			Type: "ApiKeyUsed",
+		}
+		if u := key.Edges.User; u != nil {
+			e.Name += " by user " + u.Username
+			e.UserID = u.ID
+		}
+		activity.Record(ctx, db, e)
+	}
+	return key, nil
+}
@@ -1,6 +1,9 @@
 package middleware
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
 	"net/http"
 	"regexp"
 	"time"
@@ -53,6 +56,14 @@ func ExtractToken(c *gin.Context) string {
 	return c.Query("ApiKey")
 }
 
+// HashToken returns the hex SHA-256 of a session token or API key, which is
+// what the database stores in place of the token. Tokens are random, so a
+// plain hash is enough and keeps lookups a single indexed query.
+func HashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
 // ExtractAllTokens returns every candidate auth token from the request.
 // Headers are returned first (highest priority), followed by all api_key and
 // ApiKey query parameter values. This is needed on public streaming routes
@@ -79,19 +90,64 @@ func ExtractAllTokens(c *gin.Context) []string {
 // Auth validates the Jellyfin token on every protected request, loads the
 // associated user, and stores both in the gin context for downstream handlers.
 // If cfg.SessionTTL > 0 sessions that have been idle longer than the TTL are
-// rejected and deleted automatically.
+// rejected and deleted automatically. A token that is not a session is tried
+// as an API key, which SessionTTL does not apply to. Users that are disabled,
+// or connect remotely without remote access, are refused (see
+// CheckUserPolicy).
+//
+// With cfg.TrustedAuthHeader set, a request without a token that comes from
+// a trusted proxy is signed in as the user named in that header instead.
+// A token always takes precedence, so API keys and token clients behave the
+// same either way.
 func Auth(db *ent.Client, cfg config.Config) gin.HandlerFunc {
+	trusted := newTrustedHeaderAuth(db, cfg)
 	return func(c *gin.Context) {
 		token := ExtractToken(c)
+		if token == "" && trusted != nil {
+			if username := trusted.username(c.Request); username != "" {
+				session, err := trusted.session(c, username)
+				if err != nil {
+					c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": "failed to sign in trusted user"})
+					return
+				}
+				if abortIfDenied(c, session.Edges.User) {
+					return
+				}
+				touchSession(c, session)
+				c.Set(ContextKeyUser, session.Edges.User)
+				c.Set(ContextKeySession, session)
+				c.Next()
+				return
+			}
+		}
 		if token == "" {
 			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
 			return
 		}
 
 		session, err := db.Session.Query().
-			Where(entsession.Token(token)).
+			Where(entsession.Token(HashToken(token))).
 			WithUser().
 			Only(c.Request.Context())
+		if ent.IsNotFound(err) {
+			// Not a session token; API keys are accepted from the same places.
+			key, err := LookupAPIKey(c.Request.Context(), db, token)
+			if errors.Is(err, ErrAPIKeyExpired) {
+				c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "API key expired"})
+				return
+			}
+			if err != nil {
+				c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+				return
+			}
+			if abortIfDenied(c, key.Edges.User) {
+				return
+			}
+			c.Set(ContextKeyUser, key.Edges.User)
+			c.Set(ContextKeyAPIKey, key)
+			c.Next()
+			return
+		}
 		if err != nil {
 			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
 			return
@@ -105,14 +161,21 @@ func Auth(db *ent.Client, cfg config.Config) gin.HandlerFunc {
 			return
 		}
 
-		// Debounce last-activity updates to avoid a DB write on every request.
-		// Only update if the last recorded activity was more than 5 minutes ago.
-		if time.Since(session.LastActivity) > 5*time.Minute {
-			_ = session.Update().SetLastActivity(time.Now()).Exec(c.Request.Context())
+		if abortIfDenied(c, session.Edges.User) {
+			return
 		}
-
+		touchSession(c, session)
 		c.Set(ContextKeyUser, session.Edges.User)
 		c.Set(ContextKeySession, session)
 		c.Next()
 	}
 }
+
+// touchSession records activity on session. Updates are debounced to avoid
+// a DB write on every request: only when the last recorded activity was more
+// than 5 minutes ago.
+func touchSession(c *gin.Context, session *ent.Session) {
+	if time.Since(session.LastActivity) > 5*time.Minute {
+		_ = session.Update().SetLastActivity(time.Now()).Exec(c.Request.Context())
+	}
+}
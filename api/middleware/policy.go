@@ -0,0 +1,55 @@
+package middleware
+
+import (
+	"errors"
+	"net/http"
+	"net/netip"
+
+	"github.com/ddevcap/jellyfin-proxy/ent"
+	"github.com/gin-gonic/gin"
+)
+
+var (
+	// ErrUserDisabled is returned by CheckUserPolicy for a disabled account.
+	ErrUserDisabled = errors.New("user account is disabled")
+	// ErrRemoteAccessDenied is returned by CheckUserPolicy when a user
+	// without remote access connects from outside the local network.
+	ErrRemoteAccessDenied = errors.New("remote access is disabled for this user")
+)
+
+// IsLocalAddress reports whether ip is a loopback, private or link-local
+// address, which is how the proxy tells local clients from remote ones.
+func IsLocalAddress(ip string) bool {
+	addr, err := netip.ParseAddr(ip)
+	if err != nil {
+		return false
+	}
+	addr = addr.Unmap()
+	return addr.IsLoopback() || addr.IsPrivate() || addr.IsLinkLocalUnicast()
+}
+
+// CheckUserPolicy returns why user may not use the server from the client
+// of c, or nil when they may.
+func CheckUserPolicy(c *gin.Context, user *ent.User) error {
+	if user.IsDisabled {
+		return ErrUserDisabled
+	}
+	if !user.EnableRemoteAccess && !IsLocalAddress(ClientIP(c)) {
+		return ErrRemoteAccessDenied
+	}
+	return nil
+}
+
+// abortIfDenied aborts the request when CheckUserPolicy refuses user.
+// Disabled accounts get 401 so clients drop their session; remote access is
+// refused with 403, as the session stays valid on the local network.
+func abortIfDenied(c *gin.Context, user *ent.User) bool {
+	err := CheckUserPolicy(c, user)
+	switch {
+	case errors.Is(err, ErrUserDisabled):
+		c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+	case err != nil:
+		c.AbortWithStatusJSON(http.StatusForbidden, gin.H{"error": err.Error()})
+	}
+	return err != nil
+}
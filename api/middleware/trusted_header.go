@@ -0,0 +1,174 @@
+package middleware
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"log/slog"
+	"net/http"
+	"net/netip"
+	"slices"
+	"time"
+
+	"github.com/ddevcap/jellyfin-proxy/activity"
+	"github.com/ddevcap/jellyfin-proxy/config"
+	"github.com/ddevcap/jellyfin-proxy/ent"
+	entsession "github.com/ddevcap/jellyfin-proxy/ent/session"
+	entuser "github.com/ddevcap/jellyfin-proxy/ent/user"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// trustedHeaderAuth signs in requests that a forward-auth reverse proxy
+// (e.g. Caddy with Authelia) has already authenticated, using the username
+// it passes in a header. Each user gets an implicit session per device, so
+// the rest of the proxy sees them like any logged-in client. Like any other
+// session it ends after SessionTTL of inactivity; the next request then
+// starts a new one.
+type trustedHeaderAuth struct {
+	db       *ent.Client
+	header   string
+	networks []netip.Prefix
+	ttl      time.Duration // cfg.SessionTTL; 0 keeps sessions forever
+}
+
+// newTrustedHeaderAuth returns nil when header authentication is disabled.
+func newTrustedHeaderAuth(db *ent.Client, cfg config.Config) *trustedHeaderAuth {
+	if cfg.TrustedAuthHeader == "" {
+		return nil
+	}
+	networks, err := cfg.TrustedAuthNetworks()
+	if err != nil || len(networks) == 0 {
+		// config.Load refuses this, so only a hand-built Config gets here.
+		slog.Error("trusted header auth disabled: no valid TRUSTED_AUTH_PROXIES", "error", err)
+		return nil
+	}
+	return &trustedHeaderAuth{db: db, header: cfg.TrustedAuthHeader, networks: networks, ttl: cfg.SessionTTL}
+}
+
+// username returns the user named by the trusted header, or "" when the
+// header is absent or the request did not come straight from a trusted
+// proxy. Only the TCP peer address counts: X-Forwarded-For and friends are
+// client-controlled and would let anyone claim to be the reverse proxy.
+func (t *trustedHeaderAuth) username(r *http.Request) string {
+	name := r.Header.Get(t.header)
+	if name == "" {
+		return ""
+	}
+	peer, err := netip.ParseAddrPort(r.RemoteAddr)
+	if err != nil {
+		return ""
+	}
+	addr := peer.Addr().Unmap()
+	if !slices.ContainsFunc(t.networks, func(p netip.Prefix) bool { return p.Contains(addr) }) {
+		return ""
+	}
+	return name
+}
+
+// session returns the implicit session of username on the calling device,
+// creating the user and the session as needed. Sessions idle for longer than
+// the TTL are not reused; the session cleaner deletes them.
+func (t *trustedHeaderAuth) session(c *gin.Context, username string) (*ent.Session, error) {
+	ctx := c.Request.Context()
+	authParams := ParseMediaBrowserAuth(c.GetHeader("Authorization"))
+	if len(authParams) == 0 {
+		authParams = ParseMediaBrowserAuth(c.GetHeader("X-Emby-Authorization"))
+	}
+	deviceID := authParams["DeviceId"]
+	if deviceID == "" {
+		deviceID = c.Query("deviceId")
+	}
+	if deviceID == "" {
+		// Keep clients that send no device ID apart per user, so that
+		// device-wide actions such as deleting the device only affect them.
+		deviceID = "trusted-header-" + username
+	}
+
+	q := t.db.Session.Query().
+		Where(
+			entsession.DeviceID(deviceID),
+			entsession.HasUserWith(entuser.Username(username)),
+		)
+	if t.ttl > 0 {
+		q.Where(entsession.LastActivityGT(time.Now().Add(-t.ttl)))
+	}
+	session, err := q.
+		Order(ent.Desc(entsession.FieldLastActivity)).
+		WithUser().
+		First(ctx)
+	if err == nil || !ent.IsNotFound(err) {
+		return session, err
+	}
+
+	user, err := t.user(c, username)
+	if err != nil {
+		return nil, err
+	}
+	deviceName := authParams["Device"]
+	if deviceName == "" {
+		deviceName = "Unknown Device"
+	}
+	appName := authParams["Client"]
+	if appName == "" {
+		appName = "Unknown"
+	}
+	session, err = t.db.Session.Create().
+		SetToken(HashToken(uuid.New().String())).
+		SetDeviceID(deviceID).
+		SetDeviceName(deviceName).
+		SetAppName(appName).
+		SetUser(user).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	session.Edges.User = user
+	activity.Record(ctx, t.db, activity.Entry{
+		Name:          user.Username + " is online from " + deviceName,
+		Type:          "SessionStarted",
+		ShortOverview: appName,
+		UserID:        user.ID,
+	})
+	return session, nil
+}
+
+// user returns the user named username, creating it on first sight. The new
+// account gets a random password nobody knows, so it can only sign in
+// through the reverse proxy until an admin sets one.
+func (t *trustedHeaderAuth) user(c *gin.Context, username string) (*ent.User, error) {
+	ctx := c.Request.Context()
+	user, err := t.db.User.Query().Where(entuser.Username(username)).Only(ctx)
+	if err == nil || !ent.IsNotFound(err) {
+		return user, err
+	}
+
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		return nil, err
+	}
+	// The password is never used, so the cheapest cost will do.
+	hash, err := bcrypt.GenerateFromPassword([]byte(hex.EncodeToString(secret)), bcrypt.MinCost)
+	if err != nil {
+		return nil, err
+	}
+	user, err = t.db.User.Create().
+		SetUsername(username).
+		SetDisplayName(username).
+		SetHashedPassword(string(hash)).
+		Save(ctx)
+	if ent.IsConstraintError(err) {
+		// A concurrent request created the user first.
+		return t.db.User.Query().Where(entuser.Username(username)).Only(ctx)
+	}
+	if err != nil {
+		return nil, err
+	}
+	activity.Record(ctx, t.db, activity.Entry{
+		Name:          "User " + user.Username + " has been created",
+		Type:          "UserCreated",
+		ShortOverview: "By trusted header " + t.header,
+		UserID:        user.ID,
+	})
+	return user, nil
+}
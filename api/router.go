@@ -55,15 +55,28 @@ func NewRouter(db *ent.Client, cfg config.Config, pool *backend.Pool, wsHub *han
 	loginMW, onFail, onSuccess, stopLimiter := middleware.LoginRateLimiter(cfg)
 
 	authH := handler.NewAuthHandler(db, cfg, onFail, onSuccess)
+	authH.SetWSHub(wsHub)
+	authH.SetTokenKeyring(pool.TokenKeyring())
 	systemH := handler.NewSystemHandler(cfg, db, pool)
 	mediaH := handler.NewMediaHandler(pool, cfg, db)
+	playing := handler.NewPlaybackRegistry(db)
+	mediaH.SetPlaybackRegistry(playing)
+	mediaH.SetWSHub(wsHub)
 	proxyUserH := handler.NewProxyUserHandler(db)
+	proxyUserH.SetWSHub(wsHub)
+	proxyUserH.OnUserDeleted(mediaH.ForgetUser)
+	proxyUserH.OnUserDeleted(systemH.ForgetUser)
 	backendH := handler.NewBackendHandler(db)
 	avatarH := handler.NewAvatarHandler(db)
+	statsH := handler.NewStatsHandler(db)
+	sessionH := handler.NewSessionHandler(db, cfg, wsHub, playing)
+	apiKeyH := handler.NewAPIKeyHandler(db, cfg)
+	apiKeyH.SetWSHub(wsHub)
+	deviceH := handler.NewDeviceHandler(db, wsHub)
 
 	// Jellyfin clients may prefix all routes with /emby or /jellyfin.
 	for _, base := range []string{"", "/emby", "/jellyfin"} {
-		registerRoutes(r, base, db, cfg, loginMW, authH, systemH, mediaH, avatarH)
+		registerRoutes(r, base, db, cfg, loginMW, authH, systemH, mediaH, avatarH, sessionH, apiKeyH, deviceH)
 	}
 
 	// Proxy admin API — not prefixed with /emby or /jellyfin.
@@ -74,7 +87,11 @@ func NewRouter(db *ent.Client, cfg config.Config, pool *backend.Pool, wsHub *han
 		admin.GET("/users", proxyUserH.ListUsers)
 		admin.GET("/users/:id", proxyUserH.GetProxyUser)
 		admin.GET("/users/:id/backends", proxyUserH.GetUserBackends)
+		admin.GET("/users/:id/access", proxyUserH.GetUserAccess)
+		admin.PUT("/users/:id/access", proxyUserH.SetUserAccess)
 		admin.PATCH("/users/:id", proxyUserH.UpdateUser)
+		admin.POST("/users/:id/unlock", proxyUserH.UnlockUser)
+		admin.DELETE("/users/:id/2fa", proxyUserH.ResetTwoFactor)
 		admin.DELETE("/users/:id", proxyUserH.DeleteUser)
 
 		admin.POST("/backends", backendH.CreateBackend)
@@ -90,6 +107,26 @@ func NewRouter(db *ent.Client, cfg config.Config, pool *backend.Pool, wsHub *han
 		admin.PATCH("/backends/:id/users/:mappingId", backendH.UpdateBackendUser)
 		admin.DELETE("/backends/:id/users/:mappingId", backendH.DeleteBackendUser)
 
+		// Long-lived API keys for scripts.
+		admin.POST("/apikeys", apiKeyH.CreateAPIKey)
+		admin.GET("/apikeys", apiKeyH.ListAPIKeys)
+		admin.DELETE("/apikeys/:id", apiKeyH.DeleteAPIKey)
+
+		// Login sessions: list and revoke.
+		admin.GET("/sessions", sessionH.ListSessions)
+		admin.DELETE("/sessions", sessionH.RevokeSessions)
+		admin.DELETE("/sessions/:id", sessionH.RevokeSession)
+
+		// Live streams across all users and backends.
+		admin.GET("/sessions/active", sessionH.ActivePlayback)
+
+		// Watch statistics from the recorded playback history.
+		admin.GET("/stats/users", statsH.UserStats)
+		admin.GET("/stats/items", statsH.ItemStats)
+		admin.GET("/stats/backends", statsH.BackendStats)
+		admin.GET("/stats/clients", statsH.ClientStats)
+		admin.GET("/stats/daily", statsH.DailyStats)
+
 		// Backend health status — shows availability from the health checker.
 		admin.GET("/backends/health", func(c *gin.Context) {
 			hc := pool.GetHealthChecker()
@@ -101,6 +138,30 @@ func NewRouter(db *ent.Client, cfg config.Config, pool *backend.Pool, wsHub *han
 		})
 	}
 
+	// Self-service for any signed-in user.
+	me := r.Group("/proxy/me")
+	me.Use(middleware.Auth(db, cfg))
+	{
+		me.GET("/sessions", sessionH.ListMySessions)
+		me.DELETE("/sessions", sessionH.RevokeMySessions)
+		me.DELETE("/sessions/:id", sessionH.RevokeMySession)
+		me.GET("/2fa", authH.GetTwoFactor)
+		me.POST("/2fa", authH.BeginTwoFactor)
+		me.POST("/2fa/confirm", authH.ConfirmTwoFactor)
+		me.POST("/2fa/disable", authH.DisableTwoFactor)
+		me.POST("/2fa/recovery-codes", authH.RegenerateRecoveryCodes)
+		me.GET("/app-passwords", authH.ListAppPasswords)
+		me.POST("/app-passwords", authH.CreateAppPassword)
+		me.DELETE("/app-passwords/:id", authH.DeleteAppPassword)
+	}
+
+	// Single sign-on through an OpenID Connect provider.
+	if cfg.OIDCIssuerURL != "" {
+		oidcH := handler.NewOIDCHandler(db, cfg)
+		r.GET("/proxy/oidc/login", oidcH.Login)
+		r.GET("/proxy/oidc/callback", oidcH.Callback)
+	}
+
 	// WebSocket — requires valid session token via api_key query param.
 	r.GET("/socket", middleware.Auth(db, cfg), handler.WebSocketHandler(wsHub))
 
@@ -128,6 +189,9 @@ func registerRoutes(
 	systemH *handler.SystemHandler,
 	mediaH *handler.MediaHandler,
 	avatarH *handler.AvatarHandler,
+	sessionH *handler.SessionHandler,
+	apiKeyH *handler.APIKeyHandler,
+	deviceH *handler.DeviceHandler,
 ) {
 	// --- Public (no auth required) ---
 	pub := r.Group(base)
@@ -138,6 +202,12 @@ func registerRoutes(
 		pub.GET("/branding/configuration", systemH.BrandingConfiguration)
 		pub.GET("/branding/css", systemH.BrandingCss)
 		pub.GET("/quickconnect/enabled", systemH.QuickConnectEnabled)
+		pub.POST("/quickconnect/initiate", authH.QuickConnectInitiate)
+		pub.GET("/quickconnect/initiate", authH.QuickConnectInitiate)
+		pub.GET("/quickconnect/connect", authH.QuickConnectConnect)
+		pub.POST("/users/authenticatewithquickconnect", loginMW, authH.AuthenticateWithQuickConnect)
+		pub.POST("/users/forgotpassword", loginMW, authH.ForgotPassword)
+		pub.POST("/users/forgotpassword/pin", loginMW, authH.ForgotPasswordPin)
 		pub.GET("/playback/bitratetest", systemH.BitrateTest)
 
 		pub.GET("/items/:itemId/images/:imageType", mediaH.GetImage)
@@ -160,8 +230,15 @@ func registerRoutes(
 		// Session
 		priv.DELETE("/sessions/logout", authH.Logout)
 		priv.POST("/sessions/logout", authH.Logout)
-		priv.GET("/sessions", mediaH.GetSessions)
-		priv.POST("/sessions/capabilities/full", systemH.SessionCapabilitiesFull)
+		priv.POST("/quickconnect/authorize", authH.QuickConnectAuthorize)
+		priv.GET("/sessions", sessionH.GetSessions)
+		priv.POST("/sessions/capabilities", sessionH.PostCapabilities)
+		priv.POST("/sessions/capabilities/full", sessionH.PostCapabilitiesFull)
+		priv.POST("/sessions/:sessionId/playing", sessionH.Play)
+		priv.POST("/sessions/:sessionId/playing/:command", sessionH.Playstate)
+		priv.POST("/sessions/:sessionId/command", sessionH.GeneralCommand)
+		priv.POST("/sessions/:sessionId/command/:command", sessionH.NamedCommand)
+		priv.POST("/sessions/:sessionId/message", sessionH.DisplayMessage)
 		priv.POST("/sessions/playing", mediaH.ReportPlaybackStart)
 		priv.POST("/sessions/playing/progress", mediaH.ReportPlaybackProgress)
 		priv.POST("/sessions/playing/stopped", mediaH.ReportPlaybackStopped)
@@ -170,7 +247,12 @@ func registerRoutes(
 		priv.GET("/system/info", systemH.Info)
 		priv.GET("/system/endpoint", systemH.GetEndpointInfo)
 		priv.GET("/system/info/storage", systemH.InfoStorage)
-		priv.GET("/system/activitylog/entries", systemH.ActivityLogEntries)
+		priv.GET("/system/activitylog/entries", middleware.AdminOnly(), systemH.ActivityLogEntries)
+
+		// API keys
+		priv.GET("/auth/keys", middleware.AdminOnly(), apiKeyH.GetAuthKeys)
+		priv.POST("/auth/keys", middleware.AdminOnly(), apiKeyH.CreateAuthKey)
+		priv.DELETE("/auth/keys/:key", middleware.AdminOnly(), apiKeyH.RevokeAuthKey)
 		priv.GET("/system/configuration", systemH.GetConfiguration)
 		priv.GET("/system/configuration/network", systemH.GetConfigurationNetwork)
 		priv.GET("/system/logs", systemH.GetSystemLogs)
@@ -180,7 +262,11 @@ func registerRoutes(
 		priv.GET("/scheduledtasks", mediaH.GetScheduledTasks)
 		priv.GET("/plugins", mediaH.GetInstalledPlugins)
 		priv.GET("/notifications/summary", mediaH.GetNotificationsSummary)
-		priv.GET("/devices", systemH.GetDevices)
+		priv.GET("/devices", middleware.AdminOnly(), deviceH.GetDevices)
+		priv.DELETE("/devices", middleware.AdminOnly(), deviceH.DeleteDevice)
+		priv.GET("/devices/info", middleware.AdminOnly(), deviceH.GetDeviceInfo)
+		priv.GET("/devices/options", middleware.AdminOnly(), deviceH.GetDeviceOptions)
+		priv.POST("/devices/options", middleware.AdminOnly(), deviceH.UpdateDeviceOptions)
 		priv.GET("/localization/options", systemH.GetLocalizationOptions)
 		priv.GET("/localization/cultures", systemH.GetLocalizationCultures)
 		priv.GET("/localization/countries", systemH.GetLocalizationCountries)
@@ -202,6 +288,7 @@ func registerRoutes(
 		priv.DELETE("/users/:userId/playeditems/:itemId", mediaH.UnmarkPlayed)
 		priv.POST("/users/:userId/items/:itemId/rating", mediaH.UpdateUserItemRating)
 		priv.POST("/users/:userId/password", authH.UpdatePassword)
+		priv.POST("/users/configuration", mediaH.UpdateUserConfiguration)
 		priv.POST("/users/:userId/configuration", mediaH.UpdateUserConfiguration)
 		priv.POST("/users/:userId/policy", mediaH.UpdateUserPolicy)
 		priv.GET("/userviews", mediaH.GetUserViews)
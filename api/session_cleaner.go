@@ -5,6 +5,7 @@ import (
 	"log/slog"
 	"time"
 
+	"github.com/ddevcap/jellyfin-proxy/api/handler"
 	"github.com/ddevcap/jellyfin-proxy/config"
 	"github.com/ddevcap/jellyfin-proxy/ent"
 	entsession "github.com/ddevcap/jellyfin-proxy/ent/session"
@@ -12,19 +13,22 @@ import (
 
 // SessionCleaner periodically deletes expired sessions from the database.
 // This prevents the session table from growing unbounded when clients don't
-// explicitly log out.
+// explicitly log out. The hub, if any, closes their WebSockets and drops the
+// state other handlers keep for them.
 type SessionCleaner struct {
 	db     *ent.Client
 	cfg    config.Config
+	hub    *handler.WSHub
 	cancel context.CancelFunc
 	done   chan struct{}
 }
 
-// NewSessionCleaner creates a cleaner that runs every hour.
-func NewSessionCleaner(db *ent.Client, cfg config.Config) *SessionCleaner {
+// NewSessionCleaner creates a cleaner that runs every hour. hub may be nil.
+func NewSessionCleaner(db *ent.Client, cfg config.Config, hub *handler.WSHub) *SessionCleaner {
 	return &SessionCleaner{
 		db:   db,
 		cfg:  cfg,
+		hub:  hub,
 		done: make(chan struct{}),
 	}
 }
@@ -61,13 +65,26 @@ func (sc *SessionCleaner) cleanup(ctx context.Context) {
 		return // no TTL configured, nothing to clean
 	}
 	cutoff := time.Now().Add(-sc.cfg.SessionTTL)
-	n, err := sc.db.Session.Delete().
+	ids, err := sc.db.Session.Query().
 		Where(entsession.LastActivityLT(cutoff)).
+		IDs(ctx)
+	if err != nil {
+		slog.Warn("session cleanup failed", "error", err)
+		return
+	}
+	if len(ids) == 0 {
+		return
+	}
+	n, err := sc.db.Session.Delete().
+		Where(entsession.IDIn(ids...)).
 		Exec(ctx)
 	if err != nil {
 		slog.Warn("session cleanup failed", "error", err)
 		return
 	}
+	if sc.hub != nil {
+		sc.hub.CloseSessions(ids...)
+	}
 	if n > 0 {
 		slog.Info("expired sessions cleaned up", "count", n)
 	}
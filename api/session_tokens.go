@@ -0,0 +1,38 @@
+package api
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/ddevcap/jellyfin-proxy/api/middleware"
+	"github.com/ddevcap/jellyfin-proxy/ent"
+	entsession "github.com/ddevcap/jellyfin-proxy/ent/session"
+)
+
+// HashLegacySessionTokens replaces session tokens stored in plaintext by
+// earlier versions with their hash, so existing clients stay logged in. It is
+// a no-op once every token is hashed, so it is safe to call on every startup.
+func HashLegacySessionTokens(ctx context.Context, db *ent.Client) {
+	// Plaintext tokens are UUIDs; a hex hash never contains a dash.
+	legacy, err := db.Session.Query().
+		Where(entsession.TokenContains("-")).
+		All(ctx)
+	if err != nil {
+		slog.Error("failed to find plaintext session tokens", "error", err)
+		return
+	}
+	hashed := 0
+	for _, s := range legacy {
+		err := db.Session.UpdateOne(s).
+			SetToken(middleware.HashToken(s.Token)).
+			Exec(ctx)
+		if err != nil {
+			slog.Error("failed to hash session token", "session", s.ID, "error", err)
+			continue
+		}
+		hashed++
+	}
+	if hashed > 0 {
+		slog.Info("hashed plaintext session tokens", "count", hashed)
+	}
+}
@@ -0,0 +1,40 @@
+package api_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/ddevcap/jellyfin-proxy/api"
+	"github.com/ddevcap/jellyfin-proxy/api/middleware"
+)
+
+var _ = Describe("HashLegacySessionTokens", func() {
+	It("hashes plaintext tokens once and leaves hashed ones alone", func() {
+		ctx := context.Background()
+		cleanDB()
+		user := db.User.Create().
+			SetUsername("legacy").
+			SetDisplayName("legacy").
+			SetHashedPassword("x").
+			SaveX(ctx)
+		const plain = "8d1f7c2e-4b7a-4f51-9a0e-2c3d4e5f6a7b"
+		old := db.Session.Create().
+			SetToken(plain).
+			SetDeviceID("d1").SetDeviceName("TV").SetAppName("app").
+			SetUser(user).
+			SaveX(ctx)
+		current := db.Session.Create().
+			SetToken(middleware.HashToken("other-token")).
+			SetDeviceID("d2").SetDeviceName("Phone").SetAppName("app").
+			SetUser(user).
+			SaveX(ctx)
+
+		api.HashLegacySessionTokens(ctx, db)
+		api.HashLegacySessionTokens(ctx, db)
+
+		Expect(db.Session.GetX(ctx, old.ID).Token).To(Equal(middleware.HashToken(plain)))
+		Expect(db.Session.GetX(ctx, current.ID).Token).To(Equal(middleware.HashToken("other-token")))
+	})
+})
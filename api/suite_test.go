@@ -43,6 +43,7 @@ var _ = AfterSuite(func() {
 
 func cleanDB() {
 	ctx := context.Background()
+	db.Item.Delete().ExecX(ctx)
 	db.BackendUser.Delete().ExecX(ctx)
 	db.Session.Delete().ExecX(ctx)
 	db.Backend.Delete().ExecX(ctx)
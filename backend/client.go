@@ -28,9 +28,20 @@ type ServerClient struct {
 // Prefix returns the backend's short prefix string (e.g. "s1").
 func (sc *ServerClient) Prefix() string { return sc.backend.Prefix }
 
+// BackendID returns the backend's database ID as a string, the key used by
+// the health checker and the indexer.
+func (sc *ServerClient) BackendID() string { return sc.backend.ID.String() }
+
+// BackendName returns the backend's display name.
+func (sc *ServerClient) BackendName() string { return sc.backend.Name }
+
 // BackendUserID returns the user's ID on the backend server.
 func (sc *ServerClient) BackendUserID() string { return sc.backendUserID }
 
+// Available reports whether the health checker currently considers this
+// backend reachable.
+func (sc *ServerClient) Available() bool { return sc.pool.isAvailable(sc.backend.ID.String()) }
+
 // ServerURL returns the backend server's base URL (e.g. "http://nas:8096").
 func (sc *ServerClient) ServerURL() string { return strings.TrimRight(sc.backend.URL, "/") }
 
@@ -74,6 +85,7 @@ func (sc *ServerClient) ProxyJSON(ctx context.Context, method, path string, quer
 	}
 
 	resp, err := sc.pool.jsonClient.Do(req)
+	sc.recordOutcome(ctx, err)
 	if err != nil {
 		return nil, 0, fmt.Errorf("backend request to %s failed: %w", sc.backend.Name, err)
 	}
@@ -88,12 +100,7 @@ func (sc *ServerClient) ProxyJSON(ctx context.Context, method, path string, quer
 		return raw, resp.StatusCode, nil
 	}
 
-	bi := &idtrans.BackendInfo{
-		ID:   sc.backend.ID.String(),
-		Name: sc.backend.Name,
-		URL:  sc.backend.URL,
-	}
-	translated, err := idtrans.RewriteResponse(raw, sc.backend.Prefix, sc.pool.cfg.ServerID, bi)
+	translated, err := idtrans.RewriteResponse(raw, sc.backend.Prefix, sc.pool.cfg.ServerID, sc.backendInfo())
 	if err != nil {
 		// Non-JSON body (e.g. an image accidentally routed here): pass through.
 		return raw, resp.StatusCode, nil
@@ -110,6 +117,7 @@ func (sc *ServerClient) ProxyRaw(ctx context.Context, method, path string, query
 		return nil, 0, err
 	}
 	resp, err := sc.pool.streamClient.Do(req)
+	sc.recordOutcome(ctx, err)
 	if err != nil {
 		return nil, 0, fmt.Errorf("backend request to %s failed: %w", sc.backend.Name, err)
 	}
@@ -140,6 +148,7 @@ func (sc *ServerClient) ProxyStream(ctx context.Context, method, path string, qu
 	req.Header.Set("Accept-Encoding", "identity")
 
 	resp, err := sc.pool.streamClient.Do(req)
+	sc.recordOutcome(ctx, err)
 	if err != nil {
 		return fmt.Errorf("backend stream request to %s failed: %w", sc.backend.Name, err)
 	}
@@ -175,6 +184,31 @@ func (sc *ServerClient) ProxyStream(ctx context.Context, method, path string, qu
 	}
 }
 
+// recordOutcome feeds the result of a request to the health checker's
+// circuit breaker. Only network-level failures, timeouts included, count
+// against the backend; requests cancelled by the client say nothing about it
+// and are ignored.
+func (sc *ServerClient) recordOutcome(ctx context.Context, err error) {
+	hc := sc.pool.health
+	if hc == nil || errors.Is(ctx.Err(), context.Canceled) {
+		return
+	}
+	if err != nil {
+		hc.RecordRequestFailure(sc.BackendID(), sc.backend.Name)
+	} else {
+		hc.RecordRequestSuccess(sc.BackendID(), sc.backend.Name)
+	}
+}
+
+// backendInfo returns the backend metadata injected into rewritten items.
+func (sc *ServerClient) backendInfo() *idtrans.BackendInfo {
+	return &idtrans.BackendInfo{
+		ID:   sc.backend.ID.String(),
+		Name: sc.backend.Name,
+		URL:  sc.backend.URL,
+	}
+}
+
 // newRequest builds an authenticated HTTP request for the backend server.
 // If body is non-nil its item IDs are stripped of the proxy prefix before
 // sending, and any UserId field is replaced with the backend user ID.
@@ -9,6 +9,7 @@ import (
 	"sync"
 	"time"
 
+	"github.com/ddevcap/jellyfin-proxy/activity"
 	entbackend "github.com/ddevcap/jellyfin-proxy/ent/backend"
 )
 
@@ -19,14 +20,49 @@ const (
 	healthCheckTimeout = 5 * time.Second
 )
 
+// Circuit breaker states. A closed circuit lets requests through. Repeated
+// failures open it, taking the backend out of rotation. The next successful
+// health check half-opens it: requests go through again on trial, and the
+// first one to succeed closes the circuit while a failure opens it again.
+const (
+	circuitClosed   = "closed"
+	circuitOpen     = "open"
+	circuitHalfOpen = "half-open"
+)
+
 // backendStatus tracks the availability of a single backend.
 type backendStatus struct {
 	available    bool
+	circuit      string
 	lastChecked  time.Time
 	lastErr      string
 	failureCount int
 }
 
+func newBackendStatus() *backendStatus {
+	return &backendStatus{available: true, circuit: circuitClosed}
+}
+
+// setCircuit moves s to state, logging the transition. The backend is
+// available unless the circuit is open.
+func (s *backendStatus) setCircuit(state, id, name, reason string) {
+	if s.circuit == state {
+		return
+	}
+	attrs := []any{"backend", name, "id", id, "from", s.circuit, "reason", reason}
+	switch state {
+	case circuitOpen:
+		slog.Warn("circuit breaker open: backend taken out of rotation",
+			append(attrs, "failures", s.failureCount)...)
+	case circuitHalfOpen:
+		slog.Info("circuit breaker half-open: letting requests through on trial", attrs...)
+	case circuitClosed:
+		slog.Info("circuit breaker closed: backend back in rotation", attrs...)
+	}
+	s.circuit = state
+	s.available = state != circuitOpen
+}
+
 // HealthChecker periodically pings every enabled backend and maintains an
 // in-memory availability map. The Pool consults this map so that fan-out
 // requests skip backends that are known to be offline.
@@ -106,8 +142,9 @@ func (hc *HealthChecker) IsAvailable(backendID string) bool {
 // connection refused, timeout during a proxied request). This supplements
 // the periodic health check — if a backend starts failing live requests,
 // the circuit breaker trips it faster than waiting for the next health-check
-// cycle. After consecutiveRequestFailuresThreshold failures, the backend is
-// marked unavailable until the next successful health check restores it.
+// cycle. After consecutiveRequestFailuresThreshold failures, or a single one
+// while the circuit is half-open, the circuit opens until the next
+// successful health check half-opens it.
 const consecutiveRequestFailuresThreshold = 5
 
 func (hc *HealthChecker) RecordRequestFailure(backendID, name string) {
@@ -116,21 +153,22 @@ func (hc *HealthChecker) RecordRequestFailure(backendID, name string) {
 
 	s, ok := hc.statuses[backendID]
 	if !ok {
-		s = &backendStatus{available: true}
+		s = newBackendStatus()
 		hc.statuses[backendID] = s
 	}
 
 	s.failureCount++
-	if s.failureCount >= consecutiveRequestFailuresThreshold && s.available {
-		slog.Warn("circuit breaker: backend marked unavailable after repeated request failures",
-			"backend", name, "id", backendID,
-			"failures", s.failureCount)
-		s.available = false
+	switch {
+	case s.circuit == circuitHalfOpen:
+		s.setCircuit(circuitOpen, backendID, name, "trial request failed")
+	case s.circuit == circuitClosed && s.failureCount >= consecutiveRequestFailuresThreshold:
+		s.setCircuit(circuitOpen, backendID, name, "repeated request failures")
 	}
 }
 
-// RecordRequestSuccess resets the per-request failure counter for a backend.
-func (hc *HealthChecker) RecordRequestSuccess(backendID string) {
+// RecordRequestSuccess resets the per-request failure counter for a backend
+// and closes a half-open circuit.
+func (hc *HealthChecker) RecordRequestSuccess(backendID, name string) {
 	hc.mu.Lock()
 	defer hc.mu.Unlock()
 
@@ -138,8 +176,10 @@ func (hc *HealthChecker) RecordRequestSuccess(backendID string) {
 	if !ok {
 		return
 	}
-	// Only reset request-failure count; don't change availability
-	// (health checker owns that transition).
+	// An open circuit stays open: only the health checker half-opens it.
+	if s.circuit == circuitHalfOpen {
+		s.setCircuit(circuitClosed, backendID, name, "trial request succeeded")
+	}
 	if s.available {
 		s.failureCount = 0
 	}
@@ -149,6 +189,7 @@ func (hc *HealthChecker) RecordRequestSuccess(backendID string) {
 type BackendHealthStatus struct {
 	BackendID    string    `json:"backend_id"`
 	Available    bool      `json:"available"`
+	CircuitState string    `json:"circuit_state"` // closed, open or half-open
 	LastChecked  time.Time `json:"last_checked"`
 	LastError    string    `json:"last_error,omitempty"`
 	FailureCount int       `json:"failure_count"`
@@ -165,6 +206,7 @@ func (hc *HealthChecker) Statuses() []BackendHealthStatus {
 		result = append(result, BackendHealthStatus{
 			BackendID:    id,
 			Available:    s.available,
+			CircuitState: s.circuit,
 			LastChecked:  s.lastChecked,
 			LastError:    s.lastErr,
 			FailureCount: s.failureCount,
@@ -224,28 +266,37 @@ func (hc *HealthChecker) checkOne(ctx context.Context, id, name, rawURL string)
 }
 
 // recordResult updates the in-memory status for a backend.
-// A backend is marked unavailable after 2 consecutive failures, and marked
-// available again on the first success. This avoids flapping on transient
-// single-request failures.
+// A backend is marked unavailable (its circuit opens) after 2 consecutive
+// failures, and available again (half-open) on the first success. This
+// avoids flapping on transient single-request failures. Both transitions
+// are recorded in the activity log.
 func (hc *HealthChecker) recordResult(id, name string, err error) {
 	hc.mu.Lock()
-	defer hc.mu.Unlock()
 
 	s, ok := hc.statuses[id]
 	if !ok {
-		s = &backendStatus{available: true}
+		s = newBackendStatus()
 		hc.statuses[id] = s
 	}
 
 	s.lastChecked = time.Now()
 
 	if err == nil {
-		if !s.available {
-			slog.Info("backend came back online", "backend", name, "id", id)
+		cameBack := !s.available
+		if s.circuit == circuitOpen {
+			s.setCircuit(circuitHalfOpen, id, name, "health check passed")
 		}
-		s.available = true
 		s.failureCount = 0
 		s.lastErr = ""
+		hc.mu.Unlock()
+
+		if cameBack {
+			slog.Info("backend came back online", "backend", name, "id", id)
+			activity.Record(context.Background(), hc.pool.db, activity.Entry{
+				Name: fmt.Sprintf("Backend %s is back online", name),
+				Type: "BackendOnline",
+			})
+		}
 		return
 	}
 
@@ -254,10 +305,18 @@ func (hc *HealthChecker) recordResult(id, name string, err error) {
 
 	// Require 2 consecutive failures before marking unavailable to avoid
 	// flapping on a single dropped packet.
-	if s.failureCount >= 2 && s.available {
-		slog.Warn("backend marked unavailable",
-			"backend", name, "id", id,
-			"failures", s.failureCount, "error", err)
-		s.available = false
+	wentDown := s.failureCount >= 2 && s.available
+	if wentDown {
+		s.setCircuit(circuitOpen, id, name, "health check failed: "+err.Error())
+	}
+	hc.mu.Unlock()
+
+	if wentDown {
+		activity.Record(context.Background(), hc.pool.db, activity.Entry{
+			Name:          fmt.Sprintf("Backend %s is unavailable", name),
+			Type:          "BackendOffline",
+			ShortOverview: err.Error(),
+			Severity:      activity.Warning,
+		})
 	}
 }
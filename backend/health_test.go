@@ -186,7 +186,7 @@ var _ = Describe("HealthChecker", func() {
 			for i := 0; i < 3; i++ {
 				hc.RecordRequestFailure(b.ID.String(), "reset-test")
 			}
-			hc.RecordRequestSuccess(b.ID.String())
+			hc.RecordRequestSuccess(b.ID.String(), "reset-test")
 
 			// Now 4 more failures should NOT trip (only 4, not 5).
 			for i := 0; i < 4; i++ {
@@ -196,6 +196,54 @@ var _ = Describe("HealthChecker", func() {
 		})
 	})
 
+	Describe("circuit states", func() {
+		It("half-opens on a passing health check and closes on a successful request", func() {
+			ctx := context.Background()
+			pool := backend.NewPool(db, config.Config{ServerID: "test"})
+
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusOK)
+			}))
+			defer srv.Close()
+
+			b, err := db.Backend.Create().
+				SetName("states-test").
+				SetURL(srv.URL).
+				SetJellyfinServerID("jf-states").
+				SetPrefix("st1").
+				SetEnabled(true).
+				Save(ctx)
+			Expect(err).NotTo(HaveOccurred())
+			id := b.ID.String()
+
+			hc := backend.NewHealthChecker(pool, 100*time.Millisecond)
+			hc.Start(ctx)
+			defer hc.Stop()
+
+			circuit := func() string {
+				for _, s := range hc.Statuses() {
+					if s.BackendID == id {
+						return s.CircuitState
+					}
+				}
+				return ""
+			}
+			Eventually(circuit, 2*time.Second, 20*time.Millisecond).Should(Equal("closed"))
+
+			for i := 0; i < 5; i++ {
+				hc.RecordRequestFailure(id, "states-test")
+			}
+			// The backend answers health checks, so the next one lets
+			// requests through again on trial.
+			Eventually(circuit, 2*time.Second, 10*time.Millisecond).Should(Equal("half-open"))
+			Expect(hc.IsAvailable(id)).To(BeTrue())
+
+			hc.RecordRequestSuccess(id, "states-test")
+			Expect(circuit()).To(Equal("closed"))
+			Consistently(circuit, 300*time.Millisecond, 50*time.Millisecond).Should(Equal("closed"))
+		})
+	})
+
 	Describe("Statuses", func() {
 		It("returns status snapshots for tracked backends", func() {
 			ctx := context.Background()
@@ -0,0 +1,377 @@
+package backend
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ddevcap/jellyfin-proxy/ent"
+	entbackend "github.com/ddevcap/jellyfin-proxy/ent/backend"
+	entitem "github.com/ddevcap/jellyfin-proxy/ent/item"
+	"github.com/ddevcap/jellyfin-proxy/idtrans"
+)
+
+const (
+	// Default interval between full index syncs.
+	defaultIndexInterval = 15 * time.Minute
+	// Number of items requested per page while walking a library.
+	indexPageSize = 500
+	// Timeout for a single backend request made by the indexer.
+	indexRequestTimeout = 30 * time.Second
+	// Number of rows inserted per bulk statement.
+	indexInsertBatch = 200
+)
+
+// indexedItemTypes are the item types the indexer stores. Folders, people and
+// other structural items are not needed to answer merged-library queries.
+var indexedItemTypes = []string{
+	"Movie", "Series", "Season", "Episode", "BoxSet",
+	"MusicAlbum", "MusicArtist", "Audio", "MusicVideo",
+	"Trailer", "Book", "Video", "Photo",
+}
+
+// indexFields are the non-default fields requested for every indexed item.
+const indexFields = "ProviderIds,SortName,Genres,Tags,DateCreated,ParentId"
+
+// Indexer periodically copies item metadata from every enabled backend into
+// the Item table. Each sync replaces a backend's rows in one transaction, so
+// readers always see either the previous or the new complete snapshot.
+type Indexer struct {
+	pool     *Pool
+	interval time.Duration
+
+	mu     sync.RWMutex
+	synced map[string]time.Time // keyed by backend UUID string
+
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+// NewIndexer creates a new indexer bound to the given pool.
+// Call Start() to begin background syncing.
+func NewIndexer(pool *Pool, interval time.Duration) *Indexer {
+	if interval <= 0 {
+		interval = defaultIndexInterval
+	}
+	return &Indexer{
+		pool:     pool,
+		interval: interval,
+		synced:   make(map[string]time.Time),
+		done:     make(chan struct{}),
+	}
+}
+
+// Start begins the background sync loop. It syncs immediately on startup,
+// then repeats at the configured interval. Safe to call once.
+func (ix *Indexer) Start(ctx context.Context) {
+	ctx, ix.cancel = context.WithCancel(ctx)
+
+	go func() {
+		defer close(ix.done)
+
+		ix.SyncAll(ctx)
+
+		ticker := time.NewTicker(ix.interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				ix.SyncAll(ctx)
+			}
+		}
+	}()
+}
+
+// Stop signals the sync loop to stop and waits for it to finish.
+func (ix *Indexer) Stop() {
+	if ix.cancel != nil {
+		ix.cancel()
+	}
+	<-ix.done
+}
+
+// Ready reports whether every given backend has completed a sync since the
+// indexer started. Until then the index may be stale or partial and callers
+// should query the backends directly.
+func (ix *Indexer) Ready(backendIDs ...string) bool {
+	ix.mu.RLock()
+	defer ix.mu.RUnlock()
+
+	for _, id := range backendIDs {
+		if _, ok := ix.synced[id]; !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// Covers reports whether items of the given type are indexed. The comparison
+// ignores case because clients and collection mappings differ in casing.
+func (ix *Indexer) Covers(itemType string) bool {
+	for _, t := range indexedItemTypes {
+		if strings.EqualFold(t, itemType) {
+			return true
+		}
+	}
+	return false
+}
+
+// LastSynced returns when the backend was last synced successfully.
+func (ix *Indexer) LastSynced(backendID string) (time.Time, bool) {
+	ix.mu.RLock()
+	defer ix.mu.RUnlock()
+
+	t, ok := ix.synced[backendID]
+	return t, ok
+}
+
+// SyncAll syncs every enabled backend one after the other. A failing backend
+// keeps its previous rows and is retried on the next run.
+func (ix *Indexer) SyncAll(ctx context.Context) {
+	backends, err := ix.pool.db.Backend.Query().
+		Where(entbackend.Enabled(true)).
+		All(ctx)
+	if err != nil {
+		slog.Warn("indexer: failed to query backends", "error", err)
+		return
+	}
+
+	for _, b := range backends {
+		if ctx.Err() != nil {
+			return
+		}
+		if !ix.pool.isAvailable(b.ID.String()) {
+			continue
+		}
+		start := time.Now()
+		n, err := ix.syncBackend(ctx, b)
+		if err != nil {
+			slog.Warn("indexer: sync failed", "backend", b.Name, "error", err)
+			continue
+		}
+		ix.mu.Lock()
+		ix.synced[b.ID.String()] = time.Now()
+		ix.mu.Unlock()
+		slog.Info("indexer: backend synced", "backend", b.Name,
+			"items", n, "duration", time.Since(start).Round(time.Millisecond))
+	}
+}
+
+// indexLibrary is the subset of a library view needed to walk it.
+type indexLibrary struct {
+	Id             string `json:"Id"`
+	CollectionType string `json:"CollectionType"`
+}
+
+// indexEntry is the subset of an item that is stored in dedicated columns.
+type indexEntry struct {
+	Id              string            `json:"Id"`
+	Name            string            `json:"Name"`
+	SortName        string            `json:"SortName"`
+	Type            string            `json:"Type"`
+	MediaType       string            `json:"MediaType"`
+	ParentId        string            `json:"ParentId"`
+	SeasonId        string            `json:"SeasonId"`
+	SeriesId        string            `json:"SeriesId"`
+	ProviderIds     map[string]string `json:"ProviderIds"`
+	Genres          []string          `json:"Genres"`
+	Tags            []string          `json:"Tags"`
+	OfficialRating  string            `json:"OfficialRating"`
+	ProductionYear  int               `json:"ProductionYear"`
+	CommunityRating float64           `json:"CommunityRating"`
+	PremiereDate    string            `json:"PremiereDate"`
+	DateCreated     string            `json:"DateCreated"`
+}
+
+// syncBackend downloads every library of b and replaces its indexed rows.
+// It returns the number of items stored.
+func (ix *Indexer) syncBackend(ctx context.Context, b *ent.Backend) (int, error) {
+	sc, err := ix.pool.ForIndexing(ctx, b)
+	if err != nil {
+		return 0, err
+	}
+
+	body, err := ix.get(ctx, sc, "/users/"+sc.BackendUserID()+"/views", nil)
+	if err != nil {
+		return 0, fmt.Errorf("listing libraries: %w", err)
+	}
+	var views struct {
+		Items []indexLibrary `json:"Items"`
+	}
+	if err := json.Unmarshal(body, &views); err != nil {
+		return 0, fmt.Errorf("decoding libraries: %w", err)
+	}
+
+	type row struct {
+		lib   indexLibrary
+		raw   json.RawMessage
+		entry indexEntry
+	}
+	seen := make(map[string]bool)
+	var rows []row
+	for _, lib := range views.Items {
+		err := ix.walkLibrary(ctx, sc, lib.Id, func(raw json.RawMessage, e indexEntry) {
+			id := backendItemID(e.Id)
+			if id == "" || e.Type == "" || seen[id] {
+				return // also skips items listed in more than one library
+			}
+			seen[id] = true
+			rows = append(rows, row{lib: lib, raw: raw, entry: e})
+		})
+		if err != nil {
+			return 0, fmt.Errorf("walking library %s: %w", lib.Id, err)
+		}
+	}
+
+	tx, err := ix.pool.db.Tx(ctx)
+	if err != nil {
+		return 0, err
+	}
+	if _, err := tx.Item.Delete().
+		Where(entitem.HasBackendWith(entbackend.ID(b.ID))).
+		Exec(ctx); err != nil {
+		return 0, rollback(tx, err)
+	}
+	now := time.Now()
+	for start := 0; start < len(rows); start += indexInsertBatch {
+		end := min(start+indexInsertBatch, len(rows))
+		batch := make([]*ent.ItemCreate, 0, end-start)
+		for _, r := range rows[start:end] {
+			batch = append(batch, itemCreate(tx.Item.Create(), r.entry).
+				SetBackend(b).
+				SetLibraryID(backendItemID(r.lib.Id)).
+				SetCollectionType(strings.ToLower(r.lib.CollectionType)).
+				SetData(r.raw).
+				SetIndexedAt(now))
+		}
+		if err := tx.Item.CreateBulk(batch...).Exec(ctx); err != nil {
+			return 0, rollback(tx, err)
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return 0, fmt.Errorf("committing index: %w", err)
+	}
+	return len(rows), nil
+}
+
+// walkLibrary pages through every indexed item below a library and calls fn
+// with each item's raw JSON and its parsed columns.
+func (ix *Indexer) walkLibrary(
+	ctx context.Context,
+	sc *ServerClient,
+	libraryID string,
+	fn func(raw json.RawMessage, e indexEntry),
+) error {
+	_, parentID, _ := idtrans.Decode(libraryID)
+	for start := 0; ; start += indexPageSize {
+		q := url.Values{}
+		q.Set("ParentId", parentID)
+		q.Set("Recursive", "true")
+		q.Set("IncludeItemTypes", strings.Join(indexedItemTypes, ","))
+		q.Set("Fields", indexFields)
+		// UserData would be the indexing user's, not the requester's.
+		q.Set("EnableUserData", "false")
+		q.Set("StartIndex", strconv.Itoa(start))
+		q.Set("Limit", strconv.Itoa(indexPageSize))
+		body, err := ix.get(ctx, sc, "/users/"+sc.BackendUserID()+"/items", q)
+		if err != nil {
+			return err
+		}
+		var page struct {
+			Items            []json.RawMessage `json:"Items"`
+			TotalRecordCount int               `json:"TotalRecordCount"`
+		}
+		if err := json.Unmarshal(body, &page); err != nil {
+			return fmt.Errorf("decoding items: %w", err)
+		}
+		for _, raw := range page.Items {
+			var e indexEntry
+			if err := json.Unmarshal(raw, &e); err != nil {
+				continue
+			}
+			fn(raw, e)
+		}
+		if len(page.Items) < indexPageSize || start+len(page.Items) >= page.TotalRecordCount {
+			return nil
+		}
+	}
+}
+
+// get performs one indexer request and returns the ID-rewritten body.
+func (ix *Indexer) get(ctx context.Context, sc *ServerClient, path string, q url.Values) ([]byte, error) {
+	ctx, cancel := context.WithTimeout(ctx, indexRequestTimeout)
+	defer cancel()
+	body, status, err := sc.ProxyJSON(ctx, http.MethodGet, path, q, nil)
+	if err != nil {
+		return nil, err
+	}
+	if status != http.StatusOK {
+		return nil, fmt.Errorf("status %d", status)
+	}
+	return body, nil
+}
+
+// itemCreate fills the metadata columns of an Item builder from e.
+func itemCreate(c *ent.ItemCreate, e indexEntry) *ent.ItemCreate {
+	c.SetBackendItemID(backendItemID(e.Id)).
+		SetName(e.Name).
+		SetSortName(e.SortName).
+		SetItemType(e.Type).
+		SetMediaType(e.MediaType).
+		SetParentID(backendItemID(e.ParentId)).
+		SetSeasonID(backendItemID(e.SeasonId)).
+		SetSeriesID(backendItemID(e.SeriesId)).
+		SetTmdbID(providerID(e.ProviderIds, "Tmdb")).
+		SetImdbID(providerID(e.ProviderIds, "Imdb")).
+		SetTvdbID(providerID(e.ProviderIds, "Tvdb")).
+		SetGenres(strings.Join(e.Genres, "|")).
+		SetTags(strings.Join(e.Tags, "|")).
+		SetOfficialRating(e.OfficialRating).
+		SetProductionYear(e.ProductionYear).
+		SetCommunityRating(e.CommunityRating)
+	if t, err := time.Parse(time.RFC3339, e.PremiereDate); err == nil {
+		c.SetPremiereDate(t)
+	}
+	if t, err := time.Parse(time.RFC3339, e.DateCreated); err == nil {
+		c.SetDateCreated(t)
+	}
+	return c
+}
+
+// backendItemID strips the proxy prefix that ProxyJSON added to an ID.
+func backendItemID(proxyID string) string {
+	_, id, _ := idtrans.Decode(proxyID)
+	return id
+}
+
+// providerID looks up a provider ID case-insensitively; backends are not
+// consistent about the casing of provider names.
+func providerID(ids map[string]string, provider string) string {
+	if v, ok := ids[provider]; ok {
+		return v
+	}
+	for k, v := range ids {
+		if strings.EqualFold(k, provider) {
+			return v
+		}
+	}
+	return ""
+}
+
+// rollback aborts tx and returns err, annotated with any rollback failure.
+func rollback(tx *ent.Tx, err error) error {
+	if rerr := tx.Rollback(); rerr != nil {
+		return fmt.Errorf("%w: rolling back: %v", err, rerr)
+	}
+	return err
+}
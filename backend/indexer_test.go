@@ -0,0 +1,165 @@
+package backend_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/ddevcap/jellyfin-proxy/backend"
+	"github.com/ddevcap/jellyfin-proxy/config"
+	"github.com/ddevcap/jellyfin-proxy/ent"
+	entitem "github.com/ddevcap/jellyfin-proxy/ent/item"
+)
+
+var _ = Describe("Indexer", func() {
+	var (
+		ctx  context.Context
+		pool *backend.Pool
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		cleanDB()
+		pool = backend.NewPool(db, config.Config{ServerID: "proxy-id"})
+	})
+
+	// fakeLibrary serves one movies library whose items are produced by
+	// itemsJSON on every listing request.
+	fakeLibrary := func(itemsJSON func() string) *httptest.Server {
+		return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			switch {
+			case strings.HasSuffix(r.URL.Path, "/views"):
+				_, _ = fmt.Fprint(w, `{"Items":[{"Id":"lib1","Name":"Movies","CollectionType":"movies"}]}`)
+			case strings.HasSuffix(r.URL.Path, "/items"):
+				items := itemsJSON()
+				n := strings.Count(items, `"Type"`)
+				_, _ = fmt.Fprintf(w, `{"Items":[%s],"TotalRecordCount":%d}`, items, n)
+			default:
+				w.WriteHeader(http.StatusNotFound)
+			}
+		}))
+	}
+
+	register := func(url, prefix string, token *string) *ent.Backend {
+		b := db.Backend.Create().
+			SetName("Backend " + prefix).
+			SetURL(url).
+			SetPrefix(prefix).
+			SetJellyfinServerID("server-id-" + prefix).
+			SaveX(ctx)
+		u := db.User.Create().
+			SetUsername("user-" + prefix).
+			SetDisplayName("user-" + prefix).
+			SetHashedPassword("hash").
+			SaveX(ctx)
+		q := db.BackendUser.Create().
+			SetBackend(b).
+			SetUser(u).
+			SetBackendUserID("bu-" + prefix)
+		if token != nil {
+			q = q.SetBackendToken(*token)
+		}
+		q.SaveX(ctx)
+		return b
+	}
+
+	It("stores metadata, provider IDs and the parent chain of every item", func() {
+		srv := fakeLibrary(func() string {
+			return `{"Id":"m1","Name":"Heat","SortName":"heat","Type":"Movie","MediaType":"Video",` +
+				`"ParentId":"lib1","ProviderIds":{"Tmdb":"949","Imdb":"tt0113277"},` +
+				`"Genres":["Action","Crime"],"ProductionYear":1995,"CommunityRating":8.3,` +
+				`"PremiereDate":"1995-12-15T00:00:00.0000000Z","DateCreated":"2024-01-02T03:04:05.0000000Z"}`
+		})
+		defer srv.Close()
+		tok := "index-token"
+		b := register(srv.URL, "s1", &tok)
+
+		ix := backend.NewIndexer(pool, time.Hour)
+		ix.SyncAll(ctx)
+
+		Expect(ix.Ready(b.ID.String())).To(BeTrue())
+		it := db.Item.Query().Where(entitem.BackendItemID("m1")).WithBackend().OnlyX(ctx)
+		Expect(it.Edges.Backend.ID).To(Equal(b.ID))
+		Expect(it.Name).To(Equal("Heat"))
+		Expect(it.ItemType).To(Equal("Movie"))
+		Expect(it.ParentID).To(Equal("lib1"))
+		Expect(it.LibraryID).To(Equal("lib1"))
+		Expect(it.CollectionType).To(Equal("movies"))
+		Expect(it.TmdbID).To(Equal("949"))
+		Expect(it.ImdbID).To(Equal("tt0113277"))
+		Expect(it.Genres).To(Equal("Action|Crime"))
+		Expect(it.ProductionYear).To(Equal(1995))
+		Expect(it.PremiereDate).NotTo(BeNil())
+		Expect(it.PremiereDate.Year()).To(Equal(1995))
+		// The stored JSON is already in proxy form.
+		Expect(string(it.Data)).To(ContainSubstring(`"Id":"s1_m1"`))
+	})
+
+	It("replaces a backend's rows on every sync", func() {
+		var second atomic.Bool
+		srv := fakeLibrary(func() string {
+			if second.Load() {
+				return `{"Id":"m2","Name":"Ronin","Type":"Movie"}`
+			}
+			return `{"Id":"m1","Name":"Heat","Type":"Movie"},{"Id":"m2","Name":"Ronin","Type":"Movie"}`
+		})
+		defer srv.Close()
+		tok := "index-token"
+		register(srv.URL, "s1", &tok)
+
+		ix := backend.NewIndexer(pool, time.Hour)
+		ix.SyncAll(ctx)
+		Expect(db.Item.Query().CountX(ctx)).To(Equal(2))
+
+		second.Store(true)
+		ix.SyncAll(ctx)
+		ids := db.Item.Query().Select(entitem.FieldBackendItemID).StringsX(ctx)
+		Expect(ids).To(ConsistOf("m2"))
+	})
+
+	It("skips backends without a logged-in user", func() {
+		srv := fakeLibrary(func() string { return `{"Id":"m1","Name":"Heat","Type":"Movie"}` })
+		defer srv.Close()
+		b := register(srv.URL, "s1", nil)
+
+		ix := backend.NewIndexer(pool, time.Hour)
+		ix.SyncAll(ctx)
+
+		Expect(ix.Ready(b.ID.String())).To(BeFalse())
+		Expect(db.Item.Query().CountX(ctx)).To(BeZero())
+	})
+
+	It("keeps the previous rows when a sync fails", func() {
+		var failing atomic.Bool
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if failing.Load() && strings.HasSuffix(r.URL.Path, "/items") {
+				w.WriteHeader(http.StatusInternalServerError)
+				return
+			}
+			w.Header().Set("Content-Type", "application/json")
+			if strings.HasSuffix(r.URL.Path, "/views") {
+				_, _ = fmt.Fprint(w, `{"Items":[{"Id":"lib1","CollectionType":"movies"}]}`)
+				return
+			}
+			_, _ = fmt.Fprint(w, `{"Items":[{"Id":"m1","Name":"Heat","Type":"Movie"}],"TotalRecordCount":1}`)
+		}))
+		defer srv.Close()
+		tok := "index-token"
+		register(srv.URL, "s1", &tok)
+
+		ix := backend.NewIndexer(pool, time.Hour)
+		ix.SyncAll(ctx)
+		failing.Store(true)
+		ix.SyncAll(ctx)
+
+		Expect(db.Item.Query().CountX(ctx)).To(Equal(1))
+	})
+})
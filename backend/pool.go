@@ -5,6 +5,7 @@ package backend
 import (
 	"context"
 	"fmt"
+	"log/slog"
 	"net"
 	"net/http"
 	"time"
@@ -14,6 +15,7 @@ import (
 	entbackend "github.com/ddevcap/jellyfin-proxy/ent/backend"
 	entbackenduser "github.com/ddevcap/jellyfin-proxy/ent/backenduser"
 	entuser "github.com/ddevcap/jellyfin-proxy/ent/user"
+	"github.com/ddevcap/jellyfin-proxy/tokencrypt"
 )
 
 // Pool manages HTTP connections to all registered backend Jellyfin servers.
@@ -24,6 +26,8 @@ type Pool struct {
 	jsonClient   *http.Client // bounded timeout — for JSON API calls
 	streamClient *http.Client // no total timeout — for binary media streams
 	health       *HealthChecker
+	indexer      *Indexer
+	tokens       *tokencrypt.Keyring // nil when tokens are stored in plaintext
 }
 
 func NewPool(db *ent.Client, cfg config.Config) *Pool {
@@ -64,6 +68,17 @@ func NewPool(db *ent.Client, cfg config.Config) *Pool {
 	}
 }
 
+// SetTokenKeyring makes the pool decrypt backend tokens with kr. Must be
+// called before the pool is used to serve requests.
+func (p *Pool) SetTokenKeyring(kr *tokencrypt.Keyring) {
+	p.tokens = kr
+}
+
+// TokenKeyring returns the keyring set by SetTokenKeyring, or nil.
+func (p *Pool) TokenKeyring() *tokencrypt.Keyring {
+	return p.tokens
+}
+
 // SetHealthChecker attaches a health checker to the pool. Must be called
 // before the pool is used to serve requests.
 func (p *Pool) SetHealthChecker(hc *HealthChecker) {
@@ -75,6 +90,17 @@ func (p *Pool) GetHealthChecker() *HealthChecker {
 	return p.health
 }
 
+// SetIndexer attaches the background item indexer so that handlers can
+// answer merged-library queries from the local index.
+func (p *Pool) SetIndexer(ix *Indexer) {
+	p.indexer = ix
+}
+
+// GetIndexer returns the attached indexer, or nil if indexing is disabled.
+func (p *Pool) GetIndexer() *Indexer {
+	return p.indexer
+}
+
 // isAvailable returns true if the backend is considered reachable.
 // If no health checker is configured, all backends are assumed available.
 func (p *Pool) isAvailable(backendID string) bool {
@@ -107,8 +133,8 @@ func (p *Pool) ForUser(ctx context.Context, prefix string, user *ent.User) (*Ser
 		Only(ctx)
 	if err == nil {
 		backendUserID = bu.BackendUserID
-		if bu.BackendToken != nil {
-			token = *bu.BackendToken
+		if token, err = p.token(bu); err != nil {
+			return nil, err
 		}
 	}
 
@@ -146,9 +172,10 @@ func (p *Pool) AllForUser(ctx context.Context, user *ent.User) ([]*ServerClient,
 		if !p.isAvailable(b.ID.String()) {
 			continue // backend offline — skip to avoid timeout
 		}
-		var token string
-		if bu.BackendToken != nil {
-			token = *bu.BackendToken
+		token, err := p.token(bu)
+		if err != nil {
+			slog.Error("backend: skipping mapping with unreadable token", "backend", b.Name, "error", err)
+			continue
 		}
 		clients = append(clients, &ServerClient{
 			backend:       b,
@@ -160,6 +187,50 @@ func (p *Pool) AllForUser(ctx context.Context, user *ent.User) ([]*ServerClient,
 	return clients, nil
 }
 
+// ForIndexing returns a ServerClient for background jobs that read a whole
+// backend library without a user request behind them. It borrows the
+// credentials of an enabled user mapping on that backend, preferring one that
+// belongs to a proxy admin since admins usually see every library.
+func (p *Pool) ForIndexing(ctx context.Context, b *ent.Backend) (*ServerClient, error) {
+	backendUsers, err := p.db.BackendUser.Query().
+		Where(
+			entbackenduser.HasBackendWith(entbackend.ID(b.ID)),
+			entbackenduser.Enabled(true),
+			entbackenduser.BackendTokenNotNil(),
+		).
+		WithUser().
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("backend: querying users of %q: %w", b.Name, err)
+	}
+
+	var picked *ent.BackendUser
+	for _, bu := range backendUsers {
+		if *bu.BackendToken == "" {
+			continue
+		}
+		if picked == nil || (bu.Edges.User != nil && bu.Edges.User.IsAdmin) {
+			picked = bu
+		}
+		if picked.Edges.User != nil && picked.Edges.User.IsAdmin {
+			break
+		}
+	}
+	if picked == nil {
+		return nil, fmt.Errorf("backend: no logged-in user on %q to index with", b.Name)
+	}
+	token, err := p.token(picked)
+	if err != nil {
+		return nil, err
+	}
+	return &ServerClient{
+		backend:       b,
+		token:         token,
+		backendUserID: picked.BackendUserID,
+		pool:          p,
+	}, nil
+}
+
 // ForBackend returns a ServerClient without user-specific credentials.
 // Used for unauthenticated public requests (e.g. images) where no user
 // session is available. The token will be empty.
@@ -158,6 +158,37 @@ var _ = Describe("Pool", func() {
 		})
 	})
 
+	Describe("ForIndexing", func() {
+		It("prefers the credentials of a proxy admin", func() {
+			b := newBackend("Index", "http://index:8096", "idx")
+			plain := newUser("plain")
+			admin := db.User.Create().
+				SetUsername("boss").
+				SetDisplayName("boss").
+				SetHashedPassword("hash").
+				SetIsAdmin(true).
+				SaveX(ctx)
+			plainTok, adminTok := "plain-token", "admin-token"
+			newBackendUser(b, plain, "plain-id", &plainTok)
+			newBackendUser(b, admin, "admin-id", &adminTok)
+
+			sc, err := pool.ForIndexing(ctx, b)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(sc.Token()).To(Equal("admin-token"))
+			Expect(sc.BackendUserID()).To(Equal("admin-id"))
+		})
+
+		It("fails when no mapping on the backend has a token", func() {
+			b := newBackend("NoLogin", "http://nologin:8096", "nol")
+			newBackendUser(b, newUser("carol"), "carol-id", nil)
+
+			_, err := pool.ForIndexing(ctx, b)
+
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
 	Describe("ServerClient accessors", func() {
 		It("ServerURL trims a trailing slash from the backend URL", func() {
 			newBackend("Test", "http://test:8096/", "tst")
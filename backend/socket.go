@@ -0,0 +1,49 @@
+package backend
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/ddevcap/jellyfin-proxy/idtrans"
+	"github.com/gorilla/websocket"
+)
+
+// socketDialer opens upstream WebSocket connections to backends.
+var socketDialer = &websocket.Dialer{
+	Proxy:            http.ProxyFromEnvironment,
+	HandshakeTimeout: 10 * time.Second,
+}
+
+// DialSocket opens the backend's /socket WebSocket with the user's
+// credentials. The backend pushes that user's events (UserDataChanged,
+// LibraryChanged, remote control commands, ...) over it.
+func (sc *ServerClient) DialSocket(ctx context.Context) (*websocket.Conn, error) {
+	u := sc.ServerURL() + "/socket"
+	switch {
+	case strings.HasPrefix(u, "https://"):
+		u = "wss://" + strings.TrimPrefix(u, "https://")
+	case strings.HasPrefix(u, "http://"):
+		u = "ws://" + strings.TrimPrefix(u, "http://")
+	}
+	header := http.Header{}
+	if sc.token != "" {
+		header.Set("X-Emby-Token", sc.token)
+	}
+	conn, resp, err := socketDialer.DialContext(ctx, u, header)
+	if resp != nil && resp.Body != nil {
+		_ = resp.Body.Close()
+	}
+	if err != nil {
+		return nil, fmt.Errorf("connecting to %s socket: %w", sc.backend.Name, err)
+	}
+	return conn, nil
+}
+
+// RewriteMessage translates a message received on the backend's socket into
+// proxy form, the same way ProxyJSON translates response bodies.
+func (sc *ServerClient) RewriteMessage(raw []byte) ([]byte, error) {
+	return idtrans.RewriteResponse(raw, sc.backend.Prefix, sc.pool.cfg.ServerID, sc.backendInfo())
+}
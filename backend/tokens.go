@@ -0,0 +1,235 @@
+package backend
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/ddevcap/jellyfin-proxy/config"
+	"github.com/ddevcap/jellyfin-proxy/ent"
+	entbackenduser "github.com/ddevcap/jellyfin-proxy/ent/backenduser"
+	"github.com/ddevcap/jellyfin-proxy/ent/hook"
+	entuser "github.com/ddevcap/jellyfin-proxy/ent/user"
+	"github.com/ddevcap/jellyfin-proxy/tokencrypt"
+)
+
+// NewTokenKeyring returns the keyring for backend tokens configured in cfg,
+// or nil when BACKEND_TOKEN_KEY is not set.
+func NewTokenKeyring(cfg config.Config) (*tokencrypt.Keyring, error) {
+	if cfg.BackendTokenKey == "" {
+		return nil, nil
+	}
+	current, err := tokencrypt.ParseKey(cfg.BackendTokenKey)
+	if err != nil {
+		return nil, fmt.Errorf("BACKEND_TOKEN_KEY: %w", err)
+	}
+	previous := make([][]byte, 0, len(cfg.BackendTokenPreviousKeys))
+	for _, s := range cfg.BackendTokenPreviousKeys {
+		k, err := tokencrypt.ParseKey(s)
+		if err != nil {
+			return nil, fmt.Errorf("BACKEND_TOKEN_PREVIOUS_KEYS: %w", err)
+		}
+		previous = append(previous, k)
+	}
+	return tokencrypt.New(current, previous...)
+}
+
+// EncryptTokensHook returns an ent hook that encrypts backend_token whenever
+// a BackendUser is created or updated, and totp_secret whenever a User is, so
+// no code path can write either in plaintext. Values that are already
+// encrypted are stored as they are. It is registered on the whole client.
+func EncryptTokensHook(kr *tokencrypt.Keyring) ent.Hook {
+	encrypt := func(v string) (string, bool, error) {
+		if v == "" || tokencrypt.IsEncrypted(v) {
+			return v, false, nil
+		}
+		enc, err := kr.Encrypt(v)
+		return enc, err == nil, err
+	}
+	return hook.On(func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			switch m := m.(type) {
+			case *ent.BackendUserMutation:
+				if token, ok := m.BackendToken(); ok {
+					enc, changed, err := encrypt(token)
+					if err != nil {
+						return nil, fmt.Errorf("backend: encrypting token: %w", err)
+					}
+					if changed {
+						m.SetBackendToken(enc)
+					}
+				}
+			case *ent.UserMutation:
+				if secret, ok := m.TotpSecret(); ok {
+					enc, changed, err := encrypt(secret)
+					if err != nil {
+						return nil, fmt.Errorf("backend: encrypting TOTP secret: %w", err)
+					}
+					if changed {
+						m.SetTotpSecret(enc)
+					}
+				}
+			}
+			return next.Mutate(ctx, m)
+		})
+	}, ent.OpCreate|ent.OpUpdate|ent.OpUpdateOne)
+}
+
+// DecryptSecret returns the plaintext of v, a value EncryptTokensHook may
+// have encrypted with kr. kr may be nil when no key is configured.
+func DecryptSecret(kr *tokencrypt.Keyring, v string) (string, error) {
+	if kr == nil {
+		if tokencrypt.IsEncrypted(v) {
+			return "", errors.New("backend: value is encrypted but BACKEND_TOKEN_KEY is not set")
+		}
+		return v, nil
+	}
+	return kr.Decrypt(v)
+}
+
+// CheckTokenEncryption refuses to start when stored tokens or TOTP secrets
+// cannot be read: some are encrypted but no key is configured, or they were
+// encrypted with a key the keyring does not hold. Plaintext values left over
+// from before encryption was enabled are only reported.
+func CheckTokenEncryption(ctx context.Context, db *ent.Client, kr *tokencrypt.Keyring) error {
+	if kr == nil {
+		n, err := db.BackendUser.Query().
+			Where(entbackenduser.BackendTokenHasPrefix("enc:")).
+			Count(ctx)
+		if err != nil {
+			return err
+		}
+		if n > 0 {
+			return fmt.Errorf("%d backend tokens are encrypted but BACKEND_TOKEN_KEY is not set", n)
+		}
+		n, err = db.User.Query().
+			Where(entuser.TotpSecretHasPrefix("enc:")).
+			Count(ctx)
+		if err != nil {
+			return err
+		}
+		if n > 0 {
+			return fmt.Errorf("%d TOTP secrets are encrypted but BACKEND_TOKEN_KEY is not set", n)
+		}
+		return nil
+	}
+
+	mappings, err := db.BackendUser.Query().
+		Where(entbackenduser.BackendTokenNotNil(), entbackenduser.BackendTokenNEQ("")).
+		All(ctx)
+	if err != nil {
+		return err
+	}
+	plaintext := 0
+	for _, bu := range mappings {
+		if !tokencrypt.IsEncrypted(*bu.BackendToken) {
+			plaintext++
+			continue
+		}
+		if _, err := kr.Decrypt(*bu.BackendToken); err != nil {
+			return fmt.Errorf("backend token of mapping %s: %w", bu.ID, err)
+		}
+	}
+	users, err := db.User.Query().
+		Where(entuser.TotpSecretNotNil(), entuser.TotpSecretNEQ("")).
+		All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, u := range users {
+		if !tokencrypt.IsEncrypted(*u.TotpSecret) {
+			plaintext++
+			continue
+		}
+		if _, err := kr.Decrypt(*u.TotpSecret); err != nil {
+			return fmt.Errorf("TOTP secret of user %s: %w", u.Username, err)
+		}
+	}
+	if plaintext > 0 {
+		slog.Warn("backend tokens or TOTP secrets stored in plaintext; run rotate-backend-token-key to encrypt them", "count", plaintext)
+	}
+	return nil
+}
+
+// RotateTokens re-encrypts every stored backend token and TOTP secret with
+// the keyring's current key, encrypting plaintext values on the way. It runs
+// in one transaction and returns how many rows it rewrote.
+func RotateTokens(ctx context.Context, db *ent.Client, kr *tokencrypt.Keyring) (int, error) {
+	tx, err := db.Tx(ctx)
+	if err != nil {
+		return 0, err
+	}
+	rotated, err := rotateTokens(ctx, tx, kr)
+	if err != nil {
+		_ = tx.Rollback()
+		return 0, err
+	}
+	return rotated, tx.Commit()
+}
+
+func rotateTokens(ctx context.Context, tx *ent.Tx, kr *tokencrypt.Keyring) (int, error) {
+	reencrypt := func(v string) (string, error) {
+		plaintext, err := kr.Decrypt(v)
+		if err != nil {
+			return "", err
+		}
+		return kr.Encrypt(plaintext)
+	}
+
+	mappings, err := tx.BackendUser.Query().
+		Where(entbackenduser.BackendTokenNotNil(), entbackenduser.BackendTokenNEQ("")).
+		All(ctx)
+	if err != nil {
+		return 0, err
+	}
+	rotated := 0
+	for _, bu := range mappings {
+		if kr.IsCurrent(*bu.BackendToken) {
+			continue
+		}
+		token, err := reencrypt(*bu.BackendToken)
+		if err == nil {
+			err = tx.BackendUser.UpdateOne(bu).SetBackendToken(token).Exec(ctx)
+		}
+		if err != nil {
+			return 0, fmt.Errorf("backend token of mapping %s: %w", bu.ID, err)
+		}
+		rotated++
+	}
+
+	users, err := tx.User.Query().
+		Where(entuser.TotpSecretNotNil(), entuser.TotpSecretNEQ("")).
+		All(ctx)
+	if err != nil {
+		return 0, err
+	}
+	for _, u := range users {
+		if kr.IsCurrent(*u.TotpSecret) {
+			continue
+		}
+		secret, err := reencrypt(*u.TotpSecret)
+		if err == nil {
+			err = tx.User.UpdateOne(u).SetTotpSecret(secret).Exec(ctx)
+		}
+		if err != nil {
+			return 0, fmt.Errorf("TOTP secret of user %s: %w", u.Username, err)
+		}
+		rotated++
+	}
+	return rotated, nil
+}
+
+// token returns the decrypted backend token of bu, or "" when it has none.
+func (p *Pool) token(bu *ent.BackendUser) (string, error) {
+	if bu.BackendToken == nil {
+		return "", nil
+	}
+	if p.tokens == nil {
+		if tokencrypt.IsEncrypted(*bu.BackendToken) {
+			return "", fmt.Errorf("backend: token of mapping %s is encrypted but BACKEND_TOKEN_KEY is not set", bu.ID)
+		}
+		return *bu.BackendToken, nil
+	}
+	return p.tokens.Decrypt(*bu.BackendToken)
+}
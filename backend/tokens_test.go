@@ -0,0 +1,251 @@
+package backend_test
+
+import (
+	"context"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/ddevcap/jellyfin-proxy/backend"
+	"github.com/ddevcap/jellyfin-proxy/config"
+	"github.com/ddevcap/jellyfin-proxy/ent"
+	"github.com/ddevcap/jellyfin-proxy/ent/enttest"
+	"github.com/ddevcap/jellyfin-proxy/tokencrypt"
+	"github.com/google/uuid"
+)
+
+var _ = Describe("Backend token encryption", func() {
+	const (
+		oldKey = "0000000000000000000000000000000000000000000000000000000000000001"
+		newKey = "0000000000000000000000000000000000000000000000000000000000000002"
+	)
+
+	var (
+		ctx context.Context
+		// encDB shares the suite database but encrypts tokens and TOTP
+		// secrets on write.
+		encDB *ent.Client
+		kr    *tokencrypt.Keyring
+		b     *ent.Backend
+		u     *ent.User
+	)
+
+	keyring := func(current string, previous ...string) *tokencrypt.Keyring {
+		k, err := backend.NewTokenKeyring(config.Config{
+			BackendTokenKey:          current,
+			BackendTokenPreviousKeys: previous,
+		})
+		Expect(err).NotTo(HaveOccurred())
+		return k
+	}
+
+	storedToken := func(id uuid.UUID) string {
+		bu := db.BackendUser.GetX(ctx, id)
+		Expect(bu.BackendToken).NotTo(BeNil())
+		return *bu.BackendToken
+	}
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		cleanDB()
+		kr = keyring(oldKey)
+		encDB = enttest.Open(GinkgoT(), "sqlite3", "file:backend_test?mode=memory&cache=shared&_pragma=foreign_keys(1)")
+		encDB.Use(backend.EncryptTokensHook(kr))
+		DeferCleanup(encDB.Close)
+
+		b = db.Backend.Create().
+			SetName("Movies").
+			SetURL("http://movies:8096").
+			SetPrefix("mov").
+			SetJellyfinServerID("server-id-mov").
+			SetEnabled(true).
+			SaveX(ctx)
+		u = db.User.Create().
+			SetUsername("alice").
+			SetDisplayName("alice").
+			SetHashedPassword("hash").
+			SaveX(ctx)
+	})
+
+	It("returns no keyring without a key", func() {
+		k, err := backend.NewTokenKeyring(config.Config{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(k).To(BeNil())
+	})
+
+	It("rejects a key of the wrong size", func() {
+		_, err := backend.NewTokenKeyring(config.Config{BackendTokenKey: "c2hvcnQ="})
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("encrypts tokens on create and update", func() {
+		bu := encDB.BackendUser.Create().
+			SetBackend(b).
+			SetUser(u).
+			SetBackendUserID("alice-id").
+			SetBackendToken("secret-1").
+			SaveX(ctx)
+		stored := storedToken(bu.ID)
+		Expect(tokencrypt.IsEncrypted(stored)).To(BeTrue())
+		Expect(stored).NotTo(ContainSubstring("secret-1"))
+
+		encDB.BackendUser.UpdateOneID(bu.ID).SetBackendToken("secret-2").ExecX(ctx)
+		Expect(kr.Decrypt(storedToken(bu.ID))).To(Equal("secret-2"))
+
+		encDB.BackendUser.Update().SetBackendToken("secret-3").ExecX(ctx)
+		Expect(kr.Decrypt(storedToken(bu.ID))).To(Equal("secret-3"))
+	})
+
+	It("encrypts TOTP secrets", func() {
+		encDB.User.UpdateOne(u).SetTotpSecret("GEZDGNBVGY3TQOJQ").ExecX(ctx)
+
+		stored := db.User.GetX(ctx, u.ID).TotpSecret
+		Expect(stored).NotTo(BeNil())
+		Expect(tokencrypt.IsEncrypted(*stored)).To(BeTrue())
+		Expect(backend.DecryptSecret(kr, *stored)).To(Equal("GEZDGNBVGY3TQOJQ"))
+
+		_, err := backend.DecryptSecret(nil, *stored)
+		Expect(err).To(HaveOccurred())
+		Expect(backend.CheckTokenEncryption(ctx, db, nil)).To(MatchError(ContainSubstring("TOTP secrets")))
+	})
+
+	It("decrypts tokens for the pool", func() {
+		encDB.BackendUser.Create().
+			SetBackend(b).
+			SetUser(u).
+			SetBackendUserID("alice-id").
+			SetBackendToken("secret").
+			ExecX(ctx)
+
+		pool := backend.NewPool(db, config.Config{ServerID: "proxy-id"})
+		pool.SetTokenKeyring(kr)
+
+		sc, err := pool.ForUser(ctx, "mov", u)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(sc.Token()).To(Equal("secret"))
+
+		all, err := pool.AllForUser(ctx, u)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(all).To(HaveLen(1))
+		Expect(all[0].Token()).To(Equal("secret"))
+	})
+
+	It("fails instead of sending an encrypted token when no key is set", func() {
+		encDB.BackendUser.Create().
+			SetBackend(b).
+			SetUser(u).
+			SetBackendUserID("alice-id").
+			SetBackendToken("secret").
+			ExecX(ctx)
+
+		pool := backend.NewPool(db, config.Config{ServerID: "proxy-id"})
+		_, err := pool.ForUser(ctx, "mov", u)
+		Expect(err).To(HaveOccurred())
+	})
+
+	Describe("CheckTokenEncryption", func() {
+		It("refuses encrypted tokens without a key", func() {
+			encDB.BackendUser.Create().
+				SetBackend(b).
+				SetUser(u).
+				SetBackendUserID("alice-id").
+				SetBackendToken("secret").
+				ExecX(ctx)
+
+			Expect(backend.CheckTokenEncryption(ctx, db, nil)).To(MatchError(ContainSubstring("BACKEND_TOKEN_KEY")))
+			Expect(backend.CheckTokenEncryption(ctx, db, kr)).To(Succeed())
+		})
+
+		It("refuses tokens encrypted with a key that is not configured", func() {
+			encDB.BackendUser.Create().
+				SetBackend(b).
+				SetUser(u).
+				SetBackendUserID("alice-id").
+				SetBackendToken("secret").
+				ExecX(ctx)
+
+			Expect(backend.CheckTokenEncryption(ctx, db, keyring(newKey))).NotTo(Succeed())
+		})
+
+		It("accepts plaintext tokens with or without a key", func() {
+			db.BackendUser.Create().
+				SetBackend(b).
+				SetUser(u).
+				SetBackendUserID("alice-id").
+				SetBackendToken("secret").
+				ExecX(ctx)
+
+			Expect(backend.CheckTokenEncryption(ctx, db, nil)).To(Succeed())
+			Expect(backend.CheckTokenEncryption(ctx, db, kr)).To(Succeed())
+		})
+	})
+
+	Describe("RotateTokens", func() {
+		It("re-encrypts tokens with the new key", func() {
+			bu := encDB.BackendUser.Create().
+				SetBackend(b).
+				SetUser(u).
+				SetBackendUserID("alice-id").
+				SetBackendToken("secret").
+				SaveX(ctx)
+
+			rotated := keyring(newKey, oldKey)
+			n, err := backend.RotateTokens(ctx, db, rotated)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(n).To(Equal(1))
+
+			stored := storedToken(bu.ID)
+			Expect(rotated.IsCurrent(stored)).To(BeTrue())
+			Expect(keyring(newKey).Decrypt(stored)).To(Equal("secret"))
+
+			n, err = backend.RotateTokens(ctx, db, rotated)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(n).To(BeZero())
+		})
+
+		It("re-encrypts TOTP secrets with the new key", func() {
+			encDB.User.UpdateOne(u).SetTotpSecret("GEZDGNBVGY3TQOJQ").ExecX(ctx)
+
+			rotated := keyring(newKey, oldKey)
+			n, err := backend.RotateTokens(ctx, db, rotated)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(n).To(Equal(1))
+
+			stored := *db.User.GetX(ctx, u.ID).TotpSecret
+			Expect(rotated.IsCurrent(stored)).To(BeTrue())
+			Expect(keyring(newKey).Decrypt(stored)).To(Equal("GEZDGNBVGY3TQOJQ"))
+		})
+
+		It("encrypts plaintext tokens", func() {
+			bu := db.BackendUser.Create().
+				SetBackend(b).
+				SetUser(u).
+				SetBackendUserID("alice-id").
+				SetBackendToken("secret").
+				SaveX(ctx)
+
+			n, err := backend.RotateTokens(ctx, db, kr)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(n).To(Equal(1))
+
+			stored := storedToken(bu.ID)
+			Expect(strings.HasPrefix(stored, "enc:")).To(BeTrue())
+			Expect(kr.Decrypt(stored)).To(Equal("secret"))
+		})
+
+		It("leaves everything unchanged when a token cannot be decrypted", func() {
+			bu := encDB.BackendUser.Create().
+				SetBackend(b).
+				SetUser(u).
+				SetBackendUserID("alice-id").
+				SetBackendToken("secret").
+				SaveX(ctx)
+			before := storedToken(bu.ID)
+
+			_, err := backend.RotateTokens(ctx, db, keyring(newKey))
+			Expect(err).To(MatchError(tokencrypt.ErrUnknownKey))
+			Expect(storedToken(bu.ID)).To(Equal(before))
+		})
+	})
+})
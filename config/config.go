@@ -2,6 +2,9 @@ package config
 
 import (
 	"fmt"
+	"net/netip"
+	"os"
+	"strings"
 	"time"
 
 	"github.com/caarlos0/env/v11"
@@ -21,6 +24,10 @@ type Config struct {
 	// SessionTTL is how long a session token remains valid after its last activity.
 	// Set to 0 to disable expiry (not recommended for production).
 	SessionTTL time.Duration `env:"SESSION_TTL" envDefault:"720h"`
+	// APIKeyTTL is how long new API keys are valid when they are created
+	// without an explicit expiry. 0 (the default) creates keys that never
+	// expire.
+	APIKeyTTL time.Duration `env:"API_KEY_TTL" envDefault:"0"`
 	// LoginMaxAttempts is the number of failed login attempts allowed per IP
 	// within LoginWindow before the IP is temporarily blocked.
 	LoginMaxAttempts int `env:"LOGIN_MAX_ATTEMPTS" envDefault:"10"`
@@ -28,6 +35,13 @@ type Config struct {
 	LoginWindow time.Duration `env:"LOGIN_WINDOW" envDefault:"15m"`
 	// LoginBanDuration is how long an IP is blocked after exceeding LoginMaxAttempts.
 	LoginBanDuration time.Duration `env:"LOGIN_BAN_DURATION" envDefault:"15m"`
+	// AccountLockoutAttempts is the number of consecutive failed logins after
+	// which an account is locked, whatever IPs they came from. 0 disables
+	// account lockout.
+	AccountLockoutAttempts int `env:"ACCOUNT_LOCKOUT_ATTEMPTS" envDefault:"5"`
+	// AccountLockoutDuration is how long a locked account refuses password
+	// logins before it unlocks by itself.
+	AccountLockoutDuration time.Duration `env:"ACCOUNT_LOCKOUT_DURATION" envDefault:"15m"`
 	// InitialAdminUser is the username for the auto-created admin account on first
 	// startup. Only used when no users exist in the database.
 	InitialAdminUser string `env:"INITIAL_ADMIN_USER" envDefault:"admin"`
@@ -54,6 +68,95 @@ type Config struct {
 	// availability. Backends that fail 2 consecutive checks are skipped in
 	// fan-out requests until they recover. Default: 30s.
 	HealthCheckInterval time.Duration `env:"HEALTH_CHECK_INTERVAL" envDefault:"30s"`
+	// IndexInterval is how often the background indexer copies item metadata
+	// from every backend into the database. Merged libraries are paged, sorted
+	// and counted from this index once each backend has been synced.
+	// Set to 0 to disable the index and always query backends live.
+	IndexInterval time.Duration `env:"INDEX_INTERVAL" envDefault:"15m"`
+	// QuickConnectEnabled lets a device log in by showing a short code that
+	// the user authorizes from a client where they are already logged in.
+	QuickConnectEnabled bool `env:"QUICK_CONNECT_ENABLED" envDefault:"false"`
+	// OIDCIssuerURL is the issuer of an OpenID Connect provider (e.g.
+	// Authelia or Keycloak) users can sign in with. Empty disables SSO.
+	OIDCIssuerURL string `env:"OIDC_ISSUER_URL"`
+	// OIDCClientID and OIDCClientSecret identify the proxy at the provider.
+	// The redirect URL to register is EXTERNAL_URL + /proxy/oidc/callback.
+	OIDCClientID     string `env:"OIDC_CLIENT_ID"`
+	OIDCClientSecret string `env:"OIDC_CLIENT_SECRET"`
+	// OIDCScopes are the scopes requested from the provider.
+	OIDCScopes []string `env:"OIDC_SCOPES" envDefault:"openid,profile,email,groups" envSeparator:","`
+	// OIDCUsernameClaim is the ID token claim used as the proxy username when
+	// an SSO user signs in for the first time.
+	OIDCUsernameClaim string `env:"OIDC_USERNAME_CLAIM" envDefault:"preferred_username"`
+	// OIDCGroupsClaim is the ID token claim listing the user's groups.
+	OIDCGroupsClaim string `env:"OIDC_GROUPS_CLAIM" envDefault:"groups"`
+	// OIDCAdminGroup makes members of this group proxy admins, and everyone
+	// else not, on every SSO login. Empty leaves is_admin alone.
+	OIDCAdminGroup string `env:"OIDC_ADMIN_GROUP"`
+	// OIDCTemplateUser is the username of a proxy user whose backend mappings
+	// are copied to users created by their first SSO login. Empty creates
+	// them without mappings.
+	OIDCTemplateUser string `env:"OIDC_TEMPLATE_USER"`
+	// TrustedAuthHeader names a header, such as Remote-User, carrying the
+	// username authenticated by a forward-auth reverse proxy in front of the
+	// proxy. Requests without a token are signed in as that user, which is
+	// created if needed. Empty disables header authentication.
+	TrustedAuthHeader string `env:"TRUSTED_AUTH_HEADER"`
+	// TrustedAuthProxies lists the networks (CIDRs or single IPs) the header
+	// is accepted from. It is required with TrustedAuthHeader and must only
+	// cover the reverse proxy, or clients could set the header themselves.
+	TrustedAuthProxies []string `env:"TRUSTED_AUTH_PROXIES" envSeparator:","`
+	// LDAPURL is the directory server users can log in against, e.g.
+	// ldaps://ldap.example.com. Empty disables LDAP authentication.
+	LDAPURL string `env:"LDAP_URL"`
+	// LDAPBindDN and LDAPBindPassword are the service account used to look
+	// users up. Empty binds anonymously.
+	LDAPBindDN       string `env:"LDAP_BIND_DN"`
+	LDAPBindPassword string `env:"LDAP_BIND_PASSWORD"`
+	// LDAPBaseDN is where user searches start.
+	LDAPBaseDN string `env:"LDAP_BASE_DN"`
+	// LDAPUserFilter finds the entry of the user logging in; {username} is
+	// replaced with the escaped login name.
+	LDAPUserFilter string `env:"LDAP_USER_FILTER" envDefault:"(&(objectClass=person)(uid={username}))"`
+	// LDAPAdminFilter is matched against the user's own entry, e.g.
+	// (memberOf=cn=jellyfin-admins,ou=groups,dc=example,dc=com), and sets
+	// is_admin on every LDAP login. Empty leaves is_admin alone.
+	LDAPAdminFilter string `env:"LDAP_ADMIN_FILTER"`
+	// LDAPDisplayNameAttribute is copied to the display name of LDAP users.
+	LDAPDisplayNameAttribute string `env:"LDAP_DISPLAY_NAME_ATTRIBUTE" envDefault:"displayName"`
+	// BackendTokenKey is the master key that encrypts backend tokens at rest:
+	// 32 bytes, base64 or hex encoded. Empty stores them in plaintext.
+	BackendTokenKey string `env:"BACKEND_TOKEN_KEY"`
+	// BackendTokenKeyFile reads BackendTokenKey from a file instead, e.g. a
+	// Docker secret.
+	BackendTokenKeyFile string `env:"BACKEND_TOKEN_KEY_FILE"`
+	// BackendTokenPreviousKeys are retired master keys, still used to decrypt
+	// while rotate-backend-token-key re-encrypts everything with the new one.
+	BackendTokenPreviousKeys []string `env:"BACKEND_TOKEN_PREVIOUS_KEYS" envSeparator:","`
+	// PasswordResetPinFile is a file that PINs for forgotten passwords are
+	// appended to, for the administrator to pass on. With no file, e-mail or
+	// webhook configured, users are told to contact the administrator.
+	PasswordResetPinFile string `env:"PASSWORD_RESET_PIN_FILE"`
+	// PasswordResetWebhookURL receives each PIN as a JSON POST.
+	PasswordResetWebhookURL string `env:"PASSWORD_RESET_WEBHOOK_URL"`
+	// PasswordResetEmailTo lists the addresses PINs are e-mailed to through
+	// the SMTP server.
+	PasswordResetEmailTo []string `env:"PASSWORD_RESET_EMAIL_TO" envSeparator:","`
+	// PasswordResetPinTTL is how long a PIN can be redeemed for.
+	PasswordResetPinTTL time.Duration `env:"PASSWORD_RESET_PIN_TTL" envDefault:"15m"`
+	// PasswordResetInterval is how long a user must wait before asking for
+	// another PIN.
+	PasswordResetInterval time.Duration `env:"PASSWORD_RESET_INTERVAL" envDefault:"5m"`
+	// SMTPHost and SMTPPort are the mail server e-mails are sent through,
+	// with STARTTLS when it offers it.
+	SMTPHost string `env:"SMTP_HOST"`
+	SMTPPort int    `env:"SMTP_PORT" envDefault:"587"`
+	// SMTPUsername and SMTPPassword log in to the mail server. Empty sends
+	// without authentication.
+	SMTPUsername string `env:"SMTP_USERNAME"`
+	SMTPPassword string `env:"SMTP_PASSWORD"`
+	// SMTPFrom is the sender address of e-mails.
+	SMTPFrom string `env:"SMTP_FROM"`
 }
 
 // Load parses configuration from environment variables.
@@ -63,5 +166,55 @@ func Load() (Config, error) {
 	if err != nil {
 		return Config{}, fmt.Errorf("config: %w", err)
 	}
+	if cfg.TrustedAuthHeader != "" {
+		networks, err := cfg.TrustedAuthNetworks()
+		if err != nil {
+			return Config{}, fmt.Errorf("config: %w", err)
+		}
+		if len(networks) == 0 {
+			return Config{}, fmt.Errorf("config: TRUSTED_AUTH_HEADER requires TRUSTED_AUTH_PROXIES")
+		}
+	}
+	if cfg.LDAPURL != "" && cfg.LDAPBaseDN == "" {
+		return Config{}, fmt.Errorf("config: LDAP_URL requires LDAP_BASE_DN")
+	}
+	if cfg.BackendTokenKey != "" && cfg.BackendTokenKeyFile != "" {
+		return Config{}, fmt.Errorf("config: set only one of BACKEND_TOKEN_KEY and BACKEND_TOKEN_KEY_FILE")
+	}
+	if cfg.BackendTokenKeyFile != "" {
+		key, err := os.ReadFile(cfg.BackendTokenKeyFile)
+		if err != nil {
+			return Config{}, fmt.Errorf("config: BACKEND_TOKEN_KEY_FILE: %w", err)
+		}
+		cfg.BackendTokenKey = strings.TrimSpace(string(key))
+	}
+	if cfg.BackendTokenKey == "" && len(cfg.BackendTokenPreviousKeys) > 0 {
+		return Config{}, fmt.Errorf("config: BACKEND_TOKEN_PREVIOUS_KEYS requires BACKEND_TOKEN_KEY")
+	}
+	if len(cfg.PasswordResetEmailTo) > 0 && (cfg.SMTPHost == "" || cfg.SMTPFrom == "") {
+		return Config{}, fmt.Errorf("config: PASSWORD_RESET_EMAIL_TO requires SMTP_HOST and SMTP_FROM")
+	}
 	return cfg, nil
 }
+
+// TrustedAuthNetworks parses TrustedAuthProxies. A single IP is taken as a
+// network of just that address.
+func (c Config) TrustedAuthNetworks() ([]netip.Prefix, error) {
+	networks := make([]netip.Prefix, 0, len(c.TrustedAuthProxies))
+	for _, s := range c.TrustedAuthProxies {
+		s = strings.TrimSpace(s)
+		if s == "" {
+			continue
+		}
+		if prefix, err := netip.ParsePrefix(s); err == nil {
+			networks = append(networks, prefix.Masked())
+			continue
+		}
+		addr, err := netip.ParseAddr(s)
+		if err != nil {
+			return nil, fmt.Errorf("TRUSTED_AUTH_PROXIES: invalid network %q", s)
+		}
+		networks = append(networks, netip.PrefixFrom(addr, addr.BitLen()))
+	}
+	return networks, nil
+}
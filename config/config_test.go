@@ -2,6 +2,7 @@ package config_test
 
 import (
 	"os"
+	"path/filepath"
 	"time"
 
 	. "github.com/onsi/ginkgo/v2"
@@ -16,6 +17,9 @@ var _ = Describe("Load", func() {
 		"DATABASE_URL", "LISTEN_ADDR", "EXTERNAL_URL", "SERVER_ID", "SERVER_NAME",
 		"SESSION_TTL", "LOGIN_MAX_ATTEMPTS", "LOGIN_WINDOW", "LOGIN_BAN_DURATION",
 		"INITIAL_ADMIN_USER", "INITIAL_ADMIN_PASSWORD", "DIRECT_STREAM",
+		"TRUSTED_AUTH_HEADER", "TRUSTED_AUTH_PROXIES", "LDAP_URL", "LDAP_BASE_DN",
+		"BACKEND_TOKEN_KEY", "BACKEND_TOKEN_KEY_FILE", "BACKEND_TOKEN_PREVIOUS_KEYS",
+		"PASSWORD_RESET_EMAIL_TO", "SMTP_HOST", "SMTP_FROM",
 	}
 
 	var saved map[string]string
@@ -128,4 +132,65 @@ var _ = Describe("Load", func() {
 		_, err := config.Load()
 		Expect(err).To(HaveOccurred())
 	})
+
+	It("parses the trusted proxy networks for header authentication", func() {
+		Expect(os.Setenv("TRUSTED_AUTH_HEADER", "Remote-User")).To(Succeed())
+		Expect(os.Setenv("TRUSTED_AUTH_PROXIES", "127.0.0.1, 172.16.0.0/12")).To(Succeed())
+
+		cfg, err := config.Load()
+		Expect(err).NotTo(HaveOccurred())
+
+		networks, err := cfg.TrustedAuthNetworks()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(networks).To(HaveLen(2))
+		Expect(networks[0].String()).To(Equal("127.0.0.1/32"))
+		Expect(networks[1].String()).To(Equal("172.16.0.0/12"))
+	})
+
+	It("refuses header authentication without trusted proxies", func() {
+		Expect(os.Setenv("TRUSTED_AUTH_HEADER", "Remote-User")).To(Succeed())
+
+		_, err := config.Load()
+		Expect(err).To(MatchError(ContainSubstring("TRUSTED_AUTH_PROXIES")))
+	})
+
+	It("returns an error for an invalid trusted proxy network", func() {
+		Expect(os.Setenv("TRUSTED_AUTH_HEADER", "Remote-User")).To(Succeed())
+		Expect(os.Setenv("TRUSTED_AUTH_PROXIES", "10.0.0.0/33")).To(Succeed())
+
+		_, err := config.Load()
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("refuses LDAP authentication without a base DN", func() {
+		Expect(os.Setenv("LDAP_URL", "ldap://ldap.example.com")).To(Succeed())
+
+		_, err := config.Load()
+		Expect(err).To(MatchError(ContainSubstring("LDAP_BASE_DN")))
+	})
+
+	It("reads the backend token key from a file", func() {
+		path := filepath.Join(GinkgoT().TempDir(), "key")
+		Expect(os.WriteFile(path, []byte("c2VjcmV0\n"), 0o600)).To(Succeed())
+		Expect(os.Setenv("BACKEND_TOKEN_KEY_FILE", path)).To(Succeed())
+
+		cfg, err := config.Load()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(cfg.BackendTokenKey).To(Equal("c2VjcmV0"))
+	})
+
+	It("refuses previous backend token keys without a current one", func() {
+		Expect(os.Setenv("BACKEND_TOKEN_PREVIOUS_KEYS", "c2VjcmV0")).To(Succeed())
+
+		_, err := config.Load()
+		Expect(err).To(MatchError(ContainSubstring("BACKEND_TOKEN_KEY")))
+	})
+
+	It("refuses password reset e-mails without a mail server", func() {
+		Expect(os.Setenv("PASSWORD_RESET_EMAIL_TO", "admin@example.com")).To(Succeed())
+		Expect(os.Setenv("SMTP_HOST", "mail.example.com")).To(Succeed())
+
+		_, err := config.Load()
+		Expect(err).To(MatchError(ContainSubstring("SMTP_FROM")))
+	})
 })
@@ -0,0 +1,119 @@
+// Code generated by ent, DO NOT EDIT.
+
+package activitylog
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the activitylog type in the database.
+	Label = "activity_log"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// FieldEventType holds the string denoting the event_type field in the database.
+	FieldEventType = "event_type"
+	// FieldShortOverview holds the string denoting the short_overview field in the database.
+	FieldShortOverview = "short_overview"
+	// FieldOverview holds the string denoting the overview field in the database.
+	FieldOverview = "overview"
+	// FieldUserID holds the string denoting the user_id field in the database.
+	FieldUserID = "user_id"
+	// FieldItemID holds the string denoting the item_id field in the database.
+	FieldItemID = "item_id"
+	// FieldSeverity holds the string denoting the severity field in the database.
+	FieldSeverity = "severity"
+	// FieldDate holds the string denoting the date field in the database.
+	FieldDate = "date"
+	// Table holds the table name of the activitylog in the database.
+	Table = "activity_logs"
+)
+
+// Columns holds all SQL columns for activitylog fields.
+var Columns = []string{
+	FieldID,
+	FieldName,
+	FieldEventType,
+	FieldShortOverview,
+	FieldOverview,
+	FieldUserID,
+	FieldItemID,
+	FieldSeverity,
+	FieldDate,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// NameValidator is a validator for the "name" field. It is called by the builders before save.
+	NameValidator func(string) error
+	// EventTypeValidator is a validator for the "event_type" field. It is called by the builders before save.
+	EventTypeValidator func(string) error
+	// DefaultSeverity holds the default value on creation for the "severity" field.
+	DefaultSeverity string
+	// DefaultDate holds the default value on creation for the "date" field.
+	DefaultDate func() time.Time
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// OrderOption defines the ordering options for the ActivityLog queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByName orders the results by the name field.
+func ByName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldName, opts...).ToFunc()
+}
+
+// ByEventType orders the results by the event_type field.
+func ByEventType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEventType, opts...).ToFunc()
+}
+
+// ByShortOverview orders the results by the short_overview field.
+func ByShortOverview(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldShortOverview, opts...).ToFunc()
+}
+
+// ByOverview orders the results by the overview field.
+func ByOverview(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOverview, opts...).ToFunc()
+}
+
+// ByUserID orders the results by the user_id field.
+func ByUserID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUserID, opts...).ToFunc()
+}
+
+// ByItemID orders the results by the item_id field.
+func ByItemID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldItemID, opts...).ToFunc()
+}
+
+// BySeverity orders the results by the severity field.
+func BySeverity(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSeverity, opts...).ToFunc()
+}
+
+// ByDate orders the results by the date field.
+func ByDate(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDate, opts...).ToFunc()
+}
@@ -0,0 +1,621 @@
+// Code generated by ent, DO NOT EDIT.
+
+package activitylog
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/ddevcap/jellyfin-proxy/ent/predicate"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.ActivityLog {
+	return predicate.ActivityLog(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.ActivityLog {
+	return predicate.ActivityLog(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.ActivityLog {
+	return predicate.ActivityLog(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.ActivityLog {
+	return predicate.ActivityLog(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.ActivityLog {
+	return predicate.ActivityLog(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.ActivityLog {
+	return predicate.ActivityLog(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.ActivityLog {
+	return predicate.ActivityLog(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.ActivityLog {
+	return predicate.ActivityLog(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.ActivityLog {
+	return predicate.ActivityLog(sql.FieldLTE(FieldID, id))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.ActivityLog {
+	return predicate.ActivityLog(sql.FieldEQ(FieldName, v))
+}
+
+// EventType applies equality check predicate on the "event_type" field. It's identical to EventTypeEQ.
+func EventType(v string) predicate.ActivityLog {
+	return predicate.ActivityLog(sql.FieldEQ(FieldEventType, v))
+}
+
+// ShortOverview applies equality check predicate on the "short_overview" field. It's identical to ShortOverviewEQ.
+func ShortOverview(v string) predicate.ActivityLog {
+	return predicate.ActivityLog(sql.FieldEQ(FieldShortOverview, v))
+}
+
+// Overview applies equality check predicate on the "overview" field. It's identical to OverviewEQ.
+func Overview(v string) predicate.ActivityLog {
+	return predicate.ActivityLog(sql.FieldEQ(FieldOverview, v))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v uuid.UUID) predicate.ActivityLog {
+	return predicate.ActivityLog(sql.FieldEQ(FieldUserID, v))
+}
+
+// ItemID applies equality check predicate on the "item_id" field. It's identical to ItemIDEQ.
+func ItemID(v string) predicate.ActivityLog {
+	return predicate.ActivityLog(sql.FieldEQ(FieldItemID, v))
+}
+
+// Severity applies equality check predicate on the "severity" field. It's identical to SeverityEQ.
+func Severity(v string) predicate.ActivityLog {
+	return predicate.ActivityLog(sql.FieldEQ(FieldSeverity, v))
+}
+
+// Date applies equality check predicate on the "date" field. It's identical to DateEQ.
+func Date(v time.Time) predicate.ActivityLog {
+	return predicate.ActivityLog(sql.FieldEQ(FieldDate, v))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.ActivityLog {
+	return predicate.ActivityLog(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.ActivityLog {
+	return predicate.ActivityLog(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.ActivityLog {
+	return predicate.ActivityLog(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.ActivityLog {
+	return predicate.ActivityLog(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.ActivityLog {
+	return predicate.ActivityLog(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.ActivityLog {
+	return predicate.ActivityLog(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.ActivityLog {
+	return predicate.ActivityLog(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.ActivityLog {
+	return predicate.ActivityLog(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.ActivityLog {
+	return predicate.ActivityLog(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.ActivityLog {
+	return predicate.ActivityLog(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.ActivityLog {
+	return predicate.ActivityLog(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.ActivityLog {
+	return predicate.ActivityLog(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.ActivityLog {
+	return predicate.ActivityLog(sql.FieldContainsFold(FieldName, v))
+}
+
+// EventTypeEQ applies the EQ predicate on the "event_type" field.
+func EventTypeEQ(v string) predicate.ActivityLog {
+	return predicate.ActivityLog(sql.FieldEQ(FieldEventType, v))
+}
+
+// EventTypeNEQ applies the NEQ predicate on the "event_type" field.
+func EventTypeNEQ(v string) predicate.ActivityLog {
+	return predicate.ActivityLog(sql.FieldNEQ(FieldEventType, v))
+}
+
+// EventTypeIn applies the In predicate on the "event_type" field.
+func EventTypeIn(vs ...string) predicate.ActivityLog {
+	return predicate.ActivityLog(sql.FieldIn(FieldEventType, vs...))
+}
+
+// EventTypeNotIn applies the NotIn predicate on the "event_type" field.
+func EventTypeNotIn(vs ...string) predicate.ActivityLog {
+	return predicate.ActivityLog(sql.FieldNotIn(FieldEventType, vs...))
+}
+
+// EventTypeGT applies the GT predicate on the "event_type" field.
+func EventTypeGT(v string) predicate.ActivityLog {
+	return predicate.ActivityLog(sql.FieldGT(FieldEventType, v))
+}
+
+// EventTypeGTE applies the GTE predicate on the "event_type" field.
+func EventTypeGTE(v string) predicate.ActivityLog {
+	return predicate.ActivityLog(sql.FieldGTE(FieldEventType, v))
+}
+
+// EventTypeLT applies the LT predicate on the "event_type" field.
+func EventTypeLT(v string) predicate.ActivityLog {
+	return predicate.ActivityLog(sql.FieldLT(FieldEventType, v))
+}
+
+// EventTypeLTE applies the LTE predicate on the "event_type" field.
+func EventTypeLTE(v string) predicate.ActivityLog {
+	return predicate.ActivityLog(sql.FieldLTE(FieldEventType, v))
+}
+
+// EventTypeContains applies the Contains predicate on the "event_type" field.
+func EventTypeContains(v string) predicate.ActivityLog {
+	return predicate.ActivityLog(sql.FieldContains(FieldEventType, v))
+}
+
+// EventTypeHasPrefix applies the HasPrefix predicate on the "event_type" field.
+func EventTypeHasPrefix(v string) predicate.ActivityLog {
+	return predicate.ActivityLog(sql.FieldHasPrefix(FieldEventType, v))
+}
+
+// EventTypeHasSuffix applies the HasSuffix predicate on the "event_type" field.
+func EventTypeHasSuffix(v string) predicate.ActivityLog {
+	return predicate.ActivityLog(sql.FieldHasSuffix(FieldEventType, v))
+}
+
+// EventTypeEqualFold applies the EqualFold predicate on the "event_type" field.
+func EventTypeEqualFold(v string) predicate.ActivityLog {
+	return predicate.ActivityLog(sql.FieldEqualFold(FieldEventType, v))
+}
+
+// EventTypeContainsFold applies the ContainsFold predicate on the "event_type" field.
+func EventTypeContainsFold(v string) predicate.ActivityLog {
+	return predicate.ActivityLog(sql.FieldContainsFold(FieldEventType, v))
+}
+
+// ShortOverviewEQ applies the EQ predicate on the "short_overview" field.
+func ShortOverviewEQ(v string) predicate.ActivityLog {
+	return predicate.ActivityLog(sql.FieldEQ(FieldShortOverview, v))
+}
+
+// ShortOverviewNEQ applies the NEQ predicate on the "short_overview" field.
+func ShortOverviewNEQ(v string) predicate.ActivityLog {
+	return predicate.ActivityLog(sql.FieldNEQ(FieldShortOverview, v))
+}
+
+// ShortOverviewIn applies the In predicate on the "short_overview" field.
+func ShortOverviewIn(vs ...string) predicate.ActivityLog {
+	return predicate.ActivityLog(sql.FieldIn(FieldShortOverview, vs...))
+}
+
+// ShortOverviewNotIn applies the NotIn predicate on the "short_overview" field.
+func ShortOverviewNotIn(vs ...string) predicate.ActivityLog {
+	return predicate.ActivityLog(sql.FieldNotIn(FieldShortOverview, vs...))
+}
+
+// ShortOverviewGT applies the GT predicate on the "short_overview" field.
+func ShortOverviewGT(v string) predicate.ActivityLog {
+	return predicate.ActivityLog(sql.FieldGT(FieldShortOverview, v))
+}
+
+// ShortOverviewGTE applies the GTE predicate on the "short_overview" field.
+func ShortOverviewGTE(v string) predicate.ActivityLog {
+	return predicate.ActivityLog(sql.FieldGTE(FieldShortOverview, v))
+}
+
+// ShortOverviewLT applies the LT predicate on the "short_overview" field.
+func ShortOverviewLT(v string) predicate.ActivityLog {
+	return predicate.ActivityLog(sql.FieldLT(FieldShortOverview, v))
+}
+
+// ShortOverviewLTE applies the LTE predicate on the "short_overview" field.
+func ShortOverviewLTE(v string) predicate.ActivityLog {
+	return predicate.ActivityLog(sql.FieldLTE(FieldShortOverview, v))
+}
+
+// ShortOverviewContains applies the Contains predicate on the "short_overview" field.
+func ShortOverviewContains(v string) predicate.ActivityLog {
+	return predicate.ActivityLog(sql.FieldContains(FieldShortOverview, v))
+}
+
+// ShortOverviewHasPrefix applies the HasPrefix predicate on the "short_overview" field.
+func ShortOverviewHasPrefix(v string) predicate.ActivityLog {
+	return predicate.ActivityLog(sql.FieldHasPrefix(FieldShortOverview, v))
+}
+
+// ShortOverviewHasSuffix applies the HasSuffix predicate on the "short_overview" field.
+func ShortOverviewHasSuffix(v string) predicate.ActivityLog {
+	return predicate.ActivityLog(sql.FieldHasSuffix(FieldShortOverview, v))
+}
+
+// ShortOverviewIsNil applies the IsNil predicate on the "short_overview" field.
+func ShortOverviewIsNil() predicate.ActivityLog {
+	return predicate.ActivityLog(sql.FieldIsNull(FieldShortOverview))
+}
+
+// ShortOverviewNotNil applies the NotNil predicate on the "short_overview" field.
+func ShortOverviewNotNil() predicate.ActivityLog {
+	return predicate.ActivityLog(sql.FieldNotNull(FieldShortOverview))
+}
+
+// ShortOverviewEqualFold applies the EqualFold predicate on the "short_overview" field.
+func ShortOverviewEqualFold(v string) predicate.ActivityLog {
+	return predicate.ActivityLog(sql.FieldEqualFold(FieldShortOverview, v))
+}
+
+// ShortOverviewContainsFold applies the ContainsFold predicate on the "short_overview" field.
+func ShortOverviewContainsFold(v string) predicate.ActivityLog {
+	return predicate.ActivityLog(sql.FieldContainsFold(FieldShortOverview, v))
+}
+
+// OverviewEQ applies the EQ predicate on the "overview" field.
+func OverviewEQ(v string) predicate.ActivityLog {
+	return predicate.ActivityLog(sql.FieldEQ(FieldOverview, v))
+}
+
+// OverviewNEQ applies the NEQ predicate on the "overview" field.
+func OverviewNEQ(v string) predicate.ActivityLog {
+	return predicate.ActivityLog(sql.FieldNEQ(FieldOverview, v))
+}
+
+// OverviewIn applies the In predicate on the "overview" field.
+func OverviewIn(vs ...string) predicate.ActivityLog {
+	return predicate.ActivityLog(sql.FieldIn(FieldOverview, vs...))
+}
+
+// OverviewNotIn applies the NotIn predicate on the "overview" field.
+func OverviewNotIn(vs ...string) predicate.ActivityLog {
+	return predicate.ActivityLog(sql.FieldNotIn(FieldOverview, vs...))
+}
+
+// OverviewGT applies the GT predicate on the "overview" field.
+func OverviewGT(v string) predicate.ActivityLog {
+	return predicate.ActivityLog(sql.FieldGT(FieldOverview, v))
+}
+
+// OverviewGTE applies the GTE predicate on the "overview" field.
+func OverviewGTE(v string) predicate.ActivityLog {
+	return predicate.ActivityLog(sql.FieldGTE(FieldOverview, v))
+}
+
+// OverviewLT applies the LT predicate on the "overview" field.
+func OverviewLT(v string) predicate.ActivityLog {
+	return predicate.ActivityLog(sql.FieldLT(FieldOverview, v))
+}
+
+// OverviewLTE applies the LTE predicate on the "overview" field.
+func OverviewLTE(v string) predicate.ActivityLog {
+	return predicate.ActivityLog(sql.FieldLTE(FieldOverview, v))
+}
+
+// OverviewContains applies the Contains predicate on the "overview" field.
+func OverviewContains(v string) predicate.ActivityLog {
+	return predicate.ActivityLog(sql.FieldContains(FieldOverview, v))
+}
+
+// OverviewHasPrefix applies the HasPrefix predicate on the "overview" field.
+func OverviewHasPrefix(v string) predicate.ActivityLog {
+	return predicate.ActivityLog(sql.FieldHasPrefix(FieldOverview, v))
+}
+
+// OverviewHasSuffix applies the HasSuffix predicate on the "overview" field.
+func OverviewHasSuffix(v string) predicate.ActivityLog {
+	return predicate.ActivityLog(sql.FieldHasSuffix(FieldOverview, v))
+}
+
+// OverviewIsNil applies the IsNil predicate on the "overview" field.
+func OverviewIsNil() predicate.ActivityLog {
+	return predicate.ActivityLog(sql.FieldIsNull(FieldOverview))
+}
+
+// OverviewNotNil applies the NotNil predicate on the "overview" field.
+func OverviewNotNil() predicate.ActivityLog {
+	return predicate.ActivityLog(sql.FieldNotNull(FieldOverview))
+}
+
+// OverviewEqualFold applies the EqualFold predicate on the "overview" field.
+func OverviewEqualFold(v string) predicate.ActivityLog {
+	return predicate.ActivityLog(sql.FieldEqualFold(FieldOverview, v))
+}
+
+// OverviewContainsFold applies the ContainsFold predicate on the "overview" field.
+func OverviewContainsFold(v string) predicate.ActivityLog {
+	return predicate.ActivityLog(sql.FieldContainsFold(FieldOverview, v))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v uuid.UUID) predicate.ActivityLog {
+	return predicate.ActivityLog(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v uuid.UUID) predicate.ActivityLog {
+	return predicate.ActivityLog(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...uuid.UUID) predicate.ActivityLog {
+	return predicate.ActivityLog(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...uuid.UUID) predicate.ActivityLog {
+	return predicate.ActivityLog(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// UserIDGT applies the GT predicate on the "user_id" field.
+func UserIDGT(v uuid.UUID) predicate.ActivityLog {
+	return predicate.ActivityLog(sql.FieldGT(FieldUserID, v))
+}
+
+// UserIDGTE applies the GTE predicate on the "user_id" field.
+func UserIDGTE(v uuid.UUID) predicate.ActivityLog {
+	return predicate.ActivityLog(sql.FieldGTE(FieldUserID, v))
+}
+
+// UserIDLT applies the LT predicate on the "user_id" field.
+func UserIDLT(v uuid.UUID) predicate.ActivityLog {
+	return predicate.ActivityLog(sql.FieldLT(FieldUserID, v))
+}
+
+// UserIDLTE applies the LTE predicate on the "user_id" field.
+func UserIDLTE(v uuid.UUID) predicate.ActivityLog {
+	return predicate.ActivityLog(sql.FieldLTE(FieldUserID, v))
+}
+
+// UserIDIsNil applies the IsNil predicate on the "user_id" field.
+func UserIDIsNil() predicate.ActivityLog {
+	return predicate.ActivityLog(sql.FieldIsNull(FieldUserID))
+}
+
+// UserIDNotNil applies the NotNil predicate on the "user_id" field.
+func UserIDNotNil() predicate.ActivityLog {
+	return predicate.ActivityLog(sql.FieldNotNull(FieldUserID))
+}
+
+// ItemIDEQ applies the EQ predicate on the "item_id" field.
+func ItemIDEQ(v string) predicate.ActivityLog {
+	return predicate.ActivityLog(sql.FieldEQ(FieldItemID, v))
+}
+
+// ItemIDNEQ applies the NEQ predicate on the "item_id" field.
+func ItemIDNEQ(v string) predicate.ActivityLog {
+	return predicate.ActivityLog(sql.FieldNEQ(FieldItemID, v))
+}
+
+// ItemIDIn applies the In predicate on the "item_id" field.
+func ItemIDIn(vs ...string) predicate.ActivityLog {
+	return predicate.ActivityLog(sql.FieldIn(FieldItemID, vs...))
+}
+
+// ItemIDNotIn applies the NotIn predicate on the "item_id" field.
+func ItemIDNotIn(vs ...string) predicate.ActivityLog {
+	return predicate.ActivityLog(sql.FieldNotIn(FieldItemID, vs...))
+}
+
+// ItemIDGT applies the GT predicate on the "item_id" field.
+func ItemIDGT(v string) predicate.ActivityLog {
+	return predicate.ActivityLog(sql.FieldGT(FieldItemID, v))
+}
+
+// ItemIDGTE applies the GTE predicate on the "item_id" field.
+func ItemIDGTE(v string) predicate.ActivityLog {
+	return predicate.ActivityLog(sql.FieldGTE(FieldItemID, v))
+}
+
+// ItemIDLT applies the LT predicate on the "item_id" field.
+func ItemIDLT(v string) predicate.ActivityLog {
+	return predicate.ActivityLog(sql.FieldLT(FieldItemID, v))
+}
+
+// ItemIDLTE applies the LTE predicate on the "item_id" field.
+func ItemIDLTE(v string) predicate.ActivityLog {
+	return predicate.ActivityLog(sql.FieldLTE(FieldItemID, v))
+}
+
+// ItemIDContains applies the Contains predicate on the "item_id" field.
+func ItemIDContains(v string) predicate.ActivityLog {
+	return predicate.ActivityLog(sql.FieldContains(FieldItemID, v))
+}
+
+// ItemIDHasPrefix applies the HasPrefix predicate on the "item_id" field.
+func ItemIDHasPrefix(v string) predicate.ActivityLog {
+	return predicate.ActivityLog(sql.FieldHasPrefix(FieldItemID, v))
+}
+
+// ItemIDHasSuffix applies the HasSuffix predicate on the "item_id" field.
+func ItemIDHasSuffix(v string) predicate.ActivityLog {
+	return predicate.ActivityLog(sql.FieldHasSuffix(FieldItemID, v))
+}
+
+// ItemIDIsNil applies the IsNil predicate on the "item_id" field.
+func ItemIDIsNil() predicate.ActivityLog {
+	return predicate.ActivityLog(sql.FieldIsNull(FieldItemID))
+}
+
+// ItemIDNotNil applies the NotNil predicate on the "item_id" field.
+func ItemIDNotNil() predicate.ActivityLog {
+	return predicate.ActivityLog(sql.FieldNotNull(FieldItemID))
+}
+
+// ItemIDEqualFold applies the EqualFold predicate on the "item_id" field.
+func ItemIDEqualFold(v string) predicate.ActivityLog {
+	return predicate.ActivityLog(sql.FieldEqualFold(FieldItemID, v))
+}
+
+// ItemIDContainsFold applies the ContainsFold predicate on the "item_id" field.
+func ItemIDContainsFold(v string) predicate.ActivityLog {
+	return predicate.ActivityLog(sql.FieldContainsFold(FieldItemID, v))
+}
+
+// SeverityEQ applies the EQ predicate on the "severity" field.
+func SeverityEQ(v string) predicate.ActivityLog {
+	return predicate.ActivityLog(sql.FieldEQ(FieldSeverity, v))
+}
+
+// SeverityNEQ applies the NEQ predicate on the "severity" field.
+func SeverityNEQ(v string) predicate.ActivityLog {
+	return predicate.ActivityLog(sql.FieldNEQ(FieldSeverity, v))
+}
+
+// SeverityIn applies the In predicate on the "severity" field.
+func SeverityIn(vs ...string) predicate.ActivityLog {
+	return predicate.ActivityLog(sql.FieldIn(FieldSeverity, vs...))
+}
+
+// SeverityNotIn applies the NotIn predicate on the "severity" field.
+func SeverityNotIn(vs ...string) predicate.ActivityLog {
+	return predicate.ActivityLog(sql.FieldNotIn(FieldSeverity, vs...))
+}
+
+// SeverityGT applies the GT predicate on the "severity" field.
+func SeverityGT(v string) predicate.ActivityLog {
+	return predicate.ActivityLog(sql.FieldGT(FieldSeverity, v))
+}
+
+// SeverityGTE applies the GTE predicate on the "severity" field.
+func SeverityGTE(v string) predicate.ActivityLog {
+	return predicate.ActivityLog(sql.FieldGTE(FieldSeverity, v))
+}
+
+// SeverityLT applies the LT predicate on the "severity" field.
+func SeverityLT(v string) predicate.ActivityLog {
+	return predicate.ActivityLog(sql.FieldLT(FieldSeverity, v))
+}
+
+// SeverityLTE applies the LTE predicate on the "severity" field.
+func SeverityLTE(v string) predicate.ActivityLog {
+	return predicate.ActivityLog(sql.FieldLTE(FieldSeverity, v))
+}
+
+// SeverityContains applies the Contains predicate on the "severity" field.
+func SeverityContains(v string) predicate.ActivityLog {
+	return predicate.ActivityLog(sql.FieldContains(FieldSeverity, v))
+}
+
+// SeverityHasPrefix applies the HasPrefix predicate on the "severity" field.
+func SeverityHasPrefix(v string) predicate.ActivityLog {
+	return predicate.ActivityLog(sql.FieldHasPrefix(FieldSeverity, v))
+}
+
+// SeverityHasSuffix applies the HasSuffix predicate on the "severity" field.
+func SeverityHasSuffix(v string) predicate.ActivityLog {
+	return predicate.ActivityLog(sql.FieldHasSuffix(FieldSeverity, v))
+}
+
+// SeverityEqualFold applies the EqualFold predicate on the "severity" field.
+func SeverityEqualFold(v string) predicate.ActivityLog {
+	return predicate.ActivityLog(sql.FieldEqualFold(FieldSeverity, v))
+}
+
+// SeverityContainsFold applies the ContainsFold predicate on the "severity" field.
+func SeverityContainsFold(v string) predicate.ActivityLog {
+	return predicate.ActivityLog(sql.FieldContainsFold(FieldSeverity, v))
+}
+
+// DateEQ applies the EQ predicate on the "date" field.
+func DateEQ(v time.Time) predicate.ActivityLog {
+	return predicate.ActivityLog(sql.FieldEQ(FieldDate, v))
+}
+
+// DateNEQ applies the NEQ predicate on the "date" field.
+func DateNEQ(v time.Time) predicate.ActivityLog {
+	return predicate.ActivityLog(sql.FieldNEQ(FieldDate, v))
+}
+
+// DateIn applies the In predicate on the "date" field.
+func DateIn(vs ...time.Time) predicate.ActivityLog {
+	return predicate.ActivityLog(sql.FieldIn(FieldDate, vs...))
+}
+
+// DateNotIn applies the NotIn predicate on the "date" field.
+func DateNotIn(vs ...time.Time) predicate.ActivityLog {
+	return predicate.ActivityLog(sql.FieldNotIn(FieldDate, vs...))
+}
+
+// DateGT applies the GT predicate on the "date" field.
+func DateGT(v time.Time) predicate.ActivityLog {
+	return predicate.ActivityLog(sql.FieldGT(FieldDate, v))
+}
+
+// DateGTE applies the GTE predicate on the "date" field.
+func DateGTE(v time.Time) predicate.ActivityLog {
+	return predicate.ActivityLog(sql.FieldGTE(FieldDate, v))
+}
+
+// DateLT applies the LT predicate on the "date" field.
+func DateLT(v time.Time) predicate.ActivityLog {
+	return predicate.ActivityLog(sql.FieldLT(FieldDate, v))
+}
+
+// DateLTE applies the LTE predicate on the "date" field.
+func DateLTE(v time.Time) predicate.ActivityLog {
+	return predicate.ActivityLog(sql.FieldLTE(FieldDate, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ActivityLog) predicate.ActivityLog {
+	return predicate.ActivityLog(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ActivityLog) predicate.ActivityLog {
+	return predicate.ActivityLog(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ActivityLog) predicate.ActivityLog {
+	return predicate.ActivityLog(sql.NotPredicates(p))
+}
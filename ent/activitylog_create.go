@@ -0,0 +1,359 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/ddevcap/jellyfin-proxy/ent/activitylog"
+	"github.com/google/uuid"
+)
+
+// ActivityLogCreate is the builder for creating a ActivityLog entity.
+type ActivityLogCreate struct {
+	config
+	mutation *ActivityLogMutation
+	hooks    []Hook
+}
+
+// SetName sets the "name" field.
+func (_c *ActivityLogCreate) SetName(v string) *ActivityLogCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetEventType sets the "event_type" field.
+func (_c *ActivityLogCreate) SetEventType(v string) *ActivityLogCreate {
+	_c.mutation.SetEventType(v)
+	return _c
+}
+
+// SetShortOverview sets the "short_overview" field.
+func (_c *ActivityLogCreate) SetShortOverview(v string) *ActivityLogCreate {
+	_c.mutation.SetShortOverview(v)
+	return _c
+}
+
+// SetNillableShortOverview sets the "short_overview" field if the given value is not nil.
+func (_c *ActivityLogCreate) SetNillableShortOverview(v *string) *ActivityLogCreate {
+	if v != nil {
+		_c.SetShortOverview(*v)
+	}
+	return _c
+}
+
+// SetOverview sets the "overview" field.
+func (_c *ActivityLogCreate) SetOverview(v string) *ActivityLogCreate {
+	_c.mutation.SetOverview(v)
+	return _c
+}
+
+// SetNillableOverview sets the "overview" field if the given value is not nil.
+func (_c *ActivityLogCreate) SetNillableOverview(v *string) *ActivityLogCreate {
+	if v != nil {
+		_c.SetOverview(*v)
+	}
+	return _c
+}
+
+// SetUserID sets the "user_id" field.
+func (_c *ActivityLogCreate) SetUserID(v uuid.UUID) *ActivityLogCreate {
+	_c.mutation.SetUserID(v)
+	return _c
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_c *ActivityLogCreate) SetNillableUserID(v *uuid.UUID) *ActivityLogCreate {
+	if v != nil {
+		_c.SetUserID(*v)
+	}
+	return _c
+}
+
+// SetItemID sets the "item_id" field.
+func (_c *ActivityLogCreate) SetItemID(v string) *ActivityLogCreate {
+	_c.mutation.SetItemID(v)
+	return _c
+}
+
+// SetNillableItemID sets the "item_id" field if the given value is not nil.
+func (_c *ActivityLogCreate) SetNillableItemID(v *string) *ActivityLogCreate {
+	if v != nil {
+		_c.SetItemID(*v)
+	}
+	return _c
+}
+
+// SetSeverity sets the "severity" field.
+func (_c *ActivityLogCreate) SetSeverity(v string) *ActivityLogCreate {
+	_c.mutation.SetSeverity(v)
+	return _c
+}
+
+// SetNillableSeverity sets the "severity" field if the given value is not nil.
+func (_c *ActivityLogCreate) SetNillableSeverity(v *string) *ActivityLogCreate {
+	if v != nil {
+		_c.SetSeverity(*v)
+	}
+	return _c
+}
+
+// SetDate sets the "date" field.
+func (_c *ActivityLogCreate) SetDate(v time.Time) *ActivityLogCreate {
+	_c.mutation.SetDate(v)
+	return _c
+}
+
+// SetNillableDate sets the "date" field if the given value is not nil.
+func (_c *ActivityLogCreate) SetNillableDate(v *time.Time) *ActivityLogCreate {
+	if v != nil {
+		_c.SetDate(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *ActivityLogCreate) SetID(v uuid.UUID) *ActivityLogCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *ActivityLogCreate) SetNillableID(v *uuid.UUID) *ActivityLogCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// Mutation returns the ActivityLogMutation object of the builder.
+func (_c *ActivityLogCreate) Mutation() *ActivityLogMutation {
+	return _c.mutation
+}
+
+// Save creates the ActivityLog in the database.
+func (_c *ActivityLogCreate) Save(ctx context.Context) (*ActivityLog, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *ActivityLogCreate) SaveX(ctx context.Context) *ActivityLog {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ActivityLogCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ActivityLogCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *ActivityLogCreate) defaults() {
+	if _, ok := _c.mutation.Severity(); !ok {
+		v := activitylog.DefaultSeverity
+		_c.mutation.SetSeverity(v)
+	}
+	if _, ok := _c.mutation.Date(); !ok {
+		v := activitylog.DefaultDate()
+		_c.mutation.SetDate(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := activitylog.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *ActivityLogCreate) check() error {
+	if _, ok := _c.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "ActivityLog.name"`)}
+	}
+	if v, ok := _c.mutation.Name(); ok {
+		if err := activitylog.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "ActivityLog.name": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.EventType(); !ok {
+		return &ValidationError{Name: "event_type", err: errors.New(`ent: missing required field "ActivityLog.event_type"`)}
+	}
+	if v, ok := _c.mutation.EventType(); ok {
+		if err := activitylog.EventTypeValidator(v); err != nil {
+			return &ValidationError{Name: "event_type", err: fmt.Errorf(`ent: validator failed for field "ActivityLog.event_type": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Severity(); !ok {
+		return &ValidationError{Name: "severity", err: errors.New(`ent: missing required field "ActivityLog.severity"`)}
+	}
+	if _, ok := _c.mutation.Date(); !ok {
+		return &ValidationError{Name: "date", err: errors.New(`ent: missing required field "ActivityLog.date"`)}
+	}
+	return nil
+}
+
+func (_c *ActivityLogCreate) sqlSave(ctx context.Context) (*ActivityLog, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *ActivityLogCreate) createSpec() (*ActivityLog, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ActivityLog{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(activitylog.Table, sqlgraph.NewFieldSpec(activitylog.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(activitylog.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := _c.mutation.EventType(); ok {
+		_spec.SetField(activitylog.FieldEventType, field.TypeString, value)
+		_node.EventType = value
+	}
+	if value, ok := _c.mutation.ShortOverview(); ok {
+		_spec.SetField(activitylog.FieldShortOverview, field.TypeString, value)
+		_node.ShortOverview = value
+	}
+	if value, ok := _c.mutation.Overview(); ok {
+		_spec.SetField(activitylog.FieldOverview, field.TypeString, value)
+		_node.Overview = value
+	}
+	if value, ok := _c.mutation.UserID(); ok {
+		_spec.SetField(activitylog.FieldUserID, field.TypeUUID, value)
+		_node.UserID = value
+	}
+	if value, ok := _c.mutation.ItemID(); ok {
+		_spec.SetField(activitylog.FieldItemID, field.TypeString, value)
+		_node.ItemID = value
+	}
+	if value, ok := _c.mutation.Severity(); ok {
+		_spec.SetField(activitylog.FieldSeverity, field.TypeString, value)
+		_node.Severity = value
+	}
+	if value, ok := _c.mutation.Date(); ok {
+		_spec.SetField(activitylog.FieldDate, field.TypeTime, value)
+		_node.Date = value
+	}
+	return _node, _spec
+}
+
+// ActivityLogCreateBulk is the builder for creating many ActivityLog entities in bulk.
+type ActivityLogCreateBulk struct {
+	config
+	err      error
+	builders []*ActivityLogCreate
+}
+
+// Save creates the ActivityLog entities in the database.
+func (_c *ActivityLogCreateBulk) Save(ctx context.Context) ([]*ActivityLog, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*ActivityLog, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ActivityLogMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *ActivityLogCreateBulk) SaveX(ctx context.Context) []*ActivityLog {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ActivityLogCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ActivityLogCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,522 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/ddevcap/jellyfin-proxy/ent/activitylog"
+	"github.com/ddevcap/jellyfin-proxy/ent/predicate"
+	"github.com/google/uuid"
+)
+
+// ActivityLogUpdate is the builder for updating ActivityLog entities.
+type ActivityLogUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ActivityLogMutation
+}
+
+// Where appends a list predicates to the ActivityLogUpdate builder.
+func (_u *ActivityLogUpdate) Where(ps ...predicate.ActivityLog) *ActivityLogUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *ActivityLogUpdate) SetName(v string) *ActivityLogUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *ActivityLogUpdate) SetNillableName(v *string) *ActivityLogUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetEventType sets the "event_type" field.
+func (_u *ActivityLogUpdate) SetEventType(v string) *ActivityLogUpdate {
+	_u.mutation.SetEventType(v)
+	return _u
+}
+
+// SetNillableEventType sets the "event_type" field if the given value is not nil.
+func (_u *ActivityLogUpdate) SetNillableEventType(v *string) *ActivityLogUpdate {
+	if v != nil {
+		_u.SetEventType(*v)
+	}
+	return _u
+}
+
+// SetShortOverview sets the "short_overview" field.
+func (_u *ActivityLogUpdate) SetShortOverview(v string) *ActivityLogUpdate {
+	_u.mutation.SetShortOverview(v)
+	return _u
+}
+
+// SetNillableShortOverview sets the "short_overview" field if the given value is not nil.
+func (_u *ActivityLogUpdate) SetNillableShortOverview(v *string) *ActivityLogUpdate {
+	if v != nil {
+		_u.SetShortOverview(*v)
+	}
+	return _u
+}
+
+// ClearShortOverview clears the value of the "short_overview" field.
+func (_u *ActivityLogUpdate) ClearShortOverview() *ActivityLogUpdate {
+	_u.mutation.ClearShortOverview()
+	return _u
+}
+
+// SetOverview sets the "overview" field.
+func (_u *ActivityLogUpdate) SetOverview(v string) *ActivityLogUpdate {
+	_u.mutation.SetOverview(v)
+	return _u
+}
+
+// SetNillableOverview sets the "overview" field if the given value is not nil.
+func (_u *ActivityLogUpdate) SetNillableOverview(v *string) *ActivityLogUpdate {
+	if v != nil {
+		_u.SetOverview(*v)
+	}
+	return _u
+}
+
+// ClearOverview clears the value of the "overview" field.
+func (_u *ActivityLogUpdate) ClearOverview() *ActivityLogUpdate {
+	_u.mutation.ClearOverview()
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *ActivityLogUpdate) SetUserID(v uuid.UUID) *ActivityLogUpdate {
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *ActivityLogUpdate) SetNillableUserID(v *uuid.UUID) *ActivityLogUpdate {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (_u *ActivityLogUpdate) ClearUserID() *ActivityLogUpdate {
+	_u.mutation.ClearUserID()
+	return _u
+}
+
+// SetItemID sets the "item_id" field.
+func (_u *ActivityLogUpdate) SetItemID(v string) *ActivityLogUpdate {
+	_u.mutation.SetItemID(v)
+	return _u
+}
+
+// SetNillableItemID sets the "item_id" field if the given value is not nil.
+func (_u *ActivityLogUpdate) SetNillableItemID(v *string) *ActivityLogUpdate {
+	if v != nil {
+		_u.SetItemID(*v)
+	}
+	return _u
+}
+
+// ClearItemID clears the value of the "item_id" field.
+func (_u *ActivityLogUpdate) ClearItemID() *ActivityLogUpdate {
+	_u.mutation.ClearItemID()
+	return _u
+}
+
+// SetSeverity sets the "severity" field.
+func (_u *ActivityLogUpdate) SetSeverity(v string) *ActivityLogUpdate {
+	_u.mutation.SetSeverity(v)
+	return _u
+}
+
+// SetNillableSeverity sets the "severity" field if the given value is not nil.
+func (_u *ActivityLogUpdate) SetNillableSeverity(v *string) *ActivityLogUpdate {
+	if v != nil {
+		_u.SetSeverity(*v)
+	}
+	return _u
+}
+
+// Mutation returns the ActivityLogMutation object of the builder.
+func (_u *ActivityLogUpdate) Mutation() *ActivityLogMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *ActivityLogUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ActivityLogUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *ActivityLogUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ActivityLogUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ActivityLogUpdate) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := activitylog.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "ActivityLog.name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.EventType(); ok {
+		if err := activitylog.EventTypeValidator(v); err != nil {
+			return &ValidationError{Name: "event_type", err: fmt.Errorf(`ent: validator failed for field "ActivityLog.event_type": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *ActivityLogUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(activitylog.Table, activitylog.Columns, sqlgraph.NewFieldSpec(activitylog.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(activitylog.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.EventType(); ok {
+		_spec.SetField(activitylog.FieldEventType, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ShortOverview(); ok {
+		_spec.SetField(activitylog.FieldShortOverview, field.TypeString, value)
+	}
+	if _u.mutation.ShortOverviewCleared() {
+		_spec.ClearField(activitylog.FieldShortOverview, field.TypeString)
+	}
+	if value, ok := _u.mutation.Overview(); ok {
+		_spec.SetField(activitylog.FieldOverview, field.TypeString, value)
+	}
+	if _u.mutation.OverviewCleared() {
+		_spec.ClearField(activitylog.FieldOverview, field.TypeString)
+	}
+	if value, ok := _u.mutation.UserID(); ok {
+		_spec.SetField(activitylog.FieldUserID, field.TypeUUID, value)
+	}
+	if _u.mutation.UserIDCleared() {
+		_spec.ClearField(activitylog.FieldUserID, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.ItemID(); ok {
+		_spec.SetField(activitylog.FieldItemID, field.TypeString, value)
+	}
+	if _u.mutation.ItemIDCleared() {
+		_spec.ClearField(activitylog.FieldItemID, field.TypeString)
+	}
+	if value, ok := _u.mutation.Severity(); ok {
+		_spec.SetField(activitylog.FieldSeverity, field.TypeString, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{activitylog.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// ActivityLogUpdateOne is the builder for updating a single ActivityLog entity.
+type ActivityLogUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ActivityLogMutation
+}
+
+// SetName sets the "name" field.
+func (_u *ActivityLogUpdateOne) SetName(v string) *ActivityLogUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *ActivityLogUpdateOne) SetNillableName(v *string) *ActivityLogUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetEventType sets the "event_type" field.
+func (_u *ActivityLogUpdateOne) SetEventType(v string) *ActivityLogUpdateOne {
+	_u.mutation.SetEventType(v)
+	return _u
+}
+
+// SetNillableEventType sets the "event_type" field if the given value is not nil.
+func (_u *ActivityLogUpdateOne) SetNillableEventType(v *string) *ActivityLogUpdateOne {
+	if v != nil {
+		_u.SetEventType(*v)
+	}
+	return _u
+}
+
+// SetShortOverview sets the "short_overview" field.
+func (_u *ActivityLogUpdateOne) SetShortOverview(v string) *ActivityLogUpdateOne {
+	_u.mutation.SetShortOverview(v)
+	return _u
+}
+
+// SetNillableShortOverview sets the "short_overview" field if the given value is not nil.
+func (_u *ActivityLogUpdateOne) SetNillableShortOverview(v *string) *ActivityLogUpdateOne {
+	if v != nil {
+		_u.SetShortOverview(*v)
+	}
+	return _u
+}
+
+// ClearShortOverview clears the value of the "short_overview" field.
+func (_u *ActivityLogUpdateOne) ClearShortOverview() *ActivityLogUpdateOne {
+	_u.mutation.ClearShortOverview()
+	return _u
+}
+
+// SetOverview sets the "overview" field.
+func (_u *ActivityLogUpdateOne) SetOverview(v string) *ActivityLogUpdateOne {
+	_u.mutation.SetOverview(v)
+	return _u
+}
+
+// SetNillableOverview sets the "overview" field if the given value is not nil.
+func (_u *ActivityLogUpdateOne) SetNillableOverview(v *string) *ActivityLogUpdateOne {
+	if v != nil {
+		_u.SetOverview(*v)
+	}
+	return _u
+}
+
+// ClearOverview clears the value of the "overview" field.
+func (_u *ActivityLogUpdateOne) ClearOverview() *ActivityLogUpdateOne {
+	_u.mutation.ClearOverview()
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *ActivityLogUpdateOne) SetUserID(v uuid.UUID) *ActivityLogUpdateOne {
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *ActivityLogUpdateOne) SetNillableUserID(v *uuid.UUID) *ActivityLogUpdateOne {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (_u *ActivityLogUpdateOne) ClearUserID() *ActivityLogUpdateOne {
+	_u.mutation.ClearUserID()
+	return _u
+}
+
+// SetItemID sets the "item_id" field.
+func (_u *ActivityLogUpdateOne) SetItemID(v string) *ActivityLogUpdateOne {
+	_u.mutation.SetItemID(v)
+	return _u
+}
+
+// SetNillableItemID sets the "item_id" field if the given value is not nil.
+func (_u *ActivityLogUpdateOne) SetNillableItemID(v *string) *ActivityLogUpdateOne {
+	if v != nil {
+		_u.SetItemID(*v)
+	}
+	return _u
+}
+
+// ClearItemID clears the value of the "item_id" field.
+func (_u *ActivityLogUpdateOne) ClearItemID() *ActivityLogUpdateOne {
+	_u.mutation.ClearItemID()
+	return _u
+}
+
+// SetSeverity sets the "severity" field.
+func (_u *ActivityLogUpdateOne) SetSeverity(v string) *ActivityLogUpdateOne {
+	_u.mutation.SetSeverity(v)
+	return _u
+}
+
+// SetNillableSeverity sets the "severity" field if the given value is not nil.
+func (_u *ActivityLogUpdateOne) SetNillableSeverity(v *string) *ActivityLogUpdateOne {
+	if v != nil {
+		_u.SetSeverity(*v)
+	}
+	return _u
+}
+
+// Mutation returns the ActivityLogMutation object of the builder.
+func (_u *ActivityLogUpdateOne) Mutation() *ActivityLogMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the ActivityLogUpdate builder.
+func (_u *ActivityLogUpdateOne) Where(ps ...predicate.ActivityLog) *ActivityLogUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *ActivityLogUpdateOne) Select(field string, fields ...string) *ActivityLogUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated ActivityLog entity.
+func (_u *ActivityLogUpdateOne) Save(ctx context.Context) (*ActivityLog, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ActivityLogUpdateOne) SaveX(ctx context.Context) *ActivityLog {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *ActivityLogUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ActivityLogUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ActivityLogUpdateOne) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := activitylog.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "ActivityLog.name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.EventType(); ok {
+		if err := activitylog.EventTypeValidator(v); err != nil {
+			return &ValidationError{Name: "event_type", err: fmt.Errorf(`ent: validator failed for field "ActivityLog.event_type": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *ActivityLogUpdateOne) sqlSave(ctx context.Context) (_node *ActivityLog, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(activitylog.Table, activitylog.Columns, sqlgraph.NewFieldSpec(activitylog.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ActivityLog.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, activitylog.FieldID)
+		for _, f := range fields {
+			if !activitylog.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != activitylog.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(activitylog.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.EventType(); ok {
+		_spec.SetField(activitylog.FieldEventType, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ShortOverview(); ok {
+		_spec.SetField(activitylog.FieldShortOverview, field.TypeString, value)
+	}
+	if _u.mutation.ShortOverviewCleared() {
+		_spec.ClearField(activitylog.FieldShortOverview, field.TypeString)
+	}
+	if value, ok := _u.mutation.Overview(); ok {
+		_spec.SetField(activitylog.FieldOverview, field.TypeString, value)
+	}
+	if _u.mutation.OverviewCleared() {
+		_spec.ClearField(activitylog.FieldOverview, field.TypeString)
+	}
+	if value, ok := _u.mutation.UserID(); ok {
+		_spec.SetField(activitylog.FieldUserID, field.TypeUUID, value)
+	}
+	if _u.mutation.UserIDCleared() {
+		_spec.ClearField(activitylog.FieldUserID, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.ItemID(); ok {
+		_spec.SetField(activitylog.FieldItemID, field.TypeString, value)
+	}
+	if _u.mutation.ItemIDCleared() {
+		_spec.ClearField(activitylog.FieldItemID, field.TypeString)
+	}
+	if value, ok := _u.mutation.Severity(); ok {
+		_spec.SetField(activitylog.FieldSeverity, field.TypeString, value)
+	}
+	_node = &ActivityLog{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{activitylog.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,197 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/ddevcap/jellyfin-proxy/ent/apppassword"
+	"github.com/ddevcap/jellyfin-proxy/ent/user"
+	"github.com/google/uuid"
+)
+
+// AppPassword is the model entity for the AppPassword schema.
+type AppPassword struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// PasswordHash holds the value of the "password_hash" field.
+	PasswordHash string `json:"-"`
+	// LastUsedAt holds the value of the "last_used_at" field.
+	LastUsedAt *time.Time `json:"last_used_at,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the AppPasswordQuery when eager-loading is set.
+	Edges              AppPasswordEdges `json:"edges"`
+	user_app_passwords *uuid.UUID
+	selectValues       sql.SelectValues
+}
+
+// AppPasswordEdges holds the relations/edges for other nodes in the graph.
+type AppPasswordEdges struct {
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// Sessions holds the value of the sessions edge.
+	Sessions []*Session `json:"sessions,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e AppPasswordEdges) UserOrErr() (*User, error) {
+	if e.User != nil {
+		return e.User, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// SessionsOrErr returns the Sessions value or an error if the edge
+// was not loaded in eager-loading.
+func (e AppPasswordEdges) SessionsOrErr() ([]*Session, error) {
+	if e.loadedTypes[1] {
+		return e.Sessions, nil
+	}
+	return nil, &NotLoadedError{edge: "sessions"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*AppPassword) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case apppassword.FieldName, apppassword.FieldPasswordHash:
+			values[i] = new(sql.NullString)
+		case apppassword.FieldLastUsedAt, apppassword.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case apppassword.FieldID:
+			values[i] = new(uuid.UUID)
+		case apppassword.ForeignKeys[0]: // user_app_passwords
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the AppPassword fields.
+func (_m *AppPassword) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case apppassword.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case apppassword.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				_m.Name = value.String
+			}
+		case apppassword.FieldPasswordHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field password_hash", values[i])
+			} else if value.Valid {
+				_m.PasswordHash = value.String
+			}
+		case apppassword.FieldLastUsedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field last_used_at", values[i])
+			} else if value.Valid {
+				_m.LastUsedAt = new(time.Time)
+				*_m.LastUsedAt = value.Time
+			}
+		case apppassword.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case apppassword.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field user_app_passwords", values[i])
+			} else if value.Valid {
+				_m.user_app_passwords = new(uuid.UUID)
+				*_m.user_app_passwords = *value.S.(*uuid.UUID)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the AppPassword.
+// This includes values selected through modifiers, order, etc.
+func (_m *AppPassword) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryUser queries the "user" edge of the AppPassword entity.
+func (_m *AppPassword) QueryUser() *UserQuery {
+	return NewAppPasswordClient(_m.config).QueryUser(_m)
+}
+
+// QuerySessions queries the "sessions" edge of the AppPassword entity.
+func (_m *AppPassword) QuerySessions() *SessionQuery {
+	return NewAppPasswordClient(_m.config).QuerySessions(_m)
+}
+
+// Update returns a builder for updating this AppPassword.
+// Note that you need to call AppPassword.Unwrap() before calling this method if this AppPassword
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *AppPassword) Update() *AppPasswordUpdateOne {
+	return NewAppPasswordClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the AppPassword entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *AppPassword) Unwrap() *AppPassword {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: AppPassword is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *AppPassword) String() string {
+	var builder strings.Builder
+	builder.WriteString("AppPassword(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("name=")
+	builder.WriteString(_m.Name)
+	builder.WriteString(", ")
+	builder.WriteString("password_hash=<sensitive>")
+	builder.WriteString(", ")
+	if v := _m.LastUsedAt; v != nil {
+		builder.WriteString("last_used_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// AppPasswords is a parsable slice of AppPassword.
+type AppPasswords []*AppPassword
@@ -40,9 +40,11 @@ type Backend struct {
 type BackendEdges struct {
 	// BackendUsers holds the value of the backend_users edge.
 	BackendUsers []*BackendUser `json:"backend_users,omitempty"`
+	// Items holds the value of the items edge.
+	Items []*Item `json:"items,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [1]bool
+	loadedTypes [2]bool
 }
 
 // BackendUsersOrErr returns the BackendUsers value or an error if the edge
@@ -54,6 +56,15 @@ func (e BackendEdges) BackendUsersOrErr() ([]*BackendUser, error) {
 	return nil, &NotLoadedError{edge: "backend_users"}
 }
 
+// ItemsOrErr returns the Items value or an error if the edge
+// was not loaded in eager-loading.
+func (e BackendEdges) ItemsOrErr() ([]*Item, error) {
+	if e.loadedTypes[1] {
+		return e.Items, nil
+	}
+	return nil, &NotLoadedError{edge: "items"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Backend) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
@@ -142,6 +153,11 @@ func (_m *Backend) QueryBackendUsers() *BackendUserQuery {
 	return NewBackendClient(_m.config).QueryBackendUsers(_m)
 }
 
+// QueryItems queries the "items" edge of the Backend entity.
+func (_m *Backend) QueryItems() *ItemQuery {
+	return NewBackendClient(_m.config).QueryItems(_m)
+}
+
 // Update returns a builder for updating this Backend.
 // Note that you need to call Backend.Unwrap() before calling this method if this Backend
 // was returned from a transaction, and the transaction was committed or rolled back.
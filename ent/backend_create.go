@@ -12,6 +12,7 @@ import (
 	"entgo.io/ent/schema/field"
 	"github.com/ddevcap/jellyfin-proxy/ent/backend"
 	"github.com/ddevcap/jellyfin-proxy/ent/backenduser"
+	"github.com/ddevcap/jellyfin-proxy/ent/item"
 	"github.com/google/uuid"
 )
 
@@ -103,6 +104,21 @@ func (_c *BackendCreate) AddBackendUsers(v ...*BackendUser) *BackendCreate {
 	return _c.AddBackendUserIDs(ids...)
 }
 
+// AddItemIDs adds the "items" edge to the Item entity by IDs.
+func (_c *BackendCreate) AddItemIDs(ids ...uuid.UUID) *BackendCreate {
+	_c.mutation.AddItemIDs(ids...)
+	return _c
+}
+
+// AddItems adds the "items" edges to the Item entity.
+func (_c *BackendCreate) AddItems(v ...*Item) *BackendCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddItemIDs(ids...)
+}
+
 // Mutation returns the BackendMutation object of the builder.
 func (_c *BackendCreate) Mutation() *BackendMutation {
 	return _c.mutation
@@ -267,6 +283,22 @@ func (_c *BackendCreate) createSpec() (*Backend, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.ItemsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   backend.ItemsTable,
+			Columns: []string{backend.ItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(item.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
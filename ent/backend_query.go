@@ -14,6 +14,7 @@ import (
 	"entgo.io/ent/schema/field"
 	"github.com/ddevcap/jellyfin-proxy/ent/backend"
 	"github.com/ddevcap/jellyfin-proxy/ent/backenduser"
+	"github.com/ddevcap/jellyfin-proxy/ent/item"
 	"github.com/ddevcap/jellyfin-proxy/ent/predicate"
 	"github.com/google/uuid"
 )
@@ -26,6 +27,7 @@ type BackendQuery struct {
 	inters           []Interceptor
 	predicates       []predicate.Backend
 	withBackendUsers *BackendUserQuery
+	withItems        *ItemQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -84,6 +86,28 @@ func (_q *BackendQuery) QueryBackendUsers() *BackendUserQuery {
 	return query
 }
 
+// QueryItems chains the current query on the "items" edge.
+func (_q *BackendQuery) QueryItems() *ItemQuery {
+	query := (&ItemClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(backend.Table, backend.FieldID, selector),
+			sqlgraph.To(item.Table, item.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, backend.ItemsTable, backend.ItemsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Backend entity from the query.
 // Returns a *NotFoundError when no Backend was found.
 func (_q *BackendQuery) First(ctx context.Context) (*Backend, error) {
@@ -277,6 +301,7 @@ func (_q *BackendQuery) Clone() *BackendQuery {
 		inters:           append([]Interceptor{}, _q.inters...),
 		predicates:       append([]predicate.Backend{}, _q.predicates...),
 		withBackendUsers: _q.withBackendUsers.Clone(),
+		withItems:        _q.withItems.Clone(),
 		// clone intermediate query.
 		sql:  _q.sql.Clone(),
 		path: _q.path,
@@ -294,6 +319,17 @@ func (_q *BackendQuery) WithBackendUsers(opts ...func(*BackendUserQuery)) *Backe
 	return _q
 }
 
+// WithItems tells the query-builder to eager-load the nodes that are connected to
+// the "items" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *BackendQuery) WithItems(opts ...func(*ItemQuery)) *BackendQuery {
+	query := (&ItemClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withItems = query
+	return _q
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -372,8 +408,9 @@ func (_q *BackendQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Back
 	var (
 		nodes       = []*Backend{}
 		_spec       = _q.querySpec()
-		loadedTypes = [1]bool{
+		loadedTypes = [2]bool{
 			_q.withBackendUsers != nil,
+			_q.withItems != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -401,6 +438,13 @@ func (_q *BackendQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Back
 			return nil, err
 		}
 	}
+	if query := _q.withItems; query != nil {
+		if err := _q.loadItems(ctx, query, nodes,
+			func(n *Backend) { n.Edges.Items = []*Item{} },
+			func(n *Backend, e *Item) { n.Edges.Items = append(n.Edges.Items, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -435,6 +479,37 @@ func (_q *BackendQuery) loadBackendUsers(ctx context.Context, query *BackendUser
 	}
 	return nil
 }
+func (_q *BackendQuery) loadItems(ctx context.Context, query *ItemQuery, nodes []*Backend, init func(*Backend), assign func(*Backend, *Item)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Backend)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.Item(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(backend.ItemsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.backend_items
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "backend_items" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "backend_items" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (_q *BackendQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
@@ -12,6 +12,7 @@ import (
 	"entgo.io/ent/schema/field"
 	"github.com/ddevcap/jellyfin-proxy/ent/backend"
 	"github.com/ddevcap/jellyfin-proxy/ent/backenduser"
+	"github.com/ddevcap/jellyfin-proxy/ent/item"
 	"github.com/ddevcap/jellyfin-proxy/ent/predicate"
 	"github.com/google/uuid"
 )
@@ -114,6 +115,21 @@ func (_u *BackendUpdate) AddBackendUsers(v ...*BackendUser) *BackendUpdate {
 	return _u.AddBackendUserIDs(ids...)
 }
 
+// AddItemIDs adds the "items" edge to the Item entity by IDs.
+func (_u *BackendUpdate) AddItemIDs(ids ...uuid.UUID) *BackendUpdate {
+	_u.mutation.AddItemIDs(ids...)
+	return _u
+}
+
+// AddItems adds the "items" edges to the Item entity.
+func (_u *BackendUpdate) AddItems(v ...*Item) *BackendUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddItemIDs(ids...)
+}
+
 // Mutation returns the BackendMutation object of the builder.
 func (_u *BackendUpdate) Mutation() *BackendMutation {
 	return _u.mutation
@@ -140,6 +156,27 @@ func (_u *BackendUpdate) RemoveBackendUsers(v ...*BackendUser) *BackendUpdate {
 	return _u.RemoveBackendUserIDs(ids...)
 }
 
+// ClearItems clears all "items" edges to the Item entity.
+func (_u *BackendUpdate) ClearItems() *BackendUpdate {
+	_u.mutation.ClearItems()
+	return _u
+}
+
+// RemoveItemIDs removes the "items" edge to Item entities by IDs.
+func (_u *BackendUpdate) RemoveItemIDs(ids ...uuid.UUID) *BackendUpdate {
+	_u.mutation.RemoveItemIDs(ids...)
+	return _u
+}
+
+// RemoveItems removes "items" edges to Item entities.
+func (_u *BackendUpdate) RemoveItems(v ...*Item) *BackendUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveItemIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *BackendUpdate) Save(ctx context.Context) (int, error) {
 	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
@@ -264,6 +301,51 @@ func (_u *BackendUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.ItemsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   backend.ItemsTable,
+			Columns: []string{backend.ItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(item.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedItemsIDs(); len(nodes) > 0 && !_u.mutation.ItemsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   backend.ItemsTable,
+			Columns: []string{backend.ItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(item.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ItemsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   backend.ItemsTable,
+			Columns: []string{backend.ItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(item.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{backend.Label}
@@ -369,6 +451,21 @@ func (_u *BackendUpdateOne) AddBackendUsers(v ...*BackendUser) *BackendUpdateOne
 	return _u.AddBackendUserIDs(ids...)
 }
 
+// AddItemIDs adds the "items" edge to the Item entity by IDs.
+func (_u *BackendUpdateOne) AddItemIDs(ids ...uuid.UUID) *BackendUpdateOne {
+	_u.mutation.AddItemIDs(ids...)
+	return _u
+}
+
+// AddItems adds the "items" edges to the Item entity.
+func (_u *BackendUpdateOne) AddItems(v ...*Item) *BackendUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddItemIDs(ids...)
+}
+
 // Mutation returns the BackendMutation object of the builder.
 func (_u *BackendUpdateOne) Mutation() *BackendMutation {
 	return _u.mutation
@@ -395,6 +492,27 @@ func (_u *BackendUpdateOne) RemoveBackendUsers(v ...*BackendUser) *BackendUpdate
 	return _u.RemoveBackendUserIDs(ids...)
 }
 
+// ClearItems clears all "items" edges to the Item entity.
+func (_u *BackendUpdateOne) ClearItems() *BackendUpdateOne {
+	_u.mutation.ClearItems()
+	return _u
+}
+
+// RemoveItemIDs removes the "items" edge to Item entities by IDs.
+func (_u *BackendUpdateOne) RemoveItemIDs(ids ...uuid.UUID) *BackendUpdateOne {
+	_u.mutation.RemoveItemIDs(ids...)
+	return _u
+}
+
+// RemoveItems removes "items" edges to Item entities.
+func (_u *BackendUpdateOne) RemoveItems(v ...*Item) *BackendUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveItemIDs(ids...)
+}
+
 // Where appends a list predicates to the BackendUpdate builder.
 func (_u *BackendUpdateOne) Where(ps ...predicate.Backend) *BackendUpdateOne {
 	_u.mutation.Where(ps...)
@@ -549,6 +667,51 @@ func (_u *BackendUpdateOne) sqlSave(ctx context.Context) (_node *Backend, err er
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.ItemsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   backend.ItemsTable,
+			Columns: []string{backend.ItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(item.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedItemsIDs(); len(nodes) > 0 && !_u.mutation.ItemsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   backend.ItemsTable,
+			Columns: []string{backend.ItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(item.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ItemsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   backend.ItemsTable,
+			Columns: []string{backend.ItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(item.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &Backend{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
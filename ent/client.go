@@ -16,10 +16,20 @@ import (
 	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/ddevcap/jellyfin-proxy/ent/activitylog"
+	"github.com/ddevcap/jellyfin-proxy/ent/apikey"
+	"github.com/ddevcap/jellyfin-proxy/ent/apppassword"
 	"github.com/ddevcap/jellyfin-proxy/ent/backend"
 	"github.com/ddevcap/jellyfin-proxy/ent/backenduser"
+	"github.com/ddevcap/jellyfin-proxy/ent/deviceoption"
+	"github.com/ddevcap/jellyfin-proxy/ent/displaypreference"
+	"github.com/ddevcap/jellyfin-proxy/ent/duplicateitem"
+	"github.com/ddevcap/jellyfin-proxy/ent/item"
+	"github.com/ddevcap/jellyfin-proxy/ent/playbackevent"
+	"github.com/ddevcap/jellyfin-proxy/ent/recoverycode"
 	"github.com/ddevcap/jellyfin-proxy/ent/session"
 	"github.com/ddevcap/jellyfin-proxy/ent/user"
+	"github.com/ddevcap/jellyfin-proxy/ent/userconfiguration"
 )
 
 // Client is the client that holds all ent builders.
@@ -27,14 +37,34 @@ type Client struct {
 	config
 	// Schema is the client for creating, migrating and dropping schema.
 	Schema *migrate.Schema
+	// ActivityLog is the client for interacting with the ActivityLog builders.
+	ActivityLog *ActivityLogClient
+	// ApiKey is the client for interacting with the ApiKey builders.
+	ApiKey *ApiKeyClient
+	// AppPassword is the client for interacting with the AppPassword builders.
+	AppPassword *AppPasswordClient
 	// Backend is the client for interacting with the Backend builders.
 	Backend *BackendClient
 	// BackendUser is the client for interacting with the BackendUser builders.
 	BackendUser *BackendUserClient
+	// DeviceOption is the client for interacting with the DeviceOption builders.
+	DeviceOption *DeviceOptionClient
+	// DisplayPreference is the client for interacting with the DisplayPreference builders.
+	DisplayPreference *DisplayPreferenceClient
+	// DuplicateItem is the client for interacting with the DuplicateItem builders.
+	DuplicateItem *DuplicateItemClient
+	// Item is the client for interacting with the Item builders.
+	Item *ItemClient
+	// PlaybackEvent is the client for interacting with the PlaybackEvent builders.
+	PlaybackEvent *PlaybackEventClient
+	// RecoveryCode is the client for interacting with the RecoveryCode builders.
+	RecoveryCode *RecoveryCodeClient
 	// Session is the client for interacting with the Session builders.
 	Session *SessionClient
 	// User is the client for interacting with the User builders.
 	User *UserClient
+	// UserConfiguration is the client for interacting with the UserConfiguration builders.
+	UserConfiguration *UserConfigurationClient
 }
 
 // NewClient creates a new client configured with the given options.
@@ -46,10 +76,20 @@ func NewClient(opts ...Option) *Client {
 
 func (c *Client) init() {
 	c.Schema = migrate.NewSchema(c.driver)
+	c.ActivityLog = NewActivityLogClient(c.config)
+	c.ApiKey = NewApiKeyClient(c.config)
+	c.AppPassword = NewAppPasswordClient(c.config)
 	c.Backend = NewBackendClient(c.config)
 	c.BackendUser = NewBackendUserClient(c.config)
+	c.DeviceOption = NewDeviceOptionClient(c.config)
+	c.DisplayPreference = NewDisplayPreferenceClient(c.config)
+	c.DuplicateItem = NewDuplicateItemClient(c.config)
+	c.Item = NewItemClient(c.config)
+	c.PlaybackEvent = NewPlaybackEventClient(c.config)
+	c.RecoveryCode = NewRecoveryCodeClient(c.config)
 	c.Session = NewSessionClient(c.config)
 	c.User = NewUserClient(c.config)
+	c.UserConfiguration = NewUserConfigurationClient(c.config)
 }
 
 type (
@@ -140,12 +180,22 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 	cfg := c.config
 	cfg.driver = tx
 	return &Tx{
-		ctx:         ctx,
-		config:      cfg,
-		Backend:     NewBackendClient(cfg),
-		BackendUser: NewBackendUserClient(cfg),
-		Session:     NewSessionClient(cfg),
-		User:        NewUserClient(cfg),
+		ctx:               ctx,
+		config:            cfg,
+		ActivityLog:       NewActivityLogClient(cfg),
+		ApiKey:            NewApiKeyClient(cfg),
+		AppPassword:       NewAppPasswordClient(cfg),
+		Backend:           NewBackendClient(cfg),
+		BackendUser:       NewBackendUserClient(cfg),
+		DeviceOption:      NewDeviceOptionClient(cfg),
+		DisplayPreference: NewDisplayPreferenceClient(cfg),
+		DuplicateItem:     NewDuplicateItemClient(cfg),
+		Item:              NewItemClient(cfg),
+		PlaybackEvent:     NewPlaybackEventClient(cfg),
+		RecoveryCode:      NewRecoveryCodeClient(cfg),
+		Session:           NewSessionClient(cfg),
+		User:              NewUserClient(cfg),
+		UserConfiguration: NewUserConfigurationClient(cfg),
 	}, nil
 }
 
@@ -163,19 +213,29 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 	cfg := c.config
 	cfg.driver = &txDriver{tx: tx, drv: c.driver}
 	return &Tx{
-		ctx:         ctx,
-		config:      cfg,
-		Backend:     NewBackendClient(cfg),
-		BackendUser: NewBackendUserClient(cfg),
-		Session:     NewSessionClient(cfg),
-		User:        NewUserClient(cfg),
+		ctx:               ctx,
+		config:            cfg,
+		ActivityLog:       NewActivityLogClient(cfg),
+		ApiKey:            NewApiKeyClient(cfg),
+		AppPassword:       NewAppPasswordClient(cfg),
+		Backend:           NewBackendClient(cfg),
+		BackendUser:       NewBackendUserClient(cfg),
+		DeviceOption:      NewDeviceOptionClient(cfg),
+		DisplayPreference: NewDisplayPreferenceClient(cfg),
+		DuplicateItem:     NewDuplicateItemClient(cfg),
+		Item:              NewItemClient(cfg),
+		PlaybackEvent:     NewPlaybackEventClient(cfg),
+		RecoveryCode:      NewRecoveryCodeClient(cfg),
+		Session:           NewSessionClient(cfg),
+		User:              NewUserClient(cfg),
+		UserConfiguration: NewUserConfigurationClient(cfg),
 	}, nil
 }
 
 // Debug returns a new debug-client. It's used to get verbose logging on specific operations.
 //
 //	client.Debug().
-//		Backend.
+//		ActivityLog.
 //		Query().
 //		Count(ctx)
 func (c *Client) Debug() *Client {
@@ -197,138 +257,1489 @@ func (c *Client) Close() error {
 // Use adds the mutation hooks to all the entity clients.
 // In order to add hooks to a specific client, call: `client.Node.Use(...)`.
 func (c *Client) Use(hooks ...Hook) {
-	c.Backend.Use(hooks...)
-	c.BackendUser.Use(hooks...)
-	c.Session.Use(hooks...)
-	c.User.Use(hooks...)
+	for _, n := range []interface{ Use(...Hook) }{
+		c.ActivityLog, c.ApiKey, c.AppPassword, c.Backend, c.BackendUser,
+		c.DeviceOption, c.DisplayPreference, c.DuplicateItem, c.Item, c.PlaybackEvent,
+		c.RecoveryCode, c.Session, c.User, c.UserConfiguration,
+	} {
+		n.Use(hooks...)
+	}
 }
 
 // Intercept adds the query interceptors to all the entity clients.
 // In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
 func (c *Client) Intercept(interceptors ...Interceptor) {
-	c.Backend.Intercept(interceptors...)
-	c.BackendUser.Intercept(interceptors...)
-	c.Session.Intercept(interceptors...)
-	c.User.Intercept(interceptors...)
+	for _, n := range []interface{ Intercept(...Interceptor) }{
+		c.ActivityLog, c.ApiKey, c.AppPassword, c.Backend, c.BackendUser,
+		c.DeviceOption, c.DisplayPreference, c.DuplicateItem, c.Item, c.PlaybackEvent,
+		c.RecoveryCode, c.Session, c.User, c.UserConfiguration,
+	} {
+		n.Intercept(interceptors...)
+	}
 }
 
 // Mutate implements the ent.Mutator interface.
 func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 	switch m := m.(type) {
+	case *ActivityLogMutation:
+		return c.ActivityLog.mutate(ctx, m)
+	case *ApiKeyMutation:
+		return c.ApiKey.mutate(ctx, m)
+	case *AppPasswordMutation:
+		return c.AppPassword.mutate(ctx, m)
 	case *BackendMutation:
 		return c.Backend.mutate(ctx, m)
 	case *BackendUserMutation:
 		return c.BackendUser.mutate(ctx, m)
+	case *DeviceOptionMutation:
+		return c.DeviceOption.mutate(ctx, m)
+	case *DisplayPreferenceMutation:
+		return c.DisplayPreference.mutate(ctx, m)
+	case *DuplicateItemMutation:
+		return c.DuplicateItem.mutate(ctx, m)
+	case *ItemMutation:
+		return c.Item.mutate(ctx, m)
+	case *PlaybackEventMutation:
+		return c.PlaybackEvent.mutate(ctx, m)
+	case *RecoveryCodeMutation:
+		return c.RecoveryCode.mutate(ctx, m)
 	case *SessionMutation:
 		return c.Session.mutate(ctx, m)
 	case *UserMutation:
 		return c.User.mutate(ctx, m)
+	case *UserConfigurationMutation:
+		return c.UserConfiguration.mutate(ctx, m)
 	default:
 		return nil, fmt.Errorf("ent: unknown mutation type %T", m)
 	}
 }
 
-// BackendClient is a client for the Backend schema.
-type BackendClient struct {
+// ActivityLogClient is a client for the ActivityLog schema.
+type ActivityLogClient struct {
 	config
 }
 
-// NewBackendClient returns a client for the Backend from the given config.
-func NewBackendClient(c config) *BackendClient {
-	return &BackendClient{config: c}
+// NewActivityLogClient returns a client for the ActivityLog from the given config.
+func NewActivityLogClient(c config) *ActivityLogClient {
+	return &ActivityLogClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `backend.Hooks(f(g(h())))`.
-func (c *BackendClient) Use(hooks ...Hook) {
-	c.hooks.Backend = append(c.hooks.Backend, hooks...)
+// A call to `Use(f, g, h)` equals to `activitylog.Hooks(f(g(h())))`.
+func (c *ActivityLogClient) Use(hooks ...Hook) {
+	c.hooks.ActivityLog = append(c.hooks.ActivityLog, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `backend.Intercept(f(g(h())))`.
-func (c *BackendClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Backend = append(c.inters.Backend, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `activitylog.Intercept(f(g(h())))`.
+func (c *ActivityLogClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ActivityLog = append(c.inters.ActivityLog, interceptors...)
 }
 
-// Create returns a builder for creating a Backend entity.
-func (c *BackendClient) Create() *BackendCreate {
-	mutation := newBackendMutation(c.config, OpCreate)
-	return &BackendCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a ActivityLog entity.
+func (c *ActivityLogClient) Create() *ActivityLogCreate {
+	mutation := newActivityLogMutation(c.config, OpCreate)
+	return &ActivityLogCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ActivityLog entities.
+func (c *ActivityLogClient) CreateBulk(builders ...*ActivityLogCreate) *ActivityLogCreateBulk {
+	return &ActivityLogCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ActivityLogClient) MapCreateBulk(slice any, setFunc func(*ActivityLogCreate, int)) *ActivityLogCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ActivityLogCreateBulk{err: fmt.Errorf("calling to ActivityLogClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ActivityLogCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ActivityLogCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ActivityLog.
+func (c *ActivityLogClient) Update() *ActivityLogUpdate {
+	mutation := newActivityLogMutation(c.config, OpUpdate)
+	return &ActivityLogUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ActivityLogClient) UpdateOne(_m *ActivityLog) *ActivityLogUpdateOne {
+	mutation := newActivityLogMutation(c.config, OpUpdateOne, withActivityLog(_m))
+	return &ActivityLogUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ActivityLogClient) UpdateOneID(id uuid.UUID) *ActivityLogUpdateOne {
+	mutation := newActivityLogMutation(c.config, OpUpdateOne, withActivityLogID(id))
+	return &ActivityLogUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ActivityLog.
+func (c *ActivityLogClient) Delete() *ActivityLogDelete {
+	mutation := newActivityLogMutation(c.config, OpDelete)
+	return &ActivityLogDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ActivityLogClient) DeleteOne(_m *ActivityLog) *ActivityLogDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ActivityLogClient) DeleteOneID(id uuid.UUID) *ActivityLogDeleteOne {
+	builder := c.Delete().Where(activitylog.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ActivityLogDeleteOne{builder}
+}
+
+// Query returns a query builder for ActivityLog.
+func (c *ActivityLogClient) Query() *ActivityLogQuery {
+	return &ActivityLogQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeActivityLog},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ActivityLog entity by its id.
+func (c *ActivityLogClient) Get(ctx context.Context, id uuid.UUID) (*ActivityLog, error) {
+	return c.Query().Where(activitylog.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ActivityLogClient) GetX(ctx context.Context, id uuid.UUID) *ActivityLog {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *ActivityLogClient) Hooks() []Hook {
+	return c.hooks.ActivityLog
+}
+
+// Interceptors returns the client interceptors.
+func (c *ActivityLogClient) Interceptors() []Interceptor {
+	return c.inters.ActivityLog
+}
+
+func (c *ActivityLogClient) mutate(ctx context.Context, m *ActivityLogMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ActivityLogCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ActivityLogUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ActivityLogUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ActivityLogDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown ActivityLog mutation op: %q", m.Op())
+	}
+}
+
+// ApiKeyClient is a client for the ApiKey schema.
+type ApiKeyClient struct {
+	config
+}
+
+// NewApiKeyClient returns a client for the ApiKey from the given config.
+func NewApiKeyClient(c config) *ApiKeyClient {
+	return &ApiKeyClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `apikey.Hooks(f(g(h())))`.
+func (c *ApiKeyClient) Use(hooks ...Hook) {
+	c.hooks.ApiKey = append(c.hooks.ApiKey, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `apikey.Intercept(f(g(h())))`.
+func (c *ApiKeyClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ApiKey = append(c.inters.ApiKey, interceptors...)
+}
+
+// Create returns a builder for creating a ApiKey entity.
+func (c *ApiKeyClient) Create() *ApiKeyCreate {
+	mutation := newApiKeyMutation(c.config, OpCreate)
+	return &ApiKeyCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ApiKey entities.
+func (c *ApiKeyClient) CreateBulk(builders ...*ApiKeyCreate) *ApiKeyCreateBulk {
+	return &ApiKeyCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ApiKeyClient) MapCreateBulk(slice any, setFunc func(*ApiKeyCreate, int)) *ApiKeyCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ApiKeyCreateBulk{err: fmt.Errorf("calling to ApiKeyClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ApiKeyCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ApiKeyCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ApiKey.
+func (c *ApiKeyClient) Update() *ApiKeyUpdate {
+	mutation := newApiKeyMutation(c.config, OpUpdate)
+	return &ApiKeyUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ApiKeyClient) UpdateOne(_m *ApiKey) *ApiKeyUpdateOne {
+	mutation := newApiKeyMutation(c.config, OpUpdateOne, withApiKey(_m))
+	return &ApiKeyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ApiKeyClient) UpdateOneID(id uuid.UUID) *ApiKeyUpdateOne {
+	mutation := newApiKeyMutation(c.config, OpUpdateOne, withApiKeyID(id))
+	return &ApiKeyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ApiKey.
+func (c *ApiKeyClient) Delete() *ApiKeyDelete {
+	mutation := newApiKeyMutation(c.config, OpDelete)
+	return &ApiKeyDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ApiKeyClient) DeleteOne(_m *ApiKey) *ApiKeyDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ApiKeyClient) DeleteOneID(id uuid.UUID) *ApiKeyDeleteOne {
+	builder := c.Delete().Where(apikey.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ApiKeyDeleteOne{builder}
+}
+
+// Query returns a query builder for ApiKey.
+func (c *ApiKeyClient) Query() *ApiKeyQuery {
+	return &ApiKeyQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeApiKey},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ApiKey entity by its id.
+func (c *ApiKeyClient) Get(ctx context.Context, id uuid.UUID) (*ApiKey, error) {
+	return c.Query().Where(apikey.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ApiKeyClient) GetX(ctx context.Context, id uuid.UUID) *ApiKey {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryUser queries the user edge of a ApiKey.
+func (c *ApiKeyClient) QueryUser(_m *ApiKey) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(apikey.Table, apikey.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, apikey.UserTable, apikey.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *ApiKeyClient) Hooks() []Hook {
+	return c.hooks.ApiKey
+}
+
+// Interceptors returns the client interceptors.
+func (c *ApiKeyClient) Interceptors() []Interceptor {
+	return c.inters.ApiKey
+}
+
+func (c *ApiKeyClient) mutate(ctx context.Context, m *ApiKeyMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ApiKeyCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ApiKeyUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ApiKeyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ApiKeyDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown ApiKey mutation op: %q", m.Op())
+	}
+}
+
+// AppPasswordClient is a client for the AppPassword schema.
+type AppPasswordClient struct {
+	config
+}
+
+// NewAppPasswordClient returns a client for the AppPassword from the given config.
+func NewAppPasswordClient(c config) *AppPasswordClient {
+	return &AppPasswordClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `apppassword.Hooks(f(g(h())))`.
+func (c *AppPasswordClient) Use(hooks ...Hook) {
+	c.hooks.AppPassword = append(c.hooks.AppPassword, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `apppassword.Intercept(f(g(h())))`.
+func (c *AppPasswordClient) Intercept(interceptors ...Interceptor) {
+	c.inters.AppPassword = append(c.inters.AppPassword, interceptors...)
+}
+
+// Create returns a builder for creating a AppPassword entity.
+func (c *AppPasswordClient) Create() *AppPasswordCreate {
+	mutation := newAppPasswordMutation(c.config, OpCreate)
+	return &AppPasswordCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of AppPassword entities.
+func (c *AppPasswordClient) CreateBulk(builders ...*AppPasswordCreate) *AppPasswordCreateBulk {
+	return &AppPasswordCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *AppPasswordClient) MapCreateBulk(slice any, setFunc func(*AppPasswordCreate, int)) *AppPasswordCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &AppPasswordCreateBulk{err: fmt.Errorf("calling to AppPasswordClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*AppPasswordCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &AppPasswordCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for AppPassword.
+func (c *AppPasswordClient) Update() *AppPasswordUpdate {
+	mutation := newAppPasswordMutation(c.config, OpUpdate)
+	return &AppPasswordUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *AppPasswordClient) UpdateOne(_m *AppPassword) *AppPasswordUpdateOne {
+	mutation := newAppPasswordMutation(c.config, OpUpdateOne, withAppPassword(_m))
+	return &AppPasswordUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *AppPasswordClient) UpdateOneID(id uuid.UUID) *AppPasswordUpdateOne {
+	mutation := newAppPasswordMutation(c.config, OpUpdateOne, withAppPasswordID(id))
+	return &AppPasswordUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for AppPassword.
+func (c *AppPasswordClient) Delete() *AppPasswordDelete {
+	mutation := newAppPasswordMutation(c.config, OpDelete)
+	return &AppPasswordDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *AppPasswordClient) DeleteOne(_m *AppPassword) *AppPasswordDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *AppPasswordClient) DeleteOneID(id uuid.UUID) *AppPasswordDeleteOne {
+	builder := c.Delete().Where(apppassword.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &AppPasswordDeleteOne{builder}
+}
+
+// Query returns a query builder for AppPassword.
+func (c *AppPasswordClient) Query() *AppPasswordQuery {
+	return &AppPasswordQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeAppPassword},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a AppPassword entity by its id.
+func (c *AppPasswordClient) Get(ctx context.Context, id uuid.UUID) (*AppPassword, error) {
+	return c.Query().Where(apppassword.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *AppPasswordClient) GetX(ctx context.Context, id uuid.UUID) *AppPassword {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryUser queries the user edge of a AppPassword.
+func (c *AppPasswordClient) QueryUser(_m *AppPassword) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(apppassword.Table, apppassword.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, apppassword.UserTable, apppassword.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QuerySessions queries the sessions edge of a AppPassword.
+func (c *AppPasswordClient) QuerySessions(_m *AppPassword) *SessionQuery {
+	query := (&SessionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(apppassword.Table, apppassword.FieldID, id),
+			sqlgraph.To(session.Table, session.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, apppassword.SessionsTable, apppassword.SessionsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *AppPasswordClient) Hooks() []Hook {
+	return c.hooks.AppPassword
+}
+
+// Interceptors returns the client interceptors.
+func (c *AppPasswordClient) Interceptors() []Interceptor {
+	return c.inters.AppPassword
+}
+
+func (c *AppPasswordClient) mutate(ctx context.Context, m *AppPasswordMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&AppPasswordCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&AppPasswordUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&AppPasswordUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&AppPasswordDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown AppPassword mutation op: %q", m.Op())
+	}
+}
+
+// BackendClient is a client for the Backend schema.
+type BackendClient struct {
+	config
+}
+
+// NewBackendClient returns a client for the Backend from the given config.
+func NewBackendClient(c config) *BackendClient {
+	return &BackendClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `backend.Hooks(f(g(h())))`.
+func (c *BackendClient) Use(hooks ...Hook) {
+	c.hooks.Backend = append(c.hooks.Backend, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `backend.Intercept(f(g(h())))`.
+func (c *BackendClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Backend = append(c.inters.Backend, interceptors...)
+}
+
+// Create returns a builder for creating a Backend entity.
+func (c *BackendClient) Create() *BackendCreate {
+	mutation := newBackendMutation(c.config, OpCreate)
+	return &BackendCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Backend entities.
+func (c *BackendClient) CreateBulk(builders ...*BackendCreate) *BackendCreateBulk {
+	return &BackendCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *BackendClient) MapCreateBulk(slice any, setFunc func(*BackendCreate, int)) *BackendCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &BackendCreateBulk{err: fmt.Errorf("calling to BackendClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*BackendCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &BackendCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Backend.
+func (c *BackendClient) Update() *BackendUpdate {
+	mutation := newBackendMutation(c.config, OpUpdate)
+	return &BackendUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *BackendClient) UpdateOne(_m *Backend) *BackendUpdateOne {
+	mutation := newBackendMutation(c.config, OpUpdateOne, withBackend(_m))
+	return &BackendUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *BackendClient) UpdateOneID(id uuid.UUID) *BackendUpdateOne {
+	mutation := newBackendMutation(c.config, OpUpdateOne, withBackendID(id))
+	return &BackendUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Backend.
+func (c *BackendClient) Delete() *BackendDelete {
+	mutation := newBackendMutation(c.config, OpDelete)
+	return &BackendDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *BackendClient) DeleteOne(_m *Backend) *BackendDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *BackendClient) DeleteOneID(id uuid.UUID) *BackendDeleteOne {
+	builder := c.Delete().Where(backend.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &BackendDeleteOne{builder}
+}
+
+// Query returns a query builder for Backend.
+func (c *BackendClient) Query() *BackendQuery {
+	return &BackendQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeBackend},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Backend entity by its id.
+func (c *BackendClient) Get(ctx context.Context, id uuid.UUID) (*Backend, error) {
+	return c.Query().Where(backend.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *BackendClient) GetX(ctx context.Context, id uuid.UUID) *Backend {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryBackendUsers queries the backend_users edge of a Backend.
+func (c *BackendClient) QueryBackendUsers(_m *Backend) *BackendUserQuery {
+	query := (&BackendUserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(backend.Table, backend.FieldID, id),
+			sqlgraph.To(backenduser.Table, backenduser.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, backend.BackendUsersTable, backend.BackendUsersColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryItems queries the items edge of a Backend.
+func (c *BackendClient) QueryItems(_m *Backend) *ItemQuery {
+	query := (&ItemClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(backend.Table, backend.FieldID, id),
+			sqlgraph.To(item.Table, item.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, backend.ItemsTable, backend.ItemsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *BackendClient) Hooks() []Hook {
+	return c.hooks.Backend
+}
+
+// Interceptors returns the client interceptors.
+func (c *BackendClient) Interceptors() []Interceptor {
+	return c.inters.Backend
+}
+
+func (c *BackendClient) mutate(ctx context.Context, m *BackendMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&BackendCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&BackendUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&BackendUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&BackendDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Backend mutation op: %q", m.Op())
+	}
+}
+
+// BackendUserClient is a client for the BackendUser schema.
+type BackendUserClient struct {
+	config
+}
+
+// NewBackendUserClient returns a client for the BackendUser from the given config.
+func NewBackendUserClient(c config) *BackendUserClient {
+	return &BackendUserClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `backenduser.Hooks(f(g(h())))`.
+func (c *BackendUserClient) Use(hooks ...Hook) {
+	c.hooks.BackendUser = append(c.hooks.BackendUser, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `backenduser.Intercept(f(g(h())))`.
+func (c *BackendUserClient) Intercept(interceptors ...Interceptor) {
+	c.inters.BackendUser = append(c.inters.BackendUser, interceptors...)
+}
+
+// Create returns a builder for creating a BackendUser entity.
+func (c *BackendUserClient) Create() *BackendUserCreate {
+	mutation := newBackendUserMutation(c.config, OpCreate)
+	return &BackendUserCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of BackendUser entities.
+func (c *BackendUserClient) CreateBulk(builders ...*BackendUserCreate) *BackendUserCreateBulk {
+	return &BackendUserCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *BackendUserClient) MapCreateBulk(slice any, setFunc func(*BackendUserCreate, int)) *BackendUserCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &BackendUserCreateBulk{err: fmt.Errorf("calling to BackendUserClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*BackendUserCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &BackendUserCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for BackendUser.
+func (c *BackendUserClient) Update() *BackendUserUpdate {
+	mutation := newBackendUserMutation(c.config, OpUpdate)
+	return &BackendUserUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *BackendUserClient) UpdateOne(_m *BackendUser) *BackendUserUpdateOne {
+	mutation := newBackendUserMutation(c.config, OpUpdateOne, withBackendUser(_m))
+	return &BackendUserUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *BackendUserClient) UpdateOneID(id uuid.UUID) *BackendUserUpdateOne {
+	mutation := newBackendUserMutation(c.config, OpUpdateOne, withBackendUserID(id))
+	return &BackendUserUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for BackendUser.
+func (c *BackendUserClient) Delete() *BackendUserDelete {
+	mutation := newBackendUserMutation(c.config, OpDelete)
+	return &BackendUserDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *BackendUserClient) DeleteOne(_m *BackendUser) *BackendUserDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *BackendUserClient) DeleteOneID(id uuid.UUID) *BackendUserDeleteOne {
+	builder := c.Delete().Where(backenduser.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &BackendUserDeleteOne{builder}
+}
+
+// Query returns a query builder for BackendUser.
+func (c *BackendUserClient) Query() *BackendUserQuery {
+	return &BackendUserQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeBackendUser},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a BackendUser entity by its id.
+func (c *BackendUserClient) Get(ctx context.Context, id uuid.UUID) (*BackendUser, error) {
+	return c.Query().Where(backenduser.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *BackendUserClient) GetX(ctx context.Context, id uuid.UUID) *BackendUser {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryUser queries the user edge of a BackendUser.
+func (c *BackendUserClient) QueryUser(_m *BackendUser) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(backenduser.Table, backenduser.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, backenduser.UserTable, backenduser.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryBackend queries the backend edge of a BackendUser.
+func (c *BackendUserClient) QueryBackend(_m *BackendUser) *BackendQuery {
+	query := (&BackendClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(backenduser.Table, backenduser.FieldID, id),
+			sqlgraph.To(backend.Table, backend.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, backenduser.BackendTable, backenduser.BackendColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *BackendUserClient) Hooks() []Hook {
+	return c.hooks.BackendUser
+}
+
+// Interceptors returns the client interceptors.
+func (c *BackendUserClient) Interceptors() []Interceptor {
+	return c.inters.BackendUser
+}
+
+func (c *BackendUserClient) mutate(ctx context.Context, m *BackendUserMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&BackendUserCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&BackendUserUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&BackendUserUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&BackendUserDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown BackendUser mutation op: %q", m.Op())
+	}
+}
+
+// DeviceOptionClient is a client for the DeviceOption schema.
+type DeviceOptionClient struct {
+	config
+}
+
+// NewDeviceOptionClient returns a client for the DeviceOption from the given config.
+func NewDeviceOptionClient(c config) *DeviceOptionClient {
+	return &DeviceOptionClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `deviceoption.Hooks(f(g(h())))`.
+func (c *DeviceOptionClient) Use(hooks ...Hook) {
+	c.hooks.DeviceOption = append(c.hooks.DeviceOption, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `deviceoption.Intercept(f(g(h())))`.
+func (c *DeviceOptionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.DeviceOption = append(c.inters.DeviceOption, interceptors...)
+}
+
+// Create returns a builder for creating a DeviceOption entity.
+func (c *DeviceOptionClient) Create() *DeviceOptionCreate {
+	mutation := newDeviceOptionMutation(c.config, OpCreate)
+	return &DeviceOptionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of DeviceOption entities.
+func (c *DeviceOptionClient) CreateBulk(builders ...*DeviceOptionCreate) *DeviceOptionCreateBulk {
+	return &DeviceOptionCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *DeviceOptionClient) MapCreateBulk(slice any, setFunc func(*DeviceOptionCreate, int)) *DeviceOptionCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &DeviceOptionCreateBulk{err: fmt.Errorf("calling to DeviceOptionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*DeviceOptionCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &DeviceOptionCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for DeviceOption.
+func (c *DeviceOptionClient) Update() *DeviceOptionUpdate {
+	mutation := newDeviceOptionMutation(c.config, OpUpdate)
+	return &DeviceOptionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *DeviceOptionClient) UpdateOne(_m *DeviceOption) *DeviceOptionUpdateOne {
+	mutation := newDeviceOptionMutation(c.config, OpUpdateOne, withDeviceOption(_m))
+	return &DeviceOptionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *DeviceOptionClient) UpdateOneID(id uuid.UUID) *DeviceOptionUpdateOne {
+	mutation := newDeviceOptionMutation(c.config, OpUpdateOne, withDeviceOptionID(id))
+	return &DeviceOptionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for DeviceOption.
+func (c *DeviceOptionClient) Delete() *DeviceOptionDelete {
+	mutation := newDeviceOptionMutation(c.config, OpDelete)
+	return &DeviceOptionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *DeviceOptionClient) DeleteOne(_m *DeviceOption) *DeviceOptionDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *DeviceOptionClient) DeleteOneID(id uuid.UUID) *DeviceOptionDeleteOne {
+	builder := c.Delete().Where(deviceoption.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &DeviceOptionDeleteOne{builder}
+}
+
+// Query returns a query builder for DeviceOption.
+func (c *DeviceOptionClient) Query() *DeviceOptionQuery {
+	return &DeviceOptionQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeDeviceOption},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a DeviceOption entity by its id.
+func (c *DeviceOptionClient) Get(ctx context.Context, id uuid.UUID) (*DeviceOption, error) {
+	return c.Query().Where(deviceoption.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *DeviceOptionClient) GetX(ctx context.Context, id uuid.UUID) *DeviceOption {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *DeviceOptionClient) Hooks() []Hook {
+	return c.hooks.DeviceOption
+}
+
+// Interceptors returns the client interceptors.
+func (c *DeviceOptionClient) Interceptors() []Interceptor {
+	return c.inters.DeviceOption
+}
+
+func (c *DeviceOptionClient) mutate(ctx context.Context, m *DeviceOptionMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&DeviceOptionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&DeviceOptionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&DeviceOptionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&DeviceOptionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown DeviceOption mutation op: %q", m.Op())
+	}
+}
+
+// DisplayPreferenceClient is a client for the DisplayPreference schema.
+type DisplayPreferenceClient struct {
+	config
+}
+
+// NewDisplayPreferenceClient returns a client for the DisplayPreference from the given config.
+func NewDisplayPreferenceClient(c config) *DisplayPreferenceClient {
+	return &DisplayPreferenceClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `displaypreference.Hooks(f(g(h())))`.
+func (c *DisplayPreferenceClient) Use(hooks ...Hook) {
+	c.hooks.DisplayPreference = append(c.hooks.DisplayPreference, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `displaypreference.Intercept(f(g(h())))`.
+func (c *DisplayPreferenceClient) Intercept(interceptors ...Interceptor) {
+	c.inters.DisplayPreference = append(c.inters.DisplayPreference, interceptors...)
+}
+
+// Create returns a builder for creating a DisplayPreference entity.
+func (c *DisplayPreferenceClient) Create() *DisplayPreferenceCreate {
+	mutation := newDisplayPreferenceMutation(c.config, OpCreate)
+	return &DisplayPreferenceCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of DisplayPreference entities.
+func (c *DisplayPreferenceClient) CreateBulk(builders ...*DisplayPreferenceCreate) *DisplayPreferenceCreateBulk {
+	return &DisplayPreferenceCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *DisplayPreferenceClient) MapCreateBulk(slice any, setFunc func(*DisplayPreferenceCreate, int)) *DisplayPreferenceCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &DisplayPreferenceCreateBulk{err: fmt.Errorf("calling to DisplayPreferenceClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*DisplayPreferenceCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &DisplayPreferenceCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for DisplayPreference.
+func (c *DisplayPreferenceClient) Update() *DisplayPreferenceUpdate {
+	mutation := newDisplayPreferenceMutation(c.config, OpUpdate)
+	return &DisplayPreferenceUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *DisplayPreferenceClient) UpdateOne(_m *DisplayPreference) *DisplayPreferenceUpdateOne {
+	mutation := newDisplayPreferenceMutation(c.config, OpUpdateOne, withDisplayPreference(_m))
+	return &DisplayPreferenceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *DisplayPreferenceClient) UpdateOneID(id uuid.UUID) *DisplayPreferenceUpdateOne {
+	mutation := newDisplayPreferenceMutation(c.config, OpUpdateOne, withDisplayPreferenceID(id))
+	return &DisplayPreferenceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for DisplayPreference.
+func (c *DisplayPreferenceClient) Delete() *DisplayPreferenceDelete {
+	mutation := newDisplayPreferenceMutation(c.config, OpDelete)
+	return &DisplayPreferenceDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *DisplayPreferenceClient) DeleteOne(_m *DisplayPreference) *DisplayPreferenceDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *DisplayPreferenceClient) DeleteOneID(id uuid.UUID) *DisplayPreferenceDeleteOne {
+	builder := c.Delete().Where(displaypreference.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &DisplayPreferenceDeleteOne{builder}
+}
+
+// Query returns a query builder for DisplayPreference.
+func (c *DisplayPreferenceClient) Query() *DisplayPreferenceQuery {
+	return &DisplayPreferenceQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeDisplayPreference},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a DisplayPreference entity by its id.
+func (c *DisplayPreferenceClient) Get(ctx context.Context, id uuid.UUID) (*DisplayPreference, error) {
+	return c.Query().Where(displaypreference.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *DisplayPreferenceClient) GetX(ctx context.Context, id uuid.UUID) *DisplayPreference {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *DisplayPreferenceClient) Hooks() []Hook {
+	return c.hooks.DisplayPreference
+}
+
+// Interceptors returns the client interceptors.
+func (c *DisplayPreferenceClient) Interceptors() []Interceptor {
+	return c.inters.DisplayPreference
+}
+
+func (c *DisplayPreferenceClient) mutate(ctx context.Context, m *DisplayPreferenceMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&DisplayPreferenceCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&DisplayPreferenceUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&DisplayPreferenceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&DisplayPreferenceDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown DisplayPreference mutation op: %q", m.Op())
+	}
+}
+
+// DuplicateItemClient is a client for the DuplicateItem schema.
+type DuplicateItemClient struct {
+	config
+}
+
+// NewDuplicateItemClient returns a client for the DuplicateItem from the given config.
+func NewDuplicateItemClient(c config) *DuplicateItemClient {
+	return &DuplicateItemClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `duplicateitem.Hooks(f(g(h())))`.
+func (c *DuplicateItemClient) Use(hooks ...Hook) {
+	c.hooks.DuplicateItem = append(c.hooks.DuplicateItem, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `duplicateitem.Intercept(f(g(h())))`.
+func (c *DuplicateItemClient) Intercept(interceptors ...Interceptor) {
+	c.inters.DuplicateItem = append(c.inters.DuplicateItem, interceptors...)
+}
+
+// Create returns a builder for creating a DuplicateItem entity.
+func (c *DuplicateItemClient) Create() *DuplicateItemCreate {
+	mutation := newDuplicateItemMutation(c.config, OpCreate)
+	return &DuplicateItemCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of DuplicateItem entities.
+func (c *DuplicateItemClient) CreateBulk(builders ...*DuplicateItemCreate) *DuplicateItemCreateBulk {
+	return &DuplicateItemCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *DuplicateItemClient) MapCreateBulk(slice any, setFunc func(*DuplicateItemCreate, int)) *DuplicateItemCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &DuplicateItemCreateBulk{err: fmt.Errorf("calling to DuplicateItemClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*DuplicateItemCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &DuplicateItemCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for DuplicateItem.
+func (c *DuplicateItemClient) Update() *DuplicateItemUpdate {
+	mutation := newDuplicateItemMutation(c.config, OpUpdate)
+	return &DuplicateItemUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *DuplicateItemClient) UpdateOne(_m *DuplicateItem) *DuplicateItemUpdateOne {
+	mutation := newDuplicateItemMutation(c.config, OpUpdateOne, withDuplicateItem(_m))
+	return &DuplicateItemUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *DuplicateItemClient) UpdateOneID(id uuid.UUID) *DuplicateItemUpdateOne {
+	mutation := newDuplicateItemMutation(c.config, OpUpdateOne, withDuplicateItemID(id))
+	return &DuplicateItemUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for DuplicateItem.
+func (c *DuplicateItemClient) Delete() *DuplicateItemDelete {
+	mutation := newDuplicateItemMutation(c.config, OpDelete)
+	return &DuplicateItemDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *DuplicateItemClient) DeleteOne(_m *DuplicateItem) *DuplicateItemDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *DuplicateItemClient) DeleteOneID(id uuid.UUID) *DuplicateItemDeleteOne {
+	builder := c.Delete().Where(duplicateitem.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &DuplicateItemDeleteOne{builder}
+}
+
+// Query returns a query builder for DuplicateItem.
+func (c *DuplicateItemClient) Query() *DuplicateItemQuery {
+	return &DuplicateItemQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeDuplicateItem},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a DuplicateItem entity by its id.
+func (c *DuplicateItemClient) Get(ctx context.Context, id uuid.UUID) (*DuplicateItem, error) {
+	return c.Query().Where(duplicateitem.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *DuplicateItemClient) GetX(ctx context.Context, id uuid.UUID) *DuplicateItem {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *DuplicateItemClient) Hooks() []Hook {
+	return c.hooks.DuplicateItem
+}
+
+// Interceptors returns the client interceptors.
+func (c *DuplicateItemClient) Interceptors() []Interceptor {
+	return c.inters.DuplicateItem
+}
+
+func (c *DuplicateItemClient) mutate(ctx context.Context, m *DuplicateItemMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&DuplicateItemCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&DuplicateItemUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&DuplicateItemUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&DuplicateItemDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown DuplicateItem mutation op: %q", m.Op())
+	}
+}
+
+// ItemClient is a client for the Item schema.
+type ItemClient struct {
+	config
+}
+
+// NewItemClient returns a client for the Item from the given config.
+func NewItemClient(c config) *ItemClient {
+	return &ItemClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `item.Hooks(f(g(h())))`.
+func (c *ItemClient) Use(hooks ...Hook) {
+	c.hooks.Item = append(c.hooks.Item, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `item.Intercept(f(g(h())))`.
+func (c *ItemClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Item = append(c.inters.Item, interceptors...)
+}
+
+// Create returns a builder for creating a Item entity.
+func (c *ItemClient) Create() *ItemCreate {
+	mutation := newItemMutation(c.config, OpCreate)
+	return &ItemCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Item entities.
+func (c *ItemClient) CreateBulk(builders ...*ItemCreate) *ItemCreateBulk {
+	return &ItemCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ItemClient) MapCreateBulk(slice any, setFunc func(*ItemCreate, int)) *ItemCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ItemCreateBulk{err: fmt.Errorf("calling to ItemClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ItemCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ItemCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Item.
+func (c *ItemClient) Update() *ItemUpdate {
+	mutation := newItemMutation(c.config, OpUpdate)
+	return &ItemUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ItemClient) UpdateOne(_m *Item) *ItemUpdateOne {
+	mutation := newItemMutation(c.config, OpUpdateOne, withItem(_m))
+	return &ItemUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ItemClient) UpdateOneID(id uuid.UUID) *ItemUpdateOne {
+	mutation := newItemMutation(c.config, OpUpdateOne, withItemID(id))
+	return &ItemUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Item.
+func (c *ItemClient) Delete() *ItemDelete {
+	mutation := newItemMutation(c.config, OpDelete)
+	return &ItemDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ItemClient) DeleteOne(_m *Item) *ItemDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ItemClient) DeleteOneID(id uuid.UUID) *ItemDeleteOne {
+	builder := c.Delete().Where(item.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ItemDeleteOne{builder}
+}
+
+// Query returns a query builder for Item.
+func (c *ItemClient) Query() *ItemQuery {
+	return &ItemQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeItem},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Item entity by its id.
+func (c *ItemClient) Get(ctx context.Context, id uuid.UUID) (*Item, error) {
+	return c.Query().Where(item.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ItemClient) GetX(ctx context.Context, id uuid.UUID) *Item {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryBackend queries the backend edge of a Item.
+func (c *ItemClient) QueryBackend(_m *Item) *BackendQuery {
+	query := (&BackendClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(item.Table, item.FieldID, id),
+			sqlgraph.To(backend.Table, backend.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, item.BackendTable, item.BackendColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *ItemClient) Hooks() []Hook {
+	return c.hooks.Item
+}
+
+// Interceptors returns the client interceptors.
+func (c *ItemClient) Interceptors() []Interceptor {
+	return c.inters.Item
+}
+
+func (c *ItemClient) mutate(ctx context.Context, m *ItemMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ItemCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ItemUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ItemUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ItemDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Item mutation op: %q", m.Op())
+	}
+}
+
+// PlaybackEventClient is a client for the PlaybackEvent schema.
+type PlaybackEventClient struct {
+	config
+}
+
+// NewPlaybackEventClient returns a client for the PlaybackEvent from the given config.
+func NewPlaybackEventClient(c config) *PlaybackEventClient {
+	return &PlaybackEventClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `playbackevent.Hooks(f(g(h())))`.
+func (c *PlaybackEventClient) Use(hooks ...Hook) {
+	c.hooks.PlaybackEvent = append(c.hooks.PlaybackEvent, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `playbackevent.Intercept(f(g(h())))`.
+func (c *PlaybackEventClient) Intercept(interceptors ...Interceptor) {
+	c.inters.PlaybackEvent = append(c.inters.PlaybackEvent, interceptors...)
+}
+
+// Create returns a builder for creating a PlaybackEvent entity.
+func (c *PlaybackEventClient) Create() *PlaybackEventCreate {
+	mutation := newPlaybackEventMutation(c.config, OpCreate)
+	return &PlaybackEventCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Backend entities.
-func (c *BackendClient) CreateBulk(builders ...*BackendCreate) *BackendCreateBulk {
-	return &BackendCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of PlaybackEvent entities.
+func (c *PlaybackEventClient) CreateBulk(builders ...*PlaybackEventCreate) *PlaybackEventCreateBulk {
+	return &PlaybackEventCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *BackendClient) MapCreateBulk(slice any, setFunc func(*BackendCreate, int)) *BackendCreateBulk {
+func (c *PlaybackEventClient) MapCreateBulk(slice any, setFunc func(*PlaybackEventCreate, int)) *PlaybackEventCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &BackendCreateBulk{err: fmt.Errorf("calling to BackendClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &PlaybackEventCreateBulk{err: fmt.Errorf("calling to PlaybackEventClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*BackendCreate, rv.Len())
+	builders := make([]*PlaybackEventCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &BackendCreateBulk{config: c.config, builders: builders}
+	return &PlaybackEventCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Backend.
-func (c *BackendClient) Update() *BackendUpdate {
-	mutation := newBackendMutation(c.config, OpUpdate)
-	return &BackendUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for PlaybackEvent.
+func (c *PlaybackEventClient) Update() *PlaybackEventUpdate {
+	mutation := newPlaybackEventMutation(c.config, OpUpdate)
+	return &PlaybackEventUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *BackendClient) UpdateOne(_m *Backend) *BackendUpdateOne {
-	mutation := newBackendMutation(c.config, OpUpdateOne, withBackend(_m))
-	return &BackendUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *PlaybackEventClient) UpdateOne(_m *PlaybackEvent) *PlaybackEventUpdateOne {
+	mutation := newPlaybackEventMutation(c.config, OpUpdateOne, withPlaybackEvent(_m))
+	return &PlaybackEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *BackendClient) UpdateOneID(id uuid.UUID) *BackendUpdateOne {
-	mutation := newBackendMutation(c.config, OpUpdateOne, withBackendID(id))
-	return &BackendUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *PlaybackEventClient) UpdateOneID(id uuid.UUID) *PlaybackEventUpdateOne {
+	mutation := newPlaybackEventMutation(c.config, OpUpdateOne, withPlaybackEventID(id))
+	return &PlaybackEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Backend.
-func (c *BackendClient) Delete() *BackendDelete {
-	mutation := newBackendMutation(c.config, OpDelete)
-	return &BackendDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for PlaybackEvent.
+func (c *PlaybackEventClient) Delete() *PlaybackEventDelete {
+	mutation := newPlaybackEventMutation(c.config, OpDelete)
+	return &PlaybackEventDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *BackendClient) DeleteOne(_m *Backend) *BackendDeleteOne {
+func (c *PlaybackEventClient) DeleteOne(_m *PlaybackEvent) *PlaybackEventDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *BackendClient) DeleteOneID(id uuid.UUID) *BackendDeleteOne {
-	builder := c.Delete().Where(backend.ID(id))
+func (c *PlaybackEventClient) DeleteOneID(id uuid.UUID) *PlaybackEventDeleteOne {
+	builder := c.Delete().Where(playbackevent.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &BackendDeleteOne{builder}
+	return &PlaybackEventDeleteOne{builder}
 }
 
-// Query returns a query builder for Backend.
-func (c *BackendClient) Query() *BackendQuery {
-	return &BackendQuery{
+// Query returns a query builder for PlaybackEvent.
+func (c *PlaybackEventClient) Query() *PlaybackEventQuery {
+	return &PlaybackEventQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeBackend},
+		ctx:    &QueryContext{Type: TypePlaybackEvent},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Backend entity by its id.
-func (c *BackendClient) Get(ctx context.Context, id uuid.UUID) (*Backend, error) {
-	return c.Query().Where(backend.ID(id)).Only(ctx)
+// Get returns a PlaybackEvent entity by its id.
+func (c *PlaybackEventClient) Get(ctx context.Context, id uuid.UUID) (*PlaybackEvent, error) {
+	return c.Query().Where(playbackevent.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *BackendClient) GetX(ctx context.Context, id uuid.UUID) *Backend {
+func (c *PlaybackEventClient) GetX(ctx context.Context, id uuid.UUID) *PlaybackEvent {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -336,148 +1747,132 @@ func (c *BackendClient) GetX(ctx context.Context, id uuid.UUID) *Backend {
 	return obj
 }
 
-// QueryBackendUsers queries the backend_users edge of a Backend.
-func (c *BackendClient) QueryBackendUsers(_m *Backend) *BackendUserQuery {
-	query := (&BackendUserClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(backend.Table, backend.FieldID, id),
-			sqlgraph.To(backenduser.Table, backenduser.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, backend.BackendUsersTable, backend.BackendUsersColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
 // Hooks returns the client hooks.
-func (c *BackendClient) Hooks() []Hook {
-	return c.hooks.Backend
+func (c *PlaybackEventClient) Hooks() []Hook {
+	return c.hooks.PlaybackEvent
 }
 
 // Interceptors returns the client interceptors.
-func (c *BackendClient) Interceptors() []Interceptor {
-	return c.inters.Backend
+func (c *PlaybackEventClient) Interceptors() []Interceptor {
+	return c.inters.PlaybackEvent
 }
 
-func (c *BackendClient) mutate(ctx context.Context, m *BackendMutation) (Value, error) {
+func (c *PlaybackEventClient) mutate(ctx context.Context, m *PlaybackEventMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&BackendCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&PlaybackEventCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&BackendUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&PlaybackEventUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&BackendUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&PlaybackEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&BackendDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&PlaybackEventDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown Backend mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown PlaybackEvent mutation op: %q", m.Op())
 	}
 }
 
-// BackendUserClient is a client for the BackendUser schema.
-type BackendUserClient struct {
+// RecoveryCodeClient is a client for the RecoveryCode schema.
+type RecoveryCodeClient struct {
 	config
 }
 
-// NewBackendUserClient returns a client for the BackendUser from the given config.
-func NewBackendUserClient(c config) *BackendUserClient {
-	return &BackendUserClient{config: c}
+// NewRecoveryCodeClient returns a client for the RecoveryCode from the given config.
+func NewRecoveryCodeClient(c config) *RecoveryCodeClient {
+	return &RecoveryCodeClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `backenduser.Hooks(f(g(h())))`.
-func (c *BackendUserClient) Use(hooks ...Hook) {
-	c.hooks.BackendUser = append(c.hooks.BackendUser, hooks...)
+// A call to `Use(f, g, h)` equals to `recoverycode.Hooks(f(g(h())))`.
+func (c *RecoveryCodeClient) Use(hooks ...Hook) {
+	c.hooks.RecoveryCode = append(c.hooks.RecoveryCode, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `backenduser.Intercept(f(g(h())))`.
-func (c *BackendUserClient) Intercept(interceptors ...Interceptor) {
-	c.inters.BackendUser = append(c.inters.BackendUser, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `recoverycode.Intercept(f(g(h())))`.
+func (c *RecoveryCodeClient) Intercept(interceptors ...Interceptor) {
+	c.inters.RecoveryCode = append(c.inters.RecoveryCode, interceptors...)
 }
 
-// Create returns a builder for creating a BackendUser entity.
-func (c *BackendUserClient) Create() *BackendUserCreate {
-	mutation := newBackendUserMutation(c.config, OpCreate)
-	return &BackendUserCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a RecoveryCode entity.
+func (c *RecoveryCodeClient) Create() *RecoveryCodeCreate {
+	mutation := newRecoveryCodeMutation(c.config, OpCreate)
+	return &RecoveryCodeCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of BackendUser entities.
-func (c *BackendUserClient) CreateBulk(builders ...*BackendUserCreate) *BackendUserCreateBulk {
-	return &BackendUserCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of RecoveryCode entities.
+func (c *RecoveryCodeClient) CreateBulk(builders ...*RecoveryCodeCreate) *RecoveryCodeCreateBulk {
+	return &RecoveryCodeCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *BackendUserClient) MapCreateBulk(slice any, setFunc func(*BackendUserCreate, int)) *BackendUserCreateBulk {
+func (c *RecoveryCodeClient) MapCreateBulk(slice any, setFunc func(*RecoveryCodeCreate, int)) *RecoveryCodeCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &BackendUserCreateBulk{err: fmt.Errorf("calling to BackendUserClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &RecoveryCodeCreateBulk{err: fmt.Errorf("calling to RecoveryCodeClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*BackendUserCreate, rv.Len())
+	builders := make([]*RecoveryCodeCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &BackendUserCreateBulk{config: c.config, builders: builders}
+	return &RecoveryCodeCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for BackendUser.
-func (c *BackendUserClient) Update() *BackendUserUpdate {
-	mutation := newBackendUserMutation(c.config, OpUpdate)
-	return &BackendUserUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for RecoveryCode.
+func (c *RecoveryCodeClient) Update() *RecoveryCodeUpdate {
+	mutation := newRecoveryCodeMutation(c.config, OpUpdate)
+	return &RecoveryCodeUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *BackendUserClient) UpdateOne(_m *BackendUser) *BackendUserUpdateOne {
-	mutation := newBackendUserMutation(c.config, OpUpdateOne, withBackendUser(_m))
-	return &BackendUserUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *RecoveryCodeClient) UpdateOne(_m *RecoveryCode) *RecoveryCodeUpdateOne {
+	mutation := newRecoveryCodeMutation(c.config, OpUpdateOne, withRecoveryCode(_m))
+	return &RecoveryCodeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *BackendUserClient) UpdateOneID(id uuid.UUID) *BackendUserUpdateOne {
-	mutation := newBackendUserMutation(c.config, OpUpdateOne, withBackendUserID(id))
-	return &BackendUserUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *RecoveryCodeClient) UpdateOneID(id uuid.UUID) *RecoveryCodeUpdateOne {
+	mutation := newRecoveryCodeMutation(c.config, OpUpdateOne, withRecoveryCodeID(id))
+	return &RecoveryCodeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for BackendUser.
-func (c *BackendUserClient) Delete() *BackendUserDelete {
-	mutation := newBackendUserMutation(c.config, OpDelete)
-	return &BackendUserDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for RecoveryCode.
+func (c *RecoveryCodeClient) Delete() *RecoveryCodeDelete {
+	mutation := newRecoveryCodeMutation(c.config, OpDelete)
+	return &RecoveryCodeDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *BackendUserClient) DeleteOne(_m *BackendUser) *BackendUserDeleteOne {
+func (c *RecoveryCodeClient) DeleteOne(_m *RecoveryCode) *RecoveryCodeDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *BackendUserClient) DeleteOneID(id uuid.UUID) *BackendUserDeleteOne {
-	builder := c.Delete().Where(backenduser.ID(id))
+func (c *RecoveryCodeClient) DeleteOneID(id uuid.UUID) *RecoveryCodeDeleteOne {
+	builder := c.Delete().Where(recoverycode.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &BackendUserDeleteOne{builder}
+	return &RecoveryCodeDeleteOne{builder}
 }
 
-// Query returns a query builder for BackendUser.
-func (c *BackendUserClient) Query() *BackendUserQuery {
-	return &BackendUserQuery{
+// Query returns a query builder for RecoveryCode.
+func (c *RecoveryCodeClient) Query() *RecoveryCodeQuery {
+	return &RecoveryCodeQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeBackendUser},
+		ctx:    &QueryContext{Type: TypeRecoveryCode},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a BackendUser entity by its id.
-func (c *BackendUserClient) Get(ctx context.Context, id uuid.UUID) (*BackendUser, error) {
-	return c.Query().Where(backenduser.ID(id)).Only(ctx)
+// Get returns a RecoveryCode entity by its id.
+func (c *RecoveryCodeClient) Get(ctx context.Context, id uuid.UUID) (*RecoveryCode, error) {
+	return c.Query().Where(recoverycode.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *BackendUserClient) GetX(ctx context.Context, id uuid.UUID) *BackendUser {
+func (c *RecoveryCodeClient) GetX(ctx context.Context, id uuid.UUID) *RecoveryCode {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -485,31 +1880,15 @@ func (c *BackendUserClient) GetX(ctx context.Context, id uuid.UUID) *BackendUser
 	return obj
 }
 
-// QueryUser queries the user edge of a BackendUser.
-func (c *BackendUserClient) QueryUser(_m *BackendUser) *UserQuery {
+// QueryUser queries the user edge of a RecoveryCode.
+func (c *RecoveryCodeClient) QueryUser(_m *RecoveryCode) *UserQuery {
 	query := (&UserClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(backenduser.Table, backenduser.FieldID, id),
+			sqlgraph.From(recoverycode.Table, recoverycode.FieldID, id),
 			sqlgraph.To(user.Table, user.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, backenduser.UserTable, backenduser.UserColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryBackend queries the backend edge of a BackendUser.
-func (c *BackendUserClient) QueryBackend(_m *BackendUser) *BackendQuery {
-	query := (&BackendClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(backenduser.Table, backenduser.FieldID, id),
-			sqlgraph.To(backend.Table, backend.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, backenduser.BackendTable, backenduser.BackendColumn),
+			sqlgraph.Edge(sqlgraph.M2O, true, recoverycode.UserTable, recoverycode.UserColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -518,27 +1897,27 @@ func (c *BackendUserClient) QueryBackend(_m *BackendUser) *BackendQuery {
 }
 
 // Hooks returns the client hooks.
-func (c *BackendUserClient) Hooks() []Hook {
-	return c.hooks.BackendUser
+func (c *RecoveryCodeClient) Hooks() []Hook {
+	return c.hooks.RecoveryCode
 }
 
 // Interceptors returns the client interceptors.
-func (c *BackendUserClient) Interceptors() []Interceptor {
-	return c.inters.BackendUser
+func (c *RecoveryCodeClient) Interceptors() []Interceptor {
+	return c.inters.RecoveryCode
 }
 
-func (c *BackendUserClient) mutate(ctx context.Context, m *BackendUserMutation) (Value, error) {
+func (c *RecoveryCodeClient) mutate(ctx context.Context, m *RecoveryCodeMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&BackendUserCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&RecoveryCodeCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&BackendUserUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&RecoveryCodeUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&BackendUserUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&RecoveryCodeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&BackendUserDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&RecoveryCodeDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown BackendUser mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown RecoveryCode mutation op: %q", m.Op())
 	}
 }
 
@@ -666,6 +2045,22 @@ func (c *SessionClient) QueryUser(_m *Session) *UserQuery {
 	return query
 }
 
+// QueryAppPassword queries the app_password edge of a Session.
+func (c *SessionClient) QueryAppPassword(_m *Session) *AppPasswordQuery {
+	query := (&AppPasswordClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(session.Table, session.FieldID, id),
+			sqlgraph.To(apppassword.Table, apppassword.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, session.AppPasswordTable, session.AppPasswordColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *SessionClient) Hooks() []Hook {
 	return c.hooks.Session
@@ -831,6 +2226,54 @@ func (c *UserClient) QueryBackendUsers(_m *User) *BackendUserQuery {
 	return query
 }
 
+// QueryAPIKeys queries the api_keys edge of a User.
+func (c *UserClient) QueryAPIKeys(_m *User) *ApiKeyQuery {
+	query := (&ApiKeyClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(apikey.Table, apikey.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.APIKeysTable, user.APIKeysColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryRecoveryCodes queries the recovery_codes edge of a User.
+func (c *UserClient) QueryRecoveryCodes(_m *User) *RecoveryCodeQuery {
+	query := (&RecoveryCodeClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(recoverycode.Table, recoverycode.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.RecoveryCodesTable, user.RecoveryCodesColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryAppPasswords queries the app_passwords edge of a User.
+func (c *UserClient) QueryAppPasswords(_m *User) *AppPasswordQuery {
+	query := (&AppPasswordClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(apppassword.Table, apppassword.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.AppPasswordsTable, user.AppPasswordsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *UserClient) Hooks() []Hook {
 	return c.hooks.User
@@ -856,12 +2299,149 @@ func (c *UserClient) mutate(ctx context.Context, m *UserMutation) (Value, error)
 	}
 }
 
+// UserConfigurationClient is a client for the UserConfiguration schema.
+type UserConfigurationClient struct {
+	config
+}
+
+// NewUserConfigurationClient returns a client for the UserConfiguration from the given config.
+func NewUserConfigurationClient(c config) *UserConfigurationClient {
+	return &UserConfigurationClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `userconfiguration.Hooks(f(g(h())))`.
+func (c *UserConfigurationClient) Use(hooks ...Hook) {
+	c.hooks.UserConfiguration = append(c.hooks.UserConfiguration, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `userconfiguration.Intercept(f(g(h())))`.
+func (c *UserConfigurationClient) Intercept(interceptors ...Interceptor) {
+	c.inters.UserConfiguration = append(c.inters.UserConfiguration, interceptors...)
+}
+
+// Create returns a builder for creating a UserConfiguration entity.
+func (c *UserConfigurationClient) Create() *UserConfigurationCreate {
+	mutation := newUserConfigurationMutation(c.config, OpCreate)
+	return &UserConfigurationCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of UserConfiguration entities.
+func (c *UserConfigurationClient) CreateBulk(builders ...*UserConfigurationCreate) *UserConfigurationCreateBulk {
+	return &UserConfigurationCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *UserConfigurationClient) MapCreateBulk(slice any, setFunc func(*UserConfigurationCreate, int)) *UserConfigurationCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &UserConfigurationCreateBulk{err: fmt.Errorf("calling to UserConfigurationClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*UserConfigurationCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &UserConfigurationCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for UserConfiguration.
+func (c *UserConfigurationClient) Update() *UserConfigurationUpdate {
+	mutation := newUserConfigurationMutation(c.config, OpUpdate)
+	return &UserConfigurationUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *UserConfigurationClient) UpdateOne(_m *UserConfiguration) *UserConfigurationUpdateOne {
+	mutation := newUserConfigurationMutation(c.config, OpUpdateOne, withUserConfiguration(_m))
+	return &UserConfigurationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *UserConfigurationClient) UpdateOneID(id uuid.UUID) *UserConfigurationUpdateOne {
+	mutation := newUserConfigurationMutation(c.config, OpUpdateOne, withUserConfigurationID(id))
+	return &UserConfigurationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for UserConfiguration.
+func (c *UserConfigurationClient) Delete() *UserConfigurationDelete {
+	mutation := newUserConfigurationMutation(c.config, OpDelete)
+	return &UserConfigurationDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *UserConfigurationClient) DeleteOne(_m *UserConfiguration) *UserConfigurationDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *UserConfigurationClient) DeleteOneID(id uuid.UUID) *UserConfigurationDeleteOne {
+	builder := c.Delete().Where(userconfiguration.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &UserConfigurationDeleteOne{builder}
+}
+
+// Query returns a query builder for UserConfiguration.
+func (c *UserConfigurationClient) Query() *UserConfigurationQuery {
+	return &UserConfigurationQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeUserConfiguration},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a UserConfiguration entity by its id.
+func (c *UserConfigurationClient) Get(ctx context.Context, id uuid.UUID) (*UserConfiguration, error) {
+	return c.Query().Where(userconfiguration.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *UserConfigurationClient) GetX(ctx context.Context, id uuid.UUID) *UserConfiguration {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *UserConfigurationClient) Hooks() []Hook {
+	return c.hooks.UserConfiguration
+}
+
+// Interceptors returns the client interceptors.
+func (c *UserConfigurationClient) Interceptors() []Interceptor {
+	return c.inters.UserConfiguration
+}
+
+func (c *UserConfigurationClient) mutate(ctx context.Context, m *UserConfigurationMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&UserConfigurationCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&UserConfigurationUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&UserConfigurationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&UserConfigurationDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown UserConfiguration mutation op: %q", m.Op())
+	}
+}
+
 // hooks and interceptors per client, for fast access.
 type (
 	hooks struct {
-		Backend, BackendUser, Session, User []ent.Hook
+		ActivityLog, ApiKey, AppPassword, Backend, BackendUser, DeviceOption,
+		DisplayPreference, DuplicateItem, Item, PlaybackEvent, RecoveryCode, Session,
+		User, UserConfiguration []ent.Hook
 	}
 	inters struct {
-		Backend, BackendUser, Session, User []ent.Interceptor
+		ActivityLog, ApiKey, AppPassword, Backend, BackendUser, DeviceOption,
+		DisplayPreference, DuplicateItem, Item, PlaybackEvent, RecoveryCode, Session,
+		User, UserConfiguration []ent.Interceptor
 	}
 )
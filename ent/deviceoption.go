@@ -0,0 +1,129 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/ddevcap/jellyfin-proxy/ent/deviceoption"
+	"github.com/google/uuid"
+)
+
+// DeviceOption is the model entity for the DeviceOption schema.
+type DeviceOption struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// DeviceID holds the value of the "device_id" field.
+	DeviceID string `json:"device_id,omitempty"`
+	// CustomName holds the value of the "custom_name" field.
+	CustomName string `json:"custom_name,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt    time.Time `json:"updated_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*DeviceOption) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case deviceoption.FieldDeviceID, deviceoption.FieldCustomName:
+			values[i] = new(sql.NullString)
+		case deviceoption.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case deviceoption.FieldID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the DeviceOption fields.
+func (_m *DeviceOption) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case deviceoption.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case deviceoption.FieldDeviceID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field device_id", values[i])
+			} else if value.Valid {
+				_m.DeviceID = value.String
+			}
+		case deviceoption.FieldCustomName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field custom_name", values[i])
+			} else if value.Valid {
+				_m.CustomName = value.String
+			}
+		case deviceoption.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the DeviceOption.
+// This includes values selected through modifiers, order, etc.
+func (_m *DeviceOption) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this DeviceOption.
+// Note that you need to call DeviceOption.Unwrap() before calling this method if this DeviceOption
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *DeviceOption) Update() *DeviceOptionUpdateOne {
+	return NewDeviceOptionClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the DeviceOption entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *DeviceOption) Unwrap() *DeviceOption {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: DeviceOption is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *DeviceOption) String() string {
+	var builder strings.Builder
+	builder.WriteString("DeviceOption(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("device_id=")
+	builder.WriteString(_m.DeviceID)
+	builder.WriteString(", ")
+	builder.WriteString("custom_name=")
+	builder.WriteString(_m.CustomName)
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// DeviceOptions is a parsable slice of DeviceOption.
+type DeviceOptions []*DeviceOption
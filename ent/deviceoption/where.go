@@ -0,0 +1,266 @@
+// Code generated by ent, DO NOT EDIT.
+
+package deviceoption
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/ddevcap/jellyfin-proxy/ent/predicate"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.DeviceOption {
+	return predicate.DeviceOption(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.DeviceOption {
+	return predicate.DeviceOption(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.DeviceOption {
+	return predicate.DeviceOption(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.DeviceOption {
+	return predicate.DeviceOption(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.DeviceOption {
+	return predicate.DeviceOption(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.DeviceOption {
+	return predicate.DeviceOption(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.DeviceOption {
+	return predicate.DeviceOption(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.DeviceOption {
+	return predicate.DeviceOption(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.DeviceOption {
+	return predicate.DeviceOption(sql.FieldLTE(FieldID, id))
+}
+
+// DeviceID applies equality check predicate on the "device_id" field. It's identical to DeviceIDEQ.
+func DeviceID(v string) predicate.DeviceOption {
+	return predicate.DeviceOption(sql.FieldEQ(FieldDeviceID, v))
+}
+
+// CustomName applies equality check predicate on the "custom_name" field. It's identical to CustomNameEQ.
+func CustomName(v string) predicate.DeviceOption {
+	return predicate.DeviceOption(sql.FieldEQ(FieldCustomName, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.DeviceOption {
+	return predicate.DeviceOption(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// DeviceIDEQ applies the EQ predicate on the "device_id" field.
+func DeviceIDEQ(v string) predicate.DeviceOption {
+	return predicate.DeviceOption(sql.FieldEQ(FieldDeviceID, v))
+}
+
+// DeviceIDNEQ applies the NEQ predicate on the "device_id" field.
+func DeviceIDNEQ(v string) predicate.DeviceOption {
+	return predicate.DeviceOption(sql.FieldNEQ(FieldDeviceID, v))
+}
+
+// DeviceIDIn applies the In predicate on the "device_id" field.
+func DeviceIDIn(vs ...string) predicate.DeviceOption {
+	return predicate.DeviceOption(sql.FieldIn(FieldDeviceID, vs...))
+}
+
+// DeviceIDNotIn applies the NotIn predicate on the "device_id" field.
+func DeviceIDNotIn(vs ...string) predicate.DeviceOption {
+	return predicate.DeviceOption(sql.FieldNotIn(FieldDeviceID, vs...))
+}
+
+// DeviceIDGT applies the GT predicate on the "device_id" field.
+func DeviceIDGT(v string) predicate.DeviceOption {
+	return predicate.DeviceOption(sql.FieldGT(FieldDeviceID, v))
+}
+
+// DeviceIDGTE applies the GTE predicate on the "device_id" field.
+func DeviceIDGTE(v string) predicate.DeviceOption {
+	return predicate.DeviceOption(sql.FieldGTE(FieldDeviceID, v))
+}
+
+// DeviceIDLT applies the LT predicate on the "device_id" field.
+func DeviceIDLT(v string) predicate.DeviceOption {
+	return predicate.DeviceOption(sql.FieldLT(FieldDeviceID, v))
+}
+
+// DeviceIDLTE applies the LTE predicate on the "device_id" field.
+func DeviceIDLTE(v string) predicate.DeviceOption {
+	return predicate.DeviceOption(sql.FieldLTE(FieldDeviceID, v))
+}
+
+// DeviceIDContains applies the Contains predicate on the "device_id" field.
+func DeviceIDContains(v string) predicate.DeviceOption {
+	return predicate.DeviceOption(sql.FieldContains(FieldDeviceID, v))
+}
+
+// DeviceIDHasPrefix applies the HasPrefix predicate on the "device_id" field.
+func DeviceIDHasPrefix(v string) predicate.DeviceOption {
+	return predicate.DeviceOption(sql.FieldHasPrefix(FieldDeviceID, v))
+}
+
+// DeviceIDHasSuffix applies the HasSuffix predicate on the "device_id" field.
+func DeviceIDHasSuffix(v string) predicate.DeviceOption {
+	return predicate.DeviceOption(sql.FieldHasSuffix(FieldDeviceID, v))
+}
+
+// DeviceIDEqualFold applies the EqualFold predicate on the "device_id" field.
+func DeviceIDEqualFold(v string) predicate.DeviceOption {
+	return predicate.DeviceOption(sql.FieldEqualFold(FieldDeviceID, v))
+}
+
+// DeviceIDContainsFold applies the ContainsFold predicate on the "device_id" field.
+func DeviceIDContainsFold(v string) predicate.DeviceOption {
+	return predicate.DeviceOption(sql.FieldContainsFold(FieldDeviceID, v))
+}
+
+// CustomNameEQ applies the EQ predicate on the "custom_name" field.
+func CustomNameEQ(v string) predicate.DeviceOption {
+	return predicate.DeviceOption(sql.FieldEQ(FieldCustomName, v))
+}
+
+// CustomNameNEQ applies the NEQ predicate on the "custom_name" field.
+func CustomNameNEQ(v string) predicate.DeviceOption {
+	return predicate.DeviceOption(sql.FieldNEQ(FieldCustomName, v))
+}
+
+// CustomNameIn applies the In predicate on the "custom_name" field.
+func CustomNameIn(vs ...string) predicate.DeviceOption {
+	return predicate.DeviceOption(sql.FieldIn(FieldCustomName, vs...))
+}
+
+// CustomNameNotIn applies the NotIn predicate on the "custom_name" field.
+func CustomNameNotIn(vs ...string) predicate.DeviceOption {
+	return predicate.DeviceOption(sql.FieldNotIn(FieldCustomName, vs...))
+}
+
+// CustomNameGT applies the GT predicate on the "custom_name" field.
+func CustomNameGT(v string) predicate.DeviceOption {
+	return predicate.DeviceOption(sql.FieldGT(FieldCustomName, v))
+}
+
+// CustomNameGTE applies the GTE predicate on the "custom_name" field.
+func CustomNameGTE(v string) predicate.DeviceOption {
+	return predicate.DeviceOption(sql.FieldGTE(FieldCustomName, v))
+}
+
+// CustomNameLT applies the LT predicate on the "custom_name" field.
+func CustomNameLT(v string) predicate.DeviceOption {
+	return predicate.DeviceOption(sql.FieldLT(FieldCustomName, v))
+}
+
+// CustomNameLTE applies the LTE predicate on the "custom_name" field.
+func CustomNameLTE(v string) predicate.DeviceOption {
+	return predicate.DeviceOption(sql.FieldLTE(FieldCustomName, v))
+}
+
+// CustomNameContains applies the Contains predicate on the "custom_name" field.
+func CustomNameContains(v string) predicate.DeviceOption {
+	return predicate.DeviceOption(sql.FieldContains(FieldCustomName, v))
+}
+
+// CustomNameHasPrefix applies the HasPrefix predicate on the "custom_name" field.
+func CustomNameHasPrefix(v string) predicate.DeviceOption {
+	return predicate.DeviceOption(sql.FieldHasPrefix(FieldCustomName, v))
+}
+
+// CustomNameHasSuffix applies the HasSuffix predicate on the "custom_name" field.
+func CustomNameHasSuffix(v string) predicate.DeviceOption {
+	return predicate.DeviceOption(sql.FieldHasSuffix(FieldCustomName, v))
+}
+
+// CustomNameIsNil applies the IsNil predicate on the "custom_name" field.
+func CustomNameIsNil() predicate.DeviceOption {
+	return predicate.DeviceOption(sql.FieldIsNull(FieldCustomName))
+}
+
+// CustomNameNotNil applies the NotNil predicate on the "custom_name" field.
+func CustomNameNotNil() predicate.DeviceOption {
+	return predicate.DeviceOption(sql.FieldNotNull(FieldCustomName))
+}
+
+// CustomNameEqualFold applies the EqualFold predicate on the "custom_name" field.
+func CustomNameEqualFold(v string) predicate.DeviceOption {
+	return predicate.DeviceOption(sql.FieldEqualFold(FieldCustomName, v))
+}
+
+// CustomNameContainsFold applies the ContainsFold predicate on the "custom_name" field.
+func CustomNameContainsFold(v string) predicate.DeviceOption {
+	return predicate.DeviceOption(sql.FieldContainsFold(FieldCustomName, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.DeviceOption {
+	return predicate.DeviceOption(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.DeviceOption {
+	return predicate.DeviceOption(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.DeviceOption {
+	return predicate.DeviceOption(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.DeviceOption {
+	return predicate.DeviceOption(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.DeviceOption {
+	return predicate.DeviceOption(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.DeviceOption {
+	return predicate.DeviceOption(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.DeviceOption {
+	return predicate.DeviceOption(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.DeviceOption {
+	return predicate.DeviceOption(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.DeviceOption) predicate.DeviceOption {
+	return predicate.DeviceOption(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.DeviceOption) predicate.DeviceOption {
+	return predicate.DeviceOption(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.DeviceOption) predicate.DeviceOption {
+	return predicate.DeviceOption(sql.NotPredicates(p))
+}
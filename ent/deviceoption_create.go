@@ -0,0 +1,262 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/ddevcap/jellyfin-proxy/ent/deviceoption"
+	"github.com/google/uuid"
+)
+
+// DeviceOptionCreate is the builder for creating a DeviceOption entity.
+type DeviceOptionCreate struct {
+	config
+	mutation *DeviceOptionMutation
+	hooks    []Hook
+}
+
+// SetDeviceID sets the "device_id" field.
+func (_c *DeviceOptionCreate) SetDeviceID(v string) *DeviceOptionCreate {
+	_c.mutation.SetDeviceID(v)
+	return _c
+}
+
+// SetCustomName sets the "custom_name" field.
+func (_c *DeviceOptionCreate) SetCustomName(v string) *DeviceOptionCreate {
+	_c.mutation.SetCustomName(v)
+	return _c
+}
+
+// SetNillableCustomName sets the "custom_name" field if the given value is not nil.
+func (_c *DeviceOptionCreate) SetNillableCustomName(v *string) *DeviceOptionCreate {
+	if v != nil {
+		_c.SetCustomName(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *DeviceOptionCreate) SetUpdatedAt(v time.Time) *DeviceOptionCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *DeviceOptionCreate) SetNillableUpdatedAt(v *time.Time) *DeviceOptionCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *DeviceOptionCreate) SetID(v uuid.UUID) *DeviceOptionCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *DeviceOptionCreate) SetNillableID(v *uuid.UUID) *DeviceOptionCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// Mutation returns the DeviceOptionMutation object of the builder.
+func (_c *DeviceOptionCreate) Mutation() *DeviceOptionMutation {
+	return _c.mutation
+}
+
+// Save creates the DeviceOption in the database.
+func (_c *DeviceOptionCreate) Save(ctx context.Context) (*DeviceOption, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *DeviceOptionCreate) SaveX(ctx context.Context) *DeviceOption {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *DeviceOptionCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *DeviceOptionCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *DeviceOptionCreate) defaults() {
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := deviceoption.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := deviceoption.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *DeviceOptionCreate) check() error {
+	if _, ok := _c.mutation.DeviceID(); !ok {
+		return &ValidationError{Name: "device_id", err: errors.New(`ent: missing required field "DeviceOption.device_id"`)}
+	}
+	if v, ok := _c.mutation.DeviceID(); ok {
+		if err := deviceoption.DeviceIDValidator(v); err != nil {
+			return &ValidationError{Name: "device_id", err: fmt.Errorf(`ent: validator failed for field "DeviceOption.device_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "DeviceOption.updated_at"`)}
+	}
+	return nil
+}
+
+func (_c *DeviceOptionCreate) sqlSave(ctx context.Context) (*DeviceOption, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *DeviceOptionCreate) createSpec() (*DeviceOption, *sqlgraph.CreateSpec) {
+	var (
+		_node = &DeviceOption{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(deviceoption.Table, sqlgraph.NewFieldSpec(deviceoption.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.DeviceID(); ok {
+		_spec.SetField(deviceoption.FieldDeviceID, field.TypeString, value)
+		_node.DeviceID = value
+	}
+	if value, ok := _c.mutation.CustomName(); ok {
+		_spec.SetField(deviceoption.FieldCustomName, field.TypeString, value)
+		_node.CustomName = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(deviceoption.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	return _node, _spec
+}
+
+// DeviceOptionCreateBulk is the builder for creating many DeviceOption entities in bulk.
+type DeviceOptionCreateBulk struct {
+	config
+	err      error
+	builders []*DeviceOptionCreate
+}
+
+// Save creates the DeviceOption entities in the database.
+func (_c *DeviceOptionCreateBulk) Save(ctx context.Context) ([]*DeviceOption, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*DeviceOption, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*DeviceOptionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *DeviceOptionCreateBulk) SaveX(ctx context.Context) []*DeviceOption {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *DeviceOptionCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *DeviceOptionCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,324 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/ddevcap/jellyfin-proxy/ent/deviceoption"
+	"github.com/ddevcap/jellyfin-proxy/ent/predicate"
+)
+
+// DeviceOptionUpdate is the builder for updating DeviceOption entities.
+type DeviceOptionUpdate struct {
+	config
+	hooks    []Hook
+	mutation *DeviceOptionMutation
+}
+
+// Where appends a list predicates to the DeviceOptionUpdate builder.
+func (_u *DeviceOptionUpdate) Where(ps ...predicate.DeviceOption) *DeviceOptionUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetDeviceID sets the "device_id" field.
+func (_u *DeviceOptionUpdate) SetDeviceID(v string) *DeviceOptionUpdate {
+	_u.mutation.SetDeviceID(v)
+	return _u
+}
+
+// SetNillableDeviceID sets the "device_id" field if the given value is not nil.
+func (_u *DeviceOptionUpdate) SetNillableDeviceID(v *string) *DeviceOptionUpdate {
+	if v != nil {
+		_u.SetDeviceID(*v)
+	}
+	return _u
+}
+
+// SetCustomName sets the "custom_name" field.
+func (_u *DeviceOptionUpdate) SetCustomName(v string) *DeviceOptionUpdate {
+	_u.mutation.SetCustomName(v)
+	return _u
+}
+
+// SetNillableCustomName sets the "custom_name" field if the given value is not nil.
+func (_u *DeviceOptionUpdate) SetNillableCustomName(v *string) *DeviceOptionUpdate {
+	if v != nil {
+		_u.SetCustomName(*v)
+	}
+	return _u
+}
+
+// ClearCustomName clears the value of the "custom_name" field.
+func (_u *DeviceOptionUpdate) ClearCustomName() *DeviceOptionUpdate {
+	_u.mutation.ClearCustomName()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *DeviceOptionUpdate) SetUpdatedAt(v time.Time) *DeviceOptionUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the DeviceOptionMutation object of the builder.
+func (_u *DeviceOptionUpdate) Mutation() *DeviceOptionMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *DeviceOptionUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *DeviceOptionUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *DeviceOptionUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *DeviceOptionUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *DeviceOptionUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := deviceoption.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *DeviceOptionUpdate) check() error {
+	if v, ok := _u.mutation.DeviceID(); ok {
+		if err := deviceoption.DeviceIDValidator(v); err != nil {
+			return &ValidationError{Name: "device_id", err: fmt.Errorf(`ent: validator failed for field "DeviceOption.device_id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *DeviceOptionUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(deviceoption.Table, deviceoption.Columns, sqlgraph.NewFieldSpec(deviceoption.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.DeviceID(); ok {
+		_spec.SetField(deviceoption.FieldDeviceID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.CustomName(); ok {
+		_spec.SetField(deviceoption.FieldCustomName, field.TypeString, value)
+	}
+	if _u.mutation.CustomNameCleared() {
+		_spec.ClearField(deviceoption.FieldCustomName, field.TypeString)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(deviceoption.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{deviceoption.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// DeviceOptionUpdateOne is the builder for updating a single DeviceOption entity.
+type DeviceOptionUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *DeviceOptionMutation
+}
+
+// SetDeviceID sets the "device_id" field.
+func (_u *DeviceOptionUpdateOne) SetDeviceID(v string) *DeviceOptionUpdateOne {
+	_u.mutation.SetDeviceID(v)
+	return _u
+}
+
+// SetNillableDeviceID sets the "device_id" field if the given value is not nil.
+func (_u *DeviceOptionUpdateOne) SetNillableDeviceID(v *string) *DeviceOptionUpdateOne {
+	if v != nil {
+		_u.SetDeviceID(*v)
+	}
+	return _u
+}
+
+// SetCustomName sets the "custom_name" field.
+func (_u *DeviceOptionUpdateOne) SetCustomName(v string) *DeviceOptionUpdateOne {
+	_u.mutation.SetCustomName(v)
+	return _u
+}
+
+// SetNillableCustomName sets the "custom_name" field if the given value is not nil.
+func (_u *DeviceOptionUpdateOne) SetNillableCustomName(v *string) *DeviceOptionUpdateOne {
+	if v != nil {
+		_u.SetCustomName(*v)
+	}
+	return _u
+}
+
+// ClearCustomName clears the value of the "custom_name" field.
+func (_u *DeviceOptionUpdateOne) ClearCustomName() *DeviceOptionUpdateOne {
+	_u.mutation.ClearCustomName()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *DeviceOptionUpdateOne) SetUpdatedAt(v time.Time) *DeviceOptionUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the DeviceOptionMutation object of the builder.
+func (_u *DeviceOptionUpdateOne) Mutation() *DeviceOptionMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the DeviceOptionUpdate builder.
+func (_u *DeviceOptionUpdateOne) Where(ps ...predicate.DeviceOption) *DeviceOptionUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *DeviceOptionUpdateOne) Select(field string, fields ...string) *DeviceOptionUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated DeviceOption entity.
+func (_u *DeviceOptionUpdateOne) Save(ctx context.Context) (*DeviceOption, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *DeviceOptionUpdateOne) SaveX(ctx context.Context) *DeviceOption {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *DeviceOptionUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *DeviceOptionUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *DeviceOptionUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := deviceoption.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *DeviceOptionUpdateOne) check() error {
+	if v, ok := _u.mutation.DeviceID(); ok {
+		if err := deviceoption.DeviceIDValidator(v); err != nil {
+			return &ValidationError{Name: "device_id", err: fmt.Errorf(`ent: validator failed for field "DeviceOption.device_id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *DeviceOptionUpdateOne) sqlSave(ctx context.Context) (_node *DeviceOption, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(deviceoption.Table, deviceoption.Columns, sqlgraph.NewFieldSpec(deviceoption.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "DeviceOption.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, deviceoption.FieldID)
+		for _, f := range fields {
+			if !deviceoption.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != deviceoption.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.DeviceID(); ok {
+		_spec.SetField(deviceoption.FieldDeviceID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.CustomName(); ok {
+		_spec.SetField(deviceoption.FieldCustomName, field.TypeString, value)
+	}
+	if _u.mutation.CustomNameCleared() {
+		_spec.ClearField(deviceoption.FieldCustomName, field.TypeString)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(deviceoption.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_node = &DeviceOption{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{deviceoption.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
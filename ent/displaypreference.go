@@ -0,0 +1,153 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/ddevcap/jellyfin-proxy/ent/displaypreference"
+	"github.com/google/uuid"
+)
+
+// DisplayPreference is the model entity for the DisplayPreference schema.
+type DisplayPreference struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID uuid.UUID `json:"user_id,omitempty"`
+	// PrefsID holds the value of the "prefs_id" field.
+	PrefsID string `json:"prefs_id,omitempty"`
+	// ClientName holds the value of the "client_name" field.
+	ClientName string `json:"client_name,omitempty"`
+	// Data holds the value of the "data" field.
+	Data []byte `json:"data,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt    time.Time `json:"updated_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*DisplayPreference) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case displaypreference.FieldData:
+			values[i] = new([]byte)
+		case displaypreference.FieldPrefsID, displaypreference.FieldClientName:
+			values[i] = new(sql.NullString)
+		case displaypreference.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case displaypreference.FieldID, displaypreference.FieldUserID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the DisplayPreference fields.
+func (_m *DisplayPreference) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case displaypreference.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case displaypreference.FieldUserID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value != nil {
+				_m.UserID = *value
+			}
+		case displaypreference.FieldPrefsID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field prefs_id", values[i])
+			} else if value.Valid {
+				_m.PrefsID = value.String
+			}
+		case displaypreference.FieldClientName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field client_name", values[i])
+			} else if value.Valid {
+				_m.ClientName = value.String
+			}
+		case displaypreference.FieldData:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field data", values[i])
+			} else if value != nil {
+				_m.Data = *value
+			}
+		case displaypreference.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the DisplayPreference.
+// This includes values selected through modifiers, order, etc.
+func (_m *DisplayPreference) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this DisplayPreference.
+// Note that you need to call DisplayPreference.Unwrap() before calling this method if this DisplayPreference
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *DisplayPreference) Update() *DisplayPreferenceUpdateOne {
+	return NewDisplayPreferenceClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the DisplayPreference entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *DisplayPreference) Unwrap() *DisplayPreference {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: DisplayPreference is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *DisplayPreference) String() string {
+	var builder strings.Builder
+	builder.WriteString("DisplayPreference(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.UserID))
+	builder.WriteString(", ")
+	builder.WriteString("prefs_id=")
+	builder.WriteString(_m.PrefsID)
+	builder.WriteString(", ")
+	builder.WriteString("client_name=")
+	builder.WriteString(_m.ClientName)
+	builder.WriteString(", ")
+	builder.WriteString("data=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Data))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// DisplayPreferences is a parsable slice of DisplayPreference.
+type DisplayPreferences []*DisplayPreference
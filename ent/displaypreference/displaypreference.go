@@ -0,0 +1,90 @@
+// Code generated by ent, DO NOT EDIT.
+
+package displaypreference
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the displaypreference type in the database.
+	Label = "display_preference"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldUserID holds the string denoting the user_id field in the database.
+	FieldUserID = "user_id"
+	// FieldPrefsID holds the string denoting the prefs_id field in the database.
+	FieldPrefsID = "prefs_id"
+	// FieldClientName holds the string denoting the client_name field in the database.
+	FieldClientName = "client_name"
+	// FieldData holds the string denoting the data field in the database.
+	FieldData = "data"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// Table holds the table name of the displaypreference in the database.
+	Table = "display_preferences"
+)
+
+// Columns holds all SQL columns for displaypreference fields.
+var Columns = []string{
+	FieldID,
+	FieldUserID,
+	FieldPrefsID,
+	FieldClientName,
+	FieldData,
+	FieldUpdatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// PrefsIDValidator is a validator for the "prefs_id" field. It is called by the builders before save.
+	PrefsIDValidator func(string) error
+	// DefaultClientName holds the default value on creation for the "client_name" field.
+	DefaultClientName string
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// OrderOption defines the ordering options for the DisplayPreference queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByUserID orders the results by the user_id field.
+func ByUserID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUserID, opts...).ToFunc()
+}
+
+// ByPrefsID orders the results by the prefs_id field.
+func ByPrefsID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPrefsID, opts...).ToFunc()
+}
+
+// ByClientName orders the results by the client_name field.
+func ByClientName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldClientName, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
@@ -0,0 +1,346 @@
+// Code generated by ent, DO NOT EDIT.
+
+package displaypreference
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/ddevcap/jellyfin-proxy/ent/predicate"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.DisplayPreference {
+	return predicate.DisplayPreference(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.DisplayPreference {
+	return predicate.DisplayPreference(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.DisplayPreference {
+	return predicate.DisplayPreference(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.DisplayPreference {
+	return predicate.DisplayPreference(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.DisplayPreference {
+	return predicate.DisplayPreference(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.DisplayPreference {
+	return predicate.DisplayPreference(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.DisplayPreference {
+	return predicate.DisplayPreference(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.DisplayPreference {
+	return predicate.DisplayPreference(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.DisplayPreference {
+	return predicate.DisplayPreference(sql.FieldLTE(FieldID, id))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v uuid.UUID) predicate.DisplayPreference {
+	return predicate.DisplayPreference(sql.FieldEQ(FieldUserID, v))
+}
+
+// PrefsID applies equality check predicate on the "prefs_id" field. It's identical to PrefsIDEQ.
+func PrefsID(v string) predicate.DisplayPreference {
+	return predicate.DisplayPreference(sql.FieldEQ(FieldPrefsID, v))
+}
+
+// ClientName applies equality check predicate on the "client_name" field. It's identical to ClientNameEQ.
+func ClientName(v string) predicate.DisplayPreference {
+	return predicate.DisplayPreference(sql.FieldEQ(FieldClientName, v))
+}
+
+// Data applies equality check predicate on the "data" field. It's identical to DataEQ.
+func Data(v []byte) predicate.DisplayPreference {
+	return predicate.DisplayPreference(sql.FieldEQ(FieldData, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.DisplayPreference {
+	return predicate.DisplayPreference(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v uuid.UUID) predicate.DisplayPreference {
+	return predicate.DisplayPreference(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v uuid.UUID) predicate.DisplayPreference {
+	return predicate.DisplayPreference(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...uuid.UUID) predicate.DisplayPreference {
+	return predicate.DisplayPreference(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...uuid.UUID) predicate.DisplayPreference {
+	return predicate.DisplayPreference(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// UserIDGT applies the GT predicate on the "user_id" field.
+func UserIDGT(v uuid.UUID) predicate.DisplayPreference {
+	return predicate.DisplayPreference(sql.FieldGT(FieldUserID, v))
+}
+
+// UserIDGTE applies the GTE predicate on the "user_id" field.
+func UserIDGTE(v uuid.UUID) predicate.DisplayPreference {
+	return predicate.DisplayPreference(sql.FieldGTE(FieldUserID, v))
+}
+
+// UserIDLT applies the LT predicate on the "user_id" field.
+func UserIDLT(v uuid.UUID) predicate.DisplayPreference {
+	return predicate.DisplayPreference(sql.FieldLT(FieldUserID, v))
+}
+
+// UserIDLTE applies the LTE predicate on the "user_id" field.
+func UserIDLTE(v uuid.UUID) predicate.DisplayPreference {
+	return predicate.DisplayPreference(sql.FieldLTE(FieldUserID, v))
+}
+
+// PrefsIDEQ applies the EQ predicate on the "prefs_id" field.
+func PrefsIDEQ(v string) predicate.DisplayPreference {
+	return predicate.DisplayPreference(sql.FieldEQ(FieldPrefsID, v))
+}
+
+// PrefsIDNEQ applies the NEQ predicate on the "prefs_id" field.
+func PrefsIDNEQ(v string) predicate.DisplayPreference {
+	return predicate.DisplayPreference(sql.FieldNEQ(FieldPrefsID, v))
+}
+
+// PrefsIDIn applies the In predicate on the "prefs_id" field.
+func PrefsIDIn(vs ...string) predicate.DisplayPreference {
+	return predicate.DisplayPreference(sql.FieldIn(FieldPrefsID, vs...))
+}
+
+// PrefsIDNotIn applies the NotIn predicate on the "prefs_id" field.
+func PrefsIDNotIn(vs ...string) predicate.DisplayPreference {
+	return predicate.DisplayPreference(sql.FieldNotIn(FieldPrefsID, vs...))
+}
+
+// PrefsIDGT applies the GT predicate on the "prefs_id" field.
+func PrefsIDGT(v string) predicate.DisplayPreference {
+	return predicate.DisplayPreference(sql.FieldGT(FieldPrefsID, v))
+}
+
+// PrefsIDGTE applies the GTE predicate on the "prefs_id" field.
+func PrefsIDGTE(v string) predicate.DisplayPreference {
+	return predicate.DisplayPreference(sql.FieldGTE(FieldPrefsID, v))
+}
+
+// PrefsIDLT applies the LT predicate on the "prefs_id" field.
+func PrefsIDLT(v string) predicate.DisplayPreference {
+	return predicate.DisplayPreference(sql.FieldLT(FieldPrefsID, v))
+}
+
+// PrefsIDLTE applies the LTE predicate on the "prefs_id" field.
+func PrefsIDLTE(v string) predicate.DisplayPreference {
+	return predicate.DisplayPreference(sql.FieldLTE(FieldPrefsID, v))
+}
+
+// PrefsIDContains applies the Contains predicate on the "prefs_id" field.
+func PrefsIDContains(v string) predicate.DisplayPreference {
+	return predicate.DisplayPreference(sql.FieldContains(FieldPrefsID, v))
+}
+
+// PrefsIDHasPrefix applies the HasPrefix predicate on the "prefs_id" field.
+func PrefsIDHasPrefix(v string) predicate.DisplayPreference {
+	return predicate.DisplayPreference(sql.FieldHasPrefix(FieldPrefsID, v))
+}
+
+// PrefsIDHasSuffix applies the HasSuffix predicate on the "prefs_id" field.
+func PrefsIDHasSuffix(v string) predicate.DisplayPreference {
+	return predicate.DisplayPreference(sql.FieldHasSuffix(FieldPrefsID, v))
+}
+
+// PrefsIDEqualFold applies the EqualFold predicate on the "prefs_id" field.
+func PrefsIDEqualFold(v string) predicate.DisplayPreference {
+	return predicate.DisplayPreference(sql.FieldEqualFold(FieldPrefsID, v))
+}
+
+// PrefsIDContainsFold applies the ContainsFold predicate on the "prefs_id" field.
+func PrefsIDContainsFold(v string) predicate.DisplayPreference {
+	return predicate.DisplayPreference(sql.FieldContainsFold(FieldPrefsID, v))
+}
+
+// ClientNameEQ applies the EQ predicate on the "client_name" field.
+func ClientNameEQ(v string) predicate.DisplayPreference {
+	return predicate.DisplayPreference(sql.FieldEQ(FieldClientName, v))
+}
+
+// ClientNameNEQ applies the NEQ predicate on the "client_name" field.
+func ClientNameNEQ(v string) predicate.DisplayPreference {
+	return predicate.DisplayPreference(sql.FieldNEQ(FieldClientName, v))
+}
+
+// ClientNameIn applies the In predicate on the "client_name" field.
+func ClientNameIn(vs ...string) predicate.DisplayPreference {
+	return predicate.DisplayPreference(sql.FieldIn(FieldClientName, vs...))
+}
+
+// ClientNameNotIn applies the NotIn predicate on the "client_name" field.
+func ClientNameNotIn(vs ...string) predicate.DisplayPreference {
+	return predicate.DisplayPreference(sql.FieldNotIn(FieldClientName, vs...))
+}
+
+// ClientNameGT applies the GT predicate on the "client_name" field.
+func ClientNameGT(v string) predicate.DisplayPreference {
+	return predicate.DisplayPreference(sql.FieldGT(FieldClientName, v))
+}
+
+// ClientNameGTE applies the GTE predicate on the "client_name" field.
+func ClientNameGTE(v string) predicate.DisplayPreference {
+	return predicate.DisplayPreference(sql.FieldGTE(FieldClientName, v))
+}
+
+// ClientNameLT applies the LT predicate on the "client_name" field.
+func ClientNameLT(v string) predicate.DisplayPreference {
+	return predicate.DisplayPreference(sql.FieldLT(FieldClientName, v))
+}
+
+// ClientNameLTE applies the LTE predicate on the "client_name" field.
+func ClientNameLTE(v string) predicate.DisplayPreference {
+	return predicate.DisplayPreference(sql.FieldLTE(FieldClientName, v))
+}
+
+// ClientNameContains applies the Contains predicate on the "client_name" field.
+func ClientNameContains(v string) predicate.DisplayPreference {
+	return predicate.DisplayPreference(sql.FieldContains(FieldClientName, v))
+}
+
+// ClientNameHasPrefix applies the HasPrefix predicate on the "client_name" field.
+func ClientNameHasPrefix(v string) predicate.DisplayPreference {
+	return predicate.DisplayPreference(sql.FieldHasPrefix(FieldClientName, v))
+}
+
+// ClientNameHasSuffix applies the HasSuffix predicate on the "client_name" field.
+func ClientNameHasSuffix(v string) predicate.DisplayPreference {
+	return predicate.DisplayPreference(sql.FieldHasSuffix(FieldClientName, v))
+}
+
+// ClientNameEqualFold applies the EqualFold predicate on the "client_name" field.
+func ClientNameEqualFold(v string) predicate.DisplayPreference {
+	return predicate.DisplayPreference(sql.FieldEqualFold(FieldClientName, v))
+}
+
+// ClientNameContainsFold applies the ContainsFold predicate on the "client_name" field.
+func ClientNameContainsFold(v string) predicate.DisplayPreference {
+	return predicate.DisplayPreference(sql.FieldContainsFold(FieldClientName, v))
+}
+
+// DataEQ applies the EQ predicate on the "data" field.
+func DataEQ(v []byte) predicate.DisplayPreference {
+	return predicate.DisplayPreference(sql.FieldEQ(FieldData, v))
+}
+
+// DataNEQ applies the NEQ predicate on the "data" field.
+func DataNEQ(v []byte) predicate.DisplayPreference {
+	return predicate.DisplayPreference(sql.FieldNEQ(FieldData, v))
+}
+
+// DataIn applies the In predicate on the "data" field.
+func DataIn(vs ...[]byte) predicate.DisplayPreference {
+	return predicate.DisplayPreference(sql.FieldIn(FieldData, vs...))
+}
+
+// DataNotIn applies the NotIn predicate on the "data" field.
+func DataNotIn(vs ...[]byte) predicate.DisplayPreference {
+	return predicate.DisplayPreference(sql.FieldNotIn(FieldData, vs...))
+}
+
+// DataGT applies the GT predicate on the "data" field.
+func DataGT(v []byte) predicate.DisplayPreference {
+	return predicate.DisplayPreference(sql.FieldGT(FieldData, v))
+}
+
+// DataGTE applies the GTE predicate on the "data" field.
+func DataGTE(v []byte) predicate.DisplayPreference {
+	return predicate.DisplayPreference(sql.FieldGTE(FieldData, v))
+}
+
+// DataLT applies the LT predicate on the "data" field.
+func DataLT(v []byte) predicate.DisplayPreference {
+	return predicate.DisplayPreference(sql.FieldLT(FieldData, v))
+}
+
+// DataLTE applies the LTE predicate on the "data" field.
+func DataLTE(v []byte) predicate.DisplayPreference {
+	return predicate.DisplayPreference(sql.FieldLTE(FieldData, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.DisplayPreference {
+	return predicate.DisplayPreference(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.DisplayPreference {
+	return predicate.DisplayPreference(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.DisplayPreference {
+	return predicate.DisplayPreference(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.DisplayPreference {
+	return predicate.DisplayPreference(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.DisplayPreference {
+	return predicate.DisplayPreference(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.DisplayPreference {
+	return predicate.DisplayPreference(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.DisplayPreference {
+	return predicate.DisplayPreference(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.DisplayPreference {
+	return predicate.DisplayPreference(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.DisplayPreference) predicate.DisplayPreference {
+	return predicate.DisplayPreference(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.DisplayPreference) predicate.DisplayPreference {
+	return predicate.DisplayPreference(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.DisplayPreference) predicate.DisplayPreference {
+	return predicate.DisplayPreference(sql.NotPredicates(p))
+}
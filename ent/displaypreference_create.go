@@ -0,0 +1,295 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/ddevcap/jellyfin-proxy/ent/displaypreference"
+	"github.com/google/uuid"
+)
+
+// DisplayPreferenceCreate is the builder for creating a DisplayPreference entity.
+type DisplayPreferenceCreate struct {
+	config
+	mutation *DisplayPreferenceMutation
+	hooks    []Hook
+}
+
+// SetUserID sets the "user_id" field.
+func (_c *DisplayPreferenceCreate) SetUserID(v uuid.UUID) *DisplayPreferenceCreate {
+	_c.mutation.SetUserID(v)
+	return _c
+}
+
+// SetPrefsID sets the "prefs_id" field.
+func (_c *DisplayPreferenceCreate) SetPrefsID(v string) *DisplayPreferenceCreate {
+	_c.mutation.SetPrefsID(v)
+	return _c
+}
+
+// SetClientName sets the "client_name" field.
+func (_c *DisplayPreferenceCreate) SetClientName(v string) *DisplayPreferenceCreate {
+	_c.mutation.SetClientName(v)
+	return _c
+}
+
+// SetNillableClientName sets the "client_name" field if the given value is not nil.
+func (_c *DisplayPreferenceCreate) SetNillableClientName(v *string) *DisplayPreferenceCreate {
+	if v != nil {
+		_c.SetClientName(*v)
+	}
+	return _c
+}
+
+// SetData sets the "data" field.
+func (_c *DisplayPreferenceCreate) SetData(v []byte) *DisplayPreferenceCreate {
+	_c.mutation.SetData(v)
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *DisplayPreferenceCreate) SetUpdatedAt(v time.Time) *DisplayPreferenceCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *DisplayPreferenceCreate) SetNillableUpdatedAt(v *time.Time) *DisplayPreferenceCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *DisplayPreferenceCreate) SetID(v uuid.UUID) *DisplayPreferenceCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *DisplayPreferenceCreate) SetNillableID(v *uuid.UUID) *DisplayPreferenceCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// Mutation returns the DisplayPreferenceMutation object of the builder.
+func (_c *DisplayPreferenceCreate) Mutation() *DisplayPreferenceMutation {
+	return _c.mutation
+}
+
+// Save creates the DisplayPreference in the database.
+func (_c *DisplayPreferenceCreate) Save(ctx context.Context) (*DisplayPreference, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *DisplayPreferenceCreate) SaveX(ctx context.Context) *DisplayPreference {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *DisplayPreferenceCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *DisplayPreferenceCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *DisplayPreferenceCreate) defaults() {
+	if _, ok := _c.mutation.ClientName(); !ok {
+		v := displaypreference.DefaultClientName
+		_c.mutation.SetClientName(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := displaypreference.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := displaypreference.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *DisplayPreferenceCreate) check() error {
+	if _, ok := _c.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "DisplayPreference.user_id"`)}
+	}
+	if _, ok := _c.mutation.PrefsID(); !ok {
+		return &ValidationError{Name: "prefs_id", err: errors.New(`ent: missing required field "DisplayPreference.prefs_id"`)}
+	}
+	if v, ok := _c.mutation.PrefsID(); ok {
+		if err := displaypreference.PrefsIDValidator(v); err != nil {
+			return &ValidationError{Name: "prefs_id", err: fmt.Errorf(`ent: validator failed for field "DisplayPreference.prefs_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.ClientName(); !ok {
+		return &ValidationError{Name: "client_name", err: errors.New(`ent: missing required field "DisplayPreference.client_name"`)}
+	}
+	if _, ok := _c.mutation.Data(); !ok {
+		return &ValidationError{Name: "data", err: errors.New(`ent: missing required field "DisplayPreference.data"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "DisplayPreference.updated_at"`)}
+	}
+	return nil
+}
+
+func (_c *DisplayPreferenceCreate) sqlSave(ctx context.Context) (*DisplayPreference, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *DisplayPreferenceCreate) createSpec() (*DisplayPreference, *sqlgraph.CreateSpec) {
+	var (
+		_node = &DisplayPreference{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(displaypreference.Table, sqlgraph.NewFieldSpec(displaypreference.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.UserID(); ok {
+		_spec.SetField(displaypreference.FieldUserID, field.TypeUUID, value)
+		_node.UserID = value
+	}
+	if value, ok := _c.mutation.PrefsID(); ok {
+		_spec.SetField(displaypreference.FieldPrefsID, field.TypeString, value)
+		_node.PrefsID = value
+	}
+	if value, ok := _c.mutation.ClientName(); ok {
+		_spec.SetField(displaypreference.FieldClientName, field.TypeString, value)
+		_node.ClientName = value
+	}
+	if value, ok := _c.mutation.Data(); ok {
+		_spec.SetField(displaypreference.FieldData, field.TypeBytes, value)
+		_node.Data = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(displaypreference.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	return _node, _spec
+}
+
+// DisplayPreferenceCreateBulk is the builder for creating many DisplayPreference entities in bulk.
+type DisplayPreferenceCreateBulk struct {
+	config
+	err      error
+	builders []*DisplayPreferenceCreate
+}
+
+// Save creates the DisplayPreference entities in the database.
+func (_c *DisplayPreferenceCreateBulk) Save(ctx context.Context) ([]*DisplayPreference, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*DisplayPreference, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*DisplayPreferenceMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *DisplayPreferenceCreateBulk) SaveX(ctx context.Context) []*DisplayPreference {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *DisplayPreferenceCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *DisplayPreferenceCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
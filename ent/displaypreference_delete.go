@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/ddevcap/jellyfin-proxy/ent/displaypreference"
+	"github.com/ddevcap/jellyfin-proxy/ent/predicate"
+)
+
+// DisplayPreferenceDelete is the builder for deleting a DisplayPreference entity.
+type DisplayPreferenceDelete struct {
+	config
+	hooks    []Hook
+	mutation *DisplayPreferenceMutation
+}
+
+// Where appends a list predicates to the DisplayPreferenceDelete builder.
+func (_d *DisplayPreferenceDelete) Where(ps ...predicate.DisplayPreference) *DisplayPreferenceDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *DisplayPreferenceDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *DisplayPreferenceDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *DisplayPreferenceDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(displaypreference.Table, sqlgraph.NewFieldSpec(displaypreference.FieldID, field.TypeUUID))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// DisplayPreferenceDeleteOne is the builder for deleting a single DisplayPreference entity.
+type DisplayPreferenceDeleteOne struct {
+	_d *DisplayPreferenceDelete
+}
+
+// Where appends a list predicates to the DisplayPreferenceDelete builder.
+func (_d *DisplayPreferenceDeleteOne) Where(ps ...predicate.DisplayPreference) *DisplayPreferenceDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *DisplayPreferenceDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{displaypreference.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *DisplayPreferenceDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
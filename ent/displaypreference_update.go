@@ -0,0 +1,359 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/ddevcap/jellyfin-proxy/ent/displaypreference"
+	"github.com/ddevcap/jellyfin-proxy/ent/predicate"
+	"github.com/google/uuid"
+)
+
+// DisplayPreferenceUpdate is the builder for updating DisplayPreference entities.
+type DisplayPreferenceUpdate struct {
+	config
+	hooks    []Hook
+	mutation *DisplayPreferenceMutation
+}
+
+// Where appends a list predicates to the DisplayPreferenceUpdate builder.
+func (_u *DisplayPreferenceUpdate) Where(ps ...predicate.DisplayPreference) *DisplayPreferenceUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *DisplayPreferenceUpdate) SetUserID(v uuid.UUID) *DisplayPreferenceUpdate {
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *DisplayPreferenceUpdate) SetNillableUserID(v *uuid.UUID) *DisplayPreferenceUpdate {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// SetPrefsID sets the "prefs_id" field.
+func (_u *DisplayPreferenceUpdate) SetPrefsID(v string) *DisplayPreferenceUpdate {
+	_u.mutation.SetPrefsID(v)
+	return _u
+}
+
+// SetNillablePrefsID sets the "prefs_id" field if the given value is not nil.
+func (_u *DisplayPreferenceUpdate) SetNillablePrefsID(v *string) *DisplayPreferenceUpdate {
+	if v != nil {
+		_u.SetPrefsID(*v)
+	}
+	return _u
+}
+
+// SetClientName sets the "client_name" field.
+func (_u *DisplayPreferenceUpdate) SetClientName(v string) *DisplayPreferenceUpdate {
+	_u.mutation.SetClientName(v)
+	return _u
+}
+
+// SetNillableClientName sets the "client_name" field if the given value is not nil.
+func (_u *DisplayPreferenceUpdate) SetNillableClientName(v *string) *DisplayPreferenceUpdate {
+	if v != nil {
+		_u.SetClientName(*v)
+	}
+	return _u
+}
+
+// SetData sets the "data" field.
+func (_u *DisplayPreferenceUpdate) SetData(v []byte) *DisplayPreferenceUpdate {
+	_u.mutation.SetData(v)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *DisplayPreferenceUpdate) SetUpdatedAt(v time.Time) *DisplayPreferenceUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the DisplayPreferenceMutation object of the builder.
+func (_u *DisplayPreferenceUpdate) Mutation() *DisplayPreferenceMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *DisplayPreferenceUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *DisplayPreferenceUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *DisplayPreferenceUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *DisplayPreferenceUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *DisplayPreferenceUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := displaypreference.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *DisplayPreferenceUpdate) check() error {
+	if v, ok := _u.mutation.PrefsID(); ok {
+		if err := displaypreference.PrefsIDValidator(v); err != nil {
+			return &ValidationError{Name: "prefs_id", err: fmt.Errorf(`ent: validator failed for field "DisplayPreference.prefs_id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *DisplayPreferenceUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(displaypreference.Table, displaypreference.Columns, sqlgraph.NewFieldSpec(displaypreference.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UserID(); ok {
+		_spec.SetField(displaypreference.FieldUserID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.PrefsID(); ok {
+		_spec.SetField(displaypreference.FieldPrefsID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ClientName(); ok {
+		_spec.SetField(displaypreference.FieldClientName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Data(); ok {
+		_spec.SetField(displaypreference.FieldData, field.TypeBytes, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(displaypreference.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{displaypreference.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// DisplayPreferenceUpdateOne is the builder for updating a single DisplayPreference entity.
+type DisplayPreferenceUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *DisplayPreferenceMutation
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *DisplayPreferenceUpdateOne) SetUserID(v uuid.UUID) *DisplayPreferenceUpdateOne {
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *DisplayPreferenceUpdateOne) SetNillableUserID(v *uuid.UUID) *DisplayPreferenceUpdateOne {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// SetPrefsID sets the "prefs_id" field.
+func (_u *DisplayPreferenceUpdateOne) SetPrefsID(v string) *DisplayPreferenceUpdateOne {
+	_u.mutation.SetPrefsID(v)
+	return _u
+}
+
+// SetNillablePrefsID sets the "prefs_id" field if the given value is not nil.
+func (_u *DisplayPreferenceUpdateOne) SetNillablePrefsID(v *string) *DisplayPreferenceUpdateOne {
+	if v != nil {
+		_u.SetPrefsID(*v)
+	}
+	return _u
+}
+
+// SetClientName sets the "client_name" field.
+func (_u *DisplayPreferenceUpdateOne) SetClientName(v string) *DisplayPreferenceUpdateOne {
+	_u.mutation.SetClientName(v)
+	return _u
+}
+
+// SetNillableClientName sets the "client_name" field if the given value is not nil.
+func (_u *DisplayPreferenceUpdateOne) SetNillableClientName(v *string) *DisplayPreferenceUpdateOne {
+	if v != nil {
+		_u.SetClientName(*v)
+	}
+	return _u
+}
+
+// SetData sets the "data" field.
+func (_u *DisplayPreferenceUpdateOne) SetData(v []byte) *DisplayPreferenceUpdateOne {
+	_u.mutation.SetData(v)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *DisplayPreferenceUpdateOne) SetUpdatedAt(v time.Time) *DisplayPreferenceUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the DisplayPreferenceMutation object of the builder.
+func (_u *DisplayPreferenceUpdateOne) Mutation() *DisplayPreferenceMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the DisplayPreferenceUpdate builder.
+func (_u *DisplayPreferenceUpdateOne) Where(ps ...predicate.DisplayPreference) *DisplayPreferenceUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *DisplayPreferenceUpdateOne) Select(field string, fields ...string) *DisplayPreferenceUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated DisplayPreference entity.
+func (_u *DisplayPreferenceUpdateOne) Save(ctx context.Context) (*DisplayPreference, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *DisplayPreferenceUpdateOne) SaveX(ctx context.Context) *DisplayPreference {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *DisplayPreferenceUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *DisplayPreferenceUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *DisplayPreferenceUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := displaypreference.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *DisplayPreferenceUpdateOne) check() error {
+	if v, ok := _u.mutation.PrefsID(); ok {
+		if err := displaypreference.PrefsIDValidator(v); err != nil {
+			return &ValidationError{Name: "prefs_id", err: fmt.Errorf(`ent: validator failed for field "DisplayPreference.prefs_id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *DisplayPreferenceUpdateOne) sqlSave(ctx context.Context) (_node *DisplayPreference, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(displaypreference.Table, displaypreference.Columns, sqlgraph.NewFieldSpec(displaypreference.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "DisplayPreference.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, displaypreference.FieldID)
+		for _, f := range fields {
+			if !displaypreference.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != displaypreference.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UserID(); ok {
+		_spec.SetField(displaypreference.FieldUserID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.PrefsID(); ok {
+		_spec.SetField(displaypreference.FieldPrefsID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ClientName(); ok {
+		_spec.SetField(displaypreference.FieldClientName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Data(); ok {
+		_spec.SetField(displaypreference.FieldData, field.TypeBytes, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(displaypreference.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_node = &DisplayPreference{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{displaypreference.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,134 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/ddevcap/jellyfin-proxy/ent/duplicateitem"
+	"github.com/google/uuid"
+)
+
+// DuplicateItem is the model entity for the DuplicateItem schema.
+type DuplicateItem struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// DupID holds the value of the "dup_id" field.
+	DupID string `json:"dup_id,omitempty"`
+	// Copies holds the value of the "copies" field.
+	Copies []string `json:"copies,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt    time.Time `json:"updated_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*DuplicateItem) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case duplicateitem.FieldCopies:
+			values[i] = new([]byte)
+		case duplicateitem.FieldDupID:
+			values[i] = new(sql.NullString)
+		case duplicateitem.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case duplicateitem.FieldID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the DuplicateItem fields.
+func (_m *DuplicateItem) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case duplicateitem.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case duplicateitem.FieldDupID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field dup_id", values[i])
+			} else if value.Valid {
+				_m.DupID = value.String
+			}
+		case duplicateitem.FieldCopies:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field copies", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Copies); err != nil {
+					return fmt.Errorf("unmarshal field copies: %w", err)
+				}
+			}
+		case duplicateitem.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the DuplicateItem.
+// This includes values selected through modifiers, order, etc.
+func (_m *DuplicateItem) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this DuplicateItem.
+// Note that you need to call DuplicateItem.Unwrap() before calling this method if this DuplicateItem
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *DuplicateItem) Update() *DuplicateItemUpdateOne {
+	return NewDuplicateItemClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the DuplicateItem entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *DuplicateItem) Unwrap() *DuplicateItem {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: DuplicateItem is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *DuplicateItem) String() string {
+	var builder strings.Builder
+	builder.WriteString("DuplicateItem(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("dup_id=")
+	builder.WriteString(_m.DupID)
+	builder.WriteString(", ")
+	builder.WriteString("copies=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Copies))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// DuplicateItems is a parsable slice of DuplicateItem.
+type DuplicateItems []*DuplicateItem
@@ -0,0 +1,72 @@
+// Code generated by ent, DO NOT EDIT.
+
+package duplicateitem
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the duplicateitem type in the database.
+	Label = "duplicate_item"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldDupID holds the string denoting the dup_id field in the database.
+	FieldDupID = "dup_id"
+	// FieldCopies holds the string denoting the copies field in the database.
+	FieldCopies = "copies"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// Table holds the table name of the duplicateitem in the database.
+	Table = "duplicate_items"
+)
+
+// Columns holds all SQL columns for duplicateitem fields.
+var Columns = []string{
+	FieldID,
+	FieldDupID,
+	FieldCopies,
+	FieldUpdatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DupIDValidator is a validator for the "dup_id" field. It is called by the builders before save.
+	DupIDValidator func(string) error
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// OrderOption defines the ordering options for the DuplicateItem queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByDupID orders the results by the dup_id field.
+func ByDupID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDupID, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
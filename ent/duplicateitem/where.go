@@ -0,0 +1,186 @@
+// Code generated by ent, DO NOT EDIT.
+
+package duplicateitem
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/ddevcap/jellyfin-proxy/ent/predicate"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.DuplicateItem {
+	return predicate.DuplicateItem(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.DuplicateItem {
+	return predicate.DuplicateItem(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.DuplicateItem {
+	return predicate.DuplicateItem(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.DuplicateItem {
+	return predicate.DuplicateItem(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.DuplicateItem {
+	return predicate.DuplicateItem(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.DuplicateItem {
+	return predicate.DuplicateItem(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.DuplicateItem {
+	return predicate.DuplicateItem(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.DuplicateItem {
+	return predicate.DuplicateItem(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.DuplicateItem {
+	return predicate.DuplicateItem(sql.FieldLTE(FieldID, id))
+}
+
+// DupID applies equality check predicate on the "dup_id" field. It's identical to DupIDEQ.
+func DupID(v string) predicate.DuplicateItem {
+	return predicate.DuplicateItem(sql.FieldEQ(FieldDupID, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.DuplicateItem {
+	return predicate.DuplicateItem(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// DupIDEQ applies the EQ predicate on the "dup_id" field.
+func DupIDEQ(v string) predicate.DuplicateItem {
+	return predicate.DuplicateItem(sql.FieldEQ(FieldDupID, v))
+}
+
+// DupIDNEQ applies the NEQ predicate on the "dup_id" field.
+func DupIDNEQ(v string) predicate.DuplicateItem {
+	return predicate.DuplicateItem(sql.FieldNEQ(FieldDupID, v))
+}
+
+// DupIDIn applies the In predicate on the "dup_id" field.
+func DupIDIn(vs ...string) predicate.DuplicateItem {
+	return predicate.DuplicateItem(sql.FieldIn(FieldDupID, vs...))
+}
+
+// DupIDNotIn applies the NotIn predicate on the "dup_id" field.
+func DupIDNotIn(vs ...string) predicate.DuplicateItem {
+	return predicate.DuplicateItem(sql.FieldNotIn(FieldDupID, vs...))
+}
+
+// DupIDGT applies the GT predicate on the "dup_id" field.
+func DupIDGT(v string) predicate.DuplicateItem {
+	return predicate.DuplicateItem(sql.FieldGT(FieldDupID, v))
+}
+
+// DupIDGTE applies the GTE predicate on the "dup_id" field.
+func DupIDGTE(v string) predicate.DuplicateItem {
+	return predicate.DuplicateItem(sql.FieldGTE(FieldDupID, v))
+}
+
+// DupIDLT applies the LT predicate on the "dup_id" field.
+func DupIDLT(v string) predicate.DuplicateItem {
+	return predicate.DuplicateItem(sql.FieldLT(FieldDupID, v))
+}
+
+// DupIDLTE applies the LTE predicate on the "dup_id" field.
+func DupIDLTE(v string) predicate.DuplicateItem {
+	return predicate.DuplicateItem(sql.FieldLTE(FieldDupID, v))
+}
+
+// DupIDContains applies the Contains predicate on the "dup_id" field.
+func DupIDContains(v string) predicate.DuplicateItem {
+	return predicate.DuplicateItem(sql.FieldContains(FieldDupID, v))
+}
+
+// DupIDHasPrefix applies the HasPrefix predicate on the "dup_id" field.
+func DupIDHasPrefix(v string) predicate.DuplicateItem {
+	return predicate.DuplicateItem(sql.FieldHasPrefix(FieldDupID, v))
+}
+
+// DupIDHasSuffix applies the HasSuffix predicate on the "dup_id" field.
+func DupIDHasSuffix(v string) predicate.DuplicateItem {
+	return predicate.DuplicateItem(sql.FieldHasSuffix(FieldDupID, v))
+}
+
+// DupIDEqualFold applies the EqualFold predicate on the "dup_id" field.
+func DupIDEqualFold(v string) predicate.DuplicateItem {
+	return predicate.DuplicateItem(sql.FieldEqualFold(FieldDupID, v))
+}
+
+// DupIDContainsFold applies the ContainsFold predicate on the "dup_id" field.
+func DupIDContainsFold(v string) predicate.DuplicateItem {
+	return predicate.DuplicateItem(sql.FieldContainsFold(FieldDupID, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.DuplicateItem {
+	return predicate.DuplicateItem(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.DuplicateItem {
+	return predicate.DuplicateItem(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.DuplicateItem {
+	return predicate.DuplicateItem(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.DuplicateItem {
+	return predicate.DuplicateItem(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.DuplicateItem {
+	return predicate.DuplicateItem(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.DuplicateItem {
+	return predicate.DuplicateItem(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.DuplicateItem {
+	return predicate.DuplicateItem(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.DuplicateItem {
+	return predicate.DuplicateItem(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.DuplicateItem) predicate.DuplicateItem {
+	return predicate.DuplicateItem(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.DuplicateItem) predicate.DuplicateItem {
+	return predicate.DuplicateItem(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.DuplicateItem) predicate.DuplicateItem {
+	return predicate.DuplicateItem(sql.NotPredicates(p))
+}
@@ -0,0 +1,257 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/ddevcap/jellyfin-proxy/ent/duplicateitem"
+	"github.com/google/uuid"
+)
+
+// DuplicateItemCreate is the builder for creating a DuplicateItem entity.
+type DuplicateItemCreate struct {
+	config
+	mutation *DuplicateItemMutation
+	hooks    []Hook
+}
+
+// SetDupID sets the "dup_id" field.
+func (_c *DuplicateItemCreate) SetDupID(v string) *DuplicateItemCreate {
+	_c.mutation.SetDupID(v)
+	return _c
+}
+
+// SetCopies sets the "copies" field.
+func (_c *DuplicateItemCreate) SetCopies(v []string) *DuplicateItemCreate {
+	_c.mutation.SetCopies(v)
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *DuplicateItemCreate) SetUpdatedAt(v time.Time) *DuplicateItemCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *DuplicateItemCreate) SetNillableUpdatedAt(v *time.Time) *DuplicateItemCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *DuplicateItemCreate) SetID(v uuid.UUID) *DuplicateItemCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *DuplicateItemCreate) SetNillableID(v *uuid.UUID) *DuplicateItemCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// Mutation returns the DuplicateItemMutation object of the builder.
+func (_c *DuplicateItemCreate) Mutation() *DuplicateItemMutation {
+	return _c.mutation
+}
+
+// Save creates the DuplicateItem in the database.
+func (_c *DuplicateItemCreate) Save(ctx context.Context) (*DuplicateItem, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *DuplicateItemCreate) SaveX(ctx context.Context) *DuplicateItem {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *DuplicateItemCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *DuplicateItemCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *DuplicateItemCreate) defaults() {
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := duplicateitem.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := duplicateitem.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *DuplicateItemCreate) check() error {
+	if _, ok := _c.mutation.DupID(); !ok {
+		return &ValidationError{Name: "dup_id", err: errors.New(`ent: missing required field "DuplicateItem.dup_id"`)}
+	}
+	if v, ok := _c.mutation.DupID(); ok {
+		if err := duplicateitem.DupIDValidator(v); err != nil {
+			return &ValidationError{Name: "dup_id", err: fmt.Errorf(`ent: validator failed for field "DuplicateItem.dup_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Copies(); !ok {
+		return &ValidationError{Name: "copies", err: errors.New(`ent: missing required field "DuplicateItem.copies"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "DuplicateItem.updated_at"`)}
+	}
+	return nil
+}
+
+func (_c *DuplicateItemCreate) sqlSave(ctx context.Context) (*DuplicateItem, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *DuplicateItemCreate) createSpec() (*DuplicateItem, *sqlgraph.CreateSpec) {
+	var (
+		_node = &DuplicateItem{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(duplicateitem.Table, sqlgraph.NewFieldSpec(duplicateitem.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.DupID(); ok {
+		_spec.SetField(duplicateitem.FieldDupID, field.TypeString, value)
+		_node.DupID = value
+	}
+	if value, ok := _c.mutation.Copies(); ok {
+		_spec.SetField(duplicateitem.FieldCopies, field.TypeJSON, value)
+		_node.Copies = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(duplicateitem.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	return _node, _spec
+}
+
+// DuplicateItemCreateBulk is the builder for creating many DuplicateItem entities in bulk.
+type DuplicateItemCreateBulk struct {
+	config
+	err      error
+	builders []*DuplicateItemCreate
+}
+
+// Save creates the DuplicateItem entities in the database.
+func (_c *DuplicateItemCreateBulk) Save(ctx context.Context) ([]*DuplicateItem, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*DuplicateItem, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*DuplicateItemMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *DuplicateItemCreateBulk) SaveX(ctx context.Context) []*DuplicateItem {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *DuplicateItemCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *DuplicateItemCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
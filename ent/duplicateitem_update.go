@@ -0,0 +1,313 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/ddevcap/jellyfin-proxy/ent/duplicateitem"
+	"github.com/ddevcap/jellyfin-proxy/ent/predicate"
+)
+
+// DuplicateItemUpdate is the builder for updating DuplicateItem entities.
+type DuplicateItemUpdate struct {
+	config
+	hooks    []Hook
+	mutation *DuplicateItemMutation
+}
+
+// Where appends a list predicates to the DuplicateItemUpdate builder.
+func (_u *DuplicateItemUpdate) Where(ps ...predicate.DuplicateItem) *DuplicateItemUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetDupID sets the "dup_id" field.
+func (_u *DuplicateItemUpdate) SetDupID(v string) *DuplicateItemUpdate {
+	_u.mutation.SetDupID(v)
+	return _u
+}
+
+// SetNillableDupID sets the "dup_id" field if the given value is not nil.
+func (_u *DuplicateItemUpdate) SetNillableDupID(v *string) *DuplicateItemUpdate {
+	if v != nil {
+		_u.SetDupID(*v)
+	}
+	return _u
+}
+
+// SetCopies sets the "copies" field.
+func (_u *DuplicateItemUpdate) SetCopies(v []string) *DuplicateItemUpdate {
+	_u.mutation.SetCopies(v)
+	return _u
+}
+
+// AppendCopies appends value to the "copies" field.
+func (_u *DuplicateItemUpdate) AppendCopies(v []string) *DuplicateItemUpdate {
+	_u.mutation.AppendCopies(v)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *DuplicateItemUpdate) SetUpdatedAt(v time.Time) *DuplicateItemUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the DuplicateItemMutation object of the builder.
+func (_u *DuplicateItemUpdate) Mutation() *DuplicateItemMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *DuplicateItemUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *DuplicateItemUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *DuplicateItemUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *DuplicateItemUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *DuplicateItemUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := duplicateitem.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *DuplicateItemUpdate) check() error {
+	if v, ok := _u.mutation.DupID(); ok {
+		if err := duplicateitem.DupIDValidator(v); err != nil {
+			return &ValidationError{Name: "dup_id", err: fmt.Errorf(`ent: validator failed for field "DuplicateItem.dup_id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *DuplicateItemUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(duplicateitem.Table, duplicateitem.Columns, sqlgraph.NewFieldSpec(duplicateitem.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.DupID(); ok {
+		_spec.SetField(duplicateitem.FieldDupID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Copies(); ok {
+		_spec.SetField(duplicateitem.FieldCopies, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedCopies(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, duplicateitem.FieldCopies, value)
+		})
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(duplicateitem.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{duplicateitem.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// DuplicateItemUpdateOne is the builder for updating a single DuplicateItem entity.
+type DuplicateItemUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *DuplicateItemMutation
+}
+
+// SetDupID sets the "dup_id" field.
+func (_u *DuplicateItemUpdateOne) SetDupID(v string) *DuplicateItemUpdateOne {
+	_u.mutation.SetDupID(v)
+	return _u
+}
+
+// SetNillableDupID sets the "dup_id" field if the given value is not nil.
+func (_u *DuplicateItemUpdateOne) SetNillableDupID(v *string) *DuplicateItemUpdateOne {
+	if v != nil {
+		_u.SetDupID(*v)
+	}
+	return _u
+}
+
+// SetCopies sets the "copies" field.
+func (_u *DuplicateItemUpdateOne) SetCopies(v []string) *DuplicateItemUpdateOne {
+	_u.mutation.SetCopies(v)
+	return _u
+}
+
+// AppendCopies appends value to the "copies" field.
+func (_u *DuplicateItemUpdateOne) AppendCopies(v []string) *DuplicateItemUpdateOne {
+	_u.mutation.AppendCopies(v)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *DuplicateItemUpdateOne) SetUpdatedAt(v time.Time) *DuplicateItemUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the DuplicateItemMutation object of the builder.
+func (_u *DuplicateItemUpdateOne) Mutation() *DuplicateItemMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the DuplicateItemUpdate builder.
+func (_u *DuplicateItemUpdateOne) Where(ps ...predicate.DuplicateItem) *DuplicateItemUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *DuplicateItemUpdateOne) Select(field string, fields ...string) *DuplicateItemUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated DuplicateItem entity.
+func (_u *DuplicateItemUpdateOne) Save(ctx context.Context) (*DuplicateItem, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *DuplicateItemUpdateOne) SaveX(ctx context.Context) *DuplicateItem {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *DuplicateItemUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *DuplicateItemUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *DuplicateItemUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := duplicateitem.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *DuplicateItemUpdateOne) check() error {
+	if v, ok := _u.mutation.DupID(); ok {
+		if err := duplicateitem.DupIDValidator(v); err != nil {
+			return &ValidationError{Name: "dup_id", err: fmt.Errorf(`ent: validator failed for field "DuplicateItem.dup_id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *DuplicateItemUpdateOne) sqlSave(ctx context.Context) (_node *DuplicateItem, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(duplicateitem.Table, duplicateitem.Columns, sqlgraph.NewFieldSpec(duplicateitem.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "DuplicateItem.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, duplicateitem.FieldID)
+		for _, f := range fields {
+			if !duplicateitem.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != duplicateitem.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.DupID(); ok {
+		_spec.SetField(duplicateitem.FieldDupID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Copies(); ok {
+		_spec.SetField(duplicateitem.FieldCopies, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedCopies(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, duplicateitem.FieldCopies, value)
+		})
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(duplicateitem.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_node = &DuplicateItem{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{duplicateitem.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
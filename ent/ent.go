@@ -12,10 +12,20 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/ddevcap/jellyfin-proxy/ent/activitylog"
+	"github.com/ddevcap/jellyfin-proxy/ent/apikey"
+	"github.com/ddevcap/jellyfin-proxy/ent/apppassword"
 	"github.com/ddevcap/jellyfin-proxy/ent/backend"
 	"github.com/ddevcap/jellyfin-proxy/ent/backenduser"
+	"github.com/ddevcap/jellyfin-proxy/ent/deviceoption"
+	"github.com/ddevcap/jellyfin-proxy/ent/displaypreference"
+	"github.com/ddevcap/jellyfin-proxy/ent/duplicateitem"
+	"github.com/ddevcap/jellyfin-proxy/ent/item"
+	"github.com/ddevcap/jellyfin-proxy/ent/playbackevent"
+	"github.com/ddevcap/jellyfin-proxy/ent/recoverycode"
 	"github.com/ddevcap/jellyfin-proxy/ent/session"
 	"github.com/ddevcap/jellyfin-proxy/ent/user"
+	"github.com/ddevcap/jellyfin-proxy/ent/userconfiguration"
 )
 
 // ent aliases to avoid import conflicts in user's code.
@@ -76,10 +86,20 @@ var (
 func checkColumn(t, c string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			backend.Table:     backend.ValidColumn,
-			backenduser.Table: backenduser.ValidColumn,
-			session.Table:     session.ValidColumn,
-			user.Table:        user.ValidColumn,
+			activitylog.Table:       activitylog.ValidColumn,
+			apikey.Table:            apikey.ValidColumn,
+			apppassword.Table:       apppassword.ValidColumn,
+			backend.Table:           backend.ValidColumn,
+			backenduser.Table:       backenduser.ValidColumn,
+			deviceoption.Table:      deviceoption.ValidColumn,
+			displaypreference.Table: displaypreference.ValidColumn,
+			duplicateitem.Table:     duplicateitem.ValidColumn,
+			item.Table:              item.ValidColumn,
+			playbackevent.Table:     playbackevent.ValidColumn,
+			recoverycode.Table:      recoverycode.ValidColumn,
+			session.Table:           session.ValidColumn,
+			user.Table:              user.ValidColumn,
+			userconfiguration.Table: userconfiguration.ValidColumn,
 		})
 	})
 	return columnCheck(t, c)
@@ -0,0 +1,389 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/ddevcap/jellyfin-proxy/ent/backend"
+	"github.com/ddevcap/jellyfin-proxy/ent/item"
+	"github.com/google/uuid"
+)
+
+// Item is the model entity for the Item schema.
+type Item struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// BackendItemID holds the value of the "backend_item_id" field.
+	BackendItemID string `json:"backend_item_id,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// SortName holds the value of the "sort_name" field.
+	SortName string `json:"sort_name,omitempty"`
+	// ItemType holds the value of the "item_type" field.
+	ItemType string `json:"item_type,omitempty"`
+	// MediaType holds the value of the "media_type" field.
+	MediaType string `json:"media_type,omitempty"`
+	// LibraryID holds the value of the "library_id" field.
+	LibraryID string `json:"library_id,omitempty"`
+	// CollectionType holds the value of the "collection_type" field.
+	CollectionType string `json:"collection_type,omitempty"`
+	// ParentID holds the value of the "parent_id" field.
+	ParentID string `json:"parent_id,omitempty"`
+	// SeasonID holds the value of the "season_id" field.
+	SeasonID string `json:"season_id,omitempty"`
+	// SeriesID holds the value of the "series_id" field.
+	SeriesID string `json:"series_id,omitempty"`
+	// TmdbID holds the value of the "tmdb_id" field.
+	TmdbID string `json:"tmdb_id,omitempty"`
+	// ImdbID holds the value of the "imdb_id" field.
+	ImdbID string `json:"imdb_id,omitempty"`
+	// TvdbID holds the value of the "tvdb_id" field.
+	TvdbID string `json:"tvdb_id,omitempty"`
+	// Genres holds the value of the "genres" field.
+	Genres string `json:"genres,omitempty"`
+	// Tags holds the value of the "tags" field.
+	Tags string `json:"tags,omitempty"`
+	// OfficialRating holds the value of the "official_rating" field.
+	OfficialRating string `json:"official_rating,omitempty"`
+	// ProductionYear holds the value of the "production_year" field.
+	ProductionYear int `json:"production_year,omitempty"`
+	// CommunityRating holds the value of the "community_rating" field.
+	CommunityRating float64 `json:"community_rating,omitempty"`
+	// PremiereDate holds the value of the "premiere_date" field.
+	PremiereDate *time.Time `json:"premiere_date,omitempty"`
+	// DateCreated holds the value of the "date_created" field.
+	DateCreated *time.Time `json:"date_created,omitempty"`
+	// Data holds the value of the "data" field.
+	Data []byte `json:"data,omitempty"`
+	// IndexedAt holds the value of the "indexed_at" field.
+	IndexedAt time.Time `json:"indexed_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ItemQuery when eager-loading is set.
+	Edges         ItemEdges `json:"edges"`
+	backend_items *uuid.UUID
+	selectValues  sql.SelectValues
+}
+
+// ItemEdges holds the relations/edges for other nodes in the graph.
+type ItemEdges struct {
+	// Backend holds the value of the backend edge.
+	Backend *Backend `json:"backend,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// BackendOrErr returns the Backend value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ItemEdges) BackendOrErr() (*Backend, error) {
+	if e.Backend != nil {
+		return e.Backend, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: backend.Label}
+	}
+	return nil, &NotLoadedError{edge: "backend"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Item) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case item.FieldData:
+			values[i] = new([]byte)
+		case item.FieldCommunityRating:
+			values[i] = new(sql.NullFloat64)
+		case item.FieldProductionYear:
+			values[i] = new(sql.NullInt64)
+		case item.FieldBackendItemID, item.FieldName, item.FieldSortName, item.FieldItemType, item.FieldMediaType, item.FieldLibraryID, item.FieldCollectionType, item.FieldParentID, item.FieldSeasonID, item.FieldSeriesID, item.FieldTmdbID, item.FieldImdbID, item.FieldTvdbID, item.FieldGenres, item.FieldTags, item.FieldOfficialRating:
+			values[i] = new(sql.NullString)
+		case item.FieldPremiereDate, item.FieldDateCreated, item.FieldIndexedAt:
+			values[i] = new(sql.NullTime)
+		case item.FieldID:
+			values[i] = new(uuid.UUID)
+		case item.ForeignKeys[0]: // backend_items
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Item fields.
+func (_m *Item) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case item.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case item.FieldBackendItemID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field backend_item_id", values[i])
+			} else if value.Valid {
+				_m.BackendItemID = value.String
+			}
+		case item.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				_m.Name = value.String
+			}
+		case item.FieldSortName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field sort_name", values[i])
+			} else if value.Valid {
+				_m.SortName = value.String
+			}
+		case item.FieldItemType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field item_type", values[i])
+			} else if value.Valid {
+				_m.ItemType = value.String
+			}
+		case item.FieldMediaType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field media_type", values[i])
+			} else if value.Valid {
+				_m.MediaType = value.String
+			}
+		case item.FieldLibraryID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field library_id", values[i])
+			} else if value.Valid {
+				_m.LibraryID = value.String
+			}
+		case item.FieldCollectionType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field collection_type", values[i])
+			} else if value.Valid {
+				_m.CollectionType = value.String
+			}
+		case item.FieldParentID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field parent_id", values[i])
+			} else if value.Valid {
+				_m.ParentID = value.String
+			}
+		case item.FieldSeasonID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field season_id", values[i])
+			} else if value.Valid {
+				_m.SeasonID = value.String
+			}
+		case item.FieldSeriesID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field series_id", values[i])
+			} else if value.Valid {
+				_m.SeriesID = value.String
+			}
+		case item.FieldTmdbID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field tmdb_id", values[i])
+			} else if value.Valid {
+				_m.TmdbID = value.String
+			}
+		case item.FieldImdbID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field imdb_id", values[i])
+			} else if value.Valid {
+				_m.ImdbID = value.String
+			}
+		case item.FieldTvdbID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field tvdb_id", values[i])
+			} else if value.Valid {
+				_m.TvdbID = value.String
+			}
+		case item.FieldGenres:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field genres", values[i])
+			} else if value.Valid {
+				_m.Genres = value.String
+			}
+		case item.FieldTags:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field tags", values[i])
+			} else if value.Valid {
+				_m.Tags = value.String
+			}
+		case item.FieldOfficialRating:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field official_rating", values[i])
+			} else if value.Valid {
+				_m.OfficialRating = value.String
+			}
+		case item.FieldProductionYear:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field production_year", values[i])
+			} else if value.Valid {
+				_m.ProductionYear = int(value.Int64)
+			}
+		case item.FieldCommunityRating:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field community_rating", values[i])
+			} else if value.Valid {
+				_m.CommunityRating = value.Float64
+			}
+		case item.FieldPremiereDate:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field premiere_date", values[i])
+			} else if value.Valid {
+				_m.PremiereDate = new(time.Time)
+				*_m.PremiereDate = value.Time
+			}
+		case item.FieldDateCreated:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field date_created", values[i])
+			} else if value.Valid {
+				_m.DateCreated = new(time.Time)
+				*_m.DateCreated = value.Time
+			}
+		case item.FieldData:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field data", values[i])
+			} else if value != nil {
+				_m.Data = *value
+			}
+		case item.FieldIndexedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field indexed_at", values[i])
+			} else if value.Valid {
+				_m.IndexedAt = value.Time
+			}
+		case item.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field backend_items", values[i])
+			} else if value.Valid {
+				_m.backend_items = new(uuid.UUID)
+				*_m.backend_items = *value.S.(*uuid.UUID)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Item.
+// This includes values selected through modifiers, order, etc.
+func (_m *Item) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryBackend queries the "backend" edge of the Item entity.
+func (_m *Item) QueryBackend() *BackendQuery {
+	return NewItemClient(_m.config).QueryBackend(_m)
+}
+
+// Update returns a builder for updating this Item.
+// Note that you need to call Item.Unwrap() before calling this method if this Item
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *Item) Update() *ItemUpdateOne {
+	return NewItemClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the Item entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *Item) Unwrap() *Item {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Item is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *Item) String() string {
+	var builder strings.Builder
+	builder.WriteString("Item(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("backend_item_id=")
+	builder.WriteString(_m.BackendItemID)
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(_m.Name)
+	builder.WriteString(", ")
+	builder.WriteString("sort_name=")
+	builder.WriteString(_m.SortName)
+	builder.WriteString(", ")
+	builder.WriteString("item_type=")
+	builder.WriteString(_m.ItemType)
+	builder.WriteString(", ")
+	builder.WriteString("media_type=")
+	builder.WriteString(_m.MediaType)
+	builder.WriteString(", ")
+	builder.WriteString("library_id=")
+	builder.WriteString(_m.LibraryID)
+	builder.WriteString(", ")
+	builder.WriteString("collection_type=")
+	builder.WriteString(_m.CollectionType)
+	builder.WriteString(", ")
+	builder.WriteString("parent_id=")
+	builder.WriteString(_m.ParentID)
+	builder.WriteString(", ")
+	builder.WriteString("season_id=")
+	builder.WriteString(_m.SeasonID)
+	builder.WriteString(", ")
+	builder.WriteString("series_id=")
+	builder.WriteString(_m.SeriesID)
+	builder.WriteString(", ")
+	builder.WriteString("tmdb_id=")
+	builder.WriteString(_m.TmdbID)
+	builder.WriteString(", ")
+	builder.WriteString("imdb_id=")
+	builder.WriteString(_m.ImdbID)
+	builder.WriteString(", ")
+	builder.WriteString("tvdb_id=")
+	builder.WriteString(_m.TvdbID)
+	builder.WriteString(", ")
+	builder.WriteString("genres=")
+	builder.WriteString(_m.Genres)
+	builder.WriteString(", ")
+	builder.WriteString("tags=")
+	builder.WriteString(_m.Tags)
+	builder.WriteString(", ")
+	builder.WriteString("official_rating=")
+	builder.WriteString(_m.OfficialRating)
+	builder.WriteString(", ")
+	builder.WriteString("production_year=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ProductionYear))
+	builder.WriteString(", ")
+	builder.WriteString("community_rating=")
+	builder.WriteString(fmt.Sprintf("%v", _m.CommunityRating))
+	builder.WriteString(", ")
+	if v := _m.PremiereDate; v != nil {
+		builder.WriteString("premiere_date=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.DateCreated; v != nil {
+		builder.WriteString("date_created=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("data=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Data))
+	builder.WriteString(", ")
+	builder.WriteString("indexed_at=")
+	builder.WriteString(_m.IndexedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Items is a parsable slice of Item.
+type Items []*Item
@@ -0,0 +1,259 @@
+// Code generated by ent, DO NOT EDIT.
+
+package item
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the item type in the database.
+	Label = "item"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldBackendItemID holds the string denoting the backend_item_id field in the database.
+	FieldBackendItemID = "backend_item_id"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// FieldSortName holds the string denoting the sort_name field in the database.
+	FieldSortName = "sort_name"
+	// FieldItemType holds the string denoting the item_type field in the database.
+	FieldItemType = "item_type"
+	// FieldMediaType holds the string denoting the media_type field in the database.
+	FieldMediaType = "media_type"
+	// FieldLibraryID holds the string denoting the library_id field in the database.
+	FieldLibraryID = "library_id"
+	// FieldCollectionType holds the string denoting the collection_type field in the database.
+	FieldCollectionType = "collection_type"
+	// FieldParentID holds the string denoting the parent_id field in the database.
+	FieldParentID = "parent_id"
+	// FieldSeasonID holds the string denoting the season_id field in the database.
+	FieldSeasonID = "season_id"
+	// FieldSeriesID holds the string denoting the series_id field in the database.
+	FieldSeriesID = "series_id"
+	// FieldTmdbID holds the string denoting the tmdb_id field in the database.
+	FieldTmdbID = "tmdb_id"
+	// FieldImdbID holds the string denoting the imdb_id field in the database.
+	FieldImdbID = "imdb_id"
+	// FieldTvdbID holds the string denoting the tvdb_id field in the database.
+	FieldTvdbID = "tvdb_id"
+	// FieldGenres holds the string denoting the genres field in the database.
+	FieldGenres = "genres"
+	// FieldTags holds the string denoting the tags field in the database.
+	FieldTags = "tags"
+	// FieldOfficialRating holds the string denoting the official_rating field in the database.
+	FieldOfficialRating = "official_rating"
+	// FieldProductionYear holds the string denoting the production_year field in the database.
+	FieldProductionYear = "production_year"
+	// FieldCommunityRating holds the string denoting the community_rating field in the database.
+	FieldCommunityRating = "community_rating"
+	// FieldPremiereDate holds the string denoting the premiere_date field in the database.
+	FieldPremiereDate = "premiere_date"
+	// FieldDateCreated holds the string denoting the date_created field in the database.
+	FieldDateCreated = "date_created"
+	// FieldData holds the string denoting the data field in the database.
+	FieldData = "data"
+	// FieldIndexedAt holds the string denoting the indexed_at field in the database.
+	FieldIndexedAt = "indexed_at"
+	// EdgeBackend holds the string denoting the backend edge name in mutations.
+	EdgeBackend = "backend"
+	// Table holds the table name of the item in the database.
+	Table = "items"
+	// BackendTable is the table that holds the backend relation/edge.
+	BackendTable = "items"
+	// BackendInverseTable is the table name for the Backend entity.
+	// It exists in this package in order to avoid circular dependency with the "backend" package.
+	BackendInverseTable = "backends"
+	// BackendColumn is the table column denoting the backend relation/edge.
+	BackendColumn = "backend_items"
+)
+
+// Columns holds all SQL columns for item fields.
+var Columns = []string{
+	FieldID,
+	FieldBackendItemID,
+	FieldName,
+	FieldSortName,
+	FieldItemType,
+	FieldMediaType,
+	FieldLibraryID,
+	FieldCollectionType,
+	FieldParentID,
+	FieldSeasonID,
+	FieldSeriesID,
+	FieldTmdbID,
+	FieldImdbID,
+	FieldTvdbID,
+	FieldGenres,
+	FieldTags,
+	FieldOfficialRating,
+	FieldProductionYear,
+	FieldCommunityRating,
+	FieldPremiereDate,
+	FieldDateCreated,
+	FieldData,
+	FieldIndexedAt,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the "items"
+// table and are not defined as standalone fields in the schema.
+var ForeignKeys = []string{
+	"backend_items",
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// BackendItemIDValidator is a validator for the "backend_item_id" field. It is called by the builders before save.
+	BackendItemIDValidator func(string) error
+	// ItemTypeValidator is a validator for the "item_type" field. It is called by the builders before save.
+	ItemTypeValidator func(string) error
+	// DefaultIndexedAt holds the default value on creation for the "indexed_at" field.
+	DefaultIndexedAt func() time.Time
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// OrderOption defines the ordering options for the Item queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByBackendItemID orders the results by the backend_item_id field.
+func ByBackendItemID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBackendItemID, opts...).ToFunc()
+}
+
+// ByName orders the results by the name field.
+func ByName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldName, opts...).ToFunc()
+}
+
+// BySortName orders the results by the sort_name field.
+func BySortName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSortName, opts...).ToFunc()
+}
+
+// ByItemType orders the results by the item_type field.
+func ByItemType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldItemType, opts...).ToFunc()
+}
+
+// ByMediaType orders the results by the media_type field.
+func ByMediaType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMediaType, opts...).ToFunc()
+}
+
+// ByLibraryID orders the results by the library_id field.
+func ByLibraryID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLibraryID, opts...).ToFunc()
+}
+
+// ByCollectionType orders the results by the collection_type field.
+func ByCollectionType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCollectionType, opts...).ToFunc()
+}
+
+// ByParentID orders the results by the parent_id field.
+func ByParentID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldParentID, opts...).ToFunc()
+}
+
+// BySeasonID orders the results by the season_id field.
+func BySeasonID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSeasonID, opts...).ToFunc()
+}
+
+// BySeriesID orders the results by the series_id field.
+func BySeriesID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSeriesID, opts...).ToFunc()
+}
+
+// ByTmdbID orders the results by the tmdb_id field.
+func ByTmdbID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTmdbID, opts...).ToFunc()
+}
+
+// ByImdbID orders the results by the imdb_id field.
+func ByImdbID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldImdbID, opts...).ToFunc()
+}
+
+// ByTvdbID orders the results by the tvdb_id field.
+func ByTvdbID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTvdbID, opts...).ToFunc()
+}
+
+// ByGenres orders the results by the genres field.
+func ByGenres(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldGenres, opts...).ToFunc()
+}
+
+// ByTags orders the results by the tags field.
+func ByTags(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTags, opts...).ToFunc()
+}
+
+// ByOfficialRating orders the results by the official_rating field.
+func ByOfficialRating(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOfficialRating, opts...).ToFunc()
+}
+
+// ByProductionYear orders the results by the production_year field.
+func ByProductionYear(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldProductionYear, opts...).ToFunc()
+}
+
+// ByCommunityRating orders the results by the community_rating field.
+func ByCommunityRating(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCommunityRating, opts...).ToFunc()
+}
+
+// ByPremiereDate orders the results by the premiere_date field.
+func ByPremiereDate(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPremiereDate, opts...).ToFunc()
+}
+
+// ByDateCreated orders the results by the date_created field.
+func ByDateCreated(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDateCreated, opts...).ToFunc()
+}
+
+// ByIndexedAt orders the results by the indexed_at field.
+func ByIndexedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldIndexedAt, opts...).ToFunc()
+}
+
+// ByBackendField orders the results by backend field.
+func ByBackendField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newBackendStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newBackendStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(BackendInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, BackendTable, BackendColumn),
+	)
+}
@@ -0,0 +1,1645 @@
+// Code generated by ent, DO NOT EDIT.
+
+package item
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/ddevcap/jellyfin-proxy/ent/predicate"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.Item {
+	return predicate.Item(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.Item {
+	return predicate.Item(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.Item {
+	return predicate.Item(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.Item {
+	return predicate.Item(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.Item {
+	return predicate.Item(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.Item {
+	return predicate.Item(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.Item {
+	return predicate.Item(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.Item {
+	return predicate.Item(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.Item {
+	return predicate.Item(sql.FieldLTE(FieldID, id))
+}
+
+// BackendItemID applies equality check predicate on the "backend_item_id" field. It's identical to BackendItemIDEQ.
+func BackendItemID(v string) predicate.Item {
+	return predicate.Item(sql.FieldEQ(FieldBackendItemID, v))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.Item {
+	return predicate.Item(sql.FieldEQ(FieldName, v))
+}
+
+// SortName applies equality check predicate on the "sort_name" field. It's identical to SortNameEQ.
+func SortName(v string) predicate.Item {
+	return predicate.Item(sql.FieldEQ(FieldSortName, v))
+}
+
+// ItemType applies equality check predicate on the "item_type" field. It's identical to ItemTypeEQ.
+func ItemType(v string) predicate.Item {
+	return predicate.Item(sql.FieldEQ(FieldItemType, v))
+}
+
+// MediaType applies equality check predicate on the "media_type" field. It's identical to MediaTypeEQ.
+func MediaType(v string) predicate.Item {
+	return predicate.Item(sql.FieldEQ(FieldMediaType, v))
+}
+
+// LibraryID applies equality check predicate on the "library_id" field. It's identical to LibraryIDEQ.
+func LibraryID(v string) predicate.Item {
+	return predicate.Item(sql.FieldEQ(FieldLibraryID, v))
+}
+
+// CollectionType applies equality check predicate on the "collection_type" field. It's identical to CollectionTypeEQ.
+func CollectionType(v string) predicate.Item {
+	return predicate.Item(sql.FieldEQ(FieldCollectionType, v))
+}
+
+// ParentID applies equality check predicate on the "parent_id" field. It's identical to ParentIDEQ.
+func ParentID(v string) predicate.Item {
+	return predicate.Item(sql.FieldEQ(FieldParentID, v))
+}
+
+// SeasonID applies equality check predicate on the "season_id" field. It's identical to SeasonIDEQ.
+func SeasonID(v string) predicate.Item {
+	return predicate.Item(sql.FieldEQ(FieldSeasonID, v))
+}
+
+// SeriesID applies equality check predicate on the "series_id" field. It's identical to SeriesIDEQ.
+func SeriesID(v string) predicate.Item {
+	return predicate.Item(sql.FieldEQ(FieldSeriesID, v))
+}
+
+// TmdbID applies equality check predicate on the "tmdb_id" field. It's identical to TmdbIDEQ.
+func TmdbID(v string) predicate.Item {
+	return predicate.Item(sql.FieldEQ(FieldTmdbID, v))
+}
+
+// ImdbID applies equality check predicate on the "imdb_id" field. It's identical to ImdbIDEQ.
+func ImdbID(v string) predicate.Item {
+	return predicate.Item(sql.FieldEQ(FieldImdbID, v))
+}
+
+// TvdbID applies equality check predicate on the "tvdb_id" field. It's identical to TvdbIDEQ.
+func TvdbID(v string) predicate.Item {
+	return predicate.Item(sql.FieldEQ(FieldTvdbID, v))
+}
+
+// Genres applies equality check predicate on the "genres" field. It's identical to GenresEQ.
+func Genres(v string) predicate.Item {
+	return predicate.Item(sql.FieldEQ(FieldGenres, v))
+}
+
+// Tags applies equality check predicate on the "tags" field. It's identical to TagsEQ.
+func Tags(v string) predicate.Item {
+	return predicate.Item(sql.FieldEQ(FieldTags, v))
+}
+
+// OfficialRating applies equality check predicate on the "official_rating" field. It's identical to OfficialRatingEQ.
+func OfficialRating(v string) predicate.Item {
+	return predicate.Item(sql.FieldEQ(FieldOfficialRating, v))
+}
+
+// ProductionYear applies equality check predicate on the "production_year" field. It's identical to ProductionYearEQ.
+func ProductionYear(v int) predicate.Item {
+	return predicate.Item(sql.FieldEQ(FieldProductionYear, v))
+}
+
+// CommunityRating applies equality check predicate on the "community_rating" field. It's identical to CommunityRatingEQ.
+func CommunityRating(v float64) predicate.Item {
+	return predicate.Item(sql.FieldEQ(FieldCommunityRating, v))
+}
+
+// PremiereDate applies equality check predicate on the "premiere_date" field. It's identical to PremiereDateEQ.
+func PremiereDate(v time.Time) predicate.Item {
+	return predicate.Item(sql.FieldEQ(FieldPremiereDate, v))
+}
+
+// DateCreated applies equality check predicate on the "date_created" field. It's identical to DateCreatedEQ.
+func DateCreated(v time.Time) predicate.Item {
+	return predicate.Item(sql.FieldEQ(FieldDateCreated, v))
+}
+
+// Data applies equality check predicate on the "data" field. It's identical to DataEQ.
+func Data(v []byte) predicate.Item {
+	return predicate.Item(sql.FieldEQ(FieldData, v))
+}
+
+// IndexedAt applies equality check predicate on the "indexed_at" field. It's identical to IndexedAtEQ.
+func IndexedAt(v time.Time) predicate.Item {
+	return predicate.Item(sql.FieldEQ(FieldIndexedAt, v))
+}
+
+// BackendItemIDEQ applies the EQ predicate on the "backend_item_id" field.
+func BackendItemIDEQ(v string) predicate.Item {
+	return predicate.Item(sql.FieldEQ(FieldBackendItemID, v))
+}
+
+// BackendItemIDNEQ applies the NEQ predicate on the "backend_item_id" field.
+func BackendItemIDNEQ(v string) predicate.Item {
+	return predicate.Item(sql.FieldNEQ(FieldBackendItemID, v))
+}
+
+// BackendItemIDIn applies the In predicate on the "backend_item_id" field.
+func BackendItemIDIn(vs ...string) predicate.Item {
+	return predicate.Item(sql.FieldIn(FieldBackendItemID, vs...))
+}
+
+// BackendItemIDNotIn applies the NotIn predicate on the "backend_item_id" field.
+func BackendItemIDNotIn(vs ...string) predicate.Item {
+	return predicate.Item(sql.FieldNotIn(FieldBackendItemID, vs...))
+}
+
+// BackendItemIDGT applies the GT predicate on the "backend_item_id" field.
+func BackendItemIDGT(v string) predicate.Item {
+	return predicate.Item(sql.FieldGT(FieldBackendItemID, v))
+}
+
+// BackendItemIDGTE applies the GTE predicate on the "backend_item_id" field.
+func BackendItemIDGTE(v string) predicate.Item {
+	return predicate.Item(sql.FieldGTE(FieldBackendItemID, v))
+}
+
+// BackendItemIDLT applies the LT predicate on the "backend_item_id" field.
+func BackendItemIDLT(v string) predicate.Item {
+	return predicate.Item(sql.FieldLT(FieldBackendItemID, v))
+}
+
+// BackendItemIDLTE applies the LTE predicate on the "backend_item_id" field.
+func BackendItemIDLTE(v string) predicate.Item {
+	return predicate.Item(sql.FieldLTE(FieldBackendItemID, v))
+}
+
+// BackendItemIDContains applies the Contains predicate on the "backend_item_id" field.
+func BackendItemIDContains(v string) predicate.Item {
+	return predicate.Item(sql.FieldContains(FieldBackendItemID, v))
+}
+
+// BackendItemIDHasPrefix applies the HasPrefix predicate on the "backend_item_id" field.
+func BackendItemIDHasPrefix(v string) predicate.Item {
+	return predicate.Item(sql.FieldHasPrefix(FieldBackendItemID, v))
+}
+
+// BackendItemIDHasSuffix applies the HasSuffix predicate on the "backend_item_id" field.
+func BackendItemIDHasSuffix(v string) predicate.Item {
+	return predicate.Item(sql.FieldHasSuffix(FieldBackendItemID, v))
+}
+
+// BackendItemIDEqualFold applies the EqualFold predicate on the "backend_item_id" field.
+func BackendItemIDEqualFold(v string) predicate.Item {
+	return predicate.Item(sql.FieldEqualFold(FieldBackendItemID, v))
+}
+
+// BackendItemIDContainsFold applies the ContainsFold predicate on the "backend_item_id" field.
+func BackendItemIDContainsFold(v string) predicate.Item {
+	return predicate.Item(sql.FieldContainsFold(FieldBackendItemID, v))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.Item {
+	return predicate.Item(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.Item {
+	return predicate.Item(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.Item {
+	return predicate.Item(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.Item {
+	return predicate.Item(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.Item {
+	return predicate.Item(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.Item {
+	return predicate.Item(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.Item {
+	return predicate.Item(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.Item {
+	return predicate.Item(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.Item {
+	return predicate.Item(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.Item {
+	return predicate.Item(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.Item {
+	return predicate.Item(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.Item {
+	return predicate.Item(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.Item {
+	return predicate.Item(sql.FieldContainsFold(FieldName, v))
+}
+
+// SortNameEQ applies the EQ predicate on the "sort_name" field.
+func SortNameEQ(v string) predicate.Item {
+	return predicate.Item(sql.FieldEQ(FieldSortName, v))
+}
+
+// SortNameNEQ applies the NEQ predicate on the "sort_name" field.
+func SortNameNEQ(v string) predicate.Item {
+	return predicate.Item(sql.FieldNEQ(FieldSortName, v))
+}
+
+// SortNameIn applies the In predicate on the "sort_name" field.
+func SortNameIn(vs ...string) predicate.Item {
+	return predicate.Item(sql.FieldIn(FieldSortName, vs...))
+}
+
+// SortNameNotIn applies the NotIn predicate on the "sort_name" field.
+func SortNameNotIn(vs ...string) predicate.Item {
+	return predicate.Item(sql.FieldNotIn(FieldSortName, vs...))
+}
+
+// SortNameGT applies the GT predicate on the "sort_name" field.
+func SortNameGT(v string) predicate.Item {
+	return predicate.Item(sql.FieldGT(FieldSortName, v))
+}
+
+// SortNameGTE applies the GTE predicate on the "sort_name" field.
+func SortNameGTE(v string) predicate.Item {
+	return predicate.Item(sql.FieldGTE(FieldSortName, v))
+}
+
+// SortNameLT applies the LT predicate on the "sort_name" field.
+func SortNameLT(v string) predicate.Item {
+	return predicate.Item(sql.FieldLT(FieldSortName, v))
+}
+
+// SortNameLTE applies the LTE predicate on the "sort_name" field.
+func SortNameLTE(v string) predicate.Item {
+	return predicate.Item(sql.FieldLTE(FieldSortName, v))
+}
+
+// SortNameContains applies the Contains predicate on the "sort_name" field.
+func SortNameContains(v string) predicate.Item {
+	return predicate.Item(sql.FieldContains(FieldSortName, v))
+}
+
+// SortNameHasPrefix applies the HasPrefix predicate on the "sort_name" field.
+func SortNameHasPrefix(v string) predicate.Item {
+	return predicate.Item(sql.FieldHasPrefix(FieldSortName, v))
+}
+
+// SortNameHasSuffix applies the HasSuffix predicate on the "sort_name" field.
+func SortNameHasSuffix(v string) predicate.Item {
+	return predicate.Item(sql.FieldHasSuffix(FieldSortName, v))
+}
+
+// SortNameEqualFold applies the EqualFold predicate on the "sort_name" field.
+func SortNameEqualFold(v string) predicate.Item {
+	return predicate.Item(sql.FieldEqualFold(FieldSortName, v))
+}
+
+// SortNameContainsFold applies the ContainsFold predicate on the "sort_name" field.
+func SortNameContainsFold(v string) predicate.Item {
+	return predicate.Item(sql.FieldContainsFold(FieldSortName, v))
+}
+
+// ItemTypeEQ applies the EQ predicate on the "item_type" field.
+func ItemTypeEQ(v string) predicate.Item {
+	return predicate.Item(sql.FieldEQ(FieldItemType, v))
+}
+
+// ItemTypeNEQ applies the NEQ predicate on the "item_type" field.
+func ItemTypeNEQ(v string) predicate.Item {
+	return predicate.Item(sql.FieldNEQ(FieldItemType, v))
+}
+
+// ItemTypeIn applies the In predicate on the "item_type" field.
+func ItemTypeIn(vs ...string) predicate.Item {
+	return predicate.Item(sql.FieldIn(FieldItemType, vs...))
+}
+
+// ItemTypeNotIn applies the NotIn predicate on the "item_type" field.
+func ItemTypeNotIn(vs ...string) predicate.Item {
+	return predicate.Item(sql.FieldNotIn(FieldItemType, vs...))
+}
+
+// ItemTypeGT applies the GT predicate on the "item_type" field.
+func ItemTypeGT(v string) predicate.Item {
+	return predicate.Item(sql.FieldGT(FieldItemType, v))
+}
+
+// ItemTypeGTE applies the GTE predicate on the "item_type" field.
+func ItemTypeGTE(v string) predicate.Item {
+	return predicate.Item(sql.FieldGTE(FieldItemType, v))
+}
+
+// ItemTypeLT applies the LT predicate on the "item_type" field.
+func ItemTypeLT(v string) predicate.Item {
+	return predicate.Item(sql.FieldLT(FieldItemType, v))
+}
+
+// ItemTypeLTE applies the LTE predicate on the "item_type" field.
+func ItemTypeLTE(v string) predicate.Item {
+	return predicate.Item(sql.FieldLTE(FieldItemType, v))
+}
+
+// ItemTypeContains applies the Contains predicate on the "item_type" field.
+func ItemTypeContains(v string) predicate.Item {
+	return predicate.Item(sql.FieldContains(FieldItemType, v))
+}
+
+// ItemTypeHasPrefix applies the HasPrefix predicate on the "item_type" field.
+func ItemTypeHasPrefix(v string) predicate.Item {
+	return predicate.Item(sql.FieldHasPrefix(FieldItemType, v))
+}
+
+// ItemTypeHasSuffix applies the HasSuffix predicate on the "item_type" field.
+func ItemTypeHasSuffix(v string) predicate.Item {
+	return predicate.Item(sql.FieldHasSuffix(FieldItemType, v))
+}
+
+// ItemTypeEqualFold applies the EqualFold predicate on the "item_type" field.
+func ItemTypeEqualFold(v string) predicate.Item {
+	return predicate.Item(sql.FieldEqualFold(FieldItemType, v))
+}
+
+// ItemTypeContainsFold applies the ContainsFold predicate on the "item_type" field.
+func ItemTypeContainsFold(v string) predicate.Item {
+	return predicate.Item(sql.FieldContainsFold(FieldItemType, v))
+}
+
+// MediaTypeEQ applies the EQ predicate on the "media_type" field.
+func MediaTypeEQ(v string) predicate.Item {
+	return predicate.Item(sql.FieldEQ(FieldMediaType, v))
+}
+
+// MediaTypeNEQ applies the NEQ predicate on the "media_type" field.
+func MediaTypeNEQ(v string) predicate.Item {
+	return predicate.Item(sql.FieldNEQ(FieldMediaType, v))
+}
+
+// MediaTypeIn applies the In predicate on the "media_type" field.
+func MediaTypeIn(vs ...string) predicate.Item {
+	return predicate.Item(sql.FieldIn(FieldMediaType, vs...))
+}
+
+// MediaTypeNotIn applies the NotIn predicate on the "media_type" field.
+func MediaTypeNotIn(vs ...string) predicate.Item {
+	return predicate.Item(sql.FieldNotIn(FieldMediaType, vs...))
+}
+
+// MediaTypeGT applies the GT predicate on the "media_type" field.
+func MediaTypeGT(v string) predicate.Item {
+	return predicate.Item(sql.FieldGT(FieldMediaType, v))
+}
+
+// MediaTypeGTE applies the GTE predicate on the "media_type" field.
+func MediaTypeGTE(v string) predicate.Item {
+	return predicate.Item(sql.FieldGTE(FieldMediaType, v))
+}
+
+// MediaTypeLT applies the LT predicate on the "media_type" field.
+func MediaTypeLT(v string) predicate.Item {
+	return predicate.Item(sql.FieldLT(FieldMediaType, v))
+}
+
+// MediaTypeLTE applies the LTE predicate on the "media_type" field.
+func MediaTypeLTE(v string) predicate.Item {
+	return predicate.Item(sql.FieldLTE(FieldMediaType, v))
+}
+
+// MediaTypeContains applies the Contains predicate on the "media_type" field.
+func MediaTypeContains(v string) predicate.Item {
+	return predicate.Item(sql.FieldContains(FieldMediaType, v))
+}
+
+// MediaTypeHasPrefix applies the HasPrefix predicate on the "media_type" field.
+func MediaTypeHasPrefix(v string) predicate.Item {
+	return predicate.Item(sql.FieldHasPrefix(FieldMediaType, v))
+}
+
+// MediaTypeHasSuffix applies the HasSuffix predicate on the "media_type" field.
+func MediaTypeHasSuffix(v string) predicate.Item {
+	return predicate.Item(sql.FieldHasSuffix(FieldMediaType, v))
+}
+
+// MediaTypeIsNil applies the IsNil predicate on the "media_type" field.
+func MediaTypeIsNil() predicate.Item {
+	return predicate.Item(sql.FieldIsNull(FieldMediaType))
+}
+
+// MediaTypeNotNil applies the NotNil predicate on the "media_type" field.
+func MediaTypeNotNil() predicate.Item {
+	return predicate.Item(sql.FieldNotNull(FieldMediaType))
+}
+
+// MediaTypeEqualFold applies the EqualFold predicate on the "media_type" field.
+func MediaTypeEqualFold(v string) predicate.Item {
+	return predicate.Item(sql.FieldEqualFold(FieldMediaType, v))
+}
+
+// MediaTypeContainsFold applies the ContainsFold predicate on the "media_type" field.
+func MediaTypeContainsFold(v string) predicate.Item {
+	return predicate.Item(sql.FieldContainsFold(FieldMediaType, v))
+}
+
+// LibraryIDEQ applies the EQ predicate on the "library_id" field.
+func LibraryIDEQ(v string) predicate.Item {
+	return predicate.Item(sql.FieldEQ(FieldLibraryID, v))
+}
+
+// LibraryIDNEQ applies the NEQ predicate on the "library_id" field.
+func LibraryIDNEQ(v string) predicate.Item {
+	return predicate.Item(sql.FieldNEQ(FieldLibraryID, v))
+}
+
+// LibraryIDIn applies the In predicate on the "library_id" field.
+func LibraryIDIn(vs ...string) predicate.Item {
+	return predicate.Item(sql.FieldIn(FieldLibraryID, vs...))
+}
+
+// LibraryIDNotIn applies the NotIn predicate on the "library_id" field.
+func LibraryIDNotIn(vs ...string) predicate.Item {
+	return predicate.Item(sql.FieldNotIn(FieldLibraryID, vs...))
+}
+
+// LibraryIDGT applies the GT predicate on the "library_id" field.
+func LibraryIDGT(v string) predicate.Item {
+	return predicate.Item(sql.FieldGT(FieldLibraryID, v))
+}
+
+// LibraryIDGTE applies the GTE predicate on the "library_id" field.
+func LibraryIDGTE(v string) predicate.Item {
+	return predicate.Item(sql.FieldGTE(FieldLibraryID, v))
+}
+
+// LibraryIDLT applies the LT predicate on the "library_id" field.
+func LibraryIDLT(v string) predicate.Item {
+	return predicate.Item(sql.FieldLT(FieldLibraryID, v))
+}
+
+// LibraryIDLTE applies the LTE predicate on the "library_id" field.
+func LibraryIDLTE(v string) predicate.Item {
+	return predicate.Item(sql.FieldLTE(FieldLibraryID, v))
+}
+
+// LibraryIDContains applies the Contains predicate on the "library_id" field.
+func LibraryIDContains(v string) predicate.Item {
+	return predicate.Item(sql.FieldContains(FieldLibraryID, v))
+}
+
+// LibraryIDHasPrefix applies the HasPrefix predicate on the "library_id" field.
+func LibraryIDHasPrefix(v string) predicate.Item {
+	return predicate.Item(sql.FieldHasPrefix(FieldLibraryID, v))
+}
+
+// LibraryIDHasSuffix applies the HasSuffix predicate on the "library_id" field.
+func LibraryIDHasSuffix(v string) predicate.Item {
+	return predicate.Item(sql.FieldHasSuffix(FieldLibraryID, v))
+}
+
+// LibraryIDIsNil applies the IsNil predicate on the "library_id" field.
+func LibraryIDIsNil() predicate.Item {
+	return predicate.Item(sql.FieldIsNull(FieldLibraryID))
+}
+
+// LibraryIDNotNil applies the NotNil predicate on the "library_id" field.
+func LibraryIDNotNil() predicate.Item {
+	return predicate.Item(sql.FieldNotNull(FieldLibraryID))
+}
+
+// LibraryIDEqualFold applies the EqualFold predicate on the "library_id" field.
+func LibraryIDEqualFold(v string) predicate.Item {
+	return predicate.Item(sql.FieldEqualFold(FieldLibraryID, v))
+}
+
+// LibraryIDContainsFold applies the ContainsFold predicate on the "library_id" field.
+func LibraryIDContainsFold(v string) predicate.Item {
+	return predicate.Item(sql.FieldContainsFold(FieldLibraryID, v))
+}
+
+// CollectionTypeEQ applies the EQ predicate on the "collection_type" field.
+func CollectionTypeEQ(v string) predicate.Item {
+	return predicate.Item(sql.FieldEQ(FieldCollectionType, v))
+}
+
+// CollectionTypeNEQ applies the NEQ predicate on the "collection_type" field.
+func CollectionTypeNEQ(v string) predicate.Item {
+	return predicate.Item(sql.FieldNEQ(FieldCollectionType, v))
+}
+
+// CollectionTypeIn applies the In predicate on the "collection_type" field.
+func CollectionTypeIn(vs ...string) predicate.Item {
+	return predicate.Item(sql.FieldIn(FieldCollectionType, vs...))
+}
+
+// CollectionTypeNotIn applies the NotIn predicate on the "collection_type" field.
+func CollectionTypeNotIn(vs ...string) predicate.Item {
+	return predicate.Item(sql.FieldNotIn(FieldCollectionType, vs...))
+}
+
+// CollectionTypeGT applies the GT predicate on the "collection_type" field.
+func CollectionTypeGT(v string) predicate.Item {
+	return predicate.Item(sql.FieldGT(FieldCollectionType, v))
+}
+
+// CollectionTypeGTE applies the GTE predicate on the "collection_type" field.
+func CollectionTypeGTE(v string) predicate.Item {
+	return predicate.Item(sql.FieldGTE(FieldCollectionType, v))
+}
+
+// CollectionTypeLT applies the LT predicate on the "collection_type" field.
+func CollectionTypeLT(v string) predicate.Item {
+	return predicate.Item(sql.FieldLT(FieldCollectionType, v))
+}
+
+// CollectionTypeLTE applies the LTE predicate on the "collection_type" field.
+func CollectionTypeLTE(v string) predicate.Item {
+	return predicate.Item(sql.FieldLTE(FieldCollectionType, v))
+}
+
+// CollectionTypeContains applies the Contains predicate on the "collection_type" field.
+func CollectionTypeContains(v string) predicate.Item {
+	return predicate.Item(sql.FieldContains(FieldCollectionType, v))
+}
+
+// CollectionTypeHasPrefix applies the HasPrefix predicate on the "collection_type" field.
+func CollectionTypeHasPrefix(v string) predicate.Item {
+	return predicate.Item(sql.FieldHasPrefix(FieldCollectionType, v))
+}
+
+// CollectionTypeHasSuffix applies the HasSuffix predicate on the "collection_type" field.
+func CollectionTypeHasSuffix(v string) predicate.Item {
+	return predicate.Item(sql.FieldHasSuffix(FieldCollectionType, v))
+}
+
+// CollectionTypeIsNil applies the IsNil predicate on the "collection_type" field.
+func CollectionTypeIsNil() predicate.Item {
+	return predicate.Item(sql.FieldIsNull(FieldCollectionType))
+}
+
+// CollectionTypeNotNil applies the NotNil predicate on the "collection_type" field.
+func CollectionTypeNotNil() predicate.Item {
+	return predicate.Item(sql.FieldNotNull(FieldCollectionType))
+}
+
+// CollectionTypeEqualFold applies the EqualFold predicate on the "collection_type" field.
+func CollectionTypeEqualFold(v string) predicate.Item {
+	return predicate.Item(sql.FieldEqualFold(FieldCollectionType, v))
+}
+
+// CollectionTypeContainsFold applies the ContainsFold predicate on the "collection_type" field.
+func CollectionTypeContainsFold(v string) predicate.Item {
+	return predicate.Item(sql.FieldContainsFold(FieldCollectionType, v))
+}
+
+// ParentIDEQ applies the EQ predicate on the "parent_id" field.
+func ParentIDEQ(v string) predicate.Item {
+	return predicate.Item(sql.FieldEQ(FieldParentID, v))
+}
+
+// ParentIDNEQ applies the NEQ predicate on the "parent_id" field.
+func ParentIDNEQ(v string) predicate.Item {
+	return predicate.Item(sql.FieldNEQ(FieldParentID, v))
+}
+
+// ParentIDIn applies the In predicate on the "parent_id" field.
+func ParentIDIn(vs ...string) predicate.Item {
+	return predicate.Item(sql.FieldIn(FieldParentID, vs...))
+}
+
+// ParentIDNotIn applies the NotIn predicate on the "parent_id" field.
+func ParentIDNotIn(vs ...string) predicate.Item {
+	return predicate.Item(sql.FieldNotIn(FieldParentID, vs...))
+}
+
+// ParentIDGT applies the GT predicate on the "parent_id" field.
+func ParentIDGT(v string) predicate.Item {
+	return predicate.Item(sql.FieldGT(FieldParentID, v))
+}
+
+// ParentIDGTE applies the GTE predicate on the "parent_id" field.
+func ParentIDGTE(v string) predicate.Item {
+	return predicate.Item(sql.FieldGTE(FieldParentID, v))
+}
+
+// ParentIDLT applies the LT predicate on the "parent_id" field.
+func ParentIDLT(v string) predicate.Item {
+	return predicate.Item(sql.FieldLT(FieldParentID, v))
+}
+
+// ParentIDLTE applies the LTE predicate on the "parent_id" field.
+func ParentIDLTE(v string) predicate.Item {
+	return predicate.Item(sql.FieldLTE(FieldParentID, v))
+}
+
+// ParentIDContains applies the Contains predicate on the "parent_id" field.
+func ParentIDContains(v string) predicate.Item {
+	return predicate.Item(sql.FieldContains(FieldParentID, v))
+}
+
+// ParentIDHasPrefix applies the HasPrefix predicate on the "parent_id" field.
+func ParentIDHasPrefix(v string) predicate.Item {
+	return predicate.Item(sql.FieldHasPrefix(FieldParentID, v))
+}
+
+// ParentIDHasSuffix applies the HasSuffix predicate on the "parent_id" field.
+func ParentIDHasSuffix(v string) predicate.Item {
+	return predicate.Item(sql.FieldHasSuffix(FieldParentID, v))
+}
+
+// ParentIDIsNil applies the IsNil predicate on the "parent_id" field.
+func ParentIDIsNil() predicate.Item {
+	return predicate.Item(sql.FieldIsNull(FieldParentID))
+}
+
+// ParentIDNotNil applies the NotNil predicate on the "parent_id" field.
+func ParentIDNotNil() predicate.Item {
+	return predicate.Item(sql.FieldNotNull(FieldParentID))
+}
+
+// ParentIDEqualFold applies the EqualFold predicate on the "parent_id" field.
+func ParentIDEqualFold(v string) predicate.Item {
+	return predicate.Item(sql.FieldEqualFold(FieldParentID, v))
+}
+
+// ParentIDContainsFold applies the ContainsFold predicate on the "parent_id" field.
+func ParentIDContainsFold(v string) predicate.Item {
+	return predicate.Item(sql.FieldContainsFold(FieldParentID, v))
+}
+
+// SeasonIDEQ applies the EQ predicate on the "season_id" field.
+func SeasonIDEQ(v string) predicate.Item {
+	return predicate.Item(sql.FieldEQ(FieldSeasonID, v))
+}
+
+// SeasonIDNEQ applies the NEQ predicate on the "season_id" field.
+func SeasonIDNEQ(v string) predicate.Item {
+	return predicate.Item(sql.FieldNEQ(FieldSeasonID, v))
+}
+
+// SeasonIDIn applies the In predicate on the "season_id" field.
+func SeasonIDIn(vs ...string) predicate.Item {
+	return predicate.Item(sql.FieldIn(FieldSeasonID, vs...))
+}
+
+// SeasonIDNotIn applies the NotIn predicate on the "season_id" field.
+func SeasonIDNotIn(vs ...string) predicate.Item {
+	return predicate.Item(sql.FieldNotIn(FieldSeasonID, vs...))
+}
+
+// SeasonIDGT applies the GT predicate on the "season_id" field.
+func SeasonIDGT(v string) predicate.Item {
+	return predicate.Item(sql.FieldGT(FieldSeasonID, v))
+}
+
+// SeasonIDGTE applies the GTE predicate on the "season_id" field.
+func SeasonIDGTE(v string) predicate.Item {
+	return predicate.Item(sql.FieldGTE(FieldSeasonID, v))
+}
+
+// SeasonIDLT applies the LT predicate on the "season_id" field.
+func SeasonIDLT(v string) predicate.Item {
+	return predicate.Item(sql.FieldLT(FieldSeasonID, v))
+}
+
+// SeasonIDLTE applies the LTE predicate on the "season_id" field.
+func SeasonIDLTE(v string) predicate.Item {
+	return predicate.Item(sql.FieldLTE(FieldSeasonID, v))
+}
+
+// SeasonIDContains applies the Contains predicate on the "season_id" field.
+func SeasonIDContains(v string) predicate.Item {
+	return predicate.Item(sql.FieldContains(FieldSeasonID, v))
+}
+
+// SeasonIDHasPrefix applies the HasPrefix predicate on the "season_id" field.
+func SeasonIDHasPrefix(v string) predicate.Item {
+	return predicate.Item(sql.FieldHasPrefix(FieldSeasonID, v))
+}
+
+// SeasonIDHasSuffix applies the HasSuffix predicate on the "season_id" field.
+func SeasonIDHasSuffix(v string) predicate.Item {
+	return predicate.Item(sql.FieldHasSuffix(FieldSeasonID, v))
+}
+
+// SeasonIDIsNil applies the IsNil predicate on the "season_id" field.
+func SeasonIDIsNil() predicate.Item {
+	return predicate.Item(sql.FieldIsNull(FieldSeasonID))
+}
+
+// SeasonIDNotNil applies the NotNil predicate on the "season_id" field.
+func SeasonIDNotNil() predicate.Item {
+	return predicate.Item(sql.FieldNotNull(FieldSeasonID))
+}
+
+// SeasonIDEqualFold applies the EqualFold predicate on the "season_id" field.
+func SeasonIDEqualFold(v string) predicate.Item {
+	return predicate.Item(sql.FieldEqualFold(FieldSeasonID, v))
+}
+
+// SeasonIDContainsFold applies the ContainsFold predicate on the "season_id" field.
+func SeasonIDContainsFold(v string) predicate.Item {
+	return predicate.Item(sql.FieldContainsFold(FieldSeasonID, v))
+}
+
+// SeriesIDEQ applies the EQ predicate on the "series_id" field.
+func SeriesIDEQ(v string) predicate.Item {
+	return predicate.Item(sql.FieldEQ(FieldSeriesID, v))
+}
+
+// SeriesIDNEQ applies the NEQ predicate on the "series_id" field.
+func SeriesIDNEQ(v string) predicate.Item {
+	return predicate.Item(sql.FieldNEQ(FieldSeriesID, v))
+}
+
+// SeriesIDIn applies the In predicate on the "series_id" field.
+func SeriesIDIn(vs ...string) predicate.Item {
+	return predicate.Item(sql.FieldIn(FieldSeriesID, vs...))
+}
+
+// SeriesIDNotIn applies the NotIn predicate on the "series_id" field.
+func SeriesIDNotIn(vs ...string) predicate.Item {
+	return predicate.Item(sql.FieldNotIn(FieldSeriesID, vs...))
+}
+
+// SeriesIDGT applies the GT predicate on the "series_id" field.
+func SeriesIDGT(v string) predicate.Item {
+	return predicate.Item(sql.FieldGT(FieldSeriesID, v))
+}
+
+// SeriesIDGTE applies the GTE predicate on the "series_id" field.
+func SeriesIDGTE(v string) predicate.Item {
+	return predicate.Item(sql.FieldGTE(FieldSeriesID, v))
+}
+
+// SeriesIDLT applies the LT predicate on the "series_id" field.
+func SeriesIDLT(v string) predicate.Item {
+	return predicate.Item(sql.FieldLT(FieldSeriesID, v))
+}
+
+// SeriesIDLTE applies the LTE predicate on the "series_id" field.
+func SeriesIDLTE(v string) predicate.Item {
+	return predicate.Item(sql.FieldLTE(FieldSeriesID, v))
+}
+
+// SeriesIDContains applies the Contains predicate on the "series_id" field.
+func SeriesIDContains(v string) predicate.Item {
+	return predicate.Item(sql.FieldContains(FieldSeriesID, v))
+}
+
+// SeriesIDHasPrefix applies the HasPrefix predicate on the "series_id" field.
+func SeriesIDHasPrefix(v string) predicate.Item {
+	return predicate.Item(sql.FieldHasPrefix(FieldSeriesID, v))
+}
+
+// SeriesIDHasSuffix applies the HasSuffix predicate on the "series_id" field.
+func SeriesIDHasSuffix(v string) predicate.Item {
+	return predicate.Item(sql.FieldHasSuffix(FieldSeriesID, v))
+}
+
+// SeriesIDIsNil applies the IsNil predicate on the "series_id" field.
+func SeriesIDIsNil() predicate.Item {
+	return predicate.Item(sql.FieldIsNull(FieldSeriesID))
+}
+
+// SeriesIDNotNil applies the NotNil predicate on the "series_id" field.
+func SeriesIDNotNil() predicate.Item {
+	return predicate.Item(sql.FieldNotNull(FieldSeriesID))
+}
+
+// SeriesIDEqualFold applies the EqualFold predicate on the "series_id" field.
+func SeriesIDEqualFold(v string) predicate.Item {
+	return predicate.Item(sql.FieldEqualFold(FieldSeriesID, v))
+}
+
+// SeriesIDContainsFold applies the ContainsFold predicate on the "series_id" field.
+func SeriesIDContainsFold(v string) predicate.Item {
+	return predicate.Item(sql.FieldContainsFold(FieldSeriesID, v))
+}
+
+// TmdbIDEQ applies the EQ predicate on the "tmdb_id" field.
+func TmdbIDEQ(v string) predicate.Item {
+	return predicate.Item(sql.FieldEQ(FieldTmdbID, v))
+}
+
+// TmdbIDNEQ applies the NEQ predicate on the "tmdb_id" field.
+func TmdbIDNEQ(v string) predicate.Item {
+	return predicate.Item(sql.FieldNEQ(FieldTmdbID, v))
+}
+
+// TmdbIDIn applies the In predicate on the "tmdb_id" field.
+func TmdbIDIn(vs ...string) predicate.Item {
+	return predicate.Item(sql.FieldIn(FieldTmdbID, vs...))
+}
+
+// TmdbIDNotIn applies the NotIn predicate on the "tmdb_id" field.
+func TmdbIDNotIn(vs ...string) predicate.Item {
+	return predicate.Item(sql.FieldNotIn(FieldTmdbID, vs...))
+}
+
+// TmdbIDGT applies the GT predicate on the "tmdb_id" field.
+func TmdbIDGT(v string) predicate.Item {
+	return predicate.Item(sql.FieldGT(FieldTmdbID, v))
+}
+
+// TmdbIDGTE applies the GTE predicate on the "tmdb_id" field.
+func TmdbIDGTE(v string) predicate.Item {
+	return predicate.Item(sql.FieldGTE(FieldTmdbID, v))
+}
+
+// TmdbIDLT applies the LT predicate on the "tmdb_id" field.
+func TmdbIDLT(v string) predicate.Item {
+	return predicate.Item(sql.FieldLT(FieldTmdbID, v))
+}
+
+// TmdbIDLTE applies the LTE predicate on the "tmdb_id" field.
+func TmdbIDLTE(v string) predicate.Item {
+	return predicate.Item(sql.FieldLTE(FieldTmdbID, v))
+}
+
+// TmdbIDContains applies the Contains predicate on the "tmdb_id" field.
+func TmdbIDContains(v string) predicate.Item {
+	return predicate.Item(sql.FieldContains(FieldTmdbID, v))
+}
+
+// TmdbIDHasPrefix applies the HasPrefix predicate on the "tmdb_id" field.
+func TmdbIDHasPrefix(v string) predicate.Item {
+	return predicate.Item(sql.FieldHasPrefix(FieldTmdbID, v))
+}
+
+// TmdbIDHasSuffix applies the HasSuffix predicate on the "tmdb_id" field.
+func TmdbIDHasSuffix(v string) predicate.Item {
+	return predicate.Item(sql.FieldHasSuffix(FieldTmdbID, v))
+}
+
+// TmdbIDIsNil applies the IsNil predicate on the "tmdb_id" field.
+func TmdbIDIsNil() predicate.Item {
+	return predicate.Item(sql.FieldIsNull(FieldTmdbID))
+}
+
+// TmdbIDNotNil applies the NotNil predicate on the "tmdb_id" field.
+func TmdbIDNotNil() predicate.Item {
+	return predicate.Item(sql.FieldNotNull(FieldTmdbID))
+}
+
+// TmdbIDEqualFold applies the EqualFold predicate on the "tmdb_id" field.
+func TmdbIDEqualFold(v string) predicate.Item {
+	return predicate.Item(sql.FieldEqualFold(FieldTmdbID, v))
+}
+
+// TmdbIDContainsFold applies the ContainsFold predicate on the "tmdb_id" field.
+func TmdbIDContainsFold(v string) predicate.Item {
+	return predicate.Item(sql.FieldContainsFold(FieldTmdbID, v))
+}
+
+// ImdbIDEQ applies the EQ predicate on the "imdb_id" field.
+func ImdbIDEQ(v string) predicate.Item {
+	return predicate.Item(sql.FieldEQ(FieldImdbID, v))
+}
+
+// ImdbIDNEQ applies the NEQ predicate on the "imdb_id" field.
+func ImdbIDNEQ(v string) predicate.Item {
+	return predicate.Item(sql.FieldNEQ(FieldImdbID, v))
+}
+
+// ImdbIDIn applies the In predicate on the "imdb_id" field.
+func ImdbIDIn(vs ...string) predicate.Item {
+	return predicate.Item(sql.FieldIn(FieldImdbID, vs...))
+}
+
+// ImdbIDNotIn applies the NotIn predicate on the "imdb_id" field.
+func ImdbIDNotIn(vs ...string) predicate.Item {
+	return predicate.Item(sql.FieldNotIn(FieldImdbID, vs...))
+}
+
+// ImdbIDGT applies the GT predicate on the "imdb_id" field.
+func ImdbIDGT(v string) predicate.Item {
+	return predicate.Item(sql.FieldGT(FieldImdbID, v))
+}
+
+// ImdbIDGTE applies the GTE predicate on the "imdb_id" field.
+func ImdbIDGTE(v string) predicate.Item {
+	return predicate.Item(sql.FieldGTE(FieldImdbID, v))
+}
+
+// ImdbIDLT applies the LT predicate on the "imdb_id" field.
+func ImdbIDLT(v string) predicate.Item {
+	return predicate.Item(sql.FieldLT(FieldImdbID, v))
+}
+
+// ImdbIDLTE applies the LTE predicate on the "imdb_id" field.
+func ImdbIDLTE(v string) predicate.Item {
+	return predicate.Item(sql.FieldLTE(FieldImdbID, v))
+}
+
+// ImdbIDContains applies the Contains predicate on the "imdb_id" field.
+func ImdbIDContains(v string) predicate.Item {
+	return predicate.Item(sql.FieldContains(FieldImdbID, v))
+}
+
+// ImdbIDHasPrefix applies the HasPrefix predicate on the "imdb_id" field.
+func ImdbIDHasPrefix(v string) predicate.Item {
+	return predicate.Item(sql.FieldHasPrefix(FieldImdbID, v))
+}
+
+// ImdbIDHasSuffix applies the HasSuffix predicate on the "imdb_id" field.
+func ImdbIDHasSuffix(v string) predicate.Item {
+	return predicate.Item(sql.FieldHasSuffix(FieldImdbID, v))
+}
+
+// ImdbIDIsNil applies the IsNil predicate on the "imdb_id" field.
+func ImdbIDIsNil() predicate.Item {
+	return predicate.Item(sql.FieldIsNull(FieldImdbID))
+}
+
+// ImdbIDNotNil applies the NotNil predicate on the "imdb_id" field.
+func ImdbIDNotNil() predicate.Item {
+	return predicate.Item(sql.FieldNotNull(FieldImdbID))
+}
+
+// ImdbIDEqualFold applies the EqualFold predicate on the "imdb_id" field.
+func ImdbIDEqualFold(v string) predicate.Item {
+	return predicate.Item(sql.FieldEqualFold(FieldImdbID, v))
+}
+
+// ImdbIDContainsFold applies the ContainsFold predicate on the "imdb_id" field.
+func ImdbIDContainsFold(v string) predicate.Item {
+	return predicate.Item(sql.FieldContainsFold(FieldImdbID, v))
+}
+
+// TvdbIDEQ applies the EQ predicate on the "tvdb_id" field.
+func TvdbIDEQ(v string) predicate.Item {
+	return predicate.Item(sql.FieldEQ(FieldTvdbID, v))
+}
+
+// TvdbIDNEQ applies the NEQ predicate on the "tvdb_id" field.
+func TvdbIDNEQ(v string) predicate.Item {
+	return predicate.Item(sql.FieldNEQ(FieldTvdbID, v))
+}
+
+// TvdbIDIn applies the In predicate on the "tvdb_id" field.
+func TvdbIDIn(vs ...string) predicate.Item {
+	return predicate.Item(sql.FieldIn(FieldTvdbID, vs...))
+}
+
+// TvdbIDNotIn applies the NotIn predicate on the "tvdb_id" field.
+func TvdbIDNotIn(vs ...string) predicate.Item {
+	return predicate.Item(sql.FieldNotIn(FieldTvdbID, vs...))
+}
+
+// TvdbIDGT applies the GT predicate on the "tvdb_id" field.
+func TvdbIDGT(v string) predicate.Item {
+	return predicate.Item(sql.FieldGT(FieldTvdbID, v))
+}
+
+// TvdbIDGTE applies the GTE predicate on the "tvdb_id" field.
+func TvdbIDGTE(v string) predicate.Item {
+	return predicate.Item(sql.FieldGTE(FieldTvdbID, v))
+}
+
+// TvdbIDLT applies the LT predicate on the "tvdb_id" field.
+func TvdbIDLT(v string) predicate.Item {
+	return predicate.Item(sql.FieldLT(FieldTvdbID, v))
+}
+
+// TvdbIDLTE applies the LTE predicate on the "tvdb_id" field.
+func TvdbIDLTE(v string) predicate.Item {
+	return predicate.Item(sql.FieldLTE(FieldTvdbID, v))
+}
+
+// TvdbIDContains applies the Contains predicate on the "tvdb_id" field.
+func TvdbIDContains(v string) predicate.Item {
+	return predicate.Item(sql.FieldContains(FieldTvdbID, v))
+}
+
+// TvdbIDHasPrefix applies the HasPrefix predicate on the "tvdb_id" field.
+func TvdbIDHasPrefix(v string) predicate.Item {
+	return predicate.Item(sql.FieldHasPrefix(FieldTvdbID, v))
+}
+
+// TvdbIDHasSuffix applies the HasSuffix predicate on the "tvdb_id" field.
+func TvdbIDHasSuffix(v string) predicate.Item {
+	return predicate.Item(sql.FieldHasSuffix(FieldTvdbID, v))
+}
+
+// TvdbIDIsNil applies the IsNil predicate on the "tvdb_id" field.
+func TvdbIDIsNil() predicate.Item {
+	return predicate.Item(sql.FieldIsNull(FieldTvdbID))
+}
+
+// TvdbIDNotNil applies the NotNil predicate on the "tvdb_id" field.
+func TvdbIDNotNil() predicate.Item {
+	return predicate.Item(sql.FieldNotNull(FieldTvdbID))
+}
+
+// TvdbIDEqualFold applies the EqualFold predicate on the "tvdb_id" field.
+func TvdbIDEqualFold(v string) predicate.Item {
+	return predicate.Item(sql.FieldEqualFold(FieldTvdbID, v))
+}
+
+// TvdbIDContainsFold applies the ContainsFold predicate on the "tvdb_id" field.
+func TvdbIDContainsFold(v string) predicate.Item {
+	return predicate.Item(sql.FieldContainsFold(FieldTvdbID, v))
+}
+
+// GenresEQ applies the EQ predicate on the "genres" field.
+func GenresEQ(v string) predicate.Item {
+	return predicate.Item(sql.FieldEQ(FieldGenres, v))
+}
+
+// GenresNEQ applies the NEQ predicate on the "genres" field.
+func GenresNEQ(v string) predicate.Item {
+	return predicate.Item(sql.FieldNEQ(FieldGenres, v))
+}
+
+// GenresIn applies the In predicate on the "genres" field.
+func GenresIn(vs ...string) predicate.Item {
+	return predicate.Item(sql.FieldIn(FieldGenres, vs...))
+}
+
+// GenresNotIn applies the NotIn predicate on the "genres" field.
+func GenresNotIn(vs ...string) predicate.Item {
+	return predicate.Item(sql.FieldNotIn(FieldGenres, vs...))
+}
+
+// GenresGT applies the GT predicate on the "genres" field.
+func GenresGT(v string) predicate.Item {
+	return predicate.Item(sql.FieldGT(FieldGenres, v))
+}
+
+// GenresGTE applies the GTE predicate on the "genres" field.
+func GenresGTE(v string) predicate.Item {
+	return predicate.Item(sql.FieldGTE(FieldGenres, v))
+}
+
+// GenresLT applies the LT predicate on the "genres" field.
+func GenresLT(v string) predicate.Item {
+	return predicate.Item(sql.FieldLT(FieldGenres, v))
+}
+
+// GenresLTE applies the LTE predicate on the "genres" field.
+func GenresLTE(v string) predicate.Item {
+	return predicate.Item(sql.FieldLTE(FieldGenres, v))
+}
+
+// GenresContains applies the Contains predicate on the "genres" field.
+func GenresContains(v string) predicate.Item {
+	return predicate.Item(sql.FieldContains(FieldGenres, v))
+}
+
+// GenresHasPrefix applies the HasPrefix predicate on the "genres" field.
+func GenresHasPrefix(v string) predicate.Item {
+	return predicate.Item(sql.FieldHasPrefix(FieldGenres, v))
+}
+
+// GenresHasSuffix applies the HasSuffix predicate on the "genres" field.
+func GenresHasSuffix(v string) predicate.Item {
+	return predicate.Item(sql.FieldHasSuffix(FieldGenres, v))
+}
+
+// GenresIsNil applies the IsNil predicate on the "genres" field.
+func GenresIsNil() predicate.Item {
+	return predicate.Item(sql.FieldIsNull(FieldGenres))
+}
+
+// GenresNotNil applies the NotNil predicate on the "genres" field.
+func GenresNotNil() predicate.Item {
+	return predicate.Item(sql.FieldNotNull(FieldGenres))
+}
+
+// GenresEqualFold applies the EqualFold predicate on the "genres" field.
+func GenresEqualFold(v string) predicate.Item {
+	return predicate.Item(sql.FieldEqualFold(FieldGenres, v))
+}
+
+// GenresContainsFold applies the ContainsFold predicate on the "genres" field.
+func GenresContainsFold(v string) predicate.Item {
+	return predicate.Item(sql.FieldContainsFold(FieldGenres, v))
+}
+
+// TagsEQ applies the EQ predicate on the "tags" field.
+func TagsEQ(v string) predicate.Item {
+	return predicate.Item(sql.FieldEQ(FieldTags, v))
+}
+
+// TagsNEQ applies the NEQ predicate on the "tags" field.
+func TagsNEQ(v string) predicate.Item {
+	return predicate.Item(sql.FieldNEQ(FieldTags, v))
+}
+
+// TagsIn applies the In predicate on the "tags" field.
+func TagsIn(vs ...string) predicate.Item {
+	return predicate.Item(sql.FieldIn(FieldTags, vs...))
+}
+
+// TagsNotIn applies the NotIn predicate on the "tags" field.
+func TagsNotIn(vs ...string) predicate.Item {
+	return predicate.Item(sql.FieldNotIn(FieldTags, vs...))
+}
+
+// TagsGT applies the GT predicate on the "tags" field.
+func TagsGT(v string) predicate.Item {
+	return predicate.Item(sql.FieldGT(FieldTags, v))
+}
+
+// TagsGTE applies the GTE predicate on the "tags" field.
+func TagsGTE(v string) predicate.Item {
+	return predicate.Item(sql.FieldGTE(FieldTags, v))
+}
+
+// TagsLT applies the LT predicate on the "tags" field.
+func TagsLT(v string) predicate.Item {
+	return predicate.Item(sql.FieldLT(FieldTags, v))
+}
+
+// TagsLTE applies the LTE predicate on the "tags" field.
+func TagsLTE(v string) predicate.Item {
+	return predicate.Item(sql.FieldLTE(FieldTags, v))
+}
+
+// TagsContains applies the Contains predicate on the "tags" field.
+func TagsContains(v string) predicate.Item {
+	return predicate.Item(sql.FieldContains(FieldTags, v))
+}
+
+// TagsHasPrefix applies the HasPrefix predicate on the "tags" field.
+func TagsHasPrefix(v string) predicate.Item {
+	return predicate.Item(sql.FieldHasPrefix(FieldTags, v))
+}
+
+// TagsHasSuffix applies the HasSuffix predicate on the "tags" field.
+func TagsHasSuffix(v string) predicate.Item {
+	return predicate.Item(sql.FieldHasSuffix(FieldTags, v))
+}
+
+// TagsIsNil applies the IsNil predicate on the "tags" field.
+func TagsIsNil() predicate.Item {
+	return predicate.Item(sql.FieldIsNull(FieldTags))
+}
+
+// TagsNotNil applies the NotNil predicate on the "tags" field.
+func TagsNotNil() predicate.Item {
+	return predicate.Item(sql.FieldNotNull(FieldTags))
+}
+
+// TagsEqualFold applies the EqualFold predicate on the "tags" field.
+func TagsEqualFold(v string) predicate.Item {
+	return predicate.Item(sql.FieldEqualFold(FieldTags, v))
+}
+
+// TagsContainsFold applies the ContainsFold predicate on the "tags" field.
+func TagsContainsFold(v string) predicate.Item {
+	return predicate.Item(sql.FieldContainsFold(FieldTags, v))
+}
+
+// OfficialRatingEQ applies the EQ predicate on the "official_rating" field.
+func OfficialRatingEQ(v string) predicate.Item {
+	return predicate.Item(sql.FieldEQ(FieldOfficialRating, v))
+}
+
+// OfficialRatingNEQ applies the NEQ predicate on the "official_rating" field.
+func OfficialRatingNEQ(v string) predicate.Item {
+	return predicate.Item(sql.FieldNEQ(FieldOfficialRating, v))
+}
+
+// OfficialRatingIn applies the In predicate on the "official_rating" field.
+func OfficialRatingIn(vs ...string) predicate.Item {
+	return predicate.Item(sql.FieldIn(FieldOfficialRating, vs...))
+}
+
+// OfficialRatingNotIn applies the NotIn predicate on the "official_rating" field.
+func OfficialRatingNotIn(vs ...string) predicate.Item {
+	return predicate.Item(sql.FieldNotIn(FieldOfficialRating, vs...))
+}
+
+// OfficialRatingGT applies the GT predicate on the "official_rating" field.
+func OfficialRatingGT(v string) predicate.Item {
+	return predicate.Item(sql.FieldGT(FieldOfficialRating, v))
+}
+
+// OfficialRatingGTE applies the GTE predicate on the "official_rating" field.
+func OfficialRatingGTE(v string) predicate.Item {
+	return predicate.Item(sql.FieldGTE(FieldOfficialRating, v))
+}
+
+// OfficialRatingLT applies the LT predicate on the "official_rating" field.
+func OfficialRatingLT(v string) predicate.Item {
+	return predicate.Item(sql.FieldLT(FieldOfficialRating, v))
+}
+
+// OfficialRatingLTE applies the LTE predicate on the "official_rating" field.
+func OfficialRatingLTE(v string) predicate.Item {
+	return predicate.Item(sql.FieldLTE(FieldOfficialRating, v))
+}
+
+// OfficialRatingContains applies the Contains predicate on the "official_rating" field.
+func OfficialRatingContains(v string) predicate.Item {
+	return predicate.Item(sql.FieldContains(FieldOfficialRating, v))
+}
+
+// OfficialRatingHasPrefix applies the HasPrefix predicate on the "official_rating" field.
+func OfficialRatingHasPrefix(v string) predicate.Item {
+	return predicate.Item(sql.FieldHasPrefix(FieldOfficialRating, v))
+}
+
+// OfficialRatingHasSuffix applies the HasSuffix predicate on the "official_rating" field.
+func OfficialRatingHasSuffix(v string) predicate.Item {
+	return predicate.Item(sql.FieldHasSuffix(FieldOfficialRating, v))
+}
+
+// OfficialRatingIsNil applies the IsNil predicate on the "official_rating" field.
+func OfficialRatingIsNil() predicate.Item {
+	return predicate.Item(sql.FieldIsNull(FieldOfficialRating))
+}
+
+// OfficialRatingNotNil applies the NotNil predicate on the "official_rating" field.
+func OfficialRatingNotNil() predicate.Item {
+	return predicate.Item(sql.FieldNotNull(FieldOfficialRating))
+}
+
+// OfficialRatingEqualFold applies the EqualFold predicate on the "official_rating" field.
+func OfficialRatingEqualFold(v string) predicate.Item {
+	return predicate.Item(sql.FieldEqualFold(FieldOfficialRating, v))
+}
+
+// OfficialRatingContainsFold applies the ContainsFold predicate on the "official_rating" field.
+func OfficialRatingContainsFold(v string) predicate.Item {
+	return predicate.Item(sql.FieldContainsFold(FieldOfficialRating, v))
+}
+
+// ProductionYearEQ applies the EQ predicate on the "production_year" field.
+func ProductionYearEQ(v int) predicate.Item {
+	return predicate.Item(sql.FieldEQ(FieldProductionYear, v))
+}
+
+// ProductionYearNEQ applies the NEQ predicate on the "production_year" field.
+func ProductionYearNEQ(v int) predicate.Item {
+	return predicate.Item(sql.FieldNEQ(FieldProductionYear, v))
+}
+
+// ProductionYearIn applies the In predicate on the "production_year" field.
+func ProductionYearIn(vs ...int) predicate.Item {
+	return predicate.Item(sql.FieldIn(FieldProductionYear, vs...))
+}
+
+// ProductionYearNotIn applies the NotIn predicate on the "production_year" field.
+func ProductionYearNotIn(vs ...int) predicate.Item {
+	return predicate.Item(sql.FieldNotIn(FieldProductionYear, vs...))
+}
+
+// ProductionYearGT applies the GT predicate on the "production_year" field.
+func ProductionYearGT(v int) predicate.Item {
+	return predicate.Item(sql.FieldGT(FieldProductionYear, v))
+}
+
+// ProductionYearGTE applies the GTE predicate on the "production_year" field.
+func ProductionYearGTE(v int) predicate.Item {
+	return predicate.Item(sql.FieldGTE(FieldProductionYear, v))
+}
+
+// ProductionYearLT applies the LT predicate on the "production_year" field.
+func ProductionYearLT(v int) predicate.Item {
+	return predicate.Item(sql.FieldLT(FieldProductionYear, v))
+}
+
+// ProductionYearLTE applies the LTE predicate on the "production_year" field.
+func ProductionYearLTE(v int) predicate.Item {
+	return predicate.Item(sql.FieldLTE(FieldProductionYear, v))
+}
+
+// ProductionYearIsNil applies the IsNil predicate on the "production_year" field.
+func ProductionYearIsNil() predicate.Item {
+	return predicate.Item(sql.FieldIsNull(FieldProductionYear))
+}
+
+// ProductionYearNotNil applies the NotNil predicate on the "production_year" field.
+func ProductionYearNotNil() predicate.Item {
+	return predicate.Item(sql.FieldNotNull(FieldProductionYear))
+}
+
+// CommunityRatingEQ applies the EQ predicate on the "community_rating" field.
+func CommunityRatingEQ(v float64) predicate.Item {
+	return predicate.Item(sql.FieldEQ(FieldCommunityRating, v))
+}
+
+// CommunityRatingNEQ applies the NEQ predicate on the "community_rating" field.
+func CommunityRatingNEQ(v float64) predicate.Item {
+	return predicate.Item(sql.FieldNEQ(FieldCommunityRating, v))
+}
+
+// CommunityRatingIn applies the In predicate on the "community_rating" field.
+func CommunityRatingIn(vs ...float64) predicate.Item {
+	return predicate.Item(sql.FieldIn(FieldCommunityRating, vs...))
+}
+
+// CommunityRatingNotIn applies the NotIn predicate on the "community_rating" field.
+func CommunityRatingNotIn(vs ...float64) predicate.Item {
+	return predicate.Item(sql.FieldNotIn(FieldCommunityRating, vs...))
+}
+
+// CommunityRatingGT applies the GT predicate on the "community_rating" field.
+func CommunityRatingGT(v float64) predicate.Item {
+	return predicate.Item(sql.FieldGT(FieldCommunityRating, v))
+}
+
+// CommunityRatingGTE applies the GTE predicate on the "community_rating" field.
+func CommunityRatingGTE(v float64) predicate.Item {
+	return predicate.Item(sql.FieldGTE(FieldCommunityRating, v))
+}
+
+// CommunityRatingLT applies the LT predicate on the "community_rating" field.
+func CommunityRatingLT(v float64) predicate.Item {
+	return predicate.Item(sql.FieldLT(FieldCommunityRating, v))
+}
+
+// CommunityRatingLTE applies the LTE predicate on the "community_rating" field.
+func CommunityRatingLTE(v float64) predicate.Item {
+	return predicate.Item(sql.FieldLTE(FieldCommunityRating, v))
+}
+
+// CommunityRatingIsNil applies the IsNil predicate on the "community_rating" field.
+func CommunityRatingIsNil() predicate.Item {
+	return predicate.Item(sql.FieldIsNull(FieldCommunityRating))
+}
+
+// CommunityRatingNotNil applies the NotNil predicate on the "community_rating" field.
+func CommunityRatingNotNil() predicate.Item {
+	return predicate.Item(sql.FieldNotNull(FieldCommunityRating))
+}
+
+// PremiereDateEQ applies the EQ predicate on the "premiere_date" field.
+func PremiereDateEQ(v time.Time) predicate.Item {
+	return predicate.Item(sql.FieldEQ(FieldPremiereDate, v))
+}
+
+// PremiereDateNEQ applies the NEQ predicate on the "premiere_date" field.
+func PremiereDateNEQ(v time.Time) predicate.Item {
+	return predicate.Item(sql.FieldNEQ(FieldPremiereDate, v))
+}
+
+// PremiereDateIn applies the In predicate on the "premiere_date" field.
+func PremiereDateIn(vs ...time.Time) predicate.Item {
+	return predicate.Item(sql.FieldIn(FieldPremiereDate, vs...))
+}
+
+// PremiereDateNotIn applies the NotIn predicate on the "premiere_date" field.
+func PremiereDateNotIn(vs ...time.Time) predicate.Item {
+	return predicate.Item(sql.FieldNotIn(FieldPremiereDate, vs...))
+}
+
+// PremiereDateGT applies the GT predicate on the "premiere_date" field.
+func PremiereDateGT(v time.Time) predicate.Item {
+	return predicate.Item(sql.FieldGT(FieldPremiereDate, v))
+}
+
+// PremiereDateGTE applies the GTE predicate on the "premiere_date" field.
+func PremiereDateGTE(v time.Time) predicate.Item {
+	return predicate.Item(sql.FieldGTE(FieldPremiereDate, v))
+}
+
+// PremiereDateLT applies the LT predicate on the "premiere_date" field.
+func PremiereDateLT(v time.Time) predicate.Item {
+	return predicate.Item(sql.FieldLT(FieldPremiereDate, v))
+}
+
+// PremiereDateLTE applies the LTE predicate on the "premiere_date" field.
+func PremiereDateLTE(v time.Time) predicate.Item {
+	return predicate.Item(sql.FieldLTE(FieldPremiereDate, v))
+}
+
+// PremiereDateIsNil applies the IsNil predicate on the "premiere_date" field.
+func PremiereDateIsNil() predicate.Item {
+	return predicate.Item(sql.FieldIsNull(FieldPremiereDate))
+}
+
+// PremiereDateNotNil applies the NotNil predicate on the "premiere_date" field.
+func PremiereDateNotNil() predicate.Item {
+	return predicate.Item(sql.FieldNotNull(FieldPremiereDate))
+}
+
+// DateCreatedEQ applies the EQ predicate on the "date_created" field.
+func DateCreatedEQ(v time.Time) predicate.Item {
+	return predicate.Item(sql.FieldEQ(FieldDateCreated, v))
+}
+
+// DateCreatedNEQ applies the NEQ predicate on the "date_created" field.
+func DateCreatedNEQ(v time.Time) predicate.Item {
+	return predicate.Item(sql.FieldNEQ(FieldDateCreated, v))
+}
+
+// DateCreatedIn applies the In predicate on the "date_created" field.
+func DateCreatedIn(vs ...time.Time) predicate.Item {
+	return predicate.Item(sql.FieldIn(FieldDateCreated, vs...))
+}
+
+// DateCreatedNotIn applies the NotIn predicate on the "date_created" field.
+func DateCreatedNotIn(vs ...time.Time) predicate.Item {
+	return predicate.Item(sql.FieldNotIn(FieldDateCreated, vs...))
+}
+
+// DateCreatedGT applies the GT predicate on the "date_created" field.
+func DateCreatedGT(v time.Time) predicate.Item {
+	return predicate.Item(sql.FieldGT(FieldDateCreated, v))
+}
+
+// DateCreatedGTE applies the GTE predicate on the "date_created" field.
+func DateCreatedGTE(v time.Time) predicate.Item {
+	return predicate.Item(sql.FieldGTE(FieldDateCreated, v))
+}
+
+// DateCreatedLT applies the LT predicate on the "date_created" field.
+func DateCreatedLT(v time.Time) predicate.Item {
+	return predicate.Item(sql.FieldLT(FieldDateCreated, v))
+}
+
+// DateCreatedLTE applies the LTE predicate on the "date_created" field.
+func DateCreatedLTE(v time.Time) predicate.Item {
+	return predicate.Item(sql.FieldLTE(FieldDateCreated, v))
+}
+
+// DateCreatedIsNil applies the IsNil predicate on the "date_created" field.
+func DateCreatedIsNil() predicate.Item {
+	return predicate.Item(sql.FieldIsNull(FieldDateCreated))
+}
+
+// DateCreatedNotNil applies the NotNil predicate on the "date_created" field.
+func DateCreatedNotNil() predicate.Item {
+	return predicate.Item(sql.FieldNotNull(FieldDateCreated))
+}
+
+// DataEQ applies the EQ predicate on the "data" field.
+func DataEQ(v []byte) predicate.Item {
+	return predicate.Item(sql.FieldEQ(FieldData, v))
+}
+
+// DataNEQ applies the NEQ predicate on the "data" field.
+func DataNEQ(v []byte) predicate.Item {
+	return predicate.Item(sql.FieldNEQ(FieldData, v))
+}
+
+// DataIn applies the In predicate on the "data" field.
+func DataIn(vs ...[]byte) predicate.Item {
+	return predicate.Item(sql.FieldIn(FieldData, vs...))
+}
+
+// DataNotIn applies the NotIn predicate on the "data" field.
+func DataNotIn(vs ...[]byte) predicate.Item {
+	return predicate.Item(sql.FieldNotIn(FieldData, vs...))
+}
+
+// DataGT applies the GT predicate on the "data" field.
+func DataGT(v []byte) predicate.Item {
+	return predicate.Item(sql.FieldGT(FieldData, v))
+}
+
+// DataGTE applies the GTE predicate on the "data" field.
+func DataGTE(v []byte) predicate.Item {
+	return predicate.Item(sql.FieldGTE(FieldData, v))
+}
+
+// DataLT applies the LT predicate on the "data" field.
+func DataLT(v []byte) predicate.Item {
+	return predicate.Item(sql.FieldLT(FieldData, v))
+}
+
+// DataLTE applies the LTE predicate on the "data" field.
+func DataLTE(v []byte) predicate.Item {
+	return predicate.Item(sql.FieldLTE(FieldData, v))
+}
+
+// IndexedAtEQ applies the EQ predicate on the "indexed_at" field.
+func IndexedAtEQ(v time.Time) predicate.Item {
+	return predicate.Item(sql.FieldEQ(FieldIndexedAt, v))
+}
+
+// IndexedAtNEQ applies the NEQ predicate on the "indexed_at" field.
+func IndexedAtNEQ(v time.Time) predicate.Item {
+	return predicate.Item(sql.FieldNEQ(FieldIndexedAt, v))
+}
+
+// IndexedAtIn applies the In predicate on the "indexed_at" field.
+func IndexedAtIn(vs ...time.Time) predicate.Item {
+	return predicate.Item(sql.FieldIn(FieldIndexedAt, vs...))
+}
+
+// IndexedAtNotIn applies the NotIn predicate on the "indexed_at" field.
+func IndexedAtNotIn(vs ...time.Time) predicate.Item {
+	return predicate.Item(sql.FieldNotIn(FieldIndexedAt, vs...))
+}
+
+// IndexedAtGT applies the GT predicate on the "indexed_at" field.
+func IndexedAtGT(v time.Time) predicate.Item {
+	return predicate.Item(sql.FieldGT(FieldIndexedAt, v))
+}
+
+// IndexedAtGTE applies the GTE predicate on the "indexed_at" field.
+func IndexedAtGTE(v time.Time) predicate.Item {
+	return predicate.Item(sql.FieldGTE(FieldIndexedAt, v))
+}
+
+// IndexedAtLT applies the LT predicate on the "indexed_at" field.
+func IndexedAtLT(v time.Time) predicate.Item {
+	return predicate.Item(sql.FieldLT(FieldIndexedAt, v))
+}
+
+// IndexedAtLTE applies the LTE predicate on the "indexed_at" field.
+func IndexedAtLTE(v time.Time) predicate.Item {
+	return predicate.Item(sql.FieldLTE(FieldIndexedAt, v))
+}
+
+// HasBackend applies the HasEdge predicate on the "backend" edge.
+func HasBackend() predicate.Item {
+	return predicate.Item(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, BackendTable, BackendColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasBackendWith applies the HasEdge predicate on the "backend" edge with a given conditions (other predicates).
+func HasBackendWith(preds ...predicate.Backend) predicate.Item {
+	return predicate.Item(func(s *sql.Selector) {
+		step := newBackendStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Item) predicate.Item {
+	return predicate.Item(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Item) predicate.Item {
+	return predicate.Item(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Item) predicate.Item {
+	return predicate.Item(sql.NotPredicates(p))
+}
@@ -0,0 +1,621 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/ddevcap/jellyfin-proxy/ent/backend"
+	"github.com/ddevcap/jellyfin-proxy/ent/item"
+	"github.com/google/uuid"
+)
+
+// ItemCreate is the builder for creating a Item entity.
+type ItemCreate struct {
+	config
+	mutation *ItemMutation
+	hooks    []Hook
+}
+
+// SetBackendItemID sets the "backend_item_id" field.
+func (_c *ItemCreate) SetBackendItemID(v string) *ItemCreate {
+	_c.mutation.SetBackendItemID(v)
+	return _c
+}
+
+// SetName sets the "name" field.
+func (_c *ItemCreate) SetName(v string) *ItemCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetSortName sets the "sort_name" field.
+func (_c *ItemCreate) SetSortName(v string) *ItemCreate {
+	_c.mutation.SetSortName(v)
+	return _c
+}
+
+// SetItemType sets the "item_type" field.
+func (_c *ItemCreate) SetItemType(v string) *ItemCreate {
+	_c.mutation.SetItemType(v)
+	return _c
+}
+
+// SetMediaType sets the "media_type" field.
+func (_c *ItemCreate) SetMediaType(v string) *ItemCreate {
+	_c.mutation.SetMediaType(v)
+	return _c
+}
+
+// SetNillableMediaType sets the "media_type" field if the given value is not nil.
+func (_c *ItemCreate) SetNillableMediaType(v *string) *ItemCreate {
+	if v != nil {
+		_c.SetMediaType(*v)
+	}
+	return _c
+}
+
+// SetLibraryID sets the "library_id" field.
+func (_c *ItemCreate) SetLibraryID(v string) *ItemCreate {
+	_c.mutation.SetLibraryID(v)
+	return _c
+}
+
+// SetNillableLibraryID sets the "library_id" field if the given value is not nil.
+func (_c *ItemCreate) SetNillableLibraryID(v *string) *ItemCreate {
+	if v != nil {
+		_c.SetLibraryID(*v)
+	}
+	return _c
+}
+
+// SetCollectionType sets the "collection_type" field.
+func (_c *ItemCreate) SetCollectionType(v string) *ItemCreate {
+	_c.mutation.SetCollectionType(v)
+	return _c
+}
+
+// SetNillableCollectionType sets the "collection_type" field if the given value is not nil.
+func (_c *ItemCreate) SetNillableCollectionType(v *string) *ItemCreate {
+	if v != nil {
+		_c.SetCollectionType(*v)
+	}
+	return _c
+}
+
+// SetParentID sets the "parent_id" field.
+func (_c *ItemCreate) SetParentID(v string) *ItemCreate {
+	_c.mutation.SetParentID(v)
+	return _c
+}
+
+// SetNillableParentID sets the "parent_id" field if the given value is not nil.
+func (_c *ItemCreate) SetNillableParentID(v *string) *ItemCreate {
+	if v != nil {
+		_c.SetParentID(*v)
+	}
+	return _c
+}
+
+// SetSeasonID sets the "season_id" field.
+func (_c *ItemCreate) SetSeasonID(v string) *ItemCreate {
+	_c.mutation.SetSeasonID(v)
+	return _c
+}
+
+// SetNillableSeasonID sets the "season_id" field if the given value is not nil.
+func (_c *ItemCreate) SetNillableSeasonID(v *string) *ItemCreate {
+	if v != nil {
+		_c.SetSeasonID(*v)
+	}
+	return _c
+}
+
+// SetSeriesID sets the "series_id" field.
+func (_c *ItemCreate) SetSeriesID(v string) *ItemCreate {
+	_c.mutation.SetSeriesID(v)
+	return _c
+}
+
+// SetNillableSeriesID sets the "series_id" field if the given value is not nil.
+func (_c *ItemCreate) SetNillableSeriesID(v *string) *ItemCreate {
+	if v != nil {
+		_c.SetSeriesID(*v)
+	}
+	return _c
+}
+
+// SetTmdbID sets the "tmdb_id" field.
+func (_c *ItemCreate) SetTmdbID(v string) *ItemCreate {
+	_c.mutation.SetTmdbID(v)
+	return _c
+}
+
+// SetNillableTmdbID sets the "tmdb_id" field if the given value is not nil.
+func (_c *ItemCreate) SetNillableTmdbID(v *string) *ItemCreate {
+	if v != nil {
+		_c.SetTmdbID(*v)
+	}
+	return _c
+}
+
+// SetImdbID sets the "imdb_id" field.
+func (_c *ItemCreate) SetImdbID(v string) *ItemCreate {
+	_c.mutation.SetImdbID(v)
+	return _c
+}
+
+// SetNillableImdbID sets the "imdb_id" field if the given value is not nil.
+func (_c *ItemCreate) SetNillableImdbID(v *string) *ItemCreate {
+	if v != nil {
+		_c.SetImdbID(*v)
+	}
+	return _c
+}
+
+// SetTvdbID sets the "tvdb_id" field.
+func (_c *ItemCreate) SetTvdbID(v string) *ItemCreate {
+	_c.mutation.SetTvdbID(v)
+	return _c
+}
+
+// SetNillableTvdbID sets the "tvdb_id" field if the given value is not nil.
+func (_c *ItemCreate) SetNillableTvdbID(v *string) *ItemCreate {
+	if v != nil {
+		_c.SetTvdbID(*v)
+	}
+	return _c
+}
+
+// SetGenres sets the "genres" field.
+func (_c *ItemCreate) SetGenres(v string) *ItemCreate {
+	_c.mutation.SetGenres(v)
+	return _c
+}
+
+// SetNillableGenres sets the "genres" field if the given value is not nil.
+func (_c *ItemCreate) SetNillableGenres(v *string) *ItemCreate {
+	if v != nil {
+		_c.SetGenres(*v)
+	}
+	return _c
+}
+
+// SetTags sets the "tags" field.
+func (_c *ItemCreate) SetTags(v string) *ItemCreate {
+	_c.mutation.SetTags(v)
+	return _c
+}
+
+// SetNillableTags sets the "tags" field if the given value is not nil.
+func (_c *ItemCreate) SetNillableTags(v *string) *ItemCreate {
+	if v != nil {
+		_c.SetTags(*v)
+	}
+	return _c
+}
+
+// SetOfficialRating sets the "official_rating" field.
+func (_c *ItemCreate) SetOfficialRating(v string) *ItemCreate {
+	_c.mutation.SetOfficialRating(v)
+	return _c
+}
+
+// SetNillableOfficialRating sets the "official_rating" field if the given value is not nil.
+func (_c *ItemCreate) SetNillableOfficialRating(v *string) *ItemCreate {
+	if v != nil {
+		_c.SetOfficialRating(*v)
+	}
+	return _c
+}
+
+// SetProductionYear sets the "production_year" field.
+func (_c *ItemCreate) SetProductionYear(v int) *ItemCreate {
+	_c.mutation.SetProductionYear(v)
+	return _c
+}
+
+// SetNillableProductionYear sets the "production_year" field if the given value is not nil.
+func (_c *ItemCreate) SetNillableProductionYear(v *int) *ItemCreate {
+	if v != nil {
+		_c.SetProductionYear(*v)
+	}
+	return _c
+}
+
+// SetCommunityRating sets the "community_rating" field.
+func (_c *ItemCreate) SetCommunityRating(v float64) *ItemCreate {
+	_c.mutation.SetCommunityRating(v)
+	return _c
+}
+
+// SetNillableCommunityRating sets the "community_rating" field if the given value is not nil.
+func (_c *ItemCreate) SetNillableCommunityRating(v *float64) *ItemCreate {
+	if v != nil {
+		_c.SetCommunityRating(*v)
+	}
+	return _c
+}
+
+// SetPremiereDate sets the "premiere_date" field.
+func (_c *ItemCreate) SetPremiereDate(v time.Time) *ItemCreate {
+	_c.mutation.SetPremiereDate(v)
+	return _c
+}
+
+// SetNillablePremiereDate sets the "premiere_date" field if the given value is not nil.
+func (_c *ItemCreate) SetNillablePremiereDate(v *time.Time) *ItemCreate {
+	if v != nil {
+		_c.SetPremiereDate(*v)
+	}
+	return _c
+}
+
+// SetDateCreated sets the "date_created" field.
+func (_c *ItemCreate) SetDateCreated(v time.Time) *ItemCreate {
+	_c.mutation.SetDateCreated(v)
+	return _c
+}
+
+// SetNillableDateCreated sets the "date_created" field if the given value is not nil.
+func (_c *ItemCreate) SetNillableDateCreated(v *time.Time) *ItemCreate {
+	if v != nil {
+		_c.SetDateCreated(*v)
+	}
+	return _c
+}
+
+// SetData sets the "data" field.
+func (_c *ItemCreate) SetData(v []byte) *ItemCreate {
+	_c.mutation.SetData(v)
+	return _c
+}
+
+// SetIndexedAt sets the "indexed_at" field.
+func (_c *ItemCreate) SetIndexedAt(v time.Time) *ItemCreate {
+	_c.mutation.SetIndexedAt(v)
+	return _c
+}
+
+// SetNillableIndexedAt sets the "indexed_at" field if the given value is not nil.
+func (_c *ItemCreate) SetNillableIndexedAt(v *time.Time) *ItemCreate {
+	if v != nil {
+		_c.SetIndexedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *ItemCreate) SetID(v uuid.UUID) *ItemCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *ItemCreate) SetNillableID(v *uuid.UUID) *ItemCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetBackendID sets the "backend" edge to the Backend entity by ID.
+func (_c *ItemCreate) SetBackendID(id uuid.UUID) *ItemCreate {
+	_c.mutation.SetBackendID(id)
+	return _c
+}
+
+// SetBackend sets the "backend" edge to the Backend entity.
+func (_c *ItemCreate) SetBackend(v *Backend) *ItemCreate {
+	return _c.SetBackendID(v.ID)
+}
+
+// Mutation returns the ItemMutation object of the builder.
+func (_c *ItemCreate) Mutation() *ItemMutation {
+	return _c.mutation
+}
+
+// Save creates the Item in the database.
+func (_c *ItemCreate) Save(ctx context.Context) (*Item, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *ItemCreate) SaveX(ctx context.Context) *Item {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ItemCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ItemCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *ItemCreate) defaults() {
+	if _, ok := _c.mutation.IndexedAt(); !ok {
+		v := item.DefaultIndexedAt()
+		_c.mutation.SetIndexedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := item.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *ItemCreate) check() error {
+	if _, ok := _c.mutation.BackendItemID(); !ok {
+		return &ValidationError{Name: "backend_item_id", err: errors.New(`ent: missing required field "Item.backend_item_id"`)}
+	}
+	if v, ok := _c.mutation.BackendItemID(); ok {
+		if err := item.BackendItemIDValidator(v); err != nil {
+			return &ValidationError{Name: "backend_item_id", err: fmt.Errorf(`ent: validator failed for field "Item.backend_item_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "Item.name"`)}
+	}
+	if _, ok := _c.mutation.SortName(); !ok {
+		return &ValidationError{Name: "sort_name", err: errors.New(`ent: missing required field "Item.sort_name"`)}
+	}
+	if _, ok := _c.mutation.ItemType(); !ok {
+		return &ValidationError{Name: "item_type", err: errors.New(`ent: missing required field "Item.item_type"`)}
+	}
+	if v, ok := _c.mutation.ItemType(); ok {
+		if err := item.ItemTypeValidator(v); err != nil {
+			return &ValidationError{Name: "item_type", err: fmt.Errorf(`ent: validator failed for field "Item.item_type": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Data(); !ok {
+		return &ValidationError{Name: "data", err: errors.New(`ent: missing required field "Item.data"`)}
+	}
+	if _, ok := _c.mutation.IndexedAt(); !ok {
+		return &ValidationError{Name: "indexed_at", err: errors.New(`ent: missing required field "Item.indexed_at"`)}
+	}
+	if len(_c.mutation.BackendIDs()) == 0 {
+		return &ValidationError{Name: "backend", err: errors.New(`ent: missing required edge "Item.backend"`)}
+	}
+	return nil
+}
+
+func (_c *ItemCreate) sqlSave(ctx context.Context) (*Item, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *ItemCreate) createSpec() (*Item, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Item{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(item.Table, sqlgraph.NewFieldSpec(item.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.BackendItemID(); ok {
+		_spec.SetField(item.FieldBackendItemID, field.TypeString, value)
+		_node.BackendItemID = value
+	}
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(item.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := _c.mutation.SortName(); ok {
+		_spec.SetField(item.FieldSortName, field.TypeString, value)
+		_node.SortName = value
+	}
+	if value, ok := _c.mutation.ItemType(); ok {
+		_spec.SetField(item.FieldItemType, field.TypeString, value)
+		_node.ItemType = value
+	}
+	if value, ok := _c.mutation.MediaType(); ok {
+		_spec.SetField(item.FieldMediaType, field.TypeString, value)
+		_node.MediaType = value
+	}
+	if value, ok := _c.mutation.LibraryID(); ok {
+		_spec.SetField(item.FieldLibraryID, field.TypeString, value)
+		_node.LibraryID = value
+	}
+	if value, ok := _c.mutation.CollectionType(); ok {
+		_spec.SetField(item.FieldCollectionType, field.TypeString, value)
+		_node.CollectionType = value
+	}
+	if value, ok := _c.mutation.ParentID(); ok {
+		_spec.SetField(item.FieldParentID, field.TypeString, value)
+		_node.ParentID = value
+	}
+	if value, ok := _c.mutation.SeasonID(); ok {
+		_spec.SetField(item.FieldSeasonID, field.TypeString, value)
+		_node.SeasonID = value
+	}
+	if value, ok := _c.mutation.SeriesID(); ok {
+		_spec.SetField(item.FieldSeriesID, field.TypeString, value)
+		_node.SeriesID = value
+	}
+	if value, ok := _c.mutation.TmdbID(); ok {
+		_spec.SetField(item.FieldTmdbID, field.TypeString, value)
+		_node.TmdbID = value
+	}
+	if value, ok := _c.mutation.ImdbID(); ok {
+		_spec.SetField(item.FieldImdbID, field.TypeString, value)
+		_node.ImdbID = value
+	}
+	if value, ok := _c.mutation.TvdbID(); ok {
+		_spec.SetField(item.FieldTvdbID, field.TypeString, value)
+		_node.TvdbID = value
+	}
+	if value, ok := _c.mutation.Genres(); ok {
+		_spec.SetField(item.FieldGenres, field.TypeString, value)
+		_node.Genres = value
+	}
+	if value, ok := _c.mutation.Tags(); ok {
+		_spec.SetField(item.FieldTags, field.TypeString, value)
+		_node.Tags = value
+	}
+	if value, ok := _c.mutation.OfficialRating(); ok {
+		_spec.SetField(item.FieldOfficialRating, field.TypeString, value)
+		_node.OfficialRating = value
+	}
+	if value, ok := _c.mutation.ProductionYear(); ok {
+		_spec.SetField(item.FieldProductionYear, field.TypeInt, value)
+		_node.ProductionYear = value
+	}
+	if value, ok := _c.mutation.CommunityRating(); ok {
+		_spec.SetField(item.FieldCommunityRating, field.TypeFloat64, value)
+		_node.CommunityRating = value
+	}
+	if value, ok := _c.mutation.PremiereDate(); ok {
+		_spec.SetField(item.FieldPremiereDate, field.TypeTime, value)
+		_node.PremiereDate = &value
+	}
+	if value, ok := _c.mutation.DateCreated(); ok {
+		_spec.SetField(item.FieldDateCreated, field.TypeTime, value)
+		_node.DateCreated = &value
+	}
+	if value, ok := _c.mutation.Data(); ok {
+		_spec.SetField(item.FieldData, field.TypeBytes, value)
+		_node.Data = value
+	}
+	if value, ok := _c.mutation.IndexedAt(); ok {
+		_spec.SetField(item.FieldIndexedAt, field.TypeTime, value)
+		_node.IndexedAt = value
+	}
+	if nodes := _c.mutation.BackendIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   item.BackendTable,
+			Columns: []string{item.BackendColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(backend.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.backend_items = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// ItemCreateBulk is the builder for creating many Item entities in bulk.
+type ItemCreateBulk struct {
+	config
+	err      error
+	builders []*ItemCreate
+}
+
+// Save creates the Item entities in the database.
+func (_c *ItemCreateBulk) Save(ctx context.Context) ([]*Item, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*Item, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ItemMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *ItemCreateBulk) SaveX(ctx context.Context) []*Item {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ItemCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ItemCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,1372 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/ddevcap/jellyfin-proxy/ent/backend"
+	"github.com/ddevcap/jellyfin-proxy/ent/item"
+	"github.com/ddevcap/jellyfin-proxy/ent/predicate"
+	"github.com/google/uuid"
+)
+
+// ItemUpdate is the builder for updating Item entities.
+type ItemUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ItemMutation
+}
+
+// Where appends a list predicates to the ItemUpdate builder.
+func (_u *ItemUpdate) Where(ps ...predicate.Item) *ItemUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetBackendItemID sets the "backend_item_id" field.
+func (_u *ItemUpdate) SetBackendItemID(v string) *ItemUpdate {
+	_u.mutation.SetBackendItemID(v)
+	return _u
+}
+
+// SetNillableBackendItemID sets the "backend_item_id" field if the given value is not nil.
+func (_u *ItemUpdate) SetNillableBackendItemID(v *string) *ItemUpdate {
+	if v != nil {
+		_u.SetBackendItemID(*v)
+	}
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *ItemUpdate) SetName(v string) *ItemUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *ItemUpdate) SetNillableName(v *string) *ItemUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetSortName sets the "sort_name" field.
+func (_u *ItemUpdate) SetSortName(v string) *ItemUpdate {
+	_u.mutation.SetSortName(v)
+	return _u
+}
+
+// SetNillableSortName sets the "sort_name" field if the given value is not nil.
+func (_u *ItemUpdate) SetNillableSortName(v *string) *ItemUpdate {
+	if v != nil {
+		_u.SetSortName(*v)
+	}
+	return _u
+}
+
+// SetItemType sets the "item_type" field.
+func (_u *ItemUpdate) SetItemType(v string) *ItemUpdate {
+	_u.mutation.SetItemType(v)
+	return _u
+}
+
+// SetNillableItemType sets the "item_type" field if the given value is not nil.
+func (_u *ItemUpdate) SetNillableItemType(v *string) *ItemUpdate {
+	if v != nil {
+		_u.SetItemType(*v)
+	}
+	return _u
+}
+
+// SetMediaType sets the "media_type" field.
+func (_u *ItemUpdate) SetMediaType(v string) *ItemUpdate {
+	_u.mutation.SetMediaType(v)
+	return _u
+}
+
+// SetNillableMediaType sets the "media_type" field if the given value is not nil.
+func (_u *ItemUpdate) SetNillableMediaType(v *string) *ItemUpdate {
+	if v != nil {
+		_u.SetMediaType(*v)
+	}
+	return _u
+}
+
+// ClearMediaType clears the value of the "media_type" field.
+func (_u *ItemUpdate) ClearMediaType() *ItemUpdate {
+	_u.mutation.ClearMediaType()
+	return _u
+}
+
+// SetLibraryID sets the "library_id" field.
+func (_u *ItemUpdate) SetLibraryID(v string) *ItemUpdate {
+	_u.mutation.SetLibraryID(v)
+	return _u
+}
+
+// SetNillableLibraryID sets the "library_id" field if the given value is not nil.
+func (_u *ItemUpdate) SetNillableLibraryID(v *string) *ItemUpdate {
+	if v != nil {
+		_u.SetLibraryID(*v)
+	}
+	return _u
+}
+
+// ClearLibraryID clears the value of the "library_id" field.
+func (_u *ItemUpdate) ClearLibraryID() *ItemUpdate {
+	_u.mutation.ClearLibraryID()
+	return _u
+}
+
+// SetCollectionType sets the "collection_type" field.
+func (_u *ItemUpdate) SetCollectionType(v string) *ItemUpdate {
+	_u.mutation.SetCollectionType(v)
+	return _u
+}
+
+// SetNillableCollectionType sets the "collection_type" field if the given value is not nil.
+func (_u *ItemUpdate) SetNillableCollectionType(v *string) *ItemUpdate {
+	if v != nil {
+		_u.SetCollectionType(*v)
+	}
+	return _u
+}
+
+// ClearCollectionType clears the value of the "collection_type" field.
+func (_u *ItemUpdate) ClearCollectionType() *ItemUpdate {
+	_u.mutation.ClearCollectionType()
+	return _u
+}
+
+// SetParentID sets the "parent_id" field.
+func (_u *ItemUpdate) SetParentID(v string) *ItemUpdate {
+	_u.mutation.SetParentID(v)
+	return _u
+}
+
+// SetNillableParentID sets the "parent_id" field if the given value is not nil.
+func (_u *ItemUpdate) SetNillableParentID(v *string) *ItemUpdate {
+	if v != nil {
+		_u.SetParentID(*v)
+	}
+	return _u
+}
+
+// ClearParentID clears the value of the "parent_id" field.
+func (_u *ItemUpdate) ClearParentID() *ItemUpdate {
+	_u.mutation.ClearParentID()
+	return _u
+}
+
+// SetSeasonID sets the "season_id" field.
+func (_u *ItemUpdate) SetSeasonID(v string) *ItemUpdate {
+	_u.mutation.SetSeasonID(v)
+	return _u
+}
+
+// SetNillableSeasonID sets the "season_id" field if the given value is not nil.
+func (_u *ItemUpdate) SetNillableSeasonID(v *string) *ItemUpdate {
+	if v != nil {
+		_u.SetSeasonID(*v)
+	}
+	return _u
+}
+
+// ClearSeasonID clears the value of the "season_id" field.
+func (_u *ItemUpdate) ClearSeasonID() *ItemUpdate {
+	_u.mutation.ClearSeasonID()
+	return _u
+}
+
+// SetSeriesID sets the "series_id" field.
+func (_u *ItemUpdate) SetSeriesID(v string) *ItemUpdate {
+	_u.mutation.SetSeriesID(v)
+	return _u
+}
+
+// SetNillableSeriesID sets the "series_id" field if the given value is not nil.
+func (_u *ItemUpdate) SetNillableSeriesID(v *string) *ItemUpdate {
+	if v != nil {
+		_u.SetSeriesID(*v)
+	}
+	return _u
+}
+
+// ClearSeriesID clears the value of the "series_id" field.
+func (_u *ItemUpdate) ClearSeriesID() *ItemUpdate {
+	_u.mutation.ClearSeriesID()
+	return _u
+}
+
+// SetTmdbID sets the "tmdb_id" field.
+func (_u *ItemUpdate) SetTmdbID(v string) *ItemUpdate {
+	_u.mutation.SetTmdbID(v)
+	return _u
+}
+
+// SetNillableTmdbID sets the "tmdb_id" field if the given value is not nil.
+func (_u *ItemUpdate) SetNillableTmdbID(v *string) *ItemUpdate {
+	if v != nil {
+		_u.SetTmdbID(*v)
+	}
+	return _u
+}
+
+// ClearTmdbID clears the value of the "tmdb_id" field.
+func (_u *ItemUpdate) ClearTmdbID() *ItemUpdate {
+	_u.mutation.ClearTmdbID()
+	return _u
+}
+
+// SetImdbID sets the "imdb_id" field.
+func (_u *ItemUpdate) SetImdbID(v string) *ItemUpdate {
+	_u.mutation.SetImdbID(v)
+	return _u
+}
+
+// SetNillableImdbID sets the "imdb_id" field if the given value is not nil.
+func (_u *ItemUpdate) SetNillableImdbID(v *string) *ItemUpdate {
+	if v != nil {
+		_u.SetImdbID(*v)
+	}
+	return _u
+}
+
+// ClearImdbID clears the value of the "imdb_id" field.
+func (_u *ItemUpdate) ClearImdbID() *ItemUpdate {
+	_u.mutation.ClearImdbID()
+	return _u
+}
+
+// SetTvdbID sets the "tvdb_id" field.
+func (_u *ItemUpdate) SetTvdbID(v string) *ItemUpdate {
+	_u.mutation.SetTvdbID(v)
+	return _u
+}
+
+// SetNillableTvdbID sets the "tvdb_id" field if the given value is not nil.
+func (_u *ItemUpdate) SetNillableTvdbID(v *string) *ItemUpdate {
+	if v != nil {
+		_u.SetTvdbID(*v)
+	}
+	return _u
+}
+
+// ClearTvdbID clears the value of the "tvdb_id" field.
+func (_u *ItemUpdate) ClearTvdbID() *ItemUpdate {
+	_u.mutation.ClearTvdbID()
+	return _u
+}
+
+// SetGenres sets the "genres" field.
+func (_u *ItemUpdate) SetGenres(v string) *ItemUpdate {
+	_u.mutation.SetGenres(v)
+	return _u
+}
+
+// SetNillableGenres sets the "genres" field if the given value is not nil.
+func (_u *ItemUpdate) SetNillableGenres(v *string) *ItemUpdate {
+	if v != nil {
+		_u.SetGenres(*v)
+	}
+	return _u
+}
+
+// ClearGenres clears the value of the "genres" field.
+func (_u *ItemUpdate) ClearGenres() *ItemUpdate {
+	_u.mutation.ClearGenres()
+	return _u
+}
+
+// SetTags sets the "tags" field.
+func (_u *ItemUpdate) SetTags(v string) *ItemUpdate {
+	_u.mutation.SetTags(v)
+	return _u
+}
+
+// SetNillableTags sets the "tags" field if the given value is not nil.
+func (_u *ItemUpdate) SetNillableTags(v *string) *ItemUpdate {
+	if v != nil {
+		_u.SetTags(*v)
+	}
+	return _u
+}
+
+// ClearTags clears the value of the "tags" field.
+func (_u *ItemUpdate) ClearTags() *ItemUpdate {
+	_u.mutation.ClearTags()
+	return _u
+}
+
+// SetOfficialRating sets the "official_rating" field.
+func (_u *ItemUpdate) SetOfficialRating(v string) *ItemUpdate {
+	_u.mutation.SetOfficialRating(v)
+	return _u
+}
+
+// SetNillableOfficialRating sets the "official_rating" field if the given value is not nil.
+func (_u *ItemUpdate) SetNillableOfficialRating(v *string) *ItemUpdate {
+	if v != nil {
+		_u.SetOfficialRating(*v)
+	}
+	return _u
+}
+
+// ClearOfficialRating clears the value of the "official_rating" field.
+func (_u *ItemUpdate) ClearOfficialRating() *ItemUpdate {
+	_u.mutation.ClearOfficialRating()
+	return _u
+}
+
+// SetProductionYear sets the "production_year" field.
+func (_u *ItemUpdate) SetProductionYear(v int) *ItemUpdate {
+	_u.mutation.ResetProductionYear()
+	_u.mutation.SetProductionYear(v)
+	return _u
+}
+
+// SetNillableProductionYear sets the "production_year" field if the given value is not nil.
+func (_u *ItemUpdate) SetNillableProductionYear(v *int) *ItemUpdate {
+	if v != nil {
+		_u.SetProductionYear(*v)
+	}
+	return _u
+}
+
+// AddProductionYear adds value to the "production_year" field.
+func (_u *ItemUpdate) AddProductionYear(v int) *ItemUpdate {
+	_u.mutation.AddProductionYear(v)
+	return _u
+}
+
+// ClearProductionYear clears the value of the "production_year" field.
+func (_u *ItemUpdate) ClearProductionYear() *ItemUpdate {
+	_u.mutation.ClearProductionYear()
+	return _u
+}
+
+// SetCommunityRating sets the "community_rating" field.
+func (_u *ItemUpdate) SetCommunityRating(v float64) *ItemUpdate {
+	_u.mutation.ResetCommunityRating()
+	_u.mutation.SetCommunityRating(v)
+	return _u
+}
+
+// SetNillableCommunityRating sets the "community_rating" field if the given value is not nil.
+func (_u *ItemUpdate) SetNillableCommunityRating(v *float64) *ItemUpdate {
+	if v != nil {
+		_u.SetCommunityRating(*v)
+	}
+	return _u
+}
+
+// AddCommunityRating adds value to the "community_rating" field.
+func (_u *ItemUpdate) AddCommunityRating(v float64) *ItemUpdate {
+	_u.mutation.AddCommunityRating(v)
+	return _u
+}
+
+// ClearCommunityRating clears the value of the "community_rating" field.
+func (_u *ItemUpdate) ClearCommunityRating() *ItemUpdate {
+	_u.mutation.ClearCommunityRating()
+	return _u
+}
+
+// SetPremiereDate sets the "premiere_date" field.
+func (_u *ItemUpdate) SetPremiereDate(v time.Time) *ItemUpdate {
+	_u.mutation.SetPremiereDate(v)
+	return _u
+}
+
+// SetNillablePremiereDate sets the "premiere_date" field if the given value is not nil.
+func (_u *ItemUpdate) SetNillablePremiereDate(v *time.Time) *ItemUpdate {
+	if v != nil {
+		_u.SetPremiereDate(*v)
+	}
+	return _u
+}
+
+// ClearPremiereDate clears the value of the "premiere_date" field.
+func (_u *ItemUpdate) ClearPremiereDate() *ItemUpdate {
+	_u.mutation.ClearPremiereDate()
+	return _u
+}
+
+// SetDateCreated sets the "date_created" field.
+func (_u *ItemUpdate) SetDateCreated(v time.Time) *ItemUpdate {
+	_u.mutation.SetDateCreated(v)
+	return _u
+}
+
+// SetNillableDateCreated sets the "date_created" field if the given value is not nil.
+func (_u *ItemUpdate) SetNillableDateCreated(v *time.Time) *ItemUpdate {
+	if v != nil {
+		_u.SetDateCreated(*v)
+	}
+	return _u
+}
+
+// ClearDateCreated clears the value of the "date_created" field.
+func (_u *ItemUpdate) ClearDateCreated() *ItemUpdate {
+	_u.mutation.ClearDateCreated()
+	return _u
+}
+
+// SetData sets the "data" field.
+func (_u *ItemUpdate) SetData(v []byte) *ItemUpdate {
+	_u.mutation.SetData(v)
+	return _u
+}
+
+// SetIndexedAt sets the "indexed_at" field.
+func (_u *ItemUpdate) SetIndexedAt(v time.Time) *ItemUpdate {
+	_u.mutation.SetIndexedAt(v)
+	return _u
+}
+
+// SetNillableIndexedAt sets the "indexed_at" field if the given value is not nil.
+func (_u *ItemUpdate) SetNillableIndexedAt(v *time.Time) *ItemUpdate {
+	if v != nil {
+		_u.SetIndexedAt(*v)
+	}
+	return _u
+}
+
+// SetBackendID sets the "backend" edge to the Backend entity by ID.
+func (_u *ItemUpdate) SetBackendID(id uuid.UUID) *ItemUpdate {
+	_u.mutation.SetBackendID(id)
+	return _u
+}
+
+// SetBackend sets the "backend" edge to the Backend entity.
+func (_u *ItemUpdate) SetBackend(v *Backend) *ItemUpdate {
+	return _u.SetBackendID(v.ID)
+}
+
+// Mutation returns the ItemMutation object of the builder.
+func (_u *ItemUpdate) Mutation() *ItemMutation {
+	return _u.mutation
+}
+
+// ClearBackend clears the "backend" edge to the Backend entity.
+func (_u *ItemUpdate) ClearBackend() *ItemUpdate {
+	_u.mutation.ClearBackend()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *ItemUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ItemUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *ItemUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ItemUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ItemUpdate) check() error {
+	if v, ok := _u.mutation.BackendItemID(); ok {
+		if err := item.BackendItemIDValidator(v); err != nil {
+			return &ValidationError{Name: "backend_item_id", err: fmt.Errorf(`ent: validator failed for field "Item.backend_item_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ItemType(); ok {
+		if err := item.ItemTypeValidator(v); err != nil {
+			return &ValidationError{Name: "item_type", err: fmt.Errorf(`ent: validator failed for field "Item.item_type": %w`, err)}
+		}
+	}
+	if _u.mutation.BackendCleared() && len(_u.mutation.BackendIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Item.backend"`)
+	}
+	return nil
+}
+
+func (_u *ItemUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(item.Table, item.Columns, sqlgraph.NewFieldSpec(item.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.BackendItemID(); ok {
+		_spec.SetField(item.FieldBackendItemID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(item.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.SortName(); ok {
+		_spec.SetField(item.FieldSortName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ItemType(); ok {
+		_spec.SetField(item.FieldItemType, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.MediaType(); ok {
+		_spec.SetField(item.FieldMediaType, field.TypeString, value)
+	}
+	if _u.mutation.MediaTypeCleared() {
+		_spec.ClearField(item.FieldMediaType, field.TypeString)
+	}
+	if value, ok := _u.mutation.LibraryID(); ok {
+		_spec.SetField(item.FieldLibraryID, field.TypeString, value)
+	}
+	if _u.mutation.LibraryIDCleared() {
+		_spec.ClearField(item.FieldLibraryID, field.TypeString)
+	}
+	if value, ok := _u.mutation.CollectionType(); ok {
+		_spec.SetField(item.FieldCollectionType, field.TypeString, value)
+	}
+	if _u.mutation.CollectionTypeCleared() {
+		_spec.ClearField(item.FieldCollectionType, field.TypeString)
+	}
+	if value, ok := _u.mutation.ParentID(); ok {
+		_spec.SetField(item.FieldParentID, field.TypeString, value)
+	}
+	if _u.mutation.ParentIDCleared() {
+		_spec.ClearField(item.FieldParentID, field.TypeString)
+	}
+	if value, ok := _u.mutation.SeasonID(); ok {
+		_spec.SetField(item.FieldSeasonID, field.TypeString, value)
+	}
+	if _u.mutation.SeasonIDCleared() {
+		_spec.ClearField(item.FieldSeasonID, field.TypeString)
+	}
+	if value, ok := _u.mutation.SeriesID(); ok {
+		_spec.SetField(item.FieldSeriesID, field.TypeString, value)
+	}
+	if _u.mutation.SeriesIDCleared() {
+		_spec.ClearField(item.FieldSeriesID, field.TypeString)
+	}
+	if value, ok := _u.mutation.TmdbID(); ok {
+		_spec.SetField(item.FieldTmdbID, field.TypeString, value)
+	}
+	if _u.mutation.TmdbIDCleared() {
+		_spec.ClearField(item.FieldTmdbID, field.TypeString)
+	}
+	if value, ok := _u.mutation.ImdbID(); ok {
+		_spec.SetField(item.FieldImdbID, field.TypeString, value)
+	}
+	if _u.mutation.ImdbIDCleared() {
+		_spec.ClearField(item.FieldImdbID, field.TypeString)
+	}
+	if value, ok := _u.mutation.TvdbID(); ok {
+		_spec.SetField(item.FieldTvdbID, field.TypeString, value)
+	}
+	if _u.mutation.TvdbIDCleared() {
+		_spec.ClearField(item.FieldTvdbID, field.TypeString)
+	}
+	if value, ok := _u.mutation.Genres(); ok {
+		_spec.SetField(item.FieldGenres, field.TypeString, value)
+	}
+	if _u.mutation.GenresCleared() {
+		_spec.ClearField(item.FieldGenres, field.TypeString)
+	}
+	if value, ok := _u.mutation.Tags(); ok {
+		_spec.SetField(item.FieldTags, field.TypeString, value)
+	}
+	if _u.mutation.TagsCleared() {
+		_spec.ClearField(item.FieldTags, field.TypeString)
+	}
+	if value, ok := _u.mutation.OfficialRating(); ok {
+		_spec.SetField(item.FieldOfficialRating, field.TypeString, value)
+	}
+	if _u.mutation.OfficialRatingCleared() {
+		_spec.ClearField(item.FieldOfficialRating, field.TypeString)
+	}
+	if value, ok := _u.mutation.ProductionYear(); ok {
+		_spec.SetField(item.FieldProductionYear, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedProductionYear(); ok {
+		_spec.AddField(item.FieldProductionYear, field.TypeInt, value)
+	}
+	if _u.mutation.ProductionYearCleared() {
+		_spec.ClearField(item.FieldProductionYear, field.TypeInt)
+	}
+	if value, ok := _u.mutation.CommunityRating(); ok {
+		_spec.SetField(item.FieldCommunityRating, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedCommunityRating(); ok {
+		_spec.AddField(item.FieldCommunityRating, field.TypeFloat64, value)
+	}
+	if _u.mutation.CommunityRatingCleared() {
+		_spec.ClearField(item.FieldCommunityRating, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.PremiereDate(); ok {
+		_spec.SetField(item.FieldPremiereDate, field.TypeTime, value)
+	}
+	if _u.mutation.PremiereDateCleared() {
+		_spec.ClearField(item.FieldPremiereDate, field.TypeTime)
+	}
+	if value, ok := _u.mutation.DateCreated(); ok {
+		_spec.SetField(item.FieldDateCreated, field.TypeTime, value)
+	}
+	if _u.mutation.DateCreatedCleared() {
+		_spec.ClearField(item.FieldDateCreated, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Data(); ok {
+		_spec.SetField(item.FieldData, field.TypeBytes, value)
+	}
+	if value, ok := _u.mutation.IndexedAt(); ok {
+		_spec.SetField(item.FieldIndexedAt, field.TypeTime, value)
+	}
+	if _u.mutation.BackendCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   item.BackendTable,
+			Columns: []string{item.BackendColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(backend.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.BackendIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   item.BackendTable,
+			Columns: []string{item.BackendColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(backend.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{item.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// ItemUpdateOne is the builder for updating a single Item entity.
+type ItemUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ItemMutation
+}
+
+// SetBackendItemID sets the "backend_item_id" field.
+func (_u *ItemUpdateOne) SetBackendItemID(v string) *ItemUpdateOne {
+	_u.mutation.SetBackendItemID(v)
+	return _u
+}
+
+// SetNillableBackendItemID sets the "backend_item_id" field if the given value is not nil.
+func (_u *ItemUpdateOne) SetNillableBackendItemID(v *string) *ItemUpdateOne {
+	if v != nil {
+		_u.SetBackendItemID(*v)
+	}
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *ItemUpdateOne) SetName(v string) *ItemUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *ItemUpdateOne) SetNillableName(v *string) *ItemUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetSortName sets the "sort_name" field.
+func (_u *ItemUpdateOne) SetSortName(v string) *ItemUpdateOne {
+	_u.mutation.SetSortName(v)
+	return _u
+}
+
+// SetNillableSortName sets the "sort_name" field if the given value is not nil.
+func (_u *ItemUpdateOne) SetNillableSortName(v *string) *ItemUpdateOne {
+	if v != nil {
+		_u.SetSortName(*v)
+	}
+	return _u
+}
+
+// SetItemType sets the "item_type" field.
+func (_u *ItemUpdateOne) SetItemType(v string) *ItemUpdateOne {
+	_u.mutation.SetItemType(v)
+	return _u
+}
+
+// SetNillableItemType sets the "item_type" field if the given value is not nil.
+func (_u *ItemUpdateOne) SetNillableItemType(v *string) *ItemUpdateOne {
+	if v != nil {
+		_u.SetItemType(*v)
+	}
+	return _u
+}
+
+// SetMediaType sets the "media_type" field.
+func (_u *ItemUpdateOne) SetMediaType(v string) *ItemUpdateOne {
+	_u.mutation.SetMediaType(v)
+	return _u
+}
+
+// SetNillableMediaType sets the "media_type" field if the given value is not nil.
+func (_u *ItemUpdateOne) SetNillableMediaType(v *string) *ItemUpdateOne {
+	if v != nil {
+		_u.SetMediaType(*v)
+	}
+	return _u
+}
+
+// ClearMediaType clears the value of the "media_type" field.
+func (_u *ItemUpdateOne) ClearMediaType() *ItemUpdateOne {
+	_u.mutation.ClearMediaType()
+	return _u
+}
+
+// SetLibraryID sets the "library_id" field.
+func (_u *ItemUpdateOne) SetLibraryID(v string) *ItemUpdateOne {
+	_u.mutation.SetLibraryID(v)
+	return _u
+}
+
+// SetNillableLibraryID sets the "library_id" field if the given value is not nil.
+func (_u *ItemUpdateOne) SetNillableLibraryID(v *string) *ItemUpdateOne {
+	if v != nil {
+		_u.SetLibraryID(*v)
+	}
+	return _u
+}
+
+// ClearLibraryID clears the value of the "library_id" field.
+func (_u *ItemUpdateOne) ClearLibraryID() *ItemUpdateOne {
+	_u.mutation.ClearLibraryID()
+	return _u
+}
+
+// SetCollectionType sets the "collection_type" field.
+func (_u *ItemUpdateOne) SetCollectionType(v string) *ItemUpdateOne {
+	_u.mutation.SetCollectionType(v)
+	return _u
+}
+
+// SetNillableCollectionType sets the "collection_type" field if the given value is not nil.
+func (_u *ItemUpdateOne) SetNillableCollectionType(v *string) *ItemUpdateOne {
+	if v != nil {
+		_u.SetCollectionType(*v)
+	}
+	return _u
+}
+
+// ClearCollectionType clears the value of the "collection_type" field.
+func (_u *ItemUpdateOne) ClearCollectionType() *ItemUpdateOne {
+	_u.mutation.ClearCollectionType()
+	return _u
+}
+
+// SetParentID sets the "parent_id" field.
+func (_u *ItemUpdateOne) SetParentID(v string) *ItemUpdateOne {
+	_u.mutation.SetParentID(v)
+	return _u
+}
+
+// SetNillableParentID sets the "parent_id" field if the given value is not nil.
+func (_u *ItemUpdateOne) SetNillableParentID(v *string) *ItemUpdateOne {
+	if v != nil {
+		_u.SetParentID(*v)
+	}
+	return _u
+}
+
+// ClearParentID clears the value of the "parent_id" field.
+func (_u *ItemUpdateOne) ClearParentID() *ItemUpdateOne {
+	_u.mutation.ClearParentID()
+	return _u
+}
+
+// SetSeasonID sets the "season_id" field.
+func (_u *ItemUpdateOne) SetSeasonID(v string) *ItemUpdateOne {
+	_u.mutation.SetSeasonID(v)
+	return _u
+}
+
+// SetNillableSeasonID sets the "season_id" field if the given value is not nil.
+func (_u *ItemUpdateOne) SetNillableSeasonID(v *string) *ItemUpdateOne {
+	if v != nil {
+		_u.SetSeasonID(*v)
+	}
+	return _u
+}
+
+// ClearSeasonID clears the value of the "season_id" field.
+func (_u *ItemUpdateOne) ClearSeasonID() *ItemUpdateOne {
+	_u.mutation.ClearSeasonID()
+	return _u
+}
+
+// SetSeriesID sets the "series_id" field.
+func (_u *ItemUpdateOne) SetSeriesID(v string) *ItemUpdateOne {
+	_u.mutation.SetSeriesID(v)
+	return _u
+}
+
+// SetNillableSeriesID sets the "series_id" field if the given value is not nil.
+func (_u *ItemUpdateOne) SetNillableSeriesID(v *string) *ItemUpdateOne {
+	if v != nil {
+		_u.SetSeriesID(*v)
+	}
+	return _u
+}
+
+// ClearSeriesID clears the value of the "series_id" field.
+func (_u *ItemUpdateOne) ClearSeriesID() *ItemUpdateOne {
+	_u.mutation.ClearSeriesID()
+	return _u
+}
+
+// SetTmdbID sets the "tmdb_id" field.
+func (_u *ItemUpdateOne) SetTmdbID(v string) *ItemUpdateOne {
+	_u.mutation.SetTmdbID(v)
+	return _u
+}
+
+// SetNillableTmdbID sets the "tmdb_id" field if the given value is not nil.
+func (_u *ItemUpdateOne) SetNillableTmdbID(v *string) *ItemUpdateOne {
+	if v != nil {
+		_u.SetTmdbID(*v)
+	}
+	return _u
+}
+
+// ClearTmdbID clears the value of the "tmdb_id" field.
+func (_u *ItemUpdateOne) ClearTmdbID() *ItemUpdateOne {
+	_u.mutation.ClearTmdbID()
+	return _u
+}
+
+// SetImdbID sets the "imdb_id" field.
+func (_u *ItemUpdateOne) SetImdbID(v string) *ItemUpdateOne {
+	_u.mutation.SetImdbID(v)
+	return _u
+}
+
+// SetNillableImdbID sets the "imdb_id" field if the given value is not nil.
+func (_u *ItemUpdateOne) SetNillableImdbID(v *string) *ItemUpdateOne {
+	if v != nil {
+		_u.SetImdbID(*v)
+	}
+	return _u
+}
+
+// ClearImdbID clears the value of the "imdb_id" field.
+func (_u *ItemUpdateOne) ClearImdbID() *ItemUpdateOne {
+	_u.mutation.ClearImdbID()
+	return _u
+}
+
+// SetTvdbID sets the "tvdb_id" field.
+func (_u *ItemUpdateOne) SetTvdbID(v string) *ItemUpdateOne {
+	_u.mutation.SetTvdbID(v)
+	return _u
+}
+
+// SetNillableTvdbID sets the "tvdb_id" field if the given value is not nil.
+func (_u *ItemUpdateOne) SetNillableTvdbID(v *string) *ItemUpdateOne {
+	if v != nil {
+		_u.SetTvdbID(*v)
+	}
+	return _u
+}
+
+// ClearTvdbID clears the value of the "tvdb_id" field.
+func (_u *ItemUpdateOne) ClearTvdbID() *ItemUpdateOne {
+	_u.mutation.ClearTvdbID()
+	return _u
+}
+
+// SetGenres sets the "genres" field.
+func (_u *ItemUpdateOne) SetGenres(v string) *ItemUpdateOne {
+	_u.mutation.SetGenres(v)
+	return _u
+}
+
+// SetNillableGenres sets the "genres" field if the given value is not nil.
+func (_u *ItemUpdateOne) SetNillableGenres(v *string) *ItemUpdateOne {
+	if v != nil {
+		_u.SetGenres(*v)
+	}
+	return _u
+}
+
+// ClearGenres clears the value of the "genres" field.
+func (_u *ItemUpdateOne) ClearGenres() *ItemUpdateOne {
+	_u.mutation.ClearGenres()
+	return _u
+}
+
+// SetTags sets the "tags" field.
+func (_u *ItemUpdateOne) SetTags(v string) *ItemUpdateOne {
+	_u.mutation.SetTags(v)
+	return _u
+}
+
+// SetNillableTags sets the "tags" field if the given value is not nil.
+func (_u *ItemUpdateOne) SetNillableTags(v *string) *ItemUpdateOne {
+	if v != nil {
+		_u.SetTags(*v)
+	}
+	return _u
+}
+
+// ClearTags clears the value of the "tags" field.
+func (_u *ItemUpdateOne) ClearTags() *ItemUpdateOne {
+	_u.mutation.ClearTags()
+	return _u
+}
+
+// SetOfficialRating sets the "official_rating" field.
+func (_u *ItemUpdateOne) SetOfficialRating(v string) *ItemUpdateOne {
+	_u.mutation.SetOfficialRating(v)
+	return _u
+}
+
+// SetNillableOfficialRating sets the "official_rating" field if the given value is not nil.
+func (_u *ItemUpdateOne) SetNillableOfficialRating(v *string) *ItemUpdateOne {
+	if v != nil {
+		_u.SetOfficialRating(*v)
+	}
+	return _u
+}
+
+// ClearOfficialRating clears the value of the "official_rating" field.
+func (_u *ItemUpdateOne) ClearOfficialRating() *ItemUpdateOne {
+	_u.mutation.ClearOfficialRating()
+	return _u
+}
+
+// SetProductionYear sets the "production_year" field.
+func (_u *ItemUpdateOne) SetProductionYear(v int) *ItemUpdateOne {
+	_u.mutation.ResetProductionYear()
+	_u.mutation.SetProductionYear(v)
+	return _u
+}
+
+// SetNillableProductionYear sets the "production_year" field if the given value is not nil.
+func (_u *ItemUpdateOne) SetNillableProductionYear(v *int) *ItemUpdateOne {
+	if v != nil {
+		_u.SetProductionYear(*v)
+	}
+	return _u
+}
+
+// AddProductionYear adds value to the "production_year" field.
+func (_u *ItemUpdateOne) AddProductionYear(v int) *ItemUpdateOne {
+	_u.mutation.AddProductionYear(v)
+	return _u
+}
+
+// ClearProductionYear clears the value of the "production_year" field.
+func (_u *ItemUpdateOne) ClearProductionYear() *ItemUpdateOne {
+	_u.mutation.ClearProductionYear()
+	return _u
+}
+
+// SetCommunityRating sets the "community_rating" field.
+func (_u *ItemUpdateOne) SetCommunityRating(v float64) *ItemUpdateOne {
+	_u.mutation.ResetCommunityRating()
+	_u.mutation.SetCommunityRating(v)
+	return _u
+}
+
+// SetNillableCommunityRating sets the "community_rating" field if the given value is not nil.
+func (_u *ItemUpdateOne) SetNillableCommunityRating(v *float64) *ItemUpdateOne {
+	if v != nil {
+		_u.SetCommunityRating(*v)
+	}
+	return _u
+}
+
+// AddCommunityRating adds value to the "community_rating" field.
+func (_u *ItemUpdateOne) AddCommunityRating(v float64) *ItemUpdateOne {
+	_u.mutation.AddCommunityRating(v)
+	return _u
+}
+
+// ClearCommunityRating clears the value of the "community_rating" field.
+func (_u *ItemUpdateOne) ClearCommunityRating() *ItemUpdateOne {
+	_u.mutation.ClearCommunityRating()
+	return _u
+}
+
+// SetPremiereDate sets the "premiere_date" field.
+func (_u *ItemUpdateOne) SetPremiereDate(v time.Time) *ItemUpdateOne {
+	_u.mutation.SetPremiereDate(v)
+	return _u
+}
+
+// SetNillablePremiereDate sets the "premiere_date" field if the given value is not nil.
+func (_u *ItemUpdateOne) SetNillablePremiereDate(v *time.Time) *ItemUpdateOne {
+	if v != nil {
+		_u.SetPremiereDate(*v)
+	}
+	return _u
+}
+
+// ClearPremiereDate clears the value of the "premiere_date" field.
+func (_u *ItemUpdateOne) ClearPremiereDate() *ItemUpdateOne {
+	_u.mutation.ClearPremiereDate()
+	return _u
+}
+
+// SetDateCreated sets the "date_created" field.
+func (_u *ItemUpdateOne) SetDateCreated(v time.Time) *ItemUpdateOne {
+	_u.mutation.SetDateCreated(v)
+	return _u
+}
+
+// SetNillableDateCreated sets the "date_created" field if the given value is not nil.
+func (_u *ItemUpdateOne) SetNillableDateCreated(v *time.Time) *ItemUpdateOne {
+	if v != nil {
+		_u.SetDateCreated(*v)
+	}
+	return _u
+}
+
+// ClearDateCreated clears the value of the "date_created" field.
+func (_u *ItemUpdateOne) ClearDateCreated() *ItemUpdateOne {
+	_u.mutation.ClearDateCreated()
+	return _u
+}
+
+// SetData sets the "data" field.
+func (_u *ItemUpdateOne) SetData(v []byte) *ItemUpdateOne {
+	_u.mutation.SetData(v)
+	return _u
+}
+
+// SetIndexedAt sets the "indexed_at" field.
+func (_u *ItemUpdateOne) SetIndexedAt(v time.Time) *ItemUpdateOne {
+	_u.mutation.SetIndexedAt(v)
+	return _u
+}
+
+// SetNillableIndexedAt sets the "indexed_at" field if the given value is not nil.
+func (_u *ItemUpdateOne) SetNillableIndexedAt(v *time.Time) *ItemUpdateOne {
+	if v != nil {
+		_u.SetIndexedAt(*v)
+	}
+	return _u
+}
+
+// SetBackendID sets the "backend" edge to the Backend entity by ID.
+func (_u *ItemUpdateOne) SetBackendID(id uuid.UUID) *ItemUpdateOne {
+	_u.mutation.SetBackendID(id)
+	return _u
+}
+
+// SetBackend sets the "backend" edge to the Backend entity.
+func (_u *ItemUpdateOne) SetBackend(v *Backend) *ItemUpdateOne {
+	return _u.SetBackendID(v.ID)
+}
+
+// Mutation returns the ItemMutation object of the builder.
+func (_u *ItemUpdateOne) Mutation() *ItemMutation {
+	return _u.mutation
+}
+
+// ClearBackend clears the "backend" edge to the Backend entity.
+func (_u *ItemUpdateOne) ClearBackend() *ItemUpdateOne {
+	_u.mutation.ClearBackend()
+	return _u
+}
+
+// Where appends a list predicates to the ItemUpdate builder.
+func (_u *ItemUpdateOne) Where(ps ...predicate.Item) *ItemUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *ItemUpdateOne) Select(field string, fields ...string) *ItemUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated Item entity.
+func (_u *ItemUpdateOne) Save(ctx context.Context) (*Item, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ItemUpdateOne) SaveX(ctx context.Context) *Item {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *ItemUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ItemUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ItemUpdateOne) check() error {
+	if v, ok := _u.mutation.BackendItemID(); ok {
+		if err := item.BackendItemIDValidator(v); err != nil {
+			return &ValidationError{Name: "backend_item_id", err: fmt.Errorf(`ent: validator failed for field "Item.backend_item_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ItemType(); ok {
+		if err := item.ItemTypeValidator(v); err != nil {
+			return &ValidationError{Name: "item_type", err: fmt.Errorf(`ent: validator failed for field "Item.item_type": %w`, err)}
+		}
+	}
+	if _u.mutation.BackendCleared() && len(_u.mutation.BackendIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Item.backend"`)
+	}
+	return nil
+}
+
+func (_u *ItemUpdateOne) sqlSave(ctx context.Context) (_node *Item, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(item.Table, item.Columns, sqlgraph.NewFieldSpec(item.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Item.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, item.FieldID)
+		for _, f := range fields {
+			if !item.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != item.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.BackendItemID(); ok {
+		_spec.SetField(item.FieldBackendItemID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(item.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.SortName(); ok {
+		_spec.SetField(item.FieldSortName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ItemType(); ok {
+		_spec.SetField(item.FieldItemType, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.MediaType(); ok {
+		_spec.SetField(item.FieldMediaType, field.TypeString, value)
+	}
+	if _u.mutation.MediaTypeCleared() {
+		_spec.ClearField(item.FieldMediaType, field.TypeString)
+	}
+	if value, ok := _u.mutation.LibraryID(); ok {
+		_spec.SetField(item.FieldLibraryID, field.TypeString, value)
+	}
+	if _u.mutation.LibraryIDCleared() {
+		_spec.ClearField(item.FieldLibraryID, field.TypeString)
+	}
+	if value, ok := _u.mutation.CollectionType(); ok {
+		_spec.SetField(item.FieldCollectionType, field.TypeString, value)
+	}
+	if _u.mutation.CollectionTypeCleared() {
+		_spec.ClearField(item.FieldCollectionType, field.TypeString)
+	}
+	if value, ok := _u.mutation.ParentID(); ok {
+		_spec.SetField(item.FieldParentID, field.TypeString, value)
+	}
+	if _u.mutation.ParentIDCleared() {
+		_spec.ClearField(item.FieldParentID, field.TypeString)
+	}
+	if value, ok := _u.mutation.SeasonID(); ok {
+		_spec.SetField(item.FieldSeasonID, field.TypeString, value)
+	}
+	if _u.mutation.SeasonIDCleared() {
+		_spec.ClearField(item.FieldSeasonID, field.TypeString)
+	}
+	if value, ok := _u.mutation.SeriesID(); ok {
+		_spec.SetField(item.FieldSeriesID, field.TypeString, value)
+	}
+	if _u.mutation.SeriesIDCleared() {
+		_spec.ClearField(item.FieldSeriesID, field.TypeString)
+	}
+	if value, ok := _u.mutation.TmdbID(); ok {
+		_spec.SetField(item.FieldTmdbID, field.TypeString, value)
+	}
+	if _u.mutation.TmdbIDCleared() {
+		_spec.ClearField(item.FieldTmdbID, field.TypeString)
+	}
+	if value, ok := _u.mutation.ImdbID(); ok {
+		_spec.SetField(item.FieldImdbID, field.TypeString, value)
+	}
+	if _u.mutation.ImdbIDCleared() {
+		_spec.ClearField(item.FieldImdbID, field.TypeString)
+	}
+	if value, ok := _u.mutation.TvdbID(); ok {
+		_spec.SetField(item.FieldTvdbID, field.TypeString, value)
+	}
+	if _u.mutation.TvdbIDCleared() {
+		_spec.ClearField(item.FieldTvdbID, field.TypeString)
+	}
+	if value, ok := _u.mutation.Genres(); ok {
+		_spec.SetField(item.FieldGenres, field.TypeString, value)
+	}
+	if _u.mutation.GenresCleared() {
+		_spec.ClearField(item.FieldGenres, field.TypeString)
+	}
+	if value, ok := _u.mutation.Tags(); ok {
+		_spec.SetField(item.FieldTags, field.TypeString, value)
+	}
+	if _u.mutation.TagsCleared() {
+		_spec.ClearField(item.FieldTags, field.TypeString)
+	}
+	if value, ok := _u.mutation.OfficialRating(); ok {
+		_spec.SetField(item.FieldOfficialRating, field.TypeString, value)
+	}
+	if _u.mutation.OfficialRatingCleared() {
+		_spec.ClearField(item.FieldOfficialRating, field.TypeString)
+	}
+	if value, ok := _u.mutation.ProductionYear(); ok {
+		_spec.SetField(item.FieldProductionYear, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedProductionYear(); ok {
+		_spec.AddField(item.FieldProductionYear, field.TypeInt, value)
+	}
+	if _u.mutation.ProductionYearCleared() {
+		_spec.ClearField(item.FieldProductionYear, field.TypeInt)
+	}
+	if value, ok := _u.mutation.CommunityRating(); ok {
+		_spec.SetField(item.FieldCommunityRating, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedCommunityRating(); ok {
+		_spec.AddField(item.FieldCommunityRating, field.TypeFloat64, value)
+	}
+	if _u.mutation.CommunityRatingCleared() {
+		_spec.ClearField(item.FieldCommunityRating, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.PremiereDate(); ok {
+		_spec.SetField(item.FieldPremiereDate, field.TypeTime, value)
+	}
+	if _u.mutation.PremiereDateCleared() {
+		_spec.ClearField(item.FieldPremiereDate, field.TypeTime)
+	}
+	if value, ok := _u.mutation.DateCreated(); ok {
+		_spec.SetField(item.FieldDateCreated, field.TypeTime, value)
+	}
+	if _u.mutation.DateCreatedCleared() {
+		_spec.ClearField(item.FieldDateCreated, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Data(); ok {
+		_spec.SetField(item.FieldData, field.TypeBytes, value)
+	}
+	if value, ok := _u.mutation.IndexedAt(); ok {
+		_spec.SetField(item.FieldIndexedAt, field.TypeTime, value)
+	}
+	if _u.mutation.BackendCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   item.BackendTable,
+			Columns: []string{item.BackendColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(backend.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.BackendIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   item.BackendTable,
+			Columns: []string{item.BackendColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(backend.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Item{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{item.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
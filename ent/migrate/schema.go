@@ -8,6 +8,78 @@ import (
 )
 
 var (
+	// ActivityLogsColumns holds the columns for the "activity_logs" table.
+	ActivityLogsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "name", Type: field.TypeString},
+		{Name: "event_type", Type: field.TypeString},
+		{Name: "short_overview", Type: field.TypeString, Nullable: true},
+		{Name: "overview", Type: field.TypeString, Nullable: true},
+		{Name: "user_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "item_id", Type: field.TypeString, Nullable: true},
+		{Name: "severity", Type: field.TypeString, Default: "Information"},
+		{Name: "date", Type: field.TypeTime},
+	}
+	// ActivityLogsTable holds the schema information for the "activity_logs" table.
+	ActivityLogsTable = &schema.Table{
+		Name:       "activity_logs",
+		Columns:    ActivityLogsColumns,
+		PrimaryKey: []*schema.Column{ActivityLogsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "activitylog_date",
+				Unique:  false,
+				Columns: []*schema.Column{ActivityLogsColumns[8]},
+			},
+		},
+	}
+	// APIKeysColumns holds the columns for the "api_keys" table.
+	APIKeysColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "name", Type: field.TypeString},
+		{Name: "key_hash", Type: field.TypeString, Unique: true},
+		{Name: "expires_at", Type: field.TypeTime, Nullable: true},
+		{Name: "last_used_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "user_api_keys", Type: field.TypeUUID},
+	}
+	// APIKeysTable holds the schema information for the "api_keys" table.
+	APIKeysTable = &schema.Table{
+		Name:       "api_keys",
+		Columns:    APIKeysColumns,
+		PrimaryKey: []*schema.Column{APIKeysColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "api_keys_users_api_keys",
+				Columns:    []*schema.Column{APIKeysColumns[6]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+	}
+	// AppPasswordsColumns holds the columns for the "app_passwords" table.
+	AppPasswordsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "name", Type: field.TypeString},
+		{Name: "password_hash", Type: field.TypeString, Unique: true},
+		{Name: "last_used_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "user_app_passwords", Type: field.TypeUUID},
+	}
+	// AppPasswordsTable holds the schema information for the "app_passwords" table.
+	AppPasswordsTable = &schema.Table{
+		Name:       "app_passwords",
+		Columns:    AppPasswordsColumns,
+		PrimaryKey: []*schema.Column{AppPasswordsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "app_passwords_users_app_passwords",
+				Columns:    []*schema.Column{AppPasswordsColumns[5]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+	}
 	// BackendsColumns holds the columns for the "backends" table.
 	BackendsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID},
@@ -60,6 +132,166 @@ var (
 			},
 		},
 	}
+	// DeviceOptionsColumns holds the columns for the "device_options" table.
+	DeviceOptionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "device_id", Type: field.TypeString, Unique: true},
+		{Name: "custom_name", Type: field.TypeString, Nullable: true},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// DeviceOptionsTable holds the schema information for the "device_options" table.
+	DeviceOptionsTable = &schema.Table{
+		Name:       "device_options",
+		Columns:    DeviceOptionsColumns,
+		PrimaryKey: []*schema.Column{DeviceOptionsColumns[0]},
+	}
+	// DisplayPreferencesColumns holds the columns for the "display_preferences" table.
+	DisplayPreferencesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "user_id", Type: field.TypeUUID},
+		{Name: "prefs_id", Type: field.TypeString},
+		{Name: "client_name", Type: field.TypeString, Default: ""},
+		{Name: "data", Type: field.TypeBytes},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// DisplayPreferencesTable holds the schema information for the "display_preferences" table.
+	DisplayPreferencesTable = &schema.Table{
+		Name:       "display_preferences",
+		Columns:    DisplayPreferencesColumns,
+		PrimaryKey: []*schema.Column{DisplayPreferencesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "displaypreference_user_id_prefs_id_client_name",
+				Unique:  true,
+				Columns: []*schema.Column{DisplayPreferencesColumns[1], DisplayPreferencesColumns[2], DisplayPreferencesColumns[3]},
+			},
+		},
+	}
+	// DuplicateItemsColumns holds the columns for the "duplicate_items" table.
+	DuplicateItemsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "dup_id", Type: field.TypeString, Unique: true},
+		{Name: "copies", Type: field.TypeJSON},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// DuplicateItemsTable holds the schema information for the "duplicate_items" table.
+	DuplicateItemsTable = &schema.Table{
+		Name:       "duplicate_items",
+		Columns:    DuplicateItemsColumns,
+		PrimaryKey: []*schema.Column{DuplicateItemsColumns[0]},
+	}
+	// ItemsColumns holds the columns for the "items" table.
+	ItemsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "backend_item_id", Type: field.TypeString},
+		{Name: "name", Type: field.TypeString},
+		{Name: "sort_name", Type: field.TypeString},
+		{Name: "item_type", Type: field.TypeString},
+		{Name: "media_type", Type: field.TypeString, Nullable: true},
+		{Name: "library_id", Type: field.TypeString, Nullable: true},
+		{Name: "collection_type", Type: field.TypeString, Nullable: true},
+		{Name: "parent_id", Type: field.TypeString, Nullable: true},
+		{Name: "season_id", Type: field.TypeString, Nullable: true},
+		{Name: "series_id", Type: field.TypeString, Nullable: true},
+		{Name: "tmdb_id", Type: field.TypeString, Nullable: true},
+		{Name: "imdb_id", Type: field.TypeString, Nullable: true},
+		{Name: "tvdb_id", Type: field.TypeString, Nullable: true},
+		{Name: "genres", Type: field.TypeString, Nullable: true},
+		{Name: "tags", Type: field.TypeString, Nullable: true},
+		{Name: "official_rating", Type: field.TypeString, Nullable: true},
+		{Name: "production_year", Type: field.TypeInt, Nullable: true},
+		{Name: "community_rating", Type: field.TypeFloat64, Nullable: true},
+		{Name: "premiere_date", Type: field.TypeTime, Nullable: true},
+		{Name: "date_created", Type: field.TypeTime, Nullable: true},
+		{Name: "data", Type: field.TypeBytes},
+		{Name: "indexed_at", Type: field.TypeTime},
+		{Name: "backend_items", Type: field.TypeUUID},
+	}
+	// ItemsTable holds the schema information for the "items" table.
+	ItemsTable = &schema.Table{
+		Name:       "items",
+		Columns:    ItemsColumns,
+		PrimaryKey: []*schema.Column{ItemsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "items_backends_items",
+				Columns:    []*schema.Column{ItemsColumns[23]},
+				RefColumns: []*schema.Column{BackendsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "item_backend_item_id_backend_items",
+				Unique:  true,
+				Columns: []*schema.Column{ItemsColumns[1], ItemsColumns[23]},
+			},
+			{
+				Name:    "item_item_type",
+				Unique:  false,
+				Columns: []*schema.Column{ItemsColumns[4]},
+			},
+		},
+	}
+	// PlaybackEventsColumns holds the columns for the "playback_events" table.
+	PlaybackEventsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "user_id", Type: field.TypeUUID},
+		{Name: "username", Type: field.TypeString},
+		{Name: "session_id", Type: field.TypeUUID},
+		{Name: "device_id", Type: field.TypeString},
+		{Name: "device_name", Type: field.TypeString},
+		{Name: "app_name", Type: field.TypeString},
+		{Name: "item_id", Type: field.TypeString},
+		{Name: "item_name", Type: field.TypeString, Nullable: true},
+		{Name: "item_type", Type: field.TypeString, Nullable: true},
+		{Name: "backend_id", Type: field.TypeUUID},
+		{Name: "backend_name", Type: field.TypeString},
+		{Name: "play_method", Type: field.TypeString, Nullable: true},
+		{Name: "started_at", Type: field.TypeTime},
+		{Name: "stopped_at", Type: field.TypeTime, Nullable: true},
+		{Name: "position_ticks", Type: field.TypeInt64, Default: 0},
+		{Name: "play_duration", Type: field.TypeInt64, Default: 0},
+	}
+	// PlaybackEventsTable holds the schema information for the "playback_events" table.
+	PlaybackEventsTable = &schema.Table{
+		Name:       "playback_events",
+		Columns:    PlaybackEventsColumns,
+		PrimaryKey: []*schema.Column{PlaybackEventsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "playbackevent_started_at",
+				Unique:  false,
+				Columns: []*schema.Column{PlaybackEventsColumns[13]},
+			},
+			{
+				Name:    "playbackevent_user_id",
+				Unique:  false,
+				Columns: []*schema.Column{PlaybackEventsColumns[1]},
+			},
+		},
+	}
+	// RecoveryCodesColumns holds the columns for the "recovery_codes" table.
+	RecoveryCodesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "code_hash", Type: field.TypeString},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "user_recovery_codes", Type: field.TypeUUID},
+	}
+	// RecoveryCodesTable holds the schema information for the "recovery_codes" table.
+	RecoveryCodesTable = &schema.Table{
+		Name:       "recovery_codes",
+		Columns:    RecoveryCodesColumns,
+		PrimaryKey: []*schema.Column{RecoveryCodesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "recovery_codes_users_recovery_codes",
+				Columns:    []*schema.Column{RecoveryCodesColumns[3]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+	}
 	// SessionsColumns holds the columns for the "sessions" table.
 	SessionsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID},
@@ -70,6 +302,7 @@ var (
 		{Name: "app_version", Type: field.TypeString, Nullable: true},
 		{Name: "last_activity", Type: field.TypeTime},
 		{Name: "created_at", Type: field.TypeTime},
+		{Name: "app_password_sessions", Type: field.TypeUUID, Nullable: true},
 		{Name: "user_sessions", Type: field.TypeUUID},
 	}
 	// SessionsTable holds the schema information for the "sessions" table.
@@ -79,8 +312,14 @@ var (
 		PrimaryKey: []*schema.Column{SessionsColumns[0]},
 		ForeignKeys: []*schema.ForeignKey{
 			{
-				Symbol:     "sessions_users_sessions",
+				Symbol:     "sessions_app_passwords_sessions",
 				Columns:    []*schema.Column{SessionsColumns[8]},
+				RefColumns: []*schema.Column{AppPasswordsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "sessions_users_sessions",
+				Columns:    []*schema.Column{SessionsColumns[9]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
@@ -100,10 +339,24 @@ var (
 		{Name: "display_name", Type: field.TypeString},
 		{Name: "hashed_password", Type: field.TypeString},
 		{Name: "is_admin", Type: field.TypeBool, Default: false},
+		{Name: "auth_source", Type: field.TypeString, Default: "local"},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
 		{Name: "avatar", Type: field.TypeBytes, Nullable: true},
 		{Name: "avatar_content_type", Type: field.TypeString, Nullable: true},
+		{Name: "oidc_subject", Type: field.TypeString, Unique: true, Nullable: true},
+		{Name: "access_rules", Type: field.TypeJSON, Nullable: true},
+		{Name: "is_disabled", Type: field.TypeBool, Default: false},
+		{Name: "enable_remote_access", Type: field.TypeBool, Default: true},
+		{Name: "enable_media_playback", Type: field.TypeBool, Default: true},
+		{Name: "enable_video_playback_transcoding", Type: field.TypeBool, Default: true},
+		{Name: "enable_content_downloading", Type: field.TypeBool, Default: true},
+		{Name: "remote_client_bitrate_limit", Type: field.TypeInt, Nullable: true},
+		{Name: "failed_login_count", Type: field.TypeInt, Default: 0},
+		{Name: "locked_until", Type: field.TypeTime, Nullable: true},
+		{Name: "totp_secret", Type: field.TypeString, Nullable: true},
+		{Name: "totp_enabled", Type: field.TypeBool, Default: false},
+		{Name: "totp_last_step", Type: field.TypeInt64, Default: 0},
 	}
 	// UsersTable holds the schema information for the "users" table.
 	UsersTable = &schema.Table{
@@ -111,17 +364,45 @@ var (
 		Columns:    UsersColumns,
 		PrimaryKey: []*schema.Column{UsersColumns[0]},
 	}
+	// UserConfigurationsColumns holds the columns for the "user_configurations" table.
+	UserConfigurationsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "user_id", Type: field.TypeUUID, Unique: true},
+		{Name: "data", Type: field.TypeBytes},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// UserConfigurationsTable holds the schema information for the "user_configurations" table.
+	UserConfigurationsTable = &schema.Table{
+		Name:       "user_configurations",
+		Columns:    UserConfigurationsColumns,
+		PrimaryKey: []*schema.Column{UserConfigurationsColumns[0]},
+	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
+		ActivityLogsTable,
+		APIKeysTable,
+		AppPasswordsTable,
 		BackendsTable,
 		BackendUsersTable,
+		DeviceOptionsTable,
+		DisplayPreferencesTable,
+		DuplicateItemsTable,
+		ItemsTable,
+		PlaybackEventsTable,
+		RecoveryCodesTable,
 		SessionsTable,
 		UsersTable,
+		UserConfigurationsTable,
 	}
 )
 
 func init() {
+	APIKeysTable.ForeignKeys[0].RefTable = UsersTable
+	AppPasswordsTable.ForeignKeys[0].RefTable = UsersTable
 	BackendUsersTable.ForeignKeys[0].RefTable = BackendsTable
 	BackendUsersTable.ForeignKeys[1].RefTable = UsersTable
-	SessionsTable.ForeignKeys[0].RefTable = UsersTable
+	ItemsTable.ForeignKeys[0].RefTable = BackendsTable
+	RecoveryCodesTable.ForeignKeys[0].RefTable = UsersTable
+	SessionsTable.ForeignKeys[0].RefTable = AppPasswordsTable
+	SessionsTable.ForeignKeys[1].RefTable = UsersTable
 }
@@ -11,11 +11,22 @@ import (
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
+	"github.com/ddevcap/jellyfin-proxy/access"
+	"github.com/ddevcap/jellyfin-proxy/ent/activitylog"
+	"github.com/ddevcap/jellyfin-proxy/ent/apikey"
+	"github.com/ddevcap/jellyfin-proxy/ent/apppassword"
 	"github.com/ddevcap/jellyfin-proxy/ent/backend"
 	"github.com/ddevcap/jellyfin-proxy/ent/backenduser"
+	"github.com/ddevcap/jellyfin-proxy/ent/deviceoption"
+	"github.com/ddevcap/jellyfin-proxy/ent/displaypreference"
+	"github.com/ddevcap/jellyfin-proxy/ent/duplicateitem"
+	"github.com/ddevcap/jellyfin-proxy/ent/item"
+	"github.com/ddevcap/jellyfin-proxy/ent/playbackevent"
 	"github.com/ddevcap/jellyfin-proxy/ent/predicate"
+	"github.com/ddevcap/jellyfin-proxy/ent/recoverycode"
 	"github.com/ddevcap/jellyfin-proxy/ent/session"
 	"github.com/ddevcap/jellyfin-proxy/ent/user"
+	"github.com/ddevcap/jellyfin-proxy/ent/userconfiguration"
 	"github.com/google/uuid"
 )
 
@@ -28,44 +39,53 @@ const (
 	OpUpdateOne = ent.OpUpdateOne
 
 	// Node types.
-	TypeBackend     = "Backend"
-	TypeBackendUser = "BackendUser"
-	TypeSession     = "Session"
-	TypeUser        = "User"
+	TypeActivityLog       = "ActivityLog"
+	TypeApiKey            = "ApiKey"
+	TypeAppPassword       = "AppPassword"
+	TypeBackend           = "Backend"
+	TypeBackendUser       = "BackendUser"
+	TypeDeviceOption      = "DeviceOption"
+	TypeDisplayPreference = "DisplayPreference"
+	TypeDuplicateItem     = "DuplicateItem"
+	TypeItem              = "Item"
+	TypePlaybackEvent     = "PlaybackEvent"
+	TypeRecoveryCode      = "RecoveryCode"
+	TypeSession           = "Session"
+	TypeUser              = "User"
+	TypeUserConfiguration = "UserConfiguration"
 )
 
-// BackendMutation represents an operation that mutates the Backend nodes in the graph.
-type BackendMutation struct {
+// ActivityLogMutation represents an operation that mutates the ActivityLog nodes in the graph.
+type ActivityLogMutation struct {
 	config
-	op                   Op
-	typ                  string
-	id                   *uuid.UUID
-	name                 *string
-	url                  *string
-	jellyfin_server_id   *string
-	prefix               *string
-	enabled              *bool
-	created_at           *time.Time
-	clearedFields        map[string]struct{}
-	backend_users        map[uuid.UUID]struct{}
-	removedbackend_users map[uuid.UUID]struct{}
-	clearedbackend_users bool
-	done                 bool
-	oldValue             func(context.Context) (*Backend, error)
-	predicates           []predicate.Backend
-}
-
-var _ ent.Mutation = (*BackendMutation)(nil)
-
-// backendOption allows management of the mutation configuration using functional options.
-type backendOption func(*BackendMutation)
-
-// newBackendMutation creates new mutation for the Backend entity.
-func newBackendMutation(c config, op Op, opts ...backendOption) *BackendMutation {
-	m := &BackendMutation{
+	op             Op
+	typ            string
+	id             *uuid.UUID
+	name           *string
+	event_type     *string
+	short_overview *string
+	overview       *string
+	user_id        *uuid.UUID
+	item_id        *string
+	severity       *string
+	date           *time.Time
+	clearedFields  map[string]struct{}
+	done           bool
+	oldValue       func(context.Context) (*ActivityLog, error)
+	predicates     []predicate.ActivityLog
+}
+
+var _ ent.Mutation = (*ActivityLogMutation)(nil)
+
+// activitylogOption allows management of the mutation configuration using functional options.
+type activitylogOption func(*ActivityLogMutation)
+
+// newActivityLogMutation creates new mutation for the ActivityLog entity.
+func newActivityLogMutation(c config, op Op, opts ...activitylogOption) *ActivityLogMutation {
+	m := &ActivityLogMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeBackend,
+		typ:           TypeActivityLog,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -74,20 +94,20 @@ func newBackendMutation(c config, op Op, opts ...backendOption) *BackendMutation
 	return m
 }
 
-// withBackendID sets the ID field of the mutation.
-func withBackendID(id uuid.UUID) backendOption {
-	return func(m *BackendMutation) {
+// withActivityLogID sets the ID field of the mutation.
+func withActivityLogID(id uuid.UUID) activitylogOption {
+	return func(m *ActivityLogMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Backend
+			value *ActivityLog
 		)
-		m.oldValue = func(ctx context.Context) (*Backend, error) {
+		m.oldValue = func(ctx context.Context) (*ActivityLog, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Backend.Get(ctx, id)
+					value, err = m.Client().ActivityLog.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -96,10 +116,10 @@ func withBackendID(id uuid.UUID) backendOption {
 	}
 }
 
-// withBackend sets the old Backend of the mutation.
-func withBackend(node *Backend) backendOption {
-	return func(m *BackendMutation) {
-		m.oldValue = func(context.Context) (*Backend, error) {
+// withActivityLog sets the old ActivityLog of the mutation.
+func withActivityLog(node *ActivityLog) activitylogOption {
+	return func(m *ActivityLogMutation) {
+		m.oldValue = func(context.Context) (*ActivityLog, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -108,7 +128,7 @@ func withBackend(node *Backend) backendOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m BackendMutation) Client() *Client {
+func (m ActivityLogMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -116,7 +136,7 @@ func (m BackendMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m BackendMutation) Tx() (*Tx, error) {
+func (m ActivityLogMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -126,14 +146,14 @@ func (m BackendMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Backend entities.
-func (m *BackendMutation) SetID(id uuid.UUID) {
+// operation is only accepted on creation of ActivityLog entities.
+func (m *ActivityLogMutation) SetID(id uuid.UUID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *BackendMutation) ID() (id uuid.UUID, exists bool) {
+func (m *ActivityLogMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -144,7 +164,7 @@ func (m *BackendMutation) ID() (id uuid.UUID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *BackendMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+func (m *ActivityLogMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -153,19 +173,19 @@ func (m *BackendMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Backend.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().ActivityLog.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetName sets the "name" field.
-func (m *BackendMutation) SetName(s string) {
+func (m *ActivityLogMutation) SetName(s string) {
 	m.name = &s
 }
 
 // Name returns the value of the "name" field in the mutation.
-func (m *BackendMutation) Name() (r string, exists bool) {
+func (m *ActivityLogMutation) Name() (r string, exists bool) {
 	v := m.name
 	if v == nil {
 		return
@@ -173,10 +193,10 @@ func (m *BackendMutation) Name() (r string, exists bool) {
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the Backend entity.
-// If the Backend object wasn't provided to the builder, the object is fetched from the database.
+// OldName returns the old "name" field's value of the ActivityLog entity.
+// If the ActivityLog object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *BackendMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *ActivityLogMutation) OldName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
@@ -191,253 +211,323 @@ func (m *BackendMutation) OldName(ctx context.Context) (v string, err error) {
 }
 
 // ResetName resets all changes to the "name" field.
-func (m *BackendMutation) ResetName() {
+func (m *ActivityLogMutation) ResetName() {
 	m.name = nil
 }
 
-// SetURL sets the "url" field.
-func (m *BackendMutation) SetURL(s string) {
-	m.url = &s
+// SetEventType sets the "event_type" field.
+func (m *ActivityLogMutation) SetEventType(s string) {
+	m.event_type = &s
 }
 
-// URL returns the value of the "url" field in the mutation.
-func (m *BackendMutation) URL() (r string, exists bool) {
-	v := m.url
+// EventType returns the value of the "event_type" field in the mutation.
+func (m *ActivityLogMutation) EventType() (r string, exists bool) {
+	v := m.event_type
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldURL returns the old "url" field's value of the Backend entity.
-// If the Backend object wasn't provided to the builder, the object is fetched from the database.
+// OldEventType returns the old "event_type" field's value of the ActivityLog entity.
+// If the ActivityLog object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *BackendMutation) OldURL(ctx context.Context) (v string, err error) {
+func (m *ActivityLogMutation) OldEventType(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldURL is only allowed on UpdateOne operations")
+		return v, errors.New("OldEventType is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldURL requires an ID field in the mutation")
+		return v, errors.New("OldEventType requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldURL: %w", err)
+		return v, fmt.Errorf("querying old value for OldEventType: %w", err)
 	}
-	return oldValue.URL, nil
+	return oldValue.EventType, nil
 }
 
-// ResetURL resets all changes to the "url" field.
-func (m *BackendMutation) ResetURL() {
-	m.url = nil
+// ResetEventType resets all changes to the "event_type" field.
+func (m *ActivityLogMutation) ResetEventType() {
+	m.event_type = nil
 }
 
-// SetJellyfinServerID sets the "jellyfin_server_id" field.
-func (m *BackendMutation) SetJellyfinServerID(s string) {
-	m.jellyfin_server_id = &s
+// SetShortOverview sets the "short_overview" field.
+func (m *ActivityLogMutation) SetShortOverview(s string) {
+	m.short_overview = &s
 }
 
-// JellyfinServerID returns the value of the "jellyfin_server_id" field in the mutation.
-func (m *BackendMutation) JellyfinServerID() (r string, exists bool) {
-	v := m.jellyfin_server_id
+// ShortOverview returns the value of the "short_overview" field in the mutation.
+func (m *ActivityLogMutation) ShortOverview() (r string, exists bool) {
+	v := m.short_overview
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldJellyfinServerID returns the old "jellyfin_server_id" field's value of the Backend entity.
-// If the Backend object wasn't provided to the builder, the object is fetched from the database.
+// OldShortOverview returns the old "short_overview" field's value of the ActivityLog entity.
+// If the ActivityLog object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *BackendMutation) OldJellyfinServerID(ctx context.Context) (v string, err error) {
+func (m *ActivityLogMutation) OldShortOverview(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldJellyfinServerID is only allowed on UpdateOne operations")
+		return v, errors.New("OldShortOverview is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldJellyfinServerID requires an ID field in the mutation")
+		return v, errors.New("OldShortOverview requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldJellyfinServerID: %w", err)
+		return v, fmt.Errorf("querying old value for OldShortOverview: %w", err)
 	}
-	return oldValue.JellyfinServerID, nil
+	return oldValue.ShortOverview, nil
 }
 
-// ResetJellyfinServerID resets all changes to the "jellyfin_server_id" field.
-func (m *BackendMutation) ResetJellyfinServerID() {
-	m.jellyfin_server_id = nil
+// ClearShortOverview clears the value of the "short_overview" field.
+func (m *ActivityLogMutation) ClearShortOverview() {
+	m.short_overview = nil
+	m.clearedFields[activitylog.FieldShortOverview] = struct{}{}
 }
 
-// SetPrefix sets the "prefix" field.
-func (m *BackendMutation) SetPrefix(s string) {
-	m.prefix = &s
+// ShortOverviewCleared returns if the "short_overview" field was cleared in this mutation.
+func (m *ActivityLogMutation) ShortOverviewCleared() bool {
+	_, ok := m.clearedFields[activitylog.FieldShortOverview]
+	return ok
 }
 
-// Prefix returns the value of the "prefix" field in the mutation.
-func (m *BackendMutation) Prefix() (r string, exists bool) {
-	v := m.prefix
+// ResetShortOverview resets all changes to the "short_overview" field.
+func (m *ActivityLogMutation) ResetShortOverview() {
+	m.short_overview = nil
+	delete(m.clearedFields, activitylog.FieldShortOverview)
+}
+
+// SetOverview sets the "overview" field.
+func (m *ActivityLogMutation) SetOverview(s string) {
+	m.overview = &s
+}
+
+// Overview returns the value of the "overview" field in the mutation.
+func (m *ActivityLogMutation) Overview() (r string, exists bool) {
+	v := m.overview
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldPrefix returns the old "prefix" field's value of the Backend entity.
-// If the Backend object wasn't provided to the builder, the object is fetched from the database.
+// OldOverview returns the old "overview" field's value of the ActivityLog entity.
+// If the ActivityLog object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *BackendMutation) OldPrefix(ctx context.Context) (v string, err error) {
+func (m *ActivityLogMutation) OldOverview(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPrefix is only allowed on UpdateOne operations")
+		return v, errors.New("OldOverview is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPrefix requires an ID field in the mutation")
+		return v, errors.New("OldOverview requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPrefix: %w", err)
+		return v, fmt.Errorf("querying old value for OldOverview: %w", err)
 	}
-	return oldValue.Prefix, nil
+	return oldValue.Overview, nil
 }
 
-// ResetPrefix resets all changes to the "prefix" field.
-func (m *BackendMutation) ResetPrefix() {
-	m.prefix = nil
+// ClearOverview clears the value of the "overview" field.
+func (m *ActivityLogMutation) ClearOverview() {
+	m.overview = nil
+	m.clearedFields[activitylog.FieldOverview] = struct{}{}
 }
 
-// SetEnabled sets the "enabled" field.
-func (m *BackendMutation) SetEnabled(b bool) {
-	m.enabled = &b
+// OverviewCleared returns if the "overview" field was cleared in this mutation.
+func (m *ActivityLogMutation) OverviewCleared() bool {
+	_, ok := m.clearedFields[activitylog.FieldOverview]
+	return ok
 }
 
-// Enabled returns the value of the "enabled" field in the mutation.
-func (m *BackendMutation) Enabled() (r bool, exists bool) {
-	v := m.enabled
+// ResetOverview resets all changes to the "overview" field.
+func (m *ActivityLogMutation) ResetOverview() {
+	m.overview = nil
+	delete(m.clearedFields, activitylog.FieldOverview)
+}
+
+// SetUserID sets the "user_id" field.
+func (m *ActivityLogMutation) SetUserID(u uuid.UUID) {
+	m.user_id = &u
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *ActivityLogMutation) UserID() (r uuid.UUID, exists bool) {
+	v := m.user_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldEnabled returns the old "enabled" field's value of the Backend entity.
-// If the Backend object wasn't provided to the builder, the object is fetched from the database.
+// OldUserID returns the old "user_id" field's value of the ActivityLog entity.
+// If the ActivityLog object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *BackendMutation) OldEnabled(ctx context.Context) (v bool, err error) {
+func (m *ActivityLogMutation) OldUserID(ctx context.Context) (v uuid.UUID, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldEnabled is only allowed on UpdateOne operations")
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldEnabled requires an ID field in the mutation")
+		return v, errors.New("OldUserID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldEnabled: %w", err)
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
 	}
-	return oldValue.Enabled, nil
+	return oldValue.UserID, nil
 }
 
-// ResetEnabled resets all changes to the "enabled" field.
-func (m *BackendMutation) ResetEnabled() {
-	m.enabled = nil
+// ClearUserID clears the value of the "user_id" field.
+func (m *ActivityLogMutation) ClearUserID() {
+	m.user_id = nil
+	m.clearedFields[activitylog.FieldUserID] = struct{}{}
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *BackendMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// UserIDCleared returns if the "user_id" field was cleared in this mutation.
+func (m *ActivityLogMutation) UserIDCleared() bool {
+	_, ok := m.clearedFields[activitylog.FieldUserID]
+	return ok
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *BackendMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
+// ResetUserID resets all changes to the "user_id" field.
+func (m *ActivityLogMutation) ResetUserID() {
+	m.user_id = nil
+	delete(m.clearedFields, activitylog.FieldUserID)
+}
+
+// SetItemID sets the "item_id" field.
+func (m *ActivityLogMutation) SetItemID(s string) {
+	m.item_id = &s
+}
+
+// ItemID returns the value of the "item_id" field in the mutation.
+func (m *ActivityLogMutation) ItemID() (r string, exists bool) {
+	v := m.item_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Backend entity.
-// If the Backend object wasn't provided to the builder, the object is fetched from the database.
+// OldItemID returns the old "item_id" field's value of the ActivityLog entity.
+// If the ActivityLog object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *BackendMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *ActivityLogMutation) OldItemID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldItemID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+		return v, errors.New("OldItemID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldItemID: %w", err)
 	}
-	return oldValue.CreatedAt, nil
+	return oldValue.ItemID, nil
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *BackendMutation) ResetCreatedAt() {
-	m.created_at = nil
+// ClearItemID clears the value of the "item_id" field.
+func (m *ActivityLogMutation) ClearItemID() {
+	m.item_id = nil
+	m.clearedFields[activitylog.FieldItemID] = struct{}{}
 }
 
-// AddBackendUserIDs adds the "backend_users" edge to the BackendUser entity by ids.
-func (m *BackendMutation) AddBackendUserIDs(ids ...uuid.UUID) {
-	if m.backend_users == nil {
-		m.backend_users = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		m.backend_users[ids[i]] = struct{}{}
-	}
+// ItemIDCleared returns if the "item_id" field was cleared in this mutation.
+func (m *ActivityLogMutation) ItemIDCleared() bool {
+	_, ok := m.clearedFields[activitylog.FieldItemID]
+	return ok
 }
 
-// ClearBackendUsers clears the "backend_users" edge to the BackendUser entity.
-func (m *BackendMutation) ClearBackendUsers() {
-	m.clearedbackend_users = true
+// ResetItemID resets all changes to the "item_id" field.
+func (m *ActivityLogMutation) ResetItemID() {
+	m.item_id = nil
+	delete(m.clearedFields, activitylog.FieldItemID)
 }
 
-// BackendUsersCleared reports if the "backend_users" edge to the BackendUser entity was cleared.
-func (m *BackendMutation) BackendUsersCleared() bool {
-	return m.clearedbackend_users
+// SetSeverity sets the "severity" field.
+func (m *ActivityLogMutation) SetSeverity(s string) {
+	m.severity = &s
 }
 
-// RemoveBackendUserIDs removes the "backend_users" edge to the BackendUser entity by IDs.
-func (m *BackendMutation) RemoveBackendUserIDs(ids ...uuid.UUID) {
-	if m.removedbackend_users == nil {
-		m.removedbackend_users = make(map[uuid.UUID]struct{})
+// Severity returns the value of the "severity" field in the mutation.
+func (m *ActivityLogMutation) Severity() (r string, exists bool) {
+	v := m.severity
+	if v == nil {
+		return
 	}
-	for i := range ids {
-		delete(m.backend_users, ids[i])
-		m.removedbackend_users[ids[i]] = struct{}{}
+	return *v, true
+}
+
+// OldSeverity returns the old "severity" field's value of the ActivityLog entity.
+// If the ActivityLog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ActivityLogMutation) OldSeverity(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSeverity is only allowed on UpdateOne operations")
 	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSeverity requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSeverity: %w", err)
+	}
+	return oldValue.Severity, nil
 }
 
-// RemovedBackendUsers returns the removed IDs of the "backend_users" edge to the BackendUser entity.
-func (m *BackendMutation) RemovedBackendUsersIDs() (ids []uuid.UUID) {
-	for id := range m.removedbackend_users {
-		ids = append(ids, id)
+// ResetSeverity resets all changes to the "severity" field.
+func (m *ActivityLogMutation) ResetSeverity() {
+	m.severity = nil
+}
+
+// SetDate sets the "date" field.
+func (m *ActivityLogMutation) SetDate(t time.Time) {
+	m.date = &t
+}
+
+// Date returns the value of the "date" field in the mutation.
+func (m *ActivityLogMutation) Date() (r time.Time, exists bool) {
+	v := m.date
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// BackendUsersIDs returns the "backend_users" edge IDs in the mutation.
-func (m *BackendMutation) BackendUsersIDs() (ids []uuid.UUID) {
-	for id := range m.backend_users {
-		ids = append(ids, id)
+// OldDate returns the old "date" field's value of the ActivityLog entity.
+// If the ActivityLog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ActivityLogMutation) OldDate(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDate is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDate requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDate: %w", err)
+	}
+	return oldValue.Date, nil
 }
 
-// ResetBackendUsers resets all changes to the "backend_users" edge.
-func (m *BackendMutation) ResetBackendUsers() {
-	m.backend_users = nil
-	m.clearedbackend_users = false
-	m.removedbackend_users = nil
+// ResetDate resets all changes to the "date" field.
+func (m *ActivityLogMutation) ResetDate() {
+	m.date = nil
 }
 
-// Where appends a list predicates to the BackendMutation builder.
-func (m *BackendMutation) Where(ps ...predicate.Backend) {
+// Where appends a list predicates to the ActivityLogMutation builder.
+func (m *ActivityLogMutation) Where(ps ...predicate.ActivityLog) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the BackendMutation builder. Using this method,
+// WhereP appends storage-level predicates to the ActivityLogMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *BackendMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Backend, len(ps))
+func (m *ActivityLogMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ActivityLog, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -445,42 +535,48 @@ func (m *BackendMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *BackendMutation) Op() Op {
+func (m *ActivityLogMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *BackendMutation) SetOp(op Op) {
+func (m *ActivityLogMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Backend).
-func (m *BackendMutation) Type() string {
+// Type returns the node type of this mutation (ActivityLog).
+func (m *ActivityLogMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *BackendMutation) Fields() []string {
-	fields := make([]string, 0, 6)
+func (m *ActivityLogMutation) Fields() []string {
+	fields := make([]string, 0, 8)
 	if m.name != nil {
-		fields = append(fields, backend.FieldName)
+		fields = append(fields, activitylog.FieldName)
 	}
-	if m.url != nil {
-		fields = append(fields, backend.FieldURL)
+	if m.event_type != nil {
+		fields = append(fields, activitylog.FieldEventType)
 	}
-	if m.jellyfin_server_id != nil {
-		fields = append(fields, backend.FieldJellyfinServerID)
+	if m.short_overview != nil {
+		fields = append(fields, activitylog.FieldShortOverview)
 	}
-	if m.prefix != nil {
-		fields = append(fields, backend.FieldPrefix)
+	if m.overview != nil {
+		fields = append(fields, activitylog.FieldOverview)
 	}
-	if m.enabled != nil {
-		fields = append(fields, backend.FieldEnabled)
+	if m.user_id != nil {
+		fields = append(fields, activitylog.FieldUserID)
 	}
-	if m.created_at != nil {
-		fields = append(fields, backend.FieldCreatedAt)
+	if m.item_id != nil {
+		fields = append(fields, activitylog.FieldItemID)
+	}
+	if m.severity != nil {
+		fields = append(fields, activitylog.FieldSeverity)
+	}
+	if m.date != nil {
+		fields = append(fields, activitylog.FieldDate)
 	}
 	return fields
 }
@@ -488,20 +584,24 @@ func (m *BackendMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *BackendMutation) Field(name string) (ent.Value, bool) {
+func (m *ActivityLogMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case backend.FieldName:
+	case activitylog.FieldName:
 		return m.Name()
-	case backend.FieldURL:
-		return m.URL()
-	case backend.FieldJellyfinServerID:
-		return m.JellyfinServerID()
-	case backend.FieldPrefix:
-		return m.Prefix()
-	case backend.FieldEnabled:
-		return m.Enabled()
-	case backend.FieldCreatedAt:
-		return m.CreatedAt()
+	case activitylog.FieldEventType:
+		return m.EventType()
+	case activitylog.FieldShortOverview:
+		return m.ShortOverview()
+	case activitylog.FieldOverview:
+		return m.Overview()
+	case activitylog.FieldUserID:
+		return m.UserID()
+	case activitylog.FieldItemID:
+		return m.ItemID()
+	case activitylog.FieldSeverity:
+		return m.Severity()
+	case activitylog.FieldDate:
+		return m.Date()
 	}
 	return nil, false
 }
@@ -509,256 +609,271 @@ func (m *BackendMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *BackendMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *ActivityLogMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case backend.FieldName:
+	case activitylog.FieldName:
 		return m.OldName(ctx)
-	case backend.FieldURL:
-		return m.OldURL(ctx)
-	case backend.FieldJellyfinServerID:
-		return m.OldJellyfinServerID(ctx)
-	case backend.FieldPrefix:
-		return m.OldPrefix(ctx)
-	case backend.FieldEnabled:
-		return m.OldEnabled(ctx)
-	case backend.FieldCreatedAt:
-		return m.OldCreatedAt(ctx)
-	}
-	return nil, fmt.Errorf("unknown Backend field %s", name)
+	case activitylog.FieldEventType:
+		return m.OldEventType(ctx)
+	case activitylog.FieldShortOverview:
+		return m.OldShortOverview(ctx)
+	case activitylog.FieldOverview:
+		return m.OldOverview(ctx)
+	case activitylog.FieldUserID:
+		return m.OldUserID(ctx)
+	case activitylog.FieldItemID:
+		return m.OldItemID(ctx)
+	case activitylog.FieldSeverity:
+		return m.OldSeverity(ctx)
+	case activitylog.FieldDate:
+		return m.OldDate(ctx)
+	}
+	return nil, fmt.Errorf("unknown ActivityLog field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *BackendMutation) SetField(name string, value ent.Value) error {
+func (m *ActivityLogMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case backend.FieldName:
+	case activitylog.FieldName:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetName(v)
 		return nil
-	case backend.FieldURL:
+	case activitylog.FieldEventType:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetURL(v)
+		m.SetEventType(v)
 		return nil
-	case backend.FieldJellyfinServerID:
+	case activitylog.FieldShortOverview:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetJellyfinServerID(v)
+		m.SetShortOverview(v)
 		return nil
-	case backend.FieldPrefix:
+	case activitylog.FieldOverview:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetPrefix(v)
+		m.SetOverview(v)
 		return nil
-	case backend.FieldEnabled:
-		v, ok := value.(bool)
+	case activitylog.FieldUserID:
+		v, ok := value.(uuid.UUID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetEnabled(v)
+		m.SetUserID(v)
 		return nil
-	case backend.FieldCreatedAt:
+	case activitylog.FieldItemID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetItemID(v)
+		return nil
+	case activitylog.FieldSeverity:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSeverity(v)
+		return nil
+	case activitylog.FieldDate:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCreatedAt(v)
+		m.SetDate(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Backend field %s", name)
+	return fmt.Errorf("unknown ActivityLog field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *BackendMutation) AddedFields() []string {
+func (m *ActivityLogMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *BackendMutation) AddedField(name string) (ent.Value, bool) {
+func (m *ActivityLogMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *BackendMutation) AddField(name string, value ent.Value) error {
+func (m *ActivityLogMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown Backend numeric field %s", name)
+	return fmt.Errorf("unknown ActivityLog numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *BackendMutation) ClearedFields() []string {
-	return nil
+func (m *ActivityLogMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(activitylog.FieldShortOverview) {
+		fields = append(fields, activitylog.FieldShortOverview)
+	}
+	if m.FieldCleared(activitylog.FieldOverview) {
+		fields = append(fields, activitylog.FieldOverview)
+	}
+	if m.FieldCleared(activitylog.FieldUserID) {
+		fields = append(fields, activitylog.FieldUserID)
+	}
+	if m.FieldCleared(activitylog.FieldItemID) {
+		fields = append(fields, activitylog.FieldItemID)
+	}
+	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *BackendMutation) FieldCleared(name string) bool {
+func (m *ActivityLogMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *BackendMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown Backend nullable field %s", name)
+func (m *ActivityLogMutation) ClearField(name string) error {
+	switch name {
+	case activitylog.FieldShortOverview:
+		m.ClearShortOverview()
+		return nil
+	case activitylog.FieldOverview:
+		m.ClearOverview()
+		return nil
+	case activitylog.FieldUserID:
+		m.ClearUserID()
+		return nil
+	case activitylog.FieldItemID:
+		m.ClearItemID()
+		return nil
+	}
+	return fmt.Errorf("unknown ActivityLog nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *BackendMutation) ResetField(name string) error {
+func (m *ActivityLogMutation) ResetField(name string) error {
 	switch name {
-	case backend.FieldName:
+	case activitylog.FieldName:
 		m.ResetName()
 		return nil
-	case backend.FieldURL:
-		m.ResetURL()
+	case activitylog.FieldEventType:
+		m.ResetEventType()
 		return nil
-	case backend.FieldJellyfinServerID:
-		m.ResetJellyfinServerID()
+	case activitylog.FieldShortOverview:
+		m.ResetShortOverview()
 		return nil
-	case backend.FieldPrefix:
-		m.ResetPrefix()
+	case activitylog.FieldOverview:
+		m.ResetOverview()
 		return nil
-	case backend.FieldEnabled:
-		m.ResetEnabled()
+	case activitylog.FieldUserID:
+		m.ResetUserID()
 		return nil
-	case backend.FieldCreatedAt:
-		m.ResetCreatedAt()
+	case activitylog.FieldItemID:
+		m.ResetItemID()
+		return nil
+	case activitylog.FieldSeverity:
+		m.ResetSeverity()
+		return nil
+	case activitylog.FieldDate:
+		m.ResetDate()
 		return nil
 	}
-	return fmt.Errorf("unknown Backend field %s", name)
+	return fmt.Errorf("unknown ActivityLog field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *BackendMutation) AddedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.backend_users != nil {
-		edges = append(edges, backend.EdgeBackendUsers)
-	}
+func (m *ActivityLogMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *BackendMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case backend.EdgeBackendUsers:
-		ids := make([]ent.Value, 0, len(m.backend_users))
-		for id := range m.backend_users {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *ActivityLogMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *BackendMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.removedbackend_users != nil {
-		edges = append(edges, backend.EdgeBackendUsers)
-	}
+func (m *ActivityLogMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *BackendMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case backend.EdgeBackendUsers:
-		ids := make([]ent.Value, 0, len(m.removedbackend_users))
-		for id := range m.removedbackend_users {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *ActivityLogMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *BackendMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.clearedbackend_users {
-		edges = append(edges, backend.EdgeBackendUsers)
-	}
+func (m *ActivityLogMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *BackendMutation) EdgeCleared(name string) bool {
-	switch name {
-	case backend.EdgeBackendUsers:
-		return m.clearedbackend_users
-	}
+func (m *ActivityLogMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *BackendMutation) ClearEdge(name string) error {
-	switch name {
-	}
-	return fmt.Errorf("unknown Backend unique edge %s", name)
+func (m *ActivityLogMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown ActivityLog unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *BackendMutation) ResetEdge(name string) error {
-	switch name {
-	case backend.EdgeBackendUsers:
-		m.ResetBackendUsers()
-		return nil
-	}
-	return fmt.Errorf("unknown Backend edge %s", name)
+func (m *ActivityLogMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown ActivityLog edge %s", name)
 }
 
-// BackendUserMutation represents an operation that mutates the BackendUser nodes in the graph.
-type BackendUserMutation struct {
+// ApiKeyMutation represents an operation that mutates the ApiKey nodes in the graph.
+type ApiKeyMutation struct {
 	config
-	op              Op
-	typ             string
-	id              *uuid.UUID
-	backend_user_id *string
-	backend_token   *string
-	enabled         *bool
-	clearedFields   map[string]struct{}
-	user            *uuid.UUID
-	cleareduser     bool
-	backend         *uuid.UUID
-	clearedbackend  bool
-	done            bool
-	oldValue        func(context.Context) (*BackendUser, error)
-	predicates      []predicate.BackendUser
+	op            Op
+	typ           string
+	id            *uuid.UUID
+	name          *string
+	key_hash      *string
+	expires_at    *time.Time
+	last_used_at  *time.Time
+	created_at    *time.Time
+	clearedFields map[string]struct{}
+	user          *uuid.UUID
+	cleareduser   bool
+	done          bool
+	oldValue      func(context.Context) (*ApiKey, error)
+	predicates    []predicate.ApiKey
 }
 
-var _ ent.Mutation = (*BackendUserMutation)(nil)
+var _ ent.Mutation = (*ApiKeyMutation)(nil)
 
-// backenduserOption allows management of the mutation configuration using functional options.
-type backenduserOption func(*BackendUserMutation)
+// apikeyOption allows management of the mutation configuration using functional options.
+type apikeyOption func(*ApiKeyMutation)
 
-// newBackendUserMutation creates new mutation for the BackendUser entity.
-func newBackendUserMutation(c config, op Op, opts ...backenduserOption) *BackendUserMutation {
-	m := &BackendUserMutation{
+// newApiKeyMutation creates new mutation for the ApiKey entity.
+func newApiKeyMutation(c config, op Op, opts ...apikeyOption) *ApiKeyMutation {
+	m := &ApiKeyMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeBackendUser,
+		typ:           TypeApiKey,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -767,20 +882,20 @@ func newBackendUserMutation(c config, op Op, opts ...backenduserOption) *Backend
 	return m
 }
 
-// withBackendUserID sets the ID field of the mutation.
-func withBackendUserID(id uuid.UUID) backenduserOption {
-	return func(m *BackendUserMutation) {
+// withApiKeyID sets the ID field of the mutation.
+func withApiKeyID(id uuid.UUID) apikeyOption {
+	return func(m *ApiKeyMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *BackendUser
+			value *ApiKey
 		)
-		m.oldValue = func(ctx context.Context) (*BackendUser, error) {
+		m.oldValue = func(ctx context.Context) (*ApiKey, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().BackendUser.Get(ctx, id)
+					value, err = m.Client().ApiKey.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -789,10 +904,10 @@ func withBackendUserID(id uuid.UUID) backenduserOption {
 	}
 }
 
-// withBackendUser sets the old BackendUser of the mutation.
-func withBackendUser(node *BackendUser) backenduserOption {
-	return func(m *BackendUserMutation) {
-		m.oldValue = func(context.Context) (*BackendUser, error) {
+// withApiKey sets the old ApiKey of the mutation.
+func withApiKey(node *ApiKey) apikeyOption {
+	return func(m *ApiKeyMutation) {
+		m.oldValue = func(context.Context) (*ApiKey, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -801,7 +916,7 @@ func withBackendUser(node *BackendUser) backenduserOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m BackendUserMutation) Client() *Client {
+func (m ApiKeyMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -809,7 +924,7 @@ func (m BackendUserMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m BackendUserMutation) Tx() (*Tx, error) {
+func (m ApiKeyMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -819,14 +934,14 @@ func (m BackendUserMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of BackendUser entities.
-func (m *BackendUserMutation) SetID(id uuid.UUID) {
+// operation is only accepted on creation of ApiKey entities.
+func (m *ApiKeyMutation) SetID(id uuid.UUID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *BackendUserMutation) ID() (id uuid.UUID, exists bool) {
+func (m *ApiKeyMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -837,7 +952,7 @@ func (m *BackendUserMutation) ID() (id uuid.UUID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *BackendUserMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+func (m *ApiKeyMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -846,220 +961,266 @@ func (m *BackendUserMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().BackendUser.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().ApiKey.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetBackendUserID sets the "backend_user_id" field.
-func (m *BackendUserMutation) SetBackendUserID(s string) {
-	m.backend_user_id = &s
+// SetName sets the "name" field.
+func (m *ApiKeyMutation) SetName(s string) {
+	m.name = &s
 }
 
-// BackendUserID returns the value of the "backend_user_id" field in the mutation.
-func (m *BackendUserMutation) BackendUserID() (r string, exists bool) {
-	v := m.backend_user_id
+// Name returns the value of the "name" field in the mutation.
+func (m *ApiKeyMutation) Name() (r string, exists bool) {
+	v := m.name
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldBackendUserID returns the old "backend_user_id" field's value of the BackendUser entity.
-// If the BackendUser object wasn't provided to the builder, the object is fetched from the database.
+// OldName returns the old "name" field's value of the ApiKey entity.
+// If the ApiKey object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *BackendUserMutation) OldBackendUserID(ctx context.Context) (v string, err error) {
+func (m *ApiKeyMutation) OldName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldBackendUserID is only allowed on UpdateOne operations")
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldBackendUserID requires an ID field in the mutation")
+		return v, errors.New("OldName requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldBackendUserID: %w", err)
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
 	}
-	return oldValue.BackendUserID, nil
+	return oldValue.Name, nil
 }
 
-// ResetBackendUserID resets all changes to the "backend_user_id" field.
-func (m *BackendUserMutation) ResetBackendUserID() {
-	m.backend_user_id = nil
+// ResetName resets all changes to the "name" field.
+func (m *ApiKeyMutation) ResetName() {
+	m.name = nil
 }
 
-// SetBackendToken sets the "backend_token" field.
-func (m *BackendUserMutation) SetBackendToken(s string) {
-	m.backend_token = &s
+// SetKeyHash sets the "key_hash" field.
+func (m *ApiKeyMutation) SetKeyHash(s string) {
+	m.key_hash = &s
 }
 
-// BackendToken returns the value of the "backend_token" field in the mutation.
-func (m *BackendUserMutation) BackendToken() (r string, exists bool) {
-	v := m.backend_token
+// KeyHash returns the value of the "key_hash" field in the mutation.
+func (m *ApiKeyMutation) KeyHash() (r string, exists bool) {
+	v := m.key_hash
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldBackendToken returns the old "backend_token" field's value of the BackendUser entity.
-// If the BackendUser object wasn't provided to the builder, the object is fetched from the database.
+// OldKeyHash returns the old "key_hash" field's value of the ApiKey entity.
+// If the ApiKey object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *BackendUserMutation) OldBackendToken(ctx context.Context) (v *string, err error) {
+func (m *ApiKeyMutation) OldKeyHash(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldBackendToken is only allowed on UpdateOne operations")
+		return v, errors.New("OldKeyHash is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldBackendToken requires an ID field in the mutation")
+		return v, errors.New("OldKeyHash requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldBackendToken: %w", err)
+		return v, fmt.Errorf("querying old value for OldKeyHash: %w", err)
 	}
-	return oldValue.BackendToken, nil
-}
-
-// ClearBackendToken clears the value of the "backend_token" field.
-func (m *BackendUserMutation) ClearBackendToken() {
-	m.backend_token = nil
-	m.clearedFields[backenduser.FieldBackendToken] = struct{}{}
-}
-
-// BackendTokenCleared returns if the "backend_token" field was cleared in this mutation.
-func (m *BackendUserMutation) BackendTokenCleared() bool {
-	_, ok := m.clearedFields[backenduser.FieldBackendToken]
-	return ok
+	return oldValue.KeyHash, nil
 }
 
-// ResetBackendToken resets all changes to the "backend_token" field.
-func (m *BackendUserMutation) ResetBackendToken() {
-	m.backend_token = nil
-	delete(m.clearedFields, backenduser.FieldBackendToken)
+// ResetKeyHash resets all changes to the "key_hash" field.
+func (m *ApiKeyMutation) ResetKeyHash() {
+	m.key_hash = nil
 }
 
-// SetEnabled sets the "enabled" field.
-func (m *BackendUserMutation) SetEnabled(b bool) {
-	m.enabled = &b
+// SetExpiresAt sets the "expires_at" field.
+func (m *ApiKeyMutation) SetExpiresAt(t time.Time) {
+	m.expires_at = &t
 }
 
-// Enabled returns the value of the "enabled" field in the mutation.
-func (m *BackendUserMutation) Enabled() (r bool, exists bool) {
-	v := m.enabled
+// ExpiresAt returns the value of the "expires_at" field in the mutation.
+func (m *ApiKeyMutation) ExpiresAt() (r time.Time, exists bool) {
+	v := m.expires_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldEnabled returns the old "enabled" field's value of the BackendUser entity.
-// If the BackendUser object wasn't provided to the builder, the object is fetched from the database.
+// OldExpiresAt returns the old "expires_at" field's value of the ApiKey entity.
+// If the ApiKey object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *BackendUserMutation) OldEnabled(ctx context.Context) (v bool, err error) {
+func (m *ApiKeyMutation) OldExpiresAt(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldEnabled is only allowed on UpdateOne operations")
+		return v, errors.New("OldExpiresAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldEnabled requires an ID field in the mutation")
+		return v, errors.New("OldExpiresAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldEnabled: %w", err)
+		return v, fmt.Errorf("querying old value for OldExpiresAt: %w", err)
 	}
-	return oldValue.Enabled, nil
+	return oldValue.ExpiresAt, nil
 }
 
-// ResetEnabled resets all changes to the "enabled" field.
-func (m *BackendUserMutation) ResetEnabled() {
-	m.enabled = nil
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (m *ApiKeyMutation) ClearExpiresAt() {
+	m.expires_at = nil
+	m.clearedFields[apikey.FieldExpiresAt] = struct{}{}
 }
 
-// SetUserID sets the "user" edge to the User entity by id.
-func (m *BackendUserMutation) SetUserID(id uuid.UUID) {
-	m.user = &id
+// ExpiresAtCleared returns if the "expires_at" field was cleared in this mutation.
+func (m *ApiKeyMutation) ExpiresAtCleared() bool {
+	_, ok := m.clearedFields[apikey.FieldExpiresAt]
+	return ok
 }
 
-// ClearUser clears the "user" edge to the User entity.
-func (m *BackendUserMutation) ClearUser() {
-	m.cleareduser = true
+// ResetExpiresAt resets all changes to the "expires_at" field.
+func (m *ApiKeyMutation) ResetExpiresAt() {
+	m.expires_at = nil
+	delete(m.clearedFields, apikey.FieldExpiresAt)
 }
 
-// UserCleared reports if the "user" edge to the User entity was cleared.
-func (m *BackendUserMutation) UserCleared() bool {
-	return m.cleareduser
+// SetLastUsedAt sets the "last_used_at" field.
+func (m *ApiKeyMutation) SetLastUsedAt(t time.Time) {
+	m.last_used_at = &t
 }
 
-// UserID returns the "user" edge ID in the mutation.
-func (m *BackendUserMutation) UserID() (id uuid.UUID, exists bool) {
-	if m.user != nil {
-		return *m.user, true
+// LastUsedAt returns the value of the "last_used_at" field in the mutation.
+func (m *ApiKeyMutation) LastUsedAt() (r time.Time, exists bool) {
+	v := m.last_used_at
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// UserIDs returns the "user" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// UserID instead. It exists only for internal usage by the builders.
-func (m *BackendUserMutation) UserIDs() (ids []uuid.UUID) {
-	if id := m.user; id != nil {
-		ids = append(ids, *id)
+// OldLastUsedAt returns the old "last_used_at" field's value of the ApiKey entity.
+// If the ApiKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ApiKeyMutation) OldLastUsedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastUsedAt is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastUsedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastUsedAt: %w", err)
+	}
+	return oldValue.LastUsedAt, nil
 }
 
-// ResetUser resets all changes to the "user" edge.
-func (m *BackendUserMutation) ResetUser() {
-	m.user = nil
-	m.cleareduser = false
+// ClearLastUsedAt clears the value of the "last_used_at" field.
+func (m *ApiKeyMutation) ClearLastUsedAt() {
+	m.last_used_at = nil
+	m.clearedFields[apikey.FieldLastUsedAt] = struct{}{}
 }
 
-// SetBackendID sets the "backend" edge to the Backend entity by id.
-func (m *BackendUserMutation) SetBackendID(id uuid.UUID) {
-	m.backend = &id
+// LastUsedAtCleared returns if the "last_used_at" field was cleared in this mutation.
+func (m *ApiKeyMutation) LastUsedAtCleared() bool {
+	_, ok := m.clearedFields[apikey.FieldLastUsedAt]
+	return ok
 }
 
-// ClearBackend clears the "backend" edge to the Backend entity.
-func (m *BackendUserMutation) ClearBackend() {
-	m.clearedbackend = true
+// ResetLastUsedAt resets all changes to the "last_used_at" field.
+func (m *ApiKeyMutation) ResetLastUsedAt() {
+	m.last_used_at = nil
+	delete(m.clearedFields, apikey.FieldLastUsedAt)
 }
 
-// BackendCleared reports if the "backend" edge to the Backend entity was cleared.
-func (m *BackendUserMutation) BackendCleared() bool {
-	return m.clearedbackend
+// SetCreatedAt sets the "created_at" field.
+func (m *ApiKeyMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
 }
 
-// BackendID returns the "backend" edge ID in the mutation.
-func (m *BackendUserMutation) BackendID() (id uuid.UUID, exists bool) {
-	if m.backend != nil {
-		return *m.backend, true
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *ApiKeyMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the ApiKey entity.
+// If the ApiKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ApiKeyMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *ApiKeyMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUserID sets the "user" edge to the User entity by id.
+func (m *ApiKeyMutation) SetUserID(id uuid.UUID) {
+	m.user = &id
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (m *ApiKeyMutation) ClearUser() {
+	m.cleareduser = true
+}
+
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *ApiKeyMutation) UserCleared() bool {
+	return m.cleareduser
+}
+
+// UserID returns the "user" edge ID in the mutation.
+func (m *ApiKeyMutation) UserID() (id uuid.UUID, exists bool) {
+	if m.user != nil {
+		return *m.user, true
 	}
 	return
 }
 
-// BackendIDs returns the "backend" edge IDs in the mutation.
+// UserIDs returns the "user" edge IDs in the mutation.
 // Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// BackendID instead. It exists only for internal usage by the builders.
-func (m *BackendUserMutation) BackendIDs() (ids []uuid.UUID) {
-	if id := m.backend; id != nil {
+// UserID instead. It exists only for internal usage by the builders.
+func (m *ApiKeyMutation) UserIDs() (ids []uuid.UUID) {
+	if id := m.user; id != nil {
 		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetBackend resets all changes to the "backend" edge.
-func (m *BackendUserMutation) ResetBackend() {
-	m.backend = nil
-	m.clearedbackend = false
+// ResetUser resets all changes to the "user" edge.
+func (m *ApiKeyMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
 }
 
-// Where appends a list predicates to the BackendUserMutation builder.
-func (m *BackendUserMutation) Where(ps ...predicate.BackendUser) {
+// Where appends a list predicates to the ApiKeyMutation builder.
+func (m *ApiKeyMutation) Where(ps ...predicate.ApiKey) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the BackendUserMutation builder. Using this method,
+// WhereP appends storage-level predicates to the ApiKeyMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *BackendUserMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.BackendUser, len(ps))
+func (m *ApiKeyMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ApiKey, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -1067,33 +1228,39 @@ func (m *BackendUserMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *BackendUserMutation) Op() Op {
+func (m *ApiKeyMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *BackendUserMutation) SetOp(op Op) {
+func (m *ApiKeyMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (BackendUser).
-func (m *BackendUserMutation) Type() string {
+// Type returns the node type of this mutation (ApiKey).
+func (m *ApiKeyMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *BackendUserMutation) Fields() []string {
-	fields := make([]string, 0, 3)
-	if m.backend_user_id != nil {
-		fields = append(fields, backenduser.FieldBackendUserID)
+func (m *ApiKeyMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.name != nil {
+		fields = append(fields, apikey.FieldName)
 	}
-	if m.backend_token != nil {
-		fields = append(fields, backenduser.FieldBackendToken)
+	if m.key_hash != nil {
+		fields = append(fields, apikey.FieldKeyHash)
 	}
-	if m.enabled != nil {
-		fields = append(fields, backenduser.FieldEnabled)
+	if m.expires_at != nil {
+		fields = append(fields, apikey.FieldExpiresAt)
+	}
+	if m.last_used_at != nil {
+		fields = append(fields, apikey.FieldLastUsedAt)
+	}
+	if m.created_at != nil {
+		fields = append(fields, apikey.FieldCreatedAt)
 	}
 	return fields
 }
@@ -1101,14 +1268,18 @@ func (m *BackendUserMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *BackendUserMutation) Field(name string) (ent.Value, bool) {
+func (m *ApiKeyMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case backenduser.FieldBackendUserID:
-		return m.BackendUserID()
-	case backenduser.FieldBackendToken:
-		return m.BackendToken()
-	case backenduser.FieldEnabled:
-		return m.Enabled()
+	case apikey.FieldName:
+		return m.Name()
+	case apikey.FieldKeyHash:
+		return m.KeyHash()
+	case apikey.FieldExpiresAt:
+		return m.ExpiresAt()
+	case apikey.FieldLastUsedAt:
+		return m.LastUsedAt()
+	case apikey.FieldCreatedAt:
+		return m.CreatedAt()
 	}
 	return nil, false
 }
@@ -1116,239 +1287,251 @@ func (m *BackendUserMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *BackendUserMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *ApiKeyMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case backenduser.FieldBackendUserID:
-		return m.OldBackendUserID(ctx)
-	case backenduser.FieldBackendToken:
-		return m.OldBackendToken(ctx)
-	case backenduser.FieldEnabled:
-		return m.OldEnabled(ctx)
+	case apikey.FieldName:
+		return m.OldName(ctx)
+	case apikey.FieldKeyHash:
+		return m.OldKeyHash(ctx)
+	case apikey.FieldExpiresAt:
+		return m.OldExpiresAt(ctx)
+	case apikey.FieldLastUsedAt:
+		return m.OldLastUsedAt(ctx)
+	case apikey.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown BackendUser field %s", name)
+	return nil, fmt.Errorf("unknown ApiKey field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *BackendUserMutation) SetField(name string, value ent.Value) error {
+func (m *ApiKeyMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case backenduser.FieldBackendUserID:
+	case apikey.FieldName:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetBackendUserID(v)
+		m.SetName(v)
 		return nil
-	case backenduser.FieldBackendToken:
+	case apikey.FieldKeyHash:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetBackendToken(v)
+		m.SetKeyHash(v)
 		return nil
-	case backenduser.FieldEnabled:
-		v, ok := value.(bool)
+	case apikey.FieldExpiresAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetEnabled(v)
+		m.SetExpiresAt(v)
+		return nil
+	case apikey.FieldLastUsedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastUsedAt(v)
+		return nil
+	case apikey.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown BackendUser field %s", name)
+	return fmt.Errorf("unknown ApiKey field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *BackendUserMutation) AddedFields() []string {
+func (m *ApiKeyMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *BackendUserMutation) AddedField(name string) (ent.Value, bool) {
+func (m *ApiKeyMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *BackendUserMutation) AddField(name string, value ent.Value) error {
+func (m *ApiKeyMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown BackendUser numeric field %s", name)
+	return fmt.Errorf("unknown ApiKey numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *BackendUserMutation) ClearedFields() []string {
+func (m *ApiKeyMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(backenduser.FieldBackendToken) {
-		fields = append(fields, backenduser.FieldBackendToken)
+	if m.FieldCleared(apikey.FieldExpiresAt) {
+		fields = append(fields, apikey.FieldExpiresAt)
+	}
+	if m.FieldCleared(apikey.FieldLastUsedAt) {
+		fields = append(fields, apikey.FieldLastUsedAt)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *BackendUserMutation) FieldCleared(name string) bool {
+func (m *ApiKeyMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *BackendUserMutation) ClearField(name string) error {
+func (m *ApiKeyMutation) ClearField(name string) error {
 	switch name {
-	case backenduser.FieldBackendToken:
-		m.ClearBackendToken()
+	case apikey.FieldExpiresAt:
+		m.ClearExpiresAt()
+		return nil
+	case apikey.FieldLastUsedAt:
+		m.ClearLastUsedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown BackendUser nullable field %s", name)
+	return fmt.Errorf("unknown ApiKey nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *BackendUserMutation) ResetField(name string) error {
+func (m *ApiKeyMutation) ResetField(name string) error {
 	switch name {
-	case backenduser.FieldBackendUserID:
-		m.ResetBackendUserID()
+	case apikey.FieldName:
+		m.ResetName()
 		return nil
-	case backenduser.FieldBackendToken:
-		m.ResetBackendToken()
+	case apikey.FieldKeyHash:
+		m.ResetKeyHash()
 		return nil
-	case backenduser.FieldEnabled:
-		m.ResetEnabled()
+	case apikey.FieldExpiresAt:
+		m.ResetExpiresAt()
+		return nil
+	case apikey.FieldLastUsedAt:
+		m.ResetLastUsedAt()
+		return nil
+	case apikey.FieldCreatedAt:
+		m.ResetCreatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown BackendUser field %s", name)
+	return fmt.Errorf("unknown ApiKey field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *BackendUserMutation) AddedEdges() []string {
-	edges := make([]string, 0, 2)
+func (m *ApiKeyMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
 	if m.user != nil {
-		edges = append(edges, backenduser.EdgeUser)
-	}
-	if m.backend != nil {
-		edges = append(edges, backenduser.EdgeBackend)
+		edges = append(edges, apikey.EdgeUser)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *BackendUserMutation) AddedIDs(name string) []ent.Value {
+func (m *ApiKeyMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case backenduser.EdgeUser:
+	case apikey.EdgeUser:
 		if id := m.user; id != nil {
 			return []ent.Value{*id}
 		}
-	case backenduser.EdgeBackend:
-		if id := m.backend; id != nil {
-			return []ent.Value{*id}
-		}
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *BackendUserMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 2)
+func (m *ApiKeyMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *BackendUserMutation) RemovedIDs(name string) []ent.Value {
+func (m *ApiKeyMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *BackendUserMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 2)
+func (m *ApiKeyMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
 	if m.cleareduser {
-		edges = append(edges, backenduser.EdgeUser)
-	}
-	if m.clearedbackend {
-		edges = append(edges, backenduser.EdgeBackend)
+		edges = append(edges, apikey.EdgeUser)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *BackendUserMutation) EdgeCleared(name string) bool {
+func (m *ApiKeyMutation) EdgeCleared(name string) bool {
 	switch name {
-	case backenduser.EdgeUser:
+	case apikey.EdgeUser:
 		return m.cleareduser
-	case backenduser.EdgeBackend:
-		return m.clearedbackend
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *BackendUserMutation) ClearEdge(name string) error {
+func (m *ApiKeyMutation) ClearEdge(name string) error {
 	switch name {
-	case backenduser.EdgeUser:
+	case apikey.EdgeUser:
 		m.ClearUser()
 		return nil
-	case backenduser.EdgeBackend:
-		m.ClearBackend()
-		return nil
 	}
-	return fmt.Errorf("unknown BackendUser unique edge %s", name)
+	return fmt.Errorf("unknown ApiKey unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *BackendUserMutation) ResetEdge(name string) error {
+func (m *ApiKeyMutation) ResetEdge(name string) error {
 	switch name {
-	case backenduser.EdgeUser:
+	case apikey.EdgeUser:
 		m.ResetUser()
 		return nil
-	case backenduser.EdgeBackend:
-		m.ResetBackend()
-		return nil
 	}
-	return fmt.Errorf("unknown BackendUser edge %s", name)
+	return fmt.Errorf("unknown ApiKey edge %s", name)
 }
 
-// SessionMutation represents an operation that mutates the Session nodes in the graph.
-type SessionMutation struct {
+// AppPasswordMutation represents an operation that mutates the AppPassword nodes in the graph.
+type AppPasswordMutation struct {
 	config
-	op            Op
-	typ           string
-	id            *uuid.UUID
-	token         *string
-	device_id     *string
-	device_name   *string
-	app_name      *string
-	app_version   *string
-	last_activity *time.Time
-	created_at    *time.Time
-	clearedFields map[string]struct{}
-	user          *uuid.UUID
-	cleareduser   bool
-	done          bool
-	oldValue      func(context.Context) (*Session, error)
-	predicates    []predicate.Session
+	op              Op
+	typ             string
+	id              *uuid.UUID
+	name            *string
+	password_hash   *string
+	last_used_at    *time.Time
+	created_at      *time.Time
+	clearedFields   map[string]struct{}
+	user            *uuid.UUID
+	cleareduser     bool
+	sessions        map[uuid.UUID]struct{}
+	removedsessions map[uuid.UUID]struct{}
+	clearedsessions bool
+	done            bool
+	oldValue        func(context.Context) (*AppPassword, error)
+	predicates      []predicate.AppPassword
 }
 
-var _ ent.Mutation = (*SessionMutation)(nil)
+var _ ent.Mutation = (*AppPasswordMutation)(nil)
 
-// sessionOption allows management of the mutation configuration using functional options.
-type sessionOption func(*SessionMutation)
+// apppasswordOption allows management of the mutation configuration using functional options.
+type apppasswordOption func(*AppPasswordMutation)
 
-// newSessionMutation creates new mutation for the Session entity.
-func newSessionMutation(c config, op Op, opts ...sessionOption) *SessionMutation {
-	m := &SessionMutation{
+// newAppPasswordMutation creates new mutation for the AppPassword entity.
+func newAppPasswordMutation(c config, op Op, opts ...apppasswordOption) *AppPasswordMutation {
+	m := &AppPasswordMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeSession,
+		typ:           TypeAppPassword,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -1357,20 +1540,20 @@ func newSessionMutation(c config, op Op, opts ...sessionOption) *SessionMutation
 	return m
 }
 
-// withSessionID sets the ID field of the mutation.
-func withSessionID(id uuid.UUID) sessionOption {
-	return func(m *SessionMutation) {
+// withAppPasswordID sets the ID field of the mutation.
+func withAppPasswordID(id uuid.UUID) apppasswordOption {
+	return func(m *AppPasswordMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Session
+			value *AppPassword
 		)
-		m.oldValue = func(ctx context.Context) (*Session, error) {
+		m.oldValue = func(ctx context.Context) (*AppPassword, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Session.Get(ctx, id)
+					value, err = m.Client().AppPassword.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -1379,10 +1562,10 @@ func withSessionID(id uuid.UUID) sessionOption {
 	}
 }
 
-// withSession sets the old Session of the mutation.
-func withSession(node *Session) sessionOption {
-	return func(m *SessionMutation) {
-		m.oldValue = func(context.Context) (*Session, error) {
+// withAppPassword sets the old AppPassword of the mutation.
+func withAppPassword(node *AppPassword) apppasswordOption {
+	return func(m *AppPasswordMutation) {
+		m.oldValue = func(context.Context) (*AppPassword, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -1391,7 +1574,7 @@ func withSession(node *Session) sessionOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m SessionMutation) Client() *Client {
+func (m AppPasswordMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -1399,7 +1582,7 @@ func (m SessionMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m SessionMutation) Tx() (*Tx, error) {
+func (m AppPasswordMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -1409,14 +1592,14 @@ func (m SessionMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Session entities.
-func (m *SessionMutation) SetID(id uuid.UUID) {
+// operation is only accepted on creation of AppPassword entities.
+func (m *AppPasswordMutation) SetID(id uuid.UUID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *SessionMutation) ID() (id uuid.UUID, exists bool) {
+func (m *AppPasswordMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -1427,7 +1610,7 @@ func (m *SessionMutation) ID() (id uuid.UUID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *SessionMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+func (m *AppPasswordMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -1436,248 +1619,140 @@ func (m *SessionMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Session.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().AppPassword.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetToken sets the "token" field.
-func (m *SessionMutation) SetToken(s string) {
-	m.token = &s
-}
-
-// Token returns the value of the "token" field in the mutation.
-func (m *SessionMutation) Token() (r string, exists bool) {
-	v := m.token
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldToken returns the old "token" field's value of the Session entity.
-// If the Session object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SessionMutation) OldToken(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldToken is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldToken requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldToken: %w", err)
-	}
-	return oldValue.Token, nil
-}
-
-// ResetToken resets all changes to the "token" field.
-func (m *SessionMutation) ResetToken() {
-	m.token = nil
-}
-
-// SetDeviceID sets the "device_id" field.
-func (m *SessionMutation) SetDeviceID(s string) {
-	m.device_id = &s
-}
-
-// DeviceID returns the value of the "device_id" field in the mutation.
-func (m *SessionMutation) DeviceID() (r string, exists bool) {
-	v := m.device_id
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldDeviceID returns the old "device_id" field's value of the Session entity.
-// If the Session object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SessionMutation) OldDeviceID(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDeviceID is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDeviceID requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDeviceID: %w", err)
-	}
-	return oldValue.DeviceID, nil
-}
-
-// ResetDeviceID resets all changes to the "device_id" field.
-func (m *SessionMutation) ResetDeviceID() {
-	m.device_id = nil
-}
-
-// SetDeviceName sets the "device_name" field.
-func (m *SessionMutation) SetDeviceName(s string) {
-	m.device_name = &s
+// SetName sets the "name" field.
+func (m *AppPasswordMutation) SetName(s string) {
+	m.name = &s
 }
 
-// DeviceName returns the value of the "device_name" field in the mutation.
-func (m *SessionMutation) DeviceName() (r string, exists bool) {
-	v := m.device_name
+// Name returns the value of the "name" field in the mutation.
+func (m *AppPasswordMutation) Name() (r string, exists bool) {
+	v := m.name
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDeviceName returns the old "device_name" field's value of the Session entity.
-// If the Session object wasn't provided to the builder, the object is fetched from the database.
+// OldName returns the old "name" field's value of the AppPassword entity.
+// If the AppPassword object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SessionMutation) OldDeviceName(ctx context.Context) (v string, err error) {
+func (m *AppPasswordMutation) OldName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDeviceName is only allowed on UpdateOne operations")
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDeviceName requires an ID field in the mutation")
+		return v, errors.New("OldName requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDeviceName: %w", err)
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
 	}
-	return oldValue.DeviceName, nil
+	return oldValue.Name, nil
 }
 
-// ResetDeviceName resets all changes to the "device_name" field.
-func (m *SessionMutation) ResetDeviceName() {
-	m.device_name = nil
+// ResetName resets all changes to the "name" field.
+func (m *AppPasswordMutation) ResetName() {
+	m.name = nil
 }
 
-// SetAppName sets the "app_name" field.
-func (m *SessionMutation) SetAppName(s string) {
-	m.app_name = &s
+// SetPasswordHash sets the "password_hash" field.
+func (m *AppPasswordMutation) SetPasswordHash(s string) {
+	m.password_hash = &s
 }
 
-// AppName returns the value of the "app_name" field in the mutation.
-func (m *SessionMutation) AppName() (r string, exists bool) {
-	v := m.app_name
+// PasswordHash returns the value of the "password_hash" field in the mutation.
+func (m *AppPasswordMutation) PasswordHash() (r string, exists bool) {
+	v := m.password_hash
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldAppName returns the old "app_name" field's value of the Session entity.
-// If the Session object wasn't provided to the builder, the object is fetched from the database.
+// OldPasswordHash returns the old "password_hash" field's value of the AppPassword entity.
+// If the AppPassword object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SessionMutation) OldAppName(ctx context.Context) (v string, err error) {
+func (m *AppPasswordMutation) OldPasswordHash(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAppName is only allowed on UpdateOne operations")
+		return v, errors.New("OldPasswordHash is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAppName requires an ID field in the mutation")
+		return v, errors.New("OldPasswordHash requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAppName: %w", err)
+		return v, fmt.Errorf("querying old value for OldPasswordHash: %w", err)
 	}
-	return oldValue.AppName, nil
+	return oldValue.PasswordHash, nil
 }
 
-// ResetAppName resets all changes to the "app_name" field.
-func (m *SessionMutation) ResetAppName() {
-	m.app_name = nil
+// ResetPasswordHash resets all changes to the "password_hash" field.
+func (m *AppPasswordMutation) ResetPasswordHash() {
+	m.password_hash = nil
 }
 
-// SetAppVersion sets the "app_version" field.
-func (m *SessionMutation) SetAppVersion(s string) {
-	m.app_version = &s
+// SetLastUsedAt sets the "last_used_at" field.
+func (m *AppPasswordMutation) SetLastUsedAt(t time.Time) {
+	m.last_used_at = &t
 }
 
-// AppVersion returns the value of the "app_version" field in the mutation.
-func (m *SessionMutation) AppVersion() (r string, exists bool) {
-	v := m.app_version
+// LastUsedAt returns the value of the "last_used_at" field in the mutation.
+func (m *AppPasswordMutation) LastUsedAt() (r time.Time, exists bool) {
+	v := m.last_used_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldAppVersion returns the old "app_version" field's value of the Session entity.
-// If the Session object wasn't provided to the builder, the object is fetched from the database.
+// OldLastUsedAt returns the old "last_used_at" field's value of the AppPassword entity.
+// If the AppPassword object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SessionMutation) OldAppVersion(ctx context.Context) (v string, err error) {
+func (m *AppPasswordMutation) OldLastUsedAt(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAppVersion is only allowed on UpdateOne operations")
+		return v, errors.New("OldLastUsedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAppVersion requires an ID field in the mutation")
+		return v, errors.New("OldLastUsedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAppVersion: %w", err)
+		return v, fmt.Errorf("querying old value for OldLastUsedAt: %w", err)
 	}
-	return oldValue.AppVersion, nil
+	return oldValue.LastUsedAt, nil
 }
 
-// ClearAppVersion clears the value of the "app_version" field.
-func (m *SessionMutation) ClearAppVersion() {
-	m.app_version = nil
-	m.clearedFields[session.FieldAppVersion] = struct{}{}
+// ClearLastUsedAt clears the value of the "last_used_at" field.
+func (m *AppPasswordMutation) ClearLastUsedAt() {
+	m.last_used_at = nil
+	m.clearedFields[apppassword.FieldLastUsedAt] = struct{}{}
 }
 
-// AppVersionCleared returns if the "app_version" field was cleared in this mutation.
-func (m *SessionMutation) AppVersionCleared() bool {
-	_, ok := m.clearedFields[session.FieldAppVersion]
+// LastUsedAtCleared returns if the "last_used_at" field was cleared in this mutation.
+func (m *AppPasswordMutation) LastUsedAtCleared() bool {
+	_, ok := m.clearedFields[apppassword.FieldLastUsedAt]
 	return ok
 }
 
-// ResetAppVersion resets all changes to the "app_version" field.
-func (m *SessionMutation) ResetAppVersion() {
-	m.app_version = nil
-	delete(m.clearedFields, session.FieldAppVersion)
-}
-
-// SetLastActivity sets the "last_activity" field.
-func (m *SessionMutation) SetLastActivity(t time.Time) {
-	m.last_activity = &t
-}
-
-// LastActivity returns the value of the "last_activity" field in the mutation.
-func (m *SessionMutation) LastActivity() (r time.Time, exists bool) {
-	v := m.last_activity
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldLastActivity returns the old "last_activity" field's value of the Session entity.
-// If the Session object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SessionMutation) OldLastActivity(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldLastActivity is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldLastActivity requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldLastActivity: %w", err)
-	}
-	return oldValue.LastActivity, nil
-}
-
-// ResetLastActivity resets all changes to the "last_activity" field.
-func (m *SessionMutation) ResetLastActivity() {
-	m.last_activity = nil
+// ResetLastUsedAt resets all changes to the "last_used_at" field.
+func (m *AppPasswordMutation) ResetLastUsedAt() {
+	m.last_used_at = nil
+	delete(m.clearedFields, apppassword.FieldLastUsedAt)
 }
 
 // SetCreatedAt sets the "created_at" field.
-func (m *SessionMutation) SetCreatedAt(t time.Time) {
+func (m *AppPasswordMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *SessionMutation) CreatedAt() (r time.Time, exists bool) {
+func (m *AppPasswordMutation) CreatedAt() (r time.Time, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -1685,10 +1760,10 @@ func (m *SessionMutation) CreatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Session entity.
-// If the Session object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the AppPassword entity.
+// If the AppPassword object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SessionMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *AppPasswordMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -1703,27 +1778,27 @@ func (m *SessionMutation) OldCreatedAt(ctx context.Context) (v time.Time, err er
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *SessionMutation) ResetCreatedAt() {
+func (m *AppPasswordMutation) ResetCreatedAt() {
 	m.created_at = nil
 }
 
 // SetUserID sets the "user" edge to the User entity by id.
-func (m *SessionMutation) SetUserID(id uuid.UUID) {
+func (m *AppPasswordMutation) SetUserID(id uuid.UUID) {
 	m.user = &id
 }
 
 // ClearUser clears the "user" edge to the User entity.
-func (m *SessionMutation) ClearUser() {
+func (m *AppPasswordMutation) ClearUser() {
 	m.cleareduser = true
 }
 
 // UserCleared reports if the "user" edge to the User entity was cleared.
-func (m *SessionMutation) UserCleared() bool {
+func (m *AppPasswordMutation) UserCleared() bool {
 	return m.cleareduser
 }
 
 // UserID returns the "user" edge ID in the mutation.
-func (m *SessionMutation) UserID() (id uuid.UUID, exists bool) {
+func (m *AppPasswordMutation) UserID() (id uuid.UUID, exists bool) {
 	if m.user != nil {
 		return *m.user, true
 	}
@@ -1733,7 +1808,7 @@ func (m *SessionMutation) UserID() (id uuid.UUID, exists bool) {
 // UserIDs returns the "user" edge IDs in the mutation.
 // Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
 // UserID instead. It exists only for internal usage by the builders.
-func (m *SessionMutation) UserIDs() (ids []uuid.UUID) {
+func (m *AppPasswordMutation) UserIDs() (ids []uuid.UUID) {
 	if id := m.user; id != nil {
 		ids = append(ids, *id)
 	}
@@ -1741,20 +1816,74 @@ func (m *SessionMutation) UserIDs() (ids []uuid.UUID) {
 }
 
 // ResetUser resets all changes to the "user" edge.
-func (m *SessionMutation) ResetUser() {
+func (m *AppPasswordMutation) ResetUser() {
 	m.user = nil
 	m.cleareduser = false
 }
 
-// Where appends a list predicates to the SessionMutation builder.
-func (m *SessionMutation) Where(ps ...predicate.Session) {
+// AddSessionIDs adds the "sessions" edge to the Session entity by ids.
+func (m *AppPasswordMutation) AddSessionIDs(ids ...uuid.UUID) {
+	if m.sessions == nil {
+		m.sessions = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.sessions[ids[i]] = struct{}{}
+	}
+}
+
+// ClearSessions clears the "sessions" edge to the Session entity.
+func (m *AppPasswordMutation) ClearSessions() {
+	m.clearedsessions = true
+}
+
+// SessionsCleared reports if the "sessions" edge to the Session entity was cleared.
+func (m *AppPasswordMutation) SessionsCleared() bool {
+	return m.clearedsessions
+}
+
+// RemoveSessionIDs removes the "sessions" edge to the Session entity by IDs.
+func (m *AppPasswordMutation) RemoveSessionIDs(ids ...uuid.UUID) {
+	if m.removedsessions == nil {
+		m.removedsessions = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.sessions, ids[i])
+		m.removedsessions[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedSessions returns the removed IDs of the "sessions" edge to the Session entity.
+func (m *AppPasswordMutation) RemovedSessionsIDs() (ids []uuid.UUID) {
+	for id := range m.removedsessions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// SessionsIDs returns the "sessions" edge IDs in the mutation.
+func (m *AppPasswordMutation) SessionsIDs() (ids []uuid.UUID) {
+	for id := range m.sessions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetSessions resets all changes to the "sessions" edge.
+func (m *AppPasswordMutation) ResetSessions() {
+	m.sessions = nil
+	m.clearedsessions = false
+	m.removedsessions = nil
+}
+
+// Where appends a list predicates to the AppPasswordMutation builder.
+func (m *AppPasswordMutation) Where(ps ...predicate.AppPassword) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the SessionMutation builder. Using this method,
+// WhereP appends storage-level predicates to the AppPasswordMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *SessionMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Session, len(ps))
+func (m *AppPasswordMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.AppPassword, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -1762,45 +1891,36 @@ func (m *SessionMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *SessionMutation) Op() Op {
+func (m *AppPasswordMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *SessionMutation) SetOp(op Op) {
+func (m *AppPasswordMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Session).
-func (m *SessionMutation) Type() string {
+// Type returns the node type of this mutation (AppPassword).
+func (m *AppPasswordMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *SessionMutation) Fields() []string {
-	fields := make([]string, 0, 7)
-	if m.token != nil {
-		fields = append(fields, session.FieldToken)
-	}
-	if m.device_id != nil {
-		fields = append(fields, session.FieldDeviceID)
+func (m *AppPasswordMutation) Fields() []string {
+	fields := make([]string, 0, 4)
+	if m.name != nil {
+		fields = append(fields, apppassword.FieldName)
 	}
-	if m.device_name != nil {
-		fields = append(fields, session.FieldDeviceName)
+	if m.password_hash != nil {
+		fields = append(fields, apppassword.FieldPasswordHash)
 	}
-	if m.app_name != nil {
-		fields = append(fields, session.FieldAppName)
+	if m.last_used_at != nil {
+		fields = append(fields, apppassword.FieldLastUsedAt)
 	}
-	if m.app_version != nil {
-		fields = append(fields, session.FieldAppVersion)
-	}
-	if m.last_activity != nil {
-		fields = append(fields, session.FieldLastActivity)
-	}
-	if m.created_at != nil {
-		fields = append(fields, session.FieldCreatedAt)
+	if m.created_at != nil {
+		fields = append(fields, apppassword.FieldCreatedAt)
 	}
 	return fields
 }
@@ -1808,21 +1928,15 @@ func (m *SessionMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *SessionMutation) Field(name string) (ent.Value, bool) {
+func (m *AppPasswordMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case session.FieldToken:
-		return m.Token()
-	case session.FieldDeviceID:
-		return m.DeviceID()
-	case session.FieldDeviceName:
-		return m.DeviceName()
-	case session.FieldAppName:
-		return m.AppName()
-	case session.FieldAppVersion:
-		return m.AppVersion()
-	case session.FieldLastActivity:
-		return m.LastActivity()
-	case session.FieldCreatedAt:
+	case apppassword.FieldName:
+		return m.Name()
+	case apppassword.FieldPasswordHash:
+		return m.PasswordHash()
+	case apppassword.FieldLastUsedAt:
+		return m.LastUsedAt()
+	case apppassword.FieldCreatedAt:
 		return m.CreatedAt()
 	}
 	return nil, false
@@ -1831,74 +1945,47 @@ func (m *SessionMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *SessionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *AppPasswordMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case session.FieldToken:
-		return m.OldToken(ctx)
-	case session.FieldDeviceID:
-		return m.OldDeviceID(ctx)
-	case session.FieldDeviceName:
-		return m.OldDeviceName(ctx)
-	case session.FieldAppName:
-		return m.OldAppName(ctx)
-	case session.FieldAppVersion:
-		return m.OldAppVersion(ctx)
-	case session.FieldLastActivity:
-		return m.OldLastActivity(ctx)
-	case session.FieldCreatedAt:
+	case apppassword.FieldName:
+		return m.OldName(ctx)
+	case apppassword.FieldPasswordHash:
+		return m.OldPasswordHash(ctx)
+	case apppassword.FieldLastUsedAt:
+		return m.OldLastUsedAt(ctx)
+	case apppassword.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown Session field %s", name)
+	return nil, fmt.Errorf("unknown AppPassword field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *SessionMutation) SetField(name string, value ent.Value) error {
+func (m *AppPasswordMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case session.FieldToken:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetToken(v)
-		return nil
-	case session.FieldDeviceID:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetDeviceID(v)
-		return nil
-	case session.FieldDeviceName:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetDeviceName(v)
-		return nil
-	case session.FieldAppName:
+	case apppassword.FieldName:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetAppName(v)
+		m.SetName(v)
 		return nil
-	case session.FieldAppVersion:
+	case apppassword.FieldPasswordHash:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetAppVersion(v)
+		m.SetPasswordHash(v)
 		return nil
-	case session.FieldLastActivity:
+	case apppassword.FieldLastUsedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetLastActivity(v)
+		m.SetLastUsedAt(v)
 		return nil
-	case session.FieldCreatedAt:
+	case apppassword.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
@@ -1906,199 +1993,216 @@ func (m *SessionMutation) SetField(name string, value ent.Value) error {
 		m.SetCreatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Session field %s", name)
+	return fmt.Errorf("unknown AppPassword field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *SessionMutation) AddedFields() []string {
+func (m *AppPasswordMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *SessionMutation) AddedField(name string) (ent.Value, bool) {
+func (m *AppPasswordMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *SessionMutation) AddField(name string, value ent.Value) error {
+func (m *AppPasswordMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown Session numeric field %s", name)
+	return fmt.Errorf("unknown AppPassword numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *SessionMutation) ClearedFields() []string {
+func (m *AppPasswordMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(session.FieldAppVersion) {
-		fields = append(fields, session.FieldAppVersion)
+	if m.FieldCleared(apppassword.FieldLastUsedAt) {
+		fields = append(fields, apppassword.FieldLastUsedAt)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *SessionMutation) FieldCleared(name string) bool {
+func (m *AppPasswordMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *SessionMutation) ClearField(name string) error {
+func (m *AppPasswordMutation) ClearField(name string) error {
 	switch name {
-	case session.FieldAppVersion:
-		m.ClearAppVersion()
+	case apppassword.FieldLastUsedAt:
+		m.ClearLastUsedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown Session nullable field %s", name)
+	return fmt.Errorf("unknown AppPassword nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *SessionMutation) ResetField(name string) error {
+func (m *AppPasswordMutation) ResetField(name string) error {
 	switch name {
-	case session.FieldToken:
-		m.ResetToken()
-		return nil
-	case session.FieldDeviceID:
-		m.ResetDeviceID()
-		return nil
-	case session.FieldDeviceName:
-		m.ResetDeviceName()
-		return nil
-	case session.FieldAppName:
-		m.ResetAppName()
+	case apppassword.FieldName:
+		m.ResetName()
 		return nil
-	case session.FieldAppVersion:
-		m.ResetAppVersion()
+	case apppassword.FieldPasswordHash:
+		m.ResetPasswordHash()
 		return nil
-	case session.FieldLastActivity:
-		m.ResetLastActivity()
+	case apppassword.FieldLastUsedAt:
+		m.ResetLastUsedAt()
 		return nil
-	case session.FieldCreatedAt:
+	case apppassword.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown Session field %s", name)
+	return fmt.Errorf("unknown AppPassword field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *SessionMutation) AddedEdges() []string {
-	edges := make([]string, 0, 1)
+func (m *AppPasswordMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
 	if m.user != nil {
-		edges = append(edges, session.EdgeUser)
+		edges = append(edges, apppassword.EdgeUser)
+	}
+	if m.sessions != nil {
+		edges = append(edges, apppassword.EdgeSessions)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *SessionMutation) AddedIDs(name string) []ent.Value {
+func (m *AppPasswordMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case session.EdgeUser:
+	case apppassword.EdgeUser:
 		if id := m.user; id != nil {
 			return []ent.Value{*id}
 		}
+	case apppassword.EdgeSessions:
+		ids := make([]ent.Value, 0, len(m.sessions))
+		for id := range m.sessions {
+			ids = append(ids, id)
+		}
+		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *SessionMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 1)
+func (m *AppPasswordMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.removedsessions != nil {
+		edges = append(edges, apppassword.EdgeSessions)
+	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *SessionMutation) RemovedIDs(name string) []ent.Value {
+func (m *AppPasswordMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case apppassword.EdgeSessions:
+		ids := make([]ent.Value, 0, len(m.removedsessions))
+		for id := range m.removedsessions {
+			ids = append(ids, id)
+		}
+		return ids
+	}
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *SessionMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 1)
+func (m *AppPasswordMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
 	if m.cleareduser {
-		edges = append(edges, session.EdgeUser)
+		edges = append(edges, apppassword.EdgeUser)
+	}
+	if m.clearedsessions {
+		edges = append(edges, apppassword.EdgeSessions)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *SessionMutation) EdgeCleared(name string) bool {
+func (m *AppPasswordMutation) EdgeCleared(name string) bool {
 	switch name {
-	case session.EdgeUser:
+	case apppassword.EdgeUser:
 		return m.cleareduser
+	case apppassword.EdgeSessions:
+		return m.clearedsessions
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *SessionMutation) ClearEdge(name string) error {
+func (m *AppPasswordMutation) ClearEdge(name string) error {
 	switch name {
-	case session.EdgeUser:
+	case apppassword.EdgeUser:
 		m.ClearUser()
 		return nil
 	}
-	return fmt.Errorf("unknown Session unique edge %s", name)
+	return fmt.Errorf("unknown AppPassword unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *SessionMutation) ResetEdge(name string) error {
+func (m *AppPasswordMutation) ResetEdge(name string) error {
 	switch name {
-	case session.EdgeUser:
+	case apppassword.EdgeUser:
 		m.ResetUser()
 		return nil
+	case apppassword.EdgeSessions:
+		m.ResetSessions()
+		return nil
 	}
-	return fmt.Errorf("unknown Session edge %s", name)
+	return fmt.Errorf("unknown AppPassword edge %s", name)
 }
 
-// UserMutation represents an operation that mutates the User nodes in the graph.
-type UserMutation struct {
+// BackendMutation represents an operation that mutates the Backend nodes in the graph.
+type BackendMutation struct {
 	config
 	op                   Op
 	typ                  string
 	id                   *uuid.UUID
-	username             *string
-	display_name         *string
-	hashed_password      *string
-	is_admin             *bool
+	name                 *string
+	url                  *string
+	jellyfin_server_id   *string
+	prefix               *string
+	enabled              *bool
 	created_at           *time.Time
-	updated_at           *time.Time
-	avatar               *[]byte
-	avatar_content_type  *string
 	clearedFields        map[string]struct{}
-	sessions             map[uuid.UUID]struct{}
-	removedsessions      map[uuid.UUID]struct{}
-	clearedsessions      bool
 	backend_users        map[uuid.UUID]struct{}
 	removedbackend_users map[uuid.UUID]struct{}
 	clearedbackend_users bool
+	items                map[uuid.UUID]struct{}
+	removeditems         map[uuid.UUID]struct{}
+	cleareditems         bool
 	done                 bool
-	oldValue             func(context.Context) (*User, error)
-	predicates           []predicate.User
+	oldValue             func(context.Context) (*Backend, error)
+	predicates           []predicate.Backend
 }
 
-var _ ent.Mutation = (*UserMutation)(nil)
+var _ ent.Mutation = (*BackendMutation)(nil)
 
-// userOption allows management of the mutation configuration using functional options.
-type userOption func(*UserMutation)
+// backendOption allows management of the mutation configuration using functional options.
+type backendOption func(*BackendMutation)
 
-// newUserMutation creates new mutation for the User entity.
-func newUserMutation(c config, op Op, opts ...userOption) *UserMutation {
-	m := &UserMutation{
+// newBackendMutation creates new mutation for the Backend entity.
+func newBackendMutation(c config, op Op, opts ...backendOption) *BackendMutation {
+	m := &BackendMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeUser,
+		typ:           TypeBackend,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -2107,20 +2211,20 @@ func newUserMutation(c config, op Op, opts ...userOption) *UserMutation {
 	return m
 }
 
-// withUserID sets the ID field of the mutation.
-func withUserID(id uuid.UUID) userOption {
-	return func(m *UserMutation) {
+// withBackendID sets the ID field of the mutation.
+func withBackendID(id uuid.UUID) backendOption {
+	return func(m *BackendMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *User
+			value *Backend
 		)
-		m.oldValue = func(ctx context.Context) (*User, error) {
+		m.oldValue = func(ctx context.Context) (*Backend, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().User.Get(ctx, id)
+					value, err = m.Client().Backend.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -2129,10 +2233,10 @@ func withUserID(id uuid.UUID) userOption {
 	}
 }
 
-// withUser sets the old User of the mutation.
-func withUser(node *User) userOption {
-	return func(m *UserMutation) {
-		m.oldValue = func(context.Context) (*User, error) {
+// withBackend sets the old Backend of the mutation.
+func withBackend(node *Backend) backendOption {
+	return func(m *BackendMutation) {
+		m.oldValue = func(context.Context) (*Backend, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -2141,7 +2245,7 @@ func withUser(node *User) userOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m UserMutation) Client() *Client {
+func (m BackendMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -2149,7 +2253,7 @@ func (m UserMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m UserMutation) Tx() (*Tx, error) {
+func (m BackendMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -2159,14 +2263,14 @@ func (m UserMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of User entities.
-func (m *UserMutation) SetID(id uuid.UUID) {
+// operation is only accepted on creation of Backend entities.
+func (m *BackendMutation) SetID(id uuid.UUID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *UserMutation) ID() (id uuid.UUID, exists bool) {
+func (m *BackendMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -2177,7 +2281,7 @@ func (m *UserMutation) ID() (id uuid.UUID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *UserMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+func (m *BackendMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -2186,324 +2290,8272 @@ func (m *UserMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().User.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Backend.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetUsername sets the "username" field.
-func (m *UserMutation) SetUsername(s string) {
-	m.username = &s
+// SetName sets the "name" field.
+func (m *BackendMutation) SetName(s string) {
+	m.name = &s
 }
 
-// Username returns the value of the "username" field in the mutation.
-func (m *UserMutation) Username() (r string, exists bool) {
-	v := m.username
+// Name returns the value of the "name" field in the mutation.
+func (m *BackendMutation) Name() (r string, exists bool) {
+	v := m.name
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUsername returns the old "username" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
+// OldName returns the old "name" field's value of the Backend entity.
+// If the Backend object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldUsername(ctx context.Context) (v string, err error) {
+func (m *BackendMutation) OldName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUsername is only allowed on UpdateOne operations")
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUsername requires an ID field in the mutation")
+		return v, errors.New("OldName requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUsername: %w", err)
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
 	}
-	return oldValue.Username, nil
+	return oldValue.Name, nil
 }
 
-// ResetUsername resets all changes to the "username" field.
-func (m *UserMutation) ResetUsername() {
-	m.username = nil
+// ResetName resets all changes to the "name" field.
+func (m *BackendMutation) ResetName() {
+	m.name = nil
 }
 
-// SetDisplayName sets the "display_name" field.
-func (m *UserMutation) SetDisplayName(s string) {
-	m.display_name = &s
+// SetURL sets the "url" field.
+func (m *BackendMutation) SetURL(s string) {
+	m.url = &s
 }
 
-// DisplayName returns the value of the "display_name" field in the mutation.
-func (m *UserMutation) DisplayName() (r string, exists bool) {
-	v := m.display_name
+// URL returns the value of the "url" field in the mutation.
+func (m *BackendMutation) URL() (r string, exists bool) {
+	v := m.url
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDisplayName returns the old "display_name" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
+// OldURL returns the old "url" field's value of the Backend entity.
+// If the Backend object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldDisplayName(ctx context.Context) (v string, err error) {
+func (m *BackendMutation) OldURL(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDisplayName is only allowed on UpdateOne operations")
+		return v, errors.New("OldURL is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDisplayName requires an ID field in the mutation")
+		return v, errors.New("OldURL requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDisplayName: %w", err)
+		return v, fmt.Errorf("querying old value for OldURL: %w", err)
 	}
-	return oldValue.DisplayName, nil
+	return oldValue.URL, nil
 }
 
-// ResetDisplayName resets all changes to the "display_name" field.
-func (m *UserMutation) ResetDisplayName() {
-	m.display_name = nil
+// ResetURL resets all changes to the "url" field.
+func (m *BackendMutation) ResetURL() {
+	m.url = nil
 }
 
-// SetHashedPassword sets the "hashed_password" field.
-func (m *UserMutation) SetHashedPassword(s string) {
-	m.hashed_password = &s
+// SetJellyfinServerID sets the "jellyfin_server_id" field.
+func (m *BackendMutation) SetJellyfinServerID(s string) {
+	m.jellyfin_server_id = &s
 }
 
-// HashedPassword returns the value of the "hashed_password" field in the mutation.
-func (m *UserMutation) HashedPassword() (r string, exists bool) {
-	v := m.hashed_password
+// JellyfinServerID returns the value of the "jellyfin_server_id" field in the mutation.
+func (m *BackendMutation) JellyfinServerID() (r string, exists bool) {
+	v := m.jellyfin_server_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldHashedPassword returns the old "hashed_password" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
+// OldJellyfinServerID returns the old "jellyfin_server_id" field's value of the Backend entity.
+// If the Backend object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldHashedPassword(ctx context.Context) (v string, err error) {
+func (m *BackendMutation) OldJellyfinServerID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldHashedPassword is only allowed on UpdateOne operations")
+		return v, errors.New("OldJellyfinServerID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldHashedPassword requires an ID field in the mutation")
+		return v, errors.New("OldJellyfinServerID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldHashedPassword: %w", err)
+		return v, fmt.Errorf("querying old value for OldJellyfinServerID: %w", err)
 	}
-	return oldValue.HashedPassword, nil
+	return oldValue.JellyfinServerID, nil
 }
 
-// ResetHashedPassword resets all changes to the "hashed_password" field.
-func (m *UserMutation) ResetHashedPassword() {
-	m.hashed_password = nil
+// ResetJellyfinServerID resets all changes to the "jellyfin_server_id" field.
+func (m *BackendMutation) ResetJellyfinServerID() {
+	m.jellyfin_server_id = nil
 }
 
-// SetIsAdmin sets the "is_admin" field.
-func (m *UserMutation) SetIsAdmin(b bool) {
-	m.is_admin = &b
+// SetPrefix sets the "prefix" field.
+func (m *BackendMutation) SetPrefix(s string) {
+	m.prefix = &s
 }
 
-// IsAdmin returns the value of the "is_admin" field in the mutation.
-func (m *UserMutation) IsAdmin() (r bool, exists bool) {
-	v := m.is_admin
+// Prefix returns the value of the "prefix" field in the mutation.
+func (m *BackendMutation) Prefix() (r string, exists bool) {
+	v := m.prefix
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldIsAdmin returns the old "is_admin" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
+// OldPrefix returns the old "prefix" field's value of the Backend entity.
+// If the Backend object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldIsAdmin(ctx context.Context) (v bool, err error) {
+func (m *BackendMutation) OldPrefix(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldIsAdmin is only allowed on UpdateOne operations")
+		return v, errors.New("OldPrefix is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldIsAdmin requires an ID field in the mutation")
+		return v, errors.New("OldPrefix requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldIsAdmin: %w", err)
+		return v, fmt.Errorf("querying old value for OldPrefix: %w", err)
 	}
-	return oldValue.IsAdmin, nil
+	return oldValue.Prefix, nil
 }
 
-// ResetIsAdmin resets all changes to the "is_admin" field.
-func (m *UserMutation) ResetIsAdmin() {
-	m.is_admin = nil
+// ResetPrefix resets all changes to the "prefix" field.
+func (m *BackendMutation) ResetPrefix() {
+	m.prefix = nil
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *UserMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// SetEnabled sets the "enabled" field.
+func (m *BackendMutation) SetEnabled(b bool) {
+	m.enabled = &b
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *UserMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
+// Enabled returns the value of the "enabled" field in the mutation.
+func (m *BackendMutation) Enabled() (r bool, exists bool) {
+	v := m.enabled
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
+// OldEnabled returns the old "enabled" field's value of the Backend entity.
+// If the Backend object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *BackendMutation) OldEnabled(ctx context.Context) (v bool, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldEnabled is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+		return v, errors.New("OldEnabled requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldEnabled: %w", err)
 	}
-	return oldValue.CreatedAt, nil
+	return oldValue.Enabled, nil
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *UserMutation) ResetCreatedAt() {
-	m.created_at = nil
+// ResetEnabled resets all changes to the "enabled" field.
+func (m *BackendMutation) ResetEnabled() {
+	m.enabled = nil
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (m *UserMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
+// SetCreatedAt sets the "created_at" field.
+func (m *BackendMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
 }
 
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *UserMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *BackendMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the Backend entity.
+// If the Backend object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *BackendMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
 	}
-	return oldValue.UpdatedAt, nil
+	return oldValue.CreatedAt, nil
 }
 
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *UserMutation) ResetUpdatedAt() {
-	m.updated_at = nil
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *BackendMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// SetAvatar sets the "avatar" field.
-func (m *UserMutation) SetAvatar(b []byte) {
-	m.avatar = &b
+// AddBackendUserIDs adds the "backend_users" edge to the BackendUser entity by ids.
+func (m *BackendMutation) AddBackendUserIDs(ids ...uuid.UUID) {
+	if m.backend_users == nil {
+		m.backend_users = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.backend_users[ids[i]] = struct{}{}
+	}
 }
 
-// Avatar returns the value of the "avatar" field in the mutation.
-func (m *UserMutation) Avatar() (r []byte, exists bool) {
-	v := m.avatar
-	if v == nil {
-		return
-	}
-	return *v, true
+// ClearBackendUsers clears the "backend_users" edge to the BackendUser entity.
+func (m *BackendMutation) ClearBackendUsers() {
+	m.clearedbackend_users = true
 }
 
-// OldAvatar returns the old "avatar" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldAvatar(ctx context.Context) (v *[]byte, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAvatar is only allowed on UpdateOne operations")
+// BackendUsersCleared reports if the "backend_users" edge to the BackendUser entity was cleared.
+func (m *BackendMutation) BackendUsersCleared() bool {
+	return m.clearedbackend_users
+}
+
+// RemoveBackendUserIDs removes the "backend_users" edge to the BackendUser entity by IDs.
+func (m *BackendMutation) RemoveBackendUserIDs(ids ...uuid.UUID) {
+	if m.removedbackend_users == nil {
+		m.removedbackend_users = make(map[uuid.UUID]struct{})
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAvatar requires an ID field in the mutation")
+	for i := range ids {
+		delete(m.backend_users, ids[i])
+		m.removedbackend_users[ids[i]] = struct{}{}
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAvatar: %w", err)
+}
+
+// RemovedBackendUsers returns the removed IDs of the "backend_users" edge to the BackendUser entity.
+func (m *BackendMutation) RemovedBackendUsersIDs() (ids []uuid.UUID) {
+	for id := range m.removedbackend_users {
+		ids = append(ids, id)
 	}
-	return oldValue.Avatar, nil
+	return
 }
 
-// ClearAvatar clears the value of the "avatar" field.
-func (m *UserMutation) ClearAvatar() {
-	m.avatar = nil
-	m.clearedFields[user.FieldAvatar] = struct{}{}
+// BackendUsersIDs returns the "backend_users" edge IDs in the mutation.
+func (m *BackendMutation) BackendUsersIDs() (ids []uuid.UUID) {
+	for id := range m.backend_users {
+		ids = append(ids, id)
+	}
+	return
 }
 
-// AvatarCleared returns if the "avatar" field was cleared in this mutation.
-func (m *UserMutation) AvatarCleared() bool {
-	_, ok := m.clearedFields[user.FieldAvatar]
-	return ok
+// ResetBackendUsers resets all changes to the "backend_users" edge.
+func (m *BackendMutation) ResetBackendUsers() {
+	m.backend_users = nil
+	m.clearedbackend_users = false
+	m.removedbackend_users = nil
 }
 
-// ResetAvatar resets all changes to the "avatar" field.
-func (m *UserMutation) ResetAvatar() {
-	m.avatar = nil
-	delete(m.clearedFields, user.FieldAvatar)
+// AddItemIDs adds the "items" edge to the Item entity by ids.
+func (m *BackendMutation) AddItemIDs(ids ...uuid.UUID) {
+	if m.items == nil {
+		m.items = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.items[ids[i]] = struct{}{}
+	}
 }
 
-// SetAvatarContentType sets the "avatar_content_type" field.
-func (m *UserMutation) SetAvatarContentType(s string) {
-	m.avatar_content_type = &s
+// ClearItems clears the "items" edge to the Item entity.
+func (m *BackendMutation) ClearItems() {
+	m.cleareditems = true
 }
 
-// AvatarContentType returns the value of the "avatar_content_type" field in the mutation.
-func (m *UserMutation) AvatarContentType() (r string, exists bool) {
-	v := m.avatar_content_type
-	if v == nil {
-		return
-	}
-	return *v, true
+// ItemsCleared reports if the "items" edge to the Item entity was cleared.
+func (m *BackendMutation) ItemsCleared() bool {
+	return m.cleareditems
 }
 
-// OldAvatarContentType returns the old "avatar_content_type" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldAvatarContentType(ctx context.Context) (v *string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAvatarContentType is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAvatarContentType requires an ID field in the mutation")
+// RemoveItemIDs removes the "items" edge to the Item entity by IDs.
+func (m *BackendMutation) RemoveItemIDs(ids ...uuid.UUID) {
+	if m.removeditems == nil {
+		m.removeditems = make(map[uuid.UUID]struct{})
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAvatarContentType: %w", err)
+	for i := range ids {
+		delete(m.items, ids[i])
+		m.removeditems[ids[i]] = struct{}{}
 	}
-	return oldValue.AvatarContentType, nil
 }
 
-// ClearAvatarContentType clears the value of the "avatar_content_type" field.
-func (m *UserMutation) ClearAvatarContentType() {
-	m.avatar_content_type = nil
-	m.clearedFields[user.FieldAvatarContentType] = struct{}{}
+// RemovedItems returns the removed IDs of the "items" edge to the Item entity.
+func (m *BackendMutation) RemovedItemsIDs() (ids []uuid.UUID) {
+	for id := range m.removeditems {
+		ids = append(ids, id)
+	}
+	return
 }
 
-// AvatarContentTypeCleared returns if the "avatar_content_type" field was cleared in this mutation.
-func (m *UserMutation) AvatarContentTypeCleared() bool {
-	_, ok := m.clearedFields[user.FieldAvatarContentType]
-	return ok
+// ItemsIDs returns the "items" edge IDs in the mutation.
+func (m *BackendMutation) ItemsIDs() (ids []uuid.UUID) {
+	for id := range m.items {
+		ids = append(ids, id)
+	}
+	return
 }
 
-// ResetAvatarContentType resets all changes to the "avatar_content_type" field.
-func (m *UserMutation) ResetAvatarContentType() {
-	m.avatar_content_type = nil
-	delete(m.clearedFields, user.FieldAvatarContentType)
+// ResetItems resets all changes to the "items" edge.
+func (m *BackendMutation) ResetItems() {
+	m.items = nil
+	m.cleareditems = false
+	m.removeditems = nil
+}
+
+// Where appends a list predicates to the BackendMutation builder.
+func (m *BackendMutation) Where(ps ...predicate.Backend) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the BackendMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *BackendMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Backend, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *BackendMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *BackendMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Backend).
+func (m *BackendMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *BackendMutation) Fields() []string {
+	fields := make([]string, 0, 6)
+	if m.name != nil {
+		fields = append(fields, backend.FieldName)
+	}
+	if m.url != nil {
+		fields = append(fields, backend.FieldURL)
+	}
+	if m.jellyfin_server_id != nil {
+		fields = append(fields, backend.FieldJellyfinServerID)
+	}
+	if m.prefix != nil {
+		fields = append(fields, backend.FieldPrefix)
+	}
+	if m.enabled != nil {
+		fields = append(fields, backend.FieldEnabled)
+	}
+	if m.created_at != nil {
+		fields = append(fields, backend.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *BackendMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case backend.FieldName:
+		return m.Name()
+	case backend.FieldURL:
+		return m.URL()
+	case backend.FieldJellyfinServerID:
+		return m.JellyfinServerID()
+	case backend.FieldPrefix:
+		return m.Prefix()
+	case backend.FieldEnabled:
+		return m.Enabled()
+	case backend.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *BackendMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case backend.FieldName:
+		return m.OldName(ctx)
+	case backend.FieldURL:
+		return m.OldURL(ctx)
+	case backend.FieldJellyfinServerID:
+		return m.OldJellyfinServerID(ctx)
+	case backend.FieldPrefix:
+		return m.OldPrefix(ctx)
+	case backend.FieldEnabled:
+		return m.OldEnabled(ctx)
+	case backend.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Backend field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *BackendMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case backend.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case backend.FieldURL:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetURL(v)
+		return nil
+	case backend.FieldJellyfinServerID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetJellyfinServerID(v)
+		return nil
+	case backend.FieldPrefix:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPrefix(v)
+		return nil
+	case backend.FieldEnabled:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEnabled(v)
+		return nil
+	case backend.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Backend field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *BackendMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *BackendMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *BackendMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Backend numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *BackendMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *BackendMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *BackendMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Backend nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *BackendMutation) ResetField(name string) error {
+	switch name {
+	case backend.FieldName:
+		m.ResetName()
+		return nil
+	case backend.FieldURL:
+		m.ResetURL()
+		return nil
+	case backend.FieldJellyfinServerID:
+		m.ResetJellyfinServerID()
+		return nil
+	case backend.FieldPrefix:
+		m.ResetPrefix()
+		return nil
+	case backend.FieldEnabled:
+		m.ResetEnabled()
+		return nil
+	case backend.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Backend field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *BackendMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.backend_users != nil {
+		edges = append(edges, backend.EdgeBackendUsers)
+	}
+	if m.items != nil {
+		edges = append(edges, backend.EdgeItems)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *BackendMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case backend.EdgeBackendUsers:
+		ids := make([]ent.Value, 0, len(m.backend_users))
+		for id := range m.backend_users {
+			ids = append(ids, id)
+		}
+		return ids
+	case backend.EdgeItems:
+		ids := make([]ent.Value, 0, len(m.items))
+		for id := range m.items {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *BackendMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.removedbackend_users != nil {
+		edges = append(edges, backend.EdgeBackendUsers)
+	}
+	if m.removeditems != nil {
+		edges = append(edges, backend.EdgeItems)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *BackendMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case backend.EdgeBackendUsers:
+		ids := make([]ent.Value, 0, len(m.removedbackend_users))
+		for id := range m.removedbackend_users {
+			ids = append(ids, id)
+		}
+		return ids
+	case backend.EdgeItems:
+		ids := make([]ent.Value, 0, len(m.removeditems))
+		for id := range m.removeditems {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *BackendMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedbackend_users {
+		edges = append(edges, backend.EdgeBackendUsers)
+	}
+	if m.cleareditems {
+		edges = append(edges, backend.EdgeItems)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *BackendMutation) EdgeCleared(name string) bool {
+	switch name {
+	case backend.EdgeBackendUsers:
+		return m.clearedbackend_users
+	case backend.EdgeItems:
+		return m.cleareditems
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *BackendMutation) ClearEdge(name string) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Backend unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *BackendMutation) ResetEdge(name string) error {
+	switch name {
+	case backend.EdgeBackendUsers:
+		m.ResetBackendUsers()
+		return nil
+	case backend.EdgeItems:
+		m.ResetItems()
+		return nil
+	}
+	return fmt.Errorf("unknown Backend edge %s", name)
+}
+
+// BackendUserMutation represents an operation that mutates the BackendUser nodes in the graph.
+type BackendUserMutation struct {
+	config
+	op              Op
+	typ             string
+	id              *uuid.UUID
+	backend_user_id *string
+	backend_token   *string
+	enabled         *bool
+	clearedFields   map[string]struct{}
+	user            *uuid.UUID
+	cleareduser     bool
+	backend         *uuid.UUID
+	clearedbackend  bool
+	done            bool
+	oldValue        func(context.Context) (*BackendUser, error)
+	predicates      []predicate.BackendUser
+}
+
+var _ ent.Mutation = (*BackendUserMutation)(nil)
+
+// backenduserOption allows management of the mutation configuration using functional options.
+type backenduserOption func(*BackendUserMutation)
+
+// newBackendUserMutation creates new mutation for the BackendUser entity.
+func newBackendUserMutation(c config, op Op, opts ...backenduserOption) *BackendUserMutation {
+	m := &BackendUserMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeBackendUser,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withBackendUserID sets the ID field of the mutation.
+func withBackendUserID(id uuid.UUID) backenduserOption {
+	return func(m *BackendUserMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *BackendUser
+		)
+		m.oldValue = func(ctx context.Context) (*BackendUser, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().BackendUser.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withBackendUser sets the old BackendUser of the mutation.
+func withBackendUser(node *BackendUser) backenduserOption {
+	return func(m *BackendUserMutation) {
+		m.oldValue = func(context.Context) (*BackendUser, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m BackendUserMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m BackendUserMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of BackendUser entities.
+func (m *BackendUserMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *BackendUserMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *BackendUserMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().BackendUser.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetBackendUserID sets the "backend_user_id" field.
+func (m *BackendUserMutation) SetBackendUserID(s string) {
+	m.backend_user_id = &s
+}
+
+// BackendUserID returns the value of the "backend_user_id" field in the mutation.
+func (m *BackendUserMutation) BackendUserID() (r string, exists bool) {
+	v := m.backend_user_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBackendUserID returns the old "backend_user_id" field's value of the BackendUser entity.
+// If the BackendUser object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BackendUserMutation) OldBackendUserID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBackendUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBackendUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBackendUserID: %w", err)
+	}
+	return oldValue.BackendUserID, nil
+}
+
+// ResetBackendUserID resets all changes to the "backend_user_id" field.
+func (m *BackendUserMutation) ResetBackendUserID() {
+	m.backend_user_id = nil
+}
+
+// SetBackendToken sets the "backend_token" field.
+func (m *BackendUserMutation) SetBackendToken(s string) {
+	m.backend_token = &s
+}
+
+// BackendToken returns the value of the "backend_token" field in the mutation.
+func (m *BackendUserMutation) BackendToken() (r string, exists bool) {
+	v := m.backend_token
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBackendToken returns the old "backend_token" field's value of the BackendUser entity.
+// If the BackendUser object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BackendUserMutation) OldBackendToken(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBackendToken is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBackendToken requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBackendToken: %w", err)
+	}
+	return oldValue.BackendToken, nil
+}
+
+// ClearBackendToken clears the value of the "backend_token" field.
+func (m *BackendUserMutation) ClearBackendToken() {
+	m.backend_token = nil
+	m.clearedFields[backenduser.FieldBackendToken] = struct{}{}
+}
+
+// BackendTokenCleared returns if the "backend_token" field was cleared in this mutation.
+func (m *BackendUserMutation) BackendTokenCleared() bool {
+	_, ok := m.clearedFields[backenduser.FieldBackendToken]
+	return ok
+}
+
+// ResetBackendToken resets all changes to the "backend_token" field.
+func (m *BackendUserMutation) ResetBackendToken() {
+	m.backend_token = nil
+	delete(m.clearedFields, backenduser.FieldBackendToken)
+}
+
+// SetEnabled sets the "enabled" field.
+func (m *BackendUserMutation) SetEnabled(b bool) {
+	m.enabled = &b
+}
+
+// Enabled returns the value of the "enabled" field in the mutation.
+func (m *BackendUserMutation) Enabled() (r bool, exists bool) {
+	v := m.enabled
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEnabled returns the old "enabled" field's value of the BackendUser entity.
+// If the BackendUser object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BackendUserMutation) OldEnabled(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEnabled is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEnabled requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEnabled: %w", err)
+	}
+	return oldValue.Enabled, nil
+}
+
+// ResetEnabled resets all changes to the "enabled" field.
+func (m *BackendUserMutation) ResetEnabled() {
+	m.enabled = nil
+}
+
+// SetUserID sets the "user" edge to the User entity by id.
+func (m *BackendUserMutation) SetUserID(id uuid.UUID) {
+	m.user = &id
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (m *BackendUserMutation) ClearUser() {
+	m.cleareduser = true
+}
+
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *BackendUserMutation) UserCleared() bool {
+	return m.cleareduser
+}
+
+// UserID returns the "user" edge ID in the mutation.
+func (m *BackendUserMutation) UserID() (id uuid.UUID, exists bool) {
+	if m.user != nil {
+		return *m.user, true
+	}
+	return
+}
+
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *BackendUserMutation) UserIDs() (ids []uuid.UUID) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetUser resets all changes to the "user" edge.
+func (m *BackendUserMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
+}
+
+// SetBackendID sets the "backend" edge to the Backend entity by id.
+func (m *BackendUserMutation) SetBackendID(id uuid.UUID) {
+	m.backend = &id
+}
+
+// ClearBackend clears the "backend" edge to the Backend entity.
+func (m *BackendUserMutation) ClearBackend() {
+	m.clearedbackend = true
+}
+
+// BackendCleared reports if the "backend" edge to the Backend entity was cleared.
+func (m *BackendUserMutation) BackendCleared() bool {
+	return m.clearedbackend
+}
+
+// BackendID returns the "backend" edge ID in the mutation.
+func (m *BackendUserMutation) BackendID() (id uuid.UUID, exists bool) {
+	if m.backend != nil {
+		return *m.backend, true
+	}
+	return
+}
+
+// BackendIDs returns the "backend" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// BackendID instead. It exists only for internal usage by the builders.
+func (m *BackendUserMutation) BackendIDs() (ids []uuid.UUID) {
+	if id := m.backend; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetBackend resets all changes to the "backend" edge.
+func (m *BackendUserMutation) ResetBackend() {
+	m.backend = nil
+	m.clearedbackend = false
+}
+
+// Where appends a list predicates to the BackendUserMutation builder.
+func (m *BackendUserMutation) Where(ps ...predicate.BackendUser) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the BackendUserMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *BackendUserMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.BackendUser, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *BackendUserMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *BackendUserMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (BackendUser).
+func (m *BackendUserMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *BackendUserMutation) Fields() []string {
+	fields := make([]string, 0, 3)
+	if m.backend_user_id != nil {
+		fields = append(fields, backenduser.FieldBackendUserID)
+	}
+	if m.backend_token != nil {
+		fields = append(fields, backenduser.FieldBackendToken)
+	}
+	if m.enabled != nil {
+		fields = append(fields, backenduser.FieldEnabled)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *BackendUserMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case backenduser.FieldBackendUserID:
+		return m.BackendUserID()
+	case backenduser.FieldBackendToken:
+		return m.BackendToken()
+	case backenduser.FieldEnabled:
+		return m.Enabled()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *BackendUserMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case backenduser.FieldBackendUserID:
+		return m.OldBackendUserID(ctx)
+	case backenduser.FieldBackendToken:
+		return m.OldBackendToken(ctx)
+	case backenduser.FieldEnabled:
+		return m.OldEnabled(ctx)
+	}
+	return nil, fmt.Errorf("unknown BackendUser field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *BackendUserMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case backenduser.FieldBackendUserID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBackendUserID(v)
+		return nil
+	case backenduser.FieldBackendToken:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBackendToken(v)
+		return nil
+	case backenduser.FieldEnabled:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEnabled(v)
+		return nil
+	}
+	return fmt.Errorf("unknown BackendUser field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *BackendUserMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *BackendUserMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *BackendUserMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown BackendUser numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *BackendUserMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(backenduser.FieldBackendToken) {
+		fields = append(fields, backenduser.FieldBackendToken)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *BackendUserMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *BackendUserMutation) ClearField(name string) error {
+	switch name {
+	case backenduser.FieldBackendToken:
+		m.ClearBackendToken()
+		return nil
+	}
+	return fmt.Errorf("unknown BackendUser nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *BackendUserMutation) ResetField(name string) error {
+	switch name {
+	case backenduser.FieldBackendUserID:
+		m.ResetBackendUserID()
+		return nil
+	case backenduser.FieldBackendToken:
+		m.ResetBackendToken()
+		return nil
+	case backenduser.FieldEnabled:
+		m.ResetEnabled()
+		return nil
+	}
+	return fmt.Errorf("unknown BackendUser field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *BackendUserMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.user != nil {
+		edges = append(edges, backenduser.EdgeUser)
+	}
+	if m.backend != nil {
+		edges = append(edges, backenduser.EdgeBackend)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *BackendUserMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case backenduser.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
+		}
+	case backenduser.EdgeBackend:
+		if id := m.backend; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *BackendUserMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *BackendUserMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *BackendUserMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.cleareduser {
+		edges = append(edges, backenduser.EdgeUser)
+	}
+	if m.clearedbackend {
+		edges = append(edges, backenduser.EdgeBackend)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *BackendUserMutation) EdgeCleared(name string) bool {
+	switch name {
+	case backenduser.EdgeUser:
+		return m.cleareduser
+	case backenduser.EdgeBackend:
+		return m.clearedbackend
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *BackendUserMutation) ClearEdge(name string) error {
+	switch name {
+	case backenduser.EdgeUser:
+		m.ClearUser()
+		return nil
+	case backenduser.EdgeBackend:
+		m.ClearBackend()
+		return nil
+	}
+	return fmt.Errorf("unknown BackendUser unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *BackendUserMutation) ResetEdge(name string) error {
+	switch name {
+	case backenduser.EdgeUser:
+		m.ResetUser()
+		return nil
+	case backenduser.EdgeBackend:
+		m.ResetBackend()
+		return nil
+	}
+	return fmt.Errorf("unknown BackendUser edge %s", name)
+}
+
+// DeviceOptionMutation represents an operation that mutates the DeviceOption nodes in the graph.
+type DeviceOptionMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *uuid.UUID
+	device_id     *string
+	custom_name   *string
+	updated_at    *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*DeviceOption, error)
+	predicates    []predicate.DeviceOption
+}
+
+var _ ent.Mutation = (*DeviceOptionMutation)(nil)
+
+// deviceoptionOption allows management of the mutation configuration using functional options.
+type deviceoptionOption func(*DeviceOptionMutation)
+
+// newDeviceOptionMutation creates new mutation for the DeviceOption entity.
+func newDeviceOptionMutation(c config, op Op, opts ...deviceoptionOption) *DeviceOptionMutation {
+	m := &DeviceOptionMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeDeviceOption,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withDeviceOptionID sets the ID field of the mutation.
+func withDeviceOptionID(id uuid.UUID) deviceoptionOption {
+	return func(m *DeviceOptionMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *DeviceOption
+		)
+		m.oldValue = func(ctx context.Context) (*DeviceOption, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().DeviceOption.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withDeviceOption sets the old DeviceOption of the mutation.
+func withDeviceOption(node *DeviceOption) deviceoptionOption {
+	return func(m *DeviceOptionMutation) {
+		m.oldValue = func(context.Context) (*DeviceOption, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m DeviceOptionMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m DeviceOptionMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of DeviceOption entities.
+func (m *DeviceOptionMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *DeviceOptionMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *DeviceOptionMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().DeviceOption.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetDeviceID sets the "device_id" field.
+func (m *DeviceOptionMutation) SetDeviceID(s string) {
+	m.device_id = &s
+}
+
+// DeviceID returns the value of the "device_id" field in the mutation.
+func (m *DeviceOptionMutation) DeviceID() (r string, exists bool) {
+	v := m.device_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeviceID returns the old "device_id" field's value of the DeviceOption entity.
+// If the DeviceOption object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeviceOptionMutation) OldDeviceID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeviceID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeviceID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeviceID: %w", err)
+	}
+	return oldValue.DeviceID, nil
+}
+
+// ResetDeviceID resets all changes to the "device_id" field.
+func (m *DeviceOptionMutation) ResetDeviceID() {
+	m.device_id = nil
+}
+
+// SetCustomName sets the "custom_name" field.
+func (m *DeviceOptionMutation) SetCustomName(s string) {
+	m.custom_name = &s
+}
+
+// CustomName returns the value of the "custom_name" field in the mutation.
+func (m *DeviceOptionMutation) CustomName() (r string, exists bool) {
+	v := m.custom_name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCustomName returns the old "custom_name" field's value of the DeviceOption entity.
+// If the DeviceOption object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeviceOptionMutation) OldCustomName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCustomName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCustomName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCustomName: %w", err)
+	}
+	return oldValue.CustomName, nil
+}
+
+// ClearCustomName clears the value of the "custom_name" field.
+func (m *DeviceOptionMutation) ClearCustomName() {
+	m.custom_name = nil
+	m.clearedFields[deviceoption.FieldCustomName] = struct{}{}
+}
+
+// CustomNameCleared returns if the "custom_name" field was cleared in this mutation.
+func (m *DeviceOptionMutation) CustomNameCleared() bool {
+	_, ok := m.clearedFields[deviceoption.FieldCustomName]
+	return ok
+}
+
+// ResetCustomName resets all changes to the "custom_name" field.
+func (m *DeviceOptionMutation) ResetCustomName() {
+	m.custom_name = nil
+	delete(m.clearedFields, deviceoption.FieldCustomName)
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *DeviceOptionMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *DeviceOptionMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the DeviceOption entity.
+// If the DeviceOption object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeviceOptionMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *DeviceOptionMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// Where appends a list predicates to the DeviceOptionMutation builder.
+func (m *DeviceOptionMutation) Where(ps ...predicate.DeviceOption) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the DeviceOptionMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *DeviceOptionMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.DeviceOption, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *DeviceOptionMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *DeviceOptionMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (DeviceOption).
+func (m *DeviceOptionMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *DeviceOptionMutation) Fields() []string {
+	fields := make([]string, 0, 3)
+	if m.device_id != nil {
+		fields = append(fields, deviceoption.FieldDeviceID)
+	}
+	if m.custom_name != nil {
+		fields = append(fields, deviceoption.FieldCustomName)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, deviceoption.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *DeviceOptionMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case deviceoption.FieldDeviceID:
+		return m.DeviceID()
+	case deviceoption.FieldCustomName:
+		return m.CustomName()
+	case deviceoption.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *DeviceOptionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case deviceoption.FieldDeviceID:
+		return m.OldDeviceID(ctx)
+	case deviceoption.FieldCustomName:
+		return m.OldCustomName(ctx)
+	case deviceoption.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown DeviceOption field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *DeviceOptionMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case deviceoption.FieldDeviceID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeviceID(v)
+		return nil
+	case deviceoption.FieldCustomName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCustomName(v)
+		return nil
+	case deviceoption.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown DeviceOption field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *DeviceOptionMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *DeviceOptionMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *DeviceOptionMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown DeviceOption numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *DeviceOptionMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(deviceoption.FieldCustomName) {
+		fields = append(fields, deviceoption.FieldCustomName)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *DeviceOptionMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *DeviceOptionMutation) ClearField(name string) error {
+	switch name {
+	case deviceoption.FieldCustomName:
+		m.ClearCustomName()
+		return nil
+	}
+	return fmt.Errorf("unknown DeviceOption nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *DeviceOptionMutation) ResetField(name string) error {
+	switch name {
+	case deviceoption.FieldDeviceID:
+		m.ResetDeviceID()
+		return nil
+	case deviceoption.FieldCustomName:
+		m.ResetCustomName()
+		return nil
+	case deviceoption.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown DeviceOption field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *DeviceOptionMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *DeviceOptionMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *DeviceOptionMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *DeviceOptionMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *DeviceOptionMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *DeviceOptionMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *DeviceOptionMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown DeviceOption unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *DeviceOptionMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown DeviceOption edge %s", name)
+}
+
+// DisplayPreferenceMutation represents an operation that mutates the DisplayPreference nodes in the graph.
+type DisplayPreferenceMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *uuid.UUID
+	user_id       *uuid.UUID
+	prefs_id      *string
+	client_name   *string
+	data          *[]byte
+	updated_at    *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*DisplayPreference, error)
+	predicates    []predicate.DisplayPreference
+}
+
+var _ ent.Mutation = (*DisplayPreferenceMutation)(nil)
+
+// displaypreferenceOption allows management of the mutation configuration using functional options.
+type displaypreferenceOption func(*DisplayPreferenceMutation)
+
+// newDisplayPreferenceMutation creates new mutation for the DisplayPreference entity.
+func newDisplayPreferenceMutation(c config, op Op, opts ...displaypreferenceOption) *DisplayPreferenceMutation {
+	m := &DisplayPreferenceMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeDisplayPreference,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withDisplayPreferenceID sets the ID field of the mutation.
+func withDisplayPreferenceID(id uuid.UUID) displaypreferenceOption {
+	return func(m *DisplayPreferenceMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *DisplayPreference
+		)
+		m.oldValue = func(ctx context.Context) (*DisplayPreference, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().DisplayPreference.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withDisplayPreference sets the old DisplayPreference of the mutation.
+func withDisplayPreference(node *DisplayPreference) displaypreferenceOption {
+	return func(m *DisplayPreferenceMutation) {
+		m.oldValue = func(context.Context) (*DisplayPreference, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m DisplayPreferenceMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m DisplayPreferenceMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of DisplayPreference entities.
+func (m *DisplayPreferenceMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *DisplayPreferenceMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *DisplayPreferenceMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().DisplayPreference.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetUserID sets the "user_id" field.
+func (m *DisplayPreferenceMutation) SetUserID(u uuid.UUID) {
+	m.user_id = &u
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *DisplayPreferenceMutation) UserID() (r uuid.UUID, exists bool) {
+	v := m.user_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the DisplayPreference entity.
+// If the DisplayPreference object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DisplayPreferenceMutation) OldUserID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *DisplayPreferenceMutation) ResetUserID() {
+	m.user_id = nil
+}
+
+// SetPrefsID sets the "prefs_id" field.
+func (m *DisplayPreferenceMutation) SetPrefsID(s string) {
+	m.prefs_id = &s
+}
+
+// PrefsID returns the value of the "prefs_id" field in the mutation.
+func (m *DisplayPreferenceMutation) PrefsID() (r string, exists bool) {
+	v := m.prefs_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPrefsID returns the old "prefs_id" field's value of the DisplayPreference entity.
+// If the DisplayPreference object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DisplayPreferenceMutation) OldPrefsID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPrefsID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPrefsID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPrefsID: %w", err)
+	}
+	return oldValue.PrefsID, nil
+}
+
+// ResetPrefsID resets all changes to the "prefs_id" field.
+func (m *DisplayPreferenceMutation) ResetPrefsID() {
+	m.prefs_id = nil
+}
+
+// SetClientName sets the "client_name" field.
+func (m *DisplayPreferenceMutation) SetClientName(s string) {
+	m.client_name = &s
+}
+
+// ClientName returns the value of the "client_name" field in the mutation.
+func (m *DisplayPreferenceMutation) ClientName() (r string, exists bool) {
+	v := m.client_name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldClientName returns the old "client_name" field's value of the DisplayPreference entity.
+// If the DisplayPreference object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DisplayPreferenceMutation) OldClientName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldClientName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldClientName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldClientName: %w", err)
+	}
+	return oldValue.ClientName, nil
+}
+
+// ResetClientName resets all changes to the "client_name" field.
+func (m *DisplayPreferenceMutation) ResetClientName() {
+	m.client_name = nil
+}
+
+// SetData sets the "data" field.
+func (m *DisplayPreferenceMutation) SetData(b []byte) {
+	m.data = &b
+}
+
+// Data returns the value of the "data" field in the mutation.
+func (m *DisplayPreferenceMutation) Data() (r []byte, exists bool) {
+	v := m.data
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldData returns the old "data" field's value of the DisplayPreference entity.
+// If the DisplayPreference object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DisplayPreferenceMutation) OldData(ctx context.Context) (v []byte, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldData is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldData requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldData: %w", err)
+	}
+	return oldValue.Data, nil
+}
+
+// ResetData resets all changes to the "data" field.
+func (m *DisplayPreferenceMutation) ResetData() {
+	m.data = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *DisplayPreferenceMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *DisplayPreferenceMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the DisplayPreference entity.
+// If the DisplayPreference object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DisplayPreferenceMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *DisplayPreferenceMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// Where appends a list predicates to the DisplayPreferenceMutation builder.
+func (m *DisplayPreferenceMutation) Where(ps ...predicate.DisplayPreference) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the DisplayPreferenceMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *DisplayPreferenceMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.DisplayPreference, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *DisplayPreferenceMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *DisplayPreferenceMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (DisplayPreference).
+func (m *DisplayPreferenceMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *DisplayPreferenceMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.user_id != nil {
+		fields = append(fields, displaypreference.FieldUserID)
+	}
+	if m.prefs_id != nil {
+		fields = append(fields, displaypreference.FieldPrefsID)
+	}
+	if m.client_name != nil {
+		fields = append(fields, displaypreference.FieldClientName)
+	}
+	if m.data != nil {
+		fields = append(fields, displaypreference.FieldData)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, displaypreference.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *DisplayPreferenceMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case displaypreference.FieldUserID:
+		return m.UserID()
+	case displaypreference.FieldPrefsID:
+		return m.PrefsID()
+	case displaypreference.FieldClientName:
+		return m.ClientName()
+	case displaypreference.FieldData:
+		return m.Data()
+	case displaypreference.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *DisplayPreferenceMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case displaypreference.FieldUserID:
+		return m.OldUserID(ctx)
+	case displaypreference.FieldPrefsID:
+		return m.OldPrefsID(ctx)
+	case displaypreference.FieldClientName:
+		return m.OldClientName(ctx)
+	case displaypreference.FieldData:
+		return m.OldData(ctx)
+	case displaypreference.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown DisplayPreference field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *DisplayPreferenceMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case displaypreference.FieldUserID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case displaypreference.FieldPrefsID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPrefsID(v)
+		return nil
+	case displaypreference.FieldClientName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetClientName(v)
+		return nil
+	case displaypreference.FieldData:
+		v, ok := value.([]byte)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetData(v)
+		return nil
+	case displaypreference.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown DisplayPreference field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *DisplayPreferenceMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *DisplayPreferenceMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *DisplayPreferenceMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown DisplayPreference numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *DisplayPreferenceMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *DisplayPreferenceMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *DisplayPreferenceMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown DisplayPreference nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *DisplayPreferenceMutation) ResetField(name string) error {
+	switch name {
+	case displaypreference.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case displaypreference.FieldPrefsID:
+		m.ResetPrefsID()
+		return nil
+	case displaypreference.FieldClientName:
+		m.ResetClientName()
+		return nil
+	case displaypreference.FieldData:
+		m.ResetData()
+		return nil
+	case displaypreference.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown DisplayPreference field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *DisplayPreferenceMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *DisplayPreferenceMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *DisplayPreferenceMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *DisplayPreferenceMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *DisplayPreferenceMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *DisplayPreferenceMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *DisplayPreferenceMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown DisplayPreference unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *DisplayPreferenceMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown DisplayPreference edge %s", name)
+}
+
+// DuplicateItemMutation represents an operation that mutates the DuplicateItem nodes in the graph.
+type DuplicateItemMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *uuid.UUID
+	dup_id        *string
+	copies        *[]string
+	appendcopies  []string
+	updated_at    *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*DuplicateItem, error)
+	predicates    []predicate.DuplicateItem
+}
+
+var _ ent.Mutation = (*DuplicateItemMutation)(nil)
+
+// duplicateitemOption allows management of the mutation configuration using functional options.
+type duplicateitemOption func(*DuplicateItemMutation)
+
+// newDuplicateItemMutation creates new mutation for the DuplicateItem entity.
+func newDuplicateItemMutation(c config, op Op, opts ...duplicateitemOption) *DuplicateItemMutation {
+	m := &DuplicateItemMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeDuplicateItem,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withDuplicateItemID sets the ID field of the mutation.
+func withDuplicateItemID(id uuid.UUID) duplicateitemOption {
+	return func(m *DuplicateItemMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *DuplicateItem
+		)
+		m.oldValue = func(ctx context.Context) (*DuplicateItem, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().DuplicateItem.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withDuplicateItem sets the old DuplicateItem of the mutation.
+func withDuplicateItem(node *DuplicateItem) duplicateitemOption {
+	return func(m *DuplicateItemMutation) {
+		m.oldValue = func(context.Context) (*DuplicateItem, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m DuplicateItemMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m DuplicateItemMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of DuplicateItem entities.
+func (m *DuplicateItemMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *DuplicateItemMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *DuplicateItemMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().DuplicateItem.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetDupID sets the "dup_id" field.
+func (m *DuplicateItemMutation) SetDupID(s string) {
+	m.dup_id = &s
+}
+
+// DupID returns the value of the "dup_id" field in the mutation.
+func (m *DuplicateItemMutation) DupID() (r string, exists bool) {
+	v := m.dup_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDupID returns the old "dup_id" field's value of the DuplicateItem entity.
+// If the DuplicateItem object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DuplicateItemMutation) OldDupID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDupID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDupID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDupID: %w", err)
+	}
+	return oldValue.DupID, nil
+}
+
+// ResetDupID resets all changes to the "dup_id" field.
+func (m *DuplicateItemMutation) ResetDupID() {
+	m.dup_id = nil
+}
+
+// SetCopies sets the "copies" field.
+func (m *DuplicateItemMutation) SetCopies(s []string) {
+	m.copies = &s
+	m.appendcopies = nil
+}
+
+// Copies returns the value of the "copies" field in the mutation.
+func (m *DuplicateItemMutation) Copies() (r []string, exists bool) {
+	v := m.copies
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCopies returns the old "copies" field's value of the DuplicateItem entity.
+// If the DuplicateItem object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DuplicateItemMutation) OldCopies(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCopies is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCopies requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCopies: %w", err)
+	}
+	return oldValue.Copies, nil
+}
+
+// AppendCopies adds s to the "copies" field.
+func (m *DuplicateItemMutation) AppendCopies(s []string) {
+	m.appendcopies = append(m.appendcopies, s...)
+}
+
+// AppendedCopies returns the list of values that were appended to the "copies" field in this mutation.
+func (m *DuplicateItemMutation) AppendedCopies() ([]string, bool) {
+	if len(m.appendcopies) == 0 {
+		return nil, false
+	}
+	return m.appendcopies, true
+}
+
+// ResetCopies resets all changes to the "copies" field.
+func (m *DuplicateItemMutation) ResetCopies() {
+	m.copies = nil
+	m.appendcopies = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *DuplicateItemMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *DuplicateItemMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the DuplicateItem entity.
+// If the DuplicateItem object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DuplicateItemMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *DuplicateItemMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// Where appends a list predicates to the DuplicateItemMutation builder.
+func (m *DuplicateItemMutation) Where(ps ...predicate.DuplicateItem) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the DuplicateItemMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *DuplicateItemMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.DuplicateItem, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *DuplicateItemMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *DuplicateItemMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (DuplicateItem).
+func (m *DuplicateItemMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *DuplicateItemMutation) Fields() []string {
+	fields := make([]string, 0, 3)
+	if m.dup_id != nil {
+		fields = append(fields, duplicateitem.FieldDupID)
+	}
+	if m.copies != nil {
+		fields = append(fields, duplicateitem.FieldCopies)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, duplicateitem.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *DuplicateItemMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case duplicateitem.FieldDupID:
+		return m.DupID()
+	case duplicateitem.FieldCopies:
+		return m.Copies()
+	case duplicateitem.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *DuplicateItemMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case duplicateitem.FieldDupID:
+		return m.OldDupID(ctx)
+	case duplicateitem.FieldCopies:
+		return m.OldCopies(ctx)
+	case duplicateitem.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown DuplicateItem field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *DuplicateItemMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case duplicateitem.FieldDupID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDupID(v)
+		return nil
+	case duplicateitem.FieldCopies:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCopies(v)
+		return nil
+	case duplicateitem.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown DuplicateItem field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *DuplicateItemMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *DuplicateItemMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *DuplicateItemMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown DuplicateItem numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *DuplicateItemMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *DuplicateItemMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *DuplicateItemMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown DuplicateItem nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *DuplicateItemMutation) ResetField(name string) error {
+	switch name {
+	case duplicateitem.FieldDupID:
+		m.ResetDupID()
+		return nil
+	case duplicateitem.FieldCopies:
+		m.ResetCopies()
+		return nil
+	case duplicateitem.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown DuplicateItem field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *DuplicateItemMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *DuplicateItemMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *DuplicateItemMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *DuplicateItemMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *DuplicateItemMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *DuplicateItemMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *DuplicateItemMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown DuplicateItem unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *DuplicateItemMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown DuplicateItem edge %s", name)
+}
+
+// ItemMutation represents an operation that mutates the Item nodes in the graph.
+type ItemMutation struct {
+	config
+	op                  Op
+	typ                 string
+	id                  *uuid.UUID
+	backend_item_id     *string
+	name                *string
+	sort_name           *string
+	item_type           *string
+	media_type          *string
+	library_id          *string
+	collection_type     *string
+	parent_id           *string
+	season_id           *string
+	series_id           *string
+	tmdb_id             *string
+	imdb_id             *string
+	tvdb_id             *string
+	genres              *string
+	tags                *string
+	official_rating     *string
+	production_year     *int
+	addproduction_year  *int
+	community_rating    *float64
+	addcommunity_rating *float64
+	premiere_date       *time.Time
+	date_created        *time.Time
+	data                *[]byte
+	indexed_at          *time.Time
+	clearedFields       map[string]struct{}
+	backend             *uuid.UUID
+	clearedbackend      bool
+	done                bool
+	oldValue            func(context.Context) (*Item, error)
+	predicates          []predicate.Item
+}
+
+var _ ent.Mutation = (*ItemMutation)(nil)
+
+// itemOption allows management of the mutation configuration using functional options.
+type itemOption func(*ItemMutation)
+
+// newItemMutation creates new mutation for the Item entity.
+func newItemMutation(c config, op Op, opts ...itemOption) *ItemMutation {
+	m := &ItemMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeItem,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withItemID sets the ID field of the mutation.
+func withItemID(id uuid.UUID) itemOption {
+	return func(m *ItemMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Item
+		)
+		m.oldValue = func(ctx context.Context) (*Item, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Item.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withItem sets the old Item of the mutation.
+func withItem(node *Item) itemOption {
+	return func(m *ItemMutation) {
+		m.oldValue = func(context.Context) (*Item, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ItemMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ItemMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Item entities.
+func (m *ItemMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ItemMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ItemMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Item.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetBackendItemID sets the "backend_item_id" field.
+func (m *ItemMutation) SetBackendItemID(s string) {
+	m.backend_item_id = &s
+}
+
+// BackendItemID returns the value of the "backend_item_id" field in the mutation.
+func (m *ItemMutation) BackendItemID() (r string, exists bool) {
+	v := m.backend_item_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBackendItemID returns the old "backend_item_id" field's value of the Item entity.
+// If the Item object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ItemMutation) OldBackendItemID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBackendItemID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBackendItemID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBackendItemID: %w", err)
+	}
+	return oldValue.BackendItemID, nil
+}
+
+// ResetBackendItemID resets all changes to the "backend_item_id" field.
+func (m *ItemMutation) ResetBackendItemID() {
+	m.backend_item_id = nil
+}
+
+// SetName sets the "name" field.
+func (m *ItemMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *ItemMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the Item entity.
+// If the Item object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ItemMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *ItemMutation) ResetName() {
+	m.name = nil
+}
+
+// SetSortName sets the "sort_name" field.
+func (m *ItemMutation) SetSortName(s string) {
+	m.sort_name = &s
+}
+
+// SortName returns the value of the "sort_name" field in the mutation.
+func (m *ItemMutation) SortName() (r string, exists bool) {
+	v := m.sort_name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSortName returns the old "sort_name" field's value of the Item entity.
+// If the Item object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ItemMutation) OldSortName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSortName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSortName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSortName: %w", err)
+	}
+	return oldValue.SortName, nil
+}
+
+// ResetSortName resets all changes to the "sort_name" field.
+func (m *ItemMutation) ResetSortName() {
+	m.sort_name = nil
+}
+
+// SetItemType sets the "item_type" field.
+func (m *ItemMutation) SetItemType(s string) {
+	m.item_type = &s
+}
+
+// ItemType returns the value of the "item_type" field in the mutation.
+func (m *ItemMutation) ItemType() (r string, exists bool) {
+	v := m.item_type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldItemType returns the old "item_type" field's value of the Item entity.
+// If the Item object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ItemMutation) OldItemType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldItemType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldItemType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldItemType: %w", err)
+	}
+	return oldValue.ItemType, nil
+}
+
+// ResetItemType resets all changes to the "item_type" field.
+func (m *ItemMutation) ResetItemType() {
+	m.item_type = nil
+}
+
+// SetMediaType sets the "media_type" field.
+func (m *ItemMutation) SetMediaType(s string) {
+	m.media_type = &s
+}
+
+// MediaType returns the value of the "media_type" field in the mutation.
+func (m *ItemMutation) MediaType() (r string, exists bool) {
+	v := m.media_type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMediaType returns the old "media_type" field's value of the Item entity.
+// If the Item object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ItemMutation) OldMediaType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMediaType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMediaType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMediaType: %w", err)
+	}
+	return oldValue.MediaType, nil
+}
+
+// ClearMediaType clears the value of the "media_type" field.
+func (m *ItemMutation) ClearMediaType() {
+	m.media_type = nil
+	m.clearedFields[item.FieldMediaType] = struct{}{}
+}
+
+// MediaTypeCleared returns if the "media_type" field was cleared in this mutation.
+func (m *ItemMutation) MediaTypeCleared() bool {
+	_, ok := m.clearedFields[item.FieldMediaType]
+	return ok
+}
+
+// ResetMediaType resets all changes to the "media_type" field.
+func (m *ItemMutation) ResetMediaType() {
+	m.media_type = nil
+	delete(m.clearedFields, item.FieldMediaType)
+}
+
+// SetLibraryID sets the "library_id" field.
+func (m *ItemMutation) SetLibraryID(s string) {
+	m.library_id = &s
+}
+
+// LibraryID returns the value of the "library_id" field in the mutation.
+func (m *ItemMutation) LibraryID() (r string, exists bool) {
+	v := m.library_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLibraryID returns the old "library_id" field's value of the Item entity.
+// If the Item object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ItemMutation) OldLibraryID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLibraryID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLibraryID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLibraryID: %w", err)
+	}
+	return oldValue.LibraryID, nil
+}
+
+// ClearLibraryID clears the value of the "library_id" field.
+func (m *ItemMutation) ClearLibraryID() {
+	m.library_id = nil
+	m.clearedFields[item.FieldLibraryID] = struct{}{}
+}
+
+// LibraryIDCleared returns if the "library_id" field was cleared in this mutation.
+func (m *ItemMutation) LibraryIDCleared() bool {
+	_, ok := m.clearedFields[item.FieldLibraryID]
+	return ok
+}
+
+// ResetLibraryID resets all changes to the "library_id" field.
+func (m *ItemMutation) ResetLibraryID() {
+	m.library_id = nil
+	delete(m.clearedFields, item.FieldLibraryID)
+}
+
+// SetCollectionType sets the "collection_type" field.
+func (m *ItemMutation) SetCollectionType(s string) {
+	m.collection_type = &s
+}
+
+// CollectionType returns the value of the "collection_type" field in the mutation.
+func (m *ItemMutation) CollectionType() (r string, exists bool) {
+	v := m.collection_type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCollectionType returns the old "collection_type" field's value of the Item entity.
+// If the Item object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ItemMutation) OldCollectionType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCollectionType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCollectionType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCollectionType: %w", err)
+	}
+	return oldValue.CollectionType, nil
+}
+
+// ClearCollectionType clears the value of the "collection_type" field.
+func (m *ItemMutation) ClearCollectionType() {
+	m.collection_type = nil
+	m.clearedFields[item.FieldCollectionType] = struct{}{}
+}
+
+// CollectionTypeCleared returns if the "collection_type" field was cleared in this mutation.
+func (m *ItemMutation) CollectionTypeCleared() bool {
+	_, ok := m.clearedFields[item.FieldCollectionType]
+	return ok
+}
+
+// ResetCollectionType resets all changes to the "collection_type" field.
+func (m *ItemMutation) ResetCollectionType() {
+	m.collection_type = nil
+	delete(m.clearedFields, item.FieldCollectionType)
+}
+
+// SetParentID sets the "parent_id" field.
+func (m *ItemMutation) SetParentID(s string) {
+	m.parent_id = &s
+}
+
+// ParentID returns the value of the "parent_id" field in the mutation.
+func (m *ItemMutation) ParentID() (r string, exists bool) {
+	v := m.parent_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldParentID returns the old "parent_id" field's value of the Item entity.
+// If the Item object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ItemMutation) OldParentID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldParentID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldParentID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldParentID: %w", err)
+	}
+	return oldValue.ParentID, nil
+}
+
+// ClearParentID clears the value of the "parent_id" field.
+func (m *ItemMutation) ClearParentID() {
+	m.parent_id = nil
+	m.clearedFields[item.FieldParentID] = struct{}{}
+}
+
+// ParentIDCleared returns if the "parent_id" field was cleared in this mutation.
+func (m *ItemMutation) ParentIDCleared() bool {
+	_, ok := m.clearedFields[item.FieldParentID]
+	return ok
+}
+
+// ResetParentID resets all changes to the "parent_id" field.
+func (m *ItemMutation) ResetParentID() {
+	m.parent_id = nil
+	delete(m.clearedFields, item.FieldParentID)
+}
+
+// SetSeasonID sets the "season_id" field.
+func (m *ItemMutation) SetSeasonID(s string) {
+	m.season_id = &s
+}
+
+// SeasonID returns the value of the "season_id" field in the mutation.
+func (m *ItemMutation) SeasonID() (r string, exists bool) {
+	v := m.season_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSeasonID returns the old "season_id" field's value of the Item entity.
+// If the Item object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ItemMutation) OldSeasonID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSeasonID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSeasonID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSeasonID: %w", err)
+	}
+	return oldValue.SeasonID, nil
+}
+
+// ClearSeasonID clears the value of the "season_id" field.
+func (m *ItemMutation) ClearSeasonID() {
+	m.season_id = nil
+	m.clearedFields[item.FieldSeasonID] = struct{}{}
+}
+
+// SeasonIDCleared returns if the "season_id" field was cleared in this mutation.
+func (m *ItemMutation) SeasonIDCleared() bool {
+	_, ok := m.clearedFields[item.FieldSeasonID]
+	return ok
+}
+
+// ResetSeasonID resets all changes to the "season_id" field.
+func (m *ItemMutation) ResetSeasonID() {
+	m.season_id = nil
+	delete(m.clearedFields, item.FieldSeasonID)
+}
+
+// SetSeriesID sets the "series_id" field.
+func (m *ItemMutation) SetSeriesID(s string) {
+	m.series_id = &s
+}
+
+// SeriesID returns the value of the "series_id" field in the mutation.
+func (m *ItemMutation) SeriesID() (r string, exists bool) {
+	v := m.series_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSeriesID returns the old "series_id" field's value of the Item entity.
+// If the Item object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ItemMutation) OldSeriesID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSeriesID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSeriesID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSeriesID: %w", err)
+	}
+	return oldValue.SeriesID, nil
+}
+
+// ClearSeriesID clears the value of the "series_id" field.
+func (m *ItemMutation) ClearSeriesID() {
+	m.series_id = nil
+	m.clearedFields[item.FieldSeriesID] = struct{}{}
+}
+
+// SeriesIDCleared returns if the "series_id" field was cleared in this mutation.
+func (m *ItemMutation) SeriesIDCleared() bool {
+	_, ok := m.clearedFields[item.FieldSeriesID]
+	return ok
+}
+
+// ResetSeriesID resets all changes to the "series_id" field.
+func (m *ItemMutation) ResetSeriesID() {
+	m.series_id = nil
+	delete(m.clearedFields, item.FieldSeriesID)
+}
+
+// SetTmdbID sets the "tmdb_id" field.
+func (m *ItemMutation) SetTmdbID(s string) {
+	m.tmdb_id = &s
+}
+
+// TmdbID returns the value of the "tmdb_id" field in the mutation.
+func (m *ItemMutation) TmdbID() (r string, exists bool) {
+	v := m.tmdb_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTmdbID returns the old "tmdb_id" field's value of the Item entity.
+// If the Item object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ItemMutation) OldTmdbID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTmdbID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTmdbID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTmdbID: %w", err)
+	}
+	return oldValue.TmdbID, nil
+}
+
+// ClearTmdbID clears the value of the "tmdb_id" field.
+func (m *ItemMutation) ClearTmdbID() {
+	m.tmdb_id = nil
+	m.clearedFields[item.FieldTmdbID] = struct{}{}
+}
+
+// TmdbIDCleared returns if the "tmdb_id" field was cleared in this mutation.
+func (m *ItemMutation) TmdbIDCleared() bool {
+	_, ok := m.clearedFields[item.FieldTmdbID]
+	return ok
+}
+
+// ResetTmdbID resets all changes to the "tmdb_id" field.
+func (m *ItemMutation) ResetTmdbID() {
+	m.tmdb_id = nil
+	delete(m.clearedFields, item.FieldTmdbID)
+}
+
+// SetImdbID sets the "imdb_id" field.
+func (m *ItemMutation) SetImdbID(s string) {
+	m.imdb_id = &s
+}
+
+// ImdbID returns the value of the "imdb_id" field in the mutation.
+func (m *ItemMutation) ImdbID() (r string, exists bool) {
+	v := m.imdb_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldImdbID returns the old "imdb_id" field's value of the Item entity.
+// If the Item object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ItemMutation) OldImdbID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldImdbID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldImdbID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldImdbID: %w", err)
+	}
+	return oldValue.ImdbID, nil
+}
+
+// ClearImdbID clears the value of the "imdb_id" field.
+func (m *ItemMutation) ClearImdbID() {
+	m.imdb_id = nil
+	m.clearedFields[item.FieldImdbID] = struct{}{}
+}
+
+// ImdbIDCleared returns if the "imdb_id" field was cleared in this mutation.
+func (m *ItemMutation) ImdbIDCleared() bool {
+	_, ok := m.clearedFields[item.FieldImdbID]
+	return ok
+}
+
+// ResetImdbID resets all changes to the "imdb_id" field.
+func (m *ItemMutation) ResetImdbID() {
+	m.imdb_id = nil
+	delete(m.clearedFields, item.FieldImdbID)
+}
+
+// SetTvdbID sets the "tvdb_id" field.
+func (m *ItemMutation) SetTvdbID(s string) {
+	m.tvdb_id = &s
+}
+
+// TvdbID returns the value of the "tvdb_id" field in the mutation.
+func (m *ItemMutation) TvdbID() (r string, exists bool) {
+	v := m.tvdb_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTvdbID returns the old "tvdb_id" field's value of the Item entity.
+// If the Item object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ItemMutation) OldTvdbID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTvdbID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTvdbID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTvdbID: %w", err)
+	}
+	return oldValue.TvdbID, nil
+}
+
+// ClearTvdbID clears the value of the "tvdb_id" field.
+func (m *ItemMutation) ClearTvdbID() {
+	m.tvdb_id = nil
+	m.clearedFields[item.FieldTvdbID] = struct{}{}
+}
+
+// TvdbIDCleared returns if the "tvdb_id" field was cleared in this mutation.
+func (m *ItemMutation) TvdbIDCleared() bool {
+	_, ok := m.clearedFields[item.FieldTvdbID]
+	return ok
+}
+
+// ResetTvdbID resets all changes to the "tvdb_id" field.
+func (m *ItemMutation) ResetTvdbID() {
+	m.tvdb_id = nil
+	delete(m.clearedFields, item.FieldTvdbID)
+}
+
+// SetGenres sets the "genres" field.
+func (m *ItemMutation) SetGenres(s string) {
+	m.genres = &s
+}
+
+// Genres returns the value of the "genres" field in the mutation.
+func (m *ItemMutation) Genres() (r string, exists bool) {
+	v := m.genres
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldGenres returns the old "genres" field's value of the Item entity.
+// If the Item object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ItemMutation) OldGenres(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldGenres is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldGenres requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldGenres: %w", err)
+	}
+	return oldValue.Genres, nil
+}
+
+// ClearGenres clears the value of the "genres" field.
+func (m *ItemMutation) ClearGenres() {
+	m.genres = nil
+	m.clearedFields[item.FieldGenres] = struct{}{}
+}
+
+// GenresCleared returns if the "genres" field was cleared in this mutation.
+func (m *ItemMutation) GenresCleared() bool {
+	_, ok := m.clearedFields[item.FieldGenres]
+	return ok
+}
+
+// ResetGenres resets all changes to the "genres" field.
+func (m *ItemMutation) ResetGenres() {
+	m.genres = nil
+	delete(m.clearedFields, item.FieldGenres)
+}
+
+// SetTags sets the "tags" field.
+func (m *ItemMutation) SetTags(s string) {
+	m.tags = &s
+}
+
+// Tags returns the value of the "tags" field in the mutation.
+func (m *ItemMutation) Tags() (r string, exists bool) {
+	v := m.tags
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTags returns the old "tags" field's value of the Item entity.
+// If the Item object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ItemMutation) OldTags(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTags is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTags requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTags: %w", err)
+	}
+	return oldValue.Tags, nil
+}
+
+// ClearTags clears the value of the "tags" field.
+func (m *ItemMutation) ClearTags() {
+	m.tags = nil
+	m.clearedFields[item.FieldTags] = struct{}{}
+}
+
+// TagsCleared returns if the "tags" field was cleared in this mutation.
+func (m *ItemMutation) TagsCleared() bool {
+	_, ok := m.clearedFields[item.FieldTags]
+	return ok
+}
+
+// ResetTags resets all changes to the "tags" field.
+func (m *ItemMutation) ResetTags() {
+	m.tags = nil
+	delete(m.clearedFields, item.FieldTags)
+}
+
+// SetOfficialRating sets the "official_rating" field.
+func (m *ItemMutation) SetOfficialRating(s string) {
+	m.official_rating = &s
+}
+
+// OfficialRating returns the value of the "official_rating" field in the mutation.
+func (m *ItemMutation) OfficialRating() (r string, exists bool) {
+	v := m.official_rating
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOfficialRating returns the old "official_rating" field's value of the Item entity.
+// If the Item object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ItemMutation) OldOfficialRating(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOfficialRating is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOfficialRating requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOfficialRating: %w", err)
+	}
+	return oldValue.OfficialRating, nil
+}
+
+// ClearOfficialRating clears the value of the "official_rating" field.
+func (m *ItemMutation) ClearOfficialRating() {
+	m.official_rating = nil
+	m.clearedFields[item.FieldOfficialRating] = struct{}{}
+}
+
+// OfficialRatingCleared returns if the "official_rating" field was cleared in this mutation.
+func (m *ItemMutation) OfficialRatingCleared() bool {
+	_, ok := m.clearedFields[item.FieldOfficialRating]
+	return ok
+}
+
+// ResetOfficialRating resets all changes to the "official_rating" field.
+func (m *ItemMutation) ResetOfficialRating() {
+	m.official_rating = nil
+	delete(m.clearedFields, item.FieldOfficialRating)
+}
+
+// SetProductionYear sets the "production_year" field.
+func (m *ItemMutation) SetProductionYear(i int) {
+	m.production_year = &i
+	m.addproduction_year = nil
+}
+
+// ProductionYear returns the value of the "production_year" field in the mutation.
+func (m *ItemMutation) ProductionYear() (r int, exists bool) {
+	v := m.production_year
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldProductionYear returns the old "production_year" field's value of the Item entity.
+// If the Item object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ItemMutation) OldProductionYear(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldProductionYear is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldProductionYear requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldProductionYear: %w", err)
+	}
+	return oldValue.ProductionYear, nil
+}
+
+// AddProductionYear adds i to the "production_year" field.
+func (m *ItemMutation) AddProductionYear(i int) {
+	if m.addproduction_year != nil {
+		*m.addproduction_year += i
+	} else {
+		m.addproduction_year = &i
+	}
+}
+
+// AddedProductionYear returns the value that was added to the "production_year" field in this mutation.
+func (m *ItemMutation) AddedProductionYear() (r int, exists bool) {
+	v := m.addproduction_year
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearProductionYear clears the value of the "production_year" field.
+func (m *ItemMutation) ClearProductionYear() {
+	m.production_year = nil
+	m.addproduction_year = nil
+	m.clearedFields[item.FieldProductionYear] = struct{}{}
+}
+
+// ProductionYearCleared returns if the "production_year" field was cleared in this mutation.
+func (m *ItemMutation) ProductionYearCleared() bool {
+	_, ok := m.clearedFields[item.FieldProductionYear]
+	return ok
+}
+
+// ResetProductionYear resets all changes to the "production_year" field.
+func (m *ItemMutation) ResetProductionYear() {
+	m.production_year = nil
+	m.addproduction_year = nil
+	delete(m.clearedFields, item.FieldProductionYear)
+}
+
+// SetCommunityRating sets the "community_rating" field.
+func (m *ItemMutation) SetCommunityRating(f float64) {
+	m.community_rating = &f
+	m.addcommunity_rating = nil
+}
+
+// CommunityRating returns the value of the "community_rating" field in the mutation.
+func (m *ItemMutation) CommunityRating() (r float64, exists bool) {
+	v := m.community_rating
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCommunityRating returns the old "community_rating" field's value of the Item entity.
+// If the Item object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ItemMutation) OldCommunityRating(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCommunityRating is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCommunityRating requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCommunityRating: %w", err)
+	}
+	return oldValue.CommunityRating, nil
+}
+
+// AddCommunityRating adds f to the "community_rating" field.
+func (m *ItemMutation) AddCommunityRating(f float64) {
+	if m.addcommunity_rating != nil {
+		*m.addcommunity_rating += f
+	} else {
+		m.addcommunity_rating = &f
+	}
+}
+
+// AddedCommunityRating returns the value that was added to the "community_rating" field in this mutation.
+func (m *ItemMutation) AddedCommunityRating() (r float64, exists bool) {
+	v := m.addcommunity_rating
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearCommunityRating clears the value of the "community_rating" field.
+func (m *ItemMutation) ClearCommunityRating() {
+	m.community_rating = nil
+	m.addcommunity_rating = nil
+	m.clearedFields[item.FieldCommunityRating] = struct{}{}
+}
+
+// CommunityRatingCleared returns if the "community_rating" field was cleared in this mutation.
+func (m *ItemMutation) CommunityRatingCleared() bool {
+	_, ok := m.clearedFields[item.FieldCommunityRating]
+	return ok
+}
+
+// ResetCommunityRating resets all changes to the "community_rating" field.
+func (m *ItemMutation) ResetCommunityRating() {
+	m.community_rating = nil
+	m.addcommunity_rating = nil
+	delete(m.clearedFields, item.FieldCommunityRating)
+}
+
+// SetPremiereDate sets the "premiere_date" field.
+func (m *ItemMutation) SetPremiereDate(t time.Time) {
+	m.premiere_date = &t
+}
+
+// PremiereDate returns the value of the "premiere_date" field in the mutation.
+func (m *ItemMutation) PremiereDate() (r time.Time, exists bool) {
+	v := m.premiere_date
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPremiereDate returns the old "premiere_date" field's value of the Item entity.
+// If the Item object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ItemMutation) OldPremiereDate(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPremiereDate is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPremiereDate requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPremiereDate: %w", err)
+	}
+	return oldValue.PremiereDate, nil
+}
+
+// ClearPremiereDate clears the value of the "premiere_date" field.
+func (m *ItemMutation) ClearPremiereDate() {
+	m.premiere_date = nil
+	m.clearedFields[item.FieldPremiereDate] = struct{}{}
+}
+
+// PremiereDateCleared returns if the "premiere_date" field was cleared in this mutation.
+func (m *ItemMutation) PremiereDateCleared() bool {
+	_, ok := m.clearedFields[item.FieldPremiereDate]
+	return ok
+}
+
+// ResetPremiereDate resets all changes to the "premiere_date" field.
+func (m *ItemMutation) ResetPremiereDate() {
+	m.premiere_date = nil
+	delete(m.clearedFields, item.FieldPremiereDate)
+}
+
+// SetDateCreated sets the "date_created" field.
+func (m *ItemMutation) SetDateCreated(t time.Time) {
+	m.date_created = &t
+}
+
+// DateCreated returns the value of the "date_created" field in the mutation.
+func (m *ItemMutation) DateCreated() (r time.Time, exists bool) {
+	v := m.date_created
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDateCreated returns the old "date_created" field's value of the Item entity.
+// If the Item object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ItemMutation) OldDateCreated(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDateCreated is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDateCreated requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDateCreated: %w", err)
+	}
+	return oldValue.DateCreated, nil
+}
+
+// ClearDateCreated clears the value of the "date_created" field.
+func (m *ItemMutation) ClearDateCreated() {
+	m.date_created = nil
+	m.clearedFields[item.FieldDateCreated] = struct{}{}
+}
+
+// DateCreatedCleared returns if the "date_created" field was cleared in this mutation.
+func (m *ItemMutation) DateCreatedCleared() bool {
+	_, ok := m.clearedFields[item.FieldDateCreated]
+	return ok
+}
+
+// ResetDateCreated resets all changes to the "date_created" field.
+func (m *ItemMutation) ResetDateCreated() {
+	m.date_created = nil
+	delete(m.clearedFields, item.FieldDateCreated)
+}
+
+// SetData sets the "data" field.
+func (m *ItemMutation) SetData(b []byte) {
+	m.data = &b
+}
+
+// Data returns the value of the "data" field in the mutation.
+func (m *ItemMutation) Data() (r []byte, exists bool) {
+	v := m.data
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldData returns the old "data" field's value of the Item entity.
+// If the Item object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ItemMutation) OldData(ctx context.Context) (v []byte, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldData is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldData requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldData: %w", err)
+	}
+	return oldValue.Data, nil
+}
+
+// ResetData resets all changes to the "data" field.
+func (m *ItemMutation) ResetData() {
+	m.data = nil
+}
+
+// SetIndexedAt sets the "indexed_at" field.
+func (m *ItemMutation) SetIndexedAt(t time.Time) {
+	m.indexed_at = &t
+}
+
+// IndexedAt returns the value of the "indexed_at" field in the mutation.
+func (m *ItemMutation) IndexedAt() (r time.Time, exists bool) {
+	v := m.indexed_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIndexedAt returns the old "indexed_at" field's value of the Item entity.
+// If the Item object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ItemMutation) OldIndexedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIndexedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIndexedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIndexedAt: %w", err)
+	}
+	return oldValue.IndexedAt, nil
+}
+
+// ResetIndexedAt resets all changes to the "indexed_at" field.
+func (m *ItemMutation) ResetIndexedAt() {
+	m.indexed_at = nil
+}
+
+// SetBackendID sets the "backend" edge to the Backend entity by id.
+func (m *ItemMutation) SetBackendID(id uuid.UUID) {
+	m.backend = &id
+}
+
+// ClearBackend clears the "backend" edge to the Backend entity.
+func (m *ItemMutation) ClearBackend() {
+	m.clearedbackend = true
+}
+
+// BackendCleared reports if the "backend" edge to the Backend entity was cleared.
+func (m *ItemMutation) BackendCleared() bool {
+	return m.clearedbackend
+}
+
+// BackendID returns the "backend" edge ID in the mutation.
+func (m *ItemMutation) BackendID() (id uuid.UUID, exists bool) {
+	if m.backend != nil {
+		return *m.backend, true
+	}
+	return
+}
+
+// BackendIDs returns the "backend" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// BackendID instead. It exists only for internal usage by the builders.
+func (m *ItemMutation) BackendIDs() (ids []uuid.UUID) {
+	if id := m.backend; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetBackend resets all changes to the "backend" edge.
+func (m *ItemMutation) ResetBackend() {
+	m.backend = nil
+	m.clearedbackend = false
+}
+
+// Where appends a list predicates to the ItemMutation builder.
+func (m *ItemMutation) Where(ps ...predicate.Item) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ItemMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ItemMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Item, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ItemMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ItemMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Item).
+func (m *ItemMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ItemMutation) Fields() []string {
+	fields := make([]string, 0, 22)
+	if m.backend_item_id != nil {
+		fields = append(fields, item.FieldBackendItemID)
+	}
+	if m.name != nil {
+		fields = append(fields, item.FieldName)
+	}
+	if m.sort_name != nil {
+		fields = append(fields, item.FieldSortName)
+	}
+	if m.item_type != nil {
+		fields = append(fields, item.FieldItemType)
+	}
+	if m.media_type != nil {
+		fields = append(fields, item.FieldMediaType)
+	}
+	if m.library_id != nil {
+		fields = append(fields, item.FieldLibraryID)
+	}
+	if m.collection_type != nil {
+		fields = append(fields, item.FieldCollectionType)
+	}
+	if m.parent_id != nil {
+		fields = append(fields, item.FieldParentID)
+	}
+	if m.season_id != nil {
+		fields = append(fields, item.FieldSeasonID)
+	}
+	if m.series_id != nil {
+		fields = append(fields, item.FieldSeriesID)
+	}
+	if m.tmdb_id != nil {
+		fields = append(fields, item.FieldTmdbID)
+	}
+	if m.imdb_id != nil {
+		fields = append(fields, item.FieldImdbID)
+	}
+	if m.tvdb_id != nil {
+		fields = append(fields, item.FieldTvdbID)
+	}
+	if m.genres != nil {
+		fields = append(fields, item.FieldGenres)
+	}
+	if m.tags != nil {
+		fields = append(fields, item.FieldTags)
+	}
+	if m.official_rating != nil {
+		fields = append(fields, item.FieldOfficialRating)
+	}
+	if m.production_year != nil {
+		fields = append(fields, item.FieldProductionYear)
+	}
+	if m.community_rating != nil {
+		fields = append(fields, item.FieldCommunityRating)
+	}
+	if m.premiere_date != nil {
+		fields = append(fields, item.FieldPremiereDate)
+	}
+	if m.date_created != nil {
+		fields = append(fields, item.FieldDateCreated)
+	}
+	if m.data != nil {
+		fields = append(fields, item.FieldData)
+	}
+	if m.indexed_at != nil {
+		fields = append(fields, item.FieldIndexedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ItemMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case item.FieldBackendItemID:
+		return m.BackendItemID()
+	case item.FieldName:
+		return m.Name()
+	case item.FieldSortName:
+		return m.SortName()
+	case item.FieldItemType:
+		return m.ItemType()
+	case item.FieldMediaType:
+		return m.MediaType()
+	case item.FieldLibraryID:
+		return m.LibraryID()
+	case item.FieldCollectionType:
+		return m.CollectionType()
+	case item.FieldParentID:
+		return m.ParentID()
+	case item.FieldSeasonID:
+		return m.SeasonID()
+	case item.FieldSeriesID:
+		return m.SeriesID()
+	case item.FieldTmdbID:
+		return m.TmdbID()
+	case item.FieldImdbID:
+		return m.ImdbID()
+	case item.FieldTvdbID:
+		return m.TvdbID()
+	case item.FieldGenres:
+		return m.Genres()
+	case item.FieldTags:
+		return m.Tags()
+	case item.FieldOfficialRating:
+		return m.OfficialRating()
+	case item.FieldProductionYear:
+		return m.ProductionYear()
+	case item.FieldCommunityRating:
+		return m.CommunityRating()
+	case item.FieldPremiereDate:
+		return m.PremiereDate()
+	case item.FieldDateCreated:
+		return m.DateCreated()
+	case item.FieldData:
+		return m.Data()
+	case item.FieldIndexedAt:
+		return m.IndexedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ItemMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case item.FieldBackendItemID:
+		return m.OldBackendItemID(ctx)
+	case item.FieldName:
+		return m.OldName(ctx)
+	case item.FieldSortName:
+		return m.OldSortName(ctx)
+	case item.FieldItemType:
+		return m.OldItemType(ctx)
+	case item.FieldMediaType:
+		return m.OldMediaType(ctx)
+	case item.FieldLibraryID:
+		return m.OldLibraryID(ctx)
+	case item.FieldCollectionType:
+		return m.OldCollectionType(ctx)
+	case item.FieldParentID:
+		return m.OldParentID(ctx)
+	case item.FieldSeasonID:
+		return m.OldSeasonID(ctx)
+	case item.FieldSeriesID:
+		return m.OldSeriesID(ctx)
+	case item.FieldTmdbID:
+		return m.OldTmdbID(ctx)
+	case item.FieldImdbID:
+		return m.OldImdbID(ctx)
+	case item.FieldTvdbID:
+		return m.OldTvdbID(ctx)
+	case item.FieldGenres:
+		return m.OldGenres(ctx)
+	case item.FieldTags:
+		return m.OldTags(ctx)
+	case item.FieldOfficialRating:
+		return m.OldOfficialRating(ctx)
+	case item.FieldProductionYear:
+		return m.OldProductionYear(ctx)
+	case item.FieldCommunityRating:
+		return m.OldCommunityRating(ctx)
+	case item.FieldPremiereDate:
+		return m.OldPremiereDate(ctx)
+	case item.FieldDateCreated:
+		return m.OldDateCreated(ctx)
+	case item.FieldData:
+		return m.OldData(ctx)
+	case item.FieldIndexedAt:
+		return m.OldIndexedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Item field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ItemMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case item.FieldBackendItemID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBackendItemID(v)
+		return nil
+	case item.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case item.FieldSortName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSortName(v)
+		return nil
+	case item.FieldItemType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetItemType(v)
+		return nil
+	case item.FieldMediaType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMediaType(v)
+		return nil
+	case item.FieldLibraryID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLibraryID(v)
+		return nil
+	case item.FieldCollectionType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCollectionType(v)
+		return nil
+	case item.FieldParentID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetParentID(v)
+		return nil
+	case item.FieldSeasonID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSeasonID(v)
+		return nil
+	case item.FieldSeriesID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSeriesID(v)
+		return nil
+	case item.FieldTmdbID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTmdbID(v)
+		return nil
+	case item.FieldImdbID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetImdbID(v)
+		return nil
+	case item.FieldTvdbID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTvdbID(v)
+		return nil
+	case item.FieldGenres:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetGenres(v)
+		return nil
+	case item.FieldTags:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTags(v)
+		return nil
+	case item.FieldOfficialRating:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOfficialRating(v)
+		return nil
+	case item.FieldProductionYear:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetProductionYear(v)
+		return nil
+	case item.FieldCommunityRating:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCommunityRating(v)
+		return nil
+	case item.FieldPremiereDate:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPremiereDate(v)
+		return nil
+	case item.FieldDateCreated:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDateCreated(v)
+		return nil
+	case item.FieldData:
+		v, ok := value.([]byte)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetData(v)
+		return nil
+	case item.FieldIndexedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIndexedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Item field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ItemMutation) AddedFields() []string {
+	var fields []string
+	if m.addproduction_year != nil {
+		fields = append(fields, item.FieldProductionYear)
+	}
+	if m.addcommunity_rating != nil {
+		fields = append(fields, item.FieldCommunityRating)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ItemMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case item.FieldProductionYear:
+		return m.AddedProductionYear()
+	case item.FieldCommunityRating:
+		return m.AddedCommunityRating()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ItemMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case item.FieldProductionYear:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddProductionYear(v)
+		return nil
+	case item.FieldCommunityRating:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCommunityRating(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Item numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ItemMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(item.FieldMediaType) {
+		fields = append(fields, item.FieldMediaType)
+	}
+	if m.FieldCleared(item.FieldLibraryID) {
+		fields = append(fields, item.FieldLibraryID)
+	}
+	if m.FieldCleared(item.FieldCollectionType) {
+		fields = append(fields, item.FieldCollectionType)
+	}
+	if m.FieldCleared(item.FieldParentID) {
+		fields = append(fields, item.FieldParentID)
+	}
+	if m.FieldCleared(item.FieldSeasonID) {
+		fields = append(fields, item.FieldSeasonID)
+	}
+	if m.FieldCleared(item.FieldSeriesID) {
+		fields = append(fields, item.FieldSeriesID)
+	}
+	if m.FieldCleared(item.FieldTmdbID) {
+		fields = append(fields, item.FieldTmdbID)
+	}
+	if m.FieldCleared(item.FieldImdbID) {
+		fields = append(fields, item.FieldImdbID)
+	}
+	if m.FieldCleared(item.FieldTvdbID) {
+		fields = append(fields, item.FieldTvdbID)
+	}
+	if m.FieldCleared(item.FieldGenres) {
+		fields = append(fields, item.FieldGenres)
+	}
+	if m.FieldCleared(item.FieldTags) {
+		fields = append(fields, item.FieldTags)
+	}
+	if m.FieldCleared(item.FieldOfficialRating) {
+		fields = append(fields, item.FieldOfficialRating)
+	}
+	if m.FieldCleared(item.FieldProductionYear) {
+		fields = append(fields, item.FieldProductionYear)
+	}
+	if m.FieldCleared(item.FieldCommunityRating) {
+		fields = append(fields, item.FieldCommunityRating)
+	}
+	if m.FieldCleared(item.FieldPremiereDate) {
+		fields = append(fields, item.FieldPremiereDate)
+	}
+	if m.FieldCleared(item.FieldDateCreated) {
+		fields = append(fields, item.FieldDateCreated)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ItemMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ItemMutation) ClearField(name string) error {
+	switch name {
+	case item.FieldMediaType:
+		m.ClearMediaType()
+		return nil
+	case item.FieldLibraryID:
+		m.ClearLibraryID()
+		return nil
+	case item.FieldCollectionType:
+		m.ClearCollectionType()
+		return nil
+	case item.FieldParentID:
+		m.ClearParentID()
+		return nil
+	case item.FieldSeasonID:
+		m.ClearSeasonID()
+		return nil
+	case item.FieldSeriesID:
+		m.ClearSeriesID()
+		return nil
+	case item.FieldTmdbID:
+		m.ClearTmdbID()
+		return nil
+	case item.FieldImdbID:
+		m.ClearImdbID()
+		return nil
+	case item.FieldTvdbID:
+		m.ClearTvdbID()
+		return nil
+	case item.FieldGenres:
+		m.ClearGenres()
+		return nil
+	case item.FieldTags:
+		m.ClearTags()
+		return nil
+	case item.FieldOfficialRating:
+		m.ClearOfficialRating()
+		return nil
+	case item.FieldProductionYear:
+		m.ClearProductionYear()
+		return nil
+	case item.FieldCommunityRating:
+		m.ClearCommunityRating()
+		return nil
+	case item.FieldPremiereDate:
+		m.ClearPremiereDate()
+		return nil
+	case item.FieldDateCreated:
+		m.ClearDateCreated()
+		return nil
+	}
+	return fmt.Errorf("unknown Item nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ItemMutation) ResetField(name string) error {
+	switch name {
+	case item.FieldBackendItemID:
+		m.ResetBackendItemID()
+		return nil
+	case item.FieldName:
+		m.ResetName()
+		return nil
+	case item.FieldSortName:
+		m.ResetSortName()
+		return nil
+	case item.FieldItemType:
+		m.ResetItemType()
+		return nil
+	case item.FieldMediaType:
+		m.ResetMediaType()
+		return nil
+	case item.FieldLibraryID:
+		m.ResetLibraryID()
+		return nil
+	case item.FieldCollectionType:
+		m.ResetCollectionType()
+		return nil
+	case item.FieldParentID:
+		m.ResetParentID()
+		return nil
+	case item.FieldSeasonID:
+		m.ResetSeasonID()
+		return nil
+	case item.FieldSeriesID:
+		m.ResetSeriesID()
+		return nil
+	case item.FieldTmdbID:
+		m.ResetTmdbID()
+		return nil
+	case item.FieldImdbID:
+		m.ResetImdbID()
+		return nil
+	case item.FieldTvdbID:
+		m.ResetTvdbID()
+		return nil
+	case item.FieldGenres:
+		m.ResetGenres()
+		return nil
+	case item.FieldTags:
+		m.ResetTags()
+		return nil
+	case item.FieldOfficialRating:
+		m.ResetOfficialRating()
+		return nil
+	case item.FieldProductionYear:
+		m.ResetProductionYear()
+		return nil
+	case item.FieldCommunityRating:
+		m.ResetCommunityRating()
+		return nil
+	case item.FieldPremiereDate:
+		m.ResetPremiereDate()
+		return nil
+	case item.FieldDateCreated:
+		m.ResetDateCreated()
+		return nil
+	case item.FieldData:
+		m.ResetData()
+		return nil
+	case item.FieldIndexedAt:
+		m.ResetIndexedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Item field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ItemMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.backend != nil {
+		edges = append(edges, item.EdgeBackend)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ItemMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case item.EdgeBackend:
+		if id := m.backend; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ItemMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ItemMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ItemMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedbackend {
+		edges = append(edges, item.EdgeBackend)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ItemMutation) EdgeCleared(name string) bool {
+	switch name {
+	case item.EdgeBackend:
+		return m.clearedbackend
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ItemMutation) ClearEdge(name string) error {
+	switch name {
+	case item.EdgeBackend:
+		m.ClearBackend()
+		return nil
+	}
+	return fmt.Errorf("unknown Item unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ItemMutation) ResetEdge(name string) error {
+	switch name {
+	case item.EdgeBackend:
+		m.ResetBackend()
+		return nil
+	}
+	return fmt.Errorf("unknown Item edge %s", name)
+}
+
+// PlaybackEventMutation represents an operation that mutates the PlaybackEvent nodes in the graph.
+type PlaybackEventMutation struct {
+	config
+	op                Op
+	typ               string
+	id                *uuid.UUID
+	user_id           *uuid.UUID
+	username          *string
+	session_id        *uuid.UUID
+	device_id         *string
+	device_name       *string
+	app_name          *string
+	item_id           *string
+	item_name         *string
+	item_type         *string
+	backend_id        *uuid.UUID
+	backend_name      *string
+	play_method       *string
+	started_at        *time.Time
+	stopped_at        *time.Time
+	position_ticks    *int64
+	addposition_ticks *int64
+	play_duration     *int64
+	addplay_duration  *int64
+	clearedFields     map[string]struct{}
+	done              bool
+	oldValue          func(context.Context) (*PlaybackEvent, error)
+	predicates        []predicate.PlaybackEvent
+}
+
+var _ ent.Mutation = (*PlaybackEventMutation)(nil)
+
+// playbackeventOption allows management of the mutation configuration using functional options.
+type playbackeventOption func(*PlaybackEventMutation)
+
+// newPlaybackEventMutation creates new mutation for the PlaybackEvent entity.
+func newPlaybackEventMutation(c config, op Op, opts ...playbackeventOption) *PlaybackEventMutation {
+	m := &PlaybackEventMutation{
+		config:        c,
+		op:            op,
+		typ:           TypePlaybackEvent,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withPlaybackEventID sets the ID field of the mutation.
+func withPlaybackEventID(id uuid.UUID) playbackeventOption {
+	return func(m *PlaybackEventMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *PlaybackEvent
+		)
+		m.oldValue = func(ctx context.Context) (*PlaybackEvent, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().PlaybackEvent.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withPlaybackEvent sets the old PlaybackEvent of the mutation.
+func withPlaybackEvent(node *PlaybackEvent) playbackeventOption {
+	return func(m *PlaybackEventMutation) {
+		m.oldValue = func(context.Context) (*PlaybackEvent, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m PlaybackEventMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m PlaybackEventMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of PlaybackEvent entities.
+func (m *PlaybackEventMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *PlaybackEventMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *PlaybackEventMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().PlaybackEvent.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetUserID sets the "user_id" field.
+func (m *PlaybackEventMutation) SetUserID(u uuid.UUID) {
+	m.user_id = &u
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *PlaybackEventMutation) UserID() (r uuid.UUID, exists bool) {
+	v := m.user_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the PlaybackEvent entity.
+// If the PlaybackEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PlaybackEventMutation) OldUserID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *PlaybackEventMutation) ResetUserID() {
+	m.user_id = nil
+}
+
+// SetUsername sets the "username" field.
+func (m *PlaybackEventMutation) SetUsername(s string) {
+	m.username = &s
+}
+
+// Username returns the value of the "username" field in the mutation.
+func (m *PlaybackEventMutation) Username() (r string, exists bool) {
+	v := m.username
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUsername returns the old "username" field's value of the PlaybackEvent entity.
+// If the PlaybackEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PlaybackEventMutation) OldUsername(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUsername is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUsername requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUsername: %w", err)
+	}
+	return oldValue.Username, nil
+}
+
+// ResetUsername resets all changes to the "username" field.
+func (m *PlaybackEventMutation) ResetUsername() {
+	m.username = nil
+}
+
+// SetSessionID sets the "session_id" field.
+func (m *PlaybackEventMutation) SetSessionID(u uuid.UUID) {
+	m.session_id = &u
+}
+
+// SessionID returns the value of the "session_id" field in the mutation.
+func (m *PlaybackEventMutation) SessionID() (r uuid.UUID, exists bool) {
+	v := m.session_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSessionID returns the old "session_id" field's value of the PlaybackEvent entity.
+// If the PlaybackEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PlaybackEventMutation) OldSessionID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSessionID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSessionID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSessionID: %w", err)
+	}
+	return oldValue.SessionID, nil
+}
+
+// ResetSessionID resets all changes to the "session_id" field.
+func (m *PlaybackEventMutation) ResetSessionID() {
+	m.session_id = nil
+}
+
+// SetDeviceID sets the "device_id" field.
+func (m *PlaybackEventMutation) SetDeviceID(s string) {
+	m.device_id = &s
+}
+
+// DeviceID returns the value of the "device_id" field in the mutation.
+func (m *PlaybackEventMutation) DeviceID() (r string, exists bool) {
+	v := m.device_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeviceID returns the old "device_id" field's value of the PlaybackEvent entity.
+// If the PlaybackEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PlaybackEventMutation) OldDeviceID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeviceID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeviceID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeviceID: %w", err)
+	}
+	return oldValue.DeviceID, nil
+}
+
+// ResetDeviceID resets all changes to the "device_id" field.
+func (m *PlaybackEventMutation) ResetDeviceID() {
+	m.device_id = nil
+}
+
+// SetDeviceName sets the "device_name" field.
+func (m *PlaybackEventMutation) SetDeviceName(s string) {
+	m.device_name = &s
+}
+
+// DeviceName returns the value of the "device_name" field in the mutation.
+func (m *PlaybackEventMutation) DeviceName() (r string, exists bool) {
+	v := m.device_name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeviceName returns the old "device_name" field's value of the PlaybackEvent entity.
+// If the PlaybackEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PlaybackEventMutation) OldDeviceName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeviceName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeviceName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeviceName: %w", err)
+	}
+	return oldValue.DeviceName, nil
+}
+
+// ResetDeviceName resets all changes to the "device_name" field.
+func (m *PlaybackEventMutation) ResetDeviceName() {
+	m.device_name = nil
+}
+
+// SetAppName sets the "app_name" field.
+func (m *PlaybackEventMutation) SetAppName(s string) {
+	m.app_name = &s
+}
+
+// AppName returns the value of the "app_name" field in the mutation.
+func (m *PlaybackEventMutation) AppName() (r string, exists bool) {
+	v := m.app_name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAppName returns the old "app_name" field's value of the PlaybackEvent entity.
+// If the PlaybackEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PlaybackEventMutation) OldAppName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAppName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAppName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAppName: %w", err)
+	}
+	return oldValue.AppName, nil
+}
+
+// ResetAppName resets all changes to the "app_name" field.
+func (m *PlaybackEventMutation) ResetAppName() {
+	m.app_name = nil
+}
+
+// SetItemID sets the "item_id" field.
+func (m *PlaybackEventMutation) SetItemID(s string) {
+	m.item_id = &s
+}
+
+// ItemID returns the value of the "item_id" field in the mutation.
+func (m *PlaybackEventMutation) ItemID() (r string, exists bool) {
+	v := m.item_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldItemID returns the old "item_id" field's value of the PlaybackEvent entity.
+// If the PlaybackEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PlaybackEventMutation) OldItemID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldItemID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldItemID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldItemID: %w", err)
+	}
+	return oldValue.ItemID, nil
+}
+
+// ResetItemID resets all changes to the "item_id" field.
+func (m *PlaybackEventMutation) ResetItemID() {
+	m.item_id = nil
+}
+
+// SetItemName sets the "item_name" field.
+func (m *PlaybackEventMutation) SetItemName(s string) {
+	m.item_name = &s
+}
+
+// ItemName returns the value of the "item_name" field in the mutation.
+func (m *PlaybackEventMutation) ItemName() (r string, exists bool) {
+	v := m.item_name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldItemName returns the old "item_name" field's value of the PlaybackEvent entity.
+// If the PlaybackEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PlaybackEventMutation) OldItemName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldItemName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldItemName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldItemName: %w", err)
+	}
+	return oldValue.ItemName, nil
+}
+
+// ClearItemName clears the value of the "item_name" field.
+func (m *PlaybackEventMutation) ClearItemName() {
+	m.item_name = nil
+	m.clearedFields[playbackevent.FieldItemName] = struct{}{}
+}
+
+// ItemNameCleared returns if the "item_name" field was cleared in this mutation.
+func (m *PlaybackEventMutation) ItemNameCleared() bool {
+	_, ok := m.clearedFields[playbackevent.FieldItemName]
+	return ok
+}
+
+// ResetItemName resets all changes to the "item_name" field.
+func (m *PlaybackEventMutation) ResetItemName() {
+	m.item_name = nil
+	delete(m.clearedFields, playbackevent.FieldItemName)
+}
+
+// SetItemType sets the "item_type" field.
+func (m *PlaybackEventMutation) SetItemType(s string) {
+	m.item_type = &s
+}
+
+// ItemType returns the value of the "item_type" field in the mutation.
+func (m *PlaybackEventMutation) ItemType() (r string, exists bool) {
+	v := m.item_type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldItemType returns the old "item_type" field's value of the PlaybackEvent entity.
+// If the PlaybackEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PlaybackEventMutation) OldItemType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldItemType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldItemType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldItemType: %w", err)
+	}
+	return oldValue.ItemType, nil
+}
+
+// ClearItemType clears the value of the "item_type" field.
+func (m *PlaybackEventMutation) ClearItemType() {
+	m.item_type = nil
+	m.clearedFields[playbackevent.FieldItemType] = struct{}{}
+}
+
+// ItemTypeCleared returns if the "item_type" field was cleared in this mutation.
+func (m *PlaybackEventMutation) ItemTypeCleared() bool {
+	_, ok := m.clearedFields[playbackevent.FieldItemType]
+	return ok
+}
+
+// ResetItemType resets all changes to the "item_type" field.
+func (m *PlaybackEventMutation) ResetItemType() {
+	m.item_type = nil
+	delete(m.clearedFields, playbackevent.FieldItemType)
+}
+
+// SetBackendID sets the "backend_id" field.
+func (m *PlaybackEventMutation) SetBackendID(u uuid.UUID) {
+	m.backend_id = &u
+}
+
+// BackendID returns the value of the "backend_id" field in the mutation.
+func (m *PlaybackEventMutation) BackendID() (r uuid.UUID, exists bool) {
+	v := m.backend_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBackendID returns the old "backend_id" field's value of the PlaybackEvent entity.
+// If the PlaybackEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PlaybackEventMutation) OldBackendID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBackendID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBackendID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBackendID: %w", err)
+	}
+	return oldValue.BackendID, nil
+}
+
+// ResetBackendID resets all changes to the "backend_id" field.
+func (m *PlaybackEventMutation) ResetBackendID() {
+	m.backend_id = nil
+}
+
+// SetBackendName sets the "backend_name" field.
+func (m *PlaybackEventMutation) SetBackendName(s string) {
+	m.backend_name = &s
+}
+
+// BackendName returns the value of the "backend_name" field in the mutation.
+func (m *PlaybackEventMutation) BackendName() (r string, exists bool) {
+	v := m.backend_name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBackendName returns the old "backend_name" field's value of the PlaybackEvent entity.
+// If the PlaybackEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PlaybackEventMutation) OldBackendName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBackendName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBackendName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBackendName: %w", err)
+	}
+	return oldValue.BackendName, nil
+}
+
+// ResetBackendName resets all changes to the "backend_name" field.
+func (m *PlaybackEventMutation) ResetBackendName() {
+	m.backend_name = nil
+}
+
+// SetPlayMethod sets the "play_method" field.
+func (m *PlaybackEventMutation) SetPlayMethod(s string) {
+	m.play_method = &s
+}
+
+// PlayMethod returns the value of the "play_method" field in the mutation.
+func (m *PlaybackEventMutation) PlayMethod() (r string, exists bool) {
+	v := m.play_method
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPlayMethod returns the old "play_method" field's value of the PlaybackEvent entity.
+// If the PlaybackEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PlaybackEventMutation) OldPlayMethod(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPlayMethod is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPlayMethod requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPlayMethod: %w", err)
+	}
+	return oldValue.PlayMethod, nil
+}
+
+// ClearPlayMethod clears the value of the "play_method" field.
+func (m *PlaybackEventMutation) ClearPlayMethod() {
+	m.play_method = nil
+	m.clearedFields[playbackevent.FieldPlayMethod] = struct{}{}
+}
+
+// PlayMethodCleared returns if the "play_method" field was cleared in this mutation.
+func (m *PlaybackEventMutation) PlayMethodCleared() bool {
+	_, ok := m.clearedFields[playbackevent.FieldPlayMethod]
+	return ok
+}
+
+// ResetPlayMethod resets all changes to the "play_method" field.
+func (m *PlaybackEventMutation) ResetPlayMethod() {
+	m.play_method = nil
+	delete(m.clearedFields, playbackevent.FieldPlayMethod)
+}
+
+// SetStartedAt sets the "started_at" field.
+func (m *PlaybackEventMutation) SetStartedAt(t time.Time) {
+	m.started_at = &t
+}
+
+// StartedAt returns the value of the "started_at" field in the mutation.
+func (m *PlaybackEventMutation) StartedAt() (r time.Time, exists bool) {
+	v := m.started_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStartedAt returns the old "started_at" field's value of the PlaybackEvent entity.
+// If the PlaybackEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PlaybackEventMutation) OldStartedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStartedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStartedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStartedAt: %w", err)
+	}
+	return oldValue.StartedAt, nil
+}
+
+// ResetStartedAt resets all changes to the "started_at" field.
+func (m *PlaybackEventMutation) ResetStartedAt() {
+	m.started_at = nil
+}
+
+// SetStoppedAt sets the "stopped_at" field.
+func (m *PlaybackEventMutation) SetStoppedAt(t time.Time) {
+	m.stopped_at = &t
+}
+
+// StoppedAt returns the value of the "stopped_at" field in the mutation.
+func (m *PlaybackEventMutation) StoppedAt() (r time.Time, exists bool) {
+	v := m.stopped_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStoppedAt returns the old "stopped_at" field's value of the PlaybackEvent entity.
+// If the PlaybackEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PlaybackEventMutation) OldStoppedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStoppedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStoppedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStoppedAt: %w", err)
+	}
+	return oldValue.StoppedAt, nil
+}
+
+// ClearStoppedAt clears the value of the "stopped_at" field.
+func (m *PlaybackEventMutation) ClearStoppedAt() {
+	m.stopped_at = nil
+	m.clearedFields[playbackevent.FieldStoppedAt] = struct{}{}
+}
+
+// StoppedAtCleared returns if the "stopped_at" field was cleared in this mutation.
+func (m *PlaybackEventMutation) StoppedAtCleared() bool {
+	_, ok := m.clearedFields[playbackevent.FieldStoppedAt]
+	return ok
+}
+
+// ResetStoppedAt resets all changes to the "stopped_at" field.
+func (m *PlaybackEventMutation) ResetStoppedAt() {
+	m.stopped_at = nil
+	delete(m.clearedFields, playbackevent.FieldStoppedAt)
+}
+
+// SetPositionTicks sets the "position_ticks" field.
+func (m *PlaybackEventMutation) SetPositionTicks(i int64) {
+	m.position_ticks = &i
+	m.addposition_ticks = nil
+}
+
+// PositionTicks returns the value of the "position_ticks" field in the mutation.
+func (m *PlaybackEventMutation) PositionTicks() (r int64, exists bool) {
+	v := m.position_ticks
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPositionTicks returns the old "position_ticks" field's value of the PlaybackEvent entity.
+// If the PlaybackEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PlaybackEventMutation) OldPositionTicks(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPositionTicks is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPositionTicks requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPositionTicks: %w", err)
+	}
+	return oldValue.PositionTicks, nil
+}
+
+// AddPositionTicks adds i to the "position_ticks" field.
+func (m *PlaybackEventMutation) AddPositionTicks(i int64) {
+	if m.addposition_ticks != nil {
+		*m.addposition_ticks += i
+	} else {
+		m.addposition_ticks = &i
+	}
+}
+
+// AddedPositionTicks returns the value that was added to the "position_ticks" field in this mutation.
+func (m *PlaybackEventMutation) AddedPositionTicks() (r int64, exists bool) {
+	v := m.addposition_ticks
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetPositionTicks resets all changes to the "position_ticks" field.
+func (m *PlaybackEventMutation) ResetPositionTicks() {
+	m.position_ticks = nil
+	m.addposition_ticks = nil
+}
+
+// SetPlayDuration sets the "play_duration" field.
+func (m *PlaybackEventMutation) SetPlayDuration(i int64) {
+	m.play_duration = &i
+	m.addplay_duration = nil
+}
+
+// PlayDuration returns the value of the "play_duration" field in the mutation.
+func (m *PlaybackEventMutation) PlayDuration() (r int64, exists bool) {
+	v := m.play_duration
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPlayDuration returns the old "play_duration" field's value of the PlaybackEvent entity.
+// If the PlaybackEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PlaybackEventMutation) OldPlayDuration(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPlayDuration is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPlayDuration requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPlayDuration: %w", err)
+	}
+	return oldValue.PlayDuration, nil
+}
+
+// AddPlayDuration adds i to the "play_duration" field.
+func (m *PlaybackEventMutation) AddPlayDuration(i int64) {
+	if m.addplay_duration != nil {
+		*m.addplay_duration += i
+	} else {
+		m.addplay_duration = &i
+	}
+}
+
+// AddedPlayDuration returns the value that was added to the "play_duration" field in this mutation.
+func (m *PlaybackEventMutation) AddedPlayDuration() (r int64, exists bool) {
+	v := m.addplay_duration
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetPlayDuration resets all changes to the "play_duration" field.
+func (m *PlaybackEventMutation) ResetPlayDuration() {
+	m.play_duration = nil
+	m.addplay_duration = nil
+}
+
+// Where appends a list predicates to the PlaybackEventMutation builder.
+func (m *PlaybackEventMutation) Where(ps ...predicate.PlaybackEvent) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the PlaybackEventMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *PlaybackEventMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.PlaybackEvent, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *PlaybackEventMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *PlaybackEventMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (PlaybackEvent).
+func (m *PlaybackEventMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *PlaybackEventMutation) Fields() []string {
+	fields := make([]string, 0, 16)
+	if m.user_id != nil {
+		fields = append(fields, playbackevent.FieldUserID)
+	}
+	if m.username != nil {
+		fields = append(fields, playbackevent.FieldUsername)
+	}
+	if m.session_id != nil {
+		fields = append(fields, playbackevent.FieldSessionID)
+	}
+	if m.device_id != nil {
+		fields = append(fields, playbackevent.FieldDeviceID)
+	}
+	if m.device_name != nil {
+		fields = append(fields, playbackevent.FieldDeviceName)
+	}
+	if m.app_name != nil {
+		fields = append(fields, playbackevent.FieldAppName)
+	}
+	if m.item_id != nil {
+		fields = append(fields, playbackevent.FieldItemID)
+	}
+	if m.item_name != nil {
+		fields = append(fields, playbackevent.FieldItemName)
+	}
+	if m.item_type != nil {
+		fields = append(fields, playbackevent.FieldItemType)
+	}
+	if m.backend_id != nil {
+		fields = append(fields, playbackevent.FieldBackendID)
+	}
+	if m.backend_name != nil {
+		fields = append(fields, playbackevent.FieldBackendName)
+	}
+	if m.play_method != nil {
+		fields = append(fields, playbackevent.FieldPlayMethod)
+	}
+	if m.started_at != nil {
+		fields = append(fields, playbackevent.FieldStartedAt)
+	}
+	if m.stopped_at != nil {
+		fields = append(fields, playbackevent.FieldStoppedAt)
+	}
+	if m.position_ticks != nil {
+		fields = append(fields, playbackevent.FieldPositionTicks)
+	}
+	if m.play_duration != nil {
+		fields = append(fields, playbackevent.FieldPlayDuration)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *PlaybackEventMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case playbackevent.FieldUserID:
+		return m.UserID()
+	case playbackevent.FieldUsername:
+		return m.Username()
+	case playbackevent.FieldSessionID:
+		return m.SessionID()
+	case playbackevent.FieldDeviceID:
+		return m.DeviceID()
+	case playbackevent.FieldDeviceName:
+		return m.DeviceName()
+	case playbackevent.FieldAppName:
+		return m.AppName()
+	case playbackevent.FieldItemID:
+		return m.ItemID()
+	case playbackevent.FieldItemName:
+		return m.ItemName()
+	case playbackevent.FieldItemType:
+		return m.ItemType()
+	case playbackevent.FieldBackendID:
+		return m.BackendID()
+	case playbackevent.FieldBackendName:
+		return m.BackendName()
+	case playbackevent.FieldPlayMethod:
+		return m.PlayMethod()
+	case playbackevent.FieldStartedAt:
+		return m.StartedAt()
+	case playbackevent.FieldStoppedAt:
+		return m.StoppedAt()
+	case playbackevent.FieldPositionTicks:
+		return m.PositionTicks()
+	case playbackevent.FieldPlayDuration:
+		return m.PlayDuration()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *PlaybackEventMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case playbackevent.FieldUserID:
+		return m.OldUserID(ctx)
+	case playbackevent.FieldUsername:
+		return m.OldUsername(ctx)
+	case playbackevent.FieldSessionID:
+		return m.OldSessionID(ctx)
+	case playbackevent.FieldDeviceID:
+		return m.OldDeviceID(ctx)
+	case playbackevent.FieldDeviceName:
+		return m.OldDeviceName(ctx)
+	case playbackevent.FieldAppName:
+		return m.OldAppName(ctx)
+	case playbackevent.FieldItemID:
+		return m.OldItemID(ctx)
+	case playbackevent.FieldItemName:
+		return m.OldItemName(ctx)
+	case playbackevent.FieldItemType:
+		return m.OldItemType(ctx)
+	case playbackevent.FieldBackendID:
+		return m.OldBackendID(ctx)
+	case playbackevent.FieldBackendName:
+		return m.OldBackendName(ctx)
+	case playbackevent.FieldPlayMethod:
+		return m.OldPlayMethod(ctx)
+	case playbackevent.FieldStartedAt:
+		return m.OldStartedAt(ctx)
+	case playbackevent.FieldStoppedAt:
+		return m.OldStoppedAt(ctx)
+	case playbackevent.FieldPositionTicks:
+		return m.OldPositionTicks(ctx)
+	case playbackevent.FieldPlayDuration:
+		return m.OldPlayDuration(ctx)
+	}
+	return nil, fmt.Errorf("unknown PlaybackEvent field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PlaybackEventMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case playbackevent.FieldUserID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case playbackevent.FieldUsername:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUsername(v)
+		return nil
+	case playbackevent.FieldSessionID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSessionID(v)
+		return nil
+	case playbackevent.FieldDeviceID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeviceID(v)
+		return nil
+	case playbackevent.FieldDeviceName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeviceName(v)
+		return nil
+	case playbackevent.FieldAppName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAppName(v)
+		return nil
+	case playbackevent.FieldItemID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetItemID(v)
+		return nil
+	case playbackevent.FieldItemName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetItemName(v)
+		return nil
+	case playbackevent.FieldItemType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetItemType(v)
+		return nil
+	case playbackevent.FieldBackendID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBackendID(v)
+		return nil
+	case playbackevent.FieldBackendName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBackendName(v)
+		return nil
+	case playbackevent.FieldPlayMethod:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPlayMethod(v)
+		return nil
+	case playbackevent.FieldStartedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStartedAt(v)
+		return nil
+	case playbackevent.FieldStoppedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStoppedAt(v)
+		return nil
+	case playbackevent.FieldPositionTicks:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPositionTicks(v)
+		return nil
+	case playbackevent.FieldPlayDuration:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPlayDuration(v)
+		return nil
+	}
+	return fmt.Errorf("unknown PlaybackEvent field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *PlaybackEventMutation) AddedFields() []string {
+	var fields []string
+	if m.addposition_ticks != nil {
+		fields = append(fields, playbackevent.FieldPositionTicks)
+	}
+	if m.addplay_duration != nil {
+		fields = append(fields, playbackevent.FieldPlayDuration)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *PlaybackEventMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case playbackevent.FieldPositionTicks:
+		return m.AddedPositionTicks()
+	case playbackevent.FieldPlayDuration:
+		return m.AddedPlayDuration()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PlaybackEventMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case playbackevent.FieldPositionTicks:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPositionTicks(v)
+		return nil
+	case playbackevent.FieldPlayDuration:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPlayDuration(v)
+		return nil
+	}
+	return fmt.Errorf("unknown PlaybackEvent numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *PlaybackEventMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(playbackevent.FieldItemName) {
+		fields = append(fields, playbackevent.FieldItemName)
+	}
+	if m.FieldCleared(playbackevent.FieldItemType) {
+		fields = append(fields, playbackevent.FieldItemType)
+	}
+	if m.FieldCleared(playbackevent.FieldPlayMethod) {
+		fields = append(fields, playbackevent.FieldPlayMethod)
+	}
+	if m.FieldCleared(playbackevent.FieldStoppedAt) {
+		fields = append(fields, playbackevent.FieldStoppedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *PlaybackEventMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *PlaybackEventMutation) ClearField(name string) error {
+	switch name {
+	case playbackevent.FieldItemName:
+		m.ClearItemName()
+		return nil
+	case playbackevent.FieldItemType:
+		m.ClearItemType()
+		return nil
+	case playbackevent.FieldPlayMethod:
+		m.ClearPlayMethod()
+		return nil
+	case playbackevent.FieldStoppedAt:
+		m.ClearStoppedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown PlaybackEvent nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *PlaybackEventMutation) ResetField(name string) error {
+	switch name {
+	case playbackevent.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case playbackevent.FieldUsername:
+		m.ResetUsername()
+		return nil
+	case playbackevent.FieldSessionID:
+		m.ResetSessionID()
+		return nil
+	case playbackevent.FieldDeviceID:
+		m.ResetDeviceID()
+		return nil
+	case playbackevent.FieldDeviceName:
+		m.ResetDeviceName()
+		return nil
+	case playbackevent.FieldAppName:
+		m.ResetAppName()
+		return nil
+	case playbackevent.FieldItemID:
+		m.ResetItemID()
+		return nil
+	case playbackevent.FieldItemName:
+		m.ResetItemName()
+		return nil
+	case playbackevent.FieldItemType:
+		m.ResetItemType()
+		return nil
+	case playbackevent.FieldBackendID:
+		m.ResetBackendID()
+		return nil
+	case playbackevent.FieldBackendName:
+		m.ResetBackendName()
+		return nil
+	case playbackevent.FieldPlayMethod:
+		m.ResetPlayMethod()
+		return nil
+	case playbackevent.FieldStartedAt:
+		m.ResetStartedAt()
+		return nil
+	case playbackevent.FieldStoppedAt:
+		m.ResetStoppedAt()
+		return nil
+	case playbackevent.FieldPositionTicks:
+		m.ResetPositionTicks()
+		return nil
+	case playbackevent.FieldPlayDuration:
+		m.ResetPlayDuration()
+		return nil
+	}
+	return fmt.Errorf("unknown PlaybackEvent field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *PlaybackEventMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *PlaybackEventMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *PlaybackEventMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *PlaybackEventMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *PlaybackEventMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *PlaybackEventMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *PlaybackEventMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown PlaybackEvent unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *PlaybackEventMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown PlaybackEvent edge %s", name)
+}
+
+// RecoveryCodeMutation represents an operation that mutates the RecoveryCode nodes in the graph.
+type RecoveryCodeMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *uuid.UUID
+	code_hash     *string
+	created_at    *time.Time
+	clearedFields map[string]struct{}
+	user          *uuid.UUID
+	cleareduser   bool
+	done          bool
+	oldValue      func(context.Context) (*RecoveryCode, error)
+	predicates    []predicate.RecoveryCode
+}
+
+var _ ent.Mutation = (*RecoveryCodeMutation)(nil)
+
+// recoverycodeOption allows management of the mutation configuration using functional options.
+type recoverycodeOption func(*RecoveryCodeMutation)
+
+// newRecoveryCodeMutation creates new mutation for the RecoveryCode entity.
+func newRecoveryCodeMutation(c config, op Op, opts ...recoverycodeOption) *RecoveryCodeMutation {
+	m := &RecoveryCodeMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeRecoveryCode,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withRecoveryCodeID sets the ID field of the mutation.
+func withRecoveryCodeID(id uuid.UUID) recoverycodeOption {
+	return func(m *RecoveryCodeMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *RecoveryCode
+		)
+		m.oldValue = func(ctx context.Context) (*RecoveryCode, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().RecoveryCode.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withRecoveryCode sets the old RecoveryCode of the mutation.
+func withRecoveryCode(node *RecoveryCode) recoverycodeOption {
+	return func(m *RecoveryCodeMutation) {
+		m.oldValue = func(context.Context) (*RecoveryCode, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m RecoveryCodeMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m RecoveryCodeMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of RecoveryCode entities.
+func (m *RecoveryCodeMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *RecoveryCodeMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *RecoveryCodeMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().RecoveryCode.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCodeHash sets the "code_hash" field.
+func (m *RecoveryCodeMutation) SetCodeHash(s string) {
+	m.code_hash = &s
+}
+
+// CodeHash returns the value of the "code_hash" field in the mutation.
+func (m *RecoveryCodeMutation) CodeHash() (r string, exists bool) {
+	v := m.code_hash
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCodeHash returns the old "code_hash" field's value of the RecoveryCode entity.
+// If the RecoveryCode object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RecoveryCodeMutation) OldCodeHash(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCodeHash is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCodeHash requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCodeHash: %w", err)
+	}
+	return oldValue.CodeHash, nil
+}
+
+// ResetCodeHash resets all changes to the "code_hash" field.
+func (m *RecoveryCodeMutation) ResetCodeHash() {
+	m.code_hash = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *RecoveryCodeMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *RecoveryCodeMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the RecoveryCode entity.
+// If the RecoveryCode object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RecoveryCodeMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *RecoveryCodeMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUserID sets the "user" edge to the User entity by id.
+func (m *RecoveryCodeMutation) SetUserID(id uuid.UUID) {
+	m.user = &id
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (m *RecoveryCodeMutation) ClearUser() {
+	m.cleareduser = true
+}
+
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *RecoveryCodeMutation) UserCleared() bool {
+	return m.cleareduser
+}
+
+// UserID returns the "user" edge ID in the mutation.
+func (m *RecoveryCodeMutation) UserID() (id uuid.UUID, exists bool) {
+	if m.user != nil {
+		return *m.user, true
+	}
+	return
+}
+
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *RecoveryCodeMutation) UserIDs() (ids []uuid.UUID) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetUser resets all changes to the "user" edge.
+func (m *RecoveryCodeMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
+}
+
+// Where appends a list predicates to the RecoveryCodeMutation builder.
+func (m *RecoveryCodeMutation) Where(ps ...predicate.RecoveryCode) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the RecoveryCodeMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *RecoveryCodeMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.RecoveryCode, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *RecoveryCodeMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *RecoveryCodeMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (RecoveryCode).
+func (m *RecoveryCodeMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *RecoveryCodeMutation) Fields() []string {
+	fields := make([]string, 0, 2)
+	if m.code_hash != nil {
+		fields = append(fields, recoverycode.FieldCodeHash)
+	}
+	if m.created_at != nil {
+		fields = append(fields, recoverycode.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *RecoveryCodeMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case recoverycode.FieldCodeHash:
+		return m.CodeHash()
+	case recoverycode.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *RecoveryCodeMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case recoverycode.FieldCodeHash:
+		return m.OldCodeHash(ctx)
+	case recoverycode.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown RecoveryCode field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *RecoveryCodeMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case recoverycode.FieldCodeHash:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCodeHash(v)
+		return nil
+	case recoverycode.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown RecoveryCode field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *RecoveryCodeMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *RecoveryCodeMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *RecoveryCodeMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown RecoveryCode numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *RecoveryCodeMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *RecoveryCodeMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *RecoveryCodeMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown RecoveryCode nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *RecoveryCodeMutation) ResetField(name string) error {
+	switch name {
+	case recoverycode.FieldCodeHash:
+		m.ResetCodeHash()
+		return nil
+	case recoverycode.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown RecoveryCode field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *RecoveryCodeMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.user != nil {
+		edges = append(edges, recoverycode.EdgeUser)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *RecoveryCodeMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case recoverycode.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *RecoveryCodeMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *RecoveryCodeMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *RecoveryCodeMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.cleareduser {
+		edges = append(edges, recoverycode.EdgeUser)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *RecoveryCodeMutation) EdgeCleared(name string) bool {
+	switch name {
+	case recoverycode.EdgeUser:
+		return m.cleareduser
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *RecoveryCodeMutation) ClearEdge(name string) error {
+	switch name {
+	case recoverycode.EdgeUser:
+		m.ClearUser()
+		return nil
+	}
+	return fmt.Errorf("unknown RecoveryCode unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *RecoveryCodeMutation) ResetEdge(name string) error {
+	switch name {
+	case recoverycode.EdgeUser:
+		m.ResetUser()
+		return nil
+	}
+	return fmt.Errorf("unknown RecoveryCode edge %s", name)
+}
+
+// SessionMutation represents an operation that mutates the Session nodes in the graph.
+type SessionMutation struct {
+	config
+	op                  Op
+	typ                 string
+	id                  *uuid.UUID
+	token               *string
+	device_id           *string
+	device_name         *string
+	app_name            *string
+	app_version         *string
+	last_activity       *time.Time
+	created_at          *time.Time
+	clearedFields       map[string]struct{}
+	user                *uuid.UUID
+	cleareduser         bool
+	app_password        *uuid.UUID
+	clearedapp_password bool
+	done                bool
+	oldValue            func(context.Context) (*Session, error)
+	predicates          []predicate.Session
+}
+
+var _ ent.Mutation = (*SessionMutation)(nil)
+
+// sessionOption allows management of the mutation configuration using functional options.
+type sessionOption func(*SessionMutation)
+
+// newSessionMutation creates new mutation for the Session entity.
+func newSessionMutation(c config, op Op, opts ...sessionOption) *SessionMutation {
+	m := &SessionMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeSession,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withSessionID sets the ID field of the mutation.
+func withSessionID(id uuid.UUID) sessionOption {
+	return func(m *SessionMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Session
+		)
+		m.oldValue = func(ctx context.Context) (*Session, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Session.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withSession sets the old Session of the mutation.
+func withSession(node *Session) sessionOption {
+	return func(m *SessionMutation) {
+		m.oldValue = func(context.Context) (*Session, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m SessionMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m SessionMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Session entities.
+func (m *SessionMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *SessionMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *SessionMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Session.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetToken sets the "token" field.
+func (m *SessionMutation) SetToken(s string) {
+	m.token = &s
+}
+
+// Token returns the value of the "token" field in the mutation.
+func (m *SessionMutation) Token() (r string, exists bool) {
+	v := m.token
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldToken returns the old "token" field's value of the Session entity.
+// If the Session object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SessionMutation) OldToken(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldToken is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldToken requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldToken: %w", err)
+	}
+	return oldValue.Token, nil
+}
+
+// ResetToken resets all changes to the "token" field.
+func (m *SessionMutation) ResetToken() {
+	m.token = nil
+}
+
+// SetDeviceID sets the "device_id" field.
+func (m *SessionMutation) SetDeviceID(s string) {
+	m.device_id = &s
+}
+
+// DeviceID returns the value of the "device_id" field in the mutation.
+func (m *SessionMutation) DeviceID() (r string, exists bool) {
+	v := m.device_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeviceID returns the old "device_id" field's value of the Session entity.
+// If the Session object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SessionMutation) OldDeviceID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeviceID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeviceID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeviceID: %w", err)
+	}
+	return oldValue.DeviceID, nil
+}
+
+// ResetDeviceID resets all changes to the "device_id" field.
+func (m *SessionMutation) ResetDeviceID() {
+	m.device_id = nil
+}
+
+// SetDeviceName sets the "device_name" field.
+func (m *SessionMutation) SetDeviceName(s string) {
+	m.device_name = &s
+}
+
+// DeviceName returns the value of the "device_name" field in the mutation.
+func (m *SessionMutation) DeviceName() (r string, exists bool) {
+	v := m.device_name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeviceName returns the old "device_name" field's value of the Session entity.
+// If the Session object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SessionMutation) OldDeviceName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeviceName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeviceName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeviceName: %w", err)
+	}
+	return oldValue.DeviceName, nil
+}
+
+// ResetDeviceName resets all changes to the "device_name" field.
+func (m *SessionMutation) ResetDeviceName() {
+	m.device_name = nil
+}
+
+// SetAppName sets the "app_name" field.
+func (m *SessionMutation) SetAppName(s string) {
+	m.app_name = &s
+}
+
+// AppName returns the value of the "app_name" field in the mutation.
+func (m *SessionMutation) AppName() (r string, exists bool) {
+	v := m.app_name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAppName returns the old "app_name" field's value of the Session entity.
+// If the Session object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SessionMutation) OldAppName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAppName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAppName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAppName: %w", err)
+	}
+	return oldValue.AppName, nil
+}
+
+// ResetAppName resets all changes to the "app_name" field.
+func (m *SessionMutation) ResetAppName() {
+	m.app_name = nil
+}
+
+// SetAppVersion sets the "app_version" field.
+func (m *SessionMutation) SetAppVersion(s string) {
+	m.app_version = &s
+}
+
+// AppVersion returns the value of the "app_version" field in the mutation.
+func (m *SessionMutation) AppVersion() (r string, exists bool) {
+	v := m.app_version
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAppVersion returns the old "app_version" field's value of the Session entity.
+// If the Session object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SessionMutation) OldAppVersion(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAppVersion is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAppVersion requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAppVersion: %w", err)
+	}
+	return oldValue.AppVersion, nil
+}
+
+// ClearAppVersion clears the value of the "app_version" field.
+func (m *SessionMutation) ClearAppVersion() {
+	m.app_version = nil
+	m.clearedFields[session.FieldAppVersion] = struct{}{}
+}
+
+// AppVersionCleared returns if the "app_version" field was cleared in this mutation.
+func (m *SessionMutation) AppVersionCleared() bool {
+	_, ok := m.clearedFields[session.FieldAppVersion]
+	return ok
+}
+
+// ResetAppVersion resets all changes to the "app_version" field.
+func (m *SessionMutation) ResetAppVersion() {
+	m.app_version = nil
+	delete(m.clearedFields, session.FieldAppVersion)
+}
+
+// SetLastActivity sets the "last_activity" field.
+func (m *SessionMutation) SetLastActivity(t time.Time) {
+	m.last_activity = &t
+}
+
+// LastActivity returns the value of the "last_activity" field in the mutation.
+func (m *SessionMutation) LastActivity() (r time.Time, exists bool) {
+	v := m.last_activity
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLastActivity returns the old "last_activity" field's value of the Session entity.
+// If the Session object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SessionMutation) OldLastActivity(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastActivity is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastActivity requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastActivity: %w", err)
+	}
+	return oldValue.LastActivity, nil
+}
+
+// ResetLastActivity resets all changes to the "last_activity" field.
+func (m *SessionMutation) ResetLastActivity() {
+	m.last_activity = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *SessionMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *SessionMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Session entity.
+// If the Session object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SessionMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *SessionMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUserID sets the "user" edge to the User entity by id.
+func (m *SessionMutation) SetUserID(id uuid.UUID) {
+	m.user = &id
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (m *SessionMutation) ClearUser() {
+	m.cleareduser = true
+}
+
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *SessionMutation) UserCleared() bool {
+	return m.cleareduser
+}
+
+// UserID returns the "user" edge ID in the mutation.
+func (m *SessionMutation) UserID() (id uuid.UUID, exists bool) {
+	if m.user != nil {
+		return *m.user, true
+	}
+	return
+}
+
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *SessionMutation) UserIDs() (ids []uuid.UUID) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetUser resets all changes to the "user" edge.
+func (m *SessionMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
+}
+
+// SetAppPasswordID sets the "app_password" edge to the AppPassword entity by id.
+func (m *SessionMutation) SetAppPasswordID(id uuid.UUID) {
+	m.app_password = &id
+}
+
+// ClearAppPassword clears the "app_password" edge to the AppPassword entity.
+func (m *SessionMutation) ClearAppPassword() {
+	m.clearedapp_password = true
+}
+
+// AppPasswordCleared reports if the "app_password" edge to the AppPassword entity was cleared.
+func (m *SessionMutation) AppPasswordCleared() bool {
+	return m.clearedapp_password
+}
+
+// AppPasswordID returns the "app_password" edge ID in the mutation.
+func (m *SessionMutation) AppPasswordID() (id uuid.UUID, exists bool) {
+	if m.app_password != nil {
+		return *m.app_password, true
+	}
+	return
+}
+
+// AppPasswordIDs returns the "app_password" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// AppPasswordID instead. It exists only for internal usage by the builders.
+func (m *SessionMutation) AppPasswordIDs() (ids []uuid.UUID) {
+	if id := m.app_password; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetAppPassword resets all changes to the "app_password" edge.
+func (m *SessionMutation) ResetAppPassword() {
+	m.app_password = nil
+	m.clearedapp_password = false
+}
+
+// Where appends a list predicates to the SessionMutation builder.
+func (m *SessionMutation) Where(ps ...predicate.Session) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the SessionMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *SessionMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Session, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *SessionMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *SessionMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Session).
+func (m *SessionMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *SessionMutation) Fields() []string {
+	fields := make([]string, 0, 7)
+	if m.token != nil {
+		fields = append(fields, session.FieldToken)
+	}
+	if m.device_id != nil {
+		fields = append(fields, session.FieldDeviceID)
+	}
+	if m.device_name != nil {
+		fields = append(fields, session.FieldDeviceName)
+	}
+	if m.app_name != nil {
+		fields = append(fields, session.FieldAppName)
+	}
+	if m.app_version != nil {
+		fields = append(fields, session.FieldAppVersion)
+	}
+	if m.last_activity != nil {
+		fields = append(fields, session.FieldLastActivity)
+	}
+	if m.created_at != nil {
+		fields = append(fields, session.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *SessionMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case session.FieldToken:
+		return m.Token()
+	case session.FieldDeviceID:
+		return m.DeviceID()
+	case session.FieldDeviceName:
+		return m.DeviceName()
+	case session.FieldAppName:
+		return m.AppName()
+	case session.FieldAppVersion:
+		return m.AppVersion()
+	case session.FieldLastActivity:
+		return m.LastActivity()
+	case session.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *SessionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case session.FieldToken:
+		return m.OldToken(ctx)
+	case session.FieldDeviceID:
+		return m.OldDeviceID(ctx)
+	case session.FieldDeviceName:
+		return m.OldDeviceName(ctx)
+	case session.FieldAppName:
+		return m.OldAppName(ctx)
+	case session.FieldAppVersion:
+		return m.OldAppVersion(ctx)
+	case session.FieldLastActivity:
+		return m.OldLastActivity(ctx)
+	case session.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Session field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SessionMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case session.FieldToken:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetToken(v)
+		return nil
+	case session.FieldDeviceID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeviceID(v)
+		return nil
+	case session.FieldDeviceName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeviceName(v)
+		return nil
+	case session.FieldAppName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAppName(v)
+		return nil
+	case session.FieldAppVersion:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAppVersion(v)
+		return nil
+	case session.FieldLastActivity:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastActivity(v)
+		return nil
+	case session.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Session field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *SessionMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *SessionMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SessionMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Session numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *SessionMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(session.FieldAppVersion) {
+		fields = append(fields, session.FieldAppVersion)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *SessionMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *SessionMutation) ClearField(name string) error {
+	switch name {
+	case session.FieldAppVersion:
+		m.ClearAppVersion()
+		return nil
+	}
+	return fmt.Errorf("unknown Session nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *SessionMutation) ResetField(name string) error {
+	switch name {
+	case session.FieldToken:
+		m.ResetToken()
+		return nil
+	case session.FieldDeviceID:
+		m.ResetDeviceID()
+		return nil
+	case session.FieldDeviceName:
+		m.ResetDeviceName()
+		return nil
+	case session.FieldAppName:
+		m.ResetAppName()
+		return nil
+	case session.FieldAppVersion:
+		m.ResetAppVersion()
+		return nil
+	case session.FieldLastActivity:
+		m.ResetLastActivity()
+		return nil
+	case session.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Session field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *SessionMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.user != nil {
+		edges = append(edges, session.EdgeUser)
+	}
+	if m.app_password != nil {
+		edges = append(edges, session.EdgeAppPassword)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *SessionMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case session.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
+		}
+	case session.EdgeAppPassword:
+		if id := m.app_password; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *SessionMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *SessionMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *SessionMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.cleareduser {
+		edges = append(edges, session.EdgeUser)
+	}
+	if m.clearedapp_password {
+		edges = append(edges, session.EdgeAppPassword)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *SessionMutation) EdgeCleared(name string) bool {
+	switch name {
+	case session.EdgeUser:
+		return m.cleareduser
+	case session.EdgeAppPassword:
+		return m.clearedapp_password
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *SessionMutation) ClearEdge(name string) error {
+	switch name {
+	case session.EdgeUser:
+		m.ClearUser()
+		return nil
+	case session.EdgeAppPassword:
+		m.ClearAppPassword()
+		return nil
+	}
+	return fmt.Errorf("unknown Session unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *SessionMutation) ResetEdge(name string) error {
+	switch name {
+	case session.EdgeUser:
+		m.ResetUser()
+		return nil
+	case session.EdgeAppPassword:
+		m.ResetAppPassword()
+		return nil
+	}
+	return fmt.Errorf("unknown Session edge %s", name)
+}
+
+// UserMutation represents an operation that mutates the User nodes in the graph.
+type UserMutation struct {
+	config
+	op                                Op
+	typ                               string
+	id                                *uuid.UUID
+	username                          *string
+	display_name                      *string
+	hashed_password                   *string
+	is_admin                          *bool
+	auth_source                       *string
+	created_at                        *time.Time
+	updated_at                        *time.Time
+	avatar                            *[]byte
+	avatar_content_type               *string
+	oidc_subject                      *string
+	access_rules                      *access.Rules
+	is_disabled                       *bool
+	enable_remote_access              *bool
+	enable_media_playback             *bool
+	enable_video_playback_transcoding *bool
+	enable_content_downloading        *bool
+	remote_client_bitrate_limit       *int
+	addremote_client_bitrate_limit    *int
+	failed_login_count                *int
+	addfailed_login_count             *int
+	locked_until                      *time.Time
+	totp_secret                       *string
+	totp_enabled                      *bool
+	totp_last_step                    *int64
+	addtotp_last_step                 *int64
+	clearedFields                     map[string]struct{}
+	sessions                          map[uuid.UUID]struct{}
+	removedsessions                   map[uuid.UUID]struct{}
+	clearedsessions                   bool
+	backend_users                     map[uuid.UUID]struct{}
+	removedbackend_users              map[uuid.UUID]struct{}
+	clearedbackend_users              bool
+	api_keys                          map[uuid.UUID]struct{}
+	removedapi_keys                   map[uuid.UUID]struct{}
+	clearedapi_keys                   bool
+	recovery_codes                    map[uuid.UUID]struct{}
+	removedrecovery_codes             map[uuid.UUID]struct{}
+	clearedrecovery_codes             bool
+	app_passwords                     map[uuid.UUID]struct{}
+	removedapp_passwords              map[uuid.UUID]struct{}
+	clearedapp_passwords              bool
+	done                              bool
+	oldValue                          func(context.Context) (*User, error)
+	predicates                        []predicate.User
+}
+
+var _ ent.Mutation = (*UserMutation)(nil)
+
+// userOption allows management of the mutation configuration using functional options.
+type userOption func(*UserMutation)
+
+// newUserMutation creates new mutation for the User entity.
+func newUserMutation(c config, op Op, opts ...userOption) *UserMutation {
+	m := &UserMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeUser,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withUserID sets the ID field of the mutation.
+func withUserID(id uuid.UUID) userOption {
+	return func(m *UserMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *User
+		)
+		m.oldValue = func(ctx context.Context) (*User, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().User.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withUser sets the old User of the mutation.
+func withUser(node *User) userOption {
+	return func(m *UserMutation) {
+		m.oldValue = func(context.Context) (*User, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m UserMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m UserMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of User entities.
+func (m *UserMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *UserMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *UserMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().User.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetUsername sets the "username" field.
+func (m *UserMutation) SetUsername(s string) {
+	m.username = &s
+}
+
+// Username returns the value of the "username" field in the mutation.
+func (m *UserMutation) Username() (r string, exists bool) {
+	v := m.username
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUsername returns the old "username" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldUsername(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUsername is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUsername requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUsername: %w", err)
+	}
+	return oldValue.Username, nil
+}
+
+// ResetUsername resets all changes to the "username" field.
+func (m *UserMutation) ResetUsername() {
+	m.username = nil
+}
+
+// SetDisplayName sets the "display_name" field.
+func (m *UserMutation) SetDisplayName(s string) {
+	m.display_name = &s
+}
+
+// DisplayName returns the value of the "display_name" field in the mutation.
+func (m *UserMutation) DisplayName() (r string, exists bool) {
+	v := m.display_name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDisplayName returns the old "display_name" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldDisplayName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDisplayName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDisplayName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDisplayName: %w", err)
+	}
+	return oldValue.DisplayName, nil
+}
+
+// ResetDisplayName resets all changes to the "display_name" field.
+func (m *UserMutation) ResetDisplayName() {
+	m.display_name = nil
+}
+
+// SetHashedPassword sets the "hashed_password" field.
+func (m *UserMutation) SetHashedPassword(s string) {
+	m.hashed_password = &s
+}
+
+// HashedPassword returns the value of the "hashed_password" field in the mutation.
+func (m *UserMutation) HashedPassword() (r string, exists bool) {
+	v := m.hashed_password
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldHashedPassword returns the old "hashed_password" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldHashedPassword(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldHashedPassword is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldHashedPassword requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldHashedPassword: %w", err)
+	}
+	return oldValue.HashedPassword, nil
+}
+
+// ResetHashedPassword resets all changes to the "hashed_password" field.
+func (m *UserMutation) ResetHashedPassword() {
+	m.hashed_password = nil
+}
+
+// SetIsAdmin sets the "is_admin" field.
+func (m *UserMutation) SetIsAdmin(b bool) {
+	m.is_admin = &b
+}
+
+// IsAdmin returns the value of the "is_admin" field in the mutation.
+func (m *UserMutation) IsAdmin() (r bool, exists bool) {
+	v := m.is_admin
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIsAdmin returns the old "is_admin" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldIsAdmin(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIsAdmin is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIsAdmin requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIsAdmin: %w", err)
+	}
+	return oldValue.IsAdmin, nil
+}
+
+// ResetIsAdmin resets all changes to the "is_admin" field.
+func (m *UserMutation) ResetIsAdmin() {
+	m.is_admin = nil
+}
+
+// SetAuthSource sets the "auth_source" field.
+func (m *UserMutation) SetAuthSource(s string) {
+	m.auth_source = &s
+}
+
+// AuthSource returns the value of the "auth_source" field in the mutation.
+func (m *UserMutation) AuthSource() (r string, exists bool) {
+	v := m.auth_source
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAuthSource returns the old "auth_source" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldAuthSource(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAuthSource is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAuthSource requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAuthSource: %w", err)
+	}
+	return oldValue.AuthSource, nil
+}
+
+// ResetAuthSource resets all changes to the "auth_source" field.
+func (m *UserMutation) ResetAuthSource() {
+	m.auth_source = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *UserMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *UserMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *UserMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *UserMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *UserMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *UserMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetAvatar sets the "avatar" field.
+func (m *UserMutation) SetAvatar(b []byte) {
+	m.avatar = &b
+}
+
+// Avatar returns the value of the "avatar" field in the mutation.
+func (m *UserMutation) Avatar() (r []byte, exists bool) {
+	v := m.avatar
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAvatar returns the old "avatar" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldAvatar(ctx context.Context) (v *[]byte, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAvatar is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAvatar requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAvatar: %w", err)
+	}
+	return oldValue.Avatar, nil
+}
+
+// ClearAvatar clears the value of the "avatar" field.
+func (m *UserMutation) ClearAvatar() {
+	m.avatar = nil
+	m.clearedFields[user.FieldAvatar] = struct{}{}
+}
+
+// AvatarCleared returns if the "avatar" field was cleared in this mutation.
+func (m *UserMutation) AvatarCleared() bool {
+	_, ok := m.clearedFields[user.FieldAvatar]
+	return ok
+}
+
+// ResetAvatar resets all changes to the "avatar" field.
+func (m *UserMutation) ResetAvatar() {
+	m.avatar = nil
+	delete(m.clearedFields, user.FieldAvatar)
+}
+
+// SetAvatarContentType sets the "avatar_content_type" field.
+func (m *UserMutation) SetAvatarContentType(s string) {
+	m.avatar_content_type = &s
+}
+
+// AvatarContentType returns the value of the "avatar_content_type" field in the mutation.
+func (m *UserMutation) AvatarContentType() (r string, exists bool) {
+	v := m.avatar_content_type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAvatarContentType returns the old "avatar_content_type" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldAvatarContentType(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAvatarContentType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAvatarContentType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAvatarContentType: %w", err)
+	}
+	return oldValue.AvatarContentType, nil
+}
+
+// ClearAvatarContentType clears the value of the "avatar_content_type" field.
+func (m *UserMutation) ClearAvatarContentType() {
+	m.avatar_content_type = nil
+	m.clearedFields[user.FieldAvatarContentType] = struct{}{}
+}
+
+// AvatarContentTypeCleared returns if the "avatar_content_type" field was cleared in this mutation.
+func (m *UserMutation) AvatarContentTypeCleared() bool {
+	_, ok := m.clearedFields[user.FieldAvatarContentType]
+	return ok
+}
+
+// ResetAvatarContentType resets all changes to the "avatar_content_type" field.
+func (m *UserMutation) ResetAvatarContentType() {
+	m.avatar_content_type = nil
+	delete(m.clearedFields, user.FieldAvatarContentType)
+}
+
+// SetOidcSubject sets the "oidc_subject" field.
+func (m *UserMutation) SetOidcSubject(s string) {
+	m.oidc_subject = &s
+}
+
+// OidcSubject returns the value of the "oidc_subject" field in the mutation.
+func (m *UserMutation) OidcSubject() (r string, exists bool) {
+	v := m.oidc_subject
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOidcSubject returns the old "oidc_subject" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldOidcSubject(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOidcSubject is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOidcSubject requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOidcSubject: %w", err)
+	}
+	return oldValue.OidcSubject, nil
+}
+
+// ClearOidcSubject clears the value of the "oidc_subject" field.
+func (m *UserMutation) ClearOidcSubject() {
+	m.oidc_subject = nil
+	m.clearedFields[user.FieldOidcSubject] = struct{}{}
+}
+
+// OidcSubjectCleared returns if the "oidc_subject" field was cleared in this mutation.
+func (m *UserMutation) OidcSubjectCleared() bool {
+	_, ok := m.clearedFields[user.FieldOidcSubject]
+	return ok
+}
+
+// ResetOidcSubject resets all changes to the "oidc_subject" field.
+func (m *UserMutation) ResetOidcSubject() {
+	m.oidc_subject = nil
+	delete(m.clearedFields, user.FieldOidcSubject)
+}
+
+// SetAccessRules sets the "access_rules" field.
+func (m *UserMutation) SetAccessRules(a access.Rules) {
+	m.access_rules = &a
+}
+
+// AccessRules returns the value of the "access_rules" field in the mutation.
+func (m *UserMutation) AccessRules() (r access.Rules, exists bool) {
+	v := m.access_rules
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAccessRules returns the old "access_rules" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldAccessRules(ctx context.Context) (v access.Rules, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAccessRules is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAccessRules requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAccessRules: %w", err)
+	}
+	return oldValue.AccessRules, nil
+}
+
+// ClearAccessRules clears the value of the "access_rules" field.
+func (m *UserMutation) ClearAccessRules() {
+	m.access_rules = nil
+	m.clearedFields[user.FieldAccessRules] = struct{}{}
+}
+
+// AccessRulesCleared returns if the "access_rules" field was cleared in this mutation.
+func (m *UserMutation) AccessRulesCleared() bool {
+	_, ok := m.clearedFields[user.FieldAccessRules]
+	return ok
+}
+
+// ResetAccessRules resets all changes to the "access_rules" field.
+func (m *UserMutation) ResetAccessRules() {
+	m.access_rules = nil
+	delete(m.clearedFields, user.FieldAccessRules)
+}
+
+// SetIsDisabled sets the "is_disabled" field.
+func (m *UserMutation) SetIsDisabled(b bool) {
+	m.is_disabled = &b
+}
+
+// IsDisabled returns the value of the "is_disabled" field in the mutation.
+func (m *UserMutation) IsDisabled() (r bool, exists bool) {
+	v := m.is_disabled
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIsDisabled returns the old "is_disabled" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldIsDisabled(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIsDisabled is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIsDisabled requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIsDisabled: %w", err)
+	}
+	return oldValue.IsDisabled, nil
+}
+
+// ResetIsDisabled resets all changes to the "is_disabled" field.
+func (m *UserMutation) ResetIsDisabled() {
+	m.is_disabled = nil
+}
+
+// SetEnableRemoteAccess sets the "enable_remote_access" field.
+func (m *UserMutation) SetEnableRemoteAccess(b bool) {
+	m.enable_remote_access = &b
+}
+
+// EnableRemoteAccess returns the value of the "enable_remote_access" field in the mutation.
+func (m *UserMutation) EnableRemoteAccess() (r bool, exists bool) {
+	v := m.enable_remote_access
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEnableRemoteAccess returns the old "enable_remote_access" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldEnableRemoteAccess(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEnableRemoteAccess is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEnableRemoteAccess requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEnableRemoteAccess: %w", err)
+	}
+	return oldValue.EnableRemoteAccess, nil
+}
+
+// ResetEnableRemoteAccess resets all changes to the "enable_remote_access" field.
+func (m *UserMutation) ResetEnableRemoteAccess() {
+	m.enable_remote_access = nil
+}
+
+// SetEnableMediaPlayback sets the "enable_media_playback" field.
+func (m *UserMutation) SetEnableMediaPlayback(b bool) {
+	m.enable_media_playback = &b
+}
+
+// EnableMediaPlayback returns the value of the "enable_media_playback" field in the mutation.
+func (m *UserMutation) EnableMediaPlayback() (r bool, exists bool) {
+	v := m.enable_media_playback
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEnableMediaPlayback returns the old "enable_media_playback" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldEnableMediaPlayback(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEnableMediaPlayback is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEnableMediaPlayback requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEnableMediaPlayback: %w", err)
+	}
+	return oldValue.EnableMediaPlayback, nil
+}
+
+// ResetEnableMediaPlayback resets all changes to the "enable_media_playback" field.
+func (m *UserMutation) ResetEnableMediaPlayback() {
+	m.enable_media_playback = nil
+}
+
+// SetEnableVideoPlaybackTranscoding sets the "enable_video_playback_transcoding" field.
+func (m *UserMutation) SetEnableVideoPlaybackTranscoding(b bool) {
+	m.enable_video_playback_transcoding = &b
+}
+
+// EnableVideoPlaybackTranscoding returns the value of the "enable_video_playback_transcoding" field in the mutation.
+func (m *UserMutation) EnableVideoPlaybackTranscoding() (r bool, exists bool) {
+	v := m.enable_video_playback_transcoding
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEnableVideoPlaybackTranscoding returns the old "enable_video_playback_transcoding" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldEnableVideoPlaybackTranscoding(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEnableVideoPlaybackTranscoding is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEnableVideoPlaybackTranscoding requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEnableVideoPlaybackTranscoding: %w", err)
+	}
+	return oldValue.EnableVideoPlaybackTranscoding, nil
+}
+
+// ResetEnableVideoPlaybackTranscoding resets all changes to the "enable_video_playback_transcoding" field.
+func (m *UserMutation) ResetEnableVideoPlaybackTranscoding() {
+	m.enable_video_playback_transcoding = nil
+}
+
+// SetEnableContentDownloading sets the "enable_content_downloading" field.
+func (m *UserMutation) SetEnableContentDownloading(b bool) {
+	m.enable_content_downloading = &b
+}
+
+// EnableContentDownloading returns the value of the "enable_content_downloading" field in the mutation.
+func (m *UserMutation) EnableContentDownloading() (r bool, exists bool) {
+	v := m.enable_content_downloading
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEnableContentDownloading returns the old "enable_content_downloading" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldEnableContentDownloading(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEnableContentDownloading is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEnableContentDownloading requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEnableContentDownloading: %w", err)
+	}
+	return oldValue.EnableContentDownloading, nil
+}
+
+// ResetEnableContentDownloading resets all changes to the "enable_content_downloading" field.
+func (m *UserMutation) ResetEnableContentDownloading() {
+	m.enable_content_downloading = nil
+}
+
+// SetRemoteClientBitrateLimit sets the "remote_client_bitrate_limit" field.
+func (m *UserMutation) SetRemoteClientBitrateLimit(i int) {
+	m.remote_client_bitrate_limit = &i
+	m.addremote_client_bitrate_limit = nil
+}
+
+// RemoteClientBitrateLimit returns the value of the "remote_client_bitrate_limit" field in the mutation.
+func (m *UserMutation) RemoteClientBitrateLimit() (r int, exists bool) {
+	v := m.remote_client_bitrate_limit
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRemoteClientBitrateLimit returns the old "remote_client_bitrate_limit" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldRemoteClientBitrateLimit(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRemoteClientBitrateLimit is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRemoteClientBitrateLimit requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRemoteClientBitrateLimit: %w", err)
+	}
+	return oldValue.RemoteClientBitrateLimit, nil
+}
+
+// AddRemoteClientBitrateLimit adds i to the "remote_client_bitrate_limit" field.
+func (m *UserMutation) AddRemoteClientBitrateLimit(i int) {
+	if m.addremote_client_bitrate_limit != nil {
+		*m.addremote_client_bitrate_limit += i
+	} else {
+		m.addremote_client_bitrate_limit = &i
+	}
+}
+
+// AddedRemoteClientBitrateLimit returns the value that was added to the "remote_client_bitrate_limit" field in this mutation.
+func (m *UserMutation) AddedRemoteClientBitrateLimit() (r int, exists bool) {
+	v := m.addremote_client_bitrate_limit
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearRemoteClientBitrateLimit clears the value of the "remote_client_bitrate_limit" field.
+func (m *UserMutation) ClearRemoteClientBitrateLimit() {
+	m.remote_client_bitrate_limit = nil
+	m.addremote_client_bitrate_limit = nil
+	m.clearedFields[user.FieldRemoteClientBitrateLimit] = struct{}{}
+}
+
+// RemoteClientBitrateLimitCleared returns if the "remote_client_bitrate_limit" field was cleared in this mutation.
+func (m *UserMutation) RemoteClientBitrateLimitCleared() bool {
+	_, ok := m.clearedFields[user.FieldRemoteClientBitrateLimit]
+	return ok
+}
+
+// ResetRemoteClientBitrateLimit resets all changes to the "remote_client_bitrate_limit" field.
+func (m *UserMutation) ResetRemoteClientBitrateLimit() {
+	m.remote_client_bitrate_limit = nil
+	m.addremote_client_bitrate_limit = nil
+	delete(m.clearedFields, user.FieldRemoteClientBitrateLimit)
+}
+
+// SetFailedLoginCount sets the "failed_login_count" field.
+func (m *UserMutation) SetFailedLoginCount(i int) {
+	m.failed_login_count = &i
+	m.addfailed_login_count = nil
+}
+
+// FailedLoginCount returns the value of the "failed_login_count" field in the mutation.
+func (m *UserMutation) FailedLoginCount() (r int, exists bool) {
+	v := m.failed_login_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFailedLoginCount returns the old "failed_login_count" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldFailedLoginCount(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFailedLoginCount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFailedLoginCount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFailedLoginCount: %w", err)
+	}
+	return oldValue.FailedLoginCount, nil
+}
+
+// AddFailedLoginCount adds i to the "failed_login_count" field.
+func (m *UserMutation) AddFailedLoginCount(i int) {
+	if m.addfailed_login_count != nil {
+		*m.addfailed_login_count += i
+	} else {
+		m.addfailed_login_count = &i
+	}
+}
+
+// AddedFailedLoginCount returns the value that was added to the "failed_login_count" field in this mutation.
+func (m *UserMutation) AddedFailedLoginCount() (r int, exists bool) {
+	v := m.addfailed_login_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetFailedLoginCount resets all changes to the "failed_login_count" field.
+func (m *UserMutation) ResetFailedLoginCount() {
+	m.failed_login_count = nil
+	m.addfailed_login_count = nil
+}
+
+// SetLockedUntil sets the "locked_until" field.
+func (m *UserMutation) SetLockedUntil(t time.Time) {
+	m.locked_until = &t
+}
+
+// LockedUntil returns the value of the "locked_until" field in the mutation.
+func (m *UserMutation) LockedUntil() (r time.Time, exists bool) {
+	v := m.locked_until
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLockedUntil returns the old "locked_until" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldLockedUntil(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLockedUntil is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLockedUntil requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLockedUntil: %w", err)
+	}
+	return oldValue.LockedUntil, nil
+}
+
+// ClearLockedUntil clears the value of the "locked_until" field.
+func (m *UserMutation) ClearLockedUntil() {
+	m.locked_until = nil
+	m.clearedFields[user.FieldLockedUntil] = struct{}{}
+}
+
+// LockedUntilCleared returns if the "locked_until" field was cleared in this mutation.
+func (m *UserMutation) LockedUntilCleared() bool {
+	_, ok := m.clearedFields[user.FieldLockedUntil]
+	return ok
+}
+
+// ResetLockedUntil resets all changes to the "locked_until" field.
+func (m *UserMutation) ResetLockedUntil() {
+	m.locked_until = nil
+	delete(m.clearedFields, user.FieldLockedUntil)
+}
+
+// SetTotpSecret sets the "totp_secret" field.
+func (m *UserMutation) SetTotpSecret(s string) {
+	m.totp_secret = &s
+}
+
+// TotpSecret returns the value of the "totp_secret" field in the mutation.
+func (m *UserMutation) TotpSecret() (r string, exists bool) {
+	v := m.totp_secret
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTotpSecret returns the old "totp_secret" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldTotpSecret(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTotpSecret is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTotpSecret requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTotpSecret: %w", err)
+	}
+	return oldValue.TotpSecret, nil
+}
+
+// ClearTotpSecret clears the value of the "totp_secret" field.
+func (m *UserMutation) ClearTotpSecret() {
+	m.totp_secret = nil
+	m.clearedFields[user.FieldTotpSecret] = struct{}{}
+}
+
+// TotpSecretCleared returns if the "totp_secret" field was cleared in this mutation.
+func (m *UserMutation) TotpSecretCleared() bool {
+	_, ok := m.clearedFields[user.FieldTotpSecret]
+	return ok
+}
+
+// ResetTotpSecret resets all changes to the "totp_secret" field.
+func (m *UserMutation) ResetTotpSecret() {
+	m.totp_secret = nil
+	delete(m.clearedFields, user.FieldTotpSecret)
+}
+
+// SetTotpEnabled sets the "totp_enabled" field.
+func (m *UserMutation) SetTotpEnabled(b bool) {
+	m.totp_enabled = &b
+}
+
+// TotpEnabled returns the value of the "totp_enabled" field in the mutation.
+func (m *UserMutation) TotpEnabled() (r bool, exists bool) {
+	v := m.totp_enabled
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTotpEnabled returns the old "totp_enabled" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldTotpEnabled(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTotpEnabled is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTotpEnabled requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTotpEnabled: %w", err)
+	}
+	return oldValue.TotpEnabled, nil
+}
+
+// ResetTotpEnabled resets all changes to the "totp_enabled" field.
+func (m *UserMutation) ResetTotpEnabled() {
+	m.totp_enabled = nil
+}
+
+// SetTotpLastStep sets the "totp_last_step" field.
+func (m *UserMutation) SetTotpLastStep(i int64) {
+	m.totp_last_step = &i
+	m.addtotp_last_step = nil
+}
+
+// TotpLastStep returns the value of the "totp_last_step" field in the mutation.
+func (m *UserMutation) TotpLastStep() (r int64, exists bool) {
+	v := m.totp_last_step
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTotpLastStep returns the old "totp_last_step" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldTotpLastStep(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTotpLastStep is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTotpLastStep requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTotpLastStep: %w", err)
+	}
+	return oldValue.TotpLastStep, nil
+}
+
+// AddTotpLastStep adds i to the "totp_last_step" field.
+func (m *UserMutation) AddTotpLastStep(i int64) {
+	if m.addtotp_last_step != nil {
+		*m.addtotp_last_step += i
+	} else {
+		m.addtotp_last_step = &i
+	}
+}
+
+// AddedTotpLastStep returns the value that was added to the "totp_last_step" field in this mutation.
+func (m *UserMutation) AddedTotpLastStep() (r int64, exists bool) {
+	v := m.addtotp_last_step
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetTotpLastStep resets all changes to the "totp_last_step" field.
+func (m *UserMutation) ResetTotpLastStep() {
+	m.totp_last_step = nil
+	m.addtotp_last_step = nil
 }
 
 // AddSessionIDs adds the "sessions" edge to the Session entity by ids.
@@ -2511,118 +10563,1267 @@ func (m *UserMutation) AddSessionIDs(ids ...uuid.UUID) {
 	if m.sessions == nil {
 		m.sessions = make(map[uuid.UUID]struct{})
 	}
-	for i := range ids {
-		m.sessions[ids[i]] = struct{}{}
+	for i := range ids {
+		m.sessions[ids[i]] = struct{}{}
+	}
+}
+
+// ClearSessions clears the "sessions" edge to the Session entity.
+func (m *UserMutation) ClearSessions() {
+	m.clearedsessions = true
+}
+
+// SessionsCleared reports if the "sessions" edge to the Session entity was cleared.
+func (m *UserMutation) SessionsCleared() bool {
+	return m.clearedsessions
+}
+
+// RemoveSessionIDs removes the "sessions" edge to the Session entity by IDs.
+func (m *UserMutation) RemoveSessionIDs(ids ...uuid.UUID) {
+	if m.removedsessions == nil {
+		m.removedsessions = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.sessions, ids[i])
+		m.removedsessions[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedSessions returns the removed IDs of the "sessions" edge to the Session entity.
+func (m *UserMutation) RemovedSessionsIDs() (ids []uuid.UUID) {
+	for id := range m.removedsessions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// SessionsIDs returns the "sessions" edge IDs in the mutation.
+func (m *UserMutation) SessionsIDs() (ids []uuid.UUID) {
+	for id := range m.sessions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetSessions resets all changes to the "sessions" edge.
+func (m *UserMutation) ResetSessions() {
+	m.sessions = nil
+	m.clearedsessions = false
+	m.removedsessions = nil
+}
+
+// AddBackendUserIDs adds the "backend_users" edge to the BackendUser entity by ids.
+func (m *UserMutation) AddBackendUserIDs(ids ...uuid.UUID) {
+	if m.backend_users == nil {
+		m.backend_users = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.backend_users[ids[i]] = struct{}{}
+	}
+}
+
+// ClearBackendUsers clears the "backend_users" edge to the BackendUser entity.
+func (m *UserMutation) ClearBackendUsers() {
+	m.clearedbackend_users = true
+}
+
+// BackendUsersCleared reports if the "backend_users" edge to the BackendUser entity was cleared.
+func (m *UserMutation) BackendUsersCleared() bool {
+	return m.clearedbackend_users
+}
+
+// RemoveBackendUserIDs removes the "backend_users" edge to the BackendUser entity by IDs.
+func (m *UserMutation) RemoveBackendUserIDs(ids ...uuid.UUID) {
+	if m.removedbackend_users == nil {
+		m.removedbackend_users = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.backend_users, ids[i])
+		m.removedbackend_users[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedBackendUsers returns the removed IDs of the "backend_users" edge to the BackendUser entity.
+func (m *UserMutation) RemovedBackendUsersIDs() (ids []uuid.UUID) {
+	for id := range m.removedbackend_users {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// BackendUsersIDs returns the "backend_users" edge IDs in the mutation.
+func (m *UserMutation) BackendUsersIDs() (ids []uuid.UUID) {
+	for id := range m.backend_users {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetBackendUsers resets all changes to the "backend_users" edge.
+func (m *UserMutation) ResetBackendUsers() {
+	m.backend_users = nil
+	m.clearedbackend_users = false
+	m.removedbackend_users = nil
+}
+
+// AddAPIKeyIDs adds the "api_keys" edge to the ApiKey entity by ids.
+func (m *UserMutation) AddAPIKeyIDs(ids ...uuid.UUID) {
+	if m.api_keys == nil {
+		m.api_keys = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.api_keys[ids[i]] = struct{}{}
+	}
+}
+
+// ClearAPIKeys clears the "api_keys" edge to the ApiKey entity.
+func (m *UserMutation) ClearAPIKeys() {
+	m.clearedapi_keys = true
+}
+
+// APIKeysCleared reports if the "api_keys" edge to the ApiKey entity was cleared.
+func (m *UserMutation) APIKeysCleared() bool {
+	return m.clearedapi_keys
+}
+
+// RemoveAPIKeyIDs removes the "api_keys" edge to the ApiKey entity by IDs.
+func (m *UserMutation) RemoveAPIKeyIDs(ids ...uuid.UUID) {
+	if m.removedapi_keys == nil {
+		m.removedapi_keys = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.api_keys, ids[i])
+		m.removedapi_keys[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedAPIKeys returns the removed IDs of the "api_keys" edge to the ApiKey entity.
+func (m *UserMutation) RemovedAPIKeysIDs() (ids []uuid.UUID) {
+	for id := range m.removedapi_keys {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// APIKeysIDs returns the "api_keys" edge IDs in the mutation.
+func (m *UserMutation) APIKeysIDs() (ids []uuid.UUID) {
+	for id := range m.api_keys {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetAPIKeys resets all changes to the "api_keys" edge.
+func (m *UserMutation) ResetAPIKeys() {
+	m.api_keys = nil
+	m.clearedapi_keys = false
+	m.removedapi_keys = nil
+}
+
+// AddRecoveryCodeIDs adds the "recovery_codes" edge to the RecoveryCode entity by ids.
+func (m *UserMutation) AddRecoveryCodeIDs(ids ...uuid.UUID) {
+	if m.recovery_codes == nil {
+		m.recovery_codes = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.recovery_codes[ids[i]] = struct{}{}
+	}
+}
+
+// ClearRecoveryCodes clears the "recovery_codes" edge to the RecoveryCode entity.
+func (m *UserMutation) ClearRecoveryCodes() {
+	m.clearedrecovery_codes = true
+}
+
+// RecoveryCodesCleared reports if the "recovery_codes" edge to the RecoveryCode entity was cleared.
+func (m *UserMutation) RecoveryCodesCleared() bool {
+	return m.clearedrecovery_codes
+}
+
+// RemoveRecoveryCodeIDs removes the "recovery_codes" edge to the RecoveryCode entity by IDs.
+func (m *UserMutation) RemoveRecoveryCodeIDs(ids ...uuid.UUID) {
+	if m.removedrecovery_codes == nil {
+		m.removedrecovery_codes = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.recovery_codes, ids[i])
+		m.removedrecovery_codes[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedRecoveryCodes returns the removed IDs of the "recovery_codes" edge to the RecoveryCode entity.
+func (m *UserMutation) RemovedRecoveryCodesIDs() (ids []uuid.UUID) {
+	for id := range m.removedrecovery_codes {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// RecoveryCodesIDs returns the "recovery_codes" edge IDs in the mutation.
+func (m *UserMutation) RecoveryCodesIDs() (ids []uuid.UUID) {
+	for id := range m.recovery_codes {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetRecoveryCodes resets all changes to the "recovery_codes" edge.
+func (m *UserMutation) ResetRecoveryCodes() {
+	m.recovery_codes = nil
+	m.clearedrecovery_codes = false
+	m.removedrecovery_codes = nil
+}
+
+// AddAppPasswordIDs adds the "app_passwords" edge to the AppPassword entity by ids.
+func (m *UserMutation) AddAppPasswordIDs(ids ...uuid.UUID) {
+	if m.app_passwords == nil {
+		m.app_passwords = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.app_passwords[ids[i]] = struct{}{}
+	}
+}
+
+// ClearAppPasswords clears the "app_passwords" edge to the AppPassword entity.
+func (m *UserMutation) ClearAppPasswords() {
+	m.clearedapp_passwords = true
+}
+
+// AppPasswordsCleared reports if the "app_passwords" edge to the AppPassword entity was cleared.
+func (m *UserMutation) AppPasswordsCleared() bool {
+	return m.clearedapp_passwords
+}
+
+// RemoveAppPasswordIDs removes the "app_passwords" edge to the AppPassword entity by IDs.
+func (m *UserMutation) RemoveAppPasswordIDs(ids ...uuid.UUID) {
+	if m.removedapp_passwords == nil {
+		m.removedapp_passwords = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.app_passwords, ids[i])
+		m.removedapp_passwords[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedAppPasswords returns the removed IDs of the "app_passwords" edge to the AppPassword entity.
+func (m *UserMutation) RemovedAppPasswordsIDs() (ids []uuid.UUID) {
+	for id := range m.removedapp_passwords {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// AppPasswordsIDs returns the "app_passwords" edge IDs in the mutation.
+func (m *UserMutation) AppPasswordsIDs() (ids []uuid.UUID) {
+	for id := range m.app_passwords {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetAppPasswords resets all changes to the "app_passwords" edge.
+func (m *UserMutation) ResetAppPasswords() {
+	m.app_passwords = nil
+	m.clearedapp_passwords = false
+	m.removedapp_passwords = nil
+}
+
+// Where appends a list predicates to the UserMutation builder.
+func (m *UserMutation) Where(ps ...predicate.User) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the UserMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *UserMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.User, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *UserMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *UserMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (User).
+func (m *UserMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *UserMutation) Fields() []string {
+	fields := make([]string, 0, 22)
+	if m.username != nil {
+		fields = append(fields, user.FieldUsername)
+	}
+	if m.display_name != nil {
+		fields = append(fields, user.FieldDisplayName)
+	}
+	if m.hashed_password != nil {
+		fields = append(fields, user.FieldHashedPassword)
+	}
+	if m.is_admin != nil {
+		fields = append(fields, user.FieldIsAdmin)
+	}
+	if m.auth_source != nil {
+		fields = append(fields, user.FieldAuthSource)
+	}
+	if m.created_at != nil {
+		fields = append(fields, user.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, user.FieldUpdatedAt)
+	}
+	if m.avatar != nil {
+		fields = append(fields, user.FieldAvatar)
+	}
+	if m.avatar_content_type != nil {
+		fields = append(fields, user.FieldAvatarContentType)
+	}
+	if m.oidc_subject != nil {
+		fields = append(fields, user.FieldOidcSubject)
+	}
+	if m.access_rules != nil {
+		fields = append(fields, user.FieldAccessRules)
+	}
+	if m.is_disabled != nil {
+		fields = append(fields, user.FieldIsDisabled)
+	}
+	if m.enable_remote_access != nil {
+		fields = append(fields, user.FieldEnableRemoteAccess)
+	}
+	if m.enable_media_playback != nil {
+		fields = append(fields, user.FieldEnableMediaPlayback)
+	}
+	if m.enable_video_playback_transcoding != nil {
+		fields = append(fields, user.FieldEnableVideoPlaybackTranscoding)
+	}
+	if m.enable_content_downloading != nil {
+		fields = append(fields, user.FieldEnableContentDownloading)
+	}
+	if m.remote_client_bitrate_limit != nil {
+		fields = append(fields, user.FieldRemoteClientBitrateLimit)
+	}
+	if m.failed_login_count != nil {
+		fields = append(fields, user.FieldFailedLoginCount)
+	}
+	if m.locked_until != nil {
+		fields = append(fields, user.FieldLockedUntil)
+	}
+	if m.totp_secret != nil {
+		fields = append(fields, user.FieldTotpSecret)
+	}
+	if m.totp_enabled != nil {
+		fields = append(fields, user.FieldTotpEnabled)
+	}
+	if m.totp_last_step != nil {
+		fields = append(fields, user.FieldTotpLastStep)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *UserMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case user.FieldUsername:
+		return m.Username()
+	case user.FieldDisplayName:
+		return m.DisplayName()
+	case user.FieldHashedPassword:
+		return m.HashedPassword()
+	case user.FieldIsAdmin:
+		return m.IsAdmin()
+	case user.FieldAuthSource:
+		return m.AuthSource()
+	case user.FieldCreatedAt:
+		return m.CreatedAt()
+	case user.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case user.FieldAvatar:
+		return m.Avatar()
+	case user.FieldAvatarContentType:
+		return m.AvatarContentType()
+	case user.FieldOidcSubject:
+		return m.OidcSubject()
+	case user.FieldAccessRules:
+		return m.AccessRules()
+	case user.FieldIsDisabled:
+		return m.IsDisabled()
+	case user.FieldEnableRemoteAccess:
+		return m.EnableRemoteAccess()
+	case user.FieldEnableMediaPlayback:
+		return m.EnableMediaPlayback()
+	case user.FieldEnableVideoPlaybackTranscoding:
+		return m.EnableVideoPlaybackTranscoding()
+	case user.FieldEnableContentDownloading:
+		return m.EnableContentDownloading()
+	case user.FieldRemoteClientBitrateLimit:
+		return m.RemoteClientBitrateLimit()
+	case user.FieldFailedLoginCount:
+		return m.FailedLoginCount()
+	case user.FieldLockedUntil:
+		return m.LockedUntil()
+	case user.FieldTotpSecret:
+		return m.TotpSecret()
+	case user.FieldTotpEnabled:
+		return m.TotpEnabled()
+	case user.FieldTotpLastStep:
+		return m.TotpLastStep()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *UserMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case user.FieldUsername:
+		return m.OldUsername(ctx)
+	case user.FieldDisplayName:
+		return m.OldDisplayName(ctx)
+	case user.FieldHashedPassword:
+		return m.OldHashedPassword(ctx)
+	case user.FieldIsAdmin:
+		return m.OldIsAdmin(ctx)
+	case user.FieldAuthSource:
+		return m.OldAuthSource(ctx)
+	case user.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case user.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case user.FieldAvatar:
+		return m.OldAvatar(ctx)
+	case user.FieldAvatarContentType:
+		return m.OldAvatarContentType(ctx)
+	case user.FieldOidcSubject:
+		return m.OldOidcSubject(ctx)
+	case user.FieldAccessRules:
+		return m.OldAccessRules(ctx)
+	case user.FieldIsDisabled:
+		return m.OldIsDisabled(ctx)
+	case user.FieldEnableRemoteAccess:
+		return m.OldEnableRemoteAccess(ctx)
+	case user.FieldEnableMediaPlayback:
+		return m.OldEnableMediaPlayback(ctx)
+	case user.FieldEnableVideoPlaybackTranscoding:
+		return m.OldEnableVideoPlaybackTranscoding(ctx)
+	case user.FieldEnableContentDownloading:
+		return m.OldEnableContentDownloading(ctx)
+	case user.FieldRemoteClientBitrateLimit:
+		return m.OldRemoteClientBitrateLimit(ctx)
+	case user.FieldFailedLoginCount:
+		return m.OldFailedLoginCount(ctx)
+	case user.FieldLockedUntil:
+		return m.OldLockedUntil(ctx)
+	case user.FieldTotpSecret:
+		return m.OldTotpSecret(ctx)
+	case user.FieldTotpEnabled:
+		return m.OldTotpEnabled(ctx)
+	case user.FieldTotpLastStep:
+		return m.OldTotpLastStep(ctx)
+	}
+	return nil, fmt.Errorf("unknown User field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *UserMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case user.FieldUsername:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUsername(v)
+		return nil
+	case user.FieldDisplayName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDisplayName(v)
+		return nil
+	case user.FieldHashedPassword:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHashedPassword(v)
+		return nil
+	case user.FieldIsAdmin:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIsAdmin(v)
+		return nil
+	case user.FieldAuthSource:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAuthSource(v)
+		return nil
+	case user.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case user.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case user.FieldAvatar:
+		v, ok := value.([]byte)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAvatar(v)
+		return nil
+	case user.FieldAvatarContentType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAvatarContentType(v)
+		return nil
+	case user.FieldOidcSubject:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOidcSubject(v)
+		return nil
+	case user.FieldAccessRules:
+		v, ok := value.(access.Rules)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAccessRules(v)
+		return nil
+	case user.FieldIsDisabled:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIsDisabled(v)
+		return nil
+	case user.FieldEnableRemoteAccess:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEnableRemoteAccess(v)
+		return nil
+	case user.FieldEnableMediaPlayback:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEnableMediaPlayback(v)
+		return nil
+	case user.FieldEnableVideoPlaybackTranscoding:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEnableVideoPlaybackTranscoding(v)
+		return nil
+	case user.FieldEnableContentDownloading:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEnableContentDownloading(v)
+		return nil
+	case user.FieldRemoteClientBitrateLimit:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRemoteClientBitrateLimit(v)
+		return nil
+	case user.FieldFailedLoginCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFailedLoginCount(v)
+		return nil
+	case user.FieldLockedUntil:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLockedUntil(v)
+		return nil
+	case user.FieldTotpSecret:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTotpSecret(v)
+		return nil
+	case user.FieldTotpEnabled:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTotpEnabled(v)
+		return nil
+	case user.FieldTotpLastStep:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTotpLastStep(v)
+		return nil
+	}
+	return fmt.Errorf("unknown User field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *UserMutation) AddedFields() []string {
+	var fields []string
+	if m.addremote_client_bitrate_limit != nil {
+		fields = append(fields, user.FieldRemoteClientBitrateLimit)
+	}
+	if m.addfailed_login_count != nil {
+		fields = append(fields, user.FieldFailedLoginCount)
+	}
+	if m.addtotp_last_step != nil {
+		fields = append(fields, user.FieldTotpLastStep)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *UserMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case user.FieldRemoteClientBitrateLimit:
+		return m.AddedRemoteClientBitrateLimit()
+	case user.FieldFailedLoginCount:
+		return m.AddedFailedLoginCount()
+	case user.FieldTotpLastStep:
+		return m.AddedTotpLastStep()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *UserMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case user.FieldRemoteClientBitrateLimit:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddRemoteClientBitrateLimit(v)
+		return nil
+	case user.FieldFailedLoginCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddFailedLoginCount(v)
+		return nil
+	case user.FieldTotpLastStep:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTotpLastStep(v)
+		return nil
+	}
+	return fmt.Errorf("unknown User numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *UserMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(user.FieldAvatar) {
+		fields = append(fields, user.FieldAvatar)
+	}
+	if m.FieldCleared(user.FieldAvatarContentType) {
+		fields = append(fields, user.FieldAvatarContentType)
+	}
+	if m.FieldCleared(user.FieldOidcSubject) {
+		fields = append(fields, user.FieldOidcSubject)
+	}
+	if m.FieldCleared(user.FieldAccessRules) {
+		fields = append(fields, user.FieldAccessRules)
+	}
+	if m.FieldCleared(user.FieldRemoteClientBitrateLimit) {
+		fields = append(fields, user.FieldRemoteClientBitrateLimit)
+	}
+	if m.FieldCleared(user.FieldLockedUntil) {
+		fields = append(fields, user.FieldLockedUntil)
+	}
+	if m.FieldCleared(user.FieldTotpSecret) {
+		fields = append(fields, user.FieldTotpSecret)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *UserMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *UserMutation) ClearField(name string) error {
+	switch name {
+	case user.FieldAvatar:
+		m.ClearAvatar()
+		return nil
+	case user.FieldAvatarContentType:
+		m.ClearAvatarContentType()
+		return nil
+	case user.FieldOidcSubject:
+		m.ClearOidcSubject()
+		return nil
+	case user.FieldAccessRules:
+		m.ClearAccessRules()
+		return nil
+	case user.FieldRemoteClientBitrateLimit:
+		m.ClearRemoteClientBitrateLimit()
+		return nil
+	case user.FieldLockedUntil:
+		m.ClearLockedUntil()
+		return nil
+	case user.FieldTotpSecret:
+		m.ClearTotpSecret()
+		return nil
+	}
+	return fmt.Errorf("unknown User nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *UserMutation) ResetField(name string) error {
+	switch name {
+	case user.FieldUsername:
+		m.ResetUsername()
+		return nil
+	case user.FieldDisplayName:
+		m.ResetDisplayName()
+		return nil
+	case user.FieldHashedPassword:
+		m.ResetHashedPassword()
+		return nil
+	case user.FieldIsAdmin:
+		m.ResetIsAdmin()
+		return nil
+	case user.FieldAuthSource:
+		m.ResetAuthSource()
+		return nil
+	case user.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case user.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case user.FieldAvatar:
+		m.ResetAvatar()
+		return nil
+	case user.FieldAvatarContentType:
+		m.ResetAvatarContentType()
+		return nil
+	case user.FieldOidcSubject:
+		m.ResetOidcSubject()
+		return nil
+	case user.FieldAccessRules:
+		m.ResetAccessRules()
+		return nil
+	case user.FieldIsDisabled:
+		m.ResetIsDisabled()
+		return nil
+	case user.FieldEnableRemoteAccess:
+		m.ResetEnableRemoteAccess()
+		return nil
+	case user.FieldEnableMediaPlayback:
+		m.ResetEnableMediaPlayback()
+		return nil
+	case user.FieldEnableVideoPlaybackTranscoding:
+		m.ResetEnableVideoPlaybackTranscoding()
+		return nil
+	case user.FieldEnableContentDownloading:
+		m.ResetEnableContentDownloading()
+		return nil
+	case user.FieldRemoteClientBitrateLimit:
+		m.ResetRemoteClientBitrateLimit()
+		return nil
+	case user.FieldFailedLoginCount:
+		m.ResetFailedLoginCount()
+		return nil
+	case user.FieldLockedUntil:
+		m.ResetLockedUntil()
+		return nil
+	case user.FieldTotpSecret:
+		m.ResetTotpSecret()
+		return nil
+	case user.FieldTotpEnabled:
+		m.ResetTotpEnabled()
+		return nil
+	case user.FieldTotpLastStep:
+		m.ResetTotpLastStep()
+		return nil
+	}
+	return fmt.Errorf("unknown User field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *UserMutation) AddedEdges() []string {
+	edges := make([]string, 0, 5)
+	if m.sessions != nil {
+		edges = append(edges, user.EdgeSessions)
+	}
+	if m.backend_users != nil {
+		edges = append(edges, user.EdgeBackendUsers)
+	}
+	if m.api_keys != nil {
+		edges = append(edges, user.EdgeAPIKeys)
+	}
+	if m.recovery_codes != nil {
+		edges = append(edges, user.EdgeRecoveryCodes)
+	}
+	if m.app_passwords != nil {
+		edges = append(edges, user.EdgeAppPasswords)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *UserMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case user.EdgeSessions:
+		ids := make([]ent.Value, 0, len(m.sessions))
+		for id := range m.sessions {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeBackendUsers:
+		ids := make([]ent.Value, 0, len(m.backend_users))
+		for id := range m.backend_users {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeAPIKeys:
+		ids := make([]ent.Value, 0, len(m.api_keys))
+		for id := range m.api_keys {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeRecoveryCodes:
+		ids := make([]ent.Value, 0, len(m.recovery_codes))
+		for id := range m.recovery_codes {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeAppPasswords:
+		ids := make([]ent.Value, 0, len(m.app_passwords))
+		for id := range m.app_passwords {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *UserMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 5)
+	if m.removedsessions != nil {
+		edges = append(edges, user.EdgeSessions)
+	}
+	if m.removedbackend_users != nil {
+		edges = append(edges, user.EdgeBackendUsers)
 	}
+	if m.removedapi_keys != nil {
+		edges = append(edges, user.EdgeAPIKeys)
+	}
+	if m.removedrecovery_codes != nil {
+		edges = append(edges, user.EdgeRecoveryCodes)
+	}
+	if m.removedapp_passwords != nil {
+		edges = append(edges, user.EdgeAppPasswords)
+	}
+	return edges
 }
 
-// ClearSessions clears the "sessions" edge to the Session entity.
-func (m *UserMutation) ClearSessions() {
-	m.clearedsessions = true
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *UserMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case user.EdgeSessions:
+		ids := make([]ent.Value, 0, len(m.removedsessions))
+		for id := range m.removedsessions {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeBackendUsers:
+		ids := make([]ent.Value, 0, len(m.removedbackend_users))
+		for id := range m.removedbackend_users {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeAPIKeys:
+		ids := make([]ent.Value, 0, len(m.removedapi_keys))
+		for id := range m.removedapi_keys {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeRecoveryCodes:
+		ids := make([]ent.Value, 0, len(m.removedrecovery_codes))
+		for id := range m.removedrecovery_codes {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeAppPasswords:
+		ids := make([]ent.Value, 0, len(m.removedapp_passwords))
+		for id := range m.removedapp_passwords {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
 }
 
-// SessionsCleared reports if the "sessions" edge to the Session entity was cleared.
-func (m *UserMutation) SessionsCleared() bool {
-	return m.clearedsessions
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *UserMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 5)
+	if m.clearedsessions {
+		edges = append(edges, user.EdgeSessions)
+	}
+	if m.clearedbackend_users {
+		edges = append(edges, user.EdgeBackendUsers)
+	}
+	if m.clearedapi_keys {
+		edges = append(edges, user.EdgeAPIKeys)
+	}
+	if m.clearedrecovery_codes {
+		edges = append(edges, user.EdgeRecoveryCodes)
+	}
+	if m.clearedapp_passwords {
+		edges = append(edges, user.EdgeAppPasswords)
+	}
+	return edges
 }
 
-// RemoveSessionIDs removes the "sessions" edge to the Session entity by IDs.
-func (m *UserMutation) RemoveSessionIDs(ids ...uuid.UUID) {
-	if m.removedsessions == nil {
-		m.removedsessions = make(map[uuid.UUID]struct{})
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *UserMutation) EdgeCleared(name string) bool {
+	switch name {
+	case user.EdgeSessions:
+		return m.clearedsessions
+	case user.EdgeBackendUsers:
+		return m.clearedbackend_users
+	case user.EdgeAPIKeys:
+		return m.clearedapi_keys
+	case user.EdgeRecoveryCodes:
+		return m.clearedrecovery_codes
+	case user.EdgeAppPasswords:
+		return m.clearedapp_passwords
 	}
-	for i := range ids {
-		delete(m.sessions, ids[i])
-		m.removedsessions[ids[i]] = struct{}{}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *UserMutation) ClearEdge(name string) error {
+	switch name {
 	}
+	return fmt.Errorf("unknown User unique edge %s", name)
 }
 
-// RemovedSessions returns the removed IDs of the "sessions" edge to the Session entity.
-func (m *UserMutation) RemovedSessionsIDs() (ids []uuid.UUID) {
-	for id := range m.removedsessions {
-		ids = append(ids, id)
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *UserMutation) ResetEdge(name string) error {
+	switch name {
+	case user.EdgeSessions:
+		m.ResetSessions()
+		return nil
+	case user.EdgeBackendUsers:
+		m.ResetBackendUsers()
+		return nil
+	case user.EdgeAPIKeys:
+		m.ResetAPIKeys()
+		return nil
+	case user.EdgeRecoveryCodes:
+		m.ResetRecoveryCodes()
+		return nil
+	case user.EdgeAppPasswords:
+		m.ResetAppPasswords()
+		return nil
 	}
-	return
+	return fmt.Errorf("unknown User edge %s", name)
 }
 
-// SessionsIDs returns the "sessions" edge IDs in the mutation.
-func (m *UserMutation) SessionsIDs() (ids []uuid.UUID) {
-	for id := range m.sessions {
-		ids = append(ids, id)
+// UserConfigurationMutation represents an operation that mutates the UserConfiguration nodes in the graph.
+type UserConfigurationMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *uuid.UUID
+	user_id       *uuid.UUID
+	data          *[]byte
+	updated_at    *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*UserConfiguration, error)
+	predicates    []predicate.UserConfiguration
+}
+
+var _ ent.Mutation = (*UserConfigurationMutation)(nil)
+
+// userconfigurationOption allows management of the mutation configuration using functional options.
+type userconfigurationOption func(*UserConfigurationMutation)
+
+// newUserConfigurationMutation creates new mutation for the UserConfiguration entity.
+func newUserConfigurationMutation(c config, op Op, opts ...userconfigurationOption) *UserConfigurationMutation {
+	m := &UserConfigurationMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeUserConfiguration,
+		clearedFields: make(map[string]struct{}),
 	}
-	return
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
 }
 
-// ResetSessions resets all changes to the "sessions" edge.
-func (m *UserMutation) ResetSessions() {
-	m.sessions = nil
-	m.clearedsessions = false
-	m.removedsessions = nil
+// withUserConfigurationID sets the ID field of the mutation.
+func withUserConfigurationID(id uuid.UUID) userconfigurationOption {
+	return func(m *UserConfigurationMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *UserConfiguration
+		)
+		m.oldValue = func(ctx context.Context) (*UserConfiguration, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().UserConfiguration.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
 }
 
-// AddBackendUserIDs adds the "backend_users" edge to the BackendUser entity by ids.
-func (m *UserMutation) AddBackendUserIDs(ids ...uuid.UUID) {
-	if m.backend_users == nil {
-		m.backend_users = make(map[uuid.UUID]struct{})
+// withUserConfiguration sets the old UserConfiguration of the mutation.
+func withUserConfiguration(node *UserConfiguration) userconfigurationOption {
+	return func(m *UserConfigurationMutation) {
+		m.oldValue = func(context.Context) (*UserConfiguration, error) {
+			return node, nil
+		}
+		m.id = &node.ID
 	}
-	for i := range ids {
-		m.backend_users[ids[i]] = struct{}{}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m UserConfigurationMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m UserConfigurationMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
 }
 
-// ClearBackendUsers clears the "backend_users" edge to the BackendUser entity.
-func (m *UserMutation) ClearBackendUsers() {
-	m.clearedbackend_users = true
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of UserConfiguration entities.
+func (m *UserConfigurationMutation) SetID(id uuid.UUID) {
+	m.id = &id
 }
 
-// BackendUsersCleared reports if the "backend_users" edge to the BackendUser entity was cleared.
-func (m *UserMutation) BackendUsersCleared() bool {
-	return m.clearedbackend_users
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *UserConfigurationMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
 }
 
-// RemoveBackendUserIDs removes the "backend_users" edge to the BackendUser entity by IDs.
-func (m *UserMutation) RemoveBackendUserIDs(ids ...uuid.UUID) {
-	if m.removedbackend_users == nil {
-		m.removedbackend_users = make(map[uuid.UUID]struct{})
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *UserConfigurationMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().UserConfiguration.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
-	for i := range ids {
-		delete(m.backend_users, ids[i])
-		m.removedbackend_users[ids[i]] = struct{}{}
+}
+
+// SetUserID sets the "user_id" field.
+func (m *UserConfigurationMutation) SetUserID(u uuid.UUID) {
+	m.user_id = &u
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *UserConfigurationMutation) UserID() (r uuid.UUID, exists bool) {
+	v := m.user_id
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// RemovedBackendUsers returns the removed IDs of the "backend_users" edge to the BackendUser entity.
-func (m *UserMutation) RemovedBackendUsersIDs() (ids []uuid.UUID) {
-	for id := range m.removedbackend_users {
-		ids = append(ids, id)
+// OldUserID returns the old "user_id" field's value of the UserConfiguration entity.
+// If the UserConfiguration object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserConfigurationMutation) OldUserID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *UserConfigurationMutation) ResetUserID() {
+	m.user_id = nil
+}
+
+// SetData sets the "data" field.
+func (m *UserConfigurationMutation) SetData(b []byte) {
+	m.data = &b
+}
+
+// Data returns the value of the "data" field in the mutation.
+func (m *UserConfigurationMutation) Data() (r []byte, exists bool) {
+	v := m.data
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldData returns the old "data" field's value of the UserConfiguration entity.
+// If the UserConfiguration object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserConfigurationMutation) OldData(ctx context.Context) (v []byte, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldData is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldData requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldData: %w", err)
+	}
+	return oldValue.Data, nil
+}
+
+// ResetData resets all changes to the "data" field.
+func (m *UserConfigurationMutation) ResetData() {
+	m.data = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *UserConfigurationMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *UserConfigurationMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// BackendUsersIDs returns the "backend_users" edge IDs in the mutation.
-func (m *UserMutation) BackendUsersIDs() (ids []uuid.UUID) {
-	for id := range m.backend_users {
-		ids = append(ids, id)
+// OldUpdatedAt returns the old "updated_at" field's value of the UserConfiguration entity.
+// If the UserConfiguration object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserConfigurationMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
 }
 
-// ResetBackendUsers resets all changes to the "backend_users" edge.
-func (m *UserMutation) ResetBackendUsers() {
-	m.backend_users = nil
-	m.clearedbackend_users = false
-	m.removedbackend_users = nil
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *UserConfigurationMutation) ResetUpdatedAt() {
+	m.updated_at = nil
 }
 
-// Where appends a list predicates to the UserMutation builder.
-func (m *UserMutation) Where(ps ...predicate.User) {
+// Where appends a list predicates to the UserConfigurationMutation builder.
+func (m *UserConfigurationMutation) Where(ps ...predicate.UserConfiguration) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the UserMutation builder. Using this method,
+// WhereP appends storage-level predicates to the UserConfigurationMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *UserMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.User, len(ps))
+func (m *UserConfigurationMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.UserConfiguration, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -2630,48 +11831,33 @@ func (m *UserMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *UserMutation) Op() Op {
+func (m *UserConfigurationMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *UserMutation) SetOp(op Op) {
+func (m *UserConfigurationMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (User).
-func (m *UserMutation) Type() string {
+// Type returns the node type of this mutation (UserConfiguration).
+func (m *UserConfigurationMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *UserMutation) Fields() []string {
-	fields := make([]string, 0, 8)
-	if m.username != nil {
-		fields = append(fields, user.FieldUsername)
-	}
-	if m.display_name != nil {
-		fields = append(fields, user.FieldDisplayName)
-	}
-	if m.hashed_password != nil {
-		fields = append(fields, user.FieldHashedPassword)
-	}
-	if m.is_admin != nil {
-		fields = append(fields, user.FieldIsAdmin)
+func (m *UserConfigurationMutation) Fields() []string {
+	fields := make([]string, 0, 3)
+	if m.user_id != nil {
+		fields = append(fields, userconfiguration.FieldUserID)
 	}
-	if m.created_at != nil {
-		fields = append(fields, user.FieldCreatedAt)
+	if m.data != nil {
+		fields = append(fields, userconfiguration.FieldData)
 	}
 	if m.updated_at != nil {
-		fields = append(fields, user.FieldUpdatedAt)
-	}
-	if m.avatar != nil {
-		fields = append(fields, user.FieldAvatar)
-	}
-	if m.avatar_content_type != nil {
-		fields = append(fields, user.FieldAvatarContentType)
+		fields = append(fields, userconfiguration.FieldUpdatedAt)
 	}
 	return fields
 }
@@ -2679,24 +11865,14 @@ func (m *UserMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *UserMutation) Field(name string) (ent.Value, bool) {
+func (m *UserConfigurationMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case user.FieldUsername:
-		return m.Username()
-	case user.FieldDisplayName:
-		return m.DisplayName()
-	case user.FieldHashedPassword:
-		return m.HashedPassword()
-	case user.FieldIsAdmin:
-		return m.IsAdmin()
-	case user.FieldCreatedAt:
-		return m.CreatedAt()
-	case user.FieldUpdatedAt:
+	case userconfiguration.FieldUserID:
+		return m.UserID()
+	case userconfiguration.FieldData:
+		return m.Data()
+	case userconfiguration.FieldUpdatedAt:
 		return m.UpdatedAt()
-	case user.FieldAvatar:
-		return m.Avatar()
-	case user.FieldAvatarContentType:
-		return m.AvatarContentType()
 	}
 	return nil, false
 }
@@ -2704,287 +11880,150 @@ func (m *UserMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *UserMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *UserConfigurationMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case user.FieldUsername:
-		return m.OldUsername(ctx)
-	case user.FieldDisplayName:
-		return m.OldDisplayName(ctx)
-	case user.FieldHashedPassword:
-		return m.OldHashedPassword(ctx)
-	case user.FieldIsAdmin:
-		return m.OldIsAdmin(ctx)
-	case user.FieldCreatedAt:
-		return m.OldCreatedAt(ctx)
-	case user.FieldUpdatedAt:
+	case userconfiguration.FieldUserID:
+		return m.OldUserID(ctx)
+	case userconfiguration.FieldData:
+		return m.OldData(ctx)
+	case userconfiguration.FieldUpdatedAt:
 		return m.OldUpdatedAt(ctx)
-	case user.FieldAvatar:
-		return m.OldAvatar(ctx)
-	case user.FieldAvatarContentType:
-		return m.OldAvatarContentType(ctx)
 	}
-	return nil, fmt.Errorf("unknown User field %s", name)
+	return nil, fmt.Errorf("unknown UserConfiguration field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *UserMutation) SetField(name string, value ent.Value) error {
+func (m *UserConfigurationMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case user.FieldUsername:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetUsername(v)
-		return nil
-	case user.FieldDisplayName:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetDisplayName(v)
-		return nil
-	case user.FieldHashedPassword:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetHashedPassword(v)
-		return nil
-	case user.FieldIsAdmin:
-		v, ok := value.(bool)
+	case userconfiguration.FieldUserID:
+		v, ok := value.(uuid.UUID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetIsAdmin(v)
+		m.SetUserID(v)
 		return nil
-	case user.FieldCreatedAt:
-		v, ok := value.(time.Time)
+	case userconfiguration.FieldData:
+		v, ok := value.([]byte)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCreatedAt(v)
+		m.SetData(v)
 		return nil
-	case user.FieldUpdatedAt:
+	case userconfiguration.FieldUpdatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetUpdatedAt(v)
 		return nil
-	case user.FieldAvatar:
-		v, ok := value.([]byte)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetAvatar(v)
-		return nil
-	case user.FieldAvatarContentType:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetAvatarContentType(v)
-		return nil
 	}
-	return fmt.Errorf("unknown User field %s", name)
+	return fmt.Errorf("unknown UserConfiguration field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *UserMutation) AddedFields() []string {
+func (m *UserConfigurationMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *UserMutation) AddedField(name string) (ent.Value, bool) {
+func (m *UserConfigurationMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *UserMutation) AddField(name string, value ent.Value) error {
+func (m *UserConfigurationMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown User numeric field %s", name)
+	return fmt.Errorf("unknown UserConfiguration numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *UserMutation) ClearedFields() []string {
-	var fields []string
-	if m.FieldCleared(user.FieldAvatar) {
-		fields = append(fields, user.FieldAvatar)
-	}
-	if m.FieldCleared(user.FieldAvatarContentType) {
-		fields = append(fields, user.FieldAvatarContentType)
-	}
-	return fields
+func (m *UserConfigurationMutation) ClearedFields() []string {
+	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *UserMutation) FieldCleared(name string) bool {
+func (m *UserConfigurationMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *UserMutation) ClearField(name string) error {
-	switch name {
-	case user.FieldAvatar:
-		m.ClearAvatar()
-		return nil
-	case user.FieldAvatarContentType:
-		m.ClearAvatarContentType()
-		return nil
-	}
-	return fmt.Errorf("unknown User nullable field %s", name)
+func (m *UserConfigurationMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown UserConfiguration nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *UserMutation) ResetField(name string) error {
+func (m *UserConfigurationMutation) ResetField(name string) error {
 	switch name {
-	case user.FieldUsername:
-		m.ResetUsername()
-		return nil
-	case user.FieldDisplayName:
-		m.ResetDisplayName()
-		return nil
-	case user.FieldHashedPassword:
-		m.ResetHashedPassword()
+	case userconfiguration.FieldUserID:
+		m.ResetUserID()
 		return nil
-	case user.FieldIsAdmin:
-		m.ResetIsAdmin()
-		return nil
-	case user.FieldCreatedAt:
-		m.ResetCreatedAt()
+	case userconfiguration.FieldData:
+		m.ResetData()
 		return nil
-	case user.FieldUpdatedAt:
+	case userconfiguration.FieldUpdatedAt:
 		m.ResetUpdatedAt()
 		return nil
-	case user.FieldAvatar:
-		m.ResetAvatar()
-		return nil
-	case user.FieldAvatarContentType:
-		m.ResetAvatarContentType()
-		return nil
 	}
-	return fmt.Errorf("unknown User field %s", name)
+	return fmt.Errorf("unknown UserConfiguration field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *UserMutation) AddedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.sessions != nil {
-		edges = append(edges, user.EdgeSessions)
-	}
-	if m.backend_users != nil {
-		edges = append(edges, user.EdgeBackendUsers)
-	}
+func (m *UserConfigurationMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *UserMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case user.EdgeSessions:
-		ids := make([]ent.Value, 0, len(m.sessions))
-		for id := range m.sessions {
-			ids = append(ids, id)
-		}
-		return ids
-	case user.EdgeBackendUsers:
-		ids := make([]ent.Value, 0, len(m.backend_users))
-		for id := range m.backend_users {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *UserConfigurationMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *UserMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.removedsessions != nil {
-		edges = append(edges, user.EdgeSessions)
-	}
-	if m.removedbackend_users != nil {
-		edges = append(edges, user.EdgeBackendUsers)
-	}
+func (m *UserConfigurationMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *UserMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case user.EdgeSessions:
-		ids := make([]ent.Value, 0, len(m.removedsessions))
-		for id := range m.removedsessions {
-			ids = append(ids, id)
-		}
-		return ids
-	case user.EdgeBackendUsers:
-		ids := make([]ent.Value, 0, len(m.removedbackend_users))
-		for id := range m.removedbackend_users {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *UserConfigurationMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *UserMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.clearedsessions {
-		edges = append(edges, user.EdgeSessions)
-	}
-	if m.clearedbackend_users {
-		edges = append(edges, user.EdgeBackendUsers)
-	}
+func (m *UserConfigurationMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *UserMutation) EdgeCleared(name string) bool {
-	switch name {
-	case user.EdgeSessions:
-		return m.clearedsessions
-	case user.EdgeBackendUsers:
-		return m.clearedbackend_users
-	}
+func (m *UserConfigurationMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *UserMutation) ClearEdge(name string) error {
-	switch name {
-	}
-	return fmt.Errorf("unknown User unique edge %s", name)
+func (m *UserConfigurationMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown UserConfiguration unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *UserMutation) ResetEdge(name string) error {
-	switch name {
-	case user.EdgeSessions:
-		m.ResetSessions()
-		return nil
-	case user.EdgeBackendUsers:
-		m.ResetBackendUsers()
-		return nil
-	}
-	return fmt.Errorf("unknown User edge %s", name)
+func (m *UserConfigurationMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown UserConfiguration edge %s", name)
 }
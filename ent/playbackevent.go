@@ -0,0 +1,277 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/ddevcap/jellyfin-proxy/ent/playbackevent"
+	"github.com/google/uuid"
+)
+
+// PlaybackEvent is the model entity for the PlaybackEvent schema.
+type PlaybackEvent struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID uuid.UUID `json:"user_id,omitempty"`
+	// Username holds the value of the "username" field.
+	Username string `json:"username,omitempty"`
+	// SessionID holds the value of the "session_id" field.
+	SessionID uuid.UUID `json:"session_id,omitempty"`
+	// DeviceID holds the value of the "device_id" field.
+	DeviceID string `json:"device_id,omitempty"`
+	// DeviceName holds the value of the "device_name" field.
+	DeviceName string `json:"device_name,omitempty"`
+	// AppName holds the value of the "app_name" field.
+	AppName string `json:"app_name,omitempty"`
+	// ItemID holds the value of the "item_id" field.
+	ItemID string `json:"item_id,omitempty"`
+	// ItemName holds the value of the "item_name" field.
+	ItemName string `json:"item_name,omitempty"`
+	// ItemType holds the value of the "item_type" field.
+	ItemType string `json:"item_type,omitempty"`
+	// BackendID holds the value of the "backend_id" field.
+	BackendID uuid.UUID `json:"backend_id,omitempty"`
+	// BackendName holds the value of the "backend_name" field.
+	BackendName string `json:"backend_name,omitempty"`
+	// PlayMethod holds the value of the "play_method" field.
+	PlayMethod string `json:"play_method,omitempty"`
+	// StartedAt holds the value of the "started_at" field.
+	StartedAt time.Time `json:"started_at,omitempty"`
+	// StoppedAt holds the value of the "stopped_at" field.
+	StoppedAt *time.Time `json:"stopped_at,omitempty"`
+	// PositionTicks holds the value of the "position_ticks" field.
+	PositionTicks int64 `json:"position_ticks,omitempty"`
+	// PlayDuration holds the value of the "play_duration" field.
+	PlayDuration int64 `json:"play_duration,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*PlaybackEvent) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case playbackevent.FieldPositionTicks, playbackevent.FieldPlayDuration:
+			values[i] = new(sql.NullInt64)
+		case playbackevent.FieldUsername, playbackevent.FieldDeviceID, playbackevent.FieldDeviceName, playbackevent.FieldAppName, playbackevent.FieldItemID, playbackevent.FieldItemName, playbackevent.FieldItemType, playbackevent.FieldBackendName, playbackevent.FieldPlayMethod:
+			values[i] = new(sql.NullString)
+		case playbackevent.FieldStartedAt, playbackevent.FieldStoppedAt:
+			values[i] = new(sql.NullTime)
+		case playbackevent.FieldID, playbackevent.FieldUserID, playbackevent.FieldSessionID, playbackevent.FieldBackendID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the PlaybackEvent fields.
+func (_m *PlaybackEvent) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case playbackevent.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case playbackevent.FieldUserID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value != nil {
+				_m.UserID = *value
+			}
+		case playbackevent.FieldUsername:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field username", values[i])
+			} else if value.Valid {
+				_m.Username = value.String
+			}
+		case playbackevent.FieldSessionID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field session_id", values[i])
+			} else if value != nil {
+				_m.SessionID = *value
+			}
+		case playbackevent.FieldDeviceID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field device_id", values[i])
+			} else if value.Valid {
+				_m.DeviceID = value.String
+			}
+		case playbackevent.FieldDeviceName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field device_name", values[i])
+			} else if value.Valid {
+				_m.DeviceName = value.String
+			}
+		case playbackevent.FieldAppName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field app_name", values[i])
+			} else if value.Valid {
+				_m.AppName = value.String
+			}
+		case playbackevent.FieldItemID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field item_id", values[i])
+			} else if value.Valid {
+				_m.ItemID = value.String
+			}
+		case playbackevent.FieldItemName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field item_name", values[i])
+			} else if value.Valid {
+				_m.ItemName = value.String
+			}
+		case playbackevent.FieldItemType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field item_type", values[i])
+			} else if value.Valid {
+				_m.ItemType = value.String
+			}
+		case playbackevent.FieldBackendID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field backend_id", values[i])
+			} else if value != nil {
+				_m.BackendID = *value
+			}
+		case playbackevent.FieldBackendName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field backend_name", values[i])
+			} else if value.Valid {
+				_m.BackendName = value.String
+			}
+		case playbackevent.FieldPlayMethod:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field play_method", values[i])
+			} else if value.Valid {
+				_m.PlayMethod = value.String
+			}
+		case playbackevent.FieldStartedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field started_at", values[i])
+			} else if value.Valid {
+				_m.StartedAt = value.Time
+			}
+		case playbackevent.FieldStoppedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field stopped_at", values[i])
+			} else if value.Valid {
+				_m.StoppedAt = new(time.Time)
+				*_m.StoppedAt = value.Time
+			}
+		case playbackevent.FieldPositionTicks:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field position_ticks", values[i])
+			} else if value.Valid {
+				_m.PositionTicks = value.Int64
+			}
+		case playbackevent.FieldPlayDuration:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field play_duration", values[i])
+			} else if value.Valid {
+				_m.PlayDuration = value.Int64
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the PlaybackEvent.
+// This includes values selected through modifiers, order, etc.
+func (_m *PlaybackEvent) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this PlaybackEvent.
+// Note that you need to call PlaybackEvent.Unwrap() before calling this method if this PlaybackEvent
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *PlaybackEvent) Update() *PlaybackEventUpdateOne {
+	return NewPlaybackEventClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the PlaybackEvent entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *PlaybackEvent) Unwrap() *PlaybackEvent {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: PlaybackEvent is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *PlaybackEvent) String() string {
+	var builder strings.Builder
+	builder.WriteString("PlaybackEvent(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.UserID))
+	builder.WriteString(", ")
+	builder.WriteString("username=")
+	builder.WriteString(_m.Username)
+	builder.WriteString(", ")
+	builder.WriteString("session_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.SessionID))
+	builder.WriteString(", ")
+	builder.WriteString("device_id=")
+	builder.WriteString(_m.DeviceID)
+	builder.WriteString(", ")
+	builder.WriteString("device_name=")
+	builder.WriteString(_m.DeviceName)
+	builder.WriteString(", ")
+	builder.WriteString("app_name=")
+	builder.WriteString(_m.AppName)
+	builder.WriteString(", ")
+	builder.WriteString("item_id=")
+	builder.WriteString(_m.ItemID)
+	builder.WriteString(", ")
+	builder.WriteString("item_name=")
+	builder.WriteString(_m.ItemName)
+	builder.WriteString(", ")
+	builder.WriteString("item_type=")
+	builder.WriteString(_m.ItemType)
+	builder.WriteString(", ")
+	builder.WriteString("backend_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.BackendID))
+	builder.WriteString(", ")
+	builder.WriteString("backend_name=")
+	builder.WriteString(_m.BackendName)
+	builder.WriteString(", ")
+	builder.WriteString("play_method=")
+	builder.WriteString(_m.PlayMethod)
+	builder.WriteString(", ")
+	builder.WriteString("started_at=")
+	builder.WriteString(_m.StartedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := _m.StoppedAt; v != nil {
+		builder.WriteString("stopped_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("position_ticks=")
+	builder.WriteString(fmt.Sprintf("%v", _m.PositionTicks))
+	builder.WriteString(", ")
+	builder.WriteString("play_duration=")
+	builder.WriteString(fmt.Sprintf("%v", _m.PlayDuration))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// PlaybackEvents is a parsable slice of PlaybackEvent.
+type PlaybackEvents []*PlaybackEvent
@@ -0,0 +1,183 @@
+// Code generated by ent, DO NOT EDIT.
+
+package playbackevent
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the playbackevent type in the database.
+	Label = "playback_event"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldUserID holds the string denoting the user_id field in the database.
+	FieldUserID = "user_id"
+	// FieldUsername holds the string denoting the username field in the database.
+	FieldUsername = "username"
+	// FieldSessionID holds the string denoting the session_id field in the database.
+	FieldSessionID = "session_id"
+	// FieldDeviceID holds the string denoting the device_id field in the database.
+	FieldDeviceID = "device_id"
+	// FieldDeviceName holds the string denoting the device_name field in the database.
+	FieldDeviceName = "device_name"
+	// FieldAppName holds the string denoting the app_name field in the database.
+	FieldAppName = "app_name"
+	// FieldItemID holds the string denoting the item_id field in the database.
+	FieldItemID = "item_id"
+	// FieldItemName holds the string denoting the item_name field in the database.
+	FieldItemName = "item_name"
+	// FieldItemType holds the string denoting the item_type field in the database.
+	FieldItemType = "item_type"
+	// FieldBackendID holds the string denoting the backend_id field in the database.
+	FieldBackendID = "backend_id"
+	// FieldBackendName holds the string denoting the backend_name field in the database.
+	FieldBackendName = "backend_name"
+	// FieldPlayMethod holds the string denoting the play_method field in the database.
+	FieldPlayMethod = "play_method"
+	// FieldStartedAt holds the string denoting the started_at field in the database.
+	FieldStartedAt = "started_at"
+	// FieldStoppedAt holds the string denoting the stopped_at field in the database.
+	FieldStoppedAt = "stopped_at"
+	// FieldPositionTicks holds the string denoting the position_ticks field in the database.
+	FieldPositionTicks = "position_ticks"
+	// FieldPlayDuration holds the string denoting the play_duration field in the database.
+	FieldPlayDuration = "play_duration"
+	// Table holds the table name of the playbackevent in the database.
+	Table = "playback_events"
+)
+
+// Columns holds all SQL columns for playbackevent fields.
+var Columns = []string{
+	FieldID,
+	FieldUserID,
+	FieldUsername,
+	FieldSessionID,
+	FieldDeviceID,
+	FieldDeviceName,
+	FieldAppName,
+	FieldItemID,
+	FieldItemName,
+	FieldItemType,
+	FieldBackendID,
+	FieldBackendName,
+	FieldPlayMethod,
+	FieldStartedAt,
+	FieldStoppedAt,
+	FieldPositionTicks,
+	FieldPlayDuration,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// ItemIDValidator is a validator for the "item_id" field. It is called by the builders before save.
+	ItemIDValidator func(string) error
+	// DefaultStartedAt holds the default value on creation for the "started_at" field.
+	DefaultStartedAt func() time.Time
+	// DefaultPositionTicks holds the default value on creation for the "position_ticks" field.
+	DefaultPositionTicks int64
+	// DefaultPlayDuration holds the default value on creation for the "play_duration" field.
+	DefaultPlayDuration int64
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// OrderOption defines the ordering options for the PlaybackEvent queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByUserID orders the results by the user_id field.
+func ByUserID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUserID, opts...).ToFunc()
+}
+
+// ByUsername orders the results by the username field.
+func ByUsername(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUsername, opts...).ToFunc()
+}
+
+// BySessionID orders the results by the session_id field.
+func BySessionID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSessionID, opts...).ToFunc()
+}
+
+// ByDeviceID orders the results by the device_id field.
+func ByDeviceID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeviceID, opts...).ToFunc()
+}
+
+// ByDeviceName orders the results by the device_name field.
+func ByDeviceName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeviceName, opts...).ToFunc()
+}
+
+// ByAppName orders the results by the app_name field.
+func ByAppName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAppName, opts...).ToFunc()
+}
+
+// ByItemID orders the results by the item_id field.
+func ByItemID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldItemID, opts...).ToFunc()
+}
+
+// ByItemName orders the results by the item_name field.
+func ByItemName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldItemName, opts...).ToFunc()
+}
+
+// ByItemType orders the results by the item_type field.
+func ByItemType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldItemType, opts...).ToFunc()
+}
+
+// ByBackendID orders the results by the backend_id field.
+func ByBackendID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBackendID, opts...).ToFunc()
+}
+
+// ByBackendName orders the results by the backend_name field.
+func ByBackendName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBackendName, opts...).ToFunc()
+}
+
+// ByPlayMethod orders the results by the play_method field.
+func ByPlayMethod(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPlayMethod, opts...).ToFunc()
+}
+
+// ByStartedAt orders the results by the started_at field.
+func ByStartedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStartedAt, opts...).ToFunc()
+}
+
+// ByStoppedAt orders the results by the stopped_at field.
+func ByStoppedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStoppedAt, opts...).ToFunc()
+}
+
+// ByPositionTicks orders the results by the position_ticks field.
+func ByPositionTicks(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPositionTicks, opts...).ToFunc()
+}
+
+// ByPlayDuration orders the results by the play_duration field.
+func ByPlayDuration(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPlayDuration, opts...).ToFunc()
+}
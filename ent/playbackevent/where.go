@@ -0,0 +1,1056 @@
+// Code generated by ent, DO NOT EDIT.
+
+package playbackevent
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/ddevcap/jellyfin-proxy/ent/predicate"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.PlaybackEvent {
+	return predicate.PlaybackEvent(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.PlaybackEvent {
+	return predicate.PlaybackEvent(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.PlaybackEvent {
+	return predicate.PlaybackEvent(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.PlaybackEvent {
+	return predicate.PlaybackEvent(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.PlaybackEvent {
+	return predicate.PlaybackEvent(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.PlaybackEvent {
+	return predicate.PlaybackEvent(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.PlaybackEvent {
+	return predicate.PlaybackEvent(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.PlaybackEvent {
+	return predicate.PlaybackEvent(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.PlaybackEvent {
+	return predicate.PlaybackEvent(sql.FieldLTE(FieldID, id))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v uuid.UUID) predicate.PlaybackEvent {
+	return predicate.PlaybackEvent(sql.FieldEQ(FieldUserID, v))
+}
+
+// Username applies equality check predicate on the "username" field. It's identical to UsernameEQ.
+func Username(v string) predicate.PlaybackEvent {
+	return predicate.PlaybackEvent(sql.FieldEQ(FieldUsername, v))
+}
+
+// SessionID applies equality check predicate on the "session_id" field. It's identical to SessionIDEQ.
+func SessionID(v uuid.UUID) predicate.PlaybackEvent {
+	return predicate.PlaybackEvent(sql.FieldEQ(FieldSessionID, v))
+}
+
+// DeviceID applies equality check predicate on the "device_id" field. It's identical to DeviceIDEQ.
+func DeviceID(v string) predicate.PlaybackEvent {
+	return predicate.PlaybackEvent(sql.FieldEQ(FieldDeviceID, v))
+}
+
+// DeviceName applies equality check predicate on the "device_name" field. It's identical to DeviceNameEQ.
+func DeviceName(v string) predicate.PlaybackEvent {
+	return predicate.PlaybackEvent(sql.FieldEQ(FieldDeviceName, v))
+}
+
+// AppName applies equality check predicate on the "app_name" field. It's identical to AppNameEQ.
+func AppName(v string) predicate.PlaybackEvent {
+	return predicate.PlaybackEvent(sql.FieldEQ(FieldAppName, v))
+}
+
+// ItemID applies equality check predicate on the "item_id" field. It's identical to ItemIDEQ.
+func ItemID(v string) predicate.PlaybackEvent {
+	return predicate.PlaybackEvent(sql.FieldEQ(FieldItemID, v))
+}
+
+// ItemName applies equality check predicate on the "item_name" field. It's identical to ItemNameEQ.
+func ItemName(v string) predicate.PlaybackEvent {
+	return predicate.PlaybackEvent(sql.FieldEQ(FieldItemName, v))
+}
+
+// ItemType applies equality check predicate on the "item_type" field. It's identical to ItemTypeEQ.
+func ItemType(v string) predicate.PlaybackEvent {
+	return predicate.PlaybackEvent(sql.FieldEQ(FieldItemType, v))
+}
+
+// BackendID applies equality check predicate on the "backend_id" field. It's identical to BackendIDEQ.
+func BackendID(v uuid.UUID) predicate.PlaybackEvent {
+	return predicate.PlaybackEvent(sql.FieldEQ(FieldBackendID, v))
+}
+
+// BackendName applies equality check predicate on the "backend_name" field. It's identical to BackendNameEQ.
+func BackendName(v string) predicate.PlaybackEvent {
+	return predicate.PlaybackEvent(sql.FieldEQ(FieldBackendName, v))
+}
+
+// PlayMethod applies equality check predicate on the "play_method" field. It's identical to PlayMethodEQ.
+func PlayMethod(v string) predicate.PlaybackEvent {
+	return predicate.PlaybackEvent(sql.FieldEQ(FieldPlayMethod, v))
+}
+
+// StartedAt applies equality check predicate on the "started_at" field. It's identical to StartedAtEQ.
+func StartedAt(v time.Time) predicate.PlaybackEvent {
+	return predicate.PlaybackEvent(sql.FieldEQ(FieldStartedAt, v))
+}
+
+// StoppedAt applies equality check predicate on the "stopped_at" field. It's identical to StoppedAtEQ.
+func StoppedAt(v time.Time) predicate.PlaybackEvent {
+	return predicate.PlaybackEvent(sql.FieldEQ(FieldStoppedAt, v))
+}
+
+// PositionTicks applies equality check predicate on the "position_ticks" field. It's identical to PositionTicksEQ.
+func PositionTicks(v int64) predicate.PlaybackEvent {
+	return predicate.PlaybackEvent(sql.FieldEQ(FieldPositionTicks, v))
+}
+
+// PlayDuration applies equality check predicate on the "play_duration" field. It's identical to PlayDurationEQ.
+func PlayDuration(v int64) predicate.PlaybackEvent {
+	return predicate.PlaybackEvent(sql.FieldEQ(FieldPlayDuration, v))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v uuid.UUID) predicate.PlaybackEvent {
+	return predicate.PlaybackEvent(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v uuid.UUID) predicate.PlaybackEvent {
+	return predicate.PlaybackEvent(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...uuid.UUID) predicate.PlaybackEvent {
+	return predicate.PlaybackEvent(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...uuid.UUID) predicate.PlaybackEvent {
+	return predicate.PlaybackEvent(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// UserIDGT applies the GT predicate on the "user_id" field.
+func UserIDGT(v uuid.UUID) predicate.PlaybackEvent {
+	return predicate.PlaybackEvent(sql.FieldGT(FieldUserID, v))
+}
+
+// UserIDGTE applies the GTE predicate on the "user_id" field.
+func UserIDGTE(v uuid.UUID) predicate.PlaybackEvent {
+	return predicate.PlaybackEvent(sql.FieldGTE(FieldUserID, v))
+}
+
+// UserIDLT applies the LT predicate on the "user_id" field.
+func UserIDLT(v uuid.UUID) predicate.PlaybackEvent {
+	return predicate.PlaybackEvent(sql.FieldLT(FieldUserID, v))
+}
+
+// UserIDLTE applies the LTE predicate on the "user_id" field.
+func UserIDLTE(v uuid.UUID) predicate.PlaybackEvent {
+	return predicate.PlaybackEvent(sql.FieldLTE(FieldUserID, v))
+}
+
+// UsernameEQ applies the EQ predicate on the "username" field.
+func UsernameEQ(v string) predicate.PlaybackEvent {
+	return predicate.PlaybackEvent(sql.FieldEQ(FieldUsername, v))
+}
+
+// UsernameNEQ applies the NEQ predicate on the "username" field.
+func UsernameNEQ(v string) predicate.PlaybackEvent {
+	return predicate.PlaybackEvent(sql.FieldNEQ(FieldUsername, v))
+}
+
+// UsernameIn applies the In predicate on the "username" field.
+func UsernameIn(vs ...string) predicate.PlaybackEvent {
+	return predicate.PlaybackEvent(sql.FieldIn(FieldUsername, vs...))
+}
+
+// UsernameNotIn applies the NotIn predicate on the "username" field.
+func UsernameNotIn(vs ...string) predicate.PlaybackEvent {
+	return predicate.PlaybackEvent(sql.FieldNotIn(FieldUsername, vs...))
+}
+
+// UsernameGT applies the GT predicate on the "username" field.
+func UsernameGT(v string) predicate.PlaybackEvent {
+	return predicate.PlaybackEvent(sql.FieldGT(FieldUsername, v))
+}
+
+// UsernameGTE applies the GTE predicate on the "username" field.
+func UsernameGTE(v string) predicate.PlaybackEvent {
+	return predicate.PlaybackEvent(sql.FieldGTE(FieldUsername, v))
+}
+
+// UsernameLT applies the LT predicate on the "username" field.
+func UsernameLT(v string) predicate.PlaybackEvent {
+	return predicate.PlaybackEvent(sql.FieldLT(FieldUsername, v))
+}
+
+// UsernameLTE applies the LTE predicate on the "username" field.
+func UsernameLTE(v string) predicate.PlaybackEvent {
+	return predicate.PlaybackEvent(sql.FieldLTE(FieldUsername, v))
+}
+
+// UsernameContains applies the Contains predicate on the "username" field.
+func UsernameContains(v string) predicate.PlaybackEvent {
+	return predicate.PlaybackEvent(sql.FieldContains(FieldUsername, v))
+}
+
+// UsernameHasPrefix applies the HasPrefix predicate on the "username" field.
+func UsernameHasPrefix(v string) predicate.PlaybackEvent {
+	return predicate.PlaybackEvent(sql.FieldHasPrefix(FieldUsername, v))
+}
+
+// UsernameHasSuffix applies the HasSuffix predicate on the "username" field.
+func UsernameHasSuffix(v string) predicate.PlaybackEvent {
+	return predicate.PlaybackEvent(sql.FieldHasSuffix(FieldUsername, v))
+}
+
+// UsernameEqualFold applies the EqualFold predicate on the "username" field.
+func UsernameEqualFold(v string) predicate.PlaybackEvent {
+	return predicate.PlaybackEvent(sql.FieldEqualFold(FieldUsername, v))
+}
+
+// UsernameContainsFold applies the ContainsFold predicate on the "username" field.
+func UsernameContainsFold(v string) predicate.PlaybackEvent {
+	return predicate.PlaybackEvent(sql.FieldContainsFold(FieldUsername, v))
+}
+
+// SessionIDEQ applies the EQ predicate on the "session_id" field.
+func SessionIDEQ(v uuid.UUID) predicate.PlaybackEvent {
+	return predicate.PlaybackEvent(sql.FieldEQ(FieldSessionID, v))
+}
+
+// SessionIDNEQ applies the NEQ predicate on the "session_id" field.
+func SessionIDNEQ(v uuid.UUID) predicate.PlaybackEvent {
+	return predicate.PlaybackEvent(sql.FieldNEQ(FieldSessionID, v))
+}
+
+// SessionIDIn applies the In predicate on the "session_id" field.
+func SessionIDIn(vs ...uuid.UUID) predicate.PlaybackEvent {
+	return predicate.PlaybackEvent(sql.FieldIn(FieldSessionID, vs...))
+}
+
+// SessionIDNotIn applies the NotIn predicate on the "session_id" field.
+func SessionIDNotIn(vs ...uuid.UUID) predicate.PlaybackEvent {
+	return predicate.PlaybackEvent(sql.FieldNotIn(FieldSessionID, vs...))
+}
+
+// SessionIDGT applies the GT predicate on the "session_id" field.
+func SessionIDGT(v uuid.UUID) predicate.PlaybackEvent {
+	return predicate.PlaybackEvent(sql.FieldGT(FieldSessionID, v))
+}
+
+// SessionIDGTE applies the GTE predicate on the "session_id" field.
+func SessionIDGTE(v uuid.UUID) predicate.PlaybackEvent {
+	return predicate.PlaybackEvent(sql.FieldGTE(FieldSessionID, v))
+}
+
+// SessionIDLT applies the LT predicate on the "session_id" field.
+func SessionIDLT(v uuid.UUID) predicate.PlaybackEvent {
+	return predicate.PlaybackEvent(sql.FieldLT(FieldSessionID, v))
+}
+
+// SessionIDLTE applies the LTE predicate on the "session_id" field.
+func SessionIDLTE(v uuid.UUID) predicate.PlaybackEvent {
+	return predicate.PlaybackEvent(sql.FieldLTE(FieldSessionID, v))
+}
+
+// DeviceIDEQ applies the EQ predicate on the "device_id" field.
+func DeviceIDEQ(v string) predicate.PlaybackEvent {
+	return predicate.PlaybackEvent(sql.FieldEQ(FieldDeviceID, v))
+}
+
+// DeviceIDNEQ applies the NEQ predicate on the "device_id" field.
+func DeviceIDNEQ(v string) predicate.PlaybackEvent {
+	return predicate.PlaybackEvent(sql.FieldNEQ(FieldDeviceID, v))
+}
+
+// DeviceIDIn applies the In predicate on the "device_id" field.
+func DeviceIDIn(vs ...string) predicate.PlaybackEvent {
+	return predicate.PlaybackEvent(sql.FieldIn(FieldDeviceID, vs...))
+}
+
+// DeviceIDNotIn applies the NotIn predicate on the "device_id" field.
+func DeviceIDNotIn(vs ...string) predicate.PlaybackEvent {
+	return predicate.PlaybackEvent(sql.FieldNotIn(FieldDeviceID, vs...))
+}
+
+// DeviceIDGT applies the GT predicate on the "device_id" field.
+func DeviceIDGT(v string) predicate.PlaybackEvent {
+	return predicate.PlaybackEvent(sql.FieldGT(FieldDeviceID, v))
+}
+
+// DeviceIDGTE applies the GTE predicate on the "device_id" field.
+func DeviceIDGTE(v string) predicate.PlaybackEvent {
+	return predicate.PlaybackEvent(sql.FieldGTE(FieldDeviceID, v))
+}
+
+// DeviceIDLT applies the LT predicate on the "device_id" field.
+func DeviceIDLT(v string) predicate.PlaybackEvent {
+	return predicate.PlaybackEvent(sql.FieldLT(FieldDeviceID, v))
+}
+
+// DeviceIDLTE applies the LTE predicate on the "device_id" field.
+func DeviceIDLTE(v string) predicate.PlaybackEvent {
+	return predicate.PlaybackEvent(sql.FieldLTE(FieldDeviceID, v))
+}
+
+// DeviceIDContains applies the Contains predicate on the "device_id" field.
+func DeviceIDContains(v string) predicate.PlaybackEvent {
+	return predicate.PlaybackEvent(sql.FieldContains(FieldDeviceID, v))
+}
+
+// DeviceIDHasPrefix applies the HasPrefix predicate on the "device_id" field.
+func DeviceIDHasPrefix(v string) predicate.PlaybackEvent {
+	return predicate.PlaybackEvent(sql.FieldHasPrefix(FieldDeviceID, v))
+}
+
+// DeviceIDHasSuffix applies the HasSuffix predicate on the "device_id" field.
+func DeviceIDHasSuffix(v string) predicate.PlaybackEvent {
+	return predicate.PlaybackEvent(sql.FieldHasSuffix(FieldDeviceID, v))
+}
+
+// DeviceIDEqualFold applies the EqualFold predicate on the "device_id" field.
+func DeviceIDEqualFold(v string) predicate.PlaybackEvent {
+	return predicate.PlaybackEvent(sql.FieldEqualFold(FieldDeviceID, v))
+}
+
+// DeviceIDContainsFold applies the ContainsFold predicate on the "device_id" field.
+func DeviceIDContainsFold(v string) predicate.PlaybackEvent {
+	return predicate.PlaybackEvent(sql.FieldContainsFold(FieldDeviceID, v))
+}
+
+// DeviceNameEQ applies the EQ predicate on the "device_name" field.
+func DeviceNameEQ(v string) predicate.PlaybackEvent {
+	return predicate.PlaybackEvent(sql.FieldEQ(FieldDeviceName, v))
+}
+
+// DeviceNameNEQ applies the NEQ predicate on the "device_name" field.
+func DeviceNameNEQ(v string) predicate.PlaybackEvent {
+	return predicate.PlaybackEvent(sql.FieldNEQ(FieldDeviceName, v))
+}
+
+// DeviceNameIn applies the In predicate on the "device_name" field.
+func DeviceNameIn(vs ...string) predicate.PlaybackEvent {
+	return predicate.PlaybackEvent(sql.FieldIn(FieldDeviceName, vs...))
+}
+
+// DeviceNameNotIn applies the NotIn predicate on the "device_name" field.
+func DeviceNameNotIn(vs ...string) predicate.PlaybackEvent {
+	return predicate.PlaybackEvent(sql.FieldNotIn(FieldDeviceName, vs...))
+}
+
+// DeviceNameGT applies the GT predicate on the "device_name" field.
+func DeviceNameGT(v string) predicate.PlaybackEvent {
+	return predicate.PlaybackEvent(sql.FieldGT(FieldDeviceName, v))
+}
+
+// DeviceNameGTE applies the GTE predicate on the "device_name" field.
+func DeviceNameGTE(v string) predicate.PlaybackEvent {
+	return predicate.PlaybackEvent(sql.FieldGTE(FieldDeviceName, v))
+}
+
+// DeviceNameLT applies the LT predicate on the "device_name" field.
+func DeviceNameLT(v string) predicate.PlaybackEvent {
+	return predicate.PlaybackEvent(sql.FieldLT(FieldDeviceName, v))
+}
+
+// DeviceNameLTE applies the LTE predicate on the "device_name" field.
+func DeviceNameLTE(v string) predicate.PlaybackEvent {
+	return predicate.PlaybackEvent(sql.FieldLTE(FieldDeviceName, v))
+}
+
+// DeviceNameContains applies the Contains predicate on the "device_name" field.
+func DeviceNameContains(v string) predicate.PlaybackEvent {
+	return predicate.PlaybackEvent(sql.FieldContains(FieldDeviceName, v))
+}
+
+// DeviceNameHasPrefix applies the HasPrefix predicate on the "device_name" field.
+func DeviceNameHasPrefix(v string) predicate.PlaybackEvent {
+	return predicate.PlaybackEvent(sql.FieldHasPrefix(FieldDeviceName, v))
+}
+
+// DeviceNameHasSuffix applies the HasSuffix predicate on the "device_name" field.
+func DeviceNameHasSuffix(v string) predicate.PlaybackEvent {
+	return predicate.PlaybackEvent(sql.FieldHasSuffix(FieldDeviceName, v))
+}
+
+// DeviceNameEqualFold applies the EqualFold predicate on the "device_name" field.
+func DeviceNameEqualFold(v string) predicate.PlaybackEvent {
+	return predicate.PlaybackEvent(sql.FieldEqualFold(FieldDeviceName, v))
+}
+
+// DeviceNameContainsFold applies the ContainsFold predicate on the "device_name" field.
+func DeviceNameContainsFold(v string) predicate.PlaybackEvent {
+	return predicate.PlaybackEvent(sql.FieldContainsFold(FieldDeviceName, v))
+}
+
+// AppNameEQ applies the EQ predicate on the "app_name" field.
+func AppNameEQ(v string) predicate.PlaybackEvent {
+	return predicate.PlaybackEvent(sql.FieldEQ(FieldAppName, v))
+}
+
+// AppNameNEQ applies the NEQ predicate on the "app_name" field.
+func AppNameNEQ(v string) predicate.PlaybackEvent {
+	return predicate.PlaybackEvent(sql.FieldNEQ(FieldAppName, v))
+}
+
+// AppNameIn applies the In predicate on the "app_name" field.
+func AppNameIn(vs ...string) predicate.PlaybackEvent {
+	return predicate.PlaybackEvent(sql.FieldIn(FieldAppName, vs...))
+}
+
+// AppNameNotIn applies the NotIn predicate on the "app_name" field.
+func AppNameNotIn(vs ...string) predicate.PlaybackEvent {
+	return predicate.PlaybackEvent(sql.FieldNotIn(FieldAppName, vs...))
+}
+
+// AppNameGT applies the GT predicate on the "app_name" field.
+func AppNameGT(v string) predicate.PlaybackEvent {
+	return predicate.PlaybackEvent(sql.FieldGT(FieldAppName, v))
+}
+
+// AppNameGTE applies the GTE predicate on the "app_name" field.
+func AppNameGTE(v string) predicate.PlaybackEvent {
+	return predicate.PlaybackEvent(sql.FieldGTE(FieldAppName, v))
+}
+
+// AppNameLT applies the LT predicate on the "app_name" field.
+func AppNameLT(v string) predicate.PlaybackEvent {
+	return predicate.PlaybackEvent(sql.FieldLT(FieldAppName, v))
+}
+
+// AppNameLTE applies the LTE predicate on the "app_name" field.
+func AppNameLTE(v string) predicate.PlaybackEvent {
+	return predicate.PlaybackEvent(sql.FieldLTE(FieldAppName, v))
+}
+
+// AppNameContains applies the Contains predicate on the "app_name" field.
+func AppNameContains(v string) predicate.PlaybackEvent {
+	return predicate.PlaybackEvent(sql.FieldContains(FieldAppName, v))
+}
+
+// AppNameHasPrefix applies the HasPrefix predicate on the "app_name" field.
+func AppNameHasPrefix(v string) predicate.PlaybackEvent {
+	return predicate.PlaybackEvent(sql.FieldHasPrefix(FieldAppName, v))
+}
+
+// AppNameHasSuffix applies the HasSuffix predicate on the "app_name" field.
+func AppNameHasSuffix(v string) predicate.PlaybackEvent {
+	return predicate.PlaybackEvent(sql.FieldHasSuffix(FieldAppName, v))
+}
+
+// AppNameEqualFold applies the EqualFold predicate on the "app_name" field.
+func AppNameEqualFold(v string) predicate.PlaybackEvent {
+	return predicate.PlaybackEvent(sql.FieldEqualFold(FieldAppName, v))
+}
+
+// AppNameContainsFold applies the ContainsFold predicate on the "app_name" field.
+func AppNameContainsFold(v string) predicate.PlaybackEvent {
+	return predicate.PlaybackEvent(sql.FieldContainsFold(FieldAppName, v))
+}
+
+// ItemIDEQ applies the EQ predicate on the "item_id" field.
+func ItemIDEQ(v string) predicate.PlaybackEvent {
+	return predicate.PlaybackEvent(sql.FieldEQ(FieldItemID, v))
+}
+
+// ItemIDNEQ applies the NEQ predicate on the "item_id" field.
+func ItemIDNEQ(v string) predicate.PlaybackEvent {
+	return predicate.PlaybackEvent(sql.FieldNEQ(FieldItemID, v))
+}
+
+// ItemIDIn applies the In predicate on the "item_id" field.
+func ItemIDIn(vs ...string) predicate.PlaybackEvent {
+	return predicate.PlaybackEvent(sql.FieldIn(FieldItemID, vs...))
+}
+
+// ItemIDNotIn applies the NotIn predicate on the "item_id" field.
+func ItemIDNotIn(vs ...string) predicate.PlaybackEvent {
+	return predicate.PlaybackEvent(sql.FieldNotIn(FieldItemID, vs...))
+}
+
+// ItemIDGT applies the GT predicate on the "item_id" field.
+func ItemIDGT(v string) predicate.PlaybackEvent {
+	return predicate.PlaybackEvent(sql.FieldGT(FieldItemID, v))
+}
+
+// ItemIDGTE applies the GTE predicate on the "item_id" field.
+func ItemIDGTE(v string) predicate.PlaybackEvent {
+	return predicate.PlaybackEvent(sql.FieldGTE(FieldItemID, v))
+}
+
+// ItemIDLT applies the LT predicate on the "item_id" field.
+func ItemIDLT(v string) predicate.PlaybackEvent {
+	return predicate.PlaybackEvent(sql.FieldLT(FieldItemID, v))
+}
+
+// ItemIDLTE applies the LTE predicate on the "item_id" field.
+func ItemIDLTE(v string) predicate.PlaybackEvent {
+	return predicate.PlaybackEvent(sql.FieldLTE(FieldItemID, v))
+}
+
+// ItemIDContains applies the Contains predicate on the "item_id" field.
+func ItemIDContains(v string) predicate.PlaybackEvent {
+	return predicate.PlaybackEvent(sql.FieldContains(FieldItemID, v))
+}
+
+// ItemIDHasPrefix applies the HasPrefix predicate on the "item_id" field.
+func ItemIDHasPrefix(v string) predicate.PlaybackEvent {
+	return predicate.PlaybackEvent(sql.FieldHasPrefix(FieldItemID, v))
+}
+
+// ItemIDHasSuffix applies the HasSuffix predicate on the "item_id" field.
+func ItemIDHasSuffix(v string) predicate.PlaybackEvent {
+	return predicate.PlaybackEvent(sql.FieldHasSuffix(FieldItemID, v))
+}
+
+// ItemIDEqualFold applies the EqualFold predicate on the "item_id" field.
+func ItemIDEqualFold(v string) predicate.PlaybackEvent {
+	return predicate.PlaybackEvent(sql.FieldEqualFold(FieldItemID, v))
+}
+
+// ItemIDContainsFold applies the ContainsFold predicate on the "item_id" field.
+func ItemIDContainsFold(v string) predicate.PlaybackEvent {
+	return predicate.PlaybackEvent(sql.FieldContainsFold(FieldItemID, v))
+}
+
+// ItemNameEQ applies the EQ predicate on the "item_name" field.
+func ItemNameEQ(v string) predicate.PlaybackEvent {
+	return predicate.PlaybackEvent(sql.FieldEQ(FieldItemName, v))
+}
+
+// ItemNameNEQ applies the NEQ predicate on the "item_name" field.
+func ItemNameNEQ(v string) predicate.PlaybackEvent {
+	return predicate.PlaybackEvent(sql.FieldNEQ(FieldItemName, v))
+}
+
+// ItemNameIn applies the In predicate on the "item_name" field.
+func ItemNameIn(vs ...string) predicate.PlaybackEvent {
+	return predicate.PlaybackEvent(sql.FieldIn(FieldItemName, vs...))
+}
+
+// ItemNameNotIn applies the NotIn predicate on the "item_name" field.
+func ItemNameNotIn(vs ...string) predicate.PlaybackEvent {
+	return predicate.PlaybackEvent(sql.FieldNotIn(FieldItemName, vs...))
+}
+
+// ItemNameGT applies the GT predicate on the "item_name" field.
+func ItemNameGT(v string) predicate.PlaybackEvent {
+	return predicate.PlaybackEvent(sql.FieldGT(FieldItemName, v))
+}
+
+// ItemNameGTE applies the GTE predicate on the "item_name" field.
+func ItemNameGTE(v string) predicate.PlaybackEvent {
+	return predicate.PlaybackEvent(sql.FieldGTE(FieldItemName, v))
+}
+
+// ItemNameLT applies the LT predicate on the "item_name" field.
+func ItemNameLT(v string) predicate.PlaybackEvent {
+	return predicate.PlaybackEvent(sql.FieldLT(FieldItemName, v))
+}
+
+// ItemNameLTE applies the LTE predicate on the "item_name" field.
+func ItemNameLTE(v string) predicate.PlaybackEvent {
+	return predicate.PlaybackEvent(sql.FieldLTE(FieldItemName, v))
+}
+
+// ItemNameContains applies the Contains predicate on the "item_name" field.
+func ItemNameContains(v string) predicate.PlaybackEvent {
+	return predicate.PlaybackEvent(sql.FieldContains(FieldItemName, v))
+}
+
+// ItemNameHasPrefix applies the HasPrefix predicate on the "item_name" field.
+func ItemNameHasPrefix(v string) predicate.PlaybackEvent {
+	return predicate.PlaybackEvent(sql.FieldHasPrefix(FieldItemName, v))
+}
+
+// ItemNameHasSuffix applies the HasSuffix predicate on the "item_name" field.
+func ItemNameHasSuffix(v string) predicate.PlaybackEvent {
+	return predicate.PlaybackEvent(sql.FieldHasSuffix(FieldItemName, v))
+}
+
+// ItemNameIsNil applies the IsNil predicate on the "item_name" field.
+func ItemNameIsNil() predicate.PlaybackEvent {
+	return predicate.PlaybackEvent(sql.FieldIsNull(FieldItemName))
+}
+
+// ItemNameNotNil applies the NotNil predicate on the "item_name" field.
+func ItemNameNotNil() predicate.PlaybackEvent {
+	return predicate.PlaybackEvent(sql.FieldNotNull(FieldItemName))
+}
+
+// ItemNameEqualFold applies the EqualFold predicate on the "item_name" field.
+func ItemNameEqualFold(v string) predicate.PlaybackEvent {
+	return predicate.PlaybackEvent(sql.FieldEqualFold(FieldItemName, v))
+}
+
+// ItemNameContainsFold applies the ContainsFold predicate on the "item_name" field.
+func ItemNameContainsFold(v string) predicate.PlaybackEvent {
+	return predicate.PlaybackEvent(sql.FieldContainsFold(FieldItemName, v))
+}
+
+// ItemTypeEQ applies the EQ predicate on the "item_type" field.
+func ItemTypeEQ(v string) predicate.PlaybackEvent {
+	return predicate.PlaybackEvent(sql.FieldEQ(FieldItemType, v))
+}
+
+// ItemTypeNEQ applies the NEQ predicate on the "item_type" field.
+func ItemTypeNEQ(v string) predicate.PlaybackEvent {
+	return predicate.PlaybackEvent(sql.FieldNEQ(FieldItemType, v))
+}
+
+// ItemTypeIn applies the In predicate on the "item_type" field.
+func ItemTypeIn(vs ...string) predicate.PlaybackEvent {
+	return predicate.PlaybackEvent(sql.FieldIn(FieldItemType, vs...))
+}
+
+// ItemTypeNotIn applies the NotIn predicate on the "item_type" field.
+func ItemTypeNotIn(vs ...string) predicate.PlaybackEvent {
+	return predicate.PlaybackEvent(sql.FieldNotIn(FieldItemType, vs...))
+}
+
+// ItemTypeGT applies the GT predicate on the "item_type" field.
+func ItemTypeGT(v string) predicate.PlaybackEvent {
+	return predicate.PlaybackEvent(sql.FieldGT(FieldItemType, v))
+}
+
+// ItemTypeGTE applies the GTE predicate on the "item_type" field.
+func ItemTypeGTE(v string) predicate.PlaybackEvent {
+	return predicate.PlaybackEvent(sql.FieldGTE(FieldItemType, v))
+}
+
+// ItemTypeLT applies the LT predicate on the "item_type" field.
+func ItemTypeLT(v string) predicate.PlaybackEvent {
+	return predicate.PlaybackEvent(sql.FieldLT(FieldItemType, v))
+}
+
+// ItemTypeLTE applies the LTE predicate on the "item_type" field.
+func ItemTypeLTE(v string) predicate.PlaybackEvent {
+	return predicate.PlaybackEvent(sql.FieldLTE(FieldItemType, v))
+}
+
+// ItemTypeContains applies the Contains predicate on the "item_type" field.
+func ItemTypeContains(v string) predicate.PlaybackEvent {
+	return predicate.PlaybackEvent(sql.FieldContains(FieldItemType, v))
+}
+
+// ItemTypeHasPrefix applies the HasPrefix predicate on the "item_type" field.
+func ItemTypeHasPrefix(v string) predicate.PlaybackEvent {
+	return predicate.PlaybackEvent(sql.FieldHasPrefix(FieldItemType, v))
+}
+
+// ItemTypeHasSuffix applies the HasSuffix predicate on the "item_type" field.
+func ItemTypeHasSuffix(v string) predicate.PlaybackEvent {
+	return predicate.PlaybackEvent(sql.FieldHasSuffix(FieldItemType, v))
+}
+
+// ItemTypeIsNil applies the IsNil predicate on the "item_type" field.
+func ItemTypeIsNil() predicate.PlaybackEvent {
+	return predicate.PlaybackEvent(sql.FieldIsNull(FieldItemType))
+}
+
+// ItemTypeNotNil applies the NotNil predicate on the "item_type" field.
+func ItemTypeNotNil() predicate.PlaybackEvent {
+	return predicate.PlaybackEvent(sql.FieldNotNull(FieldItemType))
+}
+
+// ItemTypeEqualFold applies the EqualFold predicate on the "item_type" field.
+func ItemTypeEqualFold(v string) predicate.PlaybackEvent {
+	return predicate.PlaybackEvent(sql.FieldEqualFold(FieldItemType, v))
+}
+
+// ItemTypeContainsFold applies the ContainsFold predicate on the "item_type" field.
+func ItemTypeContainsFold(v string) predicate.PlaybackEvent {
+	return predicate.PlaybackEvent(sql.FieldContainsFold(FieldItemType, v))
+}
+
+// BackendIDEQ applies the EQ predicate on the "backend_id" field.
+func BackendIDEQ(v uuid.UUID) predicate.PlaybackEvent {
+	return predicate.PlaybackEvent(sql.FieldEQ(FieldBackendID, v))
+}
+
+// BackendIDNEQ applies the NEQ predicate on the "backend_id" field.
+func BackendIDNEQ(v uuid.UUID) predicate.PlaybackEvent {
+	return predicate.PlaybackEvent(sql.FieldNEQ(FieldBackendID, v))
+}
+
+// BackendIDIn applies the In predicate on the "backend_id" field.
+func BackendIDIn(vs ...uuid.UUID) predicate.PlaybackEvent {
+	return predicate.PlaybackEvent(sql.FieldIn(FieldBackendID, vs...))
+}
+
+// BackendIDNotIn applies the NotIn predicate on the "backend_id" field.
+func BackendIDNotIn(vs ...uuid.UUID) predicate.PlaybackEvent {
+	return predicate.PlaybackEvent(sql.FieldNotIn(FieldBackendID, vs...))
+}
+
+// BackendIDGT applies the GT predicate on the "backend_id" field.
+func BackendIDGT(v uuid.UUID) predicate.PlaybackEvent {
+	return predicate.PlaybackEvent(sql.FieldGT(FieldBackendID, v))
+}
+
+// BackendIDGTE applies the GTE predicate on the "backend_id" field.
+func BackendIDGTE(v uuid.UUID) predicate.PlaybackEvent {
+	return predicate.PlaybackEvent(sql.FieldGTE(FieldBackendID, v))
+}
+
+// BackendIDLT applies the LT predicate on the "backend_id" field.
+func BackendIDLT(v uuid.UUID) predicate.PlaybackEvent {
+	return predicate.PlaybackEvent(sql.FieldLT(FieldBackendID, v))
+}
+
+// BackendIDLTE applies the LTE predicate on the "backend_id" field.
+func BackendIDLTE(v uuid.UUID) predicate.PlaybackEvent {
+	return predicate.PlaybackEvent(sql.FieldLTE(FieldBackendID, v))
+}
+
+// BackendNameEQ applies the EQ predicate on the "backend_name" field.
+func BackendNameEQ(v string) predicate.PlaybackEvent {
+	return predicate.PlaybackEvent(sql.FieldEQ(FieldBackendName, v))
+}
+
+// BackendNameNEQ applies the NEQ predicate on the "backend_name" field.
+func BackendNameNEQ(v string) predicate.PlaybackEvent {
+	return predicate.PlaybackEvent(sql.FieldNEQ(FieldBackendName, v))
+}
+
+// BackendNameIn applies the In predicate on the "backend_name" field.
+func BackendNameIn(vs ...string) predicate.PlaybackEvent {
+	return predicate.PlaybackEvent(sql.FieldIn(FieldBackendName, vs...))
+}
+
+// BackendNameNotIn applies the NotIn predicate on the "backend_name" field.
+func BackendNameNotIn(vs ...string) predicate.PlaybackEvent {
+	return predicate.PlaybackEvent(sql.FieldNotIn(FieldBackendName, vs...))
+}
+
+// BackendNameGT applies the GT predicate on the "backend_name" field.
+func BackendNameGT(v string) predicate.PlaybackEvent {
+	return predicate.PlaybackEvent(sql.FieldGT(FieldBackendName, v))
+}
+
+// BackendNameGTE applies the GTE predicate on the "backend_name" field.
+func BackendNameGTE(v string) predicate.PlaybackEvent {
+	return predicate.PlaybackEvent(sql.FieldGTE(FieldBackendName, v))
+}
+
+// BackendNameLT applies the LT predicate on the "backend_name" field.
+func BackendNameLT(v string) predicate.PlaybackEvent {
+	return predicate.PlaybackEvent(sql.FieldLT(FieldBackendName, v))
+}
+
+// BackendNameLTE applies the LTE predicate on the "backend_name" field.
+func BackendNameLTE(v string) predicate.PlaybackEvent {
+	return predicate.PlaybackEvent(sql.FieldLTE(FieldBackendName, v))
+}
+
+// BackendNameContains applies the Contains predicate on the "backend_name" field.
+func BackendNameContains(v string) predicate.PlaybackEvent {
+	return predicate.PlaybackEvent(sql.FieldContains(FieldBackendName, v))
+}
+
+// BackendNameHasPrefix applies the HasPrefix predicate on the "backend_name" field.
+func BackendNameHasPrefix(v string) predicate.PlaybackEvent {
+	return predicate.PlaybackEvent(sql.FieldHasPrefix(FieldBackendName, v))
+}
+
+// BackendNameHasSuffix applies the HasSuffix predicate on the "backend_name" field.
+func BackendNameHasSuffix(v string) predicate.PlaybackEvent {
+	return predicate.PlaybackEvent(sql.FieldHasSuffix(FieldBackendName, v))
+}
+
+// BackendNameEqualFold applies the EqualFold predicate on the "backend_name" field.
+func BackendNameEqualFold(v string) predicate.PlaybackEvent {
+	return predicate.PlaybackEvent(sql.FieldEqualFold(FieldBackendName, v))
+}
+
+// BackendNameContainsFold applies the ContainsFold predicate on the "backend_name" field.
+func BackendNameContainsFold(v string) predicate.PlaybackEvent {
+	return predicate.PlaybackEvent(sql.FieldContainsFold(FieldBackendName, v))
+}
+
+// PlayMethodEQ applies the EQ predicate on the "play_method" field.
+func PlayMethodEQ(v string) predicate.PlaybackEvent {
+	return predicate.PlaybackEvent(sql.FieldEQ(FieldPlayMethod, v))
+}
+
+// PlayMethodNEQ applies the NEQ predicate on the "play_method" field.
+func PlayMethodNEQ(v string) predicate.PlaybackEvent {
+	return predicate.PlaybackEvent(sql.FieldNEQ(FieldPlayMethod, v))
+}
+
+// PlayMethodIn applies the In predicate on the "play_method" field.
+func PlayMethodIn(vs ...string) predicate.PlaybackEvent {
+	return predicate.PlaybackEvent(sql.FieldIn(FieldPlayMethod, vs...))
+}
+
+// PlayMethodNotIn applies the NotIn predicate on the "play_method" field.
+func PlayMethodNotIn(vs ...string) predicate.PlaybackEvent {
+	return predicate.PlaybackEvent(sql.FieldNotIn(FieldPlayMethod, vs...))
+}
+
+// PlayMethodGT applies the GT predicate on the "play_method" field.
+func PlayMethodGT(v string) predicate.PlaybackEvent {
+	return predicate.PlaybackEvent(sql.FieldGT(FieldPlayMethod, v))
+}
+
+// PlayMethodGTE applies the GTE predicate on the "play_method" field.
+func PlayMethodGTE(v string) predicate.PlaybackEvent {
+	return predicate.PlaybackEvent(sql.FieldGTE(FieldPlayMethod, v))
+}
+
+// PlayMethodLT applies the LT predicate on the "play_method" field.
+func PlayMethodLT(v string) predicate.PlaybackEvent {
+	return predicate.PlaybackEvent(sql.FieldLT(FieldPlayMethod, v))
+}
+
+// PlayMethodLTE applies the LTE predicate on the "play_method" field.
+func PlayMethodLTE(v string) predicate.PlaybackEvent {
+	return predicate.PlaybackEvent(sql.FieldLTE(FieldPlayMethod, v))
+}
+
+// PlayMethodContains applies the Contains predicate on the "play_method" field.
+func PlayMethodContains(v string) predicate.PlaybackEvent {
+	return predicate.PlaybackEvent(sql.FieldContains(FieldPlayMethod, v))
+}
+
+// PlayMethodHasPrefix applies the HasPrefix predicate on the "play_method" field.
+func PlayMethodHasPrefix(v string) predicate.PlaybackEvent {
+	return predicate.PlaybackEvent(sql.FieldHasPrefix(FieldPlayMethod, v))
+}
+
+// PlayMethodHasSuffix applies the HasSuffix predicate on the "play_method" field.
+func PlayMethodHasSuffix(v string) predicate.PlaybackEvent {
+	return predicate.PlaybackEvent(sql.FieldHasSuffix(FieldPlayMethod, v))
+}
+
+// PlayMethodIsNil applies the IsNil predicate on the "play_method" field.
+func PlayMethodIsNil() predicate.PlaybackEvent {
+	return predicate.PlaybackEvent(sql.FieldIsNull(FieldPlayMethod))
+}
+
+// PlayMethodNotNil applies the NotNil predicate on the "play_method" field.
+func PlayMethodNotNil() predicate.PlaybackEvent {
+	return predicate.PlaybackEvent(sql.FieldNotNull(FieldPlayMethod))
+}
+
+// PlayMethodEqualFold applies the EqualFold predicate on the "play_method" field.
+func PlayMethodEqualFold(v string) predicate.PlaybackEvent {
+	return predicate.PlaybackEvent(sql.FieldEqualFold(FieldPlayMethod, v))
+}
+
+// PlayMethodContainsFold applies the ContainsFold predicate on the "play_method" field.
+func PlayMethodContainsFold(v string) predicate.PlaybackEvent {
+	return predicate.PlaybackEvent(sql.FieldContainsFold(FieldPlayMethod, v))
+}
+
+// StartedAtEQ applies the EQ predicate on the "started_at" field.
+func StartedAtEQ(v time.Time) predicate.PlaybackEvent {
+	return predicate.PlaybackEvent(sql.FieldEQ(FieldStartedAt, v))
+}
+
+// StartedAtNEQ applies the NEQ predicate on the "started_at" field.
+func StartedAtNEQ(v time.Time) predicate.PlaybackEvent {
+	return predicate.PlaybackEvent(sql.FieldNEQ(FieldStartedAt, v))
+}
+
+// StartedAtIn applies the In predicate on the "started_at" field.
+func StartedAtIn(vs ...time.Time) predicate.PlaybackEvent {
+	return predicate.PlaybackEvent(sql.FieldIn(FieldStartedAt, vs...))
+}
+
+// StartedAtNotIn applies the NotIn predicate on the "started_at" field.
+func StartedAtNotIn(vs ...time.Time) predicate.PlaybackEvent {
+	return predicate.PlaybackEvent(sql.FieldNotIn(FieldStartedAt, vs...))
+}
+
+// StartedAtGT applies the GT predicate on the "started_at" field.
+func StartedAtGT(v time.Time) predicate.PlaybackEvent {
+	return predicate.PlaybackEvent(sql.FieldGT(FieldStartedAt, v))
+}
+
+// StartedAtGTE applies the GTE predicate on the "started_at" field.
+func StartedAtGTE(v time.Time) predicate.PlaybackEvent {
+	return predicate.PlaybackEvent(sql.FieldGTE(FieldStartedAt, v))
+}
+
+// StartedAtLT applies the LT predicate on the "started_at" field.
+func StartedAtLT(v time.Time) predicate.PlaybackEvent {
+	return predicate.PlaybackEvent(sql.FieldLT(FieldStartedAt, v))
+}
+
+// StartedAtLTE applies the LTE predicate on the "started_at" field.
+func StartedAtLTE(v time.Time) predicate.PlaybackEvent {
+	return predicate.PlaybackEvent(sql.FieldLTE(FieldStartedAt, v))
+}
+
+// StoppedAtEQ applies the EQ predicate on the "stopped_at" field.
+func StoppedAtEQ(v time.Time) predicate.PlaybackEvent {
+	return predicate.PlaybackEvent(sql.FieldEQ(FieldStoppedAt, v))
+}
+
+// StoppedAtNEQ applies the NEQ predicate on the "stopped_at" field.
+func StoppedAtNEQ(v time.Time) predicate.PlaybackEvent {
+	return predicate.PlaybackEvent(sql.FieldNEQ(FieldStoppedAt, v))
+}
+
+// StoppedAtIn applies the In predicate on the "stopped_at" field.
+func StoppedAtIn(vs ...time.Time) predicate.PlaybackEvent {
+	return predicate.PlaybackEvent(sql.FieldIn(FieldStoppedAt, vs...))
+}
+
+// StoppedAtNotIn applies the NotIn predicate on the "stopped_at" field.
+func StoppedAtNotIn(vs ...time.Time) predicate.PlaybackEvent {
+	return predicate.PlaybackEvent(sql.FieldNotIn(FieldStoppedAt, vs...))
+}
+
+// StoppedAtGT applies the GT predicate on the "stopped_at" field.
+func StoppedAtGT(v time.Time) predicate.PlaybackEvent {
+	return predicate.PlaybackEvent(sql.FieldGT(FieldStoppedAt, v))
+}
+
+// StoppedAtGTE applies the GTE predicate on the "stopped_at" field.
+func StoppedAtGTE(v time.Time) predicate.PlaybackEvent {
+	return predicate.PlaybackEvent(sql.FieldGTE(FieldStoppedAt, v))
+}
+
+// StoppedAtLT applies the LT predicate on the "stopped_at" field.
+func StoppedAtLT(v time.Time) predicate.PlaybackEvent {
+	return predicate.PlaybackEvent(sql.FieldLT(FieldStoppedAt, v))
+}
+
+// StoppedAtLTE applies the LTE predicate on the "stopped_at" field.
+func StoppedAtLTE(v time.Time) predicate.PlaybackEvent {
+	return predicate.PlaybackEvent(sql.FieldLTE(FieldStoppedAt, v))
+}
+
+// StoppedAtIsNil applies the IsNil predicate on the "stopped_at" field.
+func StoppedAtIsNil() predicate.PlaybackEvent {
+	return predicate.PlaybackEvent(sql.FieldIsNull(FieldStoppedAt))
+}
+
+// StoppedAtNotNil applies the NotNil predicate on the "stopped_at" field.
+func StoppedAtNotNil() predicate.PlaybackEvent {
+	return predicate.PlaybackEvent(sql.FieldNotNull(FieldStoppedAt))
+}
+
+// PositionTicksEQ applies the EQ predicate on the "position_ticks" field.
+func PositionTicksEQ(v int64) predicate.PlaybackEvent {
+	return predicate.PlaybackEvent(sql.FieldEQ(FieldPositionTicks, v))
+}
+
+// PositionTicksNEQ applies the NEQ predicate on the "position_ticks" field.
+func PositionTicksNEQ(v int64) predicate.PlaybackEvent {
+	return predicate.PlaybackEvent(sql.FieldNEQ(FieldPositionTicks, v))
+}
+
+// PositionTicksIn applies the In predicate on the "position_ticks" field.
+func PositionTicksIn(vs ...int64) predicate.PlaybackEvent {
+	return predicate.PlaybackEvent(sql.FieldIn(FieldPositionTicks, vs...))
+}
+
+// PositionTicksNotIn applies the NotIn predicate on the "position_ticks" field.
+func PositionTicksNotIn(vs ...int64) predicate.PlaybackEvent {
+	return predicate.PlaybackEvent(sql.FieldNotIn(FieldPositionTicks, vs...))
+}
+
+// PositionTicksGT applies the GT predicate on the "position_ticks" field.
+func PositionTicksGT(v int64) predicate.PlaybackEvent {
+	return predicate.PlaybackEvent(sql.FieldGT(FieldPositionTicks, v))
+}
+
+// PositionTicksGTE applies the GTE predicate on the "position_ticks" field.
+func PositionTicksGTE(v int64) predicate.PlaybackEvent {
+	return predicate.PlaybackEvent(sql.FieldGTE(FieldPositionTicks, v))
+}
+
+// PositionTicksLT applies the LT predicate on the "position_ticks" field.
+func PositionTicksLT(v int64) predicate.PlaybackEvent {
+	return predicate.PlaybackEvent(sql.FieldLT(FieldPositionTicks, v))
+}
+
+// PositionTicksLTE applies the LTE predicate on the "position_ticks" field.
+func PositionTicksLTE(v int64) predicate.PlaybackEvent {
+	return predicate.PlaybackEvent(sql.FieldLTE(FieldPositionTicks, v))
+}
+
+// PlayDurationEQ applies the EQ predicate on the "play_duration" field.
+func PlayDurationEQ(v int64) predicate.PlaybackEvent {
+	return predicate.PlaybackEvent(sql.FieldEQ(FieldPlayDuration, v))
+}
+
+// PlayDurationNEQ applies the NEQ predicate on the "play_duration" field.
+func PlayDurationNEQ(v int64) predicate.PlaybackEvent {
+	return predicate.PlaybackEvent(sql.FieldNEQ(FieldPlayDuration, v))
+}
+
+// PlayDurationIn applies the In predicate on the "play_duration" field.
+func PlayDurationIn(vs ...int64) predicate.PlaybackEvent {
+	return predicate.PlaybackEvent(sql.FieldIn(FieldPlayDuration, vs...))
+}
+
+// PlayDurationNotIn applies the NotIn predicate on the "play_duration" field.
+func PlayDurationNotIn(vs ...int64) predicate.PlaybackEvent {
+	return predicate.PlaybackEvent(sql.FieldNotIn(FieldPlayDuration, vs...))
+}
+
+// PlayDurationGT applies the GT predicate on the "play_duration" field.
+func PlayDurationGT(v int64) predicate.PlaybackEvent {
+	return predicate.PlaybackEvent(sql.FieldGT(FieldPlayDuration, v))
+}
+
+// PlayDurationGTE applies the GTE predicate on the "play_duration" field.
+func PlayDurationGTE(v int64) predicate.PlaybackEvent {
+	return predicate.PlaybackEvent(sql.FieldGTE(FieldPlayDuration, v))
+}
+
+// PlayDurationLT applies the LT predicate on the "play_duration" field.
+func PlayDurationLT(v int64) predicate.PlaybackEvent {
+	return predicate.PlaybackEvent(sql.FieldLT(FieldPlayDuration, v))
+}
+
+// PlayDurationLTE applies the LTE predicate on the "play_duration" field.
+func PlayDurationLTE(v int64) predicate.PlaybackEvent {
+	return predicate.PlaybackEvent(sql.FieldLTE(FieldPlayDuration, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.PlaybackEvent) predicate.PlaybackEvent {
+	return predicate.PlaybackEvent(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.PlaybackEvent) predicate.PlaybackEvent {
+	return predicate.PlaybackEvent(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.PlaybackEvent) predicate.PlaybackEvent {
+	return predicate.PlaybackEvent(sql.NotPredicates(p))
+}
@@ -0,0 +1,470 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/ddevcap/jellyfin-proxy/ent/playbackevent"
+	"github.com/google/uuid"
+)
+
+// PlaybackEventCreate is the builder for creating a PlaybackEvent entity.
+type PlaybackEventCreate struct {
+	config
+	mutation *PlaybackEventMutation
+	hooks    []Hook
+}
+
+// SetUserID sets the "user_id" field.
+func (_c *PlaybackEventCreate) SetUserID(v uuid.UUID) *PlaybackEventCreate {
+	_c.mutation.SetUserID(v)
+	return _c
+}
+
+// SetUsername sets the "username" field.
+func (_c *PlaybackEventCreate) SetUsername(v string) *PlaybackEventCreate {
+	_c.mutation.SetUsername(v)
+	return _c
+}
+
+// SetSessionID sets the "session_id" field.
+func (_c *PlaybackEventCreate) SetSessionID(v uuid.UUID) *PlaybackEventCreate {
+	_c.mutation.SetSessionID(v)
+	return _c
+}
+
+// SetDeviceID sets the "device_id" field.
+func (_c *PlaybackEventCreate) SetDeviceID(v string) *PlaybackEventCreate {
+	_c.mutation.SetDeviceID(v)
+	return _c
+}
+
+// SetDeviceName sets the "device_name" field.
+func (_c *PlaybackEventCreate) SetDeviceName(v string) *PlaybackEventCreate {
+	_c.mutation.SetDeviceName(v)
+	return _c
+}
+
+// SetAppName sets the "app_name" field.
+func (_c *PlaybackEventCreate) SetAppName(v string) *PlaybackEventCreate {
+	_c.mutation.SetAppName(v)
+	return _c
+}
+
+// SetItemID sets the "item_id" field.
+func (_c *PlaybackEventCreate) SetItemID(v string) *PlaybackEventCreate {
+	_c.mutation.SetItemID(v)
+	return _c
+}
+
+// SetItemName sets the "item_name" field.
+func (_c *PlaybackEventCreate) SetItemName(v string) *PlaybackEventCreate {
+	_c.mutation.SetItemName(v)
+	return _c
+}
+
+// SetNillableItemName sets the "item_name" field if the given value is not nil.
+func (_c *PlaybackEventCreate) SetNillableItemName(v *string) *PlaybackEventCreate {
+	if v != nil {
+		_c.SetItemName(*v)
+	}
+	return _c
+}
+
+// SetItemType sets the "item_type" field.
+func (_c *PlaybackEventCreate) SetItemType(v string) *PlaybackEventCreate {
+	_c.mutation.SetItemType(v)
+	return _c
+}
+
+// SetNillableItemType sets the "item_type" field if the given value is not nil.
+func (_c *PlaybackEventCreate) SetNillableItemType(v *string) *PlaybackEventCreate {
+	if v != nil {
+		_c.SetItemType(*v)
+	}
+	return _c
+}
+
+// SetBackendID sets the "backend_id" field.
+func (_c *PlaybackEventCreate) SetBackendID(v uuid.UUID) *PlaybackEventCreate {
+	_c.mutation.SetBackendID(v)
+	return _c
+}
+
+// SetBackendName sets the "backend_name" field.
+func (_c *PlaybackEventCreate) SetBackendName(v string) *PlaybackEventCreate {
+	_c.mutation.SetBackendName(v)
+	return _c
+}
+
+// SetPlayMethod sets the "play_method" field.
+func (_c *PlaybackEventCreate) SetPlayMethod(v string) *PlaybackEventCreate {
+	_c.mutation.SetPlayMethod(v)
+	return _c
+}
+
+// SetNillablePlayMethod sets the "play_method" field if the given value is not nil.
+func (_c *PlaybackEventCreate) SetNillablePlayMethod(v *string) *PlaybackEventCreate {
+	if v != nil {
+		_c.SetPlayMethod(*v)
+	}
+	return _c
+}
+
+// SetStartedAt sets the "started_at" field.
+func (_c *PlaybackEventCreate) SetStartedAt(v time.Time) *PlaybackEventCreate {
+	_c.mutation.SetStartedAt(v)
+	return _c
+}
+
+// SetNillableStartedAt sets the "started_at" field if the given value is not nil.
+func (_c *PlaybackEventCreate) SetNillableStartedAt(v *time.Time) *PlaybackEventCreate {
+	if v != nil {
+		_c.SetStartedAt(*v)
+	}
+	return _c
+}
+
+// SetStoppedAt sets the "stopped_at" field.
+func (_c *PlaybackEventCreate) SetStoppedAt(v time.Time) *PlaybackEventCreate {
+	_c.mutation.SetStoppedAt(v)
+	return _c
+}
+
+// SetNillableStoppedAt sets the "stopped_at" field if the given value is not nil.
+func (_c *PlaybackEventCreate) SetNillableStoppedAt(v *time.Time) *PlaybackEventCreate {
+	if v != nil {
+		_c.SetStoppedAt(*v)
+	}
+	return _c
+}
+
+// SetPositionTicks sets the "position_ticks" field.
+func (_c *PlaybackEventCreate) SetPositionTicks(v int64) *PlaybackEventCreate {
+	_c.mutation.SetPositionTicks(v)
+	return _c
+}
+
+// SetNillablePositionTicks sets the "position_ticks" field if the given value is not nil.
+func (_c *PlaybackEventCreate) SetNillablePositionTicks(v *int64) *PlaybackEventCreate {
+	if v != nil {
+		_c.SetPositionTicks(*v)
+	}
+	return _c
+}
+
+// SetPlayDuration sets the "play_duration" field.
+func (_c *PlaybackEventCreate) SetPlayDuration(v int64) *PlaybackEventCreate {
+	_c.mutation.SetPlayDuration(v)
+	return _c
+}
+
+// SetNillablePlayDuration sets the "play_duration" field if the given value is not nil.
+func (_c *PlaybackEventCreate) SetNillablePlayDuration(v *int64) *PlaybackEventCreate {
+	if v != nil {
+		_c.SetPlayDuration(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *PlaybackEventCreate) SetID(v uuid.UUID) *PlaybackEventCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *PlaybackEventCreate) SetNillableID(v *uuid.UUID) *PlaybackEventCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// Mutation returns the PlaybackEventMutation object of the builder.
+func (_c *PlaybackEventCreate) Mutation() *PlaybackEventMutation {
+	return _c.mutation
+}
+
+// Save creates the PlaybackEvent in the database.
+func (_c *PlaybackEventCreate) Save(ctx context.Context) (*PlaybackEvent, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *PlaybackEventCreate) SaveX(ctx context.Context) *PlaybackEvent {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *PlaybackEventCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *PlaybackEventCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *PlaybackEventCreate) defaults() {
+	if _, ok := _c.mutation.StartedAt(); !ok {
+		v := playbackevent.DefaultStartedAt()
+		_c.mutation.SetStartedAt(v)
+	}
+	if _, ok := _c.mutation.PositionTicks(); !ok {
+		v := playbackevent.DefaultPositionTicks
+		_c.mutation.SetPositionTicks(v)
+	}
+	if _, ok := _c.mutation.PlayDuration(); !ok {
+		v := playbackevent.DefaultPlayDuration
+		_c.mutation.SetPlayDuration(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := playbackevent.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *PlaybackEventCreate) check() error {
+	if _, ok := _c.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "PlaybackEvent.user_id"`)}
+	}
+	if _, ok := _c.mutation.Username(); !ok {
+		return &ValidationError{Name: "username", err: errors.New(`ent: missing required field "PlaybackEvent.username"`)}
+	}
+	if _, ok := _c.mutation.SessionID(); !ok {
+		return &ValidationError{Name: "session_id", err: errors.New(`ent: missing required field "PlaybackEvent.session_id"`)}
+	}
+	if _, ok := _c.mutation.DeviceID(); !ok {
+		return &ValidationError{Name: "device_id", err: errors.New(`ent: missing required field "PlaybackEvent.device_id"`)}
+	}
+	if _, ok := _c.mutation.DeviceName(); !ok {
+		return &ValidationError{Name: "device_name", err: errors.New(`ent: missing required field "PlaybackEvent.device_name"`)}
+	}
+	if _, ok := _c.mutation.AppName(); !ok {
+		return &ValidationError{Name: "app_name", err: errors.New(`ent: missing required field "PlaybackEvent.app_name"`)}
+	}
+	if _, ok := _c.mutation.ItemID(); !ok {
+		return &ValidationError{Name: "item_id", err: errors.New(`ent: missing required field "PlaybackEvent.item_id"`)}
+	}
+	if v, ok := _c.mutation.ItemID(); ok {
+		if err := playbackevent.ItemIDValidator(v); err != nil {
+			return &ValidationError{Name: "item_id", err: fmt.Errorf(`ent: validator failed for field "PlaybackEvent.item_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.BackendID(); !ok {
+		return &ValidationError{Name: "backend_id", err: errors.New(`ent: missing required field "PlaybackEvent.backend_id"`)}
+	}
+	if _, ok := _c.mutation.BackendName(); !ok {
+		return &ValidationError{Name: "backend_name", err: errors.New(`ent: missing required field "PlaybackEvent.backend_name"`)}
+	}
+	if _, ok := _c.mutation.StartedAt(); !ok {
+		return &ValidationError{Name: "started_at", err: errors.New(`ent: missing required field "PlaybackEvent.started_at"`)}
+	}
+	if _, ok := _c.mutation.PositionTicks(); !ok {
+		return &ValidationError{Name: "position_ticks", err: errors.New(`ent: missing required field "PlaybackEvent.position_ticks"`)}
+	}
+	if _, ok := _c.mutation.PlayDuration(); !ok {
+		return &ValidationError{Name: "play_duration", err: errors.New(`ent: missing required field "PlaybackEvent.play_duration"`)}
+	}
+	return nil
+}
+
+func (_c *PlaybackEventCreate) sqlSave(ctx context.Context) (*PlaybackEvent, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *PlaybackEventCreate) createSpec() (*PlaybackEvent, *sqlgraph.CreateSpec) {
+	var (
+		_node = &PlaybackEvent{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(playbackevent.Table, sqlgraph.NewFieldSpec(playbackevent.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.UserID(); ok {
+		_spec.SetField(playbackevent.FieldUserID, field.TypeUUID, value)
+		_node.UserID = value
+	}
+	if value, ok := _c.mutation.Username(); ok {
+		_spec.SetField(playbackevent.FieldUsername, field.TypeString, value)
+		_node.Username = value
+	}
+	if value, ok := _c.mutation.SessionID(); ok {
+		_spec.SetField(playbackevent.FieldSessionID, field.TypeUUID, value)
+		_node.SessionID = value
+	}
+	if value, ok := _c.mutation.DeviceID(); ok {
+		_spec.SetField(playbackevent.FieldDeviceID, field.TypeString, value)
+		_node.DeviceID = value
+	}
+	if value, ok := _c.mutation.DeviceName(); ok {
+		_spec.SetField(playbackevent.FieldDeviceName, field.TypeString, value)
+		_node.DeviceName = value
+	}
+	if value, ok := _c.mutation.AppName(); ok {
+		_spec.SetField(playbackevent.FieldAppName, field.TypeString, value)
+		_node.AppName = value
+	}
+	if value, ok := _c.mutation.ItemID(); ok {
+		_spec.SetField(playbackevent.FieldItemID, field.TypeString, value)
+		_node.ItemID = value
+	}
+	if value, ok := _c.mutation.ItemName(); ok {
+		_spec.SetField(playbackevent.FieldItemName, field.TypeString, value)
+		_node.ItemName = value
+	}
+	if value, ok := _c.mutation.ItemType(); ok {
+		_spec.SetField(playbackevent.FieldItemType, field.TypeString, value)
+		_node.ItemType = value
+	}
+	if value, ok := _c.mutation.BackendID(); ok {
+		_spec.SetField(playbackevent.FieldBackendID, field.TypeUUID, value)
+		_node.BackendID = value
+	}
+	if value, ok := _c.mutation.BackendName(); ok {
+		_spec.SetField(playbackevent.FieldBackendName, field.TypeString, value)
+		_node.BackendName = value
+	}
+	if value, ok := _c.mutation.PlayMethod(); ok {
+		_spec.SetField(playbackevent.FieldPlayMethod, field.TypeString, value)
+		_node.PlayMethod = value
+	}
+	if value, ok := _c.mutation.StartedAt(); ok {
+		_spec.SetField(playbackevent.FieldStartedAt, field.TypeTime, value)
+		_node.StartedAt = value
+	}
+	if value, ok := _c.mutation.StoppedAt(); ok {
+		_spec.SetField(playbackevent.FieldStoppedAt, field.TypeTime, value)
+		_node.StoppedAt = &value
+	}
+	if value, ok := _c.mutation.PositionTicks(); ok {
+		_spec.SetField(playbackevent.FieldPositionTicks, field.TypeInt64, value)
+		_node.PositionTicks = value
+	}
+	if value, ok := _c.mutation.PlayDuration(); ok {
+		_spec.SetField(playbackevent.FieldPlayDuration, field.TypeInt64, value)
+		_node.PlayDuration = value
+	}
+	return _node, _spec
+}
+
+// PlaybackEventCreateBulk is the builder for creating many PlaybackEvent entities in bulk.
+type PlaybackEventCreateBulk struct {
+	config
+	err      error
+	builders []*PlaybackEventCreate
+}
+
+// Save creates the PlaybackEvent entities in the database.
+func (_c *PlaybackEventCreateBulk) Save(ctx context.Context) ([]*PlaybackEvent, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*PlaybackEvent, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*PlaybackEventMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *PlaybackEventCreateBulk) SaveX(ctx context.Context) []*PlaybackEvent {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *PlaybackEventCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *PlaybackEventCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
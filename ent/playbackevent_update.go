@@ -0,0 +1,825 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/ddevcap/jellyfin-proxy/ent/playbackevent"
+	"github.com/ddevcap/jellyfin-proxy/ent/predicate"
+	"github.com/google/uuid"
+)
+
+// PlaybackEventUpdate is the builder for updating PlaybackEvent entities.
+type PlaybackEventUpdate struct {
+	config
+	hooks    []Hook
+	mutation *PlaybackEventMutation
+}
+
+// Where appends a list predicates to the PlaybackEventUpdate builder.
+func (_u *PlaybackEventUpdate) Where(ps ...predicate.PlaybackEvent) *PlaybackEventUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *PlaybackEventUpdate) SetUserID(v uuid.UUID) *PlaybackEventUpdate {
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *PlaybackEventUpdate) SetNillableUserID(v *uuid.UUID) *PlaybackEventUpdate {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// SetUsername sets the "username" field.
+func (_u *PlaybackEventUpdate) SetUsername(v string) *PlaybackEventUpdate {
+	_u.mutation.SetUsername(v)
+	return _u
+}
+
+// SetNillableUsername sets the "username" field if the given value is not nil.
+func (_u *PlaybackEventUpdate) SetNillableUsername(v *string) *PlaybackEventUpdate {
+	if v != nil {
+		_u.SetUsername(*v)
+	}
+	return _u
+}
+
+// SetSessionID sets the "session_id" field.
+func (_u *PlaybackEventUpdate) SetSessionID(v uuid.UUID) *PlaybackEventUpdate {
+	_u.mutation.SetSessionID(v)
+	return _u
+}
+
+// SetNillableSessionID sets the "session_id" field if the given value is not nil.
+func (_u *PlaybackEventUpdate) SetNillableSessionID(v *uuid.UUID) *PlaybackEventUpdate {
+	if v != nil {
+		_u.SetSessionID(*v)
+	}
+	return _u
+}
+
+// SetDeviceID sets the "device_id" field.
+func (_u *PlaybackEventUpdate) SetDeviceID(v string) *PlaybackEventUpdate {
+	_u.mutation.SetDeviceID(v)
+	return _u
+}
+
+// SetNillableDeviceID sets the "device_id" field if the given value is not nil.
+func (_u *PlaybackEventUpdate) SetNillableDeviceID(v *string) *PlaybackEventUpdate {
+	if v != nil {
+		_u.SetDeviceID(*v)
+	}
+	return _u
+}
+
+// SetDeviceName sets the "device_name" field.
+func (_u *PlaybackEventUpdate) SetDeviceName(v string) *PlaybackEventUpdate {
+	_u.mutation.SetDeviceName(v)
+	return _u
+}
+
+// SetNillableDeviceName sets the "device_name" field if the given value is not nil.
+func (_u *PlaybackEventUpdate) SetNillableDeviceName(v *string) *PlaybackEventUpdate {
+	if v != nil {
+		_u.SetDeviceName(*v)
+	}
+	return _u
+}
+
+// SetAppName sets the "app_name" field.
+func (_u *PlaybackEventUpdate) SetAppName(v string) *PlaybackEventUpdate {
+	_u.mutation.SetAppName(v)
+	return _u
+}
+
+// SetNillableAppName sets the "app_name" field if the given value is not nil.
+func (_u *PlaybackEventUpdate) SetNillableAppName(v *string) *PlaybackEventUpdate {
+	if v != nil {
+		_u.SetAppName(*v)
+	}
+	return _u
+}
+
+// SetItemID sets the "item_id" field.
+func (_u *PlaybackEventUpdate) SetItemID(v string) *PlaybackEventUpdate {
+	_u.mutation.SetItemID(v)
+	return _u
+}
+
+// SetNillableItemID sets the "item_id" field if the given value is not nil.
+func (_u *PlaybackEventUpdate) SetNillableItemID(v *string) *PlaybackEventUpdate {
+	if v != nil {
+		_u.SetItemID(*v)
+	}
+	return _u
+}
+
+// SetItemName sets the "item_name" field.
+func (_u *PlaybackEventUpdate) SetItemName(v string) *PlaybackEventUpdate {
+	_u.mutation.SetItemName(v)
+	return _u
+}
+
+// SetNillableItemName sets the "item_name" field if the given value is not nil.
+func (_u *PlaybackEventUpdate) SetNillableItemName(v *string) *PlaybackEventUpdate {
+	if v != nil {
+		_u.SetItemName(*v)
+	}
+	return _u
+}
+
+// ClearItemName clears the value of the "item_name" field.
+func (_u *PlaybackEventUpdate) ClearItemName() *PlaybackEventUpdate {
+	_u.mutation.ClearItemName()
+	return _u
+}
+
+// SetItemType sets the "item_type" field.
+func (_u *PlaybackEventUpdate) SetItemType(v string) *PlaybackEventUpdate {
+	_u.mutation.SetItemType(v)
+	return _u
+}
+
+// SetNillableItemType sets the "item_type" field if the given value is not nil.
+func (_u *PlaybackEventUpdate) SetNillableItemType(v *string) *PlaybackEventUpdate {
+	if v != nil {
+		_u.SetItemType(*v)
+	}
+	return _u
+}
+
+// ClearItemType clears the value of the "item_type" field.
+func (_u *PlaybackEventUpdate) ClearItemType() *PlaybackEventUpdate {
+	_u.mutation.ClearItemType()
+	return _u
+}
+
+// SetBackendID sets the "backend_id" field.
+func (_u *PlaybackEventUpdate) SetBackendID(v uuid.UUID) *PlaybackEventUpdate {
+	_u.mutation.SetBackendID(v)
+	return _u
+}
+
+// SetNillableBackendID sets the "backend_id" field if the given value is not nil.
+func (_u *PlaybackEventUpdate) SetNillableBackendID(v *uuid.UUID) *PlaybackEventUpdate {
+	if v != nil {
+		_u.SetBackendID(*v)
+	}
+	return _u
+}
+
+// SetBackendName sets the "backend_name" field.
+func (_u *PlaybackEventUpdate) SetBackendName(v string) *PlaybackEventUpdate {
+	_u.mutation.SetBackendName(v)
+	return _u
+}
+
+// SetNillableBackendName sets the "backend_name" field if the given value is not nil.
+func (_u *PlaybackEventUpdate) SetNillableBackendName(v *string) *PlaybackEventUpdate {
+	if v != nil {
+		_u.SetBackendName(*v)
+	}
+	return _u
+}
+
+// SetPlayMethod sets the "play_method" field.
+func (_u *PlaybackEventUpdate) SetPlayMethod(v string) *PlaybackEventUpdate {
+	_u.mutation.SetPlayMethod(v)
+	return _u
+}
+
+// SetNillablePlayMethod sets the "play_method" field if the given value is not nil.
+func (_u *PlaybackEventUpdate) SetNillablePlayMethod(v *string) *PlaybackEventUpdate {
+	if v != nil {
+		_u.SetPlayMethod(*v)
+	}
+	return _u
+}
+
+// ClearPlayMethod clears the value of the "play_method" field.
+func (_u *PlaybackEventUpdate) ClearPlayMethod() *PlaybackEventUpdate {
+	_u.mutation.ClearPlayMethod()
+	return _u
+}
+
+// SetStoppedAt sets the "stopped_at" field.
+func (_u *PlaybackEventUpdate) SetStoppedAt(v time.Time) *PlaybackEventUpdate {
+	_u.mutation.SetStoppedAt(v)
+	return _u
+}
+
+// SetNillableStoppedAt sets the "stopped_at" field if the given value is not nil.
+func (_u *PlaybackEventUpdate) SetNillableStoppedAt(v *time.Time) *PlaybackEventUpdate {
+	if v != nil {
+		_u.SetStoppedAt(*v)
+	}
+	return _u
+}
+
+// ClearStoppedAt clears the value of the "stopped_at" field.
+func (_u *PlaybackEventUpdate) ClearStoppedAt() *PlaybackEventUpdate {
+	_u.mutation.ClearStoppedAt()
+	return _u
+}
+
+// SetPositionTicks sets the "position_ticks" field.
+func (_u *PlaybackEventUpdate) SetPositionTicks(v int64) *PlaybackEventUpdate {
+	_u.mutation.ResetPositionTicks()
+	_u.mutation.SetPositionTicks(v)
+	return _u
+}
+
+// SetNillablePositionTicks sets the "position_ticks" field if the given value is not nil.
+func (_u *PlaybackEventUpdate) SetNillablePositionTicks(v *int64) *PlaybackEventUpdate {
+	if v != nil {
+		_u.SetPositionTicks(*v)
+	}
+	return _u
+}
+
+// AddPositionTicks adds value to the "position_ticks" field.
+func (_u *PlaybackEventUpdate) AddPositionTicks(v int64) *PlaybackEventUpdate {
+	_u.mutation.AddPositionTicks(v)
+	return _u
+}
+
+// SetPlayDuration sets the "play_duration" field.
+func (_u *PlaybackEventUpdate) SetPlayDuration(v int64) *PlaybackEventUpdate {
+	_u.mutation.ResetPlayDuration()
+	_u.mutation.SetPlayDuration(v)
+	return _u
+}
+
+// SetNillablePlayDuration sets the "play_duration" field if the given value is not nil.
+func (_u *PlaybackEventUpdate) SetNillablePlayDuration(v *int64) *PlaybackEventUpdate {
+	if v != nil {
+		_u.SetPlayDuration(*v)
+	}
+	return _u
+}
+
+// AddPlayDuration adds value to the "play_duration" field.
+func (_u *PlaybackEventUpdate) AddPlayDuration(v int64) *PlaybackEventUpdate {
+	_u.mutation.AddPlayDuration(v)
+	return _u
+}
+
+// Mutation returns the PlaybackEventMutation object of the builder.
+func (_u *PlaybackEventUpdate) Mutation() *PlaybackEventMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *PlaybackEventUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *PlaybackEventUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *PlaybackEventUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *PlaybackEventUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *PlaybackEventUpdate) check() error {
+	if v, ok := _u.mutation.ItemID(); ok {
+		if err := playbackevent.ItemIDValidator(v); err != nil {
+			return &ValidationError{Name: "item_id", err: fmt.Errorf(`ent: validator failed for field "PlaybackEvent.item_id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *PlaybackEventUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(playbackevent.Table, playbackevent.Columns, sqlgraph.NewFieldSpec(playbackevent.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UserID(); ok {
+		_spec.SetField(playbackevent.FieldUserID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.Username(); ok {
+		_spec.SetField(playbackevent.FieldUsername, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.SessionID(); ok {
+		_spec.SetField(playbackevent.FieldSessionID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.DeviceID(); ok {
+		_spec.SetField(playbackevent.FieldDeviceID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.DeviceName(); ok {
+		_spec.SetField(playbackevent.FieldDeviceName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.AppName(); ok {
+		_spec.SetField(playbackevent.FieldAppName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ItemID(); ok {
+		_spec.SetField(playbackevent.FieldItemID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ItemName(); ok {
+		_spec.SetField(playbackevent.FieldItemName, field.TypeString, value)
+	}
+	if _u.mutation.ItemNameCleared() {
+		_spec.ClearField(playbackevent.FieldItemName, field.TypeString)
+	}
+	if value, ok := _u.mutation.ItemType(); ok {
+		_spec.SetField(playbackevent.FieldItemType, field.TypeString, value)
+	}
+	if _u.mutation.ItemTypeCleared() {
+		_spec.ClearField(playbackevent.FieldItemType, field.TypeString)
+	}
+	if value, ok := _u.mutation.BackendID(); ok {
+		_spec.SetField(playbackevent.FieldBackendID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.BackendName(); ok {
+		_spec.SetField(playbackevent.FieldBackendName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.PlayMethod(); ok {
+		_spec.SetField(playbackevent.FieldPlayMethod, field.TypeString, value)
+	}
+	if _u.mutation.PlayMethodCleared() {
+		_spec.ClearField(playbackevent.FieldPlayMethod, field.TypeString)
+	}
+	if value, ok := _u.mutation.StoppedAt(); ok {
+		_spec.SetField(playbackevent.FieldStoppedAt, field.TypeTime, value)
+	}
+	if _u.mutation.StoppedAtCleared() {
+		_spec.ClearField(playbackevent.FieldStoppedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.PositionTicks(); ok {
+		_spec.SetField(playbackevent.FieldPositionTicks, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedPositionTicks(); ok {
+		_spec.AddField(playbackevent.FieldPositionTicks, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.PlayDuration(); ok {
+		_spec.SetField(playbackevent.FieldPlayDuration, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedPlayDuration(); ok {
+		_spec.AddField(playbackevent.FieldPlayDuration, field.TypeInt64, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{playbackevent.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// PlaybackEventUpdateOne is the builder for updating a single PlaybackEvent entity.
+type PlaybackEventUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *PlaybackEventMutation
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *PlaybackEventUpdateOne) SetUserID(v uuid.UUID) *PlaybackEventUpdateOne {
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *PlaybackEventUpdateOne) SetNillableUserID(v *uuid.UUID) *PlaybackEventUpdateOne {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// SetUsername sets the "username" field.
+func (_u *PlaybackEventUpdateOne) SetUsername(v string) *PlaybackEventUpdateOne {
+	_u.mutation.SetUsername(v)
+	return _u
+}
+
+// SetNillableUsername sets the "username" field if the given value is not nil.
+func (_u *PlaybackEventUpdateOne) SetNillableUsername(v *string) *PlaybackEventUpdateOne {
+	if v != nil {
+		_u.SetUsername(*v)
+	}
+	return _u
+}
+
+// SetSessionID sets the "session_id" field.
+func (_u *PlaybackEventUpdateOne) SetSessionID(v uuid.UUID) *PlaybackEventUpdateOne {
+	_u.mutation.SetSessionID(v)
+	return _u
+}
+
+// SetNillableSessionID sets the "session_id" field if the given value is not nil.
+func (_u *PlaybackEventUpdateOne) SetNillableSessionID(v *uuid.UUID) *PlaybackEventUpdateOne {
+	if v != nil {
+		_u.SetSessionID(*v)
+	}
+	return _u
+}
+
+// SetDeviceID sets the "device_id" field.
+func (_u *PlaybackEventUpdateOne) SetDeviceID(v string) *PlaybackEventUpdateOne {
+	_u.mutation.SetDeviceID(v)
+	return _u
+}
+
+// SetNillableDeviceID sets the "device_id" field if the given value is not nil.
+func (_u *PlaybackEventUpdateOne) SetNillableDeviceID(v *string) *PlaybackEventUpdateOne {
+	if v != nil {
+		_u.SetDeviceID(*v)
+	}
+	return _u
+}
+
+// SetDeviceName sets the "device_name" field.
+func (_u *PlaybackEventUpdateOne) SetDeviceName(v string) *PlaybackEventUpdateOne {
+	_u.mutation.SetDeviceName(v)
+	return _u
+}
+
+// SetNillableDeviceName sets the "device_name" field if the given value is not nil.
+func (_u *PlaybackEventUpdateOne) SetNillableDeviceName(v *string) *PlaybackEventUpdateOne {
+	if v != nil {
+		_u.SetDeviceName(*v)
+	}
+	return _u
+}
+
+// SetAppName sets the "app_name" field.
+func (_u *PlaybackEventUpdateOne) SetAppName(v string) *PlaybackEventUpdateOne {
+	_u.mutation.SetAppName(v)
+	return _u
+}
+
+// SetNillableAppName sets the "app_name" field if the given value is not nil.
+func (_u *PlaybackEventUpdateOne) SetNillableAppName(v *string) *PlaybackEventUpdateOne {
+	if v != nil {
+		_u.SetAppName(*v)
+	}
+	return _u
+}
+
+// SetItemID sets the "item_id" field.
+func (_u *PlaybackEventUpdateOne) SetItemID(v string) *PlaybackEventUpdateOne {
+	_u.mutation.SetItemID(v)
+	return _u
+}
+
+// SetNillableItemID sets the "item_id" field if the given value is not nil.
+func (_u *PlaybackEventUpdateOne) SetNillableItemID(v *string) *PlaybackEventUpdateOne {
+	if v != nil {
+		_u.SetItemID(*v)
+	}
+	return _u
+}
+
+// SetItemName sets the "item_name" field.
+func (_u *PlaybackEventUpdateOne) SetItemName(v string) *PlaybackEventUpdateOne {
+	_u.mutation.SetItemName(v)
+	return _u
+}
+
+// SetNillableItemName sets the "item_name" field if the given value is not nil.
+func (_u *PlaybackEventUpdateOne) SetNillableItemName(v *string) *PlaybackEventUpdateOne {
+	if v != nil {
+		_u.SetItemName(*v)
+	}
+	return _u
+}
+
+// ClearItemName clears the value of the "item_name" field.
+func (_u *PlaybackEventUpdateOne) ClearItemName() *PlaybackEventUpdateOne {
+	_u.mutation.ClearItemName()
+	return _u
+}
+
+// SetItemType sets the "item_type" field.
+func (_u *PlaybackEventUpdateOne) SetItemType(v string) *PlaybackEventUpdateOne {
+	_u.mutation.SetItemType(v)
+	return _u
+}
+
+// SetNillableItemType sets the "item_type" field if the given value is not nil.
+func (_u *PlaybackEventUpdateOne) SetNillableItemType(v *string) *PlaybackEventUpdateOne {
+	if v != nil {
+		_u.SetItemType(*v)
+	}
+	return _u
+}
+
+// ClearItemType clears the value of the "item_type" field.
+func (_u *PlaybackEventUpdateOne) ClearItemType() *PlaybackEventUpdateOne {
+	_u.mutation.ClearItemType()
+	return _u
+}
+
+// SetBackendID sets the "backend_id" field.
+func (_u *PlaybackEventUpdateOne) SetBackendID(v uuid.UUID) *PlaybackEventUpdateOne {
+	_u.mutation.SetBackendID(v)
+	return _u
+}
+
+// SetNillableBackendID sets the "backend_id" field if the given value is not nil.
+func (_u *PlaybackEventUpdateOne) SetNillableBackendID(v *uuid.UUID) *PlaybackEventUpdateOne {
+	if v != nil {
+		_u.SetBackendID(*v)
+	}
+	return _u
+}
+
+// SetBackendName sets the "backend_name" field.
+func (_u *PlaybackEventUpdateOne) SetBackendName(v string) *PlaybackEventUpdateOne {
+	_u.mutation.SetBackendName(v)
+	return _u
+}
+
+// SetNillableBackendName sets the "backend_name" field if the given value is not nil.
+func (_u *PlaybackEventUpdateOne) SetNillableBackendName(v *string) *PlaybackEventUpdateOne {
+	if v != nil {
+		_u.SetBackendName(*v)
+	}
+	return _u
+}
+
+// SetPlayMethod sets the "play_method" field.
+func (_u *PlaybackEventUpdateOne) SetPlayMethod(v string) *PlaybackEventUpdateOne {
+	_u.mutation.SetPlayMethod(v)
+	return _u
+}
+
+// SetNillablePlayMethod sets the "play_method" field if the given value is not nil.
+func (_u *PlaybackEventUpdateOne) SetNillablePlayMethod(v *string) *PlaybackEventUpdateOne {
+	if v != nil {
+		_u.SetPlayMethod(*v)
+	}
+	return _u
+}
+
+// ClearPlayMethod clears the value of the "play_method" field.
+func (_u *PlaybackEventUpdateOne) ClearPlayMethod() *PlaybackEventUpdateOne {
+	_u.mutation.ClearPlayMethod()
+	return _u
+}
+
+// SetStoppedAt sets the "stopped_at" field.
+func (_u *PlaybackEventUpdateOne) SetStoppedAt(v time.Time) *PlaybackEventUpdateOne {
+	_u.mutation.SetStoppedAt(v)
+	return _u
+}
+
+// SetNillableStoppedAt sets the "stopped_at" field if the given value is not nil.
+func (_u *PlaybackEventUpdateOne) SetNillableStoppedAt(v *time.Time) *PlaybackEventUpdateOne {
+	if v != nil {
+		_u.SetStoppedAt(*v)
+	}
+	return _u
+}
+
+// ClearStoppedAt clears the value of the "stopped_at" field.
+func (_u *PlaybackEventUpdateOne) ClearStoppedAt() *PlaybackEventUpdateOne {
+	_u.mutation.ClearStoppedAt()
+	return _u
+}
+
+// SetPositionTicks sets the "position_ticks" field.
+func (_u *PlaybackEventUpdateOne) SetPositionTicks(v int64) *PlaybackEventUpdateOne {
+	_u.mutation.ResetPositionTicks()
+	_u.mutation.SetPositionTicks(v)
+	return _u
+}
+
+// SetNillablePositionTicks sets the "position_ticks" field if the given value is not nil.
+func (_u *PlaybackEventUpdateOne) SetNillablePositionTicks(v *int64) *PlaybackEventUpdateOne {
+	if v != nil {
+		_u.SetPositionTicks(*v)
+	}
+	return _u
+}
+
+// AddPositionTicks adds value to the "position_ticks" field.
+func (_u *PlaybackEventUpdateOne) AddPositionTicks(v int64) *PlaybackEventUpdateOne {
+	_u.mutation.AddPositionTicks(v)
+	return _u
+}
+
+// SetPlayDuration sets the "play_duration" field.
+func (_u *PlaybackEventUpdateOne) SetPlayDuration(v int64) *PlaybackEventUpdateOne {
+	_u.mutation.ResetPlayDuration()
+	_u.mutation.SetPlayDuration(v)
+	return _u
+}
+
+// SetNillablePlayDuration sets the "play_duration" field if the given value is not nil.
+func (_u *PlaybackEventUpdateOne) SetNillablePlayDuration(v *int64) *PlaybackEventUpdateOne {
+	if v != nil {
+		_u.SetPlayDuration(*v)
+	}
+	return _u
+}
+
+// AddPlayDuration adds value to the "play_duration" field.
+func (_u *PlaybackEventUpdateOne) AddPlayDuration(v int64) *PlaybackEventUpdateOne {
+	_u.mutation.AddPlayDuration(v)
+	return _u
+}
+
+// Mutation returns the PlaybackEventMutation object of the builder.
+func (_u *PlaybackEventUpdateOne) Mutation() *PlaybackEventMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the PlaybackEventUpdate builder.
+func (_u *PlaybackEventUpdateOne) Where(ps ...predicate.PlaybackEvent) *PlaybackEventUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *PlaybackEventUpdateOne) Select(field string, fields ...string) *PlaybackEventUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated PlaybackEvent entity.
+func (_u *PlaybackEventUpdateOne) Save(ctx context.Context) (*PlaybackEvent, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *PlaybackEventUpdateOne) SaveX(ctx context.Context) *PlaybackEvent {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *PlaybackEventUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *PlaybackEventUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *PlaybackEventUpdateOne) check() error {
+	if v, ok := _u.mutation.ItemID(); ok {
+		if err := playbackevent.ItemIDValidator(v); err != nil {
+			return &ValidationError{Name: "item_id", err: fmt.Errorf(`ent: validator failed for field "PlaybackEvent.item_id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *PlaybackEventUpdateOne) sqlSave(ctx context.Context) (_node *PlaybackEvent, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(playbackevent.Table, playbackevent.Columns, sqlgraph.NewFieldSpec(playbackevent.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "PlaybackEvent.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, playbackevent.FieldID)
+		for _, f := range fields {
+			if !playbackevent.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != playbackevent.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UserID(); ok {
+		_spec.SetField(playbackevent.FieldUserID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.Username(); ok {
+		_spec.SetField(playbackevent.FieldUsername, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.SessionID(); ok {
+		_spec.SetField(playbackevent.FieldSessionID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.DeviceID(); ok {
+		_spec.SetField(playbackevent.FieldDeviceID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.DeviceName(); ok {
+		_spec.SetField(playbackevent.FieldDeviceName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.AppName(); ok {
+		_spec.SetField(playbackevent.FieldAppName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ItemID(); ok {
+		_spec.SetField(playbackevent.FieldItemID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ItemName(); ok {
+		_spec.SetField(playbackevent.FieldItemName, field.TypeString, value)
+	}
+	if _u.mutation.ItemNameCleared() {
+		_spec.ClearField(playbackevent.FieldItemName, field.TypeString)
+	}
+	if value, ok := _u.mutation.ItemType(); ok {
+		_spec.SetField(playbackevent.FieldItemType, field.TypeString, value)
+	}
+	if _u.mutation.ItemTypeCleared() {
+		_spec.ClearField(playbackevent.FieldItemType, field.TypeString)
+	}
+	if value, ok := _u.mutation.BackendID(); ok {
+		_spec.SetField(playbackevent.FieldBackendID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.BackendName(); ok {
+		_spec.SetField(playbackevent.FieldBackendName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.PlayMethod(); ok {
+		_spec.SetField(playbackevent.FieldPlayMethod, field.TypeString, value)
+	}
+	if _u.mutation.PlayMethodCleared() {
+		_spec.ClearField(playbackevent.FieldPlayMethod, field.TypeString)
+	}
+	if value, ok := _u.mutation.StoppedAt(); ok {
+		_spec.SetField(playbackevent.FieldStoppedAt, field.TypeTime, value)
+	}
+	if _u.mutation.StoppedAtCleared() {
+		_spec.ClearField(playbackevent.FieldStoppedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.PositionTicks(); ok {
+		_spec.SetField(playbackevent.FieldPositionTicks, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedPositionTicks(); ok {
+		_spec.AddField(playbackevent.FieldPositionTicks, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.PlayDuration(); ok {
+		_spec.SetField(playbackevent.FieldPlayDuration, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedPlayDuration(); ok {
+		_spec.AddField(playbackevent.FieldPlayDuration, field.TypeInt64, value)
+	}
+	_node = &PlaybackEvent{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{playbackevent.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
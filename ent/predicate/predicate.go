@@ -6,14 +6,44 @@ import (
 	"entgo.io/ent/dialect/sql"
 )
 
+// ActivityLog is the predicate function for activitylog builders.
+type ActivityLog func(*sql.Selector)
+
+// ApiKey is the predicate function for apikey builders.
+type ApiKey func(*sql.Selector)
+
+// AppPassword is the predicate function for apppassword builders.
+type AppPassword func(*sql.Selector)
+
 // Backend is the predicate function for backend builders.
 type Backend func(*sql.Selector)
 
 // BackendUser is the predicate function for backenduser builders.
 type BackendUser func(*sql.Selector)
 
+// DeviceOption is the predicate function for deviceoption builders.
+type DeviceOption func(*sql.Selector)
+
+// DisplayPreference is the predicate function for displaypreference builders.
+type DisplayPreference func(*sql.Selector)
+
+// DuplicateItem is the predicate function for duplicateitem builders.
+type DuplicateItem func(*sql.Selector)
+
+// Item is the predicate function for item builders.
+type Item func(*sql.Selector)
+
+// PlaybackEvent is the predicate function for playbackevent builders.
+type PlaybackEvent func(*sql.Selector)
+
+// RecoveryCode is the predicate function for recoverycode builders.
+type RecoveryCode func(*sql.Selector)
+
 // Session is the predicate function for session builders.
 type Session func(*sql.Selector)
 
 // User is the predicate function for user builders.
 type User func(*sql.Selector)
+
+// UserConfiguration is the predicate function for userconfiguration builders.
+type UserConfiguration func(*sql.Selector)
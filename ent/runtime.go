@@ -5,11 +5,21 @@ package ent
 import (
 	"time"
 
+	"github.com/ddevcap/jellyfin-proxy/ent/activitylog"
+	"github.com/ddevcap/jellyfin-proxy/ent/apikey"
+	"github.com/ddevcap/jellyfin-proxy/ent/apppassword"
 	"github.com/ddevcap/jellyfin-proxy/ent/backend"
 	"github.com/ddevcap/jellyfin-proxy/ent/backenduser"
+	"github.com/ddevcap/jellyfin-proxy/ent/deviceoption"
+	"github.com/ddevcap/jellyfin-proxy/ent/displaypreference"
+	"github.com/ddevcap/jellyfin-proxy/ent/duplicateitem"
+	"github.com/ddevcap/jellyfin-proxy/ent/item"
+	"github.com/ddevcap/jellyfin-proxy/ent/playbackevent"
+	"github.com/ddevcap/jellyfin-proxy/ent/recoverycode"
 	"github.com/ddevcap/jellyfin-proxy/ent/schema"
 	"github.com/ddevcap/jellyfin-proxy/ent/session"
 	"github.com/ddevcap/jellyfin-proxy/ent/user"
+	"github.com/ddevcap/jellyfin-proxy/ent/userconfiguration"
 	"github.com/google/uuid"
 )
 
@@ -17,6 +27,64 @@ import (
 // (default values, validators, hooks and policies) and stitches it
 // to their package variables.
 func init() {
+	activitylogFields := schema.ActivityLog{}.Fields()
+	_ = activitylogFields
+	// activitylogDescName is the schema descriptor for name field.
+	activitylogDescName := activitylogFields[1].Descriptor()
+	// activitylog.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	activitylog.NameValidator = activitylogDescName.Validators[0].(func(string) error)
+	// activitylogDescEventType is the schema descriptor for event_type field.
+	activitylogDescEventType := activitylogFields[2].Descriptor()
+	// activitylog.EventTypeValidator is a validator for the "event_type" field. It is called by the builders before save.
+	activitylog.EventTypeValidator = activitylogDescEventType.Validators[0].(func(string) error)
+	// activitylogDescSeverity is the schema descriptor for severity field.
+	activitylogDescSeverity := activitylogFields[7].Descriptor()
+	// activitylog.DefaultSeverity holds the default value on creation for the severity field.
+	activitylog.DefaultSeverity = activitylogDescSeverity.Default.(string)
+	// activitylogDescDate is the schema descriptor for date field.
+	activitylogDescDate := activitylogFields[8].Descriptor()
+	// activitylog.DefaultDate holds the default value on creation for the date field.
+	activitylog.DefaultDate = activitylogDescDate.Default.(func() time.Time)
+	// activitylogDescID is the schema descriptor for id field.
+	activitylogDescID := activitylogFields[0].Descriptor()
+	// activitylog.DefaultID holds the default value on creation for the id field.
+	activitylog.DefaultID = activitylogDescID.Default.(func() uuid.UUID)
+	apikeyFields := schema.ApiKey{}.Fields()
+	_ = apikeyFields
+	// apikeyDescName is the schema descriptor for name field.
+	apikeyDescName := apikeyFields[1].Descriptor()
+	// apikey.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	apikey.NameValidator = apikeyDescName.Validators[0].(func(string) error)
+	// apikeyDescKeyHash is the schema descriptor for key_hash field.
+	apikeyDescKeyHash := apikeyFields[2].Descriptor()
+	// apikey.KeyHashValidator is a validator for the "key_hash" field. It is called by the builders before save.
+	apikey.KeyHashValidator = apikeyDescKeyHash.Validators[0].(func(string) error)
+	// apikeyDescCreatedAt is the schema descriptor for created_at field.
+	apikeyDescCreatedAt := apikeyFields[5].Descriptor()
+	// apikey.DefaultCreatedAt holds the default value on creation for the created_at field.
+	apikey.DefaultCreatedAt = apikeyDescCreatedAt.Default.(func() time.Time)
+	// apikeyDescID is the schema descriptor for id field.
+	apikeyDescID := apikeyFields[0].Descriptor()
+	// apikey.DefaultID holds the default value on creation for the id field.
+	apikey.DefaultID = apikeyDescID.Default.(func() uuid.UUID)
+	apppasswordFields := schema.AppPassword{}.Fields()
+	_ = apppasswordFields
+	// apppasswordDescName is the schema descriptor for name field.
+	apppasswordDescName := apppasswordFields[1].Descriptor()
+	// apppassword.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	apppassword.NameValidator = apppasswordDescName.Validators[0].(func(string) error)
+	// apppasswordDescPasswordHash is the schema descriptor for password_hash field.
+	apppasswordDescPasswordHash := apppasswordFields[2].Descriptor()
+	// apppassword.PasswordHashValidator is a validator for the "password_hash" field. It is called by the builders before save.
+	apppassword.PasswordHashValidator = apppasswordDescPasswordHash.Validators[0].(func(string) error)
+	// apppasswordDescCreatedAt is the schema descriptor for created_at field.
+	apppasswordDescCreatedAt := apppasswordFields[4].Descriptor()
+	// apppassword.DefaultCreatedAt holds the default value on creation for the created_at field.
+	apppassword.DefaultCreatedAt = apppasswordDescCreatedAt.Default.(func() time.Time)
+	// apppasswordDescID is the schema descriptor for id field.
+	apppasswordDescID := apppasswordFields[0].Descriptor()
+	// apppassword.DefaultID holds the default value on creation for the id field.
+	apppassword.DefaultID = apppasswordDescID.Default.(func() uuid.UUID)
 	backendFields := schema.Backend{}.Fields()
 	_ = backendFields
 	// backendDescName is the schema descriptor for name field.
@@ -75,6 +143,112 @@ func init() {
 	backenduserDescID := backenduserFields[0].Descriptor()
 	// backenduser.DefaultID holds the default value on creation for the id field.
 	backenduser.DefaultID = backenduserDescID.Default.(func() uuid.UUID)
+	deviceoptionFields := schema.DeviceOption{}.Fields()
+	_ = deviceoptionFields
+	// deviceoptionDescDeviceID is the schema descriptor for device_id field.
+	deviceoptionDescDeviceID := deviceoptionFields[1].Descriptor()
+	// deviceoption.DeviceIDValidator is a validator for the "device_id" field. It is called by the builders before save.
+	deviceoption.DeviceIDValidator = deviceoptionDescDeviceID.Validators[0].(func(string) error)
+	// deviceoptionDescUpdatedAt is the schema descriptor for updated_at field.
+	deviceoptionDescUpdatedAt := deviceoptionFields[3].Descriptor()
+	// deviceoption.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	deviceoption.DefaultUpdatedAt = deviceoptionDescUpdatedAt.Default.(func() time.Time)
+	// deviceoption.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	deviceoption.UpdateDefaultUpdatedAt = deviceoptionDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// deviceoptionDescID is the schema descriptor for id field.
+	deviceoptionDescID := deviceoptionFields[0].Descriptor()
+	// deviceoption.DefaultID holds the default value on creation for the id field.
+	deviceoption.DefaultID = deviceoptionDescID.Default.(func() uuid.UUID)
+	displaypreferenceFields := schema.DisplayPreference{}.Fields()
+	_ = displaypreferenceFields
+	// displaypreferenceDescPrefsID is the schema descriptor for prefs_id field.
+	displaypreferenceDescPrefsID := displaypreferenceFields[2].Descriptor()
+	// displaypreference.PrefsIDValidator is a validator for the "prefs_id" field. It is called by the builders before save.
+	displaypreference.PrefsIDValidator = displaypreferenceDescPrefsID.Validators[0].(func(string) error)
+	// displaypreferenceDescClientName is the schema descriptor for client_name field.
+	displaypreferenceDescClientName := displaypreferenceFields[3].Descriptor()
+	// displaypreference.DefaultClientName holds the default value on creation for the client_name field.
+	displaypreference.DefaultClientName = displaypreferenceDescClientName.Default.(string)
+	// displaypreferenceDescUpdatedAt is the schema descriptor for updated_at field.
+	displaypreferenceDescUpdatedAt := displaypreferenceFields[5].Descriptor()
+	// displaypreference.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	displaypreference.DefaultUpdatedAt = displaypreferenceDescUpdatedAt.Default.(func() time.Time)
+	// displaypreference.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	displaypreference.UpdateDefaultUpdatedAt = displaypreferenceDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// displaypreferenceDescID is the schema descriptor for id field.
+	displaypreferenceDescID := displaypreferenceFields[0].Descriptor()
+	// displaypreference.DefaultID holds the default value on creation for the id field.
+	displaypreference.DefaultID = displaypreferenceDescID.Default.(func() uuid.UUID)
+	duplicateitemFields := schema.DuplicateItem{}.Fields()
+	_ = duplicateitemFields
+	// duplicateitemDescDupID is the schema descriptor for dup_id field.
+	duplicateitemDescDupID := duplicateitemFields[1].Descriptor()
+	// duplicateitem.DupIDValidator is a validator for the "dup_id" field. It is called by the builders before save.
+	duplicateitem.DupIDValidator = duplicateitemDescDupID.Validators[0].(func(string) error)
+	// duplicateitemDescUpdatedAt is the schema descriptor for updated_at field.
+	duplicateitemDescUpdatedAt := duplicateitemFields[3].Descriptor()
+	// duplicateitem.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	duplicateitem.DefaultUpdatedAt = duplicateitemDescUpdatedAt.Default.(func() time.Time)
+	// duplicateitem.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	duplicateitem.UpdateDefaultUpdatedAt = duplicateitemDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// duplicateitemDescID is the schema descriptor for id field.
+	duplicateitemDescID := duplicateitemFields[0].Descriptor()
+	// duplicateitem.DefaultID holds the default value on creation for the id field.
+	duplicateitem.DefaultID = duplicateitemDescID.Default.(func() uuid.UUID)
+	itemFields := schema.Item{}.Fields()
+	_ = itemFields
+	// itemDescBackendItemID is the schema descriptor for backend_item_id field.
+	itemDescBackendItemID := itemFields[1].Descriptor()
+	// item.BackendItemIDValidator is a validator for the "backend_item_id" field. It is called by the builders before save.
+	item.BackendItemIDValidator = itemDescBackendItemID.Validators[0].(func(string) error)
+	// itemDescItemType is the schema descriptor for item_type field.
+	itemDescItemType := itemFields[4].Descriptor()
+	// item.ItemTypeValidator is a validator for the "item_type" field. It is called by the builders before save.
+	item.ItemTypeValidator = itemDescItemType.Validators[0].(func(string) error)
+	// itemDescIndexedAt is the schema descriptor for indexed_at field.
+	itemDescIndexedAt := itemFields[22].Descriptor()
+	// item.DefaultIndexedAt holds the default value on creation for the indexed_at field.
+	item.DefaultIndexedAt = itemDescIndexedAt.Default.(func() time.Time)
+	// itemDescID is the schema descriptor for id field.
+	itemDescID := itemFields[0].Descriptor()
+	// item.DefaultID holds the default value on creation for the id field.
+	item.DefaultID = itemDescID.Default.(func() uuid.UUID)
+	playbackeventFields := schema.PlaybackEvent{}.Fields()
+	_ = playbackeventFields
+	// playbackeventDescItemID is the schema descriptor for item_id field.
+	playbackeventDescItemID := playbackeventFields[7].Descriptor()
+	// playbackevent.ItemIDValidator is a validator for the "item_id" field. It is called by the builders before save.
+	playbackevent.ItemIDValidator = playbackeventDescItemID.Validators[0].(func(string) error)
+	// playbackeventDescStartedAt is the schema descriptor for started_at field.
+	playbackeventDescStartedAt := playbackeventFields[13].Descriptor()
+	// playbackevent.DefaultStartedAt holds the default value on creation for the started_at field.
+	playbackevent.DefaultStartedAt = playbackeventDescStartedAt.Default.(func() time.Time)
+	// playbackeventDescPositionTicks is the schema descriptor for position_ticks field.
+	playbackeventDescPositionTicks := playbackeventFields[15].Descriptor()
+	// playbackevent.DefaultPositionTicks holds the default value on creation for the position_ticks field.
+	playbackevent.DefaultPositionTicks = playbackeventDescPositionTicks.Default.(int64)
+	// playbackeventDescPlayDuration is the schema descriptor for play_duration field.
+	playbackeventDescPlayDuration := playbackeventFields[16].Descriptor()
+	// playbackevent.DefaultPlayDuration holds the default value on creation for the play_duration field.
+	playbackevent.DefaultPlayDuration = playbackeventDescPlayDuration.Default.(int64)
+	// playbackeventDescID is the schema descriptor for id field.
+	playbackeventDescID := playbackeventFields[0].Descriptor()
+	// playbackevent.DefaultID holds the default value on creation for the id field.
+	playbackevent.DefaultID = playbackeventDescID.Default.(func() uuid.UUID)
+	recoverycodeFields := schema.RecoveryCode{}.Fields()
+	_ = recoverycodeFields
+	// recoverycodeDescCodeHash is the schema descriptor for code_hash field.
+	recoverycodeDescCodeHash := recoverycodeFields[1].Descriptor()
+	// recoverycode.CodeHashValidator is a validator for the "code_hash" field. It is called by the builders before save.
+	recoverycode.CodeHashValidator = recoverycodeDescCodeHash.Validators[0].(func(string) error)
+	// recoverycodeDescCreatedAt is the schema descriptor for created_at field.
+	recoverycodeDescCreatedAt := recoverycodeFields[2].Descriptor()
+	// recoverycode.DefaultCreatedAt holds the default value on creation for the created_at field.
+	recoverycode.DefaultCreatedAt = recoverycodeDescCreatedAt.Default.(func() time.Time)
+	// recoverycodeDescID is the schema descriptor for id field.
+	recoverycodeDescID := recoverycodeFields[0].Descriptor()
+	// recoverycode.DefaultID holds the default value on creation for the id field.
+	recoverycode.DefaultID = recoverycodeDescID.Default.(func() uuid.UUID)
 	sessionFields := schema.Session{}.Fields()
 	_ = sessionFields
 	// sessionDescToken is the schema descriptor for token field.
@@ -123,18 +297,66 @@ func init() {
 	userDescIsAdmin := userFields[4].Descriptor()
 	// user.DefaultIsAdmin holds the default value on creation for the is_admin field.
 	user.DefaultIsAdmin = userDescIsAdmin.Default.(bool)
+	// userDescAuthSource is the schema descriptor for auth_source field.
+	userDescAuthSource := userFields[5].Descriptor()
+	// user.DefaultAuthSource holds the default value on creation for the auth_source field.
+	user.DefaultAuthSource = userDescAuthSource.Default.(string)
 	// userDescCreatedAt is the schema descriptor for created_at field.
-	userDescCreatedAt := userFields[5].Descriptor()
+	userDescCreatedAt := userFields[6].Descriptor()
 	// user.DefaultCreatedAt holds the default value on creation for the created_at field.
 	user.DefaultCreatedAt = userDescCreatedAt.Default.(func() time.Time)
 	// userDescUpdatedAt is the schema descriptor for updated_at field.
-	userDescUpdatedAt := userFields[6].Descriptor()
+	userDescUpdatedAt := userFields[7].Descriptor()
 	// user.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	user.DefaultUpdatedAt = userDescUpdatedAt.Default.(func() time.Time)
 	// user.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	user.UpdateDefaultUpdatedAt = userDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// userDescIsDisabled is the schema descriptor for is_disabled field.
+	userDescIsDisabled := userFields[12].Descriptor()
+	// user.DefaultIsDisabled holds the default value on creation for the is_disabled field.
+	user.DefaultIsDisabled = userDescIsDisabled.Default.(bool)
+	// userDescEnableRemoteAccess is the schema descriptor for enable_remote_access field.
+	userDescEnableRemoteAccess := userFields[13].Descriptor()
+	// user.DefaultEnableRemoteAccess holds the default value on creation for the enable_remote_access field.
+	user.DefaultEnableRemoteAccess = userDescEnableRemoteAccess.Default.(bool)
+	// userDescEnableMediaPlayback is the schema descriptor for enable_media_playback field.
+	userDescEnableMediaPlayback := userFields[14].Descriptor()
+	// user.DefaultEnableMediaPlayback holds the default value on creation for the enable_media_playback field.
+	user.DefaultEnableMediaPlayback = userDescEnableMediaPlayback.Default.(bool)
+	// userDescEnableVideoPlaybackTranscoding is the schema descriptor for enable_video_playback_transcoding field.
+	userDescEnableVideoPlaybackTranscoding := userFields[15].Descriptor()
+	// user.DefaultEnableVideoPlaybackTranscoding holds the default value on creation for the enable_video_playback_transcoding field.
+	user.DefaultEnableVideoPlaybackTranscoding = userDescEnableVideoPlaybackTranscoding.Default.(bool)
+	// userDescEnableContentDownloading is the schema descriptor for enable_content_downloading field.
+	userDescEnableContentDownloading := userFields[16].Descriptor()
+	// user.DefaultEnableContentDownloading holds the default value on creation for the enable_content_downloading field.
+	user.DefaultEnableContentDownloading = userDescEnableContentDownloading.Default.(bool)
+	// userDescFailedLoginCount is the schema descriptor for failed_login_count field.
+	userDescFailedLoginCount := userFields[18].Descriptor()
+	// user.DefaultFailedLoginCount holds the default value on creation for the failed_login_count field.
+	user.DefaultFailedLoginCount = userDescFailedLoginCount.Default.(int)
+	// userDescTotpEnabled is the schema descriptor for totp_enabled field.
+	userDescTotpEnabled := userFields[21].Descriptor()
+	// user.DefaultTotpEnabled holds the default value on creation for the totp_enabled field.
+	user.DefaultTotpEnabled = userDescTotpEnabled.Default.(bool)
+	// userDescTotpLastStep is the schema descriptor for totp_last_step field.
+	userDescTotpLastStep := userFields[22].Descriptor()
+	// user.DefaultTotpLastStep holds the default value on creation for the totp_last_step field.
+	user.DefaultTotpLastStep = userDescTotpLastStep.Default.(int64)
 	// userDescID is the schema descriptor for id field.
 	userDescID := userFields[0].Descriptor()
 	// user.DefaultID holds the default value on creation for the id field.
 	user.DefaultID = userDescID.Default.(func() uuid.UUID)
+	userconfigurationFields := schema.UserConfiguration{}.Fields()
+	_ = userconfigurationFields
+	// userconfigurationDescUpdatedAt is the schema descriptor for updated_at field.
+	userconfigurationDescUpdatedAt := userconfigurationFields[3].Descriptor()
+	// userconfiguration.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	userconfiguration.DefaultUpdatedAt = userconfigurationDescUpdatedAt.Default.(func() time.Time)
+	// userconfiguration.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	userconfiguration.UpdateDefaultUpdatedAt = userconfigurationDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// userconfigurationDescID is the schema descriptor for id field.
+	userconfigurationDescID := userconfigurationFields[0].Descriptor()
+	// userconfiguration.DefaultID holds the default value on creation for the id field.
+	userconfiguration.DefaultID = userconfigurationDescID.Default.(func() uuid.UUID)
 }
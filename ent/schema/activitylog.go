@@ -0,0 +1,54 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// ActivityLog is one entry of the proxy's audit trail, served to clients as
+// Jellyfin's activity log. Like PlaybackEvent it references users by value
+// so entries survive the user's deletion.
+type ActivityLog struct {
+	ent.Schema
+}
+
+func (ActivityLog) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New),
+		// One-line summary, e.g. "alice successfully authenticated".
+		field.String("name").
+			NotEmpty(),
+		// Jellyfin event type, e.g. "AuthenticationSucceeded" or "UserCreated".
+		field.String("event_type").
+			NotEmpty(),
+		field.String("short_overview").
+			Optional(),
+		field.String("overview").
+			Optional(),
+		// The user the event concerns; uuid.Nil, like Jellyfin's empty Guid,
+		// for events without one.
+		field.UUID("user_id", uuid.UUID{}).
+			Optional(),
+		// The proxy item ID the event concerns, if any.
+		field.String("item_id").
+			Optional(),
+		// Jellyfin log level name: Information, Warning or Error.
+		field.String("severity").
+			Default("Information"),
+		field.Time("date").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+func (ActivityLog) Indexes() []ent.Index {
+	return []ent.Index{
+		// Entries are listed newest first and filtered by MinDate.
+		index.Fields("date"),
+	}
+}
@@ -0,0 +1,52 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// ApiKey is a long-lived token for scripts and automation. It authenticates
+// as its owner like a session token, but is not tied to a device and does
+// not expire with SESSION_TTL.
+type ApiKey struct {
+	ent.Schema
+}
+
+func (ApiKey) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New),
+		// What the key is used for, e.g. "Sonarr".
+		field.String("name").
+			NotEmpty(),
+		// Hex SHA-256 of the key. The key itself is only shown once, when it
+		// is created.
+		field.String("key_hash").
+			Unique().
+			NotEmpty().
+			Sensitive(),
+		// Unset for keys that never expire.
+		field.Time("expires_at").
+			Optional().
+			Nillable(),
+		field.Time("last_used_at").
+			Optional().
+			Nillable(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+func (ApiKey) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).
+			Ref("api_keys").
+			Unique().
+			Required(),
+	}
+}
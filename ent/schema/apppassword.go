@@ -0,0 +1,50 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// AppPassword is a per-device password for clients that cannot prompt for a
+// two-factor code, such as TV and mobile apps. It is accepted in place of the
+// account password at login and skips the TOTP step; deleting it ends the
+// sessions it created.
+type AppPassword struct {
+	ent.Schema
+}
+
+func (AppPassword) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New),
+		// The device it is for, e.g. "Living room TV".
+		field.String("name").
+			NotEmpty(),
+		// Hex SHA-256 of the password, which is only shown once, when it is
+		// created.
+		field.String("password_hash").
+			Unique().
+			NotEmpty().
+			Sensitive(),
+		field.Time("last_used_at").
+			Optional().
+			Nillable(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+func (AppPassword) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).
+			Ref("app_passwords").
+			Unique().
+			Required(),
+		edge.To("sessions", Session.Type),
+	}
+}
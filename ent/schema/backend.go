@@ -44,5 +44,6 @@ func (Backend) Fields() []ent.Field {
 func (Backend) Edges() []ent.Edge {
 	return []ent.Edge{
 		edge.To("backend_users", BackendUser.Type),
+		edge.To("items", Item.Type),
 	}
 }
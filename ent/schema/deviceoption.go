@@ -0,0 +1,32 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// DeviceOption holds what Jellyfin calls a device's DeviceOptions: settings
+// an admin made for a client device, keyed by its device ID. Devices
+// themselves are not stored; they are derived from sessions.
+type DeviceOption struct {
+	ent.Schema
+}
+
+func (DeviceOption) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New),
+		field.String("device_id").
+			Unique().
+			NotEmpty(),
+		// Name shown in place of the one the client reports. Empty for none.
+		field.String("custom_name").
+			Optional(),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
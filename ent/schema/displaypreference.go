@@ -0,0 +1,43 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// DisplayPreference is a client's DisplayPreferencesDto for one user and
+// preferences ID (e.g. "usersettings" or a library ID): home screen layout,
+// sort order, view type. The user is stored by value, as in playback
+// events; deleting the user removes the rows.
+type DisplayPreference struct {
+	ent.Schema
+}
+
+func (DisplayPreference) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New),
+		field.UUID("user_id", uuid.UUID{}),
+		field.String("prefs_id").
+			NotEmpty(),
+		// The client query param, e.g. "emby". Empty when not sent.
+		field.String("client_name").
+			Default(""),
+		// The preferences as the client sent them, as JSON.
+		field.Bytes("data"),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+func (DisplayPreference) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id", "prefs_id", "client_name").
+			Unique(),
+	}
+}
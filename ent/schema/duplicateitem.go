@@ -0,0 +1,32 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// DuplicateItem records where the copies of a title found on several backends
+// live, keyed by the title's virtual dup_ ID. Clients keep these IDs in
+// favorites, resume lists and links, so the mapping has to outlive the
+// proxy's in-memory cache.
+type DuplicateItem struct {
+	ent.Schema
+}
+
+func (DuplicateItem) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New),
+		field.String("dup_id").
+			Unique().
+			NotEmpty(),
+		// Proxy IDs of every copy, in precedence order.
+		field.Strings("copies"),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
@@ -0,0 +1,97 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// Item is the proxy's local copy of a library item's metadata on one Backend.
+// Rows are rebuilt by the background indexer so that merged libraries can be
+// paged, sorted and counted without asking every backend for its full list.
+type Item struct {
+	ent.Schema
+}
+
+func (Item) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New),
+		// The item's ID on the backend Jellyfin server (no proxy prefix).
+		field.String("backend_item_id").
+			NotEmpty(),
+		field.String("name"),
+		field.String("sort_name"),
+		// Jellyfin item type, e.g. "Movie", "Series" or "Episode".
+		field.String("item_type").
+			NotEmpty(),
+		field.String("media_type").
+			Optional(),
+		// The backend library the item was found in and its CollectionType.
+		field.String("library_id").
+			Optional(),
+		field.String("collection_type").
+			Optional(),
+		// Parent chain on the backend, as backend IDs. Season and series are
+		// only set for episodes and seasons.
+		field.String("parent_id").
+			Optional(),
+		field.String("season_id").
+			Optional(),
+		field.String("series_id").
+			Optional(),
+		// External provider IDs, used to recognise the same title on several backends.
+		field.String("tmdb_id").
+			Optional(),
+		field.String("imdb_id").
+			Optional(),
+		field.String("tvdb_id").
+			Optional(),
+		// Genre and tag names joined with "|", matching Jellyfin's query syntax.
+		field.String("genres").
+			Optional(),
+		field.String("tags").
+			Optional(),
+		field.String("official_rating").
+			Optional(),
+		field.Int("production_year").
+			Optional(),
+		field.Float("community_rating").
+			Optional(),
+		field.Time("premiere_date").
+			Optional().
+			Nillable(),
+		field.Time("date_created").
+			Optional().
+			Nillable(),
+		// The item as returned by the backend, with IDs already rewritten to
+		// proxy form. Served when the live copy cannot be fetched.
+		field.Bytes("data"),
+		field.Time("indexed_at").
+			Default(time.Now),
+	}
+}
+
+func (Item) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("backend", Backend.Type).
+			Ref("items").
+			Unique().
+			Required(),
+	}
+}
+
+func (Item) Indexes() []ent.Index {
+	return []ent.Index{
+		// One row per backend item.
+		index.Fields("backend_item_id").
+			Edges("backend").
+			Unique(),
+		// Merged libraries are queried by item type.
+		index.Fields("item_type"),
+	}
+}
@@ -0,0 +1,64 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// PlaybackEvent records one playback reported through the proxy, from the
+// client's start report to its stop report. Users, sessions and backends are
+// stored by value rather than as edges so the history outlives them.
+type PlaybackEvent struct {
+	ent.Schema
+}
+
+func (PlaybackEvent) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New),
+		field.UUID("user_id", uuid.UUID{}),
+		field.String("username"),
+		field.UUID("session_id", uuid.UUID{}),
+		field.String("device_id"),
+		field.String("device_name"),
+		// The client application, e.g. "Jellyfin Web".
+		field.String("app_name"),
+		// The played item's proxy ID, with the backend prefix.
+		field.String("item_id").
+			NotEmpty(),
+		field.String("item_name").
+			Optional(),
+		field.String("item_type").
+			Optional(),
+		field.UUID("backend_id", uuid.UUID{}),
+		field.String("backend_name"),
+		// DirectPlay, DirectStream or Transcode.
+		field.String("play_method").
+			Optional(),
+		field.Time("started_at").
+			Default(time.Now).
+			Immutable(),
+		// Unset while the playback is running.
+		field.Time("stopped_at").
+			Optional().
+			Nillable(),
+		// Last reported position in the item, in ticks.
+		field.Int64("position_ticks").
+			Default(0),
+		// Seconds actually spent playing, excluding pauses.
+		field.Int64("play_duration").
+			Default(0),
+	}
+}
+
+func (PlaybackEvent) Indexes() []ent.Index {
+	return []ent.Index{
+		// Statistics are queried by time window.
+		index.Fields("started_at"),
+		index.Fields("user_id"),
+	}
+}
@@ -0,0 +1,40 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// RecoveryCode is a single-use code that stands in for a TOTP code when the
+// user has lost their authenticator. It is deleted once used.
+type RecoveryCode struct {
+	ent.Schema
+}
+
+func (RecoveryCode) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New),
+		// Hex SHA-256 of the normalized code, which is only shown when the
+		// codes are generated.
+		field.String("code_hash").
+			NotEmpty().
+			Sensitive(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+func (RecoveryCode) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).
+			Ref("recovery_codes").
+			Unique().
+			Required(),
+	}
+}
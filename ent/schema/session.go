@@ -21,7 +21,8 @@ func (Session) Fields() []ent.Field {
 	return []ent.Field{
 		field.UUID("id", uuid.UUID{}).
 			Default(uuid.New),
-		// The opaque token value sent by clients in X-Emby-Token / X-MediaBrowser-Token.
+		// Hex SHA-256 of the opaque token sent by clients in X-Emby-Token /
+		// X-MediaBrowser-Token. The token itself is never stored.
 		field.String("token").
 			Unique().
 			NotEmpty().
@@ -49,6 +50,11 @@ func (Session) Edges() []ent.Edge {
 			Ref("sessions").
 			Unique().
 			Required(),
+		// The app password the session logged in with, if any; revoking
+		// the app password ends its sessions.
+		edge.From("app_password", AppPassword.Type).
+			Ref("sessions").
+			Unique(),
 	}
 }
 
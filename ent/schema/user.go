@@ -6,6 +6,7 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
+	"github.com/ddevcap/jellyfin-proxy/access"
 	"github.com/google/uuid"
 )
 
@@ -28,6 +29,10 @@ func (User) Fields() []ent.Field {
 			NotEmpty(),
 		field.Bool("is_admin").
 			Default(false),
+		// Where the password is checked: "local" against hashed_password, or
+		// "ldap" against the directory, for accounts created by an LDAP login.
+		field.String("auth_source").
+			Default("local"),
 		field.Time("created_at").
 			Default(time.Now).
 			Immutable(),
@@ -40,6 +45,55 @@ func (User) Fields() []ent.Field {
 		field.String("avatar_content_type").
 			Optional().
 			Nillable(),
+		// Subject claim of the OpenID Connect identity the user signs in
+		// with, set when the user first logs in through SSO.
+		field.String("oidc_subject").
+			Optional().
+			Nillable().
+			Unique(),
+		// Libraries, parental rating, tags and hours the user is limited to.
+		// The proxy enforces them itself, on every backend.
+		field.JSON("access_rules", access.Rules{}).
+			Optional(),
+		// Jellyfin policy flags, editable from the dashboard's user editor.
+		// A disabled user cannot log in or use existing sessions.
+		field.Bool("is_disabled").
+			Default(false),
+		field.Bool("enable_remote_access").
+			Default(true),
+		field.Bool("enable_media_playback").
+			Default(true),
+		field.Bool("enable_video_playback_transcoding").
+			Default(true),
+		field.Bool("enable_content_downloading").
+			Default(true),
+		// Highest bitrate (bits/s) the user may stream at from outside the
+		// local network; nil uses BITRATE_LIMIT and 0 means unlimited.
+		field.Int("remote_client_bitrate_limit").
+			Optional().
+			Nillable(),
+		// Consecutive failed password logins; reset by a successful login or
+		// when the account gets locked.
+		field.Int("failed_login_count").
+			Default(0),
+		// Password logins are refused until this time after too many failed
+		// attempts.
+		field.Time("locked_until").
+			Optional().
+			Nillable(),
+		// Base32 TOTP secret, encrypted like backend tokens when
+		// BACKEND_TOKEN_KEY is set. Set when enrolment starts; logins only
+		// ask for a code once totp_enabled is set by confirming one.
+		field.String("totp_secret").
+			Optional().
+			Nillable().
+			Sensitive(),
+		field.Bool("totp_enabled").
+			Default(false),
+		// Time step of the last accepted code, so a code cannot be used
+		// twice.
+		field.Int64("totp_last_step").
+			Default(0),
 	}
 }
 
@@ -47,5 +101,8 @@ func (User) Edges() []ent.Edge {
 	return []ent.Edge{
 		edge.To("sessions", Session.Type),
 		edge.To("backend_users", BackendUser.Type),
+		edge.To("api_keys", ApiKey.Type),
+		edge.To("recovery_codes", RecoveryCode.Type),
+		edge.To("app_passwords", AppPassword.Type),
 	}
 }
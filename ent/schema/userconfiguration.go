@@ -0,0 +1,30 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// UserConfiguration is a user's Jellyfin UserConfiguration: audio and
+// subtitle language preferences, home screen order, excluded libraries and
+// the like, as last saved by a client.
+type UserConfiguration struct {
+	ent.Schema
+}
+
+func (UserConfiguration) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New),
+		field.UUID("user_id", uuid.UUID{}).
+			Unique(),
+		// The configuration object as JSON.
+		field.Bytes("data"),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
@@ -9,6 +9,7 @@ import (
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
+	"github.com/ddevcap/jellyfin-proxy/ent/apppassword"
 	"github.com/ddevcap/jellyfin-proxy/ent/session"
 	"github.com/ddevcap/jellyfin-proxy/ent/user"
 	"github.com/google/uuid"
@@ -35,18 +36,21 @@ type Session struct {
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the SessionQuery when eager-loading is set.
-	Edges         SessionEdges `json:"edges"`
-	user_sessions *uuid.UUID
-	selectValues  sql.SelectValues
+	Edges                 SessionEdges `json:"edges"`
+	app_password_sessions *uuid.UUID
+	user_sessions         *uuid.UUID
+	selectValues          sql.SelectValues
 }
 
 // SessionEdges holds the relations/edges for other nodes in the graph.
 type SessionEdges struct {
 	// User holds the value of the user edge.
 	User *User `json:"user,omitempty"`
+	// AppPassword holds the value of the app_password edge.
+	AppPassword *AppPassword `json:"app_password,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [1]bool
+	loadedTypes [2]bool
 }
 
 // UserOrErr returns the User value or an error if the edge
@@ -60,6 +64,17 @@ func (e SessionEdges) UserOrErr() (*User, error) {
 	return nil, &NotLoadedError{edge: "user"}
 }
 
+// AppPasswordOrErr returns the AppPassword value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e SessionEdges) AppPasswordOrErr() (*AppPassword, error) {
+	if e.AppPassword != nil {
+		return e.AppPassword, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: apppassword.Label}
+	}
+	return nil, &NotLoadedError{edge: "app_password"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Session) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
@@ -71,7 +86,9 @@ func (*Session) scanValues(columns []string) ([]any, error) {
 			values[i] = new(sql.NullTime)
 		case session.FieldID:
 			values[i] = new(uuid.UUID)
-		case session.ForeignKeys[0]: // user_sessions
+		case session.ForeignKeys[0]: // app_password_sessions
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case session.ForeignKeys[1]: // user_sessions
 			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
 		default:
 			values[i] = new(sql.UnknownType)
@@ -137,6 +154,13 @@ func (_m *Session) assignValues(columns []string, values []any) error {
 				_m.CreatedAt = value.Time
 			}
 		case session.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field app_password_sessions", values[i])
+			} else if value.Valid {
+				_m.app_password_sessions = new(uuid.UUID)
+				*_m.app_password_sessions = *value.S.(*uuid.UUID)
+			}
+		case session.ForeignKeys[1]:
 			if value, ok := values[i].(*sql.NullScanner); !ok {
 				return fmt.Errorf("unexpected type %T for field user_sessions", values[i])
 			} else if value.Valid {
@@ -161,6 +185,11 @@ func (_m *Session) QueryUser() *UserQuery {
 	return NewSessionClient(_m.config).QueryUser(_m)
 }
 
+// QueryAppPassword queries the "app_password" edge of the Session entity.
+func (_m *Session) QueryAppPassword() *AppPasswordQuery {
+	return NewSessionClient(_m.config).QueryAppPassword(_m)
+}
+
 // Update returns a builder for updating this Session.
 // Note that you need to call Session.Unwrap() before calling this method if this Session
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -31,6 +31,8 @@ const (
 	FieldCreatedAt = "created_at"
 	// EdgeUser holds the string denoting the user edge name in mutations.
 	EdgeUser = "user"
+	// EdgeAppPassword holds the string denoting the app_password edge name in mutations.
+	EdgeAppPassword = "app_password"
 	// Table holds the table name of the session in the database.
 	Table = "sessions"
 	// UserTable is the table that holds the user relation/edge.
@@ -40,6 +42,13 @@ const (
 	UserInverseTable = "users"
 	// UserColumn is the table column denoting the user relation/edge.
 	UserColumn = "user_sessions"
+	// AppPasswordTable is the table that holds the app_password relation/edge.
+	AppPasswordTable = "sessions"
+	// AppPasswordInverseTable is the table name for the AppPassword entity.
+	// It exists in this package in order to avoid circular dependency with the "apppassword" package.
+	AppPasswordInverseTable = "app_passwords"
+	// AppPasswordColumn is the table column denoting the app_password relation/edge.
+	AppPasswordColumn = "app_password_sessions"
 )
 
 // Columns holds all SQL columns for session fields.
@@ -57,6 +66,7 @@ var Columns = []string{
 // ForeignKeys holds the SQL foreign-keys that are owned by the "sessions"
 // table and are not defined as standalone fields in the schema.
 var ForeignKeys = []string{
+	"app_password_sessions",
 	"user_sessions",
 }
 
@@ -141,6 +151,13 @@ func ByUserField(field string, opts ...sql.OrderTermOption) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newUserStep(), sql.OrderByField(field, opts...))
 	}
 }
+
+// ByAppPasswordField orders the results by app_password field.
+func ByAppPasswordField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newAppPasswordStep(), sql.OrderByField(field, opts...))
+	}
+}
 func newUserStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -148,3 +165,10 @@ func newUserStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.M2O, true, UserTable, UserColumn),
 	)
 }
+func newAppPasswordStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(AppPasswordInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, AppPasswordTable, AppPasswordColumn),
+	)
+}
@@ -529,6 +529,29 @@ func HasUserWith(preds ...predicate.User) predicate.Session {
 	})
 }
 
+// HasAppPassword applies the HasEdge predicate on the "app_password" edge.
+func HasAppPassword() predicate.Session {
+	return predicate.Session(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, AppPasswordTable, AppPasswordColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasAppPasswordWith applies the HasEdge predicate on the "app_password" edge with a given conditions (other predicates).
+func HasAppPasswordWith(preds ...predicate.AppPassword) predicate.Session {
+	return predicate.Session(func(s *sql.Selector) {
+		step := newAppPasswordStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Session) predicate.Session {
 	return predicate.Session(sql.AndPredicates(predicates...))
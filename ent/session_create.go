@@ -10,6 +10,7 @@ import (
 
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/ddevcap/jellyfin-proxy/ent/apppassword"
 	"github.com/ddevcap/jellyfin-proxy/ent/session"
 	"github.com/ddevcap/jellyfin-proxy/ent/user"
 	"github.com/google/uuid"
@@ -113,6 +114,25 @@ func (_c *SessionCreate) SetUser(v *User) *SessionCreate {
 	return _c.SetUserID(v.ID)
 }
 
+// SetAppPasswordID sets the "app_password" edge to the AppPassword entity by ID.
+func (_c *SessionCreate) SetAppPasswordID(id uuid.UUID) *SessionCreate {
+	_c.mutation.SetAppPasswordID(id)
+	return _c
+}
+
+// SetNillableAppPasswordID sets the "app_password" edge to the AppPassword entity by ID if the given value is not nil.
+func (_c *SessionCreate) SetNillableAppPasswordID(id *uuid.UUID) *SessionCreate {
+	if id != nil {
+		_c = _c.SetAppPasswordID(*id)
+	}
+	return _c
+}
+
+// SetAppPassword sets the "app_password" edge to the AppPassword entity.
+func (_c *SessionCreate) SetAppPassword(v *AppPassword) *SessionCreate {
+	return _c.SetAppPasswordID(v.ID)
+}
+
 // Mutation returns the SessionMutation object of the builder.
 func (_c *SessionCreate) Mutation() *SessionMutation {
 	return _c.mutation
@@ -285,6 +305,23 @@ func (_c *SessionCreate) createSpec() (*Session, *sqlgraph.CreateSpec) {
 		_node.user_sessions = &nodes[0]
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.AppPasswordIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   session.AppPasswordTable,
+			Columns: []string{session.AppPasswordColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(apppassword.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.app_password_sessions = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
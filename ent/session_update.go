@@ -11,6 +11,7 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/ddevcap/jellyfin-proxy/ent/apppassword"
 	"github.com/ddevcap/jellyfin-proxy/ent/predicate"
 	"github.com/ddevcap/jellyfin-proxy/ent/session"
 	"github.com/ddevcap/jellyfin-proxy/ent/user"
@@ -131,6 +132,25 @@ func (_u *SessionUpdate) SetUser(v *User) *SessionUpdate {
 	return _u.SetUserID(v.ID)
 }
 
+// SetAppPasswordID sets the "app_password" edge to the AppPassword entity by ID.
+func (_u *SessionUpdate) SetAppPasswordID(id uuid.UUID) *SessionUpdate {
+	_u.mutation.SetAppPasswordID(id)
+	return _u
+}
+
+// SetNillableAppPasswordID sets the "app_password" edge to the AppPassword entity by ID if the given value is not nil.
+func (_u *SessionUpdate) SetNillableAppPasswordID(id *uuid.UUID) *SessionUpdate {
+	if id != nil {
+		_u = _u.SetAppPasswordID(*id)
+	}
+	return _u
+}
+
+// SetAppPassword sets the "app_password" edge to the AppPassword entity.
+func (_u *SessionUpdate) SetAppPassword(v *AppPassword) *SessionUpdate {
+	return _u.SetAppPasswordID(v.ID)
+}
+
 // Mutation returns the SessionMutation object of the builder.
 func (_u *SessionUpdate) Mutation() *SessionMutation {
 	return _u.mutation
@@ -142,6 +162,12 @@ func (_u *SessionUpdate) ClearUser() *SessionUpdate {
 	return _u
 }
 
+// ClearAppPassword clears the "app_password" edge to the AppPassword entity.
+func (_u *SessionUpdate) ClearAppPassword() *SessionUpdate {
+	_u.mutation.ClearAppPassword()
+	return _u
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *SessionUpdate) Save(ctx context.Context) (int, error) {
 	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
@@ -259,6 +285,35 @@ func (_u *SessionUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.AppPasswordCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   session.AppPasswordTable,
+			Columns: []string{session.AppPasswordColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(apppassword.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AppPasswordIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   session.AppPasswordTable,
+			Columns: []string{session.AppPasswordColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(apppassword.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{session.Label}
@@ -380,6 +435,25 @@ func (_u *SessionUpdateOne) SetUser(v *User) *SessionUpdateOne {
 	return _u.SetUserID(v.ID)
 }
 
+// SetAppPasswordID sets the "app_password" edge to the AppPassword entity by ID.
+func (_u *SessionUpdateOne) SetAppPasswordID(id uuid.UUID) *SessionUpdateOne {
+	_u.mutation.SetAppPasswordID(id)
+	return _u
+}
+
+// SetNillableAppPasswordID sets the "app_password" edge to the AppPassword entity by ID if the given value is not nil.
+func (_u *SessionUpdateOne) SetNillableAppPasswordID(id *uuid.UUID) *SessionUpdateOne {
+	if id != nil {
+		_u = _u.SetAppPasswordID(*id)
+	}
+	return _u
+}
+
+// SetAppPassword sets the "app_password" edge to the AppPassword entity.
+func (_u *SessionUpdateOne) SetAppPassword(v *AppPassword) *SessionUpdateOne {
+	return _u.SetAppPasswordID(v.ID)
+}
+
 // Mutation returns the SessionMutation object of the builder.
 func (_u *SessionUpdateOne) Mutation() *SessionMutation {
 	return _u.mutation
@@ -391,6 +465,12 @@ func (_u *SessionUpdateOne) ClearUser() *SessionUpdateOne {
 	return _u
 }
 
+// ClearAppPassword clears the "app_password" edge to the AppPassword entity.
+func (_u *SessionUpdateOne) ClearAppPassword() *SessionUpdateOne {
+	_u.mutation.ClearAppPassword()
+	return _u
+}
+
 // Where appends a list predicates to the SessionUpdate builder.
 func (_u *SessionUpdateOne) Where(ps ...predicate.Session) *SessionUpdateOne {
 	_u.mutation.Where(ps...)
@@ -538,6 +618,35 @@ func (_u *SessionUpdateOne) sqlSave(ctx context.Context) (_node *Session, err er
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.AppPasswordCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   session.AppPasswordTable,
+			Columns: []string{session.AppPasswordColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(apppassword.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AppPasswordIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   session.AppPasswordTable,
+			Columns: []string{session.AppPasswordColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(apppassword.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &Session{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -12,14 +12,34 @@ import (
 // Tx is a transactional client that is created by calling Client.Tx().
 type Tx struct {
 	config
+	// ActivityLog is the client for interacting with the ActivityLog builders.
+	ActivityLog *ActivityLogClient
+	// ApiKey is the client for interacting with the ApiKey builders.
+	ApiKey *ApiKeyClient
+	// AppPassword is the client for interacting with the AppPassword builders.
+	AppPassword *AppPasswordClient
 	// Backend is the client for interacting with the Backend builders.
 	Backend *BackendClient
 	// BackendUser is the client for interacting with the BackendUser builders.
 	BackendUser *BackendUserClient
+	// DeviceOption is the client for interacting with the DeviceOption builders.
+	DeviceOption *DeviceOptionClient
+	// DisplayPreference is the client for interacting with the DisplayPreference builders.
+	DisplayPreference *DisplayPreferenceClient
+	// DuplicateItem is the client for interacting with the DuplicateItem builders.
+	DuplicateItem *DuplicateItemClient
+	// Item is the client for interacting with the Item builders.
+	Item *ItemClient
+	// PlaybackEvent is the client for interacting with the PlaybackEvent builders.
+	PlaybackEvent *PlaybackEventClient
+	// RecoveryCode is the client for interacting with the RecoveryCode builders.
+	RecoveryCode *RecoveryCodeClient
 	// Session is the client for interacting with the Session builders.
 	Session *SessionClient
 	// User is the client for interacting with the User builders.
 	User *UserClient
+	// UserConfiguration is the client for interacting with the UserConfiguration builders.
+	UserConfiguration *UserConfigurationClient
 
 	// lazily loaded.
 	client     *Client
@@ -151,10 +171,20 @@ func (tx *Tx) Client() *Client {
 }
 
 func (tx *Tx) init() {
+	tx.ActivityLog = NewActivityLogClient(tx.config)
+	tx.ApiKey = NewApiKeyClient(tx.config)
+	tx.AppPassword = NewAppPasswordClient(tx.config)
 	tx.Backend = NewBackendClient(tx.config)
 	tx.BackendUser = NewBackendUserClient(tx.config)
+	tx.DeviceOption = NewDeviceOptionClient(tx.config)
+	tx.DisplayPreference = NewDisplayPreferenceClient(tx.config)
+	tx.DuplicateItem = NewDuplicateItemClient(tx.config)
+	tx.Item = NewItemClient(tx.config)
+	tx.PlaybackEvent = NewPlaybackEventClient(tx.config)
+	tx.RecoveryCode = NewRecoveryCodeClient(tx.config)
 	tx.Session = NewSessionClient(tx.config)
 	tx.User = NewUserClient(tx.config)
+	tx.UserConfiguration = NewUserConfigurationClient(tx.config)
 }
 
 // txDriver wraps the given dialect.Tx with a nop dialect.Driver implementation.
@@ -164,7 +194,7 @@ func (tx *Tx) init() {
 // of them in order to commit or rollback the transaction.
 //
 // If a closed transaction is embedded in one of the generated entities, and the entity
-// applies a query, for example: Backend.QueryXXX(), the query will be executed
+// applies a query, for example: ActivityLog.QueryXXX(), the query will be executed
 // through the driver which created this transaction.
 //
 // Note that txDriver is not goroutine safe.
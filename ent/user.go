@@ -3,12 +3,14 @@
 package ent
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 	"time"
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
+	"github.com/ddevcap/jellyfin-proxy/access"
 	"github.com/ddevcap/jellyfin-proxy/ent/user"
 	"github.com/google/uuid"
 )
@@ -26,6 +28,8 @@ type User struct {
 	HashedPassword string `json:"-"`
 	// IsAdmin holds the value of the "is_admin" field.
 	IsAdmin bool `json:"is_admin,omitempty"`
+	// AuthSource holds the value of the "auth_source" field.
+	AuthSource string `json:"auth_source,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// UpdatedAt holds the value of the "updated_at" field.
@@ -34,6 +38,32 @@ type User struct {
 	Avatar *[]byte `json:"avatar,omitempty"`
 	// AvatarContentType holds the value of the "avatar_content_type" field.
 	AvatarContentType *string `json:"avatar_content_type,omitempty"`
+	// OidcSubject holds the value of the "oidc_subject" field.
+	OidcSubject *string `json:"oidc_subject,omitempty"`
+	// AccessRules holds the value of the "access_rules" field.
+	AccessRules access.Rules `json:"access_rules,omitempty"`
+	// IsDisabled holds the value of the "is_disabled" field.
+	IsDisabled bool `json:"is_disabled,omitempty"`
+	// EnableRemoteAccess holds the value of the "enable_remote_access" field.
+	EnableRemoteAccess bool `json:"enable_remote_access,omitempty"`
+	// EnableMediaPlayback holds the value of the "enable_media_playback" field.
+	EnableMediaPlayback bool `json:"enable_media_playback,omitempty"`
+	// EnableVideoPlaybackTranscoding holds the value of the "enable_video_playback_transcoding" field.
+	EnableVideoPlaybackTranscoding bool `json:"enable_video_playback_transcoding,omitempty"`
+	// EnableContentDownloading holds the value of the "enable_content_downloading" field.
+	EnableContentDownloading bool `json:"enable_content_downloading,omitempty"`
+	// RemoteClientBitrateLimit holds the value of the "remote_client_bitrate_limit" field.
+	RemoteClientBitrateLimit *int `json:"remote_client_bitrate_limit,omitempty"`
+	// FailedLoginCount holds the value of the "failed_login_count" field.
+	FailedLoginCount int `json:"failed_login_count,omitempty"`
+	// LockedUntil holds the value of the "locked_until" field.
+	LockedUntil *time.Time `json:"locked_until,omitempty"`
+	// TotpSecret holds the value of the "totp_secret" field.
+	TotpSecret *string `json:"-"`
+	// TotpEnabled holds the value of the "totp_enabled" field.
+	TotpEnabled bool `json:"totp_enabled,omitempty"`
+	// TotpLastStep holds the value of the "totp_last_step" field.
+	TotpLastStep int64 `json:"totp_last_step,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the UserQuery when eager-loading is set.
 	Edges        UserEdges `json:"edges"`
@@ -46,9 +76,15 @@ type UserEdges struct {
 	Sessions []*Session `json:"sessions,omitempty"`
 	// BackendUsers holds the value of the backend_users edge.
 	BackendUsers []*BackendUser `json:"backend_users,omitempty"`
+	// APIKeys holds the value of the api_keys edge.
+	APIKeys []*ApiKey `json:"api_keys,omitempty"`
+	// RecoveryCodes holds the value of the recovery_codes edge.
+	RecoveryCodes []*RecoveryCode `json:"recovery_codes,omitempty"`
+	// AppPasswords holds the value of the app_passwords edge.
+	AppPasswords []*AppPassword `json:"app_passwords,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [2]bool
+	loadedTypes [5]bool
 }
 
 // SessionsOrErr returns the Sessions value or an error if the edge
@@ -69,18 +105,47 @@ func (e UserEdges) BackendUsersOrErr() ([]*BackendUser, error) {
 	return nil, &NotLoadedError{edge: "backend_users"}
 }
 
+// APIKeysOrErr returns the APIKeys value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) APIKeysOrErr() ([]*ApiKey, error) {
+	if e.loadedTypes[2] {
+		return e.APIKeys, nil
+	}
+	return nil, &NotLoadedError{edge: "api_keys"}
+}
+
+// RecoveryCodesOrErr returns the RecoveryCodes value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) RecoveryCodesOrErr() ([]*RecoveryCode, error) {
+	if e.loadedTypes[3] {
+		return e.RecoveryCodes, nil
+	}
+	return nil, &NotLoadedError{edge: "recovery_codes"}
+}
+
+// AppPasswordsOrErr returns the AppPasswords value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) AppPasswordsOrErr() ([]*AppPassword, error) {
+	if e.loadedTypes[4] {
+		return e.AppPasswords, nil
+	}
+	return nil, &NotLoadedError{edge: "app_passwords"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*User) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case user.FieldAvatar:
+		case user.FieldAvatar, user.FieldAccessRules:
 			values[i] = new([]byte)
-		case user.FieldIsAdmin:
+		case user.FieldIsAdmin, user.FieldIsDisabled, user.FieldEnableRemoteAccess, user.FieldEnableMediaPlayback, user.FieldEnableVideoPlaybackTranscoding, user.FieldEnableContentDownloading, user.FieldTotpEnabled:
 			values[i] = new(sql.NullBool)
-		case user.FieldUsername, user.FieldDisplayName, user.FieldHashedPassword, user.FieldAvatarContentType:
+		case user.FieldRemoteClientBitrateLimit, user.FieldFailedLoginCount, user.FieldTotpLastStep:
+			values[i] = new(sql.NullInt64)
+		case user.FieldUsername, user.FieldDisplayName, user.FieldHashedPassword, user.FieldAuthSource, user.FieldAvatarContentType, user.FieldOidcSubject, user.FieldTotpSecret:
 			values[i] = new(sql.NullString)
-		case user.FieldCreatedAt, user.FieldUpdatedAt:
+		case user.FieldCreatedAt, user.FieldUpdatedAt, user.FieldLockedUntil:
 			values[i] = new(sql.NullTime)
 		case user.FieldID:
 			values[i] = new(uuid.UUID)
@@ -129,6 +194,12 @@ func (_m *User) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.IsAdmin = value.Bool
 			}
+		case user.FieldAuthSource:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field auth_source", values[i])
+			} else if value.Valid {
+				_m.AuthSource = value.String
+			}
 		case user.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
@@ -154,6 +225,90 @@ func (_m *User) assignValues(columns []string, values []any) error {
 				_m.AvatarContentType = new(string)
 				*_m.AvatarContentType = value.String
 			}
+		case user.FieldOidcSubject:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field oidc_subject", values[i])
+			} else if value.Valid {
+				_m.OidcSubject = new(string)
+				*_m.OidcSubject = value.String
+			}
+		case user.FieldAccessRules:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field access_rules", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.AccessRules); err != nil {
+					return fmt.Errorf("unmarshal field access_rules: %w", err)
+				}
+			}
+		case user.FieldIsDisabled:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field is_disabled", values[i])
+			} else if value.Valid {
+				_m.IsDisabled = value.Bool
+			}
+		case user.FieldEnableRemoteAccess:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field enable_remote_access", values[i])
+			} else if value.Valid {
+				_m.EnableRemoteAccess = value.Bool
+			}
+		case user.FieldEnableMediaPlayback:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field enable_media_playback", values[i])
+			} else if value.Valid {
+				_m.EnableMediaPlayback = value.Bool
+			}
+		case user.FieldEnableVideoPlaybackTranscoding:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field enable_video_playback_transcoding", values[i])
+			} else if value.Valid {
+				_m.EnableVideoPlaybackTranscoding = value.Bool
+			}
+		case user.FieldEnableContentDownloading:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field enable_content_downloading", values[i])
+			} else if value.Valid {
+				_m.EnableContentDownloading = value.Bool
+			}
+		case user.FieldRemoteClientBitrateLimit:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field remote_client_bitrate_limit", values[i])
+			} else if value.Valid {
+				_m.RemoteClientBitrateLimit = new(int)
+				*_m.RemoteClientBitrateLimit = int(value.Int64)
+			}
+		case user.FieldFailedLoginCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field failed_login_count", values[i])
+			} else if value.Valid {
+				_m.FailedLoginCount = int(value.Int64)
+			}
+		case user.FieldLockedUntil:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field locked_until", values[i])
+			} else if value.Valid {
+				_m.LockedUntil = new(time.Time)
+				*_m.LockedUntil = value.Time
+			}
+		case user.FieldTotpSecret:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field totp_secret", values[i])
+			} else if value.Valid {
+				_m.TotpSecret = new(string)
+				*_m.TotpSecret = value.String
+			}
+		case user.FieldTotpEnabled:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field totp_enabled", values[i])
+			} else if value.Valid {
+				_m.TotpEnabled = value.Bool
+			}
+		case user.FieldTotpLastStep:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field totp_last_step", values[i])
+			} else if value.Valid {
+				_m.TotpLastStep = value.Int64
+			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -177,6 +332,21 @@ func (_m *User) QueryBackendUsers() *BackendUserQuery {
 	return NewUserClient(_m.config).QueryBackendUsers(_m)
 }
 
+// QueryAPIKeys queries the "api_keys" edge of the User entity.
+func (_m *User) QueryAPIKeys() *ApiKeyQuery {
+	return NewUserClient(_m.config).QueryAPIKeys(_m)
+}
+
+// QueryRecoveryCodes queries the "recovery_codes" edge of the User entity.
+func (_m *User) QueryRecoveryCodes() *RecoveryCodeQuery {
+	return NewUserClient(_m.config).QueryRecoveryCodes(_m)
+}
+
+// QueryAppPasswords queries the "app_passwords" edge of the User entity.
+func (_m *User) QueryAppPasswords() *AppPasswordQuery {
+	return NewUserClient(_m.config).QueryAppPasswords(_m)
+}
+
 // Update returns a builder for updating this User.
 // Note that you need to call User.Unwrap() before calling this method if this User
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -211,6 +381,9 @@ func (_m *User) String() string {
 	builder.WriteString("is_admin=")
 	builder.WriteString(fmt.Sprintf("%v", _m.IsAdmin))
 	builder.WriteString(", ")
+	builder.WriteString("auth_source=")
+	builder.WriteString(_m.AuthSource)
+	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
 	builder.WriteString(", ")
@@ -226,6 +399,50 @@ func (_m *User) String() string {
 		builder.WriteString("avatar_content_type=")
 		builder.WriteString(*v)
 	}
+	builder.WriteString(", ")
+	if v := _m.OidcSubject; v != nil {
+		builder.WriteString("oidc_subject=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("access_rules=")
+	builder.WriteString(fmt.Sprintf("%v", _m.AccessRules))
+	builder.WriteString(", ")
+	builder.WriteString("is_disabled=")
+	builder.WriteString(fmt.Sprintf("%v", _m.IsDisabled))
+	builder.WriteString(", ")
+	builder.WriteString("enable_remote_access=")
+	builder.WriteString(fmt.Sprintf("%v", _m.EnableRemoteAccess))
+	builder.WriteString(", ")
+	builder.WriteString("enable_media_playback=")
+	builder.WriteString(fmt.Sprintf("%v", _m.EnableMediaPlayback))
+	builder.WriteString(", ")
+	builder.WriteString("enable_video_playback_transcoding=")
+	builder.WriteString(fmt.Sprintf("%v", _m.EnableVideoPlaybackTranscoding))
+	builder.WriteString(", ")
+	builder.WriteString("enable_content_downloading=")
+	builder.WriteString(fmt.Sprintf("%v", _m.EnableContentDownloading))
+	builder.WriteString(", ")
+	if v := _m.RemoteClientBitrateLimit; v != nil {
+		builder.WriteString("remote_client_bitrate_limit=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("failed_login_count=")
+	builder.WriteString(fmt.Sprintf("%v", _m.FailedLoginCount))
+	builder.WriteString(", ")
+	if v := _m.LockedUntil; v != nil {
+		builder.WriteString("locked_until=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("totp_secret=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("totp_enabled=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TotpEnabled))
+	builder.WriteString(", ")
+	builder.WriteString("totp_last_step=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TotpLastStep))
 	builder.WriteByte(')')
 	return builder.String()
 }
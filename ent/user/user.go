@@ -23,6 +23,8 @@ const (
 	FieldHashedPassword = "hashed_password"
 	// FieldIsAdmin holds the string denoting the is_admin field in the database.
 	FieldIsAdmin = "is_admin"
+	// FieldAuthSource holds the string denoting the auth_source field in the database.
+	FieldAuthSource = "auth_source"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
 	FieldCreatedAt = "created_at"
 	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
@@ -31,10 +33,42 @@ const (
 	FieldAvatar = "avatar"
 	// FieldAvatarContentType holds the string denoting the avatar_content_type field in the database.
 	FieldAvatarContentType = "avatar_content_type"
+	// FieldOidcSubject holds the string denoting the oidc_subject field in the database.
+	FieldOidcSubject = "oidc_subject"
+	// FieldAccessRules holds the string denoting the access_rules field in the database.
+	FieldAccessRules = "access_rules"
+	// FieldIsDisabled holds the string denoting the is_disabled field in the database.
+	FieldIsDisabled = "is_disabled"
+	// FieldEnableRemoteAccess holds the string denoting the enable_remote_access field in the database.
+	FieldEnableRemoteAccess = "enable_remote_access"
+	// FieldEnableMediaPlayback holds the string denoting the enable_media_playback field in the database.
+	FieldEnableMediaPlayback = "enable_media_playback"
+	// FieldEnableVideoPlaybackTranscoding holds the string denoting the enable_video_playback_transcoding field in the database.
+	FieldEnableVideoPlaybackTranscoding = "enable_video_playback_transcoding"
+	// FieldEnableContentDownloading holds the string denoting the enable_content_downloading field in the database.
+	FieldEnableContentDownloading = "enable_content_downloading"
+	// FieldRemoteClientBitrateLimit holds the string denoting the remote_client_bitrate_limit field in the database.
+	FieldRemoteClientBitrateLimit = "remote_client_bitrate_limit"
+	// FieldFailedLoginCount holds the string denoting the failed_login_count field in the database.
+	FieldFailedLoginCount = "failed_login_count"
+	// FieldLockedUntil holds the string denoting the locked_until field in the database.
+	FieldLockedUntil = "locked_until"
+	// FieldTotpSecret holds the string denoting the totp_secret field in the database.
+	FieldTotpSecret = "totp_secret"
+	// FieldTotpEnabled holds the string denoting the totp_enabled field in the database.
+	FieldTotpEnabled = "totp_enabled"
+	// FieldTotpLastStep holds the string denoting the totp_last_step field in the database.
+	FieldTotpLastStep = "totp_last_step"
 	// EdgeSessions holds the string denoting the sessions edge name in mutations.
 	EdgeSessions = "sessions"
 	// EdgeBackendUsers holds the string denoting the backend_users edge name in mutations.
 	EdgeBackendUsers = "backend_users"
+	// EdgeAPIKeys holds the string denoting the api_keys edge name in mutations.
+	EdgeAPIKeys = "api_keys"
+	// EdgeRecoveryCodes holds the string denoting the recovery_codes edge name in mutations.
+	EdgeRecoveryCodes = "recovery_codes"
+	// EdgeAppPasswords holds the string denoting the app_passwords edge name in mutations.
+	EdgeAppPasswords = "app_passwords"
 	// Table holds the table name of the user in the database.
 	Table = "users"
 	// SessionsTable is the table that holds the sessions relation/edge.
@@ -51,6 +85,27 @@ const (
 	BackendUsersInverseTable = "backend_users"
 	// BackendUsersColumn is the table column denoting the backend_users relation/edge.
 	BackendUsersColumn = "user_backend_users"
+	// APIKeysTable is the table that holds the api_keys relation/edge.
+	APIKeysTable = "api_keys"
+	// APIKeysInverseTable is the table name for the ApiKey entity.
+	// It exists in this package in order to avoid circular dependency with the "apikey" package.
+	APIKeysInverseTable = "api_keys"
+	// APIKeysColumn is the table column denoting the api_keys relation/edge.
+	APIKeysColumn = "user_api_keys"
+	// RecoveryCodesTable is the table that holds the recovery_codes relation/edge.
+	RecoveryCodesTable = "recovery_codes"
+	// RecoveryCodesInverseTable is the table name for the RecoveryCode entity.
+	// It exists in this package in order to avoid circular dependency with the "recoverycode" package.
+	RecoveryCodesInverseTable = "recovery_codes"
+	// RecoveryCodesColumn is the table column denoting the recovery_codes relation/edge.
+	RecoveryCodesColumn = "user_recovery_codes"
+	// AppPasswordsTable is the table that holds the app_passwords relation/edge.
+	AppPasswordsTable = "app_passwords"
+	// AppPasswordsInverseTable is the table name for the AppPassword entity.
+	// It exists in this package in order to avoid circular dependency with the "apppassword" package.
+	AppPasswordsInverseTable = "app_passwords"
+	// AppPasswordsColumn is the table column denoting the app_passwords relation/edge.
+	AppPasswordsColumn = "user_app_passwords"
 )
 
 // Columns holds all SQL columns for user fields.
@@ -60,10 +115,24 @@ var Columns = []string{
 	FieldDisplayName,
 	FieldHashedPassword,
 	FieldIsAdmin,
+	FieldAuthSource,
 	FieldCreatedAt,
 	FieldUpdatedAt,
 	FieldAvatar,
 	FieldAvatarContentType,
+	FieldOidcSubject,
+	FieldAccessRules,
+	FieldIsDisabled,
+	FieldEnableRemoteAccess,
+	FieldEnableMediaPlayback,
+	FieldEnableVideoPlaybackTranscoding,
+	FieldEnableContentDownloading,
+	FieldRemoteClientBitrateLimit,
+	FieldFailedLoginCount,
+	FieldLockedUntil,
+	FieldTotpSecret,
+	FieldTotpEnabled,
+	FieldTotpLastStep,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -85,12 +154,30 @@ var (
 	HashedPasswordValidator func(string) error
 	// DefaultIsAdmin holds the default value on creation for the "is_admin" field.
 	DefaultIsAdmin bool
+	// DefaultAuthSource holds the default value on creation for the "auth_source" field.
+	DefaultAuthSource string
 	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
 	DefaultCreatedAt func() time.Time
 	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
 	DefaultUpdatedAt func() time.Time
 	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
 	UpdateDefaultUpdatedAt func() time.Time
+	// DefaultIsDisabled holds the default value on creation for the "is_disabled" field.
+	DefaultIsDisabled bool
+	// DefaultEnableRemoteAccess holds the default value on creation for the "enable_remote_access" field.
+	DefaultEnableRemoteAccess bool
+	// DefaultEnableMediaPlayback holds the default value on creation for the "enable_media_playback" field.
+	DefaultEnableMediaPlayback bool
+	// DefaultEnableVideoPlaybackTranscoding holds the default value on creation for the "enable_video_playback_transcoding" field.
+	DefaultEnableVideoPlaybackTranscoding bool
+	// DefaultEnableContentDownloading holds the default value on creation for the "enable_content_downloading" field.
+	DefaultEnableContentDownloading bool
+	// DefaultFailedLoginCount holds the default value on creation for the "failed_login_count" field.
+	DefaultFailedLoginCount int
+	// DefaultTotpEnabled holds the default value on creation for the "totp_enabled" field.
+	DefaultTotpEnabled bool
+	// DefaultTotpLastStep holds the default value on creation for the "totp_last_step" field.
+	DefaultTotpLastStep int64
 	// DefaultID holds the default value on creation for the "id" field.
 	DefaultID func() uuid.UUID
 )
@@ -123,6 +210,11 @@ func ByIsAdmin(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldIsAdmin, opts...).ToFunc()
 }
 
+// ByAuthSource orders the results by the auth_source field.
+func ByAuthSource(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAuthSource, opts...).ToFunc()
+}
+
 // ByCreatedAt orders the results by the created_at field.
 func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
@@ -138,6 +230,66 @@ func ByAvatarContentType(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldAvatarContentType, opts...).ToFunc()
 }
 
+// ByOidcSubject orders the results by the oidc_subject field.
+func ByOidcSubject(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOidcSubject, opts...).ToFunc()
+}
+
+// ByIsDisabled orders the results by the is_disabled field.
+func ByIsDisabled(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldIsDisabled, opts...).ToFunc()
+}
+
+// ByEnableRemoteAccess orders the results by the enable_remote_access field.
+func ByEnableRemoteAccess(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEnableRemoteAccess, opts...).ToFunc()
+}
+
+// ByEnableMediaPlayback orders the results by the enable_media_playback field.
+func ByEnableMediaPlayback(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEnableMediaPlayback, opts...).ToFunc()
+}
+
+// ByEnableVideoPlaybackTranscoding orders the results by the enable_video_playback_transcoding field.
+func ByEnableVideoPlaybackTranscoding(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEnableVideoPlaybackTranscoding, opts...).ToFunc()
+}
+
+// ByEnableContentDownloading orders the results by the enable_content_downloading field.
+func ByEnableContentDownloading(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEnableContentDownloading, opts...).ToFunc()
+}
+
+// ByRemoteClientBitrateLimit orders the results by the remote_client_bitrate_limit field.
+func ByRemoteClientBitrateLimit(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRemoteClientBitrateLimit, opts...).ToFunc()
+}
+
+// ByFailedLoginCount orders the results by the failed_login_count field.
+func ByFailedLoginCount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFailedLoginCount, opts...).ToFunc()
+}
+
+// ByLockedUntil orders the results by the locked_until field.
+func ByLockedUntil(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLockedUntil, opts...).ToFunc()
+}
+
+// ByTotpSecret orders the results by the totp_secret field.
+func ByTotpSecret(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTotpSecret, opts...).ToFunc()
+}
+
+// ByTotpEnabled orders the results by the totp_enabled field.
+func ByTotpEnabled(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTotpEnabled, opts...).ToFunc()
+}
+
+// ByTotpLastStep orders the results by the totp_last_step field.
+func ByTotpLastStep(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTotpLastStep, opts...).ToFunc()
+}
+
 // BySessionsCount orders the results by sessions count.
 func BySessionsCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -165,6 +317,48 @@ func ByBackendUsers(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newBackendUsersStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// ByAPIKeysCount orders the results by api_keys count.
+func ByAPIKeysCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newAPIKeysStep(), opts...)
+	}
+}
+
+// ByAPIKeys orders the results by api_keys terms.
+func ByAPIKeys(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newAPIKeysStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByRecoveryCodesCount orders the results by recovery_codes count.
+func ByRecoveryCodesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newRecoveryCodesStep(), opts...)
+	}
+}
+
+// ByRecoveryCodes orders the results by recovery_codes terms.
+func ByRecoveryCodes(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newRecoveryCodesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByAppPasswordsCount orders the results by app_passwords count.
+func ByAppPasswordsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newAppPasswordsStep(), opts...)
+	}
+}
+
+// ByAppPasswords orders the results by app_passwords terms.
+func ByAppPasswords(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newAppPasswordsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newSessionsStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -179,3 +373,24 @@ func newBackendUsersStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, BackendUsersTable, BackendUsersColumn),
 	)
 }
+func newAPIKeysStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(APIKeysInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, APIKeysTable, APIKeysColumn),
+	)
+}
+func newRecoveryCodesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(RecoveryCodesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, RecoveryCodesTable, RecoveryCodesColumn),
+	)
+}
+func newAppPasswordsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(AppPasswordsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, AppPasswordsTable, AppPasswordsColumn),
+	)
+}
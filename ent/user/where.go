@@ -76,6 +76,11 @@ func IsAdmin(v bool) predicate.User {
 	return predicate.User(sql.FieldEQ(FieldIsAdmin, v))
 }
 
+// AuthSource applies equality check predicate on the "auth_source" field. It's identical to AuthSourceEQ.
+func AuthSource(v string) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldAuthSource, v))
+}
+
 // CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
 func CreatedAt(v time.Time) predicate.User {
 	return predicate.User(sql.FieldEQ(FieldCreatedAt, v))
@@ -96,6 +101,66 @@ func AvatarContentType(v string) predicate.User {
 	return predicate.User(sql.FieldEQ(FieldAvatarContentType, v))
 }
 
+// OidcSubject applies equality check predicate on the "oidc_subject" field. It's identical to OidcSubjectEQ.
+func OidcSubject(v string) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldOidcSubject, v))
+}
+
+// IsDisabled applies equality check predicate on the "is_disabled" field. It's identical to IsDisabledEQ.
+func IsDisabled(v bool) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldIsDisabled, v))
+}
+
+// EnableRemoteAccess applies equality check predicate on the "enable_remote_access" field. It's identical to EnableRemoteAccessEQ.
+func EnableRemoteAccess(v bool) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldEnableRemoteAccess, v))
+}
+
+// EnableMediaPlayback applies equality check predicate on the "enable_media_playback" field. It's identical to EnableMediaPlaybackEQ.
+func EnableMediaPlayback(v bool) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldEnableMediaPlayback, v))
+}
+
+// EnableVideoPlaybackTranscoding applies equality check predicate on the "enable_video_playback_transcoding" field. It's identical to EnableVideoPlaybackTranscodingEQ.
+func EnableVideoPlaybackTranscoding(v bool) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldEnableVideoPlaybackTranscoding, v))
+}
+
+// EnableContentDownloading applies equality check predicate on the "enable_content_downloading" field. It's identical to EnableContentDownloadingEQ.
+func EnableContentDownloading(v bool) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldEnableContentDownloading, v))
+}
+
+// RemoteClientBitrateLimit applies equality check predicate on the "remote_client_bitrate_limit" field. It's identical to RemoteClientBitrateLimitEQ.
+func RemoteClientBitrateLimit(v int) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldRemoteClientBitrateLimit, v))
+}
+
+// FailedLoginCount applies equality check predicate on the "failed_login_count" field. It's identical to FailedLoginCountEQ.
+func FailedLoginCount(v int) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldFailedLoginCount, v))
+}
+
+// LockedUntil applies equality check predicate on the "locked_until" field. It's identical to LockedUntilEQ.
+func LockedUntil(v time.Time) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldLockedUntil, v))
+}
+
+// TotpSecret applies equality check predicate on the "totp_secret" field. It's identical to TotpSecretEQ.
+func TotpSecret(v string) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldTotpSecret, v))
+}
+
+// TotpEnabled applies equality check predicate on the "totp_enabled" field. It's identical to TotpEnabledEQ.
+func TotpEnabled(v bool) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldTotpEnabled, v))
+}
+
+// TotpLastStep applies equality check predicate on the "totp_last_step" field. It's identical to TotpLastStepEQ.
+func TotpLastStep(v int64) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldTotpLastStep, v))
+}
+
 // UsernameEQ applies the EQ predicate on the "username" field.
 func UsernameEQ(v string) predicate.User {
 	return predicate.User(sql.FieldEQ(FieldUsername, v))
@@ -301,6 +366,71 @@ func IsAdminNEQ(v bool) predicate.User {
 	return predicate.User(sql.FieldNEQ(FieldIsAdmin, v))
 }
 
+// AuthSourceEQ applies the EQ predicate on the "auth_source" field.
+func AuthSourceEQ(v string) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldAuthSource, v))
+}
+
+// AuthSourceNEQ applies the NEQ predicate on the "auth_source" field.
+func AuthSourceNEQ(v string) predicate.User {
+	return predicate.User(sql.FieldNEQ(FieldAuthSource, v))
+}
+
+// AuthSourceIn applies the In predicate on the "auth_source" field.
+func AuthSourceIn(vs ...string) predicate.User {
+	return predicate.User(sql.FieldIn(FieldAuthSource, vs...))
+}
+
+// AuthSourceNotIn applies the NotIn predicate on the "auth_source" field.
+func AuthSourceNotIn(vs ...string) predicate.User {
+	return predicate.User(sql.FieldNotIn(FieldAuthSource, vs...))
+}
+
+// AuthSourceGT applies the GT predicate on the "auth_source" field.
+func AuthSourceGT(v string) predicate.User {
+	return predicate.User(sql.FieldGT(FieldAuthSource, v))
+}
+
+// AuthSourceGTE applies the GTE predicate on the "auth_source" field.
+func AuthSourceGTE(v string) predicate.User {
+	return predicate.User(sql.FieldGTE(FieldAuthSource, v))
+}
+
+// AuthSourceLT applies the LT predicate on the "auth_source" field.
+func AuthSourceLT(v string) predicate.User {
+	return predicate.User(sql.FieldLT(FieldAuthSource, v))
+}
+
+// AuthSourceLTE applies the LTE predicate on the "auth_source" field.
+func AuthSourceLTE(v string) predicate.User {
+	return predicate.User(sql.FieldLTE(FieldAuthSource, v))
+}
+
+// AuthSourceContains applies the Contains predicate on the "auth_source" field.
+func AuthSourceContains(v string) predicate.User {
+	return predicate.User(sql.FieldContains(FieldAuthSource, v))
+}
+
+// AuthSourceHasPrefix applies the HasPrefix predicate on the "auth_source" field.
+func AuthSourceHasPrefix(v string) predicate.User {
+	return predicate.User(sql.FieldHasPrefix(FieldAuthSource, v))
+}
+
+// AuthSourceHasSuffix applies the HasSuffix predicate on the "auth_source" field.
+func AuthSourceHasSuffix(v string) predicate.User {
+	return predicate.User(sql.FieldHasSuffix(FieldAuthSource, v))
+}
+
+// AuthSourceEqualFold applies the EqualFold predicate on the "auth_source" field.
+func AuthSourceEqualFold(v string) predicate.User {
+	return predicate.User(sql.FieldEqualFold(FieldAuthSource, v))
+}
+
+// AuthSourceContainsFold applies the ContainsFold predicate on the "auth_source" field.
+func AuthSourceContainsFold(v string) predicate.User {
+	return predicate.User(sql.FieldContainsFold(FieldAuthSource, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.User {
 	return predicate.User(sql.FieldEQ(FieldCreatedAt, v))
@@ -506,6 +636,406 @@ func AvatarContentTypeContainsFold(v string) predicate.User {
 	return predicate.User(sql.FieldContainsFold(FieldAvatarContentType, v))
 }
 
+// OidcSubjectEQ applies the EQ predicate on the "oidc_subject" field.
+func OidcSubjectEQ(v string) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldOidcSubject, v))
+}
+
+// OidcSubjectNEQ applies the NEQ predicate on the "oidc_subject" field.
+func OidcSubjectNEQ(v string) predicate.User {
+	return predicate.User(sql.FieldNEQ(FieldOidcSubject, v))
+}
+
+// OidcSubjectIn applies the In predicate on the "oidc_subject" field.
+func OidcSubjectIn(vs ...string) predicate.User {
+	return predicate.User(sql.FieldIn(FieldOidcSubject, vs...))
+}
+
+// OidcSubjectNotIn applies the NotIn predicate on the "oidc_subject" field.
+func OidcSubjectNotIn(vs ...string) predicate.User {
+	return predicate.User(sql.FieldNotIn(FieldOidcSubject, vs...))
+}
+
+// OidcSubjectGT applies the GT predicate on the "oidc_subject" field.
+func OidcSubjectGT(v string) predicate.User {
+	return predicate.User(sql.FieldGT(FieldOidcSubject, v))
+}
+
+// OidcSubjectGTE applies the GTE predicate on the "oidc_subject" field.
+func OidcSubjectGTE(v string) predicate.User {
+	return predicate.User(sql.FieldGTE(FieldOidcSubject, v))
+}
+
+// OidcSubjectLT applies the LT predicate on the "oidc_subject" field.
+func OidcSubjectLT(v string) predicate.User {
+	return predicate.User(sql.FieldLT(FieldOidcSubject, v))
+}
+
+// OidcSubjectLTE applies the LTE predicate on the "oidc_subject" field.
+func OidcSubjectLTE(v string) predicate.User {
+	return predicate.User(sql.FieldLTE(FieldOidcSubject, v))
+}
+
+// OidcSubjectContains applies the Contains predicate on the "oidc_subject" field.
+func OidcSubjectContains(v string) predicate.User {
+	return predicate.User(sql.FieldContains(FieldOidcSubject, v))
+}
+
+// OidcSubjectHasPrefix applies the HasPrefix predicate on the "oidc_subject" field.
+func OidcSubjectHasPrefix(v string) predicate.User {
+	return predicate.User(sql.FieldHasPrefix(FieldOidcSubject, v))
+}
+
+// OidcSubjectHasSuffix applies the HasSuffix predicate on the "oidc_subject" field.
+func OidcSubjectHasSuffix(v string) predicate.User {
+	return predicate.User(sql.FieldHasSuffix(FieldOidcSubject, v))
+}
+
+// OidcSubjectIsNil applies the IsNil predicate on the "oidc_subject" field.
+func OidcSubjectIsNil() predicate.User {
+	return predicate.User(sql.FieldIsNull(FieldOidcSubject))
+}
+
+// OidcSubjectNotNil applies the NotNil predicate on the "oidc_subject" field.
+func OidcSubjectNotNil() predicate.User {
+	return predicate.User(sql.FieldNotNull(FieldOidcSubject))
+}
+
+// OidcSubjectEqualFold applies the EqualFold predicate on the "oidc_subject" field.
+func OidcSubjectEqualFold(v string) predicate.User {
+	return predicate.User(sql.FieldEqualFold(FieldOidcSubject, v))
+}
+
+// OidcSubjectContainsFold applies the ContainsFold predicate on the "oidc_subject" field.
+func OidcSubjectContainsFold(v string) predicate.User {
+	return predicate.User(sql.FieldContainsFold(FieldOidcSubject, v))
+}
+
+// AccessRulesIsNil applies the IsNil predicate on the "access_rules" field.
+func AccessRulesIsNil() predicate.User {
+	return predicate.User(sql.FieldIsNull(FieldAccessRules))
+}
+
+// AccessRulesNotNil applies the NotNil predicate on the "access_rules" field.
+func AccessRulesNotNil() predicate.User {
+	return predicate.User(sql.FieldNotNull(FieldAccessRules))
+}
+
+// IsDisabledEQ applies the EQ predicate on the "is_disabled" field.
+func IsDisabledEQ(v bool) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldIsDisabled, v))
+}
+
+// IsDisabledNEQ applies the NEQ predicate on the "is_disabled" field.
+func IsDisabledNEQ(v bool) predicate.User {
+	return predicate.User(sql.FieldNEQ(FieldIsDisabled, v))
+}
+
+// EnableRemoteAccessEQ applies the EQ predicate on the "enable_remote_access" field.
+func EnableRemoteAccessEQ(v bool) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldEnableRemoteAccess, v))
+}
+
+// EnableRemoteAccessNEQ applies the NEQ predicate on the "enable_remote_access" field.
+func EnableRemoteAccessNEQ(v bool) predicate.User {
+	return predicate.User(sql.FieldNEQ(FieldEnableRemoteAccess, v))
+}
+
+// EnableMediaPlaybackEQ applies the EQ predicate on the "enable_media_playback" field.
+func EnableMediaPlaybackEQ(v bool) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldEnableMediaPlayback, v))
+}
+
+// EnableMediaPlaybackNEQ applies the NEQ predicate on the "enable_media_playback" field.
+func EnableMediaPlaybackNEQ(v bool) predicate.User {
+	return predicate.User(sql.FieldNEQ(FieldEnableMediaPlayback, v))
+}
+
+// EnableVideoPlaybackTranscodingEQ applies the EQ predicate on the "enable_video_playback_transcoding" field.
+func EnableVideoPlaybackTranscodingEQ(v bool) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldEnableVideoPlaybackTranscoding, v))
+}
+
+// EnableVideoPlaybackTranscodingNEQ applies the NEQ predicate on the "enable_video_playback_transcoding" field.
+func EnableVideoPlaybackTranscodingNEQ(v bool) predicate.User {
+	return predicate.User(sql.FieldNEQ(FieldEnableVideoPlaybackTranscoding, v))
+}
+
+// EnableContentDownloadingEQ applies the EQ predicate on the "enable_content_downloading" field.
+func EnableContentDownloadingEQ(v bool) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldEnableContentDownloading, v))
+}
+
+// EnableContentDownloadingNEQ applies the NEQ predicate on the "enable_content_downloading" field.
+func EnableContentDownloadingNEQ(v bool) predicate.User {
+	return predicate.User(sql.FieldNEQ(FieldEnableContentDownloading, v))
+}
+
+// RemoteClientBitrateLimitEQ applies the EQ predicate on the "remote_client_bitrate_limit" field.
+func RemoteClientBitrateLimitEQ(v int) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldRemoteClientBitrateLimit, v))
+}
+
+// RemoteClientBitrateLimitNEQ applies the NEQ predicate on the "remote_client_bitrate_limit" field.
+func RemoteClientBitrateLimitNEQ(v int) predicate.User {
+	return predicate.User(sql.FieldNEQ(FieldRemoteClientBitrateLimit, v))
+}
+
+// RemoteClientBitrateLimitIn applies the In predicate on the "remote_client_bitrate_limit" field.
+func RemoteClientBitrateLimitIn(vs ...int) predicate.User {
+	return predicate.User(sql.FieldIn(FieldRemoteClientBitrateLimit, vs...))
+}
+
+// RemoteClientBitrateLimitNotIn applies the NotIn predicate on the "remote_client_bitrate_limit" field.
+func RemoteClientBitrateLimitNotIn(vs ...int) predicate.User {
+	return predicate.User(sql.FieldNotIn(FieldRemoteClientBitrateLimit, vs...))
+}
+
+// RemoteClientBitrateLimitGT applies the GT predicate on the "remote_client_bitrate_limit" field.
+func RemoteClientBitrateLimitGT(v int) predicate.User {
+	return predicate.User(sql.FieldGT(FieldRemoteClientBitrateLimit, v))
+}
+
+// RemoteClientBitrateLimitGTE applies the GTE predicate on the "remote_client_bitrate_limit" field.
+func RemoteClientBitrateLimitGTE(v int) predicate.User {
+	return predicate.User(sql.FieldGTE(FieldRemoteClientBitrateLimit, v))
+}
+
+// RemoteClientBitrateLimitLT applies the LT predicate on the "remote_client_bitrate_limit" field.
+func RemoteClientBitrateLimitLT(v int) predicate.User {
+	return predicate.User(sql.FieldLT(FieldRemoteClientBitrateLimit, v))
+}
+
+// RemoteClientBitrateLimitLTE applies the LTE predicate on the "remote_client_bitrate_limit" field.
+func RemoteClientBitrateLimitLTE(v int) predicate.User {
+	return predicate.User(sql.FieldLTE(FieldRemoteClientBitrateLimit, v))
+}
+
+// RemoteClientBitrateLimitIsNil applies the IsNil predicate on the "remote_client_bitrate_limit" field.
+func RemoteClientBitrateLimitIsNil() predicate.User {
+	return predicate.User(sql.FieldIsNull(FieldRemoteClientBitrateLimit))
+}
+
+// RemoteClientBitrateLimitNotNil applies the NotNil predicate on the "remote_client_bitrate_limit" field.
+func RemoteClientBitrateLimitNotNil() predicate.User {
+	return predicate.User(sql.FieldNotNull(FieldRemoteClientBitrateLimit))
+}
+
+// FailedLoginCountEQ applies the EQ predicate on the "failed_login_count" field.
+func FailedLoginCountEQ(v int) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldFailedLoginCount, v))
+}
+
+// FailedLoginCountNEQ applies the NEQ predicate on the "failed_login_count" field.
+func FailedLoginCountNEQ(v int) predicate.User {
+	return predicate.User(sql.FieldNEQ(FieldFailedLoginCount, v))
+}
+
+// FailedLoginCountIn applies the In predicate on the "failed_login_count" field.
+func FailedLoginCountIn(vs ...int) predicate.User {
+	return predicate.User(sql.FieldIn(FieldFailedLoginCount, vs...))
+}
+
+// FailedLoginCountNotIn applies the NotIn predicate on the "failed_login_count" field.
+func FailedLoginCountNotIn(vs ...int) predicate.User {
+	return predicate.User(sql.FieldNotIn(FieldFailedLoginCount, vs...))
+}
+
+// FailedLoginCountGT applies the GT predicate on the "failed_login_count" field.
+func FailedLoginCountGT(v int) predicate.User {
+	return predicate.User(sql.FieldGT(FieldFailedLoginCount, v))
+}
+
+// FailedLoginCountGTE applies the GTE predicate on the "failed_login_count" field.
+func FailedLoginCountGTE(v int) predicate.User {
+	return predicate.User(sql.FieldGTE(FieldFailedLoginCount, v))
+}
+
+// FailedLoginCountLT applies the LT predicate on the "failed_login_count" field.
+func FailedLoginCountLT(v int) predicate.User {
+	return predicate.User(sql.FieldLT(FieldFailedLoginCount, v))
+}
+
+// FailedLoginCountLTE applies the LTE predicate on the "failed_login_count" field.
+func FailedLoginCountLTE(v int) predicate.User {
+	return predicate.User(sql.FieldLTE(FieldFailedLoginCount, v))
+}
+
+// LockedUntilEQ applies the EQ predicate on the "locked_until" field.
+func LockedUntilEQ(v time.Time) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldLockedUntil, v))
+}
+
+// LockedUntilNEQ applies the NEQ predicate on the "locked_until" field.
+func LockedUntilNEQ(v time.Time) predicate.User {
+	return predicate.User(sql.FieldNEQ(FieldLockedUntil, v))
+}
+
+// LockedUntilIn applies the In predicate on the "locked_until" field.
+func LockedUntilIn(vs ...time.Time) predicate.User {
+	return predicate.User(sql.FieldIn(FieldLockedUntil, vs...))
+}
+
+// LockedUntilNotIn applies the NotIn predicate on the "locked_until" field.
+func LockedUntilNotIn(vs ...time.Time) predicate.User {
+	return predicate.User(sql.FieldNotIn(FieldLockedUntil, vs...))
+}
+
+// LockedUntilGT applies the GT predicate on the "locked_until" field.
+func LockedUntilGT(v time.Time) predicate.User {
+	return predicate.User(sql.FieldGT(FieldLockedUntil, v))
+}
+
+// LockedUntilGTE applies the GTE predicate on the "locked_until" field.
+func LockedUntilGTE(v time.Time) predicate.User {
+	return predicate.User(sql.FieldGTE(FieldLockedUntil, v))
+}
+
+// LockedUntilLT applies the LT predicate on the "locked_until" field.
+func LockedUntilLT(v time.Time) predicate.User {
+	return predicate.User(sql.FieldLT(FieldLockedUntil, v))
+}
+
+// LockedUntilLTE applies the LTE predicate on the "locked_until" field.
+func LockedUntilLTE(v time.Time) predicate.User {
+	return predicate.User(sql.FieldLTE(FieldLockedUntil, v))
+}
+
+// LockedUntilIsNil applies the IsNil predicate on the "locked_until" field.
+func LockedUntilIsNil() predicate.User {
+	return predicate.User(sql.FieldIsNull(FieldLockedUntil))
+}
+
+// LockedUntilNotNil applies the NotNil predicate on the "locked_until" field.
+func LockedUntilNotNil() predicate.User {
+	return predicate.User(sql.FieldNotNull(FieldLockedUntil))
+}
+
+// TotpSecretEQ applies the EQ predicate on the "totp_secret" field.
+func TotpSecretEQ(v string) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldTotpSecret, v))
+}
+
+// TotpSecretNEQ applies the NEQ predicate on the "totp_secret" field.
+func TotpSecretNEQ(v string) predicate.User {
+	return predicate.User(sql.FieldNEQ(FieldTotpSecret, v))
+}
+
+// TotpSecretIn applies the In predicate on the "totp_secret" field.
+func TotpSecretIn(vs ...string) predicate.User {
+	return predicate.User(sql.FieldIn(FieldTotpSecret, vs...))
+}
+
+// TotpSecretNotIn applies the NotIn predicate on the "totp_secret" field.
+func TotpSecretNotIn(vs ...string) predicate.User {
+	return predicate.User(sql.FieldNotIn(FieldTotpSecret, vs...))
+}
+
+// TotpSecretGT applies the GT predicate on the "totp_secret" field.
+func TotpSecretGT(v string) predicate.User {
+	return predicate.User(sql.FieldGT(FieldTotpSecret, v))
+}
+
+// TotpSecretGTE applies the GTE predicate on the "totp_secret" field.
+func TotpSecretGTE(v string) predicate.User {
+	return predicate.User(sql.FieldGTE(FieldTotpSecret, v))
+}
+
+// TotpSecretLT applies the LT predicate on the "totp_secret" field.
+func TotpSecretLT(v string) predicate.User {
+	return predicate.User(sql.FieldLT(FieldTotpSecret, v))
+}
+
+// TotpSecretLTE applies the LTE predicate on the "totp_secret" field.
+func TotpSecretLTE(v string) predicate.User {
+	return predicate.User(sql.FieldLTE(FieldTotpSecret, v))
+}
+
+// TotpSecretContains applies the Contains predicate on the "totp_secret" field.
+func TotpSecretContains(v string) predicate.User {
+	return predicate.User(sql.FieldContains(FieldTotpSecret, v))
+}
+
+// TotpSecretHasPrefix applies the HasPrefix predicate on the "totp_secret" field.
+func TotpSecretHasPrefix(v string) predicate.User {
+	return predicate.User(sql.FieldHasPrefix(FieldTotpSecret, v))
+}
+
+// TotpSecretHasSuffix applies the HasSuffix predicate on the "totp_secret" field.
+func TotpSecretHasSuffix(v string) predicate.User {
+	return predicate.User(sql.FieldHasSuffix(FieldTotpSecret, v))
+}
+
+// TotpSecretIsNil applies the IsNil predicate on the "totp_secret" field.
+func TotpSecretIsNil() predicate.User {
+	return predicate.User(sql.FieldIsNull(FieldTotpSecret))
+}
+
+// TotpSecretNotNil applies the NotNil predicate on the "totp_secret" field.
+func TotpSecretNotNil() predicate.User {
+	return predicate.User(sql.FieldNotNull(FieldTotpSecret))
+}
+
+// TotpSecretEqualFold applies the EqualFold predicate on the "totp_secret" field.
+func TotpSecretEqualFold(v string) predicate.User {
+	return predicate.User(sql.FieldEqualFold(FieldTotpSecret, v))
+}
+
+// TotpSecretContainsFold applies the ContainsFold predicate on the "totp_secret" field.
+func TotpSecretContainsFold(v string) predicate.User {
+	return predicate.User(sql.FieldContainsFold(FieldTotpSecret, v))
+}
+
+// TotpEnabledEQ applies the EQ predicate on the "totp_enabled" field.
+func TotpEnabledEQ(v bool) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldTotpEnabled, v))
+}
+
+// TotpEnabledNEQ applies the NEQ predicate on the "totp_enabled" field.
+func TotpEnabledNEQ(v bool) predicate.User {
+	return predicate.User(sql.FieldNEQ(FieldTotpEnabled, v))
+}
+
+// TotpLastStepEQ applies the EQ predicate on the "totp_last_step" field.
+func TotpLastStepEQ(v int64) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldTotpLastStep, v))
+}
+
+// TotpLastStepNEQ applies the NEQ predicate on the "totp_last_step" field.
+func TotpLastStepNEQ(v int64) predicate.User {
+	return predicate.User(sql.FieldNEQ(FieldTotpLastStep, v))
+}
+
+// TotpLastStepIn applies the In predicate on the "totp_last_step" field.
+func TotpLastStepIn(vs ...int64) predicate.User {
+	return predicate.User(sql.FieldIn(FieldTotpLastStep, vs...))
+}
+
+// TotpLastStepNotIn applies the NotIn predicate on the "totp_last_step" field.
+func TotpLastStepNotIn(vs ...int64) predicate.User {
+	return predicate.User(sql.FieldNotIn(FieldTotpLastStep, vs...))
+}
+
+// TotpLastStepGT applies the GT predicate on the "totp_last_step" field.
+func TotpLastStepGT(v int64) predicate.User {
+	return predicate.User(sql.FieldGT(FieldTotpLastStep, v))
+}
+
+// TotpLastStepGTE applies the GTE predicate on the "totp_last_step" field.
+func TotpLastStepGTE(v int64) predicate.User {
+	return predicate.User(sql.FieldGTE(FieldTotpLastStep, v))
+}
+
+// TotpLastStepLT applies the LT predicate on the "totp_last_step" field.
+func TotpLastStepLT(v int64) predicate.User {
+	return predicate.User(sql.FieldLT(FieldTotpLastStep, v))
+}
+
+// TotpLastStepLTE applies the LTE predicate on the "totp_last_step" field.
+func TotpLastStepLTE(v int64) predicate.User {
+	return predicate.User(sql.FieldLTE(FieldTotpLastStep, v))
+}
+
 // HasSessions applies the HasEdge predicate on the "sessions" edge.
 func HasSessions() predicate.User {
 	return predicate.User(func(s *sql.Selector) {
@@ -552,6 +1082,75 @@ func HasBackendUsersWith(preds ...predicate.BackendUser) predicate.User {
 	})
 }
 
+// HasAPIKeys applies the HasEdge predicate on the "api_keys" edge.
+func HasAPIKeys() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, APIKeysTable, APIKeysColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasAPIKeysWith applies the HasEdge predicate on the "api_keys" edge with a given conditions (other predicates).
+func HasAPIKeysWith(preds ...predicate.ApiKey) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newAPIKeysStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasRecoveryCodes applies the HasEdge predicate on the "recovery_codes" edge.
+func HasRecoveryCodes() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, RecoveryCodesTable, RecoveryCodesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasRecoveryCodesWith applies the HasEdge predicate on the "recovery_codes" edge with a given conditions (other predicates).
+func HasRecoveryCodesWith(preds ...predicate.RecoveryCode) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newRecoveryCodesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasAppPasswords applies the HasEdge predicate on the "app_passwords" edge.
+func HasAppPasswords() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, AppPasswordsTable, AppPasswordsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasAppPasswordsWith applies the HasEdge predicate on the "app_passwords" edge with a given conditions (other predicates).
+func HasAppPasswordsWith(preds ...predicate.AppPassword) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newAppPasswordsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.User) predicate.User {
 	return predicate.User(sql.AndPredicates(predicates...))
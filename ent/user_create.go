@@ -10,7 +10,11 @@ import (
 
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/ddevcap/jellyfin-proxy/access"
+	"github.com/ddevcap/jellyfin-proxy/ent/apikey"
+	"github.com/ddevcap/jellyfin-proxy/ent/apppassword"
 	"github.com/ddevcap/jellyfin-proxy/ent/backenduser"
+	"github.com/ddevcap/jellyfin-proxy/ent/recoverycode"
 	"github.com/ddevcap/jellyfin-proxy/ent/session"
 	"github.com/ddevcap/jellyfin-proxy/ent/user"
 	"github.com/google/uuid"
@@ -55,6 +59,20 @@ func (_c *UserCreate) SetNillableIsAdmin(v *bool) *UserCreate {
 	return _c
 }
 
+// SetAuthSource sets the "auth_source" field.
+func (_c *UserCreate) SetAuthSource(v string) *UserCreate {
+	_c.mutation.SetAuthSource(v)
+	return _c
+}
+
+// SetNillableAuthSource sets the "auth_source" field if the given value is not nil.
+func (_c *UserCreate) SetNillableAuthSource(v *string) *UserCreate {
+	if v != nil {
+		_c.SetAuthSource(*v)
+	}
+	return _c
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (_c *UserCreate) SetCreatedAt(v time.Time) *UserCreate {
 	_c.mutation.SetCreatedAt(v)
@@ -103,6 +121,188 @@ func (_c *UserCreate) SetNillableAvatarContentType(v *string) *UserCreate {
 	return _c
 }
 
+// SetOidcSubject sets the "oidc_subject" field.
+func (_c *UserCreate) SetOidcSubject(v string) *UserCreate {
+	_c.mutation.SetOidcSubject(v)
+	return _c
+}
+
+// SetNillableOidcSubject sets the "oidc_subject" field if the given value is not nil.
+func (_c *UserCreate) SetNillableOidcSubject(v *string) *UserCreate {
+	if v != nil {
+		_c.SetOidcSubject(*v)
+	}
+	return _c
+}
+
+// SetAccessRules sets the "access_rules" field.
+func (_c *UserCreate) SetAccessRules(v access.Rules) *UserCreate {
+	_c.mutation.SetAccessRules(v)
+	return _c
+}
+
+// SetNillableAccessRules sets the "access_rules" field if the given value is not nil.
+func (_c *UserCreate) SetNillableAccessRules(v *access.Rules) *UserCreate {
+	if v != nil {
+		_c.SetAccessRules(*v)
+	}
+	return _c
+}
+
+// SetIsDisabled sets the "is_disabled" field.
+func (_c *UserCreate) SetIsDisabled(v bool) *UserCreate {
+	_c.mutation.SetIsDisabled(v)
+	return _c
+}
+
+// SetNillableIsDisabled sets the "is_disabled" field if the given value is not nil.
+func (_c *UserCreate) SetNillableIsDisabled(v *bool) *UserCreate {
+	if v != nil {
+		_c.SetIsDisabled(*v)
+	}
+	return _c
+}
+
+// SetEnableRemoteAccess sets the "enable_remote_access" field.
+func (_c *UserCreate) SetEnableRemoteAccess(v bool) *UserCreate {
+	_c.mutation.SetEnableRemoteAccess(v)
+	return _c
+}
+
+// SetNillableEnableRemoteAccess sets the "enable_remote_access" field if the given value is not nil.
+func (_c *UserCreate) SetNillableEnableRemoteAccess(v *bool) *UserCreate {
+	if v != nil {
+		_c.SetEnableRemoteAccess(*v)
+	}
+	return _c
+}
+
+// SetEnableMediaPlayback sets the "enable_media_playback" field.
+func (_c *UserCreate) SetEnableMediaPlayback(v bool) *UserCreate {
+	_c.mutation.SetEnableMediaPlayback(v)
+	return _c
+}
+
+// SetNillableEnableMediaPlayback sets the "enable_media_playback" field if the given value is not nil.
+func (_c *UserCreate) SetNillableEnableMediaPlayback(v *bool) *UserCreate {
+	if v != nil {
+		_c.SetEnableMediaPlayback(*v)
+	}
+	return _c
+}
+
+// SetEnableVideoPlaybackTranscoding sets the "enable_video_playback_transcoding" field.
+func (_c *UserCreate) SetEnableVideoPlaybackTranscoding(v bool) *UserCreate {
+	_c.mutation.SetEnableVideoPlaybackTranscoding(v)
+	return _c
+}
+
+// SetNillableEnableVideoPlaybackTranscoding sets the "enable_video_playback_transcoding" field if the given value is not nil.
+func (_c *UserCreate) SetNillableEnableVideoPlaybackTranscoding(v *bool) *UserCreate {
+	if v != nil {
+		_c.SetEnableVideoPlaybackTranscoding(*v)
+	}
+	return _c
+}
+
+// SetEnableContentDownloading sets the "enable_content_downloading" field.
+func (_c *UserCreate) SetEnableContentDownloading(v bool) *UserCreate {
+	_c.mutation.SetEnableContentDownloading(v)
+	return _c
+}
+
+// SetNillableEnableContentDownloading sets the "enable_content_downloading" field if the given value is not nil.
+func (_c *UserCreate) SetNillableEnableContentDownloading(v *bool) *UserCreate {
+	if v != nil {
+		_c.SetEnableContentDownloading(*v)
+	}
+	return _c
+}
+
+// SetRemoteClientBitrateLimit sets the "remote_client_bitrate_limit" field.
+func (_c *UserCreate) SetRemoteClientBitrateLimit(v int) *UserCreate {
+	_c.mutation.SetRemoteClientBitrateLimit(v)
+	return _c
+}
+
+// SetNillableRemoteClientBitrateLimit sets the "remote_client_bitrate_limit" field if the given value is not nil.
+func (_c *UserCreate) SetNillableRemoteClientBitrateLimit(v *int) *UserCreate {
+	if v != nil {
+		_c.SetRemoteClientBitrateLimit(*v)
+	}
+	return _c
+}
+
+// SetFailedLoginCount sets the "failed_login_count" field.
+func (_c *UserCreate) SetFailedLoginCount(v int) *UserCreate {
+	_c.mutation.SetFailedLoginCount(v)
+	return _c
+}
+
+// SetNillableFailedLoginCount sets the "failed_login_count" field if the given value is not nil.
+func (_c *UserCreate) SetNillableFailedLoginCount(v *int) *UserCreate {
+	if v != nil {
+		_c.SetFailedLoginCount(*v)
+	}
+	return _c
+}
+
+// SetLockedUntil sets the "locked_until" field.
+func (_c *UserCreate) SetLockedUntil(v time.Time) *UserCreate {
+	_c.mutation.SetLockedUntil(v)
+	return _c
+}
+
+// SetNillableLockedUntil sets the "locked_until" field if the given value is not nil.
+func (_c *UserCreate) SetNillableLockedUntil(v *time.Time) *UserCreate {
+	if v != nil {
+		_c.SetLockedUntil(*v)
+	}
+	return _c
+}
+
+// SetTotpSecret sets the "totp_secret" field.
+func (_c *UserCreate) SetTotpSecret(v string) *UserCreate {
+	_c.mutation.SetTotpSecret(v)
+	return _c
+}
+
+// SetNillableTotpSecret sets the "totp_secret" field if the given value is not nil.
+func (_c *UserCreate) SetNillableTotpSecret(v *string) *UserCreate {
+	if v != nil {
+		_c.SetTotpSecret(*v)
+	}
+	return _c
+}
+
+// SetTotpEnabled sets the "totp_enabled" field.
+func (_c *UserCreate) SetTotpEnabled(v bool) *UserCreate {
+	_c.mutation.SetTotpEnabled(v)
+	return _c
+}
+
+// SetNillableTotpEnabled sets the "totp_enabled" field if the given value is not nil.
+func (_c *UserCreate) SetNillableTotpEnabled(v *bool) *UserCreate {
+	if v != nil {
+		_c.SetTotpEnabled(*v)
+	}
+	return _c
+}
+
+// SetTotpLastStep sets the "totp_last_step" field.
+func (_c *UserCreate) SetTotpLastStep(v int64) *UserCreate {
+	_c.mutation.SetTotpLastStep(v)
+	return _c
+}
+
+// SetNillableTotpLastStep sets the "totp_last_step" field if the given value is not nil.
+func (_c *UserCreate) SetNillableTotpLastStep(v *int64) *UserCreate {
+	if v != nil {
+		_c.SetTotpLastStep(*v)
+	}
+	return _c
+}
+
 // SetID sets the "id" field.
 func (_c *UserCreate) SetID(v uuid.UUID) *UserCreate {
 	_c.mutation.SetID(v)
@@ -147,6 +347,51 @@ func (_c *UserCreate) AddBackendUsers(v ...*BackendUser) *UserCreate {
 	return _c.AddBackendUserIDs(ids...)
 }
 
+// AddAPIKeyIDs adds the "api_keys" edge to the ApiKey entity by IDs.
+func (_c *UserCreate) AddAPIKeyIDs(ids ...uuid.UUID) *UserCreate {
+	_c.mutation.AddAPIKeyIDs(ids...)
+	return _c
+}
+
+// AddAPIKeys adds the "api_keys" edges to the ApiKey entity.
+func (_c *UserCreate) AddAPIKeys(v ...*ApiKey) *UserCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddAPIKeyIDs(ids...)
+}
+
+// AddRecoveryCodeIDs adds the "recovery_codes" edge to the RecoveryCode entity by IDs.
+func (_c *UserCreate) AddRecoveryCodeIDs(ids ...uuid.UUID) *UserCreate {
+	_c.mutation.AddRecoveryCodeIDs(ids...)
+	return _c
+}
+
+// AddRecoveryCodes adds the "recovery_codes" edges to the RecoveryCode entity.
+func (_c *UserCreate) AddRecoveryCodes(v ...*RecoveryCode) *UserCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddRecoveryCodeIDs(ids...)
+}
+
+// AddAppPasswordIDs adds the "app_passwords" edge to the AppPassword entity by IDs.
+func (_c *UserCreate) AddAppPasswordIDs(ids ...uuid.UUID) *UserCreate {
+	_c.mutation.AddAppPasswordIDs(ids...)
+	return _c
+}
+
+// AddAppPasswords adds the "app_passwords" edges to the AppPassword entity.
+func (_c *UserCreate) AddAppPasswords(v ...*AppPassword) *UserCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddAppPasswordIDs(ids...)
+}
+
 // Mutation returns the UserMutation object of the builder.
 func (_c *UserCreate) Mutation() *UserMutation {
 	return _c.mutation
@@ -186,6 +431,10 @@ func (_c *UserCreate) defaults() {
 		v := user.DefaultIsAdmin
 		_c.mutation.SetIsAdmin(v)
 	}
+	if _, ok := _c.mutation.AuthSource(); !ok {
+		v := user.DefaultAuthSource
+		_c.mutation.SetAuthSource(v)
+	}
 	if _, ok := _c.mutation.CreatedAt(); !ok {
 		v := user.DefaultCreatedAt()
 		_c.mutation.SetCreatedAt(v)
@@ -194,6 +443,38 @@ func (_c *UserCreate) defaults() {
 		v := user.DefaultUpdatedAt()
 		_c.mutation.SetUpdatedAt(v)
 	}
+	if _, ok := _c.mutation.IsDisabled(); !ok {
+		v := user.DefaultIsDisabled
+		_c.mutation.SetIsDisabled(v)
+	}
+	if _, ok := _c.mutation.EnableRemoteAccess(); !ok {
+		v := user.DefaultEnableRemoteAccess
+		_c.mutation.SetEnableRemoteAccess(v)
+	}
+	if _, ok := _c.mutation.EnableMediaPlayback(); !ok {
+		v := user.DefaultEnableMediaPlayback
+		_c.mutation.SetEnableMediaPlayback(v)
+	}
+	if _, ok := _c.mutation.EnableVideoPlaybackTranscoding(); !ok {
+		v := user.DefaultEnableVideoPlaybackTranscoding
+		_c.mutation.SetEnableVideoPlaybackTranscoding(v)
+	}
+	if _, ok := _c.mutation.EnableContentDownloading(); !ok {
+		v := user.DefaultEnableContentDownloading
+		_c.mutation.SetEnableContentDownloading(v)
+	}
+	if _, ok := _c.mutation.FailedLoginCount(); !ok {
+		v := user.DefaultFailedLoginCount
+		_c.mutation.SetFailedLoginCount(v)
+	}
+	if _, ok := _c.mutation.TotpEnabled(); !ok {
+		v := user.DefaultTotpEnabled
+		_c.mutation.SetTotpEnabled(v)
+	}
+	if _, ok := _c.mutation.TotpLastStep(); !ok {
+		v := user.DefaultTotpLastStep
+		_c.mutation.SetTotpLastStep(v)
+	}
 	if _, ok := _c.mutation.ID(); !ok {
 		v := user.DefaultID()
 		_c.mutation.SetID(v)
@@ -229,12 +510,39 @@ func (_c *UserCreate) check() error {
 	if _, ok := _c.mutation.IsAdmin(); !ok {
 		return &ValidationError{Name: "is_admin", err: errors.New(`ent: missing required field "User.is_admin"`)}
 	}
+	if _, ok := _c.mutation.AuthSource(); !ok {
+		return &ValidationError{Name: "auth_source", err: errors.New(`ent: missing required field "User.auth_source"`)}
+	}
 	if _, ok := _c.mutation.CreatedAt(); !ok {
 		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "User.created_at"`)}
 	}
 	if _, ok := _c.mutation.UpdatedAt(); !ok {
 		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "User.updated_at"`)}
 	}
+	if _, ok := _c.mutation.IsDisabled(); !ok {
+		return &ValidationError{Name: "is_disabled", err: errors.New(`ent: missing required field "User.is_disabled"`)}
+	}
+	if _, ok := _c.mutation.EnableRemoteAccess(); !ok {
+		return &ValidationError{Name: "enable_remote_access", err: errors.New(`ent: missing required field "User.enable_remote_access"`)}
+	}
+	if _, ok := _c.mutation.EnableMediaPlayback(); !ok {
+		return &ValidationError{Name: "enable_media_playback", err: errors.New(`ent: missing required field "User.enable_media_playback"`)}
+	}
+	if _, ok := _c.mutation.EnableVideoPlaybackTranscoding(); !ok {
+		return &ValidationError{Name: "enable_video_playback_transcoding", err: errors.New(`ent: missing required field "User.enable_video_playback_transcoding"`)}
+	}
+	if _, ok := _c.mutation.EnableContentDownloading(); !ok {
+		return &ValidationError{Name: "enable_content_downloading", err: errors.New(`ent: missing required field "User.enable_content_downloading"`)}
+	}
+	if _, ok := _c.mutation.FailedLoginCount(); !ok {
+		return &ValidationError{Name: "failed_login_count", err: errors.New(`ent: missing required field "User.failed_login_count"`)}
+	}
+	if _, ok := _c.mutation.TotpEnabled(); !ok {
+		return &ValidationError{Name: "totp_enabled", err: errors.New(`ent: missing required field "User.totp_enabled"`)}
+	}
+	if _, ok := _c.mutation.TotpLastStep(); !ok {
+		return &ValidationError{Name: "totp_last_step", err: errors.New(`ent: missing required field "User.totp_last_step"`)}
+	}
 	return nil
 }
 
@@ -286,6 +594,10 @@ func (_c *UserCreate) createSpec() (*User, *sqlgraph.CreateSpec) {
 		_spec.SetField(user.FieldIsAdmin, field.TypeBool, value)
 		_node.IsAdmin = value
 	}
+	if value, ok := _c.mutation.AuthSource(); ok {
+		_spec.SetField(user.FieldAuthSource, field.TypeString, value)
+		_node.AuthSource = value
+	}
 	if value, ok := _c.mutation.CreatedAt(); ok {
 		_spec.SetField(user.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
@@ -302,6 +614,58 @@ func (_c *UserCreate) createSpec() (*User, *sqlgraph.CreateSpec) {
 		_spec.SetField(user.FieldAvatarContentType, field.TypeString, value)
 		_node.AvatarContentType = &value
 	}
+	if value, ok := _c.mutation.OidcSubject(); ok {
+		_spec.SetField(user.FieldOidcSubject, field.TypeString, value)
+		_node.OidcSubject = &value
+	}
+	if value, ok := _c.mutation.AccessRules(); ok {
+		_spec.SetField(user.FieldAccessRules, field.TypeJSON, value)
+		_node.AccessRules = value
+	}
+	if value, ok := _c.mutation.IsDisabled(); ok {
+		_spec.SetField(user.FieldIsDisabled, field.TypeBool, value)
+		_node.IsDisabled = value
+	}
+	if value, ok := _c.mutation.EnableRemoteAccess(); ok {
+		_spec.SetField(user.FieldEnableRemoteAccess, field.TypeBool, value)
+		_node.EnableRemoteAccess = value
+	}
+	if value, ok := _c.mutation.EnableMediaPlayback(); ok {
+		_spec.SetField(user.FieldEnableMediaPlayback, field.TypeBool, value)
+		_node.EnableMediaPlayback = value
+	}
+	if value, ok := _c.mutation.EnableVideoPlaybackTranscoding(); ok {
+		_spec.SetField(user.FieldEnableVideoPlaybackTranscoding, field.TypeBool, value)
+		_node.EnableVideoPlaybackTranscoding = value
+	}
+	if value, ok := _c.mutation.EnableContentDownloading(); ok {
+		_spec.SetField(user.FieldEnableContentDownloading, field.TypeBool, value)
+		_node.EnableContentDownloading = value
+	}
+	if value, ok := _c.mutation.RemoteClientBitrateLimit(); ok {
+		_spec.SetField(user.FieldRemoteClientBitrateLimit, field.TypeInt, value)
+		_node.RemoteClientBitrateLimit = &value
+	}
+	if value, ok := _c.mutation.FailedLoginCount(); ok {
+		_spec.SetField(user.FieldFailedLoginCount, field.TypeInt, value)
+		_node.FailedLoginCount = value
+	}
+	if value, ok := _c.mutation.LockedUntil(); ok {
+		_spec.SetField(user.FieldLockedUntil, field.TypeTime, value)
+		_node.LockedUntil = &value
+	}
+	if value, ok := _c.mutation.TotpSecret(); ok {
+		_spec.SetField(user.FieldTotpSecret, field.TypeString, value)
+		_node.TotpSecret = &value
+	}
+	if value, ok := _c.mutation.TotpEnabled(); ok {
+		_spec.SetField(user.FieldTotpEnabled, field.TypeBool, value)
+		_node.TotpEnabled = value
+	}
+	if value, ok := _c.mutation.TotpLastStep(); ok {
+		_spec.SetField(user.FieldTotpLastStep, field.TypeInt64, value)
+		_node.TotpLastStep = value
+	}
 	if nodes := _c.mutation.SessionsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -334,6 +698,54 @@ func (_c *UserCreate) createSpec() (*User, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.APIKeysIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.APIKeysTable,
+			Columns: []string{user.APIKeysColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(apikey.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.RecoveryCodesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.RecoveryCodesTable,
+			Columns: []string{user.RecoveryCodesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(recoverycode.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.AppPasswordsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.AppPasswordsTable,
+			Columns: []string{user.AppPasswordsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(apppassword.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -11,8 +11,12 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/ddevcap/jellyfin-proxy/access"
+	"github.com/ddevcap/jellyfin-proxy/ent/apikey"
+	"github.com/ddevcap/jellyfin-proxy/ent/apppassword"
 	"github.com/ddevcap/jellyfin-proxy/ent/backenduser"
 	"github.com/ddevcap/jellyfin-proxy/ent/predicate"
+	"github.com/ddevcap/jellyfin-proxy/ent/recoverycode"
 	"github.com/ddevcap/jellyfin-proxy/ent/session"
 	"github.com/ddevcap/jellyfin-proxy/ent/user"
 	"github.com/google/uuid"
@@ -87,6 +91,20 @@ func (_u *UserUpdate) SetNillableIsAdmin(v *bool) *UserUpdate {
 	return _u
 }
 
+// SetAuthSource sets the "auth_source" field.
+func (_u *UserUpdate) SetAuthSource(v string) *UserUpdate {
+	_u.mutation.SetAuthSource(v)
+	return _u
+}
+
+// SetNillableAuthSource sets the "auth_source" field if the given value is not nil.
+func (_u *UserUpdate) SetNillableAuthSource(v *string) *UserUpdate {
+	if v != nil {
+		_u.SetAuthSource(*v)
+	}
+	return _u
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (_u *UserUpdate) SetUpdatedAt(v time.Time) *UserUpdate {
 	_u.mutation.SetUpdatedAt(v)
@@ -125,6 +143,239 @@ func (_u *UserUpdate) ClearAvatarContentType() *UserUpdate {
 	return _u
 }
 
+// SetOidcSubject sets the "oidc_subject" field.
+func (_u *UserUpdate) SetOidcSubject(v string) *UserUpdate {
+	_u.mutation.SetOidcSubject(v)
+	return _u
+}
+
+// SetNillableOidcSubject sets the "oidc_subject" field if the given value is not nil.
+func (_u *UserUpdate) SetNillableOidcSubject(v *string) *UserUpdate {
+	if v != nil {
+		_u.SetOidcSubject(*v)
+	}
+	return _u
+}
+
+// ClearOidcSubject clears the value of the "oidc_subject" field.
+func (_u *UserUpdate) ClearOidcSubject() *UserUpdate {
+	_u.mutation.ClearOidcSubject()
+	return _u
+}
+
+// SetAccessRules sets the "access_rules" field.
+func (_u *UserUpdate) SetAccessRules(v access.Rules) *UserUpdate {
+	_u.mutation.SetAccessRules(v)
+	return _u
+}
+
+// SetNillableAccessRules sets the "access_rules" field if the given value is not nil.
+func (_u *UserUpdate) SetNillableAccessRules(v *access.Rules) *UserUpdate {
+	if v != nil {
+		_u.SetAccessRules(*v)
+	}
+	return _u
+}
+
+// ClearAccessRules clears the value of the "access_rules" field.
+func (_u *UserUpdate) ClearAccessRules() *UserUpdate {
+	_u.mutation.ClearAccessRules()
+	return _u
+}
+
+// SetIsDisabled sets the "is_disabled" field.
+func (_u *UserUpdate) SetIsDisabled(v bool) *UserUpdate {
+	_u.mutation.SetIsDisabled(v)
+	return _u
+}
+
+// SetNillableIsDisabled sets the "is_disabled" field if the given value is not nil.
+func (_u *UserUpdate) SetNillableIsDisabled(v *bool) *UserUpdate {
+	if v != nil {
+		_u.SetIsDisabled(*v)
+	}
+	return _u
+}
+
+// SetEnableRemoteAccess sets the "enable_remote_access" field.
+func (_u *UserUpdate) SetEnableRemoteAccess(v bool) *UserUpdate {
+	_u.mutation.SetEnableRemoteAccess(v)
+	return _u
+}
+
+// SetNillableEnableRemoteAccess sets the "enable_remote_access" field if the given value is not nil.
+func (_u *UserUpdate) SetNillableEnableRemoteAccess(v *bool) *UserUpdate {
+	if v != nil {
+		_u.SetEnableRemoteAccess(*v)
+	}
+	return _u
+}
+
+// SetEnableMediaPlayback sets the "enable_media_playback" field.
+func (_u *UserUpdate) SetEnableMediaPlayback(v bool) *UserUpdate {
+	_u.mutation.SetEnableMediaPlayback(v)
+	return _u
+}
+
+// SetNillableEnableMediaPlayback sets the "enable_media_playback" field if the given value is not nil.
+func (_u *UserUpdate) SetNillableEnableMediaPlayback(v *bool) *UserUpdate {
+	if v != nil {
+		_u.SetEnableMediaPlayback(*v)
+	}
+	return _u
+}
+
+// SetEnableVideoPlaybackTranscoding sets the "enable_video_playback_transcoding" field.
+func (_u *UserUpdate) SetEnableVideoPlaybackTranscoding(v bool) *UserUpdate {
+	_u.mutation.SetEnableVideoPlaybackTranscoding(v)
+	return _u
+}
+
+// SetNillableEnableVideoPlaybackTranscoding sets the "enable_video_playback_transcoding" field if the given value is not nil.
+func (_u *UserUpdate) SetNillableEnableVideoPlaybackTranscoding(v *bool) *UserUpdate {
+	if v != nil {
+		_u.SetEnableVideoPlaybackTranscoding(*v)
+	}
+	return _u
+}
+
+// SetEnableContentDownloading sets the "enable_content_downloading" field.
+func (_u *UserUpdate) SetEnableContentDownloading(v bool) *UserUpdate {
+	_u.mutation.SetEnableContentDownloading(v)
+	return _u
+}
+
+// SetNillableEnableContentDownloading sets the "enable_content_downloading" field if the given value is not nil.
+func (_u *UserUpdate) SetNillableEnableContentDownloading(v *bool) *UserUpdate {
+	if v != nil {
+		_u.SetEnableContentDownloading(*v)
+	}
+	return _u
+}
+
+// SetRemoteClientBitrateLimit sets the "remote_client_bitrate_limit" field.
+func (_u *UserUpdate) SetRemoteClientBitrateLimit(v int) *UserUpdate {
+	_u.mutation.ResetRemoteClientBitrateLimit()
+	_u.mutation.SetRemoteClientBitrateLimit(v)
+	return _u
+}
+
+// SetNillableRemoteClientBitrateLimit sets the "remote_client_bitrate_limit" field if the given value is not nil.
+func (_u *UserUpdate) SetNillableRemoteClientBitrateLimit(v *int) *UserUpdate {
+	if v != nil {
+		_u.SetRemoteClientBitrateLimit(*v)
+	}
+	return _u
+}
+
+// AddRemoteClientBitrateLimit adds value to the "remote_client_bitrate_limit" field.
+func (_u *UserUpdate) AddRemoteClientBitrateLimit(v int) *UserUpdate {
+	_u.mutation.AddRemoteClientBitrateLimit(v)
+	return _u
+}
+
+// ClearRemoteClientBitrateLimit clears the value of the "remote_client_bitrate_limit" field.
+func (_u *UserUpdate) ClearRemoteClientBitrateLimit() *UserUpdate {
+	_u.mutation.ClearRemoteClientBitrateLimit()
+	return _u
+}
+
+// SetFailedLoginCount sets the "failed_login_count" field.
+func (_u *UserUpdate) SetFailedLoginCount(v int) *UserUpdate {
+	_u.mutation.ResetFailedLoginCount()
+	_u.mutation.SetFailedLoginCount(v)
+	return _u
+}
+
+// SetNillableFailedLoginCount sets the "failed_login_count" field if the given value is not nil.
+func (_u *UserUpdate) SetNillableFailedLoginCount(v *int) *UserUpdate {
+	if v != nil {
+		_u.SetFailedLoginCount(*v)
+	}
+	return _u
+}
+
+// AddFailedLoginCount adds value to the "failed_login_count" field.
+func (_u *UserUpdate) AddFailedLoginCount(v int) *UserUpdate {
+	_u.mutation.AddFailedLoginCount(v)
+	return _u
+}
+
+// SetLockedUntil sets the "locked_until" field.
+func (_u *UserUpdate) SetLockedUntil(v time.Time) *UserUpdate {
+	_u.mutation.SetLockedUntil(v)
+	return _u
+}
+
+// SetNillableLockedUntil sets the "locked_until" field if the given value is not nil.
+func (_u *UserUpdate) SetNillableLockedUntil(v *time.Time) *UserUpdate {
+	if v != nil {
+		_u.SetLockedUntil(*v)
+	}
+	return _u
+}
+
+// ClearLockedUntil clears the value of the "locked_until" field.
+func (_u *UserUpdate) ClearLockedUntil() *UserUpdate {
+	_u.mutation.ClearLockedUntil()
+	return _u
+}
+
+// SetTotpSecret sets the "totp_secret" field.
+func (_u *UserUpdate) SetTotpSecret(v string) *UserUpdate {
+	_u.mutation.SetTotpSecret(v)
+	return _u
+}
+
+// SetNillableTotpSecret sets the "totp_secret" field if the given value is not nil.
+func (_u *UserUpdate) SetNillableTotpSecret(v *string) *UserUpdate {
+	if v != nil {
+		_u.SetTotpSecret(*v)
+	}
+	return _u
+}
+
+// ClearTotpSecret clears the value of the "totp_secret" field.
+func (_u *UserUpdate) ClearTotpSecret() *UserUpdate {
+	_u.mutation.ClearTotpSecret()
+	return _u
+}
+
+// SetTotpEnabled sets the "totp_enabled" field.
+func (_u *UserUpdate) SetTotpEnabled(v bool) *UserUpdate {
+	_u.mutation.SetTotpEnabled(v)
+	return _u
+}
+
+// SetNillableTotpEnabled sets the "totp_enabled" field if the given value is not nil.
+func (_u *UserUpdate) SetNillableTotpEnabled(v *bool) *UserUpdate {
+	if v != nil {
+		_u.SetTotpEnabled(*v)
+	}
+	return _u
+}
+
+// SetTotpLastStep sets the "totp_last_step" field.
+func (_u *UserUpdate) SetTotpLastStep(v int64) *UserUpdate {
+	_u.mutation.ResetTotpLastStep()
+	_u.mutation.SetTotpLastStep(v)
+	return _u
+}
+
+// SetNillableTotpLastStep sets the "totp_last_step" field if the given value is not nil.
+func (_u *UserUpdate) SetNillableTotpLastStep(v *int64) *UserUpdate {
+	if v != nil {
+		_u.SetTotpLastStep(*v)
+	}
+	return _u
+}
+
+// AddTotpLastStep adds value to the "totp_last_step" field.
+func (_u *UserUpdate) AddTotpLastStep(v int64) *UserUpdate {
+	_u.mutation.AddTotpLastStep(v)
+	return _u
+}
+
 // AddSessionIDs adds the "sessions" edge to the Session entity by IDs.
 func (_u *UserUpdate) AddSessionIDs(ids ...uuid.UUID) *UserUpdate {
 	_u.mutation.AddSessionIDs(ids...)
@@ -155,6 +406,51 @@ func (_u *UserUpdate) AddBackendUsers(v ...*BackendUser) *UserUpdate {
 	return _u.AddBackendUserIDs(ids...)
 }
 
+// AddAPIKeyIDs adds the "api_keys" edge to the ApiKey entity by IDs.
+func (_u *UserUpdate) AddAPIKeyIDs(ids ...uuid.UUID) *UserUpdate {
+	_u.mutation.AddAPIKeyIDs(ids...)
+	return _u
+}
+
+// AddAPIKeys adds the "api_keys" edges to the ApiKey entity.
+func (_u *UserUpdate) AddAPIKeys(v ...*ApiKey) *UserUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddAPIKeyIDs(ids...)
+}
+
+// AddRecoveryCodeIDs adds the "recovery_codes" edge to the RecoveryCode entity by IDs.
+func (_u *UserUpdate) AddRecoveryCodeIDs(ids ...uuid.UUID) *UserUpdate {
+	_u.mutation.AddRecoveryCodeIDs(ids...)
+	return _u
+}
+
+// AddRecoveryCodes adds the "recovery_codes" edges to the RecoveryCode entity.
+func (_u *UserUpdate) AddRecoveryCodes(v ...*RecoveryCode) *UserUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddRecoveryCodeIDs(ids...)
+}
+
+// AddAppPasswordIDs adds the "app_passwords" edge to the AppPassword entity by IDs.
+func (_u *UserUpdate) AddAppPasswordIDs(ids ...uuid.UUID) *UserUpdate {
+	_u.mutation.AddAppPasswordIDs(ids...)
+	return _u
+}
+
+// AddAppPasswords adds the "app_passwords" edges to the AppPassword entity.
+func (_u *UserUpdate) AddAppPasswords(v ...*AppPassword) *UserUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddAppPasswordIDs(ids...)
+}
+
 // Mutation returns the UserMutation object of the builder.
 func (_u *UserUpdate) Mutation() *UserMutation {
 	return _u.mutation
@@ -202,6 +498,69 @@ func (_u *UserUpdate) RemoveBackendUsers(v ...*BackendUser) *UserUpdate {
 	return _u.RemoveBackendUserIDs(ids...)
 }
 
+// ClearAPIKeys clears all "api_keys" edges to the ApiKey entity.
+func (_u *UserUpdate) ClearAPIKeys() *UserUpdate {
+	_u.mutation.ClearAPIKeys()
+	return _u
+}
+
+// RemoveAPIKeyIDs removes the "api_keys" edge to ApiKey entities by IDs.
+func (_u *UserUpdate) RemoveAPIKeyIDs(ids ...uuid.UUID) *UserUpdate {
+	_u.mutation.RemoveAPIKeyIDs(ids...)
+	return _u
+}
+
+// RemoveAPIKeys removes "api_keys" edges to ApiKey entities.
+func (_u *UserUpdate) RemoveAPIKeys(v ...*ApiKey) *UserUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveAPIKeyIDs(ids...)
+}
+
+// ClearRecoveryCodes clears all "recovery_codes" edges to the RecoveryCode entity.
+func (_u *UserUpdate) ClearRecoveryCodes() *UserUpdate {
+	_u.mutation.ClearRecoveryCodes()
+	return _u
+}
+
+// RemoveRecoveryCodeIDs removes the "recovery_codes" edge to RecoveryCode entities by IDs.
+func (_u *UserUpdate) RemoveRecoveryCodeIDs(ids ...uuid.UUID) *UserUpdate {
+	_u.mutation.RemoveRecoveryCodeIDs(ids...)
+	return _u
+}
+
+// RemoveRecoveryCodes removes "recovery_codes" edges to RecoveryCode entities.
+func (_u *UserUpdate) RemoveRecoveryCodes(v ...*RecoveryCode) *UserUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveRecoveryCodeIDs(ids...)
+}
+
+// ClearAppPasswords clears all "app_passwords" edges to the AppPassword entity.
+func (_u *UserUpdate) ClearAppPasswords() *UserUpdate {
+	_u.mutation.ClearAppPasswords()
+	return _u
+}
+
+// RemoveAppPasswordIDs removes the "app_passwords" edge to AppPassword entities by IDs.
+func (_u *UserUpdate) RemoveAppPasswordIDs(ids ...uuid.UUID) *UserUpdate {
+	_u.mutation.RemoveAppPasswordIDs(ids...)
+	return _u
+}
+
+// RemoveAppPasswords removes "app_passwords" edges to AppPassword entities.
+func (_u *UserUpdate) RemoveAppPasswords(v ...*AppPassword) *UserUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveAppPasswordIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *UserUpdate) Save(ctx context.Context) (int, error) {
 	_u.defaults()
@@ -282,6 +641,9 @@ func (_u *UserUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if value, ok := _u.mutation.IsAdmin(); ok {
 		_spec.SetField(user.FieldIsAdmin, field.TypeBool, value)
 	}
+	if value, ok := _u.mutation.AuthSource(); ok {
+		_spec.SetField(user.FieldAuthSource, field.TypeString, value)
+	}
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(user.FieldUpdatedAt, field.TypeTime, value)
 	}
@@ -297,6 +659,69 @@ func (_u *UserUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if _u.mutation.AvatarContentTypeCleared() {
 		_spec.ClearField(user.FieldAvatarContentType, field.TypeString)
 	}
+	if value, ok := _u.mutation.OidcSubject(); ok {
+		_spec.SetField(user.FieldOidcSubject, field.TypeString, value)
+	}
+	if _u.mutation.OidcSubjectCleared() {
+		_spec.ClearField(user.FieldOidcSubject, field.TypeString)
+	}
+	if value, ok := _u.mutation.AccessRules(); ok {
+		_spec.SetField(user.FieldAccessRules, field.TypeJSON, value)
+	}
+	if _u.mutation.AccessRulesCleared() {
+		_spec.ClearField(user.FieldAccessRules, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.IsDisabled(); ok {
+		_spec.SetField(user.FieldIsDisabled, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.EnableRemoteAccess(); ok {
+		_spec.SetField(user.FieldEnableRemoteAccess, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.EnableMediaPlayback(); ok {
+		_spec.SetField(user.FieldEnableMediaPlayback, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.EnableVideoPlaybackTranscoding(); ok {
+		_spec.SetField(user.FieldEnableVideoPlaybackTranscoding, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.EnableContentDownloading(); ok {
+		_spec.SetField(user.FieldEnableContentDownloading, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.RemoteClientBitrateLimit(); ok {
+		_spec.SetField(user.FieldRemoteClientBitrateLimit, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedRemoteClientBitrateLimit(); ok {
+		_spec.AddField(user.FieldRemoteClientBitrateLimit, field.TypeInt, value)
+	}
+	if _u.mutation.RemoteClientBitrateLimitCleared() {
+		_spec.ClearField(user.FieldRemoteClientBitrateLimit, field.TypeInt)
+	}
+	if value, ok := _u.mutation.FailedLoginCount(); ok {
+		_spec.SetField(user.FieldFailedLoginCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedFailedLoginCount(); ok {
+		_spec.AddField(user.FieldFailedLoginCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.LockedUntil(); ok {
+		_spec.SetField(user.FieldLockedUntil, field.TypeTime, value)
+	}
+	if _u.mutation.LockedUntilCleared() {
+		_spec.ClearField(user.FieldLockedUntil, field.TypeTime)
+	}
+	if value, ok := _u.mutation.TotpSecret(); ok {
+		_spec.SetField(user.FieldTotpSecret, field.TypeString, value)
+	}
+	if _u.mutation.TotpSecretCleared() {
+		_spec.ClearField(user.FieldTotpSecret, field.TypeString)
+	}
+	if value, ok := _u.mutation.TotpEnabled(); ok {
+		_spec.SetField(user.FieldTotpEnabled, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.TotpLastStep(); ok {
+		_spec.SetField(user.FieldTotpLastStep, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedTotpLastStep(); ok {
+		_spec.AddField(user.FieldTotpLastStep, field.TypeInt64, value)
+	}
 	if _u.mutation.SessionsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -387,6 +812,141 @@ func (_u *UserUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.APIKeysCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.APIKeysTable,
+			Columns: []string{user.APIKeysColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(apikey.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedAPIKeysIDs(); len(nodes) > 0 && !_u.mutation.APIKeysCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.APIKeysTable,
+			Columns: []string{user.APIKeysColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(apikey.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.APIKeysIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.APIKeysTable,
+			Columns: []string{user.APIKeysColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(apikey.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.RecoveryCodesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.RecoveryCodesTable,
+			Columns: []string{user.RecoveryCodesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(recoverycode.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedRecoveryCodesIDs(); len(nodes) > 0 && !_u.mutation.RecoveryCodesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.RecoveryCodesTable,
+			Columns: []string{user.RecoveryCodesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(recoverycode.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RecoveryCodesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.RecoveryCodesTable,
+			Columns: []string{user.RecoveryCodesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(recoverycode.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.AppPasswordsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.AppPasswordsTable,
+			Columns: []string{user.AppPasswordsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(apppassword.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedAppPasswordsIDs(); len(nodes) > 0 && !_u.mutation.AppPasswordsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.AppPasswordsTable,
+			Columns: []string{user.AppPasswordsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(apppassword.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AppPasswordsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.AppPasswordsTable,
+			Columns: []string{user.AppPasswordsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(apppassword.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{user.Label}
@@ -395,109 +955,356 @@ func (_u *UserUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		return 0, err
 	}
-	_u.mutation.done = true
-	return _node, nil
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// UserUpdateOne is the builder for updating a single User entity.
+type UserUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *UserMutation
+}
+
+// SetUsername sets the "username" field.
+func (_u *UserUpdateOne) SetUsername(v string) *UserUpdateOne {
+	_u.mutation.SetUsername(v)
+	return _u
+}
+
+// SetNillableUsername sets the "username" field if the given value is not nil.
+func (_u *UserUpdateOne) SetNillableUsername(v *string) *UserUpdateOne {
+	if v != nil {
+		_u.SetUsername(*v)
+	}
+	return _u
+}
+
+// SetDisplayName sets the "display_name" field.
+func (_u *UserUpdateOne) SetDisplayName(v string) *UserUpdateOne {
+	_u.mutation.SetDisplayName(v)
+	return _u
+}
+
+// SetNillableDisplayName sets the "display_name" field if the given value is not nil.
+func (_u *UserUpdateOne) SetNillableDisplayName(v *string) *UserUpdateOne {
+	if v != nil {
+		_u.SetDisplayName(*v)
+	}
+	return _u
+}
+
+// SetHashedPassword sets the "hashed_password" field.
+func (_u *UserUpdateOne) SetHashedPassword(v string) *UserUpdateOne {
+	_u.mutation.SetHashedPassword(v)
+	return _u
+}
+
+// SetNillableHashedPassword sets the "hashed_password" field if the given value is not nil.
+func (_u *UserUpdateOne) SetNillableHashedPassword(v *string) *UserUpdateOne {
+	if v != nil {
+		_u.SetHashedPassword(*v)
+	}
+	return _u
+}
+
+// SetIsAdmin sets the "is_admin" field.
+func (_u *UserUpdateOne) SetIsAdmin(v bool) *UserUpdateOne {
+	_u.mutation.SetIsAdmin(v)
+	return _u
+}
+
+// SetNillableIsAdmin sets the "is_admin" field if the given value is not nil.
+func (_u *UserUpdateOne) SetNillableIsAdmin(v *bool) *UserUpdateOne {
+	if v != nil {
+		_u.SetIsAdmin(*v)
+	}
+	return _u
+}
+
+// SetAuthSource sets the "auth_source" field.
+func (_u *UserUpdateOne) SetAuthSource(v string) *UserUpdateOne {
+	_u.mutation.SetAuthSource(v)
+	return _u
+}
+
+// SetNillableAuthSource sets the "auth_source" field if the given value is not nil.
+func (_u *UserUpdateOne) SetNillableAuthSource(v *string) *UserUpdateOne {
+	if v != nil {
+		_u.SetAuthSource(*v)
+	}
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *UserUpdateOne) SetUpdatedAt(v time.Time) *UserUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetAvatar sets the "avatar" field.
+func (_u *UserUpdateOne) SetAvatar(v []byte) *UserUpdateOne {
+	_u.mutation.SetAvatar(v)
+	return _u
+}
+
+// ClearAvatar clears the value of the "avatar" field.
+func (_u *UserUpdateOne) ClearAvatar() *UserUpdateOne {
+	_u.mutation.ClearAvatar()
+	return _u
+}
+
+// SetAvatarContentType sets the "avatar_content_type" field.
+func (_u *UserUpdateOne) SetAvatarContentType(v string) *UserUpdateOne {
+	_u.mutation.SetAvatarContentType(v)
+	return _u
+}
+
+// SetNillableAvatarContentType sets the "avatar_content_type" field if the given value is not nil.
+func (_u *UserUpdateOne) SetNillableAvatarContentType(v *string) *UserUpdateOne {
+	if v != nil {
+		_u.SetAvatarContentType(*v)
+	}
+	return _u
+}
+
+// ClearAvatarContentType clears the value of the "avatar_content_type" field.
+func (_u *UserUpdateOne) ClearAvatarContentType() *UserUpdateOne {
+	_u.mutation.ClearAvatarContentType()
+	return _u
+}
+
+// SetOidcSubject sets the "oidc_subject" field.
+func (_u *UserUpdateOne) SetOidcSubject(v string) *UserUpdateOne {
+	_u.mutation.SetOidcSubject(v)
+	return _u
+}
+
+// SetNillableOidcSubject sets the "oidc_subject" field if the given value is not nil.
+func (_u *UserUpdateOne) SetNillableOidcSubject(v *string) *UserUpdateOne {
+	if v != nil {
+		_u.SetOidcSubject(*v)
+	}
+	return _u
+}
+
+// ClearOidcSubject clears the value of the "oidc_subject" field.
+func (_u *UserUpdateOne) ClearOidcSubject() *UserUpdateOne {
+	_u.mutation.ClearOidcSubject()
+	return _u
+}
+
+// SetAccessRules sets the "access_rules" field.
+func (_u *UserUpdateOne) SetAccessRules(v access.Rules) *UserUpdateOne {
+	_u.mutation.SetAccessRules(v)
+	return _u
+}
+
+// SetNillableAccessRules sets the "access_rules" field if the given value is not nil.
+func (_u *UserUpdateOne) SetNillableAccessRules(v *access.Rules) *UserUpdateOne {
+	if v != nil {
+		_u.SetAccessRules(*v)
+	}
+	return _u
+}
+
+// ClearAccessRules clears the value of the "access_rules" field.
+func (_u *UserUpdateOne) ClearAccessRules() *UserUpdateOne {
+	_u.mutation.ClearAccessRules()
+	return _u
+}
+
+// SetIsDisabled sets the "is_disabled" field.
+func (_u *UserUpdateOne) SetIsDisabled(v bool) *UserUpdateOne {
+	_u.mutation.SetIsDisabled(v)
+	return _u
+}
+
+// SetNillableIsDisabled sets the "is_disabled" field if the given value is not nil.
+func (_u *UserUpdateOne) SetNillableIsDisabled(v *bool) *UserUpdateOne {
+	if v != nil {
+		_u.SetIsDisabled(*v)
+	}
+	return _u
+}
+
+// SetEnableRemoteAccess sets the "enable_remote_access" field.
+func (_u *UserUpdateOne) SetEnableRemoteAccess(v bool) *UserUpdateOne {
+	_u.mutation.SetEnableRemoteAccess(v)
+	return _u
+}
+
+// SetNillableEnableRemoteAccess sets the "enable_remote_access" field if the given value is not nil.
+func (_u *UserUpdateOne) SetNillableEnableRemoteAccess(v *bool) *UserUpdateOne {
+	if v != nil {
+		_u.SetEnableRemoteAccess(*v)
+	}
+	return _u
+}
+
+// SetEnableMediaPlayback sets the "enable_media_playback" field.
+func (_u *UserUpdateOne) SetEnableMediaPlayback(v bool) *UserUpdateOne {
+	_u.mutation.SetEnableMediaPlayback(v)
+	return _u
+}
+
+// SetNillableEnableMediaPlayback sets the "enable_media_playback" field if the given value is not nil.
+func (_u *UserUpdateOne) SetNillableEnableMediaPlayback(v *bool) *UserUpdateOne {
+	if v != nil {
+		_u.SetEnableMediaPlayback(*v)
+	}
+	return _u
+}
+
+// SetEnableVideoPlaybackTranscoding sets the "enable_video_playback_transcoding" field.
+func (_u *UserUpdateOne) SetEnableVideoPlaybackTranscoding(v bool) *UserUpdateOne {
+	_u.mutation.SetEnableVideoPlaybackTranscoding(v)
+	return _u
+}
+
+// SetNillableEnableVideoPlaybackTranscoding sets the "enable_video_playback_transcoding" field if the given value is not nil.
+func (_u *UserUpdateOne) SetNillableEnableVideoPlaybackTranscoding(v *bool) *UserUpdateOne {
+	if v != nil {
+		_u.SetEnableVideoPlaybackTranscoding(*v)
+	}
+	return _u
 }
 
-// UserUpdateOne is the builder for updating a single User entity.
-type UserUpdateOne struct {
-	config
-	fields   []string
-	hooks    []Hook
-	mutation *UserMutation
+// SetEnableContentDownloading sets the "enable_content_downloading" field.
+func (_u *UserUpdateOne) SetEnableContentDownloading(v bool) *UserUpdateOne {
+	_u.mutation.SetEnableContentDownloading(v)
+	return _u
 }
 
-// SetUsername sets the "username" field.
-func (_u *UserUpdateOne) SetUsername(v string) *UserUpdateOne {
-	_u.mutation.SetUsername(v)
+// SetNillableEnableContentDownloading sets the "enable_content_downloading" field if the given value is not nil.
+func (_u *UserUpdateOne) SetNillableEnableContentDownloading(v *bool) *UserUpdateOne {
+	if v != nil {
+		_u.SetEnableContentDownloading(*v)
+	}
 	return _u
 }
 
-// SetNillableUsername sets the "username" field if the given value is not nil.
-func (_u *UserUpdateOne) SetNillableUsername(v *string) *UserUpdateOne {
+// SetRemoteClientBitrateLimit sets the "remote_client_bitrate_limit" field.
+func (_u *UserUpdateOne) SetRemoteClientBitrateLimit(v int) *UserUpdateOne {
+	_u.mutation.ResetRemoteClientBitrateLimit()
+	_u.mutation.SetRemoteClientBitrateLimit(v)
+	return _u
+}
+
+// SetNillableRemoteClientBitrateLimit sets the "remote_client_bitrate_limit" field if the given value is not nil.
+func (_u *UserUpdateOne) SetNillableRemoteClientBitrateLimit(v *int) *UserUpdateOne {
 	if v != nil {
-		_u.SetUsername(*v)
+		_u.SetRemoteClientBitrateLimit(*v)
 	}
 	return _u
 }
 
-// SetDisplayName sets the "display_name" field.
-func (_u *UserUpdateOne) SetDisplayName(v string) *UserUpdateOne {
-	_u.mutation.SetDisplayName(v)
+// AddRemoteClientBitrateLimit adds value to the "remote_client_bitrate_limit" field.
+func (_u *UserUpdateOne) AddRemoteClientBitrateLimit(v int) *UserUpdateOne {
+	_u.mutation.AddRemoteClientBitrateLimit(v)
 	return _u
 }
 
-// SetNillableDisplayName sets the "display_name" field if the given value is not nil.
-func (_u *UserUpdateOne) SetNillableDisplayName(v *string) *UserUpdateOne {
+// ClearRemoteClientBitrateLimit clears the value of the "remote_client_bitrate_limit" field.
+func (_u *UserUpdateOne) ClearRemoteClientBitrateLimit() *UserUpdateOne {
+	_u.mutation.ClearRemoteClientBitrateLimit()
+	return _u
+}
+
+// SetFailedLoginCount sets the "failed_login_count" field.
+func (_u *UserUpdateOne) SetFailedLoginCount(v int) *UserUpdateOne {
+	_u.mutation.ResetFailedLoginCount()
+	_u.mutation.SetFailedLoginCount(v)
+	return _u
+}
+
+// SetNillableFailedLoginCount sets the "failed_login_count" field if the given value is not nil.
+func (_u *UserUpdateOne) SetNillableFailedLoginCount(v *int) *UserUpdateOne {
 	if v != nil {
-		_u.SetDisplayName(*v)
+		_u.SetFailedLoginCount(*v)
 	}
 	return _u
 }
 
-// SetHashedPassword sets the "hashed_password" field.
-func (_u *UserUpdateOne) SetHashedPassword(v string) *UserUpdateOne {
-	_u.mutation.SetHashedPassword(v)
+// AddFailedLoginCount adds value to the "failed_login_count" field.
+func (_u *UserUpdateOne) AddFailedLoginCount(v int) *UserUpdateOne {
+	_u.mutation.AddFailedLoginCount(v)
 	return _u
 }
 
-// SetNillableHashedPassword sets the "hashed_password" field if the given value is not nil.
-func (_u *UserUpdateOne) SetNillableHashedPassword(v *string) *UserUpdateOne {
+// SetLockedUntil sets the "locked_until" field.
+func (_u *UserUpdateOne) SetLockedUntil(v time.Time) *UserUpdateOne {
+	_u.mutation.SetLockedUntil(v)
+	return _u
+}
+
+// SetNillableLockedUntil sets the "locked_until" field if the given value is not nil.
+func (_u *UserUpdateOne) SetNillableLockedUntil(v *time.Time) *UserUpdateOne {
 	if v != nil {
-		_u.SetHashedPassword(*v)
+		_u.SetLockedUntil(*v)
 	}
 	return _u
 }
 
-// SetIsAdmin sets the "is_admin" field.
-func (_u *UserUpdateOne) SetIsAdmin(v bool) *UserUpdateOne {
-	_u.mutation.SetIsAdmin(v)
+// ClearLockedUntil clears the value of the "locked_until" field.
+func (_u *UserUpdateOne) ClearLockedUntil() *UserUpdateOne {
+	_u.mutation.ClearLockedUntil()
 	return _u
 }
 
-// SetNillableIsAdmin sets the "is_admin" field if the given value is not nil.
-func (_u *UserUpdateOne) SetNillableIsAdmin(v *bool) *UserUpdateOne {
+// SetTotpSecret sets the "totp_secret" field.
+func (_u *UserUpdateOne) SetTotpSecret(v string) *UserUpdateOne {
+	_u.mutation.SetTotpSecret(v)
+	return _u
+}
+
+// SetNillableTotpSecret sets the "totp_secret" field if the given value is not nil.
+func (_u *UserUpdateOne) SetNillableTotpSecret(v *string) *UserUpdateOne {
 	if v != nil {
-		_u.SetIsAdmin(*v)
+		_u.SetTotpSecret(*v)
 	}
 	return _u
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (_u *UserUpdateOne) SetUpdatedAt(v time.Time) *UserUpdateOne {
-	_u.mutation.SetUpdatedAt(v)
+// ClearTotpSecret clears the value of the "totp_secret" field.
+func (_u *UserUpdateOne) ClearTotpSecret() *UserUpdateOne {
+	_u.mutation.ClearTotpSecret()
 	return _u
 }
 
-// SetAvatar sets the "avatar" field.
-func (_u *UserUpdateOne) SetAvatar(v []byte) *UserUpdateOne {
-	_u.mutation.SetAvatar(v)
+// SetTotpEnabled sets the "totp_enabled" field.
+func (_u *UserUpdateOne) SetTotpEnabled(v bool) *UserUpdateOne {
+	_u.mutation.SetTotpEnabled(v)
 	return _u
 }
 
-// ClearAvatar clears the value of the "avatar" field.
-func (_u *UserUpdateOne) ClearAvatar() *UserUpdateOne {
-	_u.mutation.ClearAvatar()
+// SetNillableTotpEnabled sets the "totp_enabled" field if the given value is not nil.
+func (_u *UserUpdateOne) SetNillableTotpEnabled(v *bool) *UserUpdateOne {
+	if v != nil {
+		_u.SetTotpEnabled(*v)
+	}
 	return _u
 }
 
-// SetAvatarContentType sets the "avatar_content_type" field.
-func (_u *UserUpdateOne) SetAvatarContentType(v string) *UserUpdateOne {
-	_u.mutation.SetAvatarContentType(v)
+// SetTotpLastStep sets the "totp_last_step" field.
+func (_u *UserUpdateOne) SetTotpLastStep(v int64) *UserUpdateOne {
+	_u.mutation.ResetTotpLastStep()
+	_u.mutation.SetTotpLastStep(v)
 	return _u
 }
 
-// SetNillableAvatarContentType sets the "avatar_content_type" field if the given value is not nil.
-func (_u *UserUpdateOne) SetNillableAvatarContentType(v *string) *UserUpdateOne {
+// SetNillableTotpLastStep sets the "totp_last_step" field if the given value is not nil.
+func (_u *UserUpdateOne) SetNillableTotpLastStep(v *int64) *UserUpdateOne {
 	if v != nil {
-		_u.SetAvatarContentType(*v)
+		_u.SetTotpLastStep(*v)
 	}
 	return _u
 }
 
-// ClearAvatarContentType clears the value of the "avatar_content_type" field.
-func (_u *UserUpdateOne) ClearAvatarContentType() *UserUpdateOne {
-	_u.mutation.ClearAvatarContentType()
+// AddTotpLastStep adds value to the "totp_last_step" field.
+func (_u *UserUpdateOne) AddTotpLastStep(v int64) *UserUpdateOne {
+	_u.mutation.AddTotpLastStep(v)
 	return _u
 }
 
@@ -531,6 +1338,51 @@ func (_u *UserUpdateOne) AddBackendUsers(v ...*BackendUser) *UserUpdateOne {
 	return _u.AddBackendUserIDs(ids...)
 }
 
+// AddAPIKeyIDs adds the "api_keys" edge to the ApiKey entity by IDs.
+func (_u *UserUpdateOne) AddAPIKeyIDs(ids ...uuid.UUID) *UserUpdateOne {
+	_u.mutation.AddAPIKeyIDs(ids...)
+	return _u
+}
+
+// AddAPIKeys adds the "api_keys" edges to the ApiKey entity.
+func (_u *UserUpdateOne) AddAPIKeys(v ...*ApiKey) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddAPIKeyIDs(ids...)
+}
+
+// AddRecoveryCodeIDs adds the "recovery_codes" edge to the RecoveryCode entity by IDs.
+func (_u *UserUpdateOne) AddRecoveryCodeIDs(ids ...uuid.UUID) *UserUpdateOne {
+	_u.mutation.AddRecoveryCodeIDs(ids...)
+	return _u
+}
+
+// AddRecoveryCodes adds the "recovery_codes" edges to the RecoveryCode entity.
+func (_u *UserUpdateOne) AddRecoveryCodes(v ...*RecoveryCode) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddRecoveryCodeIDs(ids...)
+}
+
+// AddAppPasswordIDs adds the "app_passwords" edge to the AppPassword entity by IDs.
+func (_u *UserUpdateOne) AddAppPasswordIDs(ids ...uuid.UUID) *UserUpdateOne {
+	_u.mutation.AddAppPasswordIDs(ids...)
+	return _u
+}
+
+// AddAppPasswords adds the "app_passwords" edges to the AppPassword entity.
+func (_u *UserUpdateOne) AddAppPasswords(v ...*AppPassword) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddAppPasswordIDs(ids...)
+}
+
 // Mutation returns the UserMutation object of the builder.
 func (_u *UserUpdateOne) Mutation() *UserMutation {
 	return _u.mutation
@@ -578,6 +1430,69 @@ func (_u *UserUpdateOne) RemoveBackendUsers(v ...*BackendUser) *UserUpdateOne {
 	return _u.RemoveBackendUserIDs(ids...)
 }
 
+// ClearAPIKeys clears all "api_keys" edges to the ApiKey entity.
+func (_u *UserUpdateOne) ClearAPIKeys() *UserUpdateOne {
+	_u.mutation.ClearAPIKeys()
+	return _u
+}
+
+// RemoveAPIKeyIDs removes the "api_keys" edge to ApiKey entities by IDs.
+func (_u *UserUpdateOne) RemoveAPIKeyIDs(ids ...uuid.UUID) *UserUpdateOne {
+	_u.mutation.RemoveAPIKeyIDs(ids...)
+	return _u
+}
+
+// RemoveAPIKeys removes "api_keys" edges to ApiKey entities.
+func (_u *UserUpdateOne) RemoveAPIKeys(v ...*ApiKey) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveAPIKeyIDs(ids...)
+}
+
+// ClearRecoveryCodes clears all "recovery_codes" edges to the RecoveryCode entity.
+func (_u *UserUpdateOne) ClearRecoveryCodes() *UserUpdateOne {
+	_u.mutation.ClearRecoveryCodes()
+	return _u
+}
+
+// RemoveRecoveryCodeIDs removes the "recovery_codes" edge to RecoveryCode entities by IDs.
+func (_u *UserUpdateOne) RemoveRecoveryCodeIDs(ids ...uuid.UUID) *UserUpdateOne {
+	_u.mutation.RemoveRecoveryCodeIDs(ids...)
+	return _u
+}
+
+// RemoveRecoveryCodes removes "recovery_codes" edges to RecoveryCode entities.
+func (_u *UserUpdateOne) RemoveRecoveryCodes(v ...*RecoveryCode) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveRecoveryCodeIDs(ids...)
+}
+
+// ClearAppPasswords clears all "app_passwords" edges to the AppPassword entity.
+func (_u *UserUpdateOne) ClearAppPasswords() *UserUpdateOne {
+	_u.mutation.ClearAppPasswords()
+	return _u
+}
+
+// RemoveAppPasswordIDs removes the "app_passwords" edge to AppPassword entities by IDs.
+func (_u *UserUpdateOne) RemoveAppPasswordIDs(ids ...uuid.UUID) *UserUpdateOne {
+	_u.mutation.RemoveAppPasswordIDs(ids...)
+	return _u
+}
+
+// RemoveAppPasswords removes "app_passwords" edges to AppPassword entities.
+func (_u *UserUpdateOne) RemoveAppPasswords(v ...*AppPassword) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveAppPasswordIDs(ids...)
+}
+
 // Where appends a list predicates to the UserUpdate builder.
 func (_u *UserUpdateOne) Where(ps ...predicate.User) *UserUpdateOne {
 	_u.mutation.Where(ps...)
@@ -688,6 +1603,9 @@ func (_u *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error) {
 	if value, ok := _u.mutation.IsAdmin(); ok {
 		_spec.SetField(user.FieldIsAdmin, field.TypeBool, value)
 	}
+	if value, ok := _u.mutation.AuthSource(); ok {
+		_spec.SetField(user.FieldAuthSource, field.TypeString, value)
+	}
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(user.FieldUpdatedAt, field.TypeTime, value)
 	}
@@ -703,6 +1621,69 @@ func (_u *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error) {
 	if _u.mutation.AvatarContentTypeCleared() {
 		_spec.ClearField(user.FieldAvatarContentType, field.TypeString)
 	}
+	if value, ok := _u.mutation.OidcSubject(); ok {
+		_spec.SetField(user.FieldOidcSubject, field.TypeString, value)
+	}
+	if _u.mutation.OidcSubjectCleared() {
+		_spec.ClearField(user.FieldOidcSubject, field.TypeString)
+	}
+	if value, ok := _u.mutation.AccessRules(); ok {
+		_spec.SetField(user.FieldAccessRules, field.TypeJSON, value)
+	}
+	if _u.mutation.AccessRulesCleared() {
+		_spec.ClearField(user.FieldAccessRules, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.IsDisabled(); ok {
+		_spec.SetField(user.FieldIsDisabled, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.EnableRemoteAccess(); ok {
+		_spec.SetField(user.FieldEnableRemoteAccess, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.EnableMediaPlayback(); ok {
+		_spec.SetField(user.FieldEnableMediaPlayback, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.EnableVideoPlaybackTranscoding(); ok {
+		_spec.SetField(user.FieldEnableVideoPlaybackTranscoding, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.EnableContentDownloading(); ok {
+		_spec.SetField(user.FieldEnableContentDownloading, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.RemoteClientBitrateLimit(); ok {
+		_spec.SetField(user.FieldRemoteClientBitrateLimit, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedRemoteClientBitrateLimit(); ok {
+		_spec.AddField(user.FieldRemoteClientBitrateLimit, field.TypeInt, value)
+	}
+	if _u.mutation.RemoteClientBitrateLimitCleared() {
+		_spec.ClearField(user.FieldRemoteClientBitrateLimit, field.TypeInt)
+	}
+	if value, ok := _u.mutation.FailedLoginCount(); ok {
+		_spec.SetField(user.FieldFailedLoginCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedFailedLoginCount(); ok {
+		_spec.AddField(user.FieldFailedLoginCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.LockedUntil(); ok {
+		_spec.SetField(user.FieldLockedUntil, field.TypeTime, value)
+	}
+	if _u.mutation.LockedUntilCleared() {
+		_spec.ClearField(user.FieldLockedUntil, field.TypeTime)
+	}
+	if value, ok := _u.mutation.TotpSecret(); ok {
+		_spec.SetField(user.FieldTotpSecret, field.TypeString, value)
+	}
+	if _u.mutation.TotpSecretCleared() {
+		_spec.ClearField(user.FieldTotpSecret, field.TypeString)
+	}
+	if value, ok := _u.mutation.TotpEnabled(); ok {
+		_spec.SetField(user.FieldTotpEnabled, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.TotpLastStep(); ok {
+		_spec.SetField(user.FieldTotpLastStep, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedTotpLastStep(); ok {
+		_spec.AddField(user.FieldTotpLastStep, field.TypeInt64, value)
+	}
 	if _u.mutation.SessionsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -793,6 +1774,141 @@ func (_u *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.APIKeysCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.APIKeysTable,
+			Columns: []string{user.APIKeysColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(apikey.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedAPIKeysIDs(); len(nodes) > 0 && !_u.mutation.APIKeysCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.APIKeysTable,
+			Columns: []string{user.APIKeysColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(apikey.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.APIKeysIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.APIKeysTable,
+			Columns: []string{user.APIKeysColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(apikey.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.RecoveryCodesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.RecoveryCodesTable,
+			Columns: []string{user.RecoveryCodesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(recoverycode.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedRecoveryCodesIDs(); len(nodes) > 0 && !_u.mutation.RecoveryCodesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.RecoveryCodesTable,
+			Columns: []string{user.RecoveryCodesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(recoverycode.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RecoveryCodesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.RecoveryCodesTable,
+			Columns: []string{user.RecoveryCodesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(recoverycode.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.AppPasswordsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.AppPasswordsTable,
+			Columns: []string{user.AppPasswordsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(apppassword.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedAppPasswordsIDs(); len(nodes) > 0 && !_u.mutation.AppPasswordsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.AppPasswordsTable,
+			Columns: []string{user.AppPasswordsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(apppassword.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AppPasswordsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.AppPasswordsTable,
+			Columns: []string{user.AppPasswordsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(apppassword.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &User{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -0,0 +1,265 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/ddevcap/jellyfin-proxy/ent/predicate"
+	"github.com/ddevcap/jellyfin-proxy/ent/userconfiguration"
+	"github.com/google/uuid"
+)
+
+// UserConfigurationUpdate is the builder for updating UserConfiguration entities.
+type UserConfigurationUpdate struct {
+	config
+	hooks    []Hook
+	mutation *UserConfigurationMutation
+}
+
+// Where appends a list predicates to the UserConfigurationUpdate builder.
+func (_u *UserConfigurationUpdate) Where(ps ...predicate.UserConfiguration) *UserConfigurationUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *UserConfigurationUpdate) SetUserID(v uuid.UUID) *UserConfigurationUpdate {
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *UserConfigurationUpdate) SetNillableUserID(v *uuid.UUID) *UserConfigurationUpdate {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// SetData sets the "data" field.
+func (_u *UserConfigurationUpdate) SetData(v []byte) *UserConfigurationUpdate {
+	_u.mutation.SetData(v)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *UserConfigurationUpdate) SetUpdatedAt(v time.Time) *UserConfigurationUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the UserConfigurationMutation object of the builder.
+func (_u *UserConfigurationUpdate) Mutation() *UserConfigurationMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *UserConfigurationUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *UserConfigurationUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *UserConfigurationUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *UserConfigurationUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *UserConfigurationUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := userconfiguration.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+func (_u *UserConfigurationUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(userconfiguration.Table, userconfiguration.Columns, sqlgraph.NewFieldSpec(userconfiguration.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UserID(); ok {
+		_spec.SetField(userconfiguration.FieldUserID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.Data(); ok {
+		_spec.SetField(userconfiguration.FieldData, field.TypeBytes, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(userconfiguration.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{userconfiguration.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// UserConfigurationUpdateOne is the builder for updating a single UserConfiguration entity.
+type UserConfigurationUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *UserConfigurationMutation
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *UserConfigurationUpdateOne) SetUserID(v uuid.UUID) *UserConfigurationUpdateOne {
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *UserConfigurationUpdateOne) SetNillableUserID(v *uuid.UUID) *UserConfigurationUpdateOne {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// SetData sets the "data" field.
+func (_u *UserConfigurationUpdateOne) SetData(v []byte) *UserConfigurationUpdateOne {
+	_u.mutation.SetData(v)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *UserConfigurationUpdateOne) SetUpdatedAt(v time.Time) *UserConfigurationUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the UserConfigurationMutation object of the builder.
+func (_u *UserConfigurationUpdateOne) Mutation() *UserConfigurationMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the UserConfigurationUpdate builder.
+func (_u *UserConfigurationUpdateOne) Where(ps ...predicate.UserConfiguration) *UserConfigurationUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *UserConfigurationUpdateOne) Select(field string, fields ...string) *UserConfigurationUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated UserConfiguration entity.
+func (_u *UserConfigurationUpdateOne) Save(ctx context.Context) (*UserConfiguration, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *UserConfigurationUpdateOne) SaveX(ctx context.Context) *UserConfiguration {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *UserConfigurationUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *UserConfigurationUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *UserConfigurationUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := userconfiguration.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+func (_u *UserConfigurationUpdateOne) sqlSave(ctx context.Context) (_node *UserConfiguration, err error) {
+	_spec := sqlgraph.NewUpdateSpec(userconfiguration.Table, userconfiguration.Columns, sqlgraph.NewFieldSpec(userconfiguration.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "UserConfiguration.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, userconfiguration.FieldID)
+		for _, f := range fields {
+			if !userconfiguration.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != userconfiguration.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UserID(); ok {
+		_spec.SetField(userconfiguration.FieldUserID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.Data(); ok {
+		_spec.SetField(userconfiguration.FieldData, field.TypeBytes, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(userconfiguration.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_node = &UserConfiguration{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{userconfiguration.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -8,6 +8,8 @@
 package idtrans
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
 	"strings"
 )
@@ -64,3 +66,22 @@ func DecodeMerged(proxyID string) (collectionType string, ok bool) {
 	}
 	return proxyID[len(mergedPrefix)+len(sep):], true
 }
+
+const dupPrefix = "dup"
+
+// EncodeDup returns a virtual proxy ID for a title that exists on more than
+// one backend. key identifies the title independently of any backend (e.g.
+// "Movie|Tmdb|348"); it is hashed so the ID is short and opaque but stays the
+// same across restarts. Like merged IDs these are never sent to a backend —
+// the proxy maps them to one of the backend copies on each request.
+//
+// Format: "dup_" followed by 16 hex characters.
+func EncodeDup(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return dupPrefix + sep + hex.EncodeToString(sum[:8])
+}
+
+// IsDup reports whether proxyID is a virtual ID produced by EncodeDup.
+func IsDup(proxyID string) bool {
+	return strings.HasPrefix(proxyID, dupPrefix+sep)
+}
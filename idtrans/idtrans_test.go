@@ -146,6 +146,24 @@ var _ = Describe("RewriteResponse", func() {
 		})
 	})
 
+	Context("ID list fields", func() {
+		It("rewrites every ID in a LibraryChanged message", func() {
+			out := rewriteResponse(obj{
+				"MessageType": "LibraryChanged",
+				"Data": obj{
+					"ItemsAdded":     []interface{}{"a", "b"},
+					"FoldersAddedTo": []interface{}{"lib"},
+					"ItemsRemoved":   []interface{}{},
+				},
+			}, "s1", "proxy-id")
+
+			data := out["Data"].(obj)
+			Expect(data["ItemsAdded"]).To(Equal([]interface{}{"s1_a", "s1_b"}))
+			Expect(data["FoldersAddedTo"]).To(Equal([]interface{}{"s1_lib"}))
+			Expect(data["ItemsRemoved"]).To(BeEmpty())
+		})
+	})
+
 	Context("ArtistItems array", func() {
 		It("rewrites Id inside each ArtistItem", func() {
 			out := rewriteResponse(obj{
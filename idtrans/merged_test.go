@@ -51,3 +51,30 @@ var _ = Describe("DecodeMerged", func() {
 		}
 	})
 })
+
+var _ = Describe("EncodeDup", func() {
+	It("returns a short dup_ ID that is stable for the same key", func() {
+		id := idtrans.EncodeDup("Movie|Tmdb|348")
+		Expect(id).To(MatchRegexp(`^dup_[0-9a-f]{16}$`))
+		Expect(idtrans.EncodeDup("Movie|Tmdb|348")).To(Equal(id))
+	})
+
+	It("returns different IDs for different keys", func() {
+		Expect(idtrans.EncodeDup("Movie|Tmdb|348")).NotTo(Equal(idtrans.EncodeDup("Series|Tmdb|348")))
+	})
+})
+
+var _ = Describe("IsDup", func() {
+	It("recognises IDs produced by EncodeDup", func() {
+		Expect(idtrans.IsDup(idtrans.EncodeDup("Movie|Imdb|tt0078748"))).To(BeTrue())
+	})
+
+	DescribeTable("rejects other IDs",
+		func(id string) {
+			Expect(idtrans.IsDup(id)).To(BeFalse())
+		},
+		Entry("regular proxy ID", "s1_abc123"),
+		Entry("merged ID", "merged_movies"),
+		Entry("empty string", ""),
+	)
+})
@@ -33,6 +33,18 @@ var idFields = map[string]bool{
 	"MediaSourceId":            true, // appears in PlaybackInfo request bodies
 }
 
+// idListFields is the set of JSON object keys whose values are arrays of item
+// IDs. They mostly appear in WebSocket messages such as LibraryChanged and Play.
+var idListFields = map[string]bool{
+	"ItemIds":            true,
+	"ItemsAdded":         true,
+	"ItemsRemoved":       true,
+	"ItemsUpdated":       true,
+	"FoldersAddedTo":     true,
+	"FoldersRemovedFrom": true,
+	"CollectionFolders":  true,
+}
+
 // serverIDFields are keys whose string values identify a Jellyfin server.
 // In responses these are replaced with the proxy's own server ID so that
 // clients never learn the addresses of the backend servers.
@@ -72,7 +84,8 @@ func RewriteRequest(b []byte) ([]byte, error) {
 }
 
 // rewriteNode recursively walks v and applies transformID to every value
-// whose key is in idFields, and replaces every value whose key is in
+// whose key is in idFields and to every element of a value whose key is in
+// idListFields, and replaces every value whose key is in
 // serverIDFields with proxyServerID (when non-empty).
 //
 // When backend is non-nil and the current map contains an "Id" key,
@@ -89,6 +102,14 @@ func rewriteNode(v interface{}, transformID func(string) string, proxyServerID s
 				if s, ok := child.(string); ok && s != "" {
 					val[k] = transformID(s)
 				}
+			case idListFields[k]:
+				if ids, ok := child.([]interface{}); ok {
+					for i, id := range ids {
+						if s, ok := id.(string); ok && s != "" {
+							ids[i] = transformID(s)
+						}
+					}
+				}
 			case serverIDFields[k] && proxyServerID != "":
 				val[k] = proxyServerID
 			default:
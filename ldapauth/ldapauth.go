@@ -0,0 +1,129 @@
+// Package ldapauth checks usernames and passwords against an LDAP directory
+// with the usual search-then-bind strategy: a service account finds the
+// user's entry, then the proxy binds as that entry with the password given.
+package ldapauth
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/go-ldap/ldap/v3"
+)
+
+// timeout bounds the connection and every request to the directory.
+const timeout = 10 * time.Second
+
+// ErrInvalidCredentials is returned when the user is not in the directory,
+// is not unique, or the password is wrong.
+var ErrInvalidCredentials = errors.New("ldapauth: invalid credentials")
+
+// Config describes the directory and how users are found in it.
+type Config struct {
+	URL          string
+	BindDN       string // empty searches anonymously
+	BindPassword string
+	BaseDN       string
+	// UserFilter finds the user's entry; {username} is replaced with the
+	// escaped login name.
+	UserFilter string
+	// AdminFilter is matched against the user's entry to decide IsAdmin.
+	// Empty leaves IsAdmin false.
+	AdminFilter          string
+	DisplayNameAttribute string
+}
+
+// Identity is a user the directory vouched for.
+type Identity struct {
+	DN          string
+	DisplayName string
+	IsAdmin     bool
+}
+
+// Authenticator authenticates users against one directory. It opens a
+// connection per login, so it is safe for concurrent use.
+type Authenticator struct {
+	cfg Config
+}
+
+func New(cfg Config) *Authenticator {
+	return &Authenticator{cfg: cfg}
+}
+
+// Authenticate verifies username and password. It returns
+// ErrInvalidCredentials when the directory rejects them and another error
+// when the directory could not be asked.
+func (a *Authenticator) Authenticate(username, password string) (Identity, error) {
+	// An empty password would be an unauthenticated bind, which many
+	// servers accept for any DN.
+	if username == "" || password == "" {
+		return Identity{}, ErrInvalidCredentials
+	}
+
+	conn, err := ldap.DialURL(a.cfg.URL, ldap.DialWithDialer(&net.Dialer{Timeout: timeout}))
+	if err != nil {
+		return Identity{}, fmt.Errorf("ldapauth: connecting: %w", err)
+	}
+	defer conn.Close()
+	conn.SetTimeout(timeout)
+
+	if err := a.bindService(conn); err != nil {
+		return Identity{}, fmt.Errorf("ldapauth: service bind: %w", err)
+	}
+
+	filter := strings.ReplaceAll(a.cfg.UserFilter, "{username}", ldap.EscapeFilter(username))
+	res, err := conn.Search(ldap.NewSearchRequest(
+		a.cfg.BaseDN, ldap.ScopeWholeSubtree, ldap.NeverDerefAliases,
+		2, int(timeout/time.Second), false,
+		filter, []string{a.cfg.DisplayNameAttribute}, nil,
+	))
+	switch {
+	case ldap.IsErrorWithCode(err, ldap.LDAPResultSizeLimitExceeded):
+		return Identity{}, ErrInvalidCredentials
+	case err != nil:
+		return Identity{}, fmt.Errorf("ldapauth: searching user: %w", err)
+	case len(res.Entries) != 1:
+		return Identity{}, ErrInvalidCredentials
+	}
+	entry := res.Entries[0]
+
+	if err := conn.Bind(entry.DN, password); err != nil {
+		if ldap.IsErrorWithCode(err, ldap.LDAPResultInvalidCredentials) {
+			return Identity{}, ErrInvalidCredentials
+		}
+		return Identity{}, fmt.Errorf("ldapauth: user bind: %w", err)
+	}
+
+	id := Identity{
+		DN:          entry.DN,
+		DisplayName: entry.GetAttributeValue(a.cfg.DisplayNameAttribute),
+	}
+	if a.cfg.AdminFilter != "" {
+		// Checked as the service account: the user may not be allowed to
+		// read their own group memberships.
+		if err := a.bindService(conn); err != nil {
+			return Identity{}, fmt.Errorf("ldapauth: service bind: %w", err)
+		}
+		res, err := conn.Search(ldap.NewSearchRequest(
+			entry.DN, ldap.ScopeBaseObject, ldap.NeverDerefAliases,
+			1, int(timeout/time.Second), false,
+			a.cfg.AdminFilter, []string{"1.1"}, nil,
+		))
+		if err != nil {
+			return Identity{}, fmt.Errorf("ldapauth: checking admin filter: %w", err)
+		}
+		id.IsAdmin = len(res.Entries) == 1
+	}
+	return id, nil
+}
+
+// bindService binds as the service account, or leaves the connection
+// anonymous when none is configured.
+func (a *Authenticator) bindService(conn *ldap.Conn) error {
+	if a.cfg.BindDN == "" {
+		return conn.UnauthenticatedBind("")
+	}
+	return conn.Bind(a.cfg.BindDN, a.cfg.BindPassword)
+}
@@ -0,0 +1,91 @@
+package ldapauth_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/ddevcap/jellyfin-proxy/ldapauth"
+	"github.com/ddevcap/jellyfin-proxy/ldapauth/ldaptest"
+)
+
+var _ = Describe("Authenticator", func() {
+	var (
+		dir  *ldaptest.Server
+		auth *ldapauth.Authenticator
+	)
+
+	BeforeEach(func() {
+		dir = ldaptest.NewServer(
+			ldaptest.Entry{DN: "cn=svc,dc=example,dc=com", Password: "svc-secret"},
+			ldaptest.Entry{
+				DN:       "uid=alice,ou=people,dc=example,dc=com",
+				Password: "alice-secret",
+				Attributes: map[string][]string{
+					"objectClass": {"person"},
+					"uid":         {"alice"},
+					"displayName": {"Alice Liddell"},
+					"memberOf":    {"cn=admins,ou=groups,dc=example,dc=com"},
+				},
+			},
+			ldaptest.Entry{
+				DN:       "uid=bob,ou=people,dc=example,dc=com",
+				Password: "bob-secret",
+				Attributes: map[string][]string{
+					"objectClass": {"person"},
+					"uid":         {"bob"},
+				},
+			},
+		)
+		DeferCleanup(dir.Close)
+		auth = ldapauth.New(ldapauth.Config{
+			URL:                  dir.URL,
+			BindDN:               "cn=svc,dc=example,dc=com",
+			BindPassword:         "svc-secret",
+			BaseDN:               "dc=example,dc=com",
+			UserFilter:           "(&(objectClass=person)(uid={username}))",
+			AdminFilter:          "(memberOf=cn=admins,ou=groups,dc=example,dc=com)",
+			DisplayNameAttribute: "displayName",
+		})
+	})
+
+	It("returns the identity of a user with the right password", func() {
+		id, err := auth.Authenticate("alice", "alice-secret")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(id.DN).To(Equal("uid=alice,ou=people,dc=example,dc=com"))
+		Expect(id.DisplayName).To(Equal("Alice Liddell"))
+		Expect(id.IsAdmin).To(BeTrue())
+	})
+
+	It("reports users outside the admin filter as non-admins", func() {
+		id, err := auth.Authenticate("bob", "bob-secret")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(id.IsAdmin).To(BeFalse())
+	})
+
+	It("rejects a wrong password, an empty password and unknown users", func() {
+		_, err := auth.Authenticate("alice", "wrong")
+		Expect(err).To(MatchError(ldapauth.ErrInvalidCredentials))
+		_, err = auth.Authenticate("alice", "")
+		Expect(err).To(MatchError(ldapauth.ErrInvalidCredentials))
+		_, err = auth.Authenticate("mallory", "alice-secret")
+		Expect(err).To(MatchError(ldapauth.ErrInvalidCredentials))
+	})
+
+	It("escapes the username in the filter", func() {
+		_, err := auth.Authenticate("*", "alice-secret")
+		Expect(err).To(MatchError(ldapauth.ErrInvalidCredentials))
+	})
+
+	It("fails with a non-credential error when the service account is wrong", func() {
+		broken := ldapauth.New(ldapauth.Config{
+			URL:          dir.URL,
+			BindDN:       "cn=svc,dc=example,dc=com",
+			BindPassword: "wrong",
+			BaseDN:       "dc=example,dc=com",
+			UserFilter:   "(uid={username})",
+		})
+		_, err := broken.Authenticate("alice", "alice-secret")
+		Expect(err).To(HaveOccurred())
+		Expect(err).NotTo(MatchError(ldapauth.ErrInvalidCredentials))
+	})
+})
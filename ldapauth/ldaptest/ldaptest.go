@@ -0,0 +1,236 @@
+// Package ldaptest provides a minimal in-process LDAP server for tests. It
+// speaks just enough of the protocol for ldapauth: simple binds, searches
+// with and/or/not/equality/presence filters, and unbind.
+package ldaptest
+
+import (
+	"net"
+	"strings"
+	"sync"
+
+	ber "github.com/go-asn1-ber/asn1-ber"
+	"github.com/go-ldap/ldap/v3"
+)
+
+// Entry is a directory entry. Password is what a bind as DN must present;
+// entries without one cannot bind.
+type Entry struct {
+	DN         string
+	Password   string
+	Attributes map[string][]string
+}
+
+// Server is a running test directory.
+type Server struct {
+	// URL is the ldap:// URL the server listens on.
+	URL string
+
+	ln      net.Listener
+	mu      sync.Mutex
+	entries []Entry
+	wg      sync.WaitGroup
+}
+
+// NewServer starts a directory holding entries. Close it when done.
+func NewServer(entries ...Entry) *Server {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		panic(err)
+	}
+	s := &Server{URL: "ldap://" + ln.Addr().String(), ln: ln, entries: entries}
+	s.wg.Add(1)
+	go s.serve()
+	return s
+}
+
+// Add adds an entry to the directory.
+func (s *Server) Add(e Entry) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.entries = append(s.entries, e)
+}
+
+// Close stops the server.
+func (s *Server) Close() {
+	_ = s.ln.Close()
+	s.wg.Wait()
+}
+
+func (s *Server) serve() {
+	defer s.wg.Done()
+	for {
+		conn, err := s.ln.Accept()
+		if err != nil {
+			return
+		}
+		go s.handle(conn)
+	}
+}
+
+func (s *Server) handle(conn net.Conn) {
+	defer func() { _ = conn.Close() }()
+	for {
+		p, err := ber.ReadPacket(conn)
+		if err != nil || len(p.Children) < 2 {
+			return
+		}
+		id, _ := p.Children[0].Value.(int64)
+		op := p.Children[1]
+		switch op.Tag {
+		case ldap.ApplicationBindRequest:
+			s.bind(conn, id, op)
+		case ldap.ApplicationSearchRequest:
+			s.search(conn, id, op)
+		default: // unbind, or something this server does not speak
+			return
+		}
+	}
+}
+
+func (s *Server) bind(conn net.Conn, id int64, op *ber.Packet) {
+	dn, _ := op.Children[1].Value.(string)
+	password := op.Children[2].Data.String()
+	if dn == "" && password == "" {
+		reply(conn, id, ldap.ApplicationBindResponse, ldap.LDAPResultSuccess)
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, e := range s.entries {
+		if strings.EqualFold(e.DN, dn) && e.Password != "" && e.Password == password {
+			reply(conn, id, ldap.ApplicationBindResponse, ldap.LDAPResultSuccess)
+			return
+		}
+	}
+	reply(conn, id, ldap.ApplicationBindResponse, ldap.LDAPResultInvalidCredentials)
+}
+
+func (s *Server) search(conn net.Conn, id int64, op *ber.Packet) {
+	base, _ := op.Children[0].Value.(string)
+	scope, _ := op.Children[1].Value.(int64)
+	sizeLimit, _ := op.Children[3].Value.(int64)
+	filter := op.Children[6]
+	var attrs []string
+	for _, a := range op.Children[7].Children {
+		attrs = append(attrs, a.Value.(string))
+	}
+
+	s.mu.Lock()
+	var found []Entry
+	for _, e := range s.entries {
+		if inScope(e.DN, base, scope) && matches(e, filter) {
+			found = append(found, e)
+		}
+	}
+	s.mu.Unlock()
+
+	for i, e := range found {
+		if sizeLimit > 0 && int64(i) == sizeLimit {
+			reply(conn, id, ldap.ApplicationSearchResultDone, ldap.LDAPResultSizeLimitExceeded)
+			return
+		}
+		writeEntry(conn, id, e, attrs)
+	}
+	reply(conn, id, ldap.ApplicationSearchResultDone, ldap.LDAPResultSuccess)
+}
+
+func inScope(dn, base string, scope int64) bool {
+	dn, base = strings.ToLower(dn), strings.ToLower(base)
+	if scope == ldap.ScopeBaseObject {
+		return dn == base
+	}
+	return base == "" || dn == base || strings.HasSuffix(dn, ","+base)
+}
+
+func matches(e Entry, f *ber.Packet) bool {
+	switch f.Tag {
+	case ldap.FilterAnd:
+		for _, c := range f.Children {
+			if !matches(e, c) {
+				return false
+			}
+		}
+		return true
+	case ldap.FilterOr:
+		for _, c := range f.Children {
+			if matches(e, c) {
+				return true
+			}
+		}
+		return false
+	case ldap.FilterNot:
+		return len(f.Children) == 1 && !matches(e, f.Children[0])
+	case ldap.FilterEqualityMatch:
+		attr, _ := f.Children[0].Value.(string)
+		want, _ := f.Children[1].Value.(string)
+		for _, v := range values(e, attr) {
+			if strings.EqualFold(v, want) {
+				return true
+			}
+		}
+		return false
+	case ldap.FilterPresent:
+		return len(values(e, f.Data.String())) > 0
+	default:
+		return false
+	}
+}
+
+func values(e Entry, attr string) []string {
+	for name, vals := range e.Attributes {
+		if strings.EqualFold(name, attr) {
+			return vals
+		}
+	}
+	return nil
+}
+
+func writeEntry(conn net.Conn, id int64, e Entry, attrs []string) {
+	entry := ber.Encode(ber.ClassApplication, ber.TypeConstructed, ldap.ApplicationSearchResultEntry, nil, "Search Result Entry")
+	entry.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, e.DN, "DN"))
+	list := ber.Encode(ber.ClassUniversal, ber.TypeConstructed, ber.TagSequence, nil, "Attributes")
+	for name, vals := range e.Attributes {
+		if !requested(name, attrs) {
+			continue
+		}
+		attr := ber.Encode(ber.ClassUniversal, ber.TypeConstructed, ber.TagSequence, nil, "Attribute")
+		attr.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, name, "Type"))
+		set := ber.Encode(ber.ClassUniversal, ber.TypeConstructed, ber.TagSet, nil, "Values")
+		for _, v := range vals {
+			set.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, v, "Value"))
+		}
+		attr.AppendChild(set)
+		list.AppendChild(attr)
+	}
+	entry.AppendChild(list)
+	write(conn, id, entry)
+}
+
+// requested reports whether attribute name was asked for. No attributes
+// means all of them; "1.1" means none.
+func requested(name string, attrs []string) bool {
+	if len(attrs) == 0 {
+		return true
+	}
+	for _, a := range attrs {
+		if a == "*" || strings.EqualFold(a, name) {
+			return true
+		}
+	}
+	return false
+}
+
+func reply(conn net.Conn, id int64, tag ber.Tag, code uint16) {
+	res := ber.Encode(ber.ClassApplication, ber.TypeConstructed, tag, nil, "Response")
+	res.AppendChild(ber.NewInteger(ber.ClassUniversal, ber.TypePrimitive, ber.TagEnumerated, int64(code), "Result Code"))
+	res.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, "", "Matched DN"))
+	res.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, "", "Diagnostic Message"))
+	write(conn, id, res)
+}
+
+func write(conn net.Conn, id int64, op *ber.Packet) {
+	envelope := ber.Encode(ber.ClassUniversal, ber.TypeConstructed, ber.TagSequence, nil, "LDAP Response")
+	envelope.AppendChild(ber.NewInteger(ber.ClassUniversal, ber.TypePrimitive, ber.TagInteger, id, "Message ID"))
+	envelope.AppendChild(op)
+	_, _ = conn.Write(envelope.Bytes())
+}
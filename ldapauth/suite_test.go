@@ -0,0 +1,13 @@
+package ldapauth_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestLDAPAuth(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "LDAP Auth Suite")
+}
@@ -45,20 +45,63 @@ func main() {
 		os.Exit(1)
 	}
 
+	// Backend tokens and TOTP secrets are encrypted at rest when a master
+	// key is configured.
+	tokenKeys, err := backend.NewTokenKeyring(cfg)
+	if err != nil {
+		slog.Error("invalid backend token key", "error", err)
+		os.Exit(1)
+	}
+	if tokenKeys != nil {
+		client.Use(backend.EncryptTokensHook(tokenKeys))
+	}
+
+	// rotate-backend-token-key re-encrypts every backend token and TOTP
+	// secret with BACKEND_TOKEN_KEY, reading old ones with
+	// BACKEND_TOKEN_PREVIOUS_KEYS.
+	if len(os.Args) > 1 && os.Args[1] == "rotate-backend-token-key" {
+		if tokenKeys == nil {
+			slog.Error("rotate-backend-token-key requires BACKEND_TOKEN_KEY")
+			os.Exit(1)
+		}
+		n, err := backend.RotateTokens(context.Background(), client, tokenKeys)
+		if err != nil {
+			slog.Error("failed to rotate backend tokens", "error", err)
+			os.Exit(1)
+		}
+		slog.Info("re-encrypted backend tokens and TOTP secrets", "count", n)
+		return
+	}
+
+	if err := backend.CheckTokenEncryption(context.Background(), client, tokenKeys); err != nil {
+		slog.Error("refusing to start", "error", err)
+		os.Exit(1)
+	}
+
 	api.SeedInitialAdmin(context.Background(), client, cfg)
+	api.HashLegacySessionTokens(context.Background(), client)
 
 	pool := backend.NewPool(client, cfg)
+	pool.SetTokenKeyring(tokenKeys)
 
 	// Start background health checker so fan-out requests skip offline backends.
 	hc := backend.NewHealthChecker(pool, cfg.HealthCheckInterval)
 	pool.SetHealthChecker(hc)
 	hc.Start(context.Background())
 
-	wsHub := handler.NewWSHub()
+	// Start the item indexer so merged libraries can be served from the database.
+	var indexer *backend.Indexer
+	if cfg.IndexInterval > 0 {
+		indexer = backend.NewIndexer(pool, cfg.IndexInterval)
+		pool.SetIndexer(indexer)
+		indexer.Start(context.Background())
+	}
+
+	wsHub := handler.NewWSHub(pool)
 	h, stopLimiter := api.NewRouter(client, cfg, pool, wsHub)
 
 	// Start periodic session cleanup.
-	sessionCleaner := api.NewSessionCleaner(client, cfg)
+	sessionCleaner := api.NewSessionCleaner(client, cfg, wsHub)
 	sessionCleaner.Start(context.Background())
 
 	srv := &http.Server{
@@ -86,6 +129,9 @@ func main() {
 
 	wsHub.Shutdown()
 	hc.Stop()
+	if indexer != nil {
+		indexer.Stop()
+	}
 	stopLimiter()
 	sessionCleaner.Stop()
 
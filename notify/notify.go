@@ -0,0 +1,200 @@
+// Package notify delivers password reset PINs to wherever the proxy's
+// operator can pick them up: a file on the server, an e-mail or a webhook.
+// Jellyfin itself only writes a file; the other channels suit proxies whose
+// file system users cannot reach.
+package notify
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"net/smtp"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// timeout bounds each delivery over the network.
+const timeout = 10 * time.Second
+
+// PasswordReset is a PIN issued for a forgotten password.
+type PasswordReset struct {
+	Username  string    `json:"username"`
+	PIN       string    `json:"pin"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+// Notifier delivers password reset PINs.
+type Notifier interface {
+	Notify(ctx context.Context, r PasswordReset) error
+	// String says where PINs go, in words shown to the user who asked for
+	// one, such as the path of the file written.
+	String() string
+}
+
+// ── File ──────────────────────────────────────────────────────────────────────
+
+// File appends each PIN to a local file as a line of JSON, the way Jellyfin
+// writes its PIN files for the administrator to read.
+type File struct {
+	Path string
+}
+
+func (f File) Notify(_ context.Context, r PasswordReset) error {
+	line, err := json.Marshal(r)
+	if err != nil {
+		return err
+	}
+	file, err := os.OpenFile(f.Path, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0o600)
+	if err != nil {
+		return fmt.Errorf("notify: %w", err)
+	}
+	if _, err := file.Write(append(line, '\n')); err != nil {
+		file.Close()
+		return fmt.Errorf("notify: %w", err)
+	}
+	return file.Close()
+}
+
+func (f File) String() string {
+	return f.Path
+}
+
+// ── E-mail ────────────────────────────────────────────────────────────────────
+
+// SMTP e-mails each PIN through a mail server, using STARTTLS when the server
+// offers it.
+type SMTP struct {
+	Host     string
+	Port     int
+	Username string // empty sends without authentication
+	Password string
+	From     string
+	To       []string
+}
+
+func (s SMTP) Notify(ctx context.Context, r PasswordReset) error {
+	if _, ok := ctx.Deadline(); !ok {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+	username := headerValue(r.Username)
+	body := fmt.Sprintf("A password reset was requested for %s.\r\n\r\n"+
+		"PIN: %s\r\n\r\nIt expires at %s. Once it is redeemed, the PIN is the new password.\r\n",
+		username, r.PIN, r.ExpiresAt.Format(time.RFC1123))
+	msg := "From: " + s.From + "\r\n" +
+		"To: " + strings.Join(s.To, ", ") + "\r\n" +
+		"Subject: Password reset for " + username + "\r\n" +
+		"Content-Type: text/plain; charset=utf-8\r\n" +
+		"\r\n" + body
+	if err := s.send(ctx, []byte(msg)); err != nil {
+		return fmt.Errorf("notify: sending mail: %w", err)
+	}
+	return nil
+}
+
+// send delivers msg the way smtp.SendMail does, but gives up when ctx is
+// done instead of waiting on an unresponsive server forever.
+func (s SMTP) send(ctx context.Context, msg []byte) error {
+	addr := net.JoinHostPort(s.Host, strconv.Itoa(s.Port))
+	var d net.Dialer
+	conn, err := d.DialContext(ctx, "tcp", addr)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	if deadline, ok := ctx.Deadline(); ok {
+		_ = conn.SetDeadline(deadline)
+	}
+	stop := context.AfterFunc(ctx, func() { conn.Close() })
+	defer stop()
+
+	c, err := smtp.NewClient(conn, s.Host)
+	if err != nil {
+		return err
+	}
+	defer c.Close()
+	if ok, _ := c.Extension("STARTTLS"); ok {
+		if err := c.StartTLS(&tls.Config{ServerName: s.Host}); err != nil {
+			return err
+		}
+	}
+	if s.Username != "" {
+		if err := c.Auth(smtp.PlainAuth("", s.Username, s.Password, s.Host)); err != nil {
+			return err
+		}
+	}
+	if err := c.Mail(s.From); err != nil {
+		return err
+	}
+	for _, to := range s.To {
+		if err := c.Rcpt(to); err != nil {
+			return err
+		}
+	}
+	w, err := c.Data()
+	if err != nil {
+		return err
+	}
+	if _, err := w.Write(msg); err != nil {
+		return err
+	}
+	if err := w.Close(); err != nil {
+		return err
+	}
+	return c.Quit()
+}
+
+// headerValue makes s safe to put in a mail header: a line break would let
+// it add headers of its own.
+func headerValue(s string) string {
+	return strings.NewReplacer("\r", " ", "\n", " ").Replace(s)
+}
+
+func (s SMTP) String() string {
+	return "e-mail"
+}
+
+// ── Webhook ───────────────────────────────────────────────────────────────────
+
+// Webhook POSTs each PasswordReset as JSON to a URL, e.g. of a chat or push
+// notification service.
+type Webhook struct {
+	URL    string
+	Client *http.Client // nil uses a client with a 10s timeout
+}
+
+func (w Webhook) Notify(ctx context.Context, r PasswordReset) error {
+	body, err := json.Marshal(r)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, w.URL, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("notify: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	client := w.Client
+	if client == nil {
+		client = &http.Client{Timeout: timeout}
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return fmt.Errorf("notify: calling webhook: %w", err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode >= 300 {
+		return fmt.Errorf("notify: webhook returned %s", resp.Status)
+	}
+	return nil
+}
+
+func (w Webhook) String() string {
+	return "notification"
+}
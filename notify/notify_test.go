@@ -0,0 +1,176 @@
+package notify_test
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/ddevcap/jellyfin-proxy/notify"
+)
+
+// smtpServer accepts connections and speaks just enough SMTP to take one
+// message on each, which it sends to the returned channel.
+func smtpServer() (l net.Listener, msgs <-chan string) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	Expect(err).NotTo(HaveOccurred())
+	DeferCleanup(l.Close)
+	ch := make(chan string, 1)
+	go func() {
+		for {
+			conn, err := l.Accept()
+			if err != nil {
+				return
+			}
+			go func() {
+				defer conn.Close()
+				r := bufio.NewReader(conn)
+				_, _ = io.WriteString(conn, "220 test ESMTP\r\n")
+				var data strings.Builder
+				inData := false
+				for {
+					line, err := r.ReadString('\n')
+					if err != nil {
+						return
+					}
+					switch {
+					case inData && line == ".\r\n":
+						inData = false
+						ch <- data.String()
+						_, _ = io.WriteString(conn, "250 OK\r\n")
+					case inData:
+						data.WriteString(line)
+					case strings.HasPrefix(line, "DATA"):
+						inData = true
+						_, _ = io.WriteString(conn, "354 go ahead\r\n")
+					case strings.HasPrefix(line, "QUIT"):
+						_, _ = io.WriteString(conn, "221 bye\r\n")
+						return
+					default:
+						_, _ = io.WriteString(conn, "250 OK\r\n")
+					}
+				}
+			}()
+		}
+	}()
+	return l, ch
+}
+
+var _ = Describe("Notifiers", func() {
+	reset := notify.PasswordReset{
+		Username:  "alice",
+		PIN:       "0A1B2C3D",
+		ExpiresAt: time.Date(2030, 1, 2, 3, 4, 5, 0, time.UTC),
+	}
+
+	Describe("File", func() {
+		It("appends each PIN as a line of JSON readable only by its owner", func() {
+			f := notify.File{Path: filepath.Join(GinkgoT().TempDir(), "pins.jsonl")}
+			Expect(f.Notify(context.Background(), reset)).To(Succeed())
+			bob := reset
+			bob.Username = "bob"
+			Expect(f.Notify(context.Background(), bob)).To(Succeed())
+
+			data, err := os.ReadFile(f.Path)
+			Expect(err).NotTo(HaveOccurred())
+			lines := strings.Split(strings.TrimSpace(string(data)), "\n")
+			Expect(lines).To(HaveLen(2))
+			Expect(lines[0]).To(MatchJSON(`{"username":"alice","pin":"0A1B2C3D","expires_at":"2030-01-02T03:04:05Z"}`))
+			Expect(lines[1]).To(ContainSubstring(`"bob"`))
+
+			info, err := os.Stat(f.Path)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(info.Mode().Perm()).To(Equal(os.FileMode(0o600)))
+			Expect(f.String()).To(Equal(f.Path))
+		})
+
+		It("fails when the file cannot be written", func() {
+			f := notify.File{Path: filepath.Join(GinkgoT().TempDir(), "missing", "pins.jsonl")}
+			Expect(f.Notify(context.Background(), reset)).NotTo(Succeed())
+		})
+	})
+
+	Describe("SMTP", func() {
+		smtpFor := func(l net.Listener) notify.SMTP {
+			host, port, _ := net.SplitHostPort(l.Addr().String())
+			p, _ := strconv.Atoi(port)
+			return notify.SMTP{Host: host, Port: p, From: "proxy@example.com", To: []string{"admin@example.com"}}
+		}
+
+		It("mails the PIN and keeps line breaks out of the subject", func() {
+			l, msgs := smtpServer()
+			evil := reset
+			evil.Username = "mallory\r\nBcc: victim@example.com"
+
+			Expect(smtpFor(l).Notify(context.Background(), evil)).To(Succeed())
+			var msg string
+			Eventually(msgs).Should(Receive(&msg))
+			Expect(msg).To(ContainSubstring("Subject: Password reset for mallory  Bcc: victim@example.com\r\n"))
+			Expect(msg).NotTo(ContainSubstring("\r\nBcc:"))
+			Expect(msg).To(ContainSubstring("PIN: 0A1B2C3D"))
+		})
+
+		It("gives up when the server does not answer before the context is done", func() {
+			l, err := net.Listen("tcp", "127.0.0.1:0")
+			Expect(err).NotTo(HaveOccurred())
+			DeferCleanup(l.Close)
+			go func() {
+				// Accept and never greet.
+				for {
+					conn, err := l.Accept()
+					if err != nil {
+						return
+					}
+					DeferCleanup(conn.Close)
+				}
+			}()
+
+			ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+			defer cancel()
+			start := time.Now()
+			Expect(smtpFor(l).Notify(ctx, reset)).NotTo(Succeed())
+			Expect(time.Since(start)).To(BeNumerically("<", 2*time.Second))
+		})
+	})
+
+	Describe("Webhook", func() {
+		It("posts the reset as JSON", func() {
+			var got notify.PasswordReset
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				defer GinkgoRecover()
+				Expect(r.Method).To(Equal(http.MethodPost))
+				Expect(r.Header.Get("Content-Type")).To(Equal("application/json"))
+				body, _ := io.ReadAll(r.Body)
+				Expect(json.Unmarshal(body, &got)).To(Succeed())
+				w.WriteHeader(http.StatusNoContent)
+			}))
+			DeferCleanup(srv.Close)
+
+			Expect(notify.Webhook{URL: srv.URL}.Notify(context.Background(), reset)).To(Succeed())
+			Expect(got.Username).To(Equal("alice"))
+			Expect(got.PIN).To(Equal("0A1B2C3D"))
+			Expect(got.ExpiresAt).To(BeTemporally("==", reset.ExpiresAt))
+		})
+
+		It("fails when the webhook answers with an error", func() {
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusInternalServerError)
+			}))
+			DeferCleanup(srv.Close)
+
+			err := notify.Webhook{URL: srv.URL}.Notify(context.Background(), reset)
+			Expect(err).To(MatchError(ContainSubstring("500")))
+		})
+	})
+})
@@ -0,0 +1,122 @@
+package oidc_test
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/ddevcap/jellyfin-proxy/oidc"
+	"github.com/ddevcap/jellyfin-proxy/oidc/oidctest"
+)
+
+var _ = Describe("Provider", func() {
+	var (
+		idp      *oidctest.Server
+		provider *oidc.Provider
+		ctx      = context.Background()
+	)
+
+	const redirectURL = "http://proxy.test/proxy/oidc/callback"
+
+	BeforeEach(func() {
+		idp = oidctest.NewServer()
+		DeferCleanup(idp.Close)
+		var err error
+		provider, err = oidc.Discover(ctx, oidc.Config{
+			IssuerURL:   idp.URL,
+			ClientID:    oidctest.ClientID,
+			RedirectURL: redirectURL,
+			Scopes:      []string{"openid", "profile", "groups"},
+		})
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	// authorize follows the authorization URL and returns the code the
+	// provider redirects back with.
+	authorize := func(state, nonce, verifier string) string {
+		client := &http.Client{CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		}}
+		resp, err := client.Get(provider.AuthCodeURL(state, nonce, verifier))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(resp.Body.Close()).To(Succeed())
+		Expect(resp.StatusCode).To(Equal(http.StatusFound))
+		loc, err := url.Parse(resp.Header.Get("Location"))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(loc.Query().Get("state")).To(Equal(state))
+		return loc.Query().Get("code")
+	}
+
+	It("completes the authorization code flow with PKCE", func() {
+		idp.SetClaims(map[string]interface{}{
+			"sub":                "user-1",
+			"preferred_username": "alice",
+			"groups":             []string{"media", "jellyfin-admins"},
+		})
+		code := authorize("state-1", "nonce-1", "verifier-1")
+
+		claims, err := provider.Exchange(ctx, code, "verifier-1", "nonce-1")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(claims.String("sub")).To(Equal("user-1"))
+		Expect(claims.String("preferred_username")).To(Equal("alice"))
+		Expect(claims.Strings("groups")).To(ConsistOf("media", "jellyfin-admins"))
+	})
+
+	It("rejects a wrong PKCE verifier", func() {
+		idp.SetClaims(map[string]interface{}{"sub": "user-1"})
+		code := authorize("state-1", "nonce-1", "verifier-1")
+
+		_, err := provider.Exchange(ctx, code, "another-verifier", "nonce-1")
+		Expect(err).To(HaveOccurred())
+	})
+
+	Describe("Verify", func() {
+		valid := func() map[string]interface{} {
+			return map[string]interface{}{
+				"iss":   idp.URL,
+				"aud":   oidctest.ClientID,
+				"sub":   "user-1",
+				"exp":   time.Now().Add(time.Hour).Unix(),
+				"nonce": "n",
+			}
+		}
+
+		It("accepts a valid token", func() {
+			_, err := provider.Verify(ctx, idp.Sign(valid()), "n")
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		DescribeTable("rejects tokens that fail a check",
+			func(mutate func(map[string]interface{})) {
+				claims := valid()
+				mutate(claims)
+				_, err := provider.Verify(ctx, idp.Sign(claims), "n")
+				Expect(err).To(HaveOccurred())
+			},
+			Entry("other issuer", func(c map[string]interface{}) { c["iss"] = "https://evil.test" }),
+			Entry("other audience", func(c map[string]interface{}) { c["aud"] = "other-client" }),
+			Entry("expired", func(c map[string]interface{}) { c["exp"] = time.Now().Add(-time.Hour).Unix() }),
+			Entry("replayed nonce", func(c map[string]interface{}) { c["nonce"] = "old" }),
+		)
+
+		It("rejects an unsigned token", func() {
+			payload, _ := json.Marshal(valid())
+			token := base64.RawURLEncoding.EncodeToString([]byte(`{"alg":"none"}`)) + "." +
+				base64.RawURLEncoding.EncodeToString(payload) + "."
+			_, err := provider.Verify(ctx, token, "n")
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("rejects a tampered signature", func() {
+			token := idp.Sign(valid())
+			_, err := provider.Verify(ctx, token[:len(token)-4]+"AAAA", "n")
+			Expect(err).To(HaveOccurred())
+		})
+	})
+})
@@ -0,0 +1,150 @@
+package oidc
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	jose "github.com/go-jose/go-jose/v4"
+	"github.com/go-jose/go-jose/v4/jwt"
+)
+
+// clockSkew is how far the provider's clock may be ahead of or behind ours.
+const clockSkew = time.Minute
+
+// signingAlgorithms are the JWS algorithms accepted on ID tokens: the RSA
+// and ECDSA algorithms that OpenID providers use. "none" and HMAC are never
+// accepted.
+var signingAlgorithms = []jose.SignatureAlgorithm{
+	jose.RS256, jose.RS384, jose.RS512,
+	jose.PS256, jose.PS384, jose.PS512,
+	jose.ES256, jose.ES384, jose.ES512,
+}
+
+// Claims are the claims of a verified ID token.
+type Claims map[string]interface{}
+
+// String returns the string claim name, or "" when it is absent or not a
+// string.
+func (c Claims) String(name string) string {
+	s, _ := c[name].(string)
+	return s
+}
+
+// Strings returns a list claim such as groups. A single string is treated
+// as a one-element list, as some providers send it that way.
+func (c Claims) Strings(name string) []string {
+	switch v := c[name].(type) {
+	case string:
+		return []string{v}
+	case []interface{}:
+		out := make([]string, 0, len(v))
+		for _, e := range v {
+			if s, ok := e.(string); ok {
+				out = append(out, s)
+			}
+		}
+		return out
+	}
+	return nil
+}
+
+// Verify checks the signature and standard claims of a raw ID token and
+// returns its claims. nonce must match the nonce sent with the
+// authorization request.
+func (p *Provider) Verify(ctx context.Context, rawIDToken, nonce string) (Claims, error) {
+	tok, err := jwt.ParseSigned(rawIDToken, signingAlgorithms)
+	if err != nil {
+		return nil, fmt.Errorf("oidc: malformed ID token: %w", err)
+	}
+	if len(tok.Headers) != 1 {
+		return nil, errors.New("oidc: ID token must have exactly one signature")
+	}
+	key, err := p.key(ctx, tok.Headers[0].KeyID)
+	if err != nil {
+		return nil, err
+	}
+
+	var std jwt.Claims
+	var claims Claims
+	if err := tok.Claims(key.Key, &std, &claims); err != nil {
+		return nil, errors.New("oidc: invalid ID token signature")
+	}
+	if std.Expiry == nil {
+		return nil, errors.New("oidc: ID token has no expiry")
+	}
+	err = std.ValidateWithLeeway(jwt.Expected{
+		Issuer:      p.issuer,
+		AnyAudience: jwt.Audience{p.cfg.ClientID},
+		Time:        time.Now(),
+	}, clockSkew)
+	if err != nil {
+		return nil, fmt.Errorf("oidc: ID token rejected: %w", err)
+	}
+	if claims.String("nonce") != nonce {
+		return nil, errors.New("oidc: ID token nonce mismatch")
+	}
+	if std.Subject == "" {
+		return nil, errors.New("oidc: ID token has no subject")
+	}
+	return claims, nil
+}
+
+// key returns the signing key with the given kid, refetching the JWKS once
+// when it is unknown so that key rotation at the provider is picked up.
+func (p *Provider) key(ctx context.Context, kid string) (jose.JSONWebKey, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if k, ok := lookupKey(p.keys, kid); ok {
+		return k, nil
+	}
+	keys, err := p.fetchKeys(ctx)
+	if err != nil {
+		return jose.JSONWebKey{}, err
+	}
+	p.keys = keys
+	if k, ok := lookupKey(p.keys, kid); ok {
+		return k, nil
+	}
+	return jose.JSONWebKey{}, fmt.Errorf("oidc: no signing key with kid %q", kid)
+}
+
+// lookupKey finds kid in keys. Tokens without a kid are accepted when the
+// provider publishes a single key.
+func lookupKey(keys map[string]jose.JSONWebKey, kid string) (jose.JSONWebKey, bool) {
+	if k, ok := keys[kid]; ok {
+		return k, true
+	}
+	if kid == "" && len(keys) == 1 {
+		for _, k := range keys {
+			return k, true
+		}
+	}
+	return jose.JSONWebKey{}, false
+}
+
+// fetchKeys downloads the provider's signing keys. Keys go-jose cannot parse,
+// private or symmetric keys and encryption keys are skipped.
+func (p *Provider) fetchKeys(ctx context.Context) (map[string]jose.JSONWebKey, error) {
+	var set struct {
+		Keys []json.RawMessage `json:"keys"`
+	}
+	if err := p.getJSON(ctx, p.jwksURI, &set); err != nil {
+		return nil, fmt.Errorf("oidc: fetching keys: %w", err)
+	}
+
+	keys := make(map[string]jose.JSONWebKey, len(set.Keys))
+	for _, raw := range set.Keys {
+		var k jose.JSONWebKey
+		if err := k.UnmarshalJSON(raw); err != nil {
+			continue
+		}
+		if (k.Use != "" && k.Use != "sig") || !k.Valid() || !k.IsPublic() {
+			continue
+		}
+		keys[k.KeyID] = k
+	}
+	return keys, nil
+}
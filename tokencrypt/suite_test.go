@@ -0,0 +1,13 @@
+package tokencrypt_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestTokencrypt(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Tokencrypt Suite")
+}
@@ -0,0 +1,181 @@
+// Package tokencrypt encrypts secrets at rest with envelope encryption: every
+// value gets its own random data key, which encrypts the value with AES-GCM
+// and is itself stored wrapped by a master key. Values record which master
+// key wrapped them, so a keyring holding the new and the previous keys can
+// read everything while rows are re-encrypted.
+package tokencrypt
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// KeySize is the length of a master key: AES-256.
+const KeySize = 32
+
+// prefix marks encrypted values; anything else is treated as plaintext.
+const prefix = "enc:v1:"
+
+// ErrUnknownKey is returned when a value was wrapped by a master key that is
+// not in the keyring.
+var ErrUnknownKey = errors.New("tokencrypt: value was encrypted with a key that is not configured")
+
+type masterKey struct {
+	id   string
+	aead cipher.AEAD
+}
+
+// Keyring encrypts with its current master key and decrypts with any of its
+// keys. It is safe for concurrent use.
+type Keyring struct {
+	current masterKey
+	byID    map[string]masterKey
+}
+
+// New returns a keyring that encrypts with current and can still decrypt
+// values encrypted with any of previous. Keys must be KeySize bytes.
+func New(current []byte, previous ...[]byte) (*Keyring, error) {
+	kr := &Keyring{byID: make(map[string]masterKey)}
+	for i, raw := range append([][]byte{current}, previous...) {
+		k, err := newMasterKey(raw)
+		if err != nil {
+			return nil, err
+		}
+		if i == 0 {
+			kr.current = k
+		}
+		if _, dup := kr.byID[k.id]; !dup {
+			kr.byID[k.id] = k
+		}
+	}
+	return kr, nil
+}
+
+func newMasterKey(raw []byte) (masterKey, error) {
+	if len(raw) != KeySize {
+		return masterKey{}, fmt.Errorf("tokencrypt: key must be %d bytes, got %d", KeySize, len(raw))
+	}
+	aead, err := newAEAD(raw)
+	if err != nil {
+		return masterKey{}, err
+	}
+	sum := sha256.Sum256(raw)
+	return masterKey{id: hex.EncodeToString(sum[:4]), aead: aead}, nil
+}
+
+// ParseKey decodes a master key given as base64 (e.g. from
+// `openssl rand -base64 32`) or as 64 hex characters.
+func ParseKey(s string) ([]byte, error) {
+	s = strings.TrimSpace(s)
+	if b, err := hex.DecodeString(s); err == nil && len(b) == KeySize {
+		return b, nil
+	}
+	for _, enc := range []*base64.Encoding{base64.StdEncoding, base64.RawStdEncoding, base64.URLEncoding, base64.RawURLEncoding} {
+		if b, err := enc.DecodeString(s); err == nil {
+			if len(b) != KeySize {
+				return nil, fmt.Errorf("tokencrypt: key must be %d bytes, got %d", KeySize, len(b))
+			}
+			return b, nil
+		}
+	}
+	return nil, errors.New("tokencrypt: key is neither base64 nor hex")
+}
+
+// IsEncrypted reports whether v is an encrypted value rather than plaintext.
+func IsEncrypted(v string) bool {
+	return strings.HasPrefix(v, prefix)
+}
+
+// Encrypt encrypts plaintext under a fresh data key wrapped by the current
+// master key.
+func (kr *Keyring) Encrypt(plaintext string) (string, error) {
+	dataKey := make([]byte, KeySize)
+	if _, err := rand.Read(dataKey); err != nil {
+		return "", err
+	}
+	dataAEAD, err := newAEAD(dataKey)
+	if err != nil {
+		return "", err
+	}
+	wrapped, err := seal(kr.current.aead, dataKey)
+	if err != nil {
+		return "", err
+	}
+	sealed, err := seal(dataAEAD, []byte(plaintext))
+	if err != nil {
+		return "", err
+	}
+	return prefix + kr.current.id + ":" + wrapped + ":" + sealed, nil
+}
+
+// Decrypt returns the plaintext of v. Values that are not encrypted are
+// returned unchanged, so rows written before encryption was enabled keep
+// working.
+func (kr *Keyring) Decrypt(v string) (string, error) {
+	if !IsEncrypted(v) {
+		return v, nil
+	}
+	parts := strings.Split(strings.TrimPrefix(v, prefix), ":")
+	if len(parts) != 3 {
+		return "", errors.New("tokencrypt: malformed value")
+	}
+	k, ok := kr.byID[parts[0]]
+	if !ok {
+		return "", ErrUnknownKey
+	}
+	dataKey, err := open(k.aead, parts[1])
+	if err != nil {
+		return "", err
+	}
+	dataAEAD, err := newAEAD(dataKey)
+	if err != nil {
+		return "", err
+	}
+	plaintext, err := open(dataAEAD, parts[2])
+	if err != nil {
+		return "", err
+	}
+	return string(plaintext), nil
+}
+
+// IsCurrent reports whether v is encrypted with the current master key, i.e.
+// needs no re-encryption after a key rotation.
+func (kr *Keyring) IsCurrent(v string) bool {
+	return strings.HasPrefix(v, prefix+kr.current.id+":")
+}
+
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// seal encrypts b and returns the nonce and ciphertext, base64-encoded.
+func seal(aead cipher.AEAD, b []byte) (string, error) {
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(aead.Seal(nonce, nonce, b, nil)), nil
+}
+
+func open(aead cipher.AEAD, s string) ([]byte, error) {
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil || len(b) < aead.NonceSize() {
+		return nil, errors.New("tokencrypt: malformed value")
+	}
+	plaintext, err := aead.Open(nil, b[:aead.NonceSize()], b[aead.NonceSize():], nil)
+	if err != nil {
+		return nil, errors.New("tokencrypt: value could not be decrypted")
+	}
+	return plaintext, nil
+}
@@ -0,0 +1,77 @@
+package tokencrypt_test
+
+import (
+	"bytes"
+	"encoding/base64"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/ddevcap/jellyfin-proxy/tokencrypt"
+)
+
+var _ = Describe("Keyring", func() {
+	oldKey := bytes.Repeat([]byte{1}, tokencrypt.KeySize)
+	newKey := bytes.Repeat([]byte{2}, tokencrypt.KeySize)
+
+	It("round-trips a value without leaving it readable", func() {
+		kr, err := tokencrypt.New(newKey)
+		Expect(err).NotTo(HaveOccurred())
+
+		enc, err := kr.Encrypt("backend-secret")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(tokencrypt.IsEncrypted(enc)).To(BeTrue())
+		Expect(enc).NotTo(ContainSubstring("backend-secret"))
+
+		again, err := kr.Encrypt("backend-secret")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(again).NotTo(Equal(enc))
+
+		Expect(kr.Decrypt(enc)).To(Equal("backend-secret"))
+	})
+
+	It("passes plaintext values through", func() {
+		kr, err := tokencrypt.New(newKey)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(kr.Decrypt("legacy-token")).To(Equal("legacy-token"))
+	})
+
+	It("decrypts values of a previous key after rotation", func() {
+		before, err := tokencrypt.New(oldKey)
+		Expect(err).NotTo(HaveOccurred())
+		enc, err := before.Encrypt("backend-secret")
+		Expect(err).NotTo(HaveOccurred())
+
+		after, err := tokencrypt.New(newKey, oldKey)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(after.IsCurrent(enc)).To(BeFalse())
+		Expect(after.Decrypt(enc)).To(Equal("backend-secret"))
+
+		only, err := tokencrypt.New(newKey)
+		Expect(err).NotTo(HaveOccurred())
+		_, err = only.Decrypt(enc)
+		Expect(err).To(MatchError(tokencrypt.ErrUnknownKey))
+	})
+
+	It("detects tampering", func() {
+		kr, err := tokencrypt.New(newKey)
+		Expect(err).NotTo(HaveOccurred())
+		enc, err := kr.Encrypt("backend-secret")
+		Expect(err).NotTo(HaveOccurred())
+
+		i := strings.LastIndex(enc, ":") + 5
+		tampered := enc[:i] + string(enc[i]^1) + enc[i+1:]
+		_, err = kr.Decrypt(tampered)
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("parses base64 and hex keys of the right size only", func() {
+		Expect(tokencrypt.ParseKey(base64.StdEncoding.EncodeToString(newKey))).To(Equal(newKey))
+		Expect(tokencrypt.ParseKey(strings.Repeat("02", tokencrypt.KeySize))).To(Equal(newKey))
+		_, err := tokencrypt.ParseKey(base64.StdEncoding.EncodeToString(newKey[:16]))
+		Expect(err).To(HaveOccurred())
+		_, err = tokencrypt.New(newKey[:16])
+		Expect(err).To(HaveOccurred())
+	})
+})
@@ -0,0 +1,139 @@
+// Package totp implements time-based one-time passwords (RFC 6238) as used
+// by authenticator apps: 6-digit codes from HMAC-SHA1 over 30-second time
+// steps, with base32 secrets exchanged through otpauth:// URIs. It also
+// generates the single-use recovery codes handed out with a secret.
+package totp
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1"
+	"crypto/subtle"
+	"encoding/base32"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const (
+	// Digits is the length of a code.
+	Digits = 6
+	// Period is how long a code is valid for.
+	Period = 30 * time.Second
+	// secretSize is the secret length in bytes, the 160 bits RFC 4226
+	// recommends for HMAC-SHA1.
+	secretSize = 20
+	// skew is how many steps a code may be ahead or behind the server's
+	// clock.
+	skew = 1
+)
+
+// ErrInvalidSecret is returned for a secret that is not valid base32.
+var ErrInvalidSecret = errors.New("totp: secret is not valid base32")
+
+// encoding is unpadded base32, the form authenticator apps expect.
+var encoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// GenerateSecret returns a new random secret, base32-encoded.
+func GenerateSecret() (string, error) {
+	b := make([]byte, secretSize)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return encoding.EncodeToString(b), nil
+}
+
+// KeyURI returns the otpauth:// URI that enrols secret in an authenticator
+// app, usually shown as a QR code. issuer names the service and account the
+// user within it.
+func KeyURI(issuer, account, secret string) string {
+	q := url.Values{}
+	q.Set("secret", secret)
+	q.Set("issuer", issuer)
+	q.Set("algorithm", "SHA1")
+	q.Set("digits", fmt.Sprint(Digits))
+	q.Set("period", fmt.Sprint(int(Period/time.Second)))
+	label := url.PathEscape(issuer) + ":" + url.PathEscape(account)
+	return "otpauth://totp/" + label + "?" + q.Encode()
+}
+
+// Step returns the time step t falls in.
+func Step(t time.Time) int64 {
+	return t.Unix() / int64(Period/time.Second)
+}
+
+// Code returns the code of secret for the time step.
+func Code(secret string, step int64) (string, error) {
+	key, err := decodeSecret(secret)
+	if err != nil {
+		return "", err
+	}
+	return generate(key, step), nil
+}
+
+// Match reports whether code is valid for secret at t, allowing for one step
+// of clock drift either way. It returns the step the code belongs to, which
+// callers store to refuse the same code a second time.
+func Match(secret, code string, t time.Time) (step int64, ok bool) {
+	key, err := decodeSecret(secret)
+	if err != nil {
+		return 0, false
+	}
+	code = strings.TrimSpace(code)
+	if len(code) != Digits {
+		return 0, false
+	}
+	now := Step(t)
+	for s := now - skew; s <= now+skew; s++ {
+		if subtle.ConstantTimeCompare([]byte(generate(key, s)), []byte(code)) == 1 {
+			return s, true
+		}
+	}
+	return 0, false
+}
+
+func generate(key []byte, step int64) string {
+	var msg [8]byte
+	binary.BigEndian.PutUint64(msg[:], uint64(step))
+	mac := hmac.New(sha1.New, key)
+	mac.Write(msg[:])
+	sum := mac.Sum(nil)
+	// Dynamic truncation, RFC 4226 section 5.3.
+	offset := sum[len(sum)-1] & 0x0f
+	value := binary.BigEndian.Uint32(sum[offset:]) & 0x7fffffff
+	return fmt.Sprintf("%0*d", Digits, value%uint32(math.Pow10(Digits)))
+}
+
+func decodeSecret(secret string) ([]byte, error) {
+	s := strings.ToUpper(strings.ReplaceAll(secret, " ", ""))
+	key, err := encoding.DecodeString(strings.TrimRight(s, "="))
+	if err != nil || len(key) == 0 {
+		return nil, ErrInvalidSecret
+	}
+	return key, nil
+}
+
+// GenerateRecoveryCodes returns n random recovery codes of the form
+// "xxxxx-xxxxx", in lower-case base32.
+func GenerateRecoveryCodes(n int) ([]string, error) {
+	codes := make([]string, n)
+	for i := range codes {
+		b := make([]byte, 10) // 80 bits, of which the code keeps 50
+		if _, err := rand.Read(b); err != nil {
+			return nil, err
+		}
+		s := strings.ToLower(encoding.EncodeToString(b))
+		codes[i] = s[:5] + "-" + s[5:10]
+	}
+	return codes, nil
+}
+
+// NormalizeRecoveryCode returns the canonical form of a recovery code as
+// typed by a user, ignoring case, spaces and dashes.
+func NormalizeRecoveryCode(code string) string {
+	return strings.ToLower(strings.NewReplacer("-", "", " ", "").Replace(code))
+}
@@ -0,0 +1,114 @@
+package totp_test
+
+import (
+	"net/url"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/ddevcap/jellyfin-proxy/totp"
+)
+
+// rfcSecret is the SHA-1 seed of the RFC 6238 test vectors, base32-encoded.
+const rfcSecret = "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ"
+
+var _ = Describe("Code", func() {
+	DescribeTable("matches the RFC 6238 test vectors",
+		func(unix int64, want string) {
+			code, err := totp.Code(rfcSecret, totp.Step(time.Unix(unix, 0)))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(code).To(Equal(want))
+		},
+		Entry("T=59", int64(59), "287082"),
+		Entry("T=1111111109", int64(1111111109), "081804"),
+		Entry("T=1234567890", int64(1234567890), "005924"),
+		Entry("T=2000000000", int64(2000000000), "279037"),
+	)
+
+	It("rejects a secret that is not base32", func() {
+		_, err := totp.Code("not base32!", 1)
+		Expect(err).To(MatchError(totp.ErrInvalidSecret))
+	})
+})
+
+var _ = Describe("Match", func() {
+	now := time.Unix(1234567890, 0)
+
+	It("accepts the current code and returns its step", func() {
+		step, ok := totp.Match(rfcSecret, "005924", now)
+		Expect(ok).To(BeTrue())
+		Expect(step).To(Equal(totp.Step(now)))
+	})
+
+	It("allows one step of clock drift either way", func() {
+		for _, t := range []time.Time{now.Add(-totp.Period), now.Add(totp.Period)} {
+			step, ok := totp.Match(rfcSecret, "005924", t)
+			Expect(ok).To(BeTrue())
+			Expect(step).To(Equal(totp.Step(now)))
+		}
+	})
+
+	It("rejects codes further away", func() {
+		_, ok := totp.Match(rfcSecret, "005924", now.Add(2*totp.Period))
+		Expect(ok).To(BeFalse())
+	})
+
+	It("rejects wrong and malformed codes", func() {
+		for _, code := range []string{"005925", "5924", "0059240", ""} {
+			_, ok := totp.Match(rfcSecret, code, now)
+			Expect(ok).To(BeFalse(), code)
+		}
+	})
+
+	It("tolerates lower case and spaces in the secret", func() {
+		_, ok := totp.Match("gezd gnbv gy3t qojq gezd gnbv gy3t qojq", "005924", now)
+		Expect(ok).To(BeTrue())
+	})
+})
+
+var _ = Describe("GenerateSecret", func() {
+	It("returns distinct 160-bit secrets", func() {
+		a, err := totp.GenerateSecret()
+		Expect(err).NotTo(HaveOccurred())
+		b, err := totp.GenerateSecret()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(a).To(HaveLen(32))
+		Expect(a).NotTo(Equal(b))
+
+		_, err = totp.Code(a, 1)
+		Expect(err).NotTo(HaveOccurred())
+	})
+})
+
+var _ = Describe("KeyURI", func() {
+	It("builds an otpauth URI for authenticator apps", func() {
+		u, err := url.Parse(totp.KeyURI("Jellyfin Proxy", "alice", rfcSecret))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(u.Scheme).To(Equal("otpauth"))
+		Expect(u.Host).To(Equal("totp"))
+		Expect(u.Path).To(Equal("/Jellyfin Proxy:alice"))
+		Expect(u.Query().Get("secret")).To(Equal(rfcSecret))
+		Expect(u.Query().Get("issuer")).To(Equal("Jellyfin Proxy"))
+		Expect(u.Query().Get("digits")).To(Equal("6"))
+		Expect(u.Query().Get("period")).To(Equal("30"))
+	})
+})
+
+var _ = Describe("Recovery codes", func() {
+	It("generates distinct codes in xxxxx-xxxxx form", func() {
+		codes, err := totp.GenerateRecoveryCodes(10)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(codes).To(HaveLen(10))
+		seen := map[string]bool{}
+		for _, c := range codes {
+			Expect(c).To(MatchRegexp(`^[a-z2-7]{5}-[a-z2-7]{5}$`))
+			Expect(seen[c]).To(BeFalse())
+			seen[c] = true
+		}
+	})
+
+	It("normalizes codes as typed", func() {
+		Expect(totp.NormalizeRecoveryCode(" ABCDE-fghij ")).To(Equal("abcdefghij"))
+	})
+})